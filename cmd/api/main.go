@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 
@@ -53,11 +54,34 @@ func main() {
 	}
 
 	// Initialize dependency injection container
-	container, err := di.NewContainer(db, redisClient, cfg.JWT.Secret, cfg.JWT.Expiration, cfg.Storage.BasePath, cfg.Storage.BaseURL)
+	container, err := di.NewContainer(db, redisClient, cfg)
 	if err != nil {
 		appLogger.Fatal(fmt.Sprintf("Failed to initialize container: %v", err))
 	}
 
+	// Fill the in-process search index before serving, it starts empty on every run
+	if container.Article.ReindexUC != nil {
+		indexed, err := container.Article.ReindexUC.Execute(context.Background())
+		if err != nil {
+			appLogger.Fatal(fmt.Sprintf("Failed to build article search index: %v", err))
+		}
+		appLogger.Info(fmt.Sprintf("Article search index built with %d articles", indexed))
+	}
+
+	// Start background jobs, they stop when main returns
+	jobsCtx, stopJobs := context.WithCancel(context.Background())
+	defer stopJobs()
+	if container.TrashPurge != nil {
+		go container.TrashPurge.Run(jobsCtx)
+		appLogger.Info(fmt.Sprintf("Trash purge started, retention %d days", cfg.Trash.RetentionDays))
+	}
+	go container.DataRequests.Run(jobsCtx)
+	appLogger.Info(fmt.Sprintf("Data request worker started, policy %s", cfg.Privacy.ErasurePolicy))
+	if container.ScheduledPublish != nil {
+		go container.ScheduledPublish.Run(jobsCtx)
+		appLogger.Info(fmt.Sprintf("Article scheduler started, checking every %d seconds", cfg.Article.SchedulerInterval))
+	}
+
 	// Setup Gin router
 	if cfg.Server.Debug {
 		gin.SetMode(gin.DebugMode)
@@ -67,7 +91,9 @@ func main() {
 	router := gin.New()
 
 	// Setup routes
-	container.Router.SetupRoutes(router, cfg.Server.Debug)
+	if err := container.Router.SetupRoutes(router, cfg.Server.Debug); err != nil {
+		appLogger.Fatal(fmt.Sprintf("Failed to setup routes: %v", err))
+	}
 
 	// Start server
 	addr := fmt.Sprintf("%s:%s", cfg.Server.Host, cfg.Server.Port)
@@ -0,0 +1,86 @@
+package auth
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// testArgon2Params keeps the tests fast
+var testArgon2Params = Argon2Params{
+	Memory:      1024,
+	Iterations:  1,
+	Parallelism: 1,
+	SaltLength:  16,
+	KeyLength:   32,
+}
+
+func TestArgon2idPasswordHasher_HashAndVerify(t *testing.T) {
+	hasher := NewArgon2idPasswordHasher(testArgon2Params)
+
+	hashed, err := hasher.Hash("testpassword123")
+	require.NoError(t, err)
+
+	assert.True(t, strings.HasPrefix(hashed, "$argon2id$v=19$m=1024,t=1,p=1$"))
+	assert.True(t, hasher.Verify(hashed, "testpassword123"))
+	assert.False(t, hasher.Verify(hashed, "wrongpassword"))
+
+	// Salted, so the same password hashes differently
+	other, err := hasher.Hash("testpassword123")
+	require.NoError(t, err)
+	assert.NotEqual(t, hashed, other)
+}
+
+func TestArgon2idPasswordHasher_Verify_UsesStoredParams(t *testing.T) {
+	hashed, err := NewArgon2idPasswordHasher(testArgon2Params).Hash("testpassword123")
+	require.NoError(t, err)
+
+	stronger := testArgon2Params
+	stronger.Iterations = 2
+	hasher := NewArgon2idPasswordHasher(stronger)
+
+	assert.True(t, hasher.Verify(hashed, "testpassword123"))
+	assert.True(t, hasher.NeedsRehash(hashed))
+}
+
+func TestArgon2idPasswordHasher_Verify_LegacyBcrypt(t *testing.T) {
+	legacy, err := bcrypt.GenerateFromPassword([]byte("testpassword123"), bcrypt.MinCost)
+	require.NoError(t, err)
+
+	hasher := NewArgon2idPasswordHasher(testArgon2Params)
+
+	assert.True(t, hasher.Verify(string(legacy), "testpassword123"))
+	assert.False(t, hasher.Verify(string(legacy), "wrongpassword"))
+	assert.True(t, hasher.NeedsRehash(string(legacy)))
+}
+
+func TestArgon2idPasswordHasher_Verify_MalformedHash(t *testing.T) {
+	hasher := NewArgon2idPasswordHasher(testArgon2Params)
+
+	tests := []string{
+		"",
+		"plaintext",
+		"$argon2i$v=19$m=1024,t=1,p=1$c2FsdA$a2V5",
+		"$argon2id$v=16$m=1024,t=1,p=1$c2FsdA$a2V5",
+		"$argon2id$v=19$m=x,t=1,p=1$c2FsdA$a2V5",
+		"$argon2id$v=19$m=1024,t=1,p=1$!!$a2V5",
+		"$argon2id$v=19$m=1024,t=1,p=1$c2FsdA$",
+	}
+
+	for _, hashed := range tests {
+		assert.False(t, hasher.Verify(hashed, "testpassword123"), hashed)
+		assert.True(t, hasher.NeedsRehash(hashed), hashed)
+	}
+}
+
+func TestArgon2idPasswordHasher_NeedsRehash_CurrentParams(t *testing.T) {
+	hasher := NewArgon2idPasswordHasher(testArgon2Params)
+
+	hashed, err := hasher.Hash("testpassword123")
+	require.NoError(t, err)
+
+	assert.False(t, hasher.NeedsRehash(hashed))
+}
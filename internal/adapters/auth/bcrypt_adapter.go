@@ -25,3 +25,9 @@ func (h *BcryptPasswordHasher) Verify(hashedPassword, password string) bool {
 	return err == nil
 }
 
+// NeedsRehash implements PasswordHasher interface
+func (h *BcryptPasswordHasher) NeedsRehash(hashedPassword string) bool {
+	cost, err := bcrypt.Cost([]byte(hashedPassword))
+	return err != nil || cost != bcrypt.DefaultCost
+}
+
@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"golang.org/x/crypto/bcrypt"
 )
 
 func TestNewBcryptPasswordHasher(t *testing.T) {
@@ -167,3 +168,16 @@ func TestBcryptPasswordHasher_HashAndVerify_RoundTrip(t *testing.T) {
 	}
 }
 
+func TestBcryptPasswordHasher_NeedsRehash(t *testing.T) {
+	hasher := NewBcryptPasswordHasher()
+
+	current, err := hasher.Hash("testpassword123")
+	assert.NoError(t, err)
+	assert.False(t, hasher.NeedsRehash(current))
+
+	weaker, err := bcrypt.GenerateFromPassword([]byte("testpassword123"), bcrypt.MinCost)
+	assert.NoError(t, err)
+	assert.True(t, hasher.NeedsRehash(string(weaker)))
+
+	assert.True(t, hasher.NeedsRehash("$argon2id$v=19$m=1024,t=1,p=1$c2FsdA$a2V5"))
+}
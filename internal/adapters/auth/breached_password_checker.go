@@ -0,0 +1,58 @@
+package auth
+
+import (
+	"bufio"
+	"context"
+	"crypto/sha1"
+	"encoding/hex"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// hashPrefixLength is the number of hex characters of the SHA-1 hash the range files are named after
+const hashPrefixLength = 5
+
+// RangeFileBreachedPasswordChecker implements BreachedPasswordChecker against a local copy of a
+// k-anonymity breach corpus, such as the Pwned Passwords range files.
+// The directory holds one file per hash prefix, e.g. 21BD1.txt, with a SUFFIX:COUNT line per breached hash.
+type RangeFileBreachedPasswordChecker struct {
+	dir string
+}
+
+// NewRangeFileBreachedPasswordChecker creates a new breached password checker reading range files from dir
+func NewRangeFileBreachedPasswordChecker(dir string) *RangeFileBreachedPasswordChecker {
+	return &RangeFileBreachedPasswordChecker{dir: dir}
+}
+
+// IsBreached implements BreachedPasswordChecker interface
+func (c *RangeFileBreachedPasswordChecker) IsBreached(ctx context.Context, password string) (bool, error) {
+	// The corpus is keyed by SHA-1, passwords are never stored with it
+	sum := sha1.Sum([]byte(password))
+	hash := strings.ToUpper(hex.EncodeToString(sum[:]))
+	prefix, suffix := hash[:hashPrefixLength], hash[hashPrefixLength:]
+
+	file, err := os.Open(filepath.Join(c.dir, prefix+".txt"))
+	if errors.Is(err, fs.ErrNotExist) {
+		// No breached password has this prefix
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	defer func() { _ = file.Close() }()
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		if err := ctx.Err(); err != nil {
+			return false, err
+		}
+		lineSuffix, _, _ := strings.Cut(strings.TrimSpace(scanner.Text()), ":")
+		if strings.EqualFold(lineSuffix, suffix) {
+			return true, nil
+		}
+	}
+	return false, scanner.Err()
+}
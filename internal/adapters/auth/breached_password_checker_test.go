@@ -0,0 +1,47 @@
+package auth
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRangeFileBreachedPasswordChecker_IsBreached(t *testing.T) {
+	dir := t.TempDir()
+	// SHA-1 of "password" is 5BAA61E4C9B93F3F0682250B6CF8331B7EE68FD8
+	ranges := "003D68EB55068C33ACE09247EE4C639306B:3\r\n1E4C9B93F3F0682250B6CF8331B7EE68FD8:9659365\r\n"
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "5BAA6.txt"), []byte(ranges), 0o600))
+
+	checker := NewRangeFileBreachedPasswordChecker(dir)
+
+	tests := []struct {
+		name     string
+		password string
+		want     bool
+	}{
+		{name: "breached password", password: "password", want: true},
+		{name: "prefix without range file", password: "correct horse battery staple", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			breached, err := checker.IsBreached(context.Background(), tt.password)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, breached)
+		})
+	}
+}
+
+func TestRangeFileBreachedPasswordChecker_IsBreached_SuffixNotInRange(t *testing.T) {
+	dir := t.TempDir()
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "5BAA6.txt"), []byte("003D68EB55068C33ACE09247EE4C639306B:3\n"), 0o600))
+
+	breached, err := NewRangeFileBreachedPasswordChecker(dir).IsBreached(context.Background(), "password")
+
+	assert.NoError(t, err)
+	assert.False(t, breached)
+}
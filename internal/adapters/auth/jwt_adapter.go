@@ -9,32 +9,59 @@ import (
 
 // JWTAdapter implements TokenGenerator and TokenValidator using JWT library
 type JWTAdapter struct {
-	secret     string
-	expiration int // in hours
+	keys       *KeySet
+	issuer     string
+	audience   string
+	expiration time.Duration
 }
 
-// NewJWTAdapter creates a new JWT adapter
-func NewJWTAdapter(secret string, expiration int) *JWTAdapter {
+// NewJWTAdapter creates a new JWT adapter.
+// Tokens carry the issuer and audience and are only accepted with the same values;
+// empty values are neither set nor checked.
+func NewJWTAdapter(keys *KeySet, issuer, audience string, expiration time.Duration) *JWTAdapter {
 	return &JWTAdapter{
-		secret:     secret,
+		keys:       keys,
+		issuer:     issuer,
+		audience:   audience,
 		expiration: expiration,
 	}
 }
 
 // Generate implements TokenGenerator interface
-func (a *JWTAdapter) Generate(userID int64, email string) (string, error) {
-	expirationTime := time.Now().Add(time.Duration(a.expiration) * time.Hour)
+func (a *JWTAdapter) Generate(subject domainuser.TokenClaims) (string, error) {
+	tokenID, err := domainuser.GenerateSecureToken(16)
+	if err != nil {
+		return "", err
+	}
+
+	expirationTime := time.Now().Add(a.expiration)
+
 	claims := &jwtClaims{
-		UserID: userID,
-		Email:  email,
+		UserID:        subject.UserID,
+		Email:         subject.Email,
+		Role:          string(subject.Role),
+		EmailVerified: subject.EmailVerified,
+		SessionID:     subject.SessionID,
+		OrgID:         subject.OrgID,
+		ActorID:       subject.ActorID,
 		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        tokenID,
+			Issuer:    a.issuer,
 			ExpiresAt: jwt.NewNumericDate(expirationTime),
 			IssuedAt:  jwt.NewNumericDate(time.Now()),
 		},
 	}
+	if a.audience != "" {
+		claims.Audience = jwt.ClaimStrings{a.audience}
+	}
+
+	key := a.keys.signingKey()
+	token := jwt.NewWithClaims(key.method, claims)
+	if key.id != "" {
+		token.Header["kid"] = key.id
+	}
 
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	tokenString, err := token.SignedString([]byte(a.secret))
+	tokenString, err := token.SignedString(key.signKey)
 	if err != nil {
 		return "", err
 	}
@@ -46,9 +73,15 @@ func (a *JWTAdapter) Generate(userID int64, email string) (string, error) {
 func (a *JWTAdapter) Validate(tokenString string) (*domainuser.TokenClaims, error) {
 	claims := &jwtClaims{}
 
-	token, err := jwt.ParseWithClaims(tokenString, claims, func(token *jwt.Token) (interface{}, error) {
-		return []byte(a.secret), nil
-	})
+	options := []jwt.ParserOption{jwt.WithValidMethods(a.keys.methods())}
+	if a.issuer != "" {
+		options = append(options, jwt.WithIssuer(a.issuer))
+	}
+	if a.audience != "" {
+		options = append(options, jwt.WithAudience(a.audience))
+	}
+
+	token, err := jwt.ParseWithClaims(tokenString, claims, a.keys.verificationKey, options...)
 
 	if err != nil {
 		return nil, err
@@ -58,16 +91,31 @@ func (a *JWTAdapter) Validate(tokenString string) (*domainuser.TokenClaims, erro
 		return nil, jwt.ErrSignatureInvalid
 	}
 
-	return &domainuser.TokenClaims{
-		UserID: claims.UserID,
-		Email:  claims.Email,
-	}, nil
+	result := &domainuser.TokenClaims{
+		UserID:        claims.UserID,
+		Email:         claims.Email,
+		Role:          domainuser.Role(claims.Role),
+		TokenID:       claims.ID,
+		EmailVerified: claims.EmailVerified,
+		SessionID:     claims.SessionID,
+		OrgID:         claims.OrgID,
+		ActorID:       claims.ActorID,
+	}
+	if claims.ExpiresAt != nil {
+		result.ExpiresAt = claims.ExpiresAt.Time
+	}
+
+	return result, nil
 }
 
 // jwtClaims represents JWT claims (internal implementation detail)
 type jwtClaims struct {
-	UserID int64  `json:"user_id"`
-	Email  string `json:"email"`
+	UserID        int64  `json:"user_id"`
+	Email         string `json:"email"`
+	Role          string `json:"role,omitempty"`
+	EmailVerified bool   `json:"email_verified"`
+	SessionID     string `json:"sid,omitempty"`
+	OrgID         int64  `json:"org_id,omitempty"`
+	ActorID       int64  `json:"actor_id,omitempty"`
 	jwt.RegisteredClaims
 }
-
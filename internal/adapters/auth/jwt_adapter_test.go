@@ -11,24 +11,24 @@ import (
 
 func TestNewJWTAdapter(t *testing.T) {
 	secret := "test-secret"
-	expiration := 24
+	expiration := 24 * time.Hour
 
-	adapter := NewJWTAdapter(secret, expiration)
+	adapter := NewJWTAdapter(NewHMACKeySet(secret), "", "", expiration)
 
 	assert.NotNil(t, adapter)
-	assert.Equal(t, secret, adapter.secret)
+	assert.Equal(t, []byte(secret), adapter.keys.signingKey().signKey)
 	assert.Equal(t, expiration, adapter.expiration)
 }
 
 func TestJWTAdapter_Generate_Success(t *testing.T) {
 	secret := "test-secret-key"
-	expiration := 24
-	adapter := NewJWTAdapter(secret, expiration)
+	expiration := 24 * time.Hour
+	adapter := NewJWTAdapter(NewHMACKeySet(secret), "", "", expiration)
 
 	userID := int64(123)
 	email := "test@example.com"
 
-	token, err := adapter.Generate(userID, email)
+	token, err := adapter.Generate(domainuser.TokenClaims{UserID: userID, Email: email})
 
 	assert.NoError(t, err)
 	assert.NotEmpty(t, token)
@@ -37,13 +37,13 @@ func TestJWTAdapter_Generate_Success(t *testing.T) {
 
 func TestJWTAdapter_Generate_ContainsCorrectClaims(t *testing.T) {
 	secret := "test-secret-key"
-	expiration := 24
-	adapter := NewJWTAdapter(secret, expiration)
+	expiration := 24 * time.Hour
+	adapter := NewJWTAdapter(NewHMACKeySet(secret), "", "", expiration)
 
 	userID := int64(123)
 	email := "test@example.com"
 
-	tokenString, err := adapter.Generate(userID, email)
+	tokenString, err := adapter.Generate(domainuser.TokenClaims{UserID: userID, Email: email})
 	assert.NoError(t, err)
 
 	// Parse the token to verify claims
@@ -62,14 +62,14 @@ func TestJWTAdapter_Generate_ContainsCorrectClaims(t *testing.T) {
 
 func TestJWTAdapter_Validate_Success(t *testing.T) {
 	secret := "test-secret-key"
-	expiration := 24
-	adapter := NewJWTAdapter(secret, expiration)
+	expiration := 24 * time.Hour
+	adapter := NewJWTAdapter(NewHMACKeySet(secret), "", "", expiration)
 
 	userID := int64(123)
 	email := "test@example.com"
 
 	// Generate a token first
-	tokenString, err := adapter.Generate(userID, email)
+	tokenString, err := adapter.Generate(domainuser.TokenClaims{UserID: userID, Email: email})
 	assert.NoError(t, err)
 
 	// Validate the token
@@ -79,22 +79,40 @@ func TestJWTAdapter_Validate_Success(t *testing.T) {
 	assert.NotNil(t, claims)
 	assert.Equal(t, userID, claims.UserID)
 	assert.Equal(t, email, claims.Email)
+	assert.NotEmpty(t, claims.TokenID)
+	assert.WithinDuration(t, time.Now().Add(expiration), claims.ExpiresAt, 5*time.Second)
+}
+
+func TestJWTAdapter_Generate_UniqueTokenIDs(t *testing.T) {
+	adapter := NewJWTAdapter(NewHMACKeySet("test-secret-key"), "", "", 15*time.Minute)
+
+	token1, err := adapter.Generate(domainuser.TokenClaims{UserID: 1, Email: "test@example.com"})
+	assert.NoError(t, err)
+	token2, err := adapter.Generate(domainuser.TokenClaims{UserID: 1, Email: "test@example.com"})
+	assert.NoError(t, err)
+
+	claims1, err := adapter.Validate(token1)
+	assert.NoError(t, err)
+	claims2, err := adapter.Validate(token2)
+	assert.NoError(t, err)
+
+	assert.NotEqual(t, claims1.TokenID, claims2.TokenID)
 }
 
 func TestJWTAdapter_Validate_InvalidSecret(t *testing.T) {
 	secret := "test-secret-key"
-	expiration := 24
-	adapter := NewJWTAdapter(secret, expiration)
+	expiration := 24 * time.Hour
+	adapter := NewJWTAdapter(NewHMACKeySet(secret), "", "", expiration)
 
 	userID := int64(123)
 	email := "test@example.com"
 
 	// Generate a token with one secret
-	tokenString, err := adapter.Generate(userID, email)
+	tokenString, err := adapter.Generate(domainuser.TokenClaims{UserID: userID, Email: email})
 	assert.NoError(t, err)
 
 	// Try to validate with different secret
-	wrongAdapter := NewJWTAdapter("wrong-secret", expiration)
+	wrongAdapter := NewJWTAdapter(NewHMACKeySet("wrong-secret"), "", "", expiration)
 	claims, err := wrongAdapter.Validate(tokenString)
 
 	assert.Error(t, err)
@@ -103,14 +121,14 @@ func TestJWTAdapter_Validate_InvalidSecret(t *testing.T) {
 
 func TestJWTAdapter_Validate_ExpiredToken(t *testing.T) {
 	secret := "test-secret-key"
-	expiration := -1 // Negative expiration means token is already expired
-	adapter := NewJWTAdapter(secret, expiration)
+	expiration := -time.Hour // Negative expiration means token is already expired
+	adapter := NewJWTAdapter(NewHMACKeySet(secret), "", "", expiration)
 
 	userID := int64(123)
 	email := "test@example.com"
 
 	// Generate a token that's already expired
-	tokenString, err := adapter.Generate(userID, email)
+	tokenString, err := adapter.Generate(domainuser.TokenClaims{UserID: userID, Email: email})
 	assert.NoError(t, err)
 
 	// Wait a bit to ensure token is expired
@@ -125,8 +143,8 @@ func TestJWTAdapter_Validate_ExpiredToken(t *testing.T) {
 
 func TestJWTAdapter_Validate_MalformedToken(t *testing.T) {
 	secret := "test-secret-key"
-	expiration := 24
-	adapter := NewJWTAdapter(secret, expiration)
+	expiration := 24 * time.Hour
+	adapter := NewJWTAdapter(NewHMACKeySet(secret), "", "", expiration)
 
 	malformedToken := "not.a.valid.jwt.token"
 
@@ -138,8 +156,8 @@ func TestJWTAdapter_Validate_MalformedToken(t *testing.T) {
 
 func TestJWTAdapter_Validate_EmptyToken(t *testing.T) {
 	secret := "test-secret-key"
-	expiration := 24
-	adapter := NewJWTAdapter(secret, expiration)
+	expiration := 24 * time.Hour
+	adapter := NewJWTAdapter(NewHMACKeySet(secret), "", "", expiration)
 
 	claims, err := adapter.Validate("")
 
@@ -149,8 +167,8 @@ func TestJWTAdapter_Validate_EmptyToken(t *testing.T) {
 
 func TestJWTAdapter_Validate_InvalidSignature(t *testing.T) {
 	secret := "test-secret-key"
-	expiration := 24
-	adapter := NewJWTAdapter(secret, expiration)
+	expiration := 24 * time.Hour
+	adapter := NewJWTAdapter(NewHMACKeySet(secret), "", "", expiration)
 
 	// Create a token with invalid signature by manually constructing it
 	// This is a token with correct structure but wrong signature
@@ -164,14 +182,14 @@ func TestJWTAdapter_Validate_InvalidSignature(t *testing.T) {
 
 func TestJWTAdapter_RoundTrip(t *testing.T) {
 	secret := "test-secret-key"
-	expiration := 24
-	adapter := NewJWTAdapter(secret, expiration)
+	expiration := 24 * time.Hour
+	adapter := NewJWTAdapter(NewHMACKeySet(secret), "", "", expiration)
 
 	userID := int64(456)
 	email := "roundtrip@example.com"
 
 	// Generate token
-	tokenString, err := adapter.Generate(userID, email)
+	tokenString, err := adapter.Generate(domainuser.TokenClaims{UserID: userID, Email: email})
 	assert.NoError(t, err)
 	assert.NotEmpty(t, tokenString)
 
@@ -183,18 +201,94 @@ func TestJWTAdapter_RoundTrip(t *testing.T) {
 	assert.Equal(t, email, claims.Email)
 }
 
+func TestJWTAdapter_RoundTrip_Role(t *testing.T) {
+	adapter := NewJWTAdapter(NewHMACKeySet("test-secret-key"), "", "", 15*time.Minute)
+
+	tokenString, err := adapter.Generate(domainuser.TokenClaims{
+		UserID:        1,
+		Email:         "admin@example.com",
+		Role:          domainuser.RoleAdmin,
+		EmailVerified: true,
+	})
+	assert.NoError(t, err)
+
+	claims, err := adapter.Validate(tokenString)
+	assert.NoError(t, err)
+	assert.Equal(t, domainuser.RoleAdmin, claims.Role)
+	assert.True(t, claims.EmailVerified)
+}
+
+func TestJWTAdapter_RoundTrip_SessionID(t *testing.T) {
+	adapter := NewJWTAdapter(NewHMACKeySet("test-secret-key"), "", "", 15*time.Minute)
+
+	tokenString, err := adapter.Generate(domainuser.TokenClaims{UserID: 1, SessionID: "session-1"})
+	assert.NoError(t, err)
+
+	claims, err := adapter.Validate(tokenString)
+	assert.NoError(t, err)
+	assert.Equal(t, "session-1", claims.SessionID)
+
+	// Tokens without a session omit the claim
+	tokenString, err = adapter.Generate(domainuser.TokenClaims{UserID: 1})
+	assert.NoError(t, err)
+
+	raw := jwt.MapClaims{}
+	_, _, err = jwt.NewParser().ParseUnverified(tokenString, raw)
+	assert.NoError(t, err)
+	assert.NotContains(t, raw, "sid")
+}
+
+func TestJWTAdapter_RoundTrip_OrgID(t *testing.T) {
+	adapter := NewJWTAdapter(NewHMACKeySet("test-secret-key"), "", "", 15*time.Minute)
+
+	tokenString, err := adapter.Generate(domainuser.TokenClaims{UserID: 1, OrgID: 4, Role: domainuser.RoleEditor})
+	assert.NoError(t, err)
+
+	claims, err := adapter.Validate(tokenString)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(4), claims.OrgID)
+	assert.Equal(t, domainuser.RoleEditor, claims.Role)
+
+	raw := jwt.MapClaims{}
+	_, _, err = jwt.NewParser().ParseUnverified(tokenString, raw)
+	assert.NoError(t, err)
+	assert.Equal(t, float64(4), raw["org_id"])
+}
+
+func TestJWTAdapter_RoundTrip_ActorID(t *testing.T) {
+	adapter := NewJWTAdapter(NewHMACKeySet("test-secret-key"), "", "", 15*time.Minute)
+
+	tokenString, err := adapter.Generate(domainuser.TokenClaims{UserID: 5, ActorID: 1})
+	assert.NoError(t, err)
+
+	claims, err := adapter.Validate(tokenString)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(5), claims.UserID)
+	assert.Equal(t, int64(1), claims.ActorID)
+	assert.True(t, claims.IsImpersonation())
+
+	// Regular tokens don't carry an actor
+	tokenString, err = adapter.Generate(domainuser.TokenClaims{UserID: 5})
+	assert.NoError(t, err)
+
+	raw := jwt.MapClaims{}
+	_, _, err = jwt.NewParser().ParseUnverified(tokenString, raw)
+	assert.NoError(t, err)
+	assert.NotContains(t, raw, "actor_id")
+}
+
 func TestJWTAdapter_Generate_DifferentUsers(t *testing.T) {
 	secret := "test-secret-key"
-	expiration := 24
-	adapter := NewJWTAdapter(secret, expiration)
+	expiration := 24 * time.Hour
+	adapter := NewJWTAdapter(NewHMACKeySet(secret), "", "", expiration)
 
 	user1ID := int64(1)
 	user1Email := "user1@example.com"
 	user2ID := int64(2)
 	user2Email := "user2@example.com"
 
-	token1, err1 := adapter.Generate(user1ID, user1Email)
-	token2, err2 := adapter.Generate(user2ID, user2Email)
+	token1, err1 := adapter.Generate(domainuser.TokenClaims{UserID: user1ID, Email: user1Email})
+	token2, err2 := adapter.Generate(domainuser.TokenClaims{UserID: user2ID, Email: user2Email})
 
 	assert.NoError(t, err1)
 	assert.NoError(t, err2)
@@ -214,8 +308,8 @@ func TestJWTAdapter_Generate_DifferentUsers(t *testing.T) {
 
 func TestJWTAdapter_Validate_ImplementsInterface(t *testing.T) {
 	secret := "test-secret-key"
-	expiration := 24
-	adapter := NewJWTAdapter(secret, expiration)
+	expiration := 24 * time.Hour
+	adapter := NewJWTAdapter(NewHMACKeySet(secret), "", "", expiration)
 
 	// Verify that JWTAdapter implements TokenGenerator and TokenValidator interfaces
 	var _ domainuser.TokenGenerator = adapter
@@ -224,13 +318,13 @@ func TestJWTAdapter_Validate_ImplementsInterface(t *testing.T) {
 
 func TestJWTAdapter_Generate_ExpirationTime(t *testing.T) {
 	secret := "test-secret-key"
-	expiration := 2 // 2 hours
-	adapter := NewJWTAdapter(secret, expiration)
+	expiration := 2 * time.Hour
+	adapter := NewJWTAdapter(NewHMACKeySet(secret), "", "", expiration)
 
 	userID := int64(123)
 	email := "test@example.com"
 
-	tokenString, err := adapter.Generate(userID, email)
+	tokenString, err := adapter.Generate(domainuser.TokenClaims{UserID: userID, Email: email})
 	assert.NoError(t, err)
 
 	// Parse token to check expiration
@@ -252,3 +346,99 @@ func TestJWTAdapter_Generate_ExpirationTime(t *testing.T) {
 	assert.True(t, timeDiff < 5*time.Second && timeDiff > -5*time.Second,
 		"Expiration time should be approximately 2 hours from now")
 }
+
+func TestJWTAdapter_KeyRotation(t *testing.T) {
+	dir := t.TempDir()
+	writeRSAKey(t, dir, "old", 2048)
+	writeEd25519Key(t, dir, "new")
+
+	oldKeys, err := LoadKeySet(dir, "old")
+	assert.NoError(t, err)
+	newKeys, err := LoadKeySet(dir, "new")
+	assert.NoError(t, err)
+
+	oldAdapter := NewJWTAdapter(oldKeys, "hexa-go", "hexa-go-api", time.Hour)
+	newAdapter := NewJWTAdapter(newKeys, "hexa-go", "hexa-go-api", time.Hour)
+
+	oldToken, err := oldAdapter.Generate(domainuser.TokenClaims{UserID: 1, Email: "test@example.com"})
+	assert.NoError(t, err)
+	newToken, err := newAdapter.Generate(domainuser.TokenClaims{UserID: 2, Email: "other@example.com"})
+	assert.NoError(t, err)
+
+	parsed, _, err := jwt.NewParser().ParseUnverified(newToken, &jwtClaims{})
+	assert.NoError(t, err)
+	assert.Equal(t, "new", parsed.Header["kid"])
+	assert.Equal(t, "EdDSA", parsed.Method.Alg())
+
+	// Tokens signed before the rotation are still accepted
+	claims, err := newAdapter.Validate(oldToken)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), claims.UserID)
+
+	claims, err = newAdapter.Validate(newToken)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), claims.UserID)
+}
+
+func TestJWTAdapter_Validate_RejectsUnknownKeyAndAlgorithm(t *testing.T) {
+	dir := t.TempDir()
+	writeEd25519Key(t, dir, "current")
+	keys, err := LoadKeySet(dir, "current")
+	assert.NoError(t, err)
+	adapter := NewJWTAdapter(keys, "", "", time.Hour)
+
+	otherDir := t.TempDir()
+	writeEd25519Key(t, otherDir, "other")
+	otherKeys, err := LoadKeySet(otherDir, "other")
+	assert.NoError(t, err)
+	otherToken, err := NewJWTAdapter(otherKeys, "", "", time.Hour).Generate(domainuser.TokenClaims{UserID: 1})
+	assert.NoError(t, err)
+
+	claims, err := adapter.Validate(otherToken)
+	assert.ErrorIs(t, err, ErrUnknownSigningKey)
+	assert.Nil(t, claims)
+
+	// An HS256 token naming a known kid must not be verified with that key
+	forged := jwt.NewWithClaims(jwt.SigningMethodHS256, &jwtClaims{UserID: 1})
+	forged.Header["kid"] = "current"
+	forgedString, err := forged.SignedString([]byte("guessed"))
+	assert.NoError(t, err)
+
+	claims, err = adapter.Validate(forgedString)
+	assert.Error(t, err)
+	assert.Nil(t, claims)
+}
+
+func TestJWTAdapter_Validate_IssuerAndAudience(t *testing.T) {
+	keys := NewHMACKeySet("test-secret-key")
+	adapter := NewJWTAdapter(keys, "hexa-go", "hexa-go-api", time.Hour)
+
+	tests := []struct {
+		name    string
+		issuer  string
+		aud     string
+		wantErr bool
+	}{
+		{name: "matching claims", issuer: "hexa-go", aud: "hexa-go-api"},
+		{name: "wrong issuer", issuer: "someone-else", aud: "hexa-go-api", wantErr: true},
+		{name: "wrong audience", issuer: "hexa-go", aud: "other-api", wantErr: true},
+		{name: "missing claims", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			token, err := NewJWTAdapter(keys, tt.issuer, tt.aud, time.Hour).Generate(domainuser.TokenClaims{UserID: 1})
+			assert.NoError(t, err)
+
+			claims, err := adapter.Validate(token)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Nil(t, claims)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, int64(1), claims.UserID)
+			}
+		})
+	}
+}
@@ -0,0 +1,215 @@
+package auth
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/golang-jwt/jwt/v5"
+	domainuser "github.com/rulzi/hexa-go/internal/domain/user"
+)
+
+// minRSAKeyBits is the smallest RSA key accepted for signing or verifying tokens
+const minRSAKeyBits = 2048
+
+// ErrUnknownSigningKey is returned when a token names a key that is not in the key set
+var ErrUnknownSigningKey = errors.New("unknown token signing key")
+
+// jwtKey is a single key of a KeySet
+type jwtKey struct {
+	id        string
+	method    jwt.SigningMethod
+	signKey   interface{} // nil for verify-only keys
+	verifyKey interface{}
+}
+
+// KeySet holds the keys for signing and verifying JWTs, identified by their kid.
+// Exactly one key is active and used for signing, the others only verify tokens
+// issued before a rotation.
+type KeySet struct {
+	active *jwtKey
+	keys   map[string]*jwtKey
+}
+
+// NewHMACKeySet creates a key set with a single shared HS256 secret.
+// HMAC secrets are never published.
+func NewHMACKeySet(secret string) *KeySet {
+	key := &jwtKey{
+		method:    jwt.SigningMethodHS256,
+		signKey:   []byte(secret),
+		verifyKey: []byte(secret),
+	}
+
+	return &KeySet{
+		active: key,
+		keys:   map[string]*jwtKey{"": key},
+	}
+}
+
+// LoadKeySet loads PEM encoded RSA and Ed25519 keys from dir, using each file name
+// without its .pem extension as kid. Private keys (PKCS#8 or PKCS#1) can sign, public
+// keys (PKIX) are verify-only. activeKeyID names the private key used for signing.
+func LoadKeySet(dir, activeKeyID string) (*KeySet, error) {
+	paths, err := filepath.Glob(filepath.Join(dir, "*.pem"))
+	if err != nil {
+		return nil, err
+	}
+
+	set := &KeySet{keys: make(map[string]*jwtKey, len(paths))}
+	for _, path := range paths {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+
+		kid := strings.TrimSuffix(filepath.Base(path), ".pem")
+		key, err := parsePEMKey(kid, data)
+		if err != nil {
+			return nil, fmt.Errorf("key %s: %w", kid, err)
+		}
+		set.keys[kid] = key
+	}
+
+	active, ok := set.keys[activeKeyID]
+	if !ok {
+		return nil, fmt.Errorf("active signing key %q not found in %s", activeKeyID, dir)
+	}
+	if active.signKey == nil {
+		return nil, fmt.Errorf("active signing key %q is a public key", activeKeyID)
+	}
+	set.active = active
+
+	return set, nil
+}
+
+// parsePEMKey parses a private or public RSA or Ed25519 key
+func parsePEMKey(kid string, data []byte) (*jwtKey, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.New("no PEM data found")
+	}
+
+	var parsed interface{}
+	var err error
+	switch block.Type {
+	case "PRIVATE KEY":
+		parsed, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	case "RSA PRIVATE KEY":
+		parsed, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	case "PUBLIC KEY":
+		parsed, err = x509.ParsePKIXPublicKey(block.Bytes)
+	default:
+		return nil, fmt.Errorf("unsupported PEM block %q", block.Type)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	key := &jwtKey{id: kid}
+	if signer, ok := parsed.(crypto.Signer); ok {
+		key.signKey = signer
+		parsed = signer.Public()
+	}
+
+	switch pub := parsed.(type) {
+	case *rsa.PublicKey:
+		if pub.N.BitLen() < minRSAKeyBits {
+			return nil, fmt.Errorf("RSA key must have at least %d bits", minRSAKeyBits)
+		}
+		key.method = jwt.SigningMethodRS256
+		key.verifyKey = pub
+	case ed25519.PublicKey:
+		key.method = jwt.SigningMethodEdDSA
+		key.verifyKey = pub
+	default:
+		return nil, fmt.Errorf("unsupported key type %T", parsed)
+	}
+
+	return key, nil
+}
+
+// signingKey returns the active key
+func (s *KeySet) signingKey() *jwtKey {
+	return s.active
+}
+
+// verificationKey returns the key that signed the token, rejecting tokens whose
+// algorithm does not match the key so one key type can't be used as another
+func (s *KeySet) verificationKey(token *jwt.Token) (interface{}, error) {
+	kid, _ := token.Header["kid"].(string)
+
+	key, ok := s.keys[kid]
+	if !ok {
+		return nil, ErrUnknownSigningKey
+	}
+	if token.Method.Alg() != key.method.Alg() {
+		return nil, jwt.ErrTokenSignatureInvalid
+	}
+
+	return key.verifyKey, nil
+}
+
+// methods returns the algorithms of all keys in the set
+func (s *KeySet) methods() []string {
+	seen := make(map[string]bool)
+	var methods []string
+	for _, key := range s.keys {
+		if alg := key.method.Alg(); !seen[alg] {
+			seen[alg] = true
+			methods = append(methods, alg)
+		}
+	}
+	sort.Strings(methods)
+
+	return methods
+}
+
+// PublicKeys implements PublicKeyProvider interface
+func (s *KeySet) PublicKeys() []domainuser.PublicKey {
+	kids := make([]string, 0, len(s.keys))
+	for kid := range s.keys {
+		kids = append(kids, kid)
+	}
+	sort.Strings(kids)
+
+	publicKeys := make([]domainuser.PublicKey, 0, len(kids))
+	for _, kid := range kids {
+		key := s.keys[kid]
+		publicKey := domainuser.PublicKey{
+			KeyID:     kid,
+			Algorithm: key.method.Alg(),
+			Use:       "sig",
+		}
+
+		switch pub := key.verifyKey.(type) {
+		case *rsa.PublicKey:
+			publicKey.KeyType = "RSA"
+			publicKey.N = base64.RawURLEncoding.EncodeToString(pub.N.Bytes())
+			publicKey.E = base64.RawURLEncoding.EncodeToString(big.NewInt(int64(pub.E)).Bytes())
+		case ed25519.PublicKey:
+			publicKey.KeyType = "OKP"
+			publicKey.Curve = "Ed25519"
+			publicKey.X = base64.RawURLEncoding.EncodeToString(pub)
+		default:
+			// Shared secrets must stay private
+			continue
+		}
+
+		publicKeys = append(publicKeys, publicKey)
+	}
+
+	return publicKeys
+}
+
+// Ensure KeySet implements domainuser.PublicKeyProvider
+var _ domainuser.PublicKeyProvider = (*KeySet)(nil)
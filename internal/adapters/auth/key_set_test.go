@@ -0,0 +1,125 @@
+package auth
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// writePEM stores a PEM block as <kid>.pem in dir
+func writePEM(t *testing.T, dir, kid, blockType string, der []byte) {
+	t.Helper()
+	data := pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: der})
+	require.NoError(t, os.WriteFile(filepath.Join(dir, kid+".pem"), data, 0o600))
+}
+
+// writeEd25519Key stores a new Ed25519 private key and returns it
+func writeEd25519Key(t *testing.T, dir, kid string) ed25519.PrivateKey {
+	t.Helper()
+	_, private, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	der, err := x509.MarshalPKCS8PrivateKey(private)
+	require.NoError(t, err)
+	writePEM(t, dir, kid, "PRIVATE KEY", der)
+	return private
+}
+
+// writeRSAKey stores a new RSA private key and returns it
+func writeRSAKey(t *testing.T, dir, kid string, bits int) *rsa.PrivateKey {
+	t.Helper()
+	private, err := rsa.GenerateKey(rand.Reader, bits)
+	require.NoError(t, err)
+	writePEM(t, dir, kid, "RSA PRIVATE KEY", x509.MarshalPKCS1PrivateKey(private))
+	return private
+}
+
+func TestLoadKeySet(t *testing.T) {
+	dir := t.TempDir()
+	writeEd25519Key(t, dir, "2024-01")
+	rsaKey := writeRSAKey(t, dir, "2023-06", 2048)
+	// Public keys of retired keys are enough to verify old tokens
+	publicDER, err := x509.MarshalPKIXPublicKey(rsaKey.Public())
+	require.NoError(t, err)
+	writePEM(t, dir, "2023-01", "PUBLIC KEY", publicDER)
+
+	keys, err := LoadKeySet(dir, "2024-01")
+
+	require.NoError(t, err)
+	assert.Equal(t, "2024-01", keys.signingKey().id)
+	assert.Equal(t, "EdDSA", keys.signingKey().method.Alg())
+	assert.Equal(t, []string{"EdDSA", "RS256"}, keys.methods())
+
+	publicKeys := keys.PublicKeys()
+	require.Len(t, publicKeys, 3)
+	assert.Equal(t, "2023-01", publicKeys[0].KeyID)
+	assert.Equal(t, "RSA", publicKeys[0].KeyType)
+	assert.Equal(t, "RS256", publicKeys[0].Algorithm)
+	assert.Equal(t, "AQAB", publicKeys[0].E)
+	assert.Equal(t, "OKP", publicKeys[2].KeyType)
+	assert.Equal(t, "Ed25519", publicKeys[2].Curve)
+	assert.NotEmpty(t, publicKeys[2].X)
+}
+
+func TestLoadKeySet_Errors(t *testing.T) {
+	tests := []struct {
+		name   string
+		setup  func(t *testing.T, dir string)
+		active string
+	}{
+		{
+			name:   "active key missing",
+			setup:  func(t *testing.T, dir string) { writeEd25519Key(t, dir, "old") },
+			active: "new",
+		},
+		{
+			name: "active key is public",
+			setup: func(t *testing.T, dir string) {
+				public, _, err := ed25519.GenerateKey(rand.Reader)
+				require.NoError(t, err)
+				der, err := x509.MarshalPKIXPublicKey(public)
+				require.NoError(t, err)
+				writePEM(t, dir, "current", "PUBLIC KEY", der)
+			},
+			active: "current",
+		},
+		{
+			name:   "weak RSA key",
+			setup:  func(t *testing.T, dir string) { writeRSAKey(t, dir, "current", 1024) },
+			active: "current",
+		},
+		{
+			name: "not a key",
+			setup: func(t *testing.T, dir string) {
+				require.NoError(t, os.WriteFile(filepath.Join(dir, "current.pem"), []byte("garbage"), 0o600))
+			},
+			active: "current",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			tt.setup(t, dir)
+
+			keys, err := LoadKeySet(dir, tt.active)
+
+			assert.Error(t, err)
+			assert.Nil(t, keys)
+		})
+	}
+}
+
+func TestNewHMACKeySet_NotPublished(t *testing.T) {
+	keys := NewHMACKeySet("secret")
+
+	assert.Empty(t, keys.PublicKeys())
+	assert.Equal(t, []string{"HS256"}, keys.methods())
+}
@@ -0,0 +1,335 @@
+package auth
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	domainuser "github.com/rulzi/hexa-go/internal/domain/user"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// stubAuthorization is an authorization code issued by the stub issuer
+type stubAuthorization struct {
+	clientID      string
+	redirectURI   string
+	codeChallenge string
+	nonce         string
+}
+
+// stubIssuer is a minimal OpenID Connect provider for tests
+type stubIssuer struct {
+	t      *testing.T
+	server *httptest.Server
+
+	mu       sync.Mutex
+	codes    map[string]stubAuthorization
+	keyID    string
+	key      *rsa.PrivateKey
+	audience string
+	expiry   time.Duration
+	jwksHits int
+}
+
+func newStubIssuer(t *testing.T) *stubIssuer {
+	t.Helper()
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	s := &stubIssuer{
+		t:      t,
+		codes:  make(map[string]stubAuthorization),
+		keyID:  "idp-1",
+		key:    key,
+		expiry: time.Minute,
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]string{
+			"issuer":                 s.server.URL,
+			"authorization_endpoint": s.server.URL + "/authorize",
+			"token_endpoint":         s.server.URL + "/token",
+			"jwks_uri":               s.server.URL + "/jwks",
+		})
+	})
+	mux.HandleFunc("/jwks", s.jwks)
+	mux.HandleFunc("/token", s.token)
+	s.server = httptest.NewServer(mux)
+	t.Cleanup(s.server.Close)
+
+	return s
+}
+
+// authorize plays the user signing in at the provider and returns the authorization code
+func (s *stubIssuer) authorize(authURL string) string {
+	s.t.Helper()
+	parsed, err := url.Parse(authURL)
+	require.NoError(s.t, err)
+	query := parsed.Query()
+	require.Equal(s.t, "S256", query.Get("code_challenge_method"))
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	code := "code-" + query.Get("state")
+	s.codes[code] = stubAuthorization{
+		clientID:      query.Get("client_id"),
+		redirectURI:   query.Get("redirect_uri"),
+		codeChallenge: query.Get("code_challenge"),
+		nonce:         query.Get("nonce"),
+	}
+	return code
+}
+
+func (s *stubIssuer) jwks(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.jwksHits++
+
+	_ = json.NewEncoder(w).Encode(map[string]interface{}{
+		"keys": []map[string]string{{
+			"kid": s.keyID,
+			"kty": "RSA",
+			"use": "sig",
+			"alg": "RS256",
+			"n":   base64.RawURLEncoding.EncodeToString(s.key.N.Bytes()),
+			"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(s.key.E)).Bytes()),
+		}},
+	})
+}
+
+func (s *stubIssuer) token(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	clientID, secret, ok := r.BasicAuth()
+	if !ok || clientID != "hexa" || secret != "s3cret" {
+		w.WriteHeader(http.StatusUnauthorized)
+		_ = json.NewEncoder(w).Encode(map[string]string{"error": "invalid_client"})
+		return
+	}
+
+	auth, found := s.codes[r.PostFormValue("code")]
+	delete(s.codes, r.PostFormValue("code"))
+	if !found ||
+		auth.clientID != clientID ||
+		auth.redirectURI != r.PostFormValue("redirect_uri") ||
+		auth.codeChallenge != codeChallenge(r.PostFormValue("code_verifier")) {
+		w.WriteHeader(http.StatusBadRequest)
+		_ = json.NewEncoder(w).Encode(map[string]string{"error": "invalid_grant"})
+		return
+	}
+
+	audience := s.audience
+	if audience == "" {
+		audience = clientID
+	}
+	now := time.Now()
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, jwt.MapClaims{
+		"iss":            s.server.URL,
+		"sub":            "idp-user-1",
+		"aud":            audience,
+		"iat":            now.Unix(),
+		"exp":            now.Add(s.expiry).Unix(),
+		"nonce":          auth.nonce,
+		"email":          "Jane@Example.com",
+		"email_verified": true,
+		"name":           "Jane Doe",
+	})
+	token.Header["kid"] = s.keyID
+	idToken, err := token.SignedString(s.key)
+	require.NoError(s.t, err)
+
+	_ = json.NewEncoder(w).Encode(map[string]string{
+		"access_token": "idp-access-token",
+		"token_type":   "Bearer",
+		"id_token":     idToken,
+	})
+}
+
+func newTestOIDCProvider(s *stubIssuer) *OIDCProvider {
+	return NewOIDCProvider(OIDCConfig{
+		Name:         "corp",
+		IssuerURL:    s.server.URL + "/",
+		ClientID:     "hexa",
+		ClientSecret: "s3cret",
+		RedirectURL:  "http://localhost:8080/api/v1/users/oidc/callback",
+		Scopes:       []string{"openid", "email", "profile"},
+	}, s.server.Client())
+}
+
+func TestOIDCProvider_AuthorizationURL(t *testing.T) {
+	issuer := newStubIssuer(t)
+	provider := newTestOIDCProvider(issuer)
+
+	authURL, err := provider.AuthorizationURL(context.Background(), "state-1", "nonce-1", "verifier-1")
+
+	require.NoError(t, err)
+	parsed, err := url.Parse(authURL)
+	require.NoError(t, err)
+	assert.Equal(t, issuer.server.URL+"/authorize", parsed.Scheme+"://"+parsed.Host+parsed.Path)
+
+	query := parsed.Query()
+	assert.Equal(t, "code", query.Get("response_type"))
+	assert.Equal(t, "hexa", query.Get("client_id"))
+	assert.Equal(t, "http://localhost:8080/api/v1/users/oidc/callback", query.Get("redirect_uri"))
+	assert.Equal(t, "openid email profile", query.Get("scope"))
+	assert.Equal(t, "state-1", query.Get("state"))
+	assert.Equal(t, "nonce-1", query.Get("nonce"))
+	assert.Equal(t, codeChallenge("verifier-1"), query.Get("code_challenge"))
+	assert.NotContains(t, authURL, "verifier-1")
+}
+
+func TestOIDCProvider_Exchange(t *testing.T) {
+	issuer := newStubIssuer(t)
+	provider := newTestOIDCProvider(issuer)
+	ctx := context.Background()
+
+	authURL, err := provider.AuthorizationURL(ctx, "state-1", "nonce-1", "verifier-1")
+	require.NoError(t, err)
+	code := issuer.authorize(authURL)
+
+	identity, err := provider.Exchange(ctx, code, "verifier-1", "nonce-1")
+
+	require.NoError(t, err)
+	assert.Equal(t, &domainuser.ExternalIdentity{
+		Provider:      "corp",
+		Subject:       "idp-user-1",
+		Email:         "jane@example.com",
+		EmailVerified: true,
+		Name:          "Jane Doe",
+	}, identity)
+
+	// Codes are single use
+	_, err = provider.Exchange(ctx, code, "verifier-1", "nonce-1")
+	assert.Equal(t, domainuser.ErrInvalidIdentity, err)
+}
+
+func TestOIDCProvider_Exchange_Rejected(t *testing.T) {
+	tests := []struct {
+		name     string
+		setup    func(s *stubIssuer)
+		verifier string
+		nonce    string
+	}{
+		{
+			name:     "wrong code verifier",
+			verifier: "other-verifier",
+			nonce:    "nonce-1",
+		},
+		{
+			name:     "nonce mismatch",
+			verifier: "verifier-1",
+			nonce:    "other-nonce",
+		},
+		{
+			name:     "wrong audience",
+			setup:    func(s *stubIssuer) { s.audience = "another-client" },
+			verifier: "verifier-1",
+			nonce:    "nonce-1",
+		},
+		{
+			name:     "expired ID token",
+			setup:    func(s *stubIssuer) { s.expiry = -time.Minute },
+			verifier: "verifier-1",
+			nonce:    "nonce-1",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			issuer := newStubIssuer(t)
+			if tt.setup != nil {
+				tt.setup(issuer)
+			}
+			provider := newTestOIDCProvider(issuer)
+			ctx := context.Background()
+
+			authURL, err := provider.AuthorizationURL(ctx, "state-1", "nonce-1", "verifier-1")
+			require.NoError(t, err)
+			code := issuer.authorize(authURL)
+
+			identity, err := provider.Exchange(ctx, code, tt.verifier, tt.nonce)
+
+			assert.Nil(t, identity)
+			assert.Equal(t, domainuser.ErrInvalidIdentity, err)
+		})
+	}
+}
+
+func TestOIDCProvider_Exchange_KeyRotation(t *testing.T) {
+	issuer := newStubIssuer(t)
+	provider := newTestOIDCProvider(issuer)
+	ctx := context.Background()
+
+	signIn := func() (*domainuser.ExternalIdentity, error) {
+		authURL, err := provider.AuthorizationURL(ctx, "state-1", "nonce-1", "verifier-1")
+		require.NoError(t, err)
+		return provider.Exchange(ctx, issuer.authorize(authURL), "verifier-1", "nonce-1")
+	}
+
+	_, err := signIn()
+	require.NoError(t, err)
+
+	// Known keys are cached
+	_, err = signIn()
+	require.NoError(t, err)
+	assert.Equal(t, 1, issuer.jwksHits)
+
+	// A new kid makes the provider refetch its keys once the refresh interval passed
+	newKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	issuer.mu.Lock()
+	issuer.keyID, issuer.key = "idp-2", newKey
+	issuer.mu.Unlock()
+	provider.keysFetched = time.Now().Add(-jwksRefreshInterval)
+
+	_, err = signIn()
+	require.NoError(t, err)
+	assert.Equal(t, 2, issuer.jwksHits)
+}
+
+func TestOIDCProvider_IssuerMismatch(t *testing.T) {
+	issuer := newStubIssuer(t)
+	// Same server under another name, so the discovered issuer does not match
+	provider := NewOIDCProvider(OIDCConfig{
+		Name:      "corp",
+		IssuerURL: strings.Replace(issuer.server.URL, "127.0.0.1", "localhost", 1),
+		ClientID:  "hexa",
+	}, issuer.server.Client())
+
+	_, err := provider.AuthorizationURL(context.Background(), "state-1", "nonce-1", "verifier-1")
+
+	assert.Error(t, err)
+}
+
+func TestParseECJWK(t *testing.T) {
+	private, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	x := base64.RawURLEncoding.EncodeToString(private.X.Bytes())
+	y := base64.RawURLEncoding.EncodeToString(private.Y.Bytes())
+
+	key, err := parseECJWK("P-256", x, y)
+	require.NoError(t, err)
+	assert.True(t, key.Equal(&private.PublicKey))
+
+	_, err = parseECJWK("P-256", y, x)
+	assert.Error(t, err)
+
+	_, err = parseECJWK("secp256k1", x, y)
+	assert.Error(t, err)
+}
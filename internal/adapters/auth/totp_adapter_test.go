@@ -0,0 +1,104 @@
+package auth
+
+import (
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// rfc6238Secret is the base32 encoding of the SHA1 test key "12345678901234567890" from RFC 6238
+const rfc6238Secret = "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+
+func TestTOTPAdapter_Validate_RFC6238Vectors(t *testing.T) {
+	adapter := NewTOTPAdapter("Hexa-Go")
+
+	// Last six digits of the RFC 6238 appendix B SHA1 values
+	tests := []struct {
+		unix int64
+		code string
+	}{
+		{unix: 59, code: "287082"},
+		{unix: 1111111109, code: "081804"},
+		{unix: 1111111111, code: "050471"},
+		{unix: 1234567890, code: "005924"},
+		{unix: 2000000000, code: "279037"},
+	}
+
+	for _, tt := range tests {
+		step, ok := adapter.Validate(rfc6238Secret, tt.code, time.Unix(tt.unix, 0))
+		assert.True(t, ok, "time %d", tt.unix)
+		assert.Equal(t, tt.unix/30, step, "time %d", tt.unix)
+	}
+}
+
+func TestTOTPAdapter_Validate_Skew(t *testing.T) {
+	adapter := NewTOTPAdapter("Hexa-Go")
+	at := time.Unix(1111111109, 0)
+
+	// One period of drift either way is accepted, two are not.
+	// The step is the one the code was generated for, not the current one.
+	step, ok := adapter.Validate(rfc6238Secret, "081804", at.Add(30*time.Second))
+	assert.True(t, ok)
+	assert.Equal(t, int64(1111111109/30), step)
+	step, ok = adapter.Validate(rfc6238Secret, "081804", at.Add(-30*time.Second))
+	assert.True(t, ok)
+	assert.Equal(t, int64(1111111109/30), step)
+	_, ok = adapter.Validate(rfc6238Secret, "081804", at.Add(90*time.Second))
+	assert.False(t, ok)
+}
+
+func TestTOTPAdapter_Validate_Invalid(t *testing.T) {
+	adapter := NewTOTPAdapter("Hexa-Go")
+	at := time.Unix(59, 0)
+
+	for _, tt := range []struct{ secret, code string }{
+		{rfc6238Secret, "000000"},
+		{rfc6238Secret, "28708"},
+		{rfc6238Secret, "2870820"},
+		{"not base32!", "287082"},
+	} {
+		_, ok := adapter.Validate(tt.secret, tt.code, at)
+		assert.False(t, ok, "code %q", tt.code)
+	}
+}
+
+func TestTOTPAdapter_GenerateSecret(t *testing.T) {
+	adapter := NewTOTPAdapter("Hexa-Go")
+
+	secret, err := adapter.GenerateSecret()
+	require.NoError(t, err)
+	assert.Len(t, secret, 32) // 20 bytes in unpadded base32
+
+	other, err := adapter.GenerateSecret()
+	require.NoError(t, err)
+	assert.NotEqual(t, secret, other)
+
+	// A generated secret works with its own codes
+	code := hotp(mustDecodeSecret(t, secret), uint64(time.Now().Unix()/30))
+	_, ok := adapter.Validate(secret, code, time.Now())
+	assert.True(t, ok)
+}
+
+func TestTOTPAdapter_ProvisioningURI(t *testing.T) {
+	adapter := NewTOTPAdapter("Hexa-Go")
+
+	uri := adapter.ProvisioningURI(rfc6238Secret, "admin@example.com")
+
+	require.True(t, strings.HasPrefix(uri, "otpauth://totp/Hexa-Go:admin@example.com?"))
+	parsed, err := url.Parse(uri)
+	require.NoError(t, err)
+	assert.Equal(t, rfc6238Secret, parsed.Query().Get("secret"))
+	assert.Equal(t, "Hexa-Go", parsed.Query().Get("issuer"))
+	assert.Equal(t, "6", parsed.Query().Get("digits"))
+	assert.Equal(t, "30", parsed.Query().Get("period"))
+}
+
+func mustDecodeSecret(t *testing.T, secret string) []byte {
+	key, err := totpEncoding.DecodeString(secret)
+	require.NoError(t, err)
+	return key
+}
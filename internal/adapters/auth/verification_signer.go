@@ -0,0 +1,81 @@
+package auth
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	domainuser "github.com/rulzi/hexa-go/internal/domain/user"
+)
+
+// HMACVerificationSigner implements EmailVerificationSigner with HMAC-SHA256 signed tokens
+type HMACVerificationSigner struct {
+	secret []byte
+}
+
+// NewHMACVerificationSigner creates a new HMAC verification signer
+func NewHMACVerificationSigner(secret string) *HMACVerificationSigner {
+	return &HMACVerificationSigner{secret: []byte(secret)}
+}
+
+// Sign implements EmailVerificationSigner interface
+func (s *HMACVerificationSigner) Sign(claims domainuser.EmailVerificationClaims) (string, error) {
+	payload := fmt.Sprintf("%d|%s|%d", claims.UserID, claims.Email, claims.ExpiresAt.Unix())
+	encoded := base64.RawURLEncoding.EncodeToString([]byte(payload))
+
+	return encoded + "." + s.sign(encoded), nil
+}
+
+// Verify implements EmailVerificationSigner interface
+func (s *HMACVerificationSigner) Verify(token string) (*domainuser.EmailVerificationClaims, error) {
+	encoded, signature, ok := strings.Cut(token, ".")
+	if !ok || !hmac.Equal([]byte(signature), []byte(s.sign(encoded))) {
+		return nil, domainuser.ErrInvalidVerificationToken
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, domainuser.ErrInvalidVerificationToken
+	}
+
+	// The email sits between the first and the last separator
+	first := strings.Index(string(payload), "|")
+	last := strings.LastIndex(string(payload), "|")
+	if first < 0 || first == last {
+		return nil, domainuser.ErrInvalidVerificationToken
+	}
+
+	userID, err := strconv.ParseInt(string(payload[:first]), 10, 64)
+	if err != nil {
+		return nil, domainuser.ErrInvalidVerificationToken
+	}
+	expiresAt, err := strconv.ParseInt(string(payload[last+1:]), 10, 64)
+	if err != nil {
+		return nil, domainuser.ErrInvalidVerificationToken
+	}
+
+	claims := &domainuser.EmailVerificationClaims{
+		UserID:    userID,
+		Email:     string(payload[first+1 : last]),
+		ExpiresAt: time.Unix(expiresAt, 0),
+	}
+	if !time.Now().Before(claims.ExpiresAt) {
+		return nil, domainuser.ErrInvalidVerificationToken
+	}
+
+	return claims, nil
+}
+
+// sign returns the base64 encoded HMAC of the payload
+func (s *HMACVerificationSigner) sign(payload string) string {
+	mac := hmac.New(sha256.New, s.secret)
+	mac.Write([]byte(payload))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// Ensure HMACVerificationSigner implements domainuser.EmailVerificationSigner
+var _ domainuser.EmailVerificationSigner = (*HMACVerificationSigner)(nil)
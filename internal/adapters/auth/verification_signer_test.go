@@ -0,0 +1,76 @@
+package auth
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	domainuser "github.com/rulzi/hexa-go/internal/domain/user"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHMACVerificationSigner_RoundTrip(t *testing.T) {
+	signer := NewHMACVerificationSigner("test-secret")
+	expiresAt := time.Now().Add(time.Hour).Truncate(time.Second)
+
+	token, err := signer.Sign(domainuser.EmailVerificationClaims{
+		UserID:    42,
+		Email:     "odd|name@example.com",
+		ExpiresAt: expiresAt,
+	})
+	assert.NoError(t, err)
+	assert.NotEmpty(t, token)
+
+	claims, err := signer.Verify(token)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(42), claims.UserID)
+	assert.Equal(t, "odd|name@example.com", claims.Email)
+	assert.True(t, expiresAt.Equal(claims.ExpiresAt))
+}
+
+func TestHMACVerificationSigner_Verify_Invalid(t *testing.T) {
+	signer := NewHMACVerificationSigner("test-secret")
+	valid, err := signer.Sign(domainuser.EmailVerificationClaims{
+		UserID:    1,
+		Email:     "test@example.com",
+		ExpiresAt: time.Now().Add(time.Hour),
+	})
+	assert.NoError(t, err)
+
+	expired, err := signer.Sign(domainuser.EmailVerificationClaims{
+		UserID:    1,
+		Email:     "test@example.com",
+		ExpiresAt: time.Now().Add(-time.Minute),
+	})
+	assert.NoError(t, err)
+
+	otherSecret, err := NewHMACVerificationSigner("other-secret").Sign(domainuser.EmailVerificationClaims{
+		UserID:    1,
+		Email:     "test@example.com",
+		ExpiresAt: time.Now().Add(time.Hour),
+	})
+	assert.NoError(t, err)
+
+	payload, signature, _ := strings.Cut(valid, ".")
+	tampered := payload + "x." + signature
+
+	tests := []struct {
+		name  string
+		token string
+	}{
+		{name: "empty", token: ""},
+		{name: "no signature", token: payload},
+		{name: "tampered payload", token: tampered},
+		{name: "signed with other secret", token: otherSecret},
+		{name: "expired", token: expired},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			claims, err := signer.Verify(tt.token)
+
+			assert.Equal(t, domainuser.ErrInvalidVerificationToken, err)
+			assert.Nil(t, claims)
+		})
+	}
+}
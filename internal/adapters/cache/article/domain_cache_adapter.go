@@ -25,29 +25,33 @@ func (a *DomainCacheAdapter) Get(ctx context.Context, id int64) (*domainarticle.
 	if err != nil {
 		return nil, err
 	}
-	if dtoResp == nil {
-		return nil, nil
-	}
+	return toDomainArticle(dtoResp), nil
+}
 
-	return &domainarticle.Article{
-		ID:        dtoResp.ID,
-		Title:     dtoResp.Title,
-		Content:   dtoResp.Content,
-		AuthorID:  dtoResp.AuthorID,
-		CreatedAt: dtoResp.CreatedAt,
-		UpdatedAt: dtoResp.UpdatedAt,
-	}, nil
+// GetBySlug implements domainarticle.Cache interface
+func (a *DomainCacheAdapter) GetBySlug(ctx context.Context, orgID int64, slug string) (*domainarticle.Article, error) {
+	dtoResp, err := a.dtoCache.GetArticleBySlug(ctx, orgID, slug)
+	if err != nil {
+		return nil, err
+	}
+	return toDomainArticle(dtoResp), nil
 }
 
 // Set implements domainarticle.Cache interface
 func (a *DomainCacheAdapter) Set(ctx context.Context, id int64, article *domainarticle.Article) error {
 	dtoResp := &dto.ArticleResponse{
-		ID:        article.ID,
-		Title:     article.Title,
-		Content:   article.Content,
-		AuthorID:  article.AuthorID,
-		CreatedAt: article.CreatedAt,
-		UpdatedAt: article.UpdatedAt,
+		ID:          article.ID,
+		Title:       article.Title,
+		Slug:        article.Slug,
+		Content:     article.Content,
+		AuthorID:    article.AuthorID,
+		OrgID:       article.OrgID,
+		CategoryID:  article.CategoryID,
+		Status:      string(article.Status),
+		PublishedAt: article.PublishedAt,
+		PublishAt:   article.PublishAt,
+		CreatedAt:   article.CreatedAt,
+		UpdatedAt:   article.UpdatedAt,
 	}
 	return a.dtoCache.SetArticle(ctx, id, dtoResp)
 }
@@ -62,3 +66,25 @@ func (a *DomainCacheAdapter) InvalidateList(ctx context.Context) error {
 	return a.dtoCache.InvalidateArticleList(ctx)
 }
 
+// toDomainArticle converts a cached article back to the domain entity, a cache miss stays nil
+func toDomainArticle(dtoResp *dto.ArticleResponse) *domainarticle.Article {
+	if dtoResp == nil {
+		return nil
+	}
+
+	return &domainarticle.Article{
+		ID:          dtoResp.ID,
+		Title:       dtoResp.Title,
+		Slug:        dtoResp.Slug,
+		Content:     dtoResp.Content,
+		AuthorID:    dtoResp.AuthorID,
+		OrgID:       dtoResp.OrgID,
+		CategoryID:  dtoResp.CategoryID,
+		Status:      domainarticle.Status(dtoResp.Status),
+		PublishedAt: dtoResp.PublishedAt,
+		PublishAt:   dtoResp.PublishAt,
+		CreatedAt:   dtoResp.CreatedAt,
+		UpdatedAt:   dtoResp.UpdatedAt,
+	}
+}
+
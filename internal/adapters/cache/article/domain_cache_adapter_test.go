@@ -15,6 +15,7 @@ import (
 // dtoCacheInterface defines the interface for DTO cache operations used by DomainCacheAdapter
 type dtoCacheInterface interface {
 	GetArticle(ctx context.Context, id int64) (*dto.ArticleResponse, error)
+	GetArticleBySlug(ctx context.Context, orgID int64, slug string) (*dto.ArticleResponse, error)
 	SetArticle(ctx context.Context, id int64, articleResp *dto.ArticleResponse) error
 	DeleteArticle(ctx context.Context, id int64) error
 	InvalidateArticleList(ctx context.Context) error
@@ -33,6 +34,14 @@ func (m *mockRedisCache) GetArticle(ctx context.Context, id int64) (*dto.Article
 	return args.Get(0).(*dto.ArticleResponse), args.Error(1)
 }
 
+func (m *mockRedisCache) GetArticleBySlug(ctx context.Context, orgID int64, slug string) (*dto.ArticleResponse, error) {
+	args := m.Called(ctx, orgID, slug)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.ArticleResponse), args.Error(1)
+}
+
 func (m *mockRedisCache) SetArticle(ctx context.Context, id int64, articleResp *dto.ArticleResponse) error {
 	args := m.Called(ctx, id, articleResp)
 	return args.Error(0)
@@ -78,6 +87,27 @@ func (a *testDomainCacheAdapter) Get(ctx context.Context, id int64) (*domainarti
 	}, nil
 }
 
+func (a *testDomainCacheAdapter) GetBySlug(ctx context.Context, orgID int64, slug string) (*domainarticle.Article, error) {
+	dtoResp, err := a.dtoCache.GetArticleBySlug(ctx, orgID, slug)
+	if err != nil {
+		return nil, err
+	}
+	if dtoResp == nil {
+		return nil, nil
+	}
+
+	return &domainarticle.Article{
+		ID:        dtoResp.ID,
+		Title:     dtoResp.Title,
+		Slug:      dtoResp.Slug,
+		Content:   dtoResp.Content,
+		AuthorID:  dtoResp.AuthorID,
+		OrgID:     dtoResp.OrgID,
+		CreatedAt: dtoResp.CreatedAt,
+		UpdatedAt: dtoResp.UpdatedAt,
+	}, nil
+}
+
 func (a *testDomainCacheAdapter) Set(ctx context.Context, id int64, article *domainarticle.Article) error {
 	dtoResp := &dto.ArticleResponse{
 		ID:        article.ID,
@@ -178,6 +208,26 @@ func TestDomainCacheAdapter_Get_Error(t *testing.T) {
 	dtoCache.AssertExpectations(t)
 }
 
+func TestDomainCacheAdapter_GetBySlug(t *testing.T) {
+	ctx := context.Background()
+	dtoCache := &mockRedisCache{}
+	adapter := newTestDomainCacheAdapter(dtoCache)
+
+	dtoCache.On("GetArticleBySlug", ctx, int64(1), "test-article").Return(&dto.ArticleResponse{ID: 3, OrgID: 1, Slug: "test-article"}, nil)
+	dtoCache.On("GetArticleBySlug", ctx, int64(1), "missing").Return(nil, nil)
+
+	result, err := adapter.GetBySlug(ctx, 1, "test-article")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(3), result.ID)
+	assert.Equal(t, "test-article", result.Slug)
+
+	result, err = adapter.GetBySlug(ctx, 1, "missing")
+	assert.NoError(t, err)
+	assert.Nil(t, result)
+
+	dtoCache.AssertExpectations(t)
+}
+
 func TestDomainCacheAdapter_Set_Success(t *testing.T) {
 	ctx := context.Background()
 	dtoCache := &mockRedisCache{}
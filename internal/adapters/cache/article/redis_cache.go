@@ -4,10 +4,13 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/redis/go-redis/v9"
 	"github.com/rulzi/hexa-go/internal/application/article/dto"
+	domainarticle "github.com/rulzi/hexa-go/internal/domain/article"
 )
 
 // RedisCache handles caching for articles using Redis
@@ -47,7 +50,25 @@ func (c *RedisCache) GetArticle(ctx context.Context, id int64) (*dto.ArticleResp
 	return &articleResp, nil
 }
 
-// SetArticle stores an article in cache
+// GetArticleBySlug retrieves an article of an organization from cache by slug
+func (c *RedisCache) GetArticleBySlug(ctx context.Context, orgID int64, slug string) (*dto.ArticleResponse, error) {
+	val, err := c.client.Get(ctx, slugKey(orgID, slug)).Result()
+	if err == redis.Nil {
+		return nil, nil // Cache miss
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get from cache: %w", err)
+	}
+
+	var articleResp dto.ArticleResponse
+	if err := json.Unmarshal([]byte(val), &articleResp); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal cached article: %w", err)
+	}
+
+	return &articleResp, nil
+}
+
+// SetArticle stores an article in cache under its ID and, when it has one, its slug
 func (c *RedisCache) SetArticle(ctx context.Context, id int64, articleResp *dto.ArticleResponse) error {
 	key := fmt.Sprintf("article:%d", id)
 
@@ -56,22 +77,35 @@ func (c *RedisCache) SetArticle(ctx context.Context, id int64, articleResp *dto.
 		return fmt.Errorf("failed to marshal article: %w", err)
 	}
 
-	if err := c.client.Set(ctx, key, data, c.ttl).Err(); err != nil {
+	pipe := c.client.TxPipeline()
+	pipe.Set(ctx, key, data, c.ttl)
+	if articleResp.Slug != "" {
+		pipe.Set(ctx, slugKey(articleResp.OrgID, articleResp.Slug), data, c.ttl)
+	}
+	if _, err := pipe.Exec(ctx); err != nil {
 		return fmt.Errorf("failed to set cache: %w", err)
 	}
 
 	return nil
 }
 
-// DeleteArticle removes an article from cache
+// DeleteArticle removes an article from cache, along with the slug key of the cached copy
+// so that a slug the article no longer uses stops resolving from cache
 func (c *RedisCache) DeleteArticle(ctx context.Context, id int64) error {
 	key := fmt.Sprintf("article:%d", id)
-	return c.client.Del(ctx, key).Err()
+	keys := []string{key}
+
+	// An unreadable copy is still removed, only its slug key is left to expire
+	if cached, err := c.GetArticle(ctx, id); err == nil && cached != nil && cached.Slug != "" {
+		keys = append(keys, slugKey(cached.OrgID, cached.Slug))
+	}
+
+	return c.client.Del(ctx, keys...).Err()
 }
 
-// GetArticleList retrieves a list of articles from cache
-func (c *RedisCache) GetArticleList(ctx context.Context, limit, offset int) (*dto.ListArticlesResponse, error) {
-	key := fmt.Sprintf("article:list:%d:%d", limit, offset)
+// GetArticleList retrieves a filtered list of articles from cache
+func (c *RedisCache) GetArticleList(ctx context.Context, filter domainarticle.ListFilter, limit, offset int) (*dto.ListArticlesResponse, error) {
+	key := listKey(filter, limit, offset)
 
 	val, err := c.client.Get(ctx, key).Result()
 	if err == redis.Nil {
@@ -89,9 +123,9 @@ func (c *RedisCache) GetArticleList(ctx context.Context, limit, offset int) (*dt
 	return &listResp, nil
 }
 
-// SetArticleList stores a list of articles in cache
-func (c *RedisCache) SetArticleList(ctx context.Context, limit, offset int, listResp *dto.ListArticlesResponse) error {
-	key := fmt.Sprintf("article:list:%d:%d", limit, offset)
+// SetArticleList stores a filtered list of articles in cache
+func (c *RedisCache) SetArticleList(ctx context.Context, filter domainarticle.ListFilter, limit, offset int, listResp *dto.ListArticlesResponse) error {
+	key := listKey(filter, limit, offset)
 
 	data, err := json.Marshal(listResp)
 	if err != nil {
@@ -121,3 +155,25 @@ func (c *RedisCache) InvalidateArticleList(ctx context.Context) error {
 
 	return nil
 }
+
+// slugKey builds the cache key of an article by slug, slugs are only unique within an organization
+func slugKey(orgID int64, slug string) string {
+	return fmt.Sprintf("article:slug:%d:%s", orgID, slug)
+}
+
+// listKey builds the cache key of a list page, every filter field is part of it
+// since the same page differs from one viewer to another
+func listKey(filter domainarticle.ListFilter, limit, offset int) string {
+	statuses := make([]string, len(filter.Statuses))
+	for i, status := range filter.Statuses {
+		statuses[i] = string(status)
+	}
+
+	categoryIDs := make([]string, len(filter.CategoryIDs))
+	for i, id := range filter.CategoryIDs {
+		categoryIDs[i] = strconv.FormatInt(id, 10)
+	}
+
+	return fmt.Sprintf("article:list:%d:%d:%s:%d:%s:%d:%d", filter.OrgID, filter.AuthorID, strings.Join(statuses, ","),
+		filter.TagID, strings.Join(categoryIDs, ","), limit, offset)
+}
@@ -10,10 +10,14 @@ import (
 	"github.com/alicebob/miniredis/v2"
 	"github.com/redis/go-redis/v9"
 	"github.com/rulzi/hexa-go/internal/application/article/dto"
+	domainarticle "github.com/rulzi/hexa-go/internal/domain/article"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
+// orgFilter lists the published articles of organization 1
+var orgFilter = domainarticle.ListFilter{OrgID: 1}
+
 // setupRedisCache creates a RedisCache instance with a miniredis server
 func setupRedisCache(t *testing.T, ttl time.Duration) (*RedisCache, *miniredis.Miniredis, func()) {
 	mr, err := miniredis.Run()
@@ -232,6 +236,45 @@ func TestRedisCache_DeleteArticle_RedisError(t *testing.T) {
 	assert.Error(t, err)
 }
 
+// Test SetArticle - the article is also stored under its slug
+func TestRedisCache_SetArticle_SlugKey(t *testing.T) {
+	cache, mr, cleanup := setupRedisCache(t, 5*time.Minute)
+	defer cleanup()
+
+	ctx := context.Background()
+	article := &dto.ArticleResponse{ID: 1, Title: "Test Article", Slug: "test-article", OrgID: 1}
+
+	require.NoError(t, cache.SetArticle(ctx, 1, article))
+
+	assert.True(t, mr.Exists("article:1"))
+	assert.True(t, mr.Exists("article:slug:1:test-article"))
+
+	result, err := cache.GetArticleBySlug(ctx, 1, "test-article")
+	require.NoError(t, err)
+	require.NotNil(t, result)
+	assert.Equal(t, int64(1), result.ID)
+
+	// Slugs are only unique within an organization
+	result, err = cache.GetArticleBySlug(ctx, 2, "test-article")
+	assert.NoError(t, err)
+	assert.Nil(t, result)
+}
+
+// Test DeleteArticle - the slug key of the cached copy is removed too
+func TestRedisCache_DeleteArticle_SlugKey(t *testing.T) {
+	cache, mr, cleanup := setupRedisCache(t, 5*time.Minute)
+	defer cleanup()
+
+	ctx := context.Background()
+	article := &dto.ArticleResponse{ID: 1, Title: "Test Article", Slug: "test-article", OrgID: 1}
+	require.NoError(t, cache.SetArticle(ctx, 1, article))
+
+	require.NoError(t, cache.DeleteArticle(ctx, 1))
+
+	assert.False(t, mr.Exists("article:1"))
+	assert.False(t, mr.Exists("article:slug:1:test-article"))
+}
+
 // Test GetArticleList - Success
 func TestRedisCache_GetArticleList_Success(t *testing.T) {
 	cache, mr, cleanup := setupRedisCache(t, 5*time.Minute)
@@ -267,12 +310,12 @@ func TestRedisCache_GetArticleList_Success(t *testing.T) {
 	// Set list in cache first
 	data, err := json.Marshal(expectedList)
 	require.NoError(t, err)
-	key := fmt.Sprintf("article:list:%d:%d", limit, offset)
+	key := fmt.Sprintf("article:list:%d:0::0::%d:%d", 1, limit, offset)
 	err = mr.Set(key, string(data))
 	require.NoError(t, err)
 
 	// Get list from cache
-	result, err := cache.GetArticleList(ctx, limit, offset)
+	result, err := cache.GetArticleList(ctx, orgFilter, limit, offset)
 	require.NoError(t, err)
 	require.NotNil(t, result)
 	assert.Equal(t, expectedList.Total, result.Total)
@@ -293,11 +336,80 @@ func TestRedisCache_GetArticleList_CacheMiss(t *testing.T) {
 	offset := 100
 
 	// Try to get non-existent list
-	result, err := cache.GetArticleList(ctx, limit, offset)
+	result, err := cache.GetArticleList(ctx, orgFilter, limit, offset)
 	require.NoError(t, err)
 	assert.Nil(t, result) // Cache miss should return nil, not error
 }
 
+// Test GetArticleList - lists of other organizations are never returned
+func TestRedisCache_GetArticleList_OtherOrganization(t *testing.T) {
+	cache, _, cleanup := setupRedisCache(t, 5*time.Minute)
+	defer cleanup()
+
+	ctx := context.Background()
+	list := &dto.ListArticlesResponse{Total: 1, Limit: 10}
+	require.NoError(t, cache.SetArticleList(ctx, orgFilter, 10, 0, list))
+
+	result, err := cache.GetArticleList(ctx, domainarticle.ListFilter{OrgID: 2}, 10, 0)
+	require.NoError(t, err)
+	assert.Nil(t, result)
+}
+
+// Test GetArticleList - lists built for another viewer are never returned
+func TestRedisCache_GetArticleList_OtherViewer(t *testing.T) {
+	cache, _, cleanup := setupRedisCache(t, 5*time.Minute)
+	defer cleanup()
+
+	ctx := context.Background()
+	author := domainarticle.ListFilter{OrgID: 1, AuthorID: 7}
+	admin := domainarticle.ListFilter{OrgID: 1, Statuses: []domainarticle.Status{domainarticle.StatusDraft, domainarticle.StatusInReview}}
+	require.NoError(t, cache.SetArticleList(ctx, author, 10, 0, &dto.ListArticlesResponse{Total: 3, Limit: 10}))
+	require.NoError(t, cache.SetArticleList(ctx, admin, 10, 0, &dto.ListArticlesResponse{Total: 5, Limit: 10}))
+
+	result, err := cache.GetArticleList(ctx, orgFilter, 10, 0)
+	require.NoError(t, err)
+	assert.Nil(t, result)
+
+	result, err = cache.GetArticleList(ctx, domainarticle.ListFilter{OrgID: 1, AuthorID: 8}, 10, 0)
+	require.NoError(t, err)
+	assert.Nil(t, result)
+
+	result, err = cache.GetArticleList(ctx, admin, 10, 0)
+	require.NoError(t, err)
+	require.NotNil(t, result)
+	assert.Equal(t, int64(5), result.Total)
+}
+
+// Test GetArticleList - lists narrowed by tag or category are cached apart
+func TestRedisCache_GetArticleList_TaxonomyFilter(t *testing.T) {
+	cache, _, cleanup := setupRedisCache(t, 5*time.Minute)
+	defer cleanup()
+
+	ctx := context.Background()
+	byTag := domainarticle.ListFilter{OrgID: 1, TagID: 3}
+	byCategory := domainarticle.ListFilter{OrgID: 1, CategoryIDs: []int64{2, 5}}
+	require.NoError(t, cache.SetArticleList(ctx, byTag, 10, 0, &dto.ListArticlesResponse{Total: 2, Limit: 10}))
+	require.NoError(t, cache.SetArticleList(ctx, byCategory, 10, 0, &dto.ListArticlesResponse{Total: 4, Limit: 10}))
+
+	result, err := cache.GetArticleList(ctx, orgFilter, 10, 0)
+	require.NoError(t, err)
+	assert.Nil(t, result)
+
+	result, err = cache.GetArticleList(ctx, domainarticle.ListFilter{OrgID: 1, CategoryIDs: []int64{2}}, 10, 0)
+	require.NoError(t, err)
+	assert.Nil(t, result)
+
+	result, err = cache.GetArticleList(ctx, byTag, 10, 0)
+	require.NoError(t, err)
+	require.NotNil(t, result)
+	assert.Equal(t, int64(2), result.Total)
+
+	result, err = cache.GetArticleList(ctx, byCategory, 10, 0)
+	require.NoError(t, err)
+	require.NotNil(t, result)
+	assert.Equal(t, int64(4), result.Total)
+}
+
 // Test GetArticleList - Error (invalid JSON)
 func TestRedisCache_GetArticleList_InvalidJSON(t *testing.T) {
 	cache, mr, cleanup := setupRedisCache(t, 5*time.Minute)
@@ -308,12 +420,12 @@ func TestRedisCache_GetArticleList_InvalidJSON(t *testing.T) {
 	offset := 0
 
 	// Set invalid JSON in cache
-	key := fmt.Sprintf("article:list:%d:%d", limit, offset)
+	key := fmt.Sprintf("article:list:%d:0::0::%d:%d", 1, limit, offset)
 	err := mr.Set(key, "invalid json string")
 	require.NoError(t, err)
 
 	// Try to get list - should fail on unmarshal
-	result, err := cache.GetArticleList(ctx, limit, offset)
+	result, err := cache.GetArticleList(ctx, orgFilter, limit, offset)
 	assert.Error(t, err)
 	assert.Nil(t, result)
 	assert.Contains(t, err.Error(), "failed to unmarshal cached list")
@@ -344,11 +456,11 @@ func TestRedisCache_SetArticleList_Success(t *testing.T) {
 	}
 
 	// Set list in cache
-	err := cache.SetArticleList(ctx, limit, offset, list)
+	err := cache.SetArticleList(ctx, orgFilter, limit, offset, list)
 	require.NoError(t, err)
 
 	// Verify it was stored
-	key := fmt.Sprintf("article:list:%d:%d", limit, offset)
+	key := fmt.Sprintf("article:list:%d:0::0::%d:%d", 1, limit, offset)
 	val, err := mr.Get(key)
 	require.NoError(t, err)
 	assert.NotEmpty(t, val)
@@ -382,7 +494,7 @@ func TestRedisCache_SetArticleList_RedisError(t *testing.T) {
 	mr.Close()
 
 	// Try to set list - should fail
-	err := cache.SetArticleList(ctx, limit, offset, list)
+	err := cache.SetArticleList(ctx, orgFilter, limit, offset, list)
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "failed to set cache")
 }
@@ -396,9 +508,9 @@ func TestRedisCache_InvalidateArticleList_Success(t *testing.T) {
 
 	// Set multiple list caches
 	keys := []string{
-		"article:list:10:0",
-		"article:list:10:10",
-		"article:list:20:0",
+		"article:list:1:10:0",
+		"article:list:1:10:10",
+		"article:list:2:20:0",
 	}
 	for _, key := range keys {
 		err := mr.Set(key, "test data")
@@ -0,0 +1,116 @@
+package user
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	domainuser "github.com/rulzi/hexa-go/internal/domain/user"
+)
+
+// RedisAuthorizationStateStore implements AuthorizationStateStore using Redis
+type RedisAuthorizationStateStore struct {
+	client *redis.Client
+}
+
+// NewRedisAuthorizationStateStore creates a new RedisAuthorizationStateStore
+func NewRedisAuthorizationStateStore(client *redis.Client) *RedisAuthorizationStateStore {
+	return &RedisAuthorizationStateStore{client: client}
+}
+
+func (s *RedisAuthorizationStateStore) key(stateHash string) string {
+	return fmt.Sprintf("oidc_state:%s", stateHash)
+}
+
+// Save implements AuthorizationStateStore interface
+func (s *RedisAuthorizationStateStore) Save(ctx context.Context, stateHash string, state domainuser.AuthorizationState, ttl time.Duration) error {
+	data, err := json.Marshal(state)
+	if err != nil {
+		return err
+	}
+
+	if err := s.client.Set(ctx, s.key(stateHash), data, ttl).Err(); err != nil {
+		return fmt.Errorf("failed to save authorization state: %w", err)
+	}
+
+	return nil
+}
+
+// Take implements AuthorizationStateStore interface.
+// GETDEL makes sure concurrent callbacks with the same state can't both succeed.
+func (s *RedisAuthorizationStateStore) Take(ctx context.Context, stateHash string) (*domainuser.AuthorizationState, error) {
+	data, err := s.client.GetDel(ctx, s.key(stateHash)).Bytes()
+	if errors.Is(err, redis.Nil) {
+		return nil, domainuser.ErrInvalidAuthorizationState
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to take authorization state: %w", err)
+	}
+
+	var state domainuser.AuthorizationState
+	if err := json.Unmarshal(data, &state); err != nil {
+		return nil, fmt.Errorf("failed to decode authorization state: %w", err)
+	}
+
+	return &state, nil
+}
+
+type memoryAuthorizationState struct {
+	state     domainuser.AuthorizationState
+	expiresAt time.Time
+}
+
+// MemoryAuthorizationStateStore implements AuthorizationStateStore in process memory.
+// It is used when Redis is not configured and is not shared between replicas.
+type MemoryAuthorizationStateStore struct {
+	mu     sync.Mutex
+	states map[string]memoryAuthorizationState
+}
+
+// NewMemoryAuthorizationStateStore creates a new MemoryAuthorizationStateStore
+func NewMemoryAuthorizationStateStore() *MemoryAuthorizationStateStore {
+	return &MemoryAuthorizationStateStore{
+		states: make(map[string]memoryAuthorizationState),
+	}
+}
+
+// Save implements AuthorizationStateStore interface
+func (s *MemoryAuthorizationStateStore) Save(ctx context.Context, stateHash string, state domainuser.AuthorizationState, ttl time.Duration) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// Drop sign-ins that were never completed
+	now := time.Now()
+	for hash, st := range s.states {
+		if !now.Before(st.expiresAt) {
+			delete(s.states, hash)
+		}
+	}
+
+	s.states[stateHash] = memoryAuthorizationState{state: state, expiresAt: now.Add(ttl)}
+
+	return nil
+}
+
+// Take implements AuthorizationStateStore interface
+func (s *MemoryAuthorizationStateStore) Take(ctx context.Context, stateHash string) (*domainuser.AuthorizationState, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	st, ok := s.states[stateHash]
+	delete(s.states, stateHash)
+	if !ok || !time.Now().Before(st.expiresAt) {
+		return nil, domainuser.ErrInvalidAuthorizationState
+	}
+
+	state := st.state
+	return &state, nil
+}
+
+// Ensure both stores implement domainuser.AuthorizationStateStore
+var _ domainuser.AuthorizationStateStore = (*RedisAuthorizationStateStore)(nil)
+var _ domainuser.AuthorizationStateStore = (*MemoryAuthorizationStateStore)(nil)
@@ -0,0 +1,101 @@
+package user
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+	domainuser "github.com/rulzi/hexa-go/internal/domain/user"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// setupRedisAuthorizationStateStore creates a RedisAuthorizationStateStore with a miniredis server
+func setupRedisAuthorizationStateStore(t *testing.T) (*RedisAuthorizationStateStore, *miniredis.Miniredis, func()) {
+	mr, err := miniredis.Run()
+	require.NoError(t, err)
+
+	client := redis.NewClient(&redis.Options{
+		Addr: mr.Addr(),
+	})
+
+	cleanup := func() {
+		_ = client.Close()
+		mr.Close()
+	}
+
+	return NewRedisAuthorizationStateStore(client), mr, cleanup
+}
+
+func TestRedisAuthorizationStateStore_SaveTake(t *testing.T) {
+	store, mr, cleanup := setupRedisAuthorizationStateStore(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	state := domainuser.AuthorizationState{CodeVerifier: "verifier", Nonce: "nonce"}
+
+	_, err := store.Take(ctx, "hash-1")
+	assert.Equal(t, domainuser.ErrInvalidAuthorizationState, err)
+
+	require.NoError(t, store.Save(ctx, "hash-1", state, 10*time.Minute))
+
+	taken, err := store.Take(ctx, "hash-1")
+	assert.NoError(t, err)
+	assert.Equal(t, &state, taken)
+
+	// A state is accepted only once
+	_, err = store.Take(ctx, "hash-1")
+	assert.Equal(t, domainuser.ErrInvalidAuthorizationState, err)
+
+	// States expire
+	require.NoError(t, store.Save(ctx, "hash-2", state, 10*time.Minute))
+	mr.FastForward(11 * time.Minute)
+	_, err = store.Take(ctx, "hash-2")
+	assert.Equal(t, domainuser.ErrInvalidAuthorizationState, err)
+}
+
+func TestRedisAuthorizationStateStore_ConnectionError(t *testing.T) {
+	store, mr, cleanup := setupRedisAuthorizationStateStore(t)
+	defer cleanup()
+
+	mr.Close()
+	ctx := context.Background()
+
+	assert.Error(t, store.Save(ctx, "hash-1", domainuser.AuthorizationState{}, time.Minute))
+
+	_, err := store.Take(ctx, "hash-1")
+	assert.Error(t, err)
+	assert.NotEqual(t, domainuser.ErrInvalidAuthorizationState, err)
+}
+
+func TestMemoryAuthorizationStateStore_SaveTake(t *testing.T) {
+	store := NewMemoryAuthorizationStateStore()
+	ctx := context.Background()
+	state := domainuser.AuthorizationState{CodeVerifier: "verifier", Nonce: "nonce"}
+
+	require.NoError(t, store.Save(ctx, "hash-1", state, time.Minute))
+
+	taken, err := store.Take(ctx, "hash-1")
+	assert.NoError(t, err)
+	assert.Equal(t, &state, taken)
+
+	_, err = store.Take(ctx, "hash-1")
+	assert.Equal(t, domainuser.ErrInvalidAuthorizationState, err)
+}
+
+func TestMemoryAuthorizationStateStore_Expired(t *testing.T) {
+	store := NewMemoryAuthorizationStateStore()
+	ctx := context.Background()
+
+	store.states["old"] = memoryAuthorizationState{expiresAt: time.Now().Add(-time.Second)}
+
+	_, err := store.Take(ctx, "old")
+	assert.Equal(t, domainuser.ErrInvalidAuthorizationState, err)
+
+	// Expired states are dropped on the next save
+	store.states["stale"] = memoryAuthorizationState{expiresAt: time.Now().Add(-time.Second)}
+	require.NoError(t, store.Save(ctx, "new", domainuser.AuthorizationState{}, time.Minute))
+	assert.NotContains(t, store.states, "stale")
+}
@@ -0,0 +1,170 @@
+package user
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	domainuser "github.com/rulzi/hexa-go/internal/domain/user"
+)
+
+// RedisLoginAttemptCounter implements LoginAttemptCounter using Redis,
+// so that failures and lockouts are shared between replicas.
+type RedisLoginAttemptCounter struct {
+	client *redis.Client
+}
+
+// NewRedisLoginAttemptCounter creates a new RedisLoginAttemptCounter
+func NewRedisLoginAttemptCounter(client *redis.Client) *RedisLoginAttemptCounter {
+	return &RedisLoginAttemptCounter{client: client}
+}
+
+func (c *RedisLoginAttemptCounter) countKey(key string) string {
+	return fmt.Sprintf("login_attempts:%s", key)
+}
+
+func (c *RedisLoginAttemptCounter) lockKey(key string) string {
+	return fmt.Sprintf("login_lock:%s", key)
+}
+
+// Increment implements LoginAttemptCounter interface
+func (c *RedisLoginAttemptCounter) Increment(ctx context.Context, key string, window time.Duration) (int, error) {
+	pipe := c.client.TxPipeline()
+	incr := pipe.Incr(ctx, c.countKey(key))
+	pipe.Expire(ctx, c.countKey(key), window)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return 0, fmt.Errorf("failed to count login attempt: %w", err)
+	}
+
+	return int(incr.Val()), nil
+}
+
+// Lock implements LoginAttemptCounter interface
+func (c *RedisLoginAttemptCounter) Lock(ctx context.Context, key string, duration time.Duration) error {
+	if err := c.client.Set(ctx, c.lockKey(key), "1", duration).Err(); err != nil {
+		return fmt.Errorf("failed to lock login: %w", err)
+	}
+
+	return nil
+}
+
+// LockedFor implements LoginAttemptCounter interface
+func (c *RedisLoginAttemptCounter) LockedFor(ctx context.Context, key string) (time.Duration, error) {
+	ttl, err := c.client.PTTL(ctx, c.lockKey(key)).Result()
+	if err != nil {
+		return 0, fmt.Errorf("failed to check login lock: %w", err)
+	}
+
+	// Negative values mean the key does not exist or has no expiry
+	if ttl < 0 {
+		return 0, nil
+	}
+
+	return ttl, nil
+}
+
+// Reset implements LoginAttemptCounter interface
+func (c *RedisLoginAttemptCounter) Reset(ctx context.Context, key string) error {
+	if err := c.client.Del(ctx, c.countKey(key), c.lockKey(key)).Err(); err != nil {
+		return fmt.Errorf("failed to reset login attempts: %w", err)
+	}
+
+	return nil
+}
+
+type memoryLoginAttempts struct {
+	count       int
+	expiresAt   time.Time
+	lockedUntil time.Time
+}
+
+// MemoryLoginAttemptCounter implements LoginAttemptCounter in process memory.
+// It is used when Redis is not configured and is not shared between replicas.
+type MemoryLoginAttemptCounter struct {
+	mu      sync.Mutex
+	entries map[string]*memoryLoginAttempts
+}
+
+// NewMemoryLoginAttemptCounter creates a new MemoryLoginAttemptCounter
+func NewMemoryLoginAttemptCounter() *MemoryLoginAttemptCounter {
+	return &MemoryLoginAttemptCounter{
+		entries: make(map[string]*memoryLoginAttempts),
+	}
+}
+
+// Increment implements LoginAttemptCounter interface
+func (c *MemoryLoginAttemptCounter) Increment(ctx context.Context, key string, window time.Duration) (int, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	// Drop entries whose failures and lock have both run out
+	now := time.Now()
+	for k, e := range c.entries {
+		if !now.Before(e.expiresAt) && !now.Before(e.lockedUntil) {
+			delete(c.entries, k)
+		}
+	}
+
+	entry, ok := c.entries[key]
+	if !ok {
+		entry = &memoryLoginAttempts{}
+		c.entries[key] = entry
+	}
+	if !now.Before(entry.expiresAt) {
+		entry.count = 0
+	}
+
+	entry.count++
+	entry.expiresAt = now.Add(window)
+
+	return entry.count, nil
+}
+
+// Lock implements LoginAttemptCounter interface
+func (c *MemoryLoginAttemptCounter) Lock(ctx context.Context, key string, duration time.Duration) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entries[key]
+	if !ok {
+		entry = &memoryLoginAttempts{}
+		c.entries[key] = entry
+	}
+	entry.lockedUntil = time.Now().Add(duration)
+
+	return nil
+}
+
+// LockedFor implements LoginAttemptCounter interface
+func (c *MemoryLoginAttemptCounter) LockedFor(ctx context.Context, key string) (time.Duration, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entries[key]
+	if !ok {
+		return 0, nil
+	}
+
+	remaining := time.Until(entry.lockedUntil)
+	if remaining < 0 {
+		return 0, nil
+	}
+
+	return remaining, nil
+}
+
+// Reset implements LoginAttemptCounter interface
+func (c *MemoryLoginAttemptCounter) Reset(ctx context.Context, key string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	delete(c.entries, key)
+
+	return nil
+}
+
+// Ensure both counters implement domainuser.LoginAttemptCounter
+var _ domainuser.LoginAttemptCounter = (*RedisLoginAttemptCounter)(nil)
+var _ domainuser.LoginAttemptCounter = (*MemoryLoginAttemptCounter)(nil)
@@ -0,0 +1,129 @@
+package user
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// setupRedisLoginAttemptCounter creates a RedisLoginAttemptCounter with a miniredis server
+func setupRedisLoginAttemptCounter(t *testing.T) (*RedisLoginAttemptCounter, *miniredis.Miniredis, func()) {
+	mr, err := miniredis.Run()
+	require.NoError(t, err)
+
+	client := redis.NewClient(&redis.Options{
+		Addr: mr.Addr(),
+	})
+
+	cleanup := func() {
+		_ = client.Close()
+		mr.Close()
+	}
+
+	return NewRedisLoginAttemptCounter(client), mr, cleanup
+}
+
+func TestRedisLoginAttemptCounter_Increment(t *testing.T) {
+	counter, mr, cleanup := setupRedisLoginAttemptCounter(t)
+	defer cleanup()
+
+	ctx := context.Background()
+
+	for want := 1; want <= 3; want++ {
+		count, err := counter.Increment(ctx, "account:test@example.com", time.Hour)
+		assert.NoError(t, err)
+		assert.Equal(t, want, count)
+	}
+
+	// Failures are forgotten after the window
+	mr.FastForward(61 * time.Minute)
+	count, err := counter.Increment(ctx, "account:test@example.com", time.Hour)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, count)
+}
+
+func TestRedisLoginAttemptCounter_LockAndReset(t *testing.T) {
+	counter, mr, cleanup := setupRedisLoginAttemptCounter(t)
+	defer cleanup()
+
+	ctx := context.Background()
+
+	lockedFor, err := counter.LockedFor(ctx, "ip:10.0.0.1")
+	assert.NoError(t, err)
+	assert.Zero(t, lockedFor)
+
+	assert.NoError(t, counter.Lock(ctx, "ip:10.0.0.1", 2*time.Minute))
+
+	lockedFor, err = counter.LockedFor(ctx, "ip:10.0.0.1")
+	assert.NoError(t, err)
+	assert.True(t, lockedFor > time.Minute && lockedFor <= 2*time.Minute)
+
+	_, err = counter.Increment(ctx, "ip:10.0.0.1", time.Hour)
+	assert.NoError(t, err)
+
+	assert.NoError(t, counter.Reset(ctx, "ip:10.0.0.1"))
+	assert.False(t, mr.Exists("login_attempts:ip:10.0.0.1"))
+	assert.False(t, mr.Exists("login_lock:ip:10.0.0.1"))
+}
+
+func TestRedisLoginAttemptCounter_ConnectionError(t *testing.T) {
+	counter, mr, cleanup := setupRedisLoginAttemptCounter(t)
+	defer cleanup()
+
+	mr.Close()
+	ctx := context.Background()
+
+	_, err := counter.Increment(ctx, "key", time.Hour)
+	assert.Error(t, err)
+
+	assert.Error(t, counter.Lock(ctx, "key", time.Minute))
+
+	_, err = counter.LockedFor(ctx, "key")
+	assert.Error(t, err)
+
+	assert.Error(t, counter.Reset(ctx, "key"))
+}
+
+func TestMemoryLoginAttemptCounter_IncrementLockAndReset(t *testing.T) {
+	counter := NewMemoryLoginAttemptCounter()
+	ctx := context.Background()
+
+	count, err := counter.Increment(ctx, "key", time.Hour)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, count)
+
+	count, _ = counter.Increment(ctx, "key", time.Hour)
+	assert.Equal(t, 2, count)
+
+	assert.NoError(t, counter.Lock(ctx, "key", time.Minute))
+	lockedFor, err := counter.LockedFor(ctx, "key")
+	assert.NoError(t, err)
+	assert.True(t, lockedFor > 0 && lockedFor <= time.Minute)
+
+	assert.NoError(t, counter.Reset(ctx, "key"))
+	lockedFor, _ = counter.LockedFor(ctx, "key")
+	assert.Zero(t, lockedFor)
+
+	count, _ = counter.Increment(ctx, "key", time.Hour)
+	assert.Equal(t, 1, count)
+}
+
+func TestMemoryLoginAttemptCounter_ExpiredEntriesAreDropped(t *testing.T) {
+	counter := NewMemoryLoginAttemptCounter()
+	ctx := context.Background()
+
+	counter.entries["old"] = &memoryLoginAttempts{count: 3, expiresAt: time.Now().Add(-time.Minute)}
+	counter.entries["locked"] = &memoryLoginAttempts{count: 5, expiresAt: time.Now().Add(-time.Minute), lockedUntil: time.Now().Add(time.Minute)}
+
+	count, err := counter.Increment(ctx, "key", time.Hour)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, count)
+
+	assert.NotContains(t, counter.entries, "old")
+	assert.Contains(t, counter.entries, "locked")
+}
@@ -0,0 +1,104 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	domainuser "github.com/rulzi/hexa-go/internal/domain/user"
+)
+
+// RedisLoginCodeStore implements LoginCodeStore using Redis
+type RedisLoginCodeStore struct {
+	client *redis.Client
+}
+
+// NewRedisLoginCodeStore creates a new RedisLoginCodeStore
+func NewRedisLoginCodeStore(client *redis.Client) *RedisLoginCodeStore {
+	return &RedisLoginCodeStore{client: client}
+}
+
+func (s *RedisLoginCodeStore) key(codeHash string) string {
+	return fmt.Sprintf("oidc_login_code:%s", codeHash)
+}
+
+// Save implements LoginCodeStore interface
+func (s *RedisLoginCodeStore) Save(ctx context.Context, codeHash string, userID int64, ttl time.Duration) error {
+	if err := s.client.Set(ctx, s.key(codeHash), userID, ttl).Err(); err != nil {
+		return fmt.Errorf("failed to save login code: %w", err)
+	}
+
+	return nil
+}
+
+// Take implements LoginCodeStore interface.
+// GETDEL makes sure concurrent redemptions of the same code can't both succeed.
+func (s *RedisLoginCodeStore) Take(ctx context.Context, codeHash string) (int64, error) {
+	userID, err := s.client.GetDel(ctx, s.key(codeHash)).Int64()
+	if errors.Is(err, redis.Nil) {
+		return 0, domainuser.ErrInvalidLoginCode
+	}
+	if err != nil {
+		return 0, fmt.Errorf("failed to take login code: %w", err)
+	}
+
+	return userID, nil
+}
+
+type memoryLoginCode struct {
+	userID    int64
+	expiresAt time.Time
+}
+
+// MemoryLoginCodeStore implements LoginCodeStore in process memory.
+// It is used when Redis is not configured and is not shared between replicas.
+type MemoryLoginCodeStore struct {
+	mu    sync.Mutex
+	codes map[string]memoryLoginCode
+}
+
+// NewMemoryLoginCodeStore creates a new MemoryLoginCodeStore
+func NewMemoryLoginCodeStore() *MemoryLoginCodeStore {
+	return &MemoryLoginCodeStore{
+		codes: make(map[string]memoryLoginCode),
+	}
+}
+
+// Save implements LoginCodeStore interface
+func (s *MemoryLoginCodeStore) Save(ctx context.Context, codeHash string, userID int64, ttl time.Duration) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// Drop codes that were never redeemed
+	now := time.Now()
+	for hash, c := range s.codes {
+		if !now.Before(c.expiresAt) {
+			delete(s.codes, hash)
+		}
+	}
+
+	s.codes[codeHash] = memoryLoginCode{userID: userID, expiresAt: now.Add(ttl)}
+
+	return nil
+}
+
+// Take implements LoginCodeStore interface
+func (s *MemoryLoginCodeStore) Take(ctx context.Context, codeHash string) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	c, ok := s.codes[codeHash]
+	delete(s.codes, codeHash)
+	if !ok || !time.Now().Before(c.expiresAt) {
+		return 0, domainuser.ErrInvalidLoginCode
+	}
+
+	return c.userID, nil
+}
+
+// Ensure both stores implement domainuser.LoginCodeStore
+var _ domainuser.LoginCodeStore = (*RedisLoginCodeStore)(nil)
+var _ domainuser.LoginCodeStore = (*MemoryLoginCodeStore)(nil)
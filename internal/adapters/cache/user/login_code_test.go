@@ -0,0 +1,99 @@
+package user
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+	domainuser "github.com/rulzi/hexa-go/internal/domain/user"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// setupRedisLoginCodeStore creates a RedisLoginCodeStore with a miniredis server
+func setupRedisLoginCodeStore(t *testing.T) (*RedisLoginCodeStore, *miniredis.Miniredis, func()) {
+	mr, err := miniredis.Run()
+	require.NoError(t, err)
+
+	client := redis.NewClient(&redis.Options{
+		Addr: mr.Addr(),
+	})
+
+	cleanup := func() {
+		_ = client.Close()
+		mr.Close()
+	}
+
+	return NewRedisLoginCodeStore(client), mr, cleanup
+}
+
+func TestRedisLoginCodeStore_SaveTake(t *testing.T) {
+	store, mr, cleanup := setupRedisLoginCodeStore(t)
+	defer cleanup()
+
+	ctx := context.Background()
+
+	_, err := store.Take(ctx, "hash-1")
+	assert.Equal(t, domainuser.ErrInvalidLoginCode, err)
+
+	require.NoError(t, store.Save(ctx, "hash-1", 42, time.Minute))
+
+	userID, err := store.Take(ctx, "hash-1")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(42), userID)
+
+	// A code is redeemed once
+	_, err = store.Take(ctx, "hash-1")
+	assert.Equal(t, domainuser.ErrInvalidLoginCode, err)
+
+	// Codes expire
+	require.NoError(t, store.Save(ctx, "hash-2", 42, time.Minute))
+	mr.FastForward(2 * time.Minute)
+	_, err = store.Take(ctx, "hash-2")
+	assert.Equal(t, domainuser.ErrInvalidLoginCode, err)
+}
+
+func TestRedisLoginCodeStore_ConnectionError(t *testing.T) {
+	store, mr, cleanup := setupRedisLoginCodeStore(t)
+	defer cleanup()
+
+	mr.Close()
+	ctx := context.Background()
+
+	assert.Error(t, store.Save(ctx, "hash-1", 42, time.Minute))
+
+	_, err := store.Take(ctx, "hash-1")
+	assert.Error(t, err)
+	assert.NotEqual(t, domainuser.ErrInvalidLoginCode, err)
+}
+
+func TestMemoryLoginCodeStore_SaveTake(t *testing.T) {
+	store := NewMemoryLoginCodeStore()
+	ctx := context.Background()
+
+	require.NoError(t, store.Save(ctx, "hash-1", 42, time.Minute))
+
+	userID, err := store.Take(ctx, "hash-1")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(42), userID)
+
+	_, err = store.Take(ctx, "hash-1")
+	assert.Equal(t, domainuser.ErrInvalidLoginCode, err)
+}
+
+func TestMemoryLoginCodeStore_Expired(t *testing.T) {
+	store := NewMemoryLoginCodeStore()
+	ctx := context.Background()
+
+	store.codes["old"] = memoryLoginCode{userID: 1, expiresAt: time.Now().Add(-time.Second)}
+
+	_, err := store.Take(ctx, "old")
+	assert.Equal(t, domainuser.ErrInvalidLoginCode, err)
+	store.codes["old"] = memoryLoginCode{userID: 1, expiresAt: time.Now().Add(-time.Second)}
+
+	// Expired codes are dropped on the next save
+	require.NoError(t, store.Save(ctx, "new", 2, time.Minute))
+	assert.NotContains(t, store.codes, "old")
+}
@@ -0,0 +1,96 @@
+package user
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	domainuser "github.com/rulzi/hexa-go/internal/domain/user"
+)
+
+// RedisTokenRevocationStore implements TokenRevocationStore using Redis.
+// Entries expire together with the token they revoke.
+type RedisTokenRevocationStore struct {
+	client *redis.Client
+}
+
+// NewRedisTokenRevocationStore creates a new RedisTokenRevocationStore
+func NewRedisTokenRevocationStore(client *redis.Client) *RedisTokenRevocationStore {
+	return &RedisTokenRevocationStore{client: client}
+}
+
+// Revoke implements TokenRevocationStore interface
+func (s *RedisTokenRevocationStore) Revoke(ctx context.Context, tokenID string, expiresAt time.Time) error {
+	ttl := time.Until(expiresAt)
+	if ttl <= 0 {
+		return nil // Token already expired
+	}
+
+	key := fmt.Sprintf("revoked_token:%s", tokenID)
+	if err := s.client.Set(ctx, key, "1", ttl).Err(); err != nil {
+		return fmt.Errorf("failed to revoke token: %w", err)
+	}
+
+	return nil
+}
+
+// IsRevoked implements TokenRevocationStore interface
+func (s *RedisTokenRevocationStore) IsRevoked(ctx context.Context, tokenID string) (bool, error) {
+	key := fmt.Sprintf("revoked_token:%s", tokenID)
+
+	n, err := s.client.Exists(ctx, key).Result()
+	if err != nil {
+		return false, fmt.Errorf("failed to check token revocation: %w", err)
+	}
+
+	return n > 0, nil
+}
+
+// MemoryTokenRevocationStore implements TokenRevocationStore in process memory.
+// It is used when Redis is not configured and is not shared between replicas.
+type MemoryTokenRevocationStore struct {
+	mu      sync.Mutex
+	revoked map[string]time.Time
+}
+
+// NewMemoryTokenRevocationStore creates a new MemoryTokenRevocationStore
+func NewMemoryTokenRevocationStore() *MemoryTokenRevocationStore {
+	return &MemoryTokenRevocationStore{
+		revoked: make(map[string]time.Time),
+	}
+}
+
+// Revoke implements TokenRevocationStore interface
+func (s *MemoryTokenRevocationStore) Revoke(ctx context.Context, tokenID string, expiresAt time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// Drop entries of tokens that have expired on their own
+	now := time.Now()
+	for id, exp := range s.revoked {
+		if !now.Before(exp) {
+			delete(s.revoked, id)
+		}
+	}
+
+	if now.Before(expiresAt) {
+		s.revoked[tokenID] = expiresAt
+	}
+
+	return nil
+}
+
+// IsRevoked implements TokenRevocationStore interface
+func (s *MemoryTokenRevocationStore) IsRevoked(ctx context.Context, tokenID string) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	exp, ok := s.revoked[tokenID]
+	return ok && time.Now().Before(exp), nil
+}
+
+// Ensure both stores implement domainuser.TokenRevocationStore
+var _ domainuser.TokenRevocationStore = (*RedisTokenRevocationStore)(nil)
+var _ domainuser.TokenRevocationStore = (*MemoryTokenRevocationStore)(nil)
@@ -0,0 +1,108 @@
+package user
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// setupRedisTokenRevocationStore creates a RedisTokenRevocationStore with a miniredis server
+func setupRedisTokenRevocationStore(t *testing.T) (*RedisTokenRevocationStore, *miniredis.Miniredis, func()) {
+	mr, err := miniredis.Run()
+	require.NoError(t, err)
+
+	client := redis.NewClient(&redis.Options{
+		Addr: mr.Addr(),
+	})
+
+	cleanup := func() {
+		_ = client.Close()
+		mr.Close()
+	}
+
+	return NewRedisTokenRevocationStore(client), mr, cleanup
+}
+
+func TestRedisTokenRevocationStore_RevokeAndCheck(t *testing.T) {
+	store, mr, cleanup := setupRedisTokenRevocationStore(t)
+	defer cleanup()
+
+	ctx := context.Background()
+
+	revoked, err := store.IsRevoked(ctx, "jti-1")
+	assert.NoError(t, err)
+	assert.False(t, revoked)
+
+	err = store.Revoke(ctx, "jti-1", time.Now().Add(15*time.Minute))
+	assert.NoError(t, err)
+
+	revoked, err = store.IsRevoked(ctx, "jti-1")
+	assert.NoError(t, err)
+	assert.True(t, revoked)
+
+	// Entry expires with the token
+	ttl := mr.TTL("revoked_token:jti-1")
+	assert.True(t, ttl > 14*time.Minute && ttl <= 15*time.Minute)
+
+	mr.FastForward(16 * time.Minute)
+	revoked, err = store.IsRevoked(ctx, "jti-1")
+	assert.NoError(t, err)
+	assert.False(t, revoked)
+}
+
+func TestRedisTokenRevocationStore_Revoke_AlreadyExpired(t *testing.T) {
+	store, mr, cleanup := setupRedisTokenRevocationStore(t)
+	defer cleanup()
+
+	err := store.Revoke(context.Background(), "jti-1", time.Now().Add(-time.Minute))
+
+	assert.NoError(t, err)
+	assert.False(t, mr.Exists("revoked_token:jti-1"))
+}
+
+func TestRedisTokenRevocationStore_ConnectionError(t *testing.T) {
+	store, mr, cleanup := setupRedisTokenRevocationStore(t)
+	defer cleanup()
+
+	mr.Close()
+
+	err := store.Revoke(context.Background(), "jti-1", time.Now().Add(time.Minute))
+	assert.Error(t, err)
+
+	_, err = store.IsRevoked(context.Background(), "jti-1")
+	assert.Error(t, err)
+}
+
+func TestMemoryTokenRevocationStore_RevokeAndCheck(t *testing.T) {
+	store := NewMemoryTokenRevocationStore()
+	ctx := context.Background()
+
+	revoked, err := store.IsRevoked(ctx, "jti-1")
+	assert.NoError(t, err)
+	assert.False(t, revoked)
+
+	assert.NoError(t, store.Revoke(ctx, "jti-1", time.Now().Add(time.Minute)))
+
+	revoked, err = store.IsRevoked(ctx, "jti-1")
+	assert.NoError(t, err)
+	assert.True(t, revoked)
+}
+
+func TestMemoryTokenRevocationStore_ExpiredEntriesAreDropped(t *testing.T) {
+	store := NewMemoryTokenRevocationStore()
+	ctx := context.Background()
+
+	store.revoked["old"] = time.Now().Add(-time.Minute)
+
+	assert.NoError(t, store.Revoke(ctx, "jti-1", time.Now().Add(-time.Second)))
+	assert.NoError(t, store.Revoke(ctx, "jti-2", time.Now().Add(time.Minute)))
+
+	assert.NotContains(t, store.revoked, "old")
+	assert.NotContains(t, store.revoked, "jti-1")
+	assert.Contains(t, store.revoked, "jti-2")
+}
@@ -0,0 +1,104 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	domainuser "github.com/rulzi/hexa-go/internal/domain/user"
+)
+
+// RedisTwoFactorChallengeStore implements TwoFactorChallengeStore using Redis
+type RedisTwoFactorChallengeStore struct {
+	client *redis.Client
+}
+
+// NewRedisTwoFactorChallengeStore creates a new RedisTwoFactorChallengeStore
+func NewRedisTwoFactorChallengeStore(client *redis.Client) *RedisTwoFactorChallengeStore {
+	return &RedisTwoFactorChallengeStore{client: client}
+}
+
+func (s *RedisTwoFactorChallengeStore) key(tokenHash string) string {
+	return fmt.Sprintf("2fa_challenge:%s", tokenHash)
+}
+
+// Save implements TwoFactorChallengeStore interface
+func (s *RedisTwoFactorChallengeStore) Save(ctx context.Context, tokenHash string, userID int64, ttl time.Duration) error {
+	if err := s.client.Set(ctx, s.key(tokenHash), userID, ttl).Err(); err != nil {
+		return fmt.Errorf("failed to save two-factor challenge: %w", err)
+	}
+
+	return nil
+}
+
+// Take implements TwoFactorChallengeStore interface.
+// GETDEL makes sure concurrent logins with the same challenge can't both succeed.
+func (s *RedisTwoFactorChallengeStore) Take(ctx context.Context, tokenHash string) (int64, error) {
+	userID, err := s.client.GetDel(ctx, s.key(tokenHash)).Int64()
+	if errors.Is(err, redis.Nil) {
+		return 0, domainuser.ErrInvalidTwoFactorChallenge
+	}
+	if err != nil {
+		return 0, fmt.Errorf("failed to take two-factor challenge: %w", err)
+	}
+
+	return userID, nil
+}
+
+type memoryTwoFactorChallenge struct {
+	userID    int64
+	expiresAt time.Time
+}
+
+// MemoryTwoFactorChallengeStore implements TwoFactorChallengeStore in process memory.
+// It is used when Redis is not configured and is not shared between replicas.
+type MemoryTwoFactorChallengeStore struct {
+	mu         sync.Mutex
+	challenges map[string]memoryTwoFactorChallenge
+}
+
+// NewMemoryTwoFactorChallengeStore creates a new MemoryTwoFactorChallengeStore
+func NewMemoryTwoFactorChallengeStore() *MemoryTwoFactorChallengeStore {
+	return &MemoryTwoFactorChallengeStore{
+		challenges: make(map[string]memoryTwoFactorChallenge),
+	}
+}
+
+// Save implements TwoFactorChallengeStore interface
+func (s *MemoryTwoFactorChallengeStore) Save(ctx context.Context, tokenHash string, userID int64, ttl time.Duration) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// Drop expired challenges
+	now := time.Now()
+	for hash, c := range s.challenges {
+		if !now.Before(c.expiresAt) {
+			delete(s.challenges, hash)
+		}
+	}
+
+	s.challenges[tokenHash] = memoryTwoFactorChallenge{userID: userID, expiresAt: now.Add(ttl)}
+
+	return nil
+}
+
+// Take implements TwoFactorChallengeStore interface
+func (s *MemoryTwoFactorChallengeStore) Take(ctx context.Context, tokenHash string) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	c, ok := s.challenges[tokenHash]
+	delete(s.challenges, tokenHash)
+	if !ok || !time.Now().Before(c.expiresAt) {
+		return 0, domainuser.ErrInvalidTwoFactorChallenge
+	}
+
+	return c.userID, nil
+}
+
+// Ensure both stores implement domainuser.TwoFactorChallengeStore
+var _ domainuser.TwoFactorChallengeStore = (*RedisTwoFactorChallengeStore)(nil)
+var _ domainuser.TwoFactorChallengeStore = (*MemoryTwoFactorChallengeStore)(nil)
@@ -0,0 +1,99 @@
+package user
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+	domainuser "github.com/rulzi/hexa-go/internal/domain/user"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// setupRedisTwoFactorChallengeStore creates a RedisTwoFactorChallengeStore with a miniredis server
+func setupRedisTwoFactorChallengeStore(t *testing.T) (*RedisTwoFactorChallengeStore, *miniredis.Miniredis, func()) {
+	mr, err := miniredis.Run()
+	require.NoError(t, err)
+
+	client := redis.NewClient(&redis.Options{
+		Addr: mr.Addr(),
+	})
+
+	cleanup := func() {
+		_ = client.Close()
+		mr.Close()
+	}
+
+	return NewRedisTwoFactorChallengeStore(client), mr, cleanup
+}
+
+func TestRedisTwoFactorChallengeStore_SaveTake(t *testing.T) {
+	store, mr, cleanup := setupRedisTwoFactorChallengeStore(t)
+	defer cleanup()
+
+	ctx := context.Background()
+
+	_, err := store.Take(ctx, "hash-1")
+	assert.Equal(t, domainuser.ErrInvalidTwoFactorChallenge, err)
+
+	require.NoError(t, store.Save(ctx, "hash-1", 42, 5*time.Minute))
+
+	userID, err := store.Take(ctx, "hash-1")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(42), userID)
+
+	// A challenge is taken once
+	_, err = store.Take(ctx, "hash-1")
+	assert.Equal(t, domainuser.ErrInvalidTwoFactorChallenge, err)
+
+	// Challenges expire
+	require.NoError(t, store.Save(ctx, "hash-2", 42, 5*time.Minute))
+	mr.FastForward(6 * time.Minute)
+	_, err = store.Take(ctx, "hash-2")
+	assert.Equal(t, domainuser.ErrInvalidTwoFactorChallenge, err)
+}
+
+func TestRedisTwoFactorChallengeStore_ConnectionError(t *testing.T) {
+	store, mr, cleanup := setupRedisTwoFactorChallengeStore(t)
+	defer cleanup()
+
+	mr.Close()
+	ctx := context.Background()
+
+	assert.Error(t, store.Save(ctx, "hash-1", 42, time.Minute))
+
+	_, err := store.Take(ctx, "hash-1")
+	assert.Error(t, err)
+	assert.NotEqual(t, domainuser.ErrInvalidTwoFactorChallenge, err)
+}
+
+func TestMemoryTwoFactorChallengeStore_SaveTake(t *testing.T) {
+	store := NewMemoryTwoFactorChallengeStore()
+	ctx := context.Background()
+
+	require.NoError(t, store.Save(ctx, "hash-1", 42, time.Minute))
+
+	userID, err := store.Take(ctx, "hash-1")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(42), userID)
+
+	_, err = store.Take(ctx, "hash-1")
+	assert.Equal(t, domainuser.ErrInvalidTwoFactorChallenge, err)
+}
+
+func TestMemoryTwoFactorChallengeStore_Expired(t *testing.T) {
+	store := NewMemoryTwoFactorChallengeStore()
+	ctx := context.Background()
+
+	store.challenges["old"] = memoryTwoFactorChallenge{userID: 1, expiresAt: time.Now().Add(-time.Second)}
+
+	_, err := store.Take(ctx, "old")
+	assert.Equal(t, domainuser.ErrInvalidTwoFactorChallenge, err)
+	store.challenges["old"] = memoryTwoFactorChallenge{userID: 1, expiresAt: time.Now().Add(-time.Second)}
+
+	// Expired challenges are dropped on the next save
+	require.NoError(t, store.Save(ctx, "new", 2, time.Minute))
+	assert.NotContains(t, store.challenges, "old")
+}
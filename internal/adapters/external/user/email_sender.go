@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"net/url"
+	"time"
 
 	domainuser "github.com/rulzi/hexa-go/internal/domain/user"
 )
@@ -11,11 +13,12 @@ import (
 // EmailSenderImpl implements NotificationService (external service adapter)
 type EmailSenderImpl struct {
 	// In a real implementation, this would have SMTP config, API keys, etc.
+	baseURL string // public URL used to build links in emails
 }
 
 // NewEmailSenderImpl creates a new EmailSenderImpl
-func NewEmailSenderImpl() *EmailSenderImpl {
-	return &EmailSenderImpl{}
+func NewEmailSenderImpl(baseURL string) *EmailSenderImpl {
+	return &EmailSenderImpl{baseURL: baseURL}
 }
 
 // SendWelcomeEmail implements NotificationService interface
@@ -33,6 +36,37 @@ func (e *EmailSenderImpl) SendWelcomeEmail(ctx context.Context, email, name stri
 	return nil
 }
 
+// SendPasswordResetEmail implements NotificationService interface
+func (e *EmailSenderImpl) SendPasswordResetEmail(ctx context.Context, email, name, token string) error {
+	log.Printf("Sending password reset email to %s (%s)", name, email)
+
+	// Simulate email sending, the link carries the token to the client
+	link := fmt.Sprintf("%s/reset-password?token=%s", e.baseURL, url.QueryEscape(token))
+	fmt.Printf("[EMAIL] Hi %s, reset your password using this link: %s\n", name, link)
+	return nil
+}
+
+// SendVerificationEmail implements NotificationService interface
+func (e *EmailSenderImpl) SendVerificationEmail(ctx context.Context, email, name, token string) error {
+	log.Printf("Sending verification email to %s (%s)", name, email)
+
+	// Simulate email sending, the link points at the verify endpoint
+	link := fmt.Sprintf("%s/api/v1/users/verify?token=%s", e.baseURL, url.QueryEscape(token))
+	fmt.Printf("[EMAIL] Hi %s, please verify your email address using this link: %s\n", name, link)
+	return nil
+}
+
+// SendInvitationEmail implements NotificationService interface
+func (e *EmailSenderImpl) SendInvitationEmail(ctx context.Context, email, token string, expiresAt time.Time) error {
+	log.Printf("Sending invitation email to %s", email)
+
+	// Simulate email sending, the link carries the token to the client
+	link := fmt.Sprintf("%s/accept-invitation?token=%s", e.baseURL, url.QueryEscape(token))
+	fmt.Printf("[EMAIL] You have been invited to join an organization, accept the invitation before %s using this link: %s\n",
+		expiresAt.Format(time.RFC1123), link)
+	return nil
+}
+
 // Ensure EmailSenderImpl implements domainuser.NotificationService
 var _ domainuser.NotificationService = (*EmailSenderImpl)(nil)
 
@@ -0,0 +1,126 @@
+package article
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rulzi/hexa-go/internal/adapters/http/response"
+	"github.com/rulzi/hexa-go/internal/application/article/dto"
+	domainarticle "github.com/rulzi/hexa-go/internal/domain/article"
+)
+
+// CreateCategoryUseCase is the interface for the create category use case
+type CreateCategoryUseCase interface {
+	Execute(ctx context.Context, actor domainarticle.Actor, req dto.CategoryRequest) (*dto.CategoryResponse, error)
+}
+
+// UpdateCategoryUseCase is the interface for the update category use case
+type UpdateCategoryUseCase interface {
+	Execute(ctx context.Context, actor domainarticle.Actor, id int64, req dto.CategoryRequest) (*dto.CategoryResponse, error)
+}
+
+// DeleteCategoryUseCase is the interface for the delete category use case
+type DeleteCategoryUseCase interface {
+	Execute(ctx context.Context, actor domainarticle.Actor, id int64) error
+}
+
+// ListCategoriesUseCase is the interface for the list categories use case
+type ListCategoriesUseCase interface {
+	Execute(ctx context.Context, actor domainarticle.Actor) (*dto.ListCategoriesResponse, error)
+}
+
+// CategoryHandler handles HTTP requests for categories
+type CategoryHandler struct {
+	createUseCase CreateCategoryUseCase
+	updateUseCase UpdateCategoryUseCase
+	deleteUseCase DeleteCategoryUseCase
+	listUseCase   ListCategoriesUseCase
+}
+
+// NewCategoryHandler creates a new CategoryHandler
+func NewCategoryHandler(
+	createUseCase CreateCategoryUseCase,
+	updateUseCase UpdateCategoryUseCase,
+	deleteUseCase DeleteCategoryUseCase,
+	listUseCase ListCategoriesUseCase,
+) *CategoryHandler {
+	return &CategoryHandler{
+		createUseCase: createUseCase,
+		updateUseCase: updateUseCase,
+		deleteUseCase: deleteUseCase,
+		listUseCase:   listUseCase,
+	}
+}
+
+// List handles GET /categories, subcategories are nested under their parent
+func (h *CategoryHandler) List(c *gin.Context) {
+	resp, err := h.listUseCase.Execute(c.Request.Context(), actorFromContext(c))
+	if err != nil {
+		response.ErrorResponseInternalServerError(c, err.Error())
+		return
+	}
+
+	response.SuccessResponseOK(c, "Categories retrieved successfully", resp)
+}
+
+// Create handles POST /categories
+func (h *CategoryHandler) Create(c *gin.Context) {
+	var req dto.CategoryRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.ErrorResponseBadRequest(c, err.Error())
+		return
+	}
+
+	resp, err := h.createUseCase.Execute(c.Request.Context(), actorFromContext(c), req)
+	if err != nil {
+		if err == domainarticle.ErrCategoryNotFound {
+			// The requested parent, not the resource of the request
+			response.ErrorResponseBadRequest(c, err.Error())
+		} else {
+			respondTaxonomyError(c, err)
+		}
+		return
+	}
+
+	response.SuccessResponseCreated(c, "Category created successfully", resp)
+}
+
+// Update handles PUT /categories/:id, it renames the category and moves it under parent_id
+func (h *CategoryHandler) Update(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		response.ErrorResponseBadRequest(c, "invalid category id")
+		return
+	}
+
+	var req dto.CategoryRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.ErrorResponseBadRequest(c, err.Error())
+		return
+	}
+
+	resp, err := h.updateUseCase.Execute(c.Request.Context(), actorFromContext(c), id, req)
+	if err != nil {
+		respondTaxonomyError(c, err)
+		return
+	}
+
+	response.SuccessResponseOK(c, "Category updated successfully", resp)
+}
+
+// Delete handles DELETE /categories/:id
+func (h *CategoryHandler) Delete(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		response.ErrorResponseBadRequest(c, "invalid category id")
+		return
+	}
+
+	if err := h.deleteUseCase.Execute(c.Request.Context(), actorFromContext(c), id); err != nil {
+		respondTaxonomyError(c, err)
+		return
+	}
+
+	response.SuccessResponseOK(c, "Category deleted successfully", nil)
+}
@@ -0,0 +1,125 @@
+package article
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/rulzi/hexa-go/internal/application/article/dto"
+	domainarticle "github.com/rulzi/hexa-go/internal/domain/article"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// mockCreateCategoryUseCase is a mock implementation of CreateCategoryUseCase
+type mockCreateCategoryUseCase struct {
+	mock.Mock
+}
+
+func (m *mockCreateCategoryUseCase) Execute(ctx context.Context, actor domainarticle.Actor, req dto.CategoryRequest) (*dto.CategoryResponse, error) {
+	args := m.Called(ctx, actor, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.CategoryResponse), args.Error(1)
+}
+
+// mockUpdateCategoryUseCase is a mock implementation of UpdateCategoryUseCase
+type mockUpdateCategoryUseCase struct {
+	mock.Mock
+}
+
+func (m *mockUpdateCategoryUseCase) Execute(ctx context.Context, actor domainarticle.Actor, id int64, req dto.CategoryRequest) (*dto.CategoryResponse, error) {
+	args := m.Called(ctx, actor, id, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.CategoryResponse), args.Error(1)
+}
+
+// mockDeleteCategoryUseCase is a mock implementation of DeleteCategoryUseCase
+type mockDeleteCategoryUseCase struct {
+	mock.Mock
+}
+
+func (m *mockDeleteCategoryUseCase) Execute(ctx context.Context, actor domainarticle.Actor, id int64) error {
+	args := m.Called(ctx, actor, id)
+	return args.Error(0)
+}
+
+func TestCategoryHandler_Create_UnknownParent(t *testing.T) {
+	parentID := int64(9)
+	createUC := &mockCreateCategoryUseCase{}
+	createUC.On("Execute", mock.Anything, testActor, dto.CategoryRequest{Name: "Tennis", ParentID: &parentID}).Return(nil, domainarticle.ErrCategoryNotFound)
+	handler := NewCategoryHandler(createUC, nil, nil, nil)
+
+	router := setupTestRouter(nil)
+	router.POST("/categories", handler.Create)
+
+	req := httptest.NewRequest(http.MethodPost, "/categories", bytes.NewBufferString(`{"name":"Tennis","parent_id":9}`))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	createUC.AssertExpectations(t)
+}
+
+func TestCategoryHandler_Update(t *testing.T) {
+	tests := []struct {
+		name       string
+		err        error
+		wantStatus int
+	}{
+		{name: "updated", wantStatus: http.StatusOK},
+		{name: "moved under a subcategory", err: domainarticle.ErrCategoryCycle, wantStatus: http.StatusBadRequest},
+		{name: "slug taken", err: domainarticle.ErrCategoryExists, wantStatus: http.StatusConflict},
+		{name: "unknown category", err: domainarticle.ErrCategoryNotFound, wantStatus: http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			parentID := int64(3)
+			updateUC := &mockUpdateCategoryUseCase{}
+			call := updateUC.On("Execute", mock.Anything, testActor, int64(1), dto.CategoryRequest{Name: "Sport", ParentID: &parentID})
+			if tt.err != nil {
+				call.Return(nil, tt.err)
+			} else {
+				call.Return(&dto.CategoryResponse{ID: 1, ParentID: &parentID, Name: "Sport", Slug: "sport"}, nil)
+			}
+			handler := NewCategoryHandler(nil, updateUC, nil, nil)
+
+			router := setupTestRouter(nil)
+			router.PUT("/categories/:id", handler.Update)
+
+			req := httptest.NewRequest(http.MethodPut, "/categories/1", bytes.NewBufferString(`{"name":"Sport","parent_id":3}`))
+			req.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.wantStatus, w.Code)
+			updateUC.AssertExpectations(t)
+		})
+	}
+}
+
+func TestCategoryHandler_Delete_HasChildren(t *testing.T) {
+	deleteUC := &mockDeleteCategoryUseCase{}
+	deleteUC.On("Execute", mock.Anything, testActor, int64(1)).Return(domainarticle.ErrCategoryHasChildren)
+	handler := NewCategoryHandler(nil, nil, deleteUC, nil)
+
+	router := setupTestRouter(nil)
+	router.DELETE("/categories/:id", handler.Delete)
+
+	req := httptest.NewRequest(http.MethodDelete, "/categories/1", nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusConflict, w.Code)
+	deleteUC.AssertExpectations(t)
+}
@@ -8,31 +8,32 @@ import (
 	"github.com/rulzi/hexa-go/internal/adapters/http/response"
 	"github.com/rulzi/hexa-go/internal/application/article/dto"
 	domainarticle "github.com/rulzi/hexa-go/internal/domain/article"
+	domainuser "github.com/rulzi/hexa-go/internal/domain/user"
 )
 
 // CreateArticleUseCase is the interface for the create article use case
 type CreateArticleUseCase interface {
-	Execute(ctx context.Context, req dto.CreateArticleRequest) (*dto.ArticleResponse, error)
+	Execute(ctx context.Context, actor domainarticle.Actor, req dto.CreateArticleRequest) (*dto.ArticleResponse, error)
 }
 
 // GetArticleUseCase is the interface for the get article use case
 type GetArticleUseCase interface {
-	Execute(ctx context.Context, id int64) (*dto.ArticleResponse, error)
+	Execute(ctx context.Context, actor domainarticle.Actor, id int64) (*dto.ArticleResponse, error)
 }
 
 // ListArticlesUseCase is the interface for the list articles use case
 type ListArticlesUseCase interface {
-	Execute(ctx context.Context, limit, offset int) (*dto.ListArticlesResponse, error)
+	Execute(ctx context.Context, actor domainarticle.Actor, req dto.ListArticlesRequest) (*dto.ListArticlesResponse, error)
 }
 
 // UpdateArticleUseCase is the interface for the update article use case
 type UpdateArticleUseCase interface {
-	Execute(ctx context.Context, id int64, req dto.UpdateArticleRequest) (*dto.ArticleResponse, error)
+	Execute(ctx context.Context, actor domainarticle.Actor, id int64, req dto.UpdateArticleRequest) (*dto.ArticleResponse, error)
 }
 
 // DeleteArticleUseCase is the interface for the delete article use case
 type DeleteArticleUseCase interface {
-	Execute(ctx context.Context, id int64) error
+	Execute(ctx context.Context, actor domainarticle.Actor, id int64) error
 }
 
 // Handler handles HTTP requests for articles
@@ -61,17 +62,42 @@ func NewHandler(
 	}
 }
 
+// actorFromContext builds the article actor from the identity set by AuthMiddleware.
+// ActorID is only set for impersonation tokens, the actor_id of the context is the user otherwise.
+func actorFromContext(c *gin.Context) domainarticle.Actor {
+	role := c.GetString("user_role")
+	return domainarticle.Actor{
+		UserID:   c.GetInt64("user_id"),
+		OrgID:    c.GetInt64("org_id"),
+		IsAdmin:  role == string(domainuser.RoleAdmin),
+		IsEditor: role == string(domainuser.RoleEditor),
+		ActorID:  c.GetInt64("impersonated_by"),
+	}
+}
+
 // Create handles POST /articles
 func (h *Handler) Create(c *gin.Context) {
+	actor := actorFromContext(c)
+	if actor.UserID <= 0 {
+		response.ErrorResponseUnauthorized(c, "user not authenticated")
+		return
+	}
+
 	var req dto.CreateArticleRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
 		response.ErrorResponseBadRequest(c, err.Error())
 		return
 	}
 
-	resp, err := h.createUseCase.Execute(c.Request.Context(), req)
+	resp, err := h.createUseCase.Execute(c.Request.Context(), actor, req)
 	if err != nil {
-		response.ErrorResponseInternalServerError(c, err.Error())
+		if err == domainarticle.ErrCategoryNotFound {
+			response.ErrorResponseBadRequest(c, err.Error())
+		} else if err == domainarticle.ErrSlugUnavailable {
+			response.ErrorResponseConflict(c, err.Error())
+		} else {
+			response.ErrorResponseInternalServerError(c, err.Error())
+		}
 		return
 	}
 
@@ -86,7 +112,7 @@ func (h *Handler) Get(c *gin.Context) {
 		return
 	}
 
-	resp, err := h.getUseCase.Execute(c.Request.Context(), id)
+	resp, err := h.getUseCase.Execute(c.Request.Context(), actorFromContext(c), id)
 	if err != nil {
 		if err == domainarticle.ErrArticleNotFound {
 			response.ErrorResponseNotFound(c, err.Error())
@@ -99,14 +125,22 @@ func (h *Handler) Get(c *gin.Context) {
 	response.SuccessResponseOK(c, "Article retrieved successfully", resp)
 }
 
-// List handles GET /articles
+// List handles GET /articles, optionally filtered by tag_id or category_id
 func (h *Handler) List(c *gin.Context) {
-	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "10"))
-	offset, _ := strconv.Atoi(c.DefaultQuery("offset", "0"))
+	var req dto.ListArticlesRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		response.ErrorResponseBadRequest(c, err.Error())
+		return
+	}
 
-	resp, err := h.listUseCase.Execute(c.Request.Context(), limit, offset)
+	resp, err := h.listUseCase.Execute(c.Request.Context(), actorFromContext(c), req)
 	if err != nil {
-		response.ErrorResponseInternalServerError(c, err.Error())
+		switch err {
+		case domainarticle.ErrTagNotFound, domainarticle.ErrCategoryNotFound:
+			response.ErrorResponseBadRequest(c, err.Error())
+		default:
+			response.ErrorResponseInternalServerError(c, err.Error())
+		}
 		return
 	}
 
@@ -127,10 +161,16 @@ func (h *Handler) Update(c *gin.Context) {
 		return
 	}
 
-	resp, err := h.updateUseCase.Execute(c.Request.Context(), id, req)
+	resp, err := h.updateUseCase.Execute(c.Request.Context(), actorFromContext(c), id, req)
 	if err != nil {
 		if err == domainarticle.ErrArticleNotFound {
 			response.ErrorResponseNotFound(c, err.Error())
+		} else if err == domainarticle.ErrForbidden {
+			response.ErrorResponseForbidden(c, err.Error())
+		} else if err == domainarticle.ErrCategoryNotFound {
+			response.ErrorResponseBadRequest(c, err.Error())
+		} else if err == domainarticle.ErrSlugUnavailable {
+			response.ErrorResponseConflict(c, err.Error())
 		} else {
 			response.ErrorResponseInternalServerError(c, err.Error())
 		}
@@ -148,10 +188,12 @@ func (h *Handler) Delete(c *gin.Context) {
 		return
 	}
 
-	err = h.deleteUseCase.Execute(c.Request.Context(), id)
+	err = h.deleteUseCase.Execute(c.Request.Context(), actorFromContext(c), id)
 	if err != nil {
 		if err == domainarticle.ErrArticleNotFound {
 			response.ErrorResponseNotFound(c, err.Error())
+		} else if err == domainarticle.ErrForbidden {
+			response.ErrorResponseForbidden(c, err.Error())
 		} else {
 			response.ErrorResponseInternalServerError(c, err.Error())
 		}
@@ -22,8 +22,8 @@ type mockCreateArticleUseCase struct {
 	mock.Mock
 }
 
-func (m *mockCreateArticleUseCase) Execute(ctx context.Context, req dto.CreateArticleRequest) (*dto.ArticleResponse, error) {
-	args := m.Called(ctx, req)
+func (m *mockCreateArticleUseCase) Execute(ctx context.Context, actor domainarticle.Actor, req dto.CreateArticleRequest) (*dto.ArticleResponse, error) {
+	args := m.Called(ctx, actor, req)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
@@ -35,8 +35,8 @@ type mockGetArticleUseCase struct {
 	mock.Mock
 }
 
-func (m *mockGetArticleUseCase) Execute(ctx context.Context, id int64) (*dto.ArticleResponse, error) {
-	args := m.Called(ctx, id)
+func (m *mockGetArticleUseCase) Execute(ctx context.Context, actor domainarticle.Actor, id int64) (*dto.ArticleResponse, error) {
+	args := m.Called(ctx, actor, id)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
@@ -48,8 +48,8 @@ type mockListArticlesUseCase struct {
 	mock.Mock
 }
 
-func (m *mockListArticlesUseCase) Execute(ctx context.Context, limit, offset int) (*dto.ListArticlesResponse, error) {
-	args := m.Called(ctx, limit, offset)
+func (m *mockListArticlesUseCase) Execute(ctx context.Context, actor domainarticle.Actor, req dto.ListArticlesRequest) (*dto.ListArticlesResponse, error) {
+	args := m.Called(ctx, actor, req)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
@@ -61,8 +61,8 @@ type mockUpdateArticleUseCase struct {
 	mock.Mock
 }
 
-func (m *mockUpdateArticleUseCase) Execute(ctx context.Context, id int64, req dto.UpdateArticleRequest) (*dto.ArticleResponse, error) {
-	args := m.Called(ctx, id, req)
+func (m *mockUpdateArticleUseCase) Execute(ctx context.Context, actor domainarticle.Actor, id int64, req dto.UpdateArticleRequest) (*dto.ArticleResponse, error) {
+	args := m.Called(ctx, actor, id, req)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
@@ -74,14 +74,29 @@ type mockDeleteArticleUseCase struct {
 	mock.Mock
 }
 
-func (m *mockDeleteArticleUseCase) Execute(ctx context.Context, id int64) error {
-	args := m.Called(ctx, id)
+func (m *mockDeleteArticleUseCase) Execute(ctx context.Context, actor domainarticle.Actor, id int64) error {
+	args := m.Called(ctx, actor, id)
 	return args.Error(0)
 }
 
+// testActor is the authenticated identity injected by setupTestRouter
+var testActor = domainarticle.Actor{UserID: 1, OrgID: 1}
+
 func setupTestRouter(handler *Handler) *gin.Engine {
+	return setupTestRouterAs(testActor.UserID, "author")
+}
+
+// setupTestRouterAs creates a router that authenticates every request as the given user
+// signed in to the organization of testActor
+func setupTestRouterAs(userID int64, role string) *gin.Engine {
 	gin.SetMode(gin.TestMode)
 	router := gin.New()
+	router.Use(func(c *gin.Context) {
+		c.Set("user_id", userID)
+		c.Set("user_role", role)
+		c.Set("org_id", testActor.OrgID)
+		c.Next()
+	})
 	return router
 }
 
@@ -102,6 +117,33 @@ func TestNewHandler(t *testing.T) {
 	assert.Equal(t, deleteUC, handler.deleteUseCase)
 }
 
+func TestActorFromContext(t *testing.T) {
+	tests := []struct {
+		name        string
+		actorID     int64
+		impersonate int64
+		want        domainarticle.Actor
+	}{
+		{name: "user", actorID: 7, want: domainarticle.Actor{UserID: 7, OrgID: 3}},
+		{name: "impersonated user", actorID: 2, impersonate: 2, want: domainarticle.Actor{UserID: 7, OrgID: 3, ActorID: 2}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, _ := gin.CreateTestContext(httptest.NewRecorder())
+			c.Set("user_id", int64(7))
+			c.Set("org_id", int64(3))
+			c.Set("user_role", "author")
+			c.Set("actor_id", tt.actorID)
+			if tt.impersonate != 0 {
+				c.Set("impersonated_by", tt.impersonate)
+			}
+
+			assert.Equal(t, tt.want, actorFromContext(c))
+		})
+	}
+}
+
 func TestHandler_Create_Success(t *testing.T) {
 	createUC := &mockCreateArticleUseCase{}
 	getUC := &mockGetArticleUseCase{}
@@ -112,21 +154,20 @@ func TestHandler_Create_Success(t *testing.T) {
 	handler := NewHandler(createUC, getUC, listUC, updateUC, deleteUC)
 
 	reqBody := dto.CreateArticleRequest{
-		Title:    "Test Article",
-		Content:  "Test Content",
-		AuthorID: 1,
+		Title:   "Test Article",
+		Content: "Test Content",
 	}
 
 	expectedResp := &dto.ArticleResponse{
 		ID:        1,
 		Title:     reqBody.Title,
 		Content:   reqBody.Content,
-		AuthorID:  reqBody.AuthorID,
+		AuthorID:  testActor.UserID,
 		CreatedAt: time.Now(),
 		UpdatedAt: time.Now(),
 	}
 
-	createUC.On("Execute", mock.Anything, reqBody).Return(expectedResp, nil)
+	createUC.On("Execute", mock.Anything, testActor, reqBody).Return(expectedResp, nil)
 
 	router := setupTestRouter(handler)
 	router.POST("/articles", handler.Create)
@@ -148,6 +189,60 @@ func TestHandler_Create_Success(t *testing.T) {
 	assert.Equal(t, "Article created successfully", response["message"])
 }
 
+func TestHandler_Create_Unauthorized_NoUser(t *testing.T) {
+	createUC := &mockCreateArticleUseCase{}
+	getUC := &mockGetArticleUseCase{}
+	listUC := &mockListArticlesUseCase{}
+	updateUC := &mockUpdateArticleUseCase{}
+	deleteUC := &mockDeleteArticleUseCase{}
+
+	handler := NewHandler(createUC, getUC, listUC, updateUC, deleteUC)
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.POST("/articles", handler.Create)
+
+	body, _ := json.Marshal(dto.CreateArticleRequest{Title: "Test Article", Content: "Test Content"})
+	req := httptest.NewRequest(http.MethodPost, "/articles", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+	createUC.AssertNotCalled(t, "Execute")
+}
+
+func TestHandler_Create_IgnoresAuthorIDInBody(t *testing.T) {
+	createUC := &mockCreateArticleUseCase{}
+	getUC := &mockGetArticleUseCase{}
+	listUC := &mockListArticlesUseCase{}
+	updateUC := &mockUpdateArticleUseCase{}
+	deleteUC := &mockDeleteArticleUseCase{}
+
+	handler := NewHandler(createUC, getUC, listUC, updateUC, deleteUC)
+
+	expectedReq := dto.CreateArticleRequest{Title: "Test Article", Content: "Test Content"}
+	createUC.On("Execute", mock.Anything, testActor, expectedReq).Return(&dto.ArticleResponse{ID: 1, AuthorID: testActor.UserID}, nil)
+
+	router := setupTestRouter(handler)
+	router.POST("/articles", handler.Create)
+
+	body, _ := json.Marshal(map[string]interface{}{
+		"title":     "Test Article",
+		"content":   "Test Content",
+		"author_id": 42,
+	})
+	req := httptest.NewRequest(http.MethodPost, "/articles", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusCreated, w.Code)
+	createUC.AssertExpectations(t)
+}
+
 func TestHandler_Create_BadRequest_InvalidJSON(t *testing.T) {
 	createUC := &mockCreateArticleUseCase{}
 	getUC := &mockGetArticleUseCase{}
@@ -181,7 +276,7 @@ func TestHandler_Create_BadRequest_MissingFields(t *testing.T) {
 
 	reqBody := map[string]interface{}{
 		"title": "Test Article",
-		// Missing content and author_id
+		// Missing content
 	}
 
 	router := setupTestRouter(handler)
@@ -208,12 +303,11 @@ func TestHandler_Create_InternalServerError(t *testing.T) {
 	handler := NewHandler(createUC, getUC, listUC, updateUC, deleteUC)
 
 	reqBody := dto.CreateArticleRequest{
-		Title:    "Test Article",
-		Content:  "Test Content",
-		AuthorID: 1,
+		Title:   "Test Article",
+		Content: "Test Content",
 	}
 
-	createUC.On("Execute", mock.Anything, reqBody).Return(nil, errors.New("database error"))
+	createUC.On("Execute", mock.Anything, testActor, reqBody).Return(nil, errors.New("database error"))
 
 	router := setupTestRouter(handler)
 	router.POST("/articles", handler.Create)
@@ -229,6 +323,32 @@ func TestHandler_Create_InternalServerError(t *testing.T) {
 	createUC.AssertExpectations(t)
 }
 
+func TestHandler_Create_Conflict_SlugUnavailable(t *testing.T) {
+	createUC := &mockCreateArticleUseCase{}
+
+	handler := NewHandler(createUC, &mockGetArticleUseCase{}, &mockListArticlesUseCase{}, &mockUpdateArticleUseCase{}, &mockDeleteArticleUseCase{})
+
+	reqBody := dto.CreateArticleRequest{
+		Title:   "Test Article",
+		Content: "Test Content",
+	}
+
+	createUC.On("Execute", mock.Anything, testActor, reqBody).Return(nil, domainarticle.ErrSlugUnavailable)
+
+	router := setupTestRouter(handler)
+	router.POST("/articles", handler.Create)
+
+	body, _ := json.Marshal(reqBody)
+	req := httptest.NewRequest(http.MethodPost, "/articles", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusConflict, w.Code)
+	createUC.AssertExpectations(t)
+}
+
 func TestHandler_Get_Success(t *testing.T) {
 	createUC := &mockCreateArticleUseCase{}
 	getUC := &mockGetArticleUseCase{}
@@ -248,7 +368,7 @@ func TestHandler_Get_Success(t *testing.T) {
 		UpdatedAt: time.Now(),
 	}
 
-	getUC.On("Execute", mock.Anything, articleID).Return(expectedResp, nil)
+	getUC.On("Execute", mock.Anything, testActor, articleID).Return(expectedResp, nil)
 
 	router := setupTestRouter(handler)
 	router.GET("/articles/:id", handler.Get)
@@ -299,7 +419,7 @@ func TestHandler_Get_NotFound(t *testing.T) {
 	handler := NewHandler(createUC, getUC, listUC, updateUC, deleteUC)
 
 	articleID := int64(999)
-	getUC.On("Execute", mock.Anything, articleID).Return(nil, domainarticle.ErrArticleNotFound)
+	getUC.On("Execute", mock.Anything, testActor, articleID).Return(nil, domainarticle.ErrArticleNotFound)
 
 	router := setupTestRouter(handler)
 	router.GET("/articles/:id", handler.Get)
@@ -323,7 +443,7 @@ func TestHandler_Get_InternalServerError(t *testing.T) {
 	handler := NewHandler(createUC, getUC, listUC, updateUC, deleteUC)
 
 	articleID := int64(1)
-	getUC.On("Execute", mock.Anything, articleID).Return(nil, errors.New("database error"))
+	getUC.On("Execute", mock.Anything, testActor, articleID).Return(nil, errors.New("database error"))
 
 	router := setupTestRouter(handler)
 	router.GET("/articles/:id", handler.Get)
@@ -364,7 +484,7 @@ func TestHandler_List_Success(t *testing.T) {
 		Offset: offset,
 	}
 
-	listUC.On("Execute", mock.Anything, limit, offset).Return(expectedResp, nil)
+	listUC.On("Execute", mock.Anything, testActor, dto.ListArticlesRequest{}).Return(expectedResp, nil)
 
 	router := setupTestRouter(handler)
 	router.GET("/articles", handler.List)
@@ -402,7 +522,7 @@ func TestHandler_List_WithQueryParams(t *testing.T) {
 		Offset:   offset,
 	}
 
-	listUC.On("Execute", mock.Anything, limit, offset).Return(expectedResp, nil)
+	listUC.On("Execute", mock.Anything, testActor, dto.ListArticlesRequest{Limit: limit, Offset: offset}).Return(expectedResp, nil)
 
 	router := setupTestRouter(handler)
 	router.GET("/articles", handler.List)
@@ -425,9 +545,7 @@ func TestHandler_List_InternalServerError(t *testing.T) {
 
 	handler := NewHandler(createUC, getUC, listUC, updateUC, deleteUC)
 
-	limit := 10
-	offset := 0
-	listUC.On("Execute", mock.Anything, limit, offset).Return(nil, errors.New("database error"))
+	listUC.On("Execute", mock.Anything, testActor, dto.ListArticlesRequest{}).Return(nil, errors.New("database error"))
 
 	router := setupTestRouter(handler)
 	router.GET("/articles", handler.List)
@@ -441,6 +559,82 @@ func TestHandler_List_InternalServerError(t *testing.T) {
 	listUC.AssertExpectations(t)
 }
 
+func TestHandler_List_TaxonomyFilter(t *testing.T) {
+	tests := []struct {
+		name       string
+		query      string
+		err        error
+		wantStatus int
+	}{
+		{name: "by tag", query: "tag_id=4", wantStatus: http.StatusOK},
+		{name: "by category", query: "category_id=2", wantStatus: http.StatusOK},
+		{name: "unknown tag", query: "tag_id=4", err: domainarticle.ErrTagNotFound, wantStatus: http.StatusBadRequest},
+		{name: "unknown category", query: "category_id=2", err: domainarticle.ErrCategoryNotFound, wantStatus: http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			listUC := &mockListArticlesUseCase{}
+			handler := NewHandler(nil, nil, listUC, nil, nil)
+
+			listUC.On("Execute", mock.Anything, testActor, mock.MatchedBy(func(req dto.ListArticlesRequest) bool {
+				return req.TagID == 4 || req.CategoryID == 2
+			})).Return(&dto.ListArticlesResponse{Limit: 10}, tt.err)
+
+			router := setupTestRouter(handler)
+			router.GET("/articles", handler.List)
+
+			req := httptest.NewRequest(http.MethodGet, "/articles?"+tt.query, nil)
+			w := httptest.NewRecorder()
+
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.wantStatus, w.Code)
+			listUC.AssertExpectations(t)
+		})
+	}
+}
+
+func TestHandler_List_InvalidQuery(t *testing.T) {
+	listUC := &mockListArticlesUseCase{}
+	handler := NewHandler(nil, nil, listUC, nil, nil)
+
+	router := setupTestRouter(handler)
+	router.GET("/articles", handler.List)
+
+	req := httptest.NewRequest(http.MethodGet, "/articles?tag_id=sport", nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	listUC.AssertNotCalled(t, "Execute")
+}
+
+func TestHandler_List_EditorActor(t *testing.T) {
+	createUC := &mockCreateArticleUseCase{}
+	getUC := &mockGetArticleUseCase{}
+	listUC := &mockListArticlesUseCase{}
+	updateUC := &mockUpdateArticleUseCase{}
+	deleteUC := &mockDeleteArticleUseCase{}
+
+	handler := NewHandler(createUC, getUC, listUC, updateUC, deleteUC)
+
+	editorActor := domainarticle.Actor{UserID: 5, OrgID: 1, IsEditor: true}
+	listUC.On("Execute", mock.Anything, editorActor, dto.ListArticlesRequest{}).Return(&dto.ListArticlesResponse{Limit: 10}, nil)
+
+	router := setupTestRouterAs(editorActor.UserID, "editor")
+	router.GET("/articles", handler.List)
+
+	req := httptest.NewRequest(http.MethodGet, "/articles", nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	listUC.AssertExpectations(t)
+}
+
 func TestHandler_Update_Success(t *testing.T) {
 	createUC := &mockCreateArticleUseCase{}
 	getUC := &mockGetArticleUseCase{}
@@ -465,7 +659,7 @@ func TestHandler_Update_Success(t *testing.T) {
 		UpdatedAt: time.Now(),
 	}
 
-	updateUC.On("Execute", mock.Anything, articleID, reqBody).Return(expectedResp, nil)
+	updateUC.On("Execute", mock.Anything, testActor, articleID, reqBody).Return(expectedResp, nil)
 
 	router := setupTestRouter(handler)
 	router.PUT("/articles/:id", handler.Update)
@@ -565,6 +759,69 @@ func TestHandler_Update_BadRequest_MissingFields(t *testing.T) {
 	updateUC.AssertNotCalled(t, "Execute")
 }
 
+func TestHandler_Update_Forbidden(t *testing.T) {
+	createUC := &mockCreateArticleUseCase{}
+	getUC := &mockGetArticleUseCase{}
+	listUC := &mockListArticlesUseCase{}
+	updateUC := &mockUpdateArticleUseCase{}
+	deleteUC := &mockDeleteArticleUseCase{}
+
+	handler := NewHandler(createUC, getUC, listUC, updateUC, deleteUC)
+
+	articleID := int64(1)
+	reqBody := dto.UpdateArticleRequest{
+		Title:   "Updated Article",
+		Content: "Updated Content",
+	}
+
+	updateUC.On("Execute", mock.Anything, testActor, articleID, reqBody).Return(nil, domainarticle.ErrForbidden)
+
+	router := setupTestRouter(handler)
+	router.PUT("/articles/:id", handler.Update)
+
+	body, _ := json.Marshal(reqBody)
+	req := httptest.NewRequest(http.MethodPut, "/articles/1", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusForbidden, w.Code)
+	updateUC.AssertExpectations(t)
+}
+
+func TestHandler_Update_AdminActor(t *testing.T) {
+	createUC := &mockCreateArticleUseCase{}
+	getUC := &mockGetArticleUseCase{}
+	listUC := &mockListArticlesUseCase{}
+	updateUC := &mockUpdateArticleUseCase{}
+	deleteUC := &mockDeleteArticleUseCase{}
+
+	handler := NewHandler(createUC, getUC, listUC, updateUC, deleteUC)
+
+	articleID := int64(1)
+	reqBody := dto.UpdateArticleRequest{
+		Title:   "Updated Article",
+		Content: "Updated Content",
+	}
+	adminActor := domainarticle.Actor{UserID: 9, OrgID: 1, IsAdmin: true}
+
+	updateUC.On("Execute", mock.Anything, adminActor, articleID, reqBody).Return(&dto.ArticleResponse{ID: articleID}, nil)
+
+	router := setupTestRouterAs(adminActor.UserID, "admin")
+	router.PUT("/articles/:id", handler.Update)
+
+	body, _ := json.Marshal(reqBody)
+	req := httptest.NewRequest(http.MethodPut, "/articles/1", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	updateUC.AssertExpectations(t)
+}
+
 func TestHandler_Update_NotFound(t *testing.T) {
 	createUC := &mockCreateArticleUseCase{}
 	getUC := &mockGetArticleUseCase{}
@@ -580,7 +837,7 @@ func TestHandler_Update_NotFound(t *testing.T) {
 		Content: "Updated Content",
 	}
 
-	updateUC.On("Execute", mock.Anything, articleID, reqBody).Return(nil, domainarticle.ErrArticleNotFound)
+	updateUC.On("Execute", mock.Anything, testActor, articleID, reqBody).Return(nil, domainarticle.ErrArticleNotFound)
 
 	router := setupTestRouter(handler)
 	router.PUT("/articles/:id", handler.Update)
@@ -611,7 +868,7 @@ func TestHandler_Update_InternalServerError(t *testing.T) {
 		Content: "Updated Content",
 	}
 
-	updateUC.On("Execute", mock.Anything, articleID, reqBody).Return(nil, errors.New("database error"))
+	updateUC.On("Execute", mock.Anything, testActor, articleID, reqBody).Return(nil, errors.New("database error"))
 
 	router := setupTestRouter(handler)
 	router.PUT("/articles/:id", handler.Update)
@@ -637,7 +894,7 @@ func TestHandler_Delete_Success(t *testing.T) {
 	handler := NewHandler(createUC, getUC, listUC, updateUC, deleteUC)
 
 	articleID := int64(1)
-	deleteUC.On("Execute", mock.Anything, articleID).Return(nil)
+	deleteUC.On("Execute", mock.Anything, testActor, articleID).Return(nil)
 
 	router := setupTestRouter(handler)
 	router.DELETE("/articles/:id", handler.Delete)
@@ -678,6 +935,30 @@ func TestHandler_Delete_BadRequest_InvalidID(t *testing.T) {
 	deleteUC.AssertNotCalled(t, "Execute")
 }
 
+func TestHandler_Delete_Forbidden(t *testing.T) {
+	createUC := &mockCreateArticleUseCase{}
+	getUC := &mockGetArticleUseCase{}
+	listUC := &mockListArticlesUseCase{}
+	updateUC := &mockUpdateArticleUseCase{}
+	deleteUC := &mockDeleteArticleUseCase{}
+
+	handler := NewHandler(createUC, getUC, listUC, updateUC, deleteUC)
+
+	articleID := int64(1)
+	deleteUC.On("Execute", mock.Anything, testActor, articleID).Return(domainarticle.ErrForbidden)
+
+	router := setupTestRouter(handler)
+	router.DELETE("/articles/:id", handler.Delete)
+
+	req := httptest.NewRequest(http.MethodDelete, "/articles/1", nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusForbidden, w.Code)
+	deleteUC.AssertExpectations(t)
+}
+
 func TestHandler_Delete_NotFound(t *testing.T) {
 	createUC := &mockCreateArticleUseCase{}
 	getUC := &mockGetArticleUseCase{}
@@ -688,7 +969,7 @@ func TestHandler_Delete_NotFound(t *testing.T) {
 	handler := NewHandler(createUC, getUC, listUC, updateUC, deleteUC)
 
 	articleID := int64(999)
-	deleteUC.On("Execute", mock.Anything, articleID).Return(domainarticle.ErrArticleNotFound)
+	deleteUC.On("Execute", mock.Anything, testActor, articleID).Return(domainarticle.ErrArticleNotFound)
 
 	router := setupTestRouter(handler)
 	router.DELETE("/articles/:id", handler.Delete)
@@ -712,7 +993,7 @@ func TestHandler_Delete_InternalServerError(t *testing.T) {
 	handler := NewHandler(createUC, getUC, listUC, updateUC, deleteUC)
 
 	articleID := int64(1)
-	deleteUC.On("Execute", mock.Anything, articleID).Return(errors.New("database error"))
+	deleteUC.On("Execute", mock.Anything, testActor, articleID).Return(errors.New("database error"))
 
 	router := setupTestRouter(handler)
 	router.DELETE("/articles/:id", handler.Delete)
@@ -0,0 +1,46 @@
+package article
+
+import (
+	"context"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rulzi/hexa-go/internal/adapters/http/response"
+	"github.com/rulzi/hexa-go/internal/application/article/dto"
+	domainarticle "github.com/rulzi/hexa-go/internal/domain/article"
+)
+
+// SearchArticlesUseCase is the interface for the search articles use case
+type SearchArticlesUseCase interface {
+	Execute(ctx context.Context, actor domainarticle.Actor, req dto.SearchArticlesRequest) (*dto.SearchArticlesResponse, error)
+}
+
+// SearchHandler handles HTTP requests searching articles
+type SearchHandler struct {
+	searchUseCase SearchArticlesUseCase
+}
+
+// NewSearchHandler creates a new SearchHandler
+func NewSearchHandler(searchUseCase SearchArticlesUseCase) *SearchHandler {
+	return &SearchHandler{searchUseCase: searchUseCase}
+}
+
+// Search handles GET /articles/search?q=, hits come most relevant first with a highlighted snippet
+func (h *SearchHandler) Search(c *gin.Context) {
+	var req dto.SearchArticlesRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		response.ErrorResponseBadRequest(c, err.Error())
+		return
+	}
+
+	resp, err := h.searchUseCase.Execute(c.Request.Context(), actorFromContext(c), req)
+	if err != nil {
+		if err == domainarticle.ErrSearchQueryRequired {
+			response.ErrorResponseBadRequest(c, err.Error())
+		} else {
+			response.ErrorResponseInternalServerError(c, err.Error())
+		}
+		return
+	}
+
+	response.SuccessResponseOK(c, "Articles found successfully", resp)
+}
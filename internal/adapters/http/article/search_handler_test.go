@@ -0,0 +1,97 @@
+package article
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/rulzi/hexa-go/internal/application/article/dto"
+	domainarticle "github.com/rulzi/hexa-go/internal/domain/article"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// mockSearchArticlesUseCase is a mock implementation of SearchArticlesUseCase
+type mockSearchArticlesUseCase struct {
+	mock.Mock
+}
+
+func (m *mockSearchArticlesUseCase) Execute(ctx context.Context, actor domainarticle.Actor, req dto.SearchArticlesRequest) (*dto.SearchArticlesResponse, error) {
+	args := m.Called(ctx, actor, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.SearchArticlesResponse), args.Error(1)
+}
+
+func TestSearchHandler_Search(t *testing.T) {
+	searchUC := &mockSearchArticlesUseCase{}
+	searchUC.On("Execute", mock.Anything, testActor, dto.SearchArticlesRequest{Query: "go generics", Limit: 5, Offset: 10}).Return(&dto.SearchArticlesResponse{
+		Query: "go generics",
+		Hits: []dto.SearchHitResponse{
+			{Article: dto.ArticleResponse{ID: 4, Title: "Go generics"}, Score: 2.5, Snippet: "<mark>Go</mark> <mark>generics</mark>"},
+		},
+		Total:  11,
+		Limit:  5,
+		Offset: 10,
+	}, nil)
+	handler := NewSearchHandler(searchUC)
+
+	router := setupTestRouter(nil)
+	router.GET("/articles/search", handler.Search)
+
+	req := httptest.NewRequest(http.MethodGet, "/articles/search?q=go+generics&limit=5&offset=10", nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	var body struct {
+		Data dto.SearchArticlesResponse `json:"data"`
+	}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+	assert.Equal(t, int64(11), body.Data.Total)
+	require.Len(t, body.Data.Hits, 1)
+	assert.Equal(t, int64(4), body.Data.Hits[0].Article.ID)
+	assert.Equal(t, "<mark>Go</mark> <mark>generics</mark>", body.Data.Hits[0].Snippet)
+	searchUC.AssertExpectations(t)
+}
+
+func TestSearchHandler_Search_Errors(t *testing.T) {
+	tests := []struct {
+		name       string
+		query      string
+		err        error
+		wantCall   bool
+		wantStatus int
+	}{
+		{name: "missing query", query: "", err: domainarticle.ErrSearchQueryRequired, wantCall: true, wantStatus: http.StatusBadRequest},
+		{name: "invalid limit", query: "q=go&limit=ten", wantStatus: http.StatusBadRequest},
+		{name: "database error", query: "q=go", err: errors.New("database error"), wantCall: true, wantStatus: http.StatusInternalServerError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			searchUC := &mockSearchArticlesUseCase{}
+			if tt.wantCall {
+				searchUC.On("Execute", mock.Anything, testActor, mock.Anything).Return(nil, tt.err)
+			}
+			handler := NewSearchHandler(searchUC)
+
+			router := setupTestRouter(nil)
+			router.GET("/articles/search", handler.Search)
+
+			req := httptest.NewRequest(http.MethodGet, "/articles/search?"+tt.query, nil)
+			w := httptest.NewRecorder()
+
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.wantStatus, w.Code)
+			searchUC.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,45 @@
+package article
+
+import (
+	"context"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rulzi/hexa-go/internal/adapters/http/response"
+	"github.com/rulzi/hexa-go/internal/application/article/dto"
+	domainarticle "github.com/rulzi/hexa-go/internal/domain/article"
+)
+
+// GetArticleBySlugUseCase is the interface for the get article by slug use case
+type GetArticleBySlugUseCase interface {
+	Execute(ctx context.Context, actor domainarticle.Actor, slug string) (*dto.ArticleBySlugResponse, error)
+}
+
+// SlugHandler handles HTTP requests addressing articles by slug
+type SlugHandler struct {
+	getUseCase GetArticleBySlugUseCase
+}
+
+// NewSlugHandler creates a new SlugHandler
+func NewSlugHandler(getUseCase GetArticleBySlugUseCase) *SlugHandler {
+	return &SlugHandler{getUseCase: getUseCase}
+}
+
+// Get handles GET /articles/by-slug/:slug.
+// A previous slug of the article still resolves, redirect_to then holds the slug clients should use instead.
+func (h *SlugHandler) Get(c *gin.Context) {
+	resp, err := h.getUseCase.Execute(c.Request.Context(), actorFromContext(c), c.Param("slug"))
+	if err != nil {
+		if err == domainarticle.ErrArticleNotFound {
+			response.ErrorResponseNotFound(c, err.Error())
+		} else {
+			response.ErrorResponseInternalServerError(c, err.Error())
+		}
+		return
+	}
+
+	if resp.RedirectTo != "" {
+		response.SuccessResponseOK(c, "Article moved to a new slug", resp)
+		return
+	}
+	response.SuccessResponseOK(c, "Article retrieved successfully", resp)
+}
@@ -0,0 +1,76 @@
+package article
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/rulzi/hexa-go/internal/application/article/dto"
+	domainarticle "github.com/rulzi/hexa-go/internal/domain/article"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// mockGetArticleBySlugUseCase is a mock implementation of GetArticleBySlugUseCase
+type mockGetArticleBySlugUseCase struct {
+	mock.Mock
+}
+
+func (m *mockGetArticleBySlugUseCase) Execute(ctx context.Context, actor domainarticle.Actor, slug string) (*dto.ArticleBySlugResponse, error) {
+	args := m.Called(ctx, actor, slug)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.ArticleBySlugResponse), args.Error(1)
+}
+
+func TestSlugHandler_Get(t *testing.T) {
+	tests := []struct {
+		name         string
+		resp         *dto.ArticleBySlugResponse
+		err          error
+		wantStatus   int
+		wantRedirect string
+	}{
+		{name: "current slug", resp: &dto.ArticleBySlugResponse{Article: dto.ArticleResponse{ID: 3, Slug: "hello-world"}}, wantStatus: http.StatusOK},
+		{name: "previous slug", resp: &dto.ArticleBySlugResponse{Article: dto.ArticleResponse{ID: 3, Slug: "hello-again"}, RedirectTo: "hello-again"}, wantStatus: http.StatusOK, wantRedirect: "hello-again"},
+		{name: "not found", err: domainarticle.ErrArticleNotFound, wantStatus: http.StatusNotFound},
+		{name: "database error", err: errors.New("database error"), wantStatus: http.StatusInternalServerError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			getUC := &mockGetArticleBySlugUseCase{}
+			call := getUC.On("Execute", mock.Anything, testActor, "hello-world")
+			if tt.err != nil {
+				call.Return(nil, tt.err)
+			} else {
+				call.Return(tt.resp, nil)
+			}
+			handler := NewSlugHandler(getUC)
+
+			router := setupTestRouter(nil)
+			router.GET("/articles/by-slug/:slug", handler.Get)
+
+			req := httptest.NewRequest(http.MethodGet, "/articles/by-slug/hello-world", nil)
+			w := httptest.NewRecorder()
+
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.wantStatus, w.Code)
+			if tt.wantStatus == http.StatusOK {
+				var body struct {
+					Data dto.ArticleBySlugResponse `json:"data"`
+				}
+				require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+				assert.Equal(t, int64(3), body.Data.Article.ID)
+				assert.Equal(t, tt.wantRedirect, body.Data.RedirectTo)
+			}
+			getUC.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,198 @@
+package article
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rulzi/hexa-go/internal/adapters/http/response"
+	"github.com/rulzi/hexa-go/internal/application/article/dto"
+	domainarticle "github.com/rulzi/hexa-go/internal/domain/article"
+)
+
+// CreateTagUseCase is the interface for the create tag use case
+type CreateTagUseCase interface {
+	Execute(ctx context.Context, actor domainarticle.Actor, req dto.TagRequest) (*dto.TagResponse, error)
+}
+
+// UpdateTagUseCase is the interface for the update tag use case
+type UpdateTagUseCase interface {
+	Execute(ctx context.Context, actor domainarticle.Actor, id int64, req dto.TagRequest) (*dto.TagResponse, error)
+}
+
+// DeleteTagUseCase is the interface for the delete tag use case
+type DeleteTagUseCase interface {
+	Execute(ctx context.Context, actor domainarticle.Actor, id int64) error
+}
+
+// ListTagsUseCase is the interface for the list tags use case
+type ListTagsUseCase interface {
+	Execute(ctx context.Context, actor domainarticle.Actor) (*dto.ListTagsResponse, error)
+}
+
+// GetArticleTagsUseCase is the interface for the get article tags use case
+type GetArticleTagsUseCase interface {
+	Execute(ctx context.Context, actor domainarticle.Actor, articleID int64) (*dto.ListTagsResponse, error)
+}
+
+// SetArticleTagsUseCase is the interface for the set article tags use case
+type SetArticleTagsUseCase interface {
+	Execute(ctx context.Context, actor domainarticle.Actor, articleID int64, req dto.SetArticleTagsRequest) (*dto.ListTagsResponse, error)
+}
+
+// TagHandler handles HTTP requests for tags and the tags of articles
+type TagHandler struct {
+	createUseCase         CreateTagUseCase
+	updateUseCase         UpdateTagUseCase
+	deleteUseCase         DeleteTagUseCase
+	listUseCase           ListTagsUseCase
+	getArticleTagsUseCase GetArticleTagsUseCase
+	setArticleTagsUseCase SetArticleTagsUseCase
+}
+
+// NewTagHandler creates a new TagHandler
+func NewTagHandler(
+	createUseCase CreateTagUseCase,
+	updateUseCase UpdateTagUseCase,
+	deleteUseCase DeleteTagUseCase,
+	listUseCase ListTagsUseCase,
+	getArticleTagsUseCase GetArticleTagsUseCase,
+	setArticleTagsUseCase SetArticleTagsUseCase,
+) *TagHandler {
+	return &TagHandler{
+		createUseCase:         createUseCase,
+		updateUseCase:         updateUseCase,
+		deleteUseCase:         deleteUseCase,
+		listUseCase:           listUseCase,
+		getArticleTagsUseCase: getArticleTagsUseCase,
+		setArticleTagsUseCase: setArticleTagsUseCase,
+	}
+}
+
+// List handles GET /tags, each tag counts the articles the caller may view
+func (h *TagHandler) List(c *gin.Context) {
+	resp, err := h.listUseCase.Execute(c.Request.Context(), actorFromContext(c))
+	if err != nil {
+		response.ErrorResponseInternalServerError(c, err.Error())
+		return
+	}
+
+	response.SuccessResponseOK(c, "Tags retrieved successfully", resp)
+}
+
+// Create handles POST /tags
+func (h *TagHandler) Create(c *gin.Context) {
+	var req dto.TagRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.ErrorResponseBadRequest(c, err.Error())
+		return
+	}
+
+	resp, err := h.createUseCase.Execute(c.Request.Context(), actorFromContext(c), req)
+	if err != nil {
+		respondTaxonomyError(c, err)
+		return
+	}
+
+	response.SuccessResponseCreated(c, "Tag created successfully", resp)
+}
+
+// Update handles PUT /tags/:id
+func (h *TagHandler) Update(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		response.ErrorResponseBadRequest(c, "invalid tag id")
+		return
+	}
+
+	var req dto.TagRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.ErrorResponseBadRequest(c, err.Error())
+		return
+	}
+
+	resp, err := h.updateUseCase.Execute(c.Request.Context(), actorFromContext(c), id, req)
+	if err != nil {
+		respondTaxonomyError(c, err)
+		return
+	}
+
+	response.SuccessResponseOK(c, "Tag updated successfully", resp)
+}
+
+// Delete handles DELETE /tags/:id
+func (h *TagHandler) Delete(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		response.ErrorResponseBadRequest(c, "invalid tag id")
+		return
+	}
+
+	if err := h.deleteUseCase.Execute(c.Request.Context(), actorFromContext(c), id); err != nil {
+		respondTaxonomyError(c, err)
+		return
+	}
+
+	response.SuccessResponseOK(c, "Tag deleted successfully", nil)
+}
+
+// ListByArticle handles GET /articles/:id/tags
+func (h *TagHandler) ListByArticle(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		response.ErrorResponseBadRequest(c, "invalid article id")
+		return
+	}
+
+	resp, err := h.getArticleTagsUseCase.Execute(c.Request.Context(), actorFromContext(c), id)
+	if err != nil {
+		respondTaxonomyError(c, err)
+		return
+	}
+
+	response.SuccessResponseOK(c, "Article tags retrieved successfully", resp)
+}
+
+// SetForArticle handles PUT /articles/:id/tags, the given tags replace the ones of the article
+func (h *TagHandler) SetForArticle(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		response.ErrorResponseBadRequest(c, "invalid article id")
+		return
+	}
+
+	var req dto.SetArticleTagsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.ErrorResponseBadRequest(c, err.Error())
+		return
+	}
+
+	resp, err := h.setArticleTagsUseCase.Execute(c.Request.Context(), actorFromContext(c), id, req)
+	if err != nil {
+		if err == domainarticle.ErrTagNotFound {
+			// One of the requested tags, not the resource of the request
+			response.ErrorResponseBadRequest(c, err.Error())
+		} else {
+			respondTaxonomyError(c, err)
+		}
+		return
+	}
+
+	response.SuccessResponseOK(c, "Article tags updated successfully", resp)
+}
+
+// respondTaxonomyError maps the errors of the tag and category use cases to HTTP responses
+func respondTaxonomyError(c *gin.Context, err error) {
+	switch err {
+	case domainarticle.ErrTagNotFound, domainarticle.ErrCategoryNotFound, domainarticle.ErrArticleNotFound:
+		response.ErrorResponseNotFound(c, err.Error())
+	case domainarticle.ErrForbidden:
+		response.ErrorResponseForbidden(c, err.Error())
+	case domainarticle.ErrTagExists, domainarticle.ErrCategoryExists, domainarticle.ErrCategoryHasChildren:
+		response.ErrorResponseConflict(c, err.Error())
+	case domainarticle.ErrTagNameRequired, domainarticle.ErrCategoryNameRequired, domainarticle.ErrCategoryCycle:
+		response.ErrorResponseBadRequest(c, err.Error())
+	default:
+		response.ErrorResponseInternalServerError(c, err.Error())
+	}
+}
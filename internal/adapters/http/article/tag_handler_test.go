@@ -0,0 +1,187 @@
+package article
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/rulzi/hexa-go/internal/application/article/dto"
+	domainarticle "github.com/rulzi/hexa-go/internal/domain/article"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// mockCreateTagUseCase is a mock implementation of CreateTagUseCase
+type mockCreateTagUseCase struct {
+	mock.Mock
+}
+
+func (m *mockCreateTagUseCase) Execute(ctx context.Context, actor domainarticle.Actor, req dto.TagRequest) (*dto.TagResponse, error) {
+	args := m.Called(ctx, actor, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.TagResponse), args.Error(1)
+}
+
+// mockDeleteTagUseCase is a mock implementation of DeleteTagUseCase
+type mockDeleteTagUseCase struct {
+	mock.Mock
+}
+
+func (m *mockDeleteTagUseCase) Execute(ctx context.Context, actor domainarticle.Actor, id int64) error {
+	args := m.Called(ctx, actor, id)
+	return args.Error(0)
+}
+
+// mockListTagsUseCase is a mock implementation of ListTagsUseCase
+type mockListTagsUseCase struct {
+	mock.Mock
+}
+
+func (m *mockListTagsUseCase) Execute(ctx context.Context, actor domainarticle.Actor) (*dto.ListTagsResponse, error) {
+	args := m.Called(ctx, actor)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.ListTagsResponse), args.Error(1)
+}
+
+// mockSetArticleTagsUseCase is a mock implementation of SetArticleTagsUseCase
+type mockSetArticleTagsUseCase struct {
+	mock.Mock
+}
+
+func (m *mockSetArticleTagsUseCase) Execute(ctx context.Context, actor domainarticle.Actor, articleID int64, req dto.SetArticleTagsRequest) (*dto.ListTagsResponse, error) {
+	args := m.Called(ctx, actor, articleID, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.ListTagsResponse), args.Error(1)
+}
+
+func TestTagHandler_List(t *testing.T) {
+	count := int64(3)
+	listUC := &mockListTagsUseCase{}
+	listUC.On("Execute", mock.Anything, testActor).Return(&dto.ListTagsResponse{Tags: []dto.TagResponse{{ID: 2, Name: "Football", Slug: "football", ArticleCount: &count}}}, nil)
+	handler := NewTagHandler(nil, nil, nil, listUC, nil, nil)
+
+	router := setupTestRouter(nil)
+	router.GET("/tags", handler.List)
+
+	req := httptest.NewRequest(http.MethodGet, "/tags", nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	var body struct {
+		Data dto.ListTagsResponse `json:"data"`
+	}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+	require.Len(t, body.Data.Tags, 1)
+	assert.Equal(t, int64(3), *body.Data.Tags[0].ArticleCount)
+	listUC.AssertExpectations(t)
+}
+
+func TestTagHandler_Create(t *testing.T) {
+	tests := []struct {
+		name       string
+		body       string
+		err        error
+		wantCall   bool
+		wantStatus int
+	}{
+		{name: "created", body: `{"name":"Sport"}`, wantCall: true, wantStatus: http.StatusCreated},
+		{name: "already exists", body: `{"name":"Sport"}`, err: domainarticle.ErrTagExists, wantCall: true, wantStatus: http.StatusConflict},
+		{name: "database error", body: `{"name":"Sport"}`, err: errors.New("database error"), wantCall: true, wantStatus: http.StatusInternalServerError},
+		{name: "missing name", body: `{}`, wantStatus: http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			createUC := &mockCreateTagUseCase{}
+			if tt.wantCall {
+				call := createUC.On("Execute", mock.Anything, testActor, dto.TagRequest{Name: "Sport"})
+				if tt.err != nil {
+					call.Return(nil, tt.err)
+				} else {
+					call.Return(&dto.TagResponse{ID: 1, Name: "Sport", Slug: "sport"}, nil)
+				}
+			}
+			handler := NewTagHandler(createUC, nil, nil, nil, nil, nil)
+
+			router := setupTestRouter(nil)
+			router.POST("/tags", handler.Create)
+
+			req := httptest.NewRequest(http.MethodPost, "/tags", bytes.NewBufferString(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.wantStatus, w.Code)
+			createUC.AssertExpectations(t)
+		})
+	}
+}
+
+func TestTagHandler_Delete_NotFound(t *testing.T) {
+	deleteUC := &mockDeleteTagUseCase{}
+	deleteUC.On("Execute", mock.Anything, testActor, int64(4)).Return(domainarticle.ErrTagNotFound)
+	handler := NewTagHandler(nil, nil, deleteUC, nil, nil, nil)
+
+	router := setupTestRouter(nil)
+	router.DELETE("/tags/:id", handler.Delete)
+
+	req := httptest.NewRequest(http.MethodDelete, "/tags/4", nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+	deleteUC.AssertExpectations(t)
+}
+
+func TestTagHandler_SetForArticle(t *testing.T) {
+	tests := []struct {
+		name       string
+		err        error
+		wantStatus int
+	}{
+		{name: "tags replaced", wantStatus: http.StatusOK},
+		{name: "unknown tag", err: domainarticle.ErrTagNotFound, wantStatus: http.StatusBadRequest},
+		{name: "unknown article", err: domainarticle.ErrArticleNotFound, wantStatus: http.StatusNotFound},
+		{name: "article of another author", err: domainarticle.ErrForbidden, wantStatus: http.StatusForbidden},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			setUC := &mockSetArticleTagsUseCase{}
+			call := setUC.On("Execute", mock.Anything, testActor, int64(9), dto.SetArticleTagsRequest{TagIDs: []int64{1, 2}})
+			if tt.err != nil {
+				call.Return(nil, tt.err)
+			} else {
+				call.Return(&dto.ListTagsResponse{Tags: []dto.TagResponse{{ID: 1}, {ID: 2}}}, nil)
+			}
+			handler := NewTagHandler(nil, nil, nil, nil, nil, setUC)
+
+			router := setupTestRouter(nil)
+			router.PUT("/articles/:id/tags", handler.SetForArticle)
+
+			req := httptest.NewRequest(http.MethodPut, "/articles/9/tags", bytes.NewBufferString(`{"tag_ids":[1,2]}`))
+			req.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.wantStatus, w.Code)
+			setUC.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,70 @@
+package article
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rulzi/hexa-go/internal/adapters/http/response"
+	"github.com/rulzi/hexa-go/internal/application/article/dto"
+	domainarticle "github.com/rulzi/hexa-go/internal/domain/article"
+)
+
+// ListDeletedArticlesUseCase is the interface for the list deleted articles use case
+type ListDeletedArticlesUseCase interface {
+	Execute(ctx context.Context, orgID int64, limit, offset int) (*dto.ListArticlesResponse, error)
+}
+
+// RestoreArticleUseCase is the interface for the restore article use case
+type RestoreArticleUseCase interface {
+	Execute(ctx context.Context, orgID, id int64) (*dto.ArticleResponse, error)
+}
+
+// TrashHandler handles HTTP requests for the articles in the trash
+type TrashHandler struct {
+	listUseCase    ListDeletedArticlesUseCase
+	restoreUseCase RestoreArticleUseCase
+}
+
+// NewTrashHandler creates a new TrashHandler
+func NewTrashHandler(listUseCase ListDeletedArticlesUseCase, restoreUseCase RestoreArticleUseCase) *TrashHandler {
+	return &TrashHandler{
+		listUseCase:    listUseCase,
+		restoreUseCase: restoreUseCase,
+	}
+}
+
+// List handles GET /admin/trash/articles
+func (h *TrashHandler) List(c *gin.Context) {
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "10"))
+	offset, _ := strconv.Atoi(c.DefaultQuery("offset", "0"))
+
+	resp, err := h.listUseCase.Execute(c.Request.Context(), c.GetInt64("org_id"), limit, offset)
+	if err != nil {
+		response.ErrorResponseInternalServerError(c, err.Error())
+		return
+	}
+
+	response.SuccessResponseOK(c, "Deleted articles retrieved successfully", resp)
+}
+
+// Restore handles POST /admin/trash/articles/:id/restore
+func (h *TrashHandler) Restore(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		response.ErrorResponseBadRequest(c, "invalid article id")
+		return
+	}
+
+	resp, err := h.restoreUseCase.Execute(c.Request.Context(), c.GetInt64("org_id"), id)
+	if err != nil {
+		if err == domainarticle.ErrArticleNotFound {
+			response.ErrorResponseNotFound(c, err.Error())
+		} else {
+			response.ErrorResponseInternalServerError(c, err.Error())
+		}
+		return
+	}
+
+	response.SuccessResponseOK(c, "Article restored successfully", resp)
+}
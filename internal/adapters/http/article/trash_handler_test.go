@@ -0,0 +1,90 @@
+package article
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/rulzi/hexa-go/internal/application/article/dto"
+	domainarticle "github.com/rulzi/hexa-go/internal/domain/article"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// mockListDeletedArticlesUseCase is a mock implementation of ListDeletedArticlesUseCase
+type mockListDeletedArticlesUseCase struct {
+	mock.Mock
+}
+
+func (m *mockListDeletedArticlesUseCase) Execute(ctx context.Context, orgID int64, limit, offset int) (*dto.ListArticlesResponse, error) {
+	args := m.Called(ctx, orgID, limit, offset)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.ListArticlesResponse), args.Error(1)
+}
+
+// mockRestoreArticleUseCase is a mock implementation of RestoreArticleUseCase
+type mockRestoreArticleUseCase struct {
+	mock.Mock
+}
+
+func (m *mockRestoreArticleUseCase) Execute(ctx context.Context, orgID, id int64) (*dto.ArticleResponse, error) {
+	args := m.Called(ctx, orgID, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.ArticleResponse), args.Error(1)
+}
+
+func TestTrashHandler_List(t *testing.T) {
+	listUC := &mockListDeletedArticlesUseCase{}
+	handler := NewTrashHandler(listUC, nil)
+	listUC.On("Execute", mock.Anything, testActor.OrgID, 10, 0).Return(&dto.ListArticlesResponse{Limit: 10}, nil)
+
+	router := setupTestRouterAs(1, "admin")
+	router.GET("/admin/trash/articles", handler.List)
+
+	req := httptest.NewRequest(http.MethodGet, "/admin/trash/articles", nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	listUC.AssertExpectations(t)
+}
+
+func TestTrashHandler_Restore(t *testing.T) {
+	tests := []struct {
+		name       string
+		err        error
+		wantStatus int
+	}{
+		{name: "restored", wantStatus: http.StatusOK},
+		{name: "not in trash", err: domainarticle.ErrArticleNotFound, wantStatus: http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			restoreUC := &mockRestoreArticleUseCase{}
+			if tt.err != nil {
+				restoreUC.On("Execute", mock.Anything, testActor.OrgID, int64(3)).Return(nil, tt.err)
+			} else {
+				restoreUC.On("Execute", mock.Anything, testActor.OrgID, int64(3)).Return(&dto.ArticleResponse{ID: 3}, nil)
+			}
+			handler := NewTrashHandler(nil, restoreUC)
+
+			router := setupTestRouterAs(1, "admin")
+			router.POST("/admin/trash/articles/:id/restore", handler.Restore)
+
+			req := httptest.NewRequest(http.MethodPost, "/admin/trash/articles/3/restore", nil)
+			w := httptest.NewRecorder()
+
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.wantStatus, w.Code)
+			restoreUC.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,128 @@
+package article
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rulzi/hexa-go/internal/adapters/http/response"
+	"github.com/rulzi/hexa-go/internal/application/article/dto"
+	domainarticle "github.com/rulzi/hexa-go/internal/domain/article"
+)
+
+// TransitionArticleUseCase is the interface for the transition article use case
+type TransitionArticleUseCase interface {
+	Execute(ctx context.Context, actor domainarticle.Actor, id int64, to domainarticle.Status) (*dto.ArticleResponse, error)
+}
+
+// ScheduleArticleUseCase is the interface for the schedule article use case
+type ScheduleArticleUseCase interface {
+	Execute(ctx context.Context, actor domainarticle.Actor, id int64, publishAt *time.Time) (*dto.ArticleResponse, error)
+}
+
+// WorkflowHandler handles HTTP requests moving articles through the editorial workflow
+type WorkflowHandler struct {
+	transitionUseCase TransitionArticleUseCase
+	scheduleUseCase   ScheduleArticleUseCase
+}
+
+// NewWorkflowHandler creates a new WorkflowHandler
+func NewWorkflowHandler(transitionUseCase TransitionArticleUseCase, scheduleUseCase ScheduleArticleUseCase) *WorkflowHandler {
+	return &WorkflowHandler{
+		transitionUseCase: transitionUseCase,
+		scheduleUseCase:   scheduleUseCase,
+	}
+}
+
+// Submit handles POST /articles/:id/submit
+func (h *WorkflowHandler) Submit(c *gin.Context) {
+	h.transition(c, domainarticle.StatusInReview, "Article submitted for review")
+}
+
+// Publish handles POST /articles/:id/publish
+func (h *WorkflowHandler) Publish(c *gin.Context) {
+	h.transition(c, domainarticle.StatusPublished, "Article published successfully")
+}
+
+// Archive handles POST /articles/:id/archive
+func (h *WorkflowHandler) Archive(c *gin.Context) {
+	h.transition(c, domainarticle.StatusArchived, "Article archived successfully")
+}
+
+// Reopen handles POST /articles/:id/reopen, it sends an article in review or archived back to draft
+func (h *WorkflowHandler) Reopen(c *gin.Context) {
+	h.transition(c, domainarticle.StatusDraft, "Article moved back to draft")
+}
+
+// Schedule handles POST /articles/:id/schedule
+func (h *WorkflowHandler) Schedule(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		response.ErrorResponseBadRequest(c, "invalid article id")
+		return
+	}
+
+	var req dto.ScheduleArticleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.ErrorResponseBadRequest(c, err.Error())
+		return
+	}
+
+	h.schedule(c, id, &req.PublishAt, "Article scheduled successfully")
+}
+
+// Unschedule handles DELETE /articles/:id/schedule
+func (h *WorkflowHandler) Unschedule(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		response.ErrorResponseBadRequest(c, "invalid article id")
+		return
+	}
+
+	h.schedule(c, id, nil, "Article schedule cancelled")
+}
+
+// schedule sets or cancels the publish time of the article
+func (h *WorkflowHandler) schedule(c *gin.Context, id int64, publishAt *time.Time, message string) {
+	resp, err := h.scheduleUseCase.Execute(c.Request.Context(), actorFromContext(c), id, publishAt)
+	if err != nil {
+		respondWorkflowError(c, err)
+		return
+	}
+
+	response.SuccessResponseOK(c, message, resp)
+}
+
+// transition moves the article of the request to the given status
+func (h *WorkflowHandler) transition(c *gin.Context, to domainarticle.Status, message string) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		response.ErrorResponseBadRequest(c, "invalid article id")
+		return
+	}
+
+	resp, err := h.transitionUseCase.Execute(c.Request.Context(), actorFromContext(c), id, to)
+	if err != nil {
+		respondWorkflowError(c, err)
+		return
+	}
+
+	response.SuccessResponseOK(c, message, resp)
+}
+
+// respondWorkflowError maps the errors of the workflow use cases to HTTP responses
+func respondWorkflowError(c *gin.Context, err error) {
+	switch err {
+	case domainarticle.ErrArticleNotFound:
+		response.ErrorResponseNotFound(c, err.Error())
+	case domainarticle.ErrForbidden:
+		response.ErrorResponseForbidden(c, err.Error())
+	case domainarticle.ErrInvalidTransition:
+		response.ErrorResponseConflict(c, err.Error())
+	case domainarticle.ErrInvalidStatus, domainarticle.ErrPublishAtInPast:
+		response.ErrorResponseBadRequest(c, err.Error())
+	default:
+		response.ErrorResponseInternalServerError(c, err.Error())
+	}
+}
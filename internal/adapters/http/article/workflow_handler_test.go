@@ -0,0 +1,188 @@
+package article
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rulzi/hexa-go/internal/application/article/dto"
+	domainarticle "github.com/rulzi/hexa-go/internal/domain/article"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// mockTransitionArticleUseCase is a mock implementation of TransitionArticleUseCase
+type mockTransitionArticleUseCase struct {
+	mock.Mock
+}
+
+func (m *mockTransitionArticleUseCase) Execute(ctx context.Context, actor domainarticle.Actor, id int64, to domainarticle.Status) (*dto.ArticleResponse, error) {
+	args := m.Called(ctx, actor, id, to)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.ArticleResponse), args.Error(1)
+}
+
+// mockScheduleArticleUseCase is a mock implementation of ScheduleArticleUseCase
+type mockScheduleArticleUseCase struct {
+	mock.Mock
+}
+
+func (m *mockScheduleArticleUseCase) Execute(ctx context.Context, actor domainarticle.Actor, id int64, publishAt *time.Time) (*dto.ArticleResponse, error) {
+	args := m.Called(ctx, actor, id, publishAt)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.ArticleResponse), args.Error(1)
+}
+
+func TestWorkflowHandler_Routes(t *testing.T) {
+	tests := []struct {
+		name    string
+		path    string
+		handler func(h *WorkflowHandler) gin.HandlerFunc
+		to      domainarticle.Status
+	}{
+		{name: "submit", path: "submit", handler: func(h *WorkflowHandler) gin.HandlerFunc { return h.Submit }, to: domainarticle.StatusInReview},
+		{name: "publish", path: "publish", handler: func(h *WorkflowHandler) gin.HandlerFunc { return h.Publish }, to: domainarticle.StatusPublished},
+		{name: "archive", path: "archive", handler: func(h *WorkflowHandler) gin.HandlerFunc { return h.Archive }, to: domainarticle.StatusArchived},
+		{name: "reopen", path: "reopen", handler: func(h *WorkflowHandler) gin.HandlerFunc { return h.Reopen }, to: domainarticle.StatusDraft},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			transitionUC := &mockTransitionArticleUseCase{}
+			transitionUC.On("Execute", mock.Anything, testActor, int64(3), tt.to).Return(&dto.ArticleResponse{ID: 3, Status: string(tt.to)}, nil)
+			handler := NewWorkflowHandler(transitionUC, nil)
+
+			router := setupTestRouter(nil)
+			router.POST("/articles/:id/"+tt.path, tt.handler(handler))
+
+			req := httptest.NewRequest(http.MethodPost, "/articles/3/"+tt.path, nil)
+			w := httptest.NewRecorder()
+
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, http.StatusOK, w.Code)
+			transitionUC.AssertExpectations(t)
+		})
+	}
+}
+
+func TestWorkflowHandler_Publish_Errors(t *testing.T) {
+	tests := []struct {
+		name       string
+		err        error
+		wantStatus int
+	}{
+		{name: "not found", err: domainarticle.ErrArticleNotFound, wantStatus: http.StatusNotFound},
+		{name: "not a reviewer", err: domainarticle.ErrForbidden, wantStatus: http.StatusForbidden},
+		{name: "not in review", err: domainarticle.ErrInvalidTransition, wantStatus: http.StatusConflict},
+		{name: "database error", err: errors.New("database error"), wantStatus: http.StatusInternalServerError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			transitionUC := &mockTransitionArticleUseCase{}
+			transitionUC.On("Execute", mock.Anything, testActor, int64(3), domainarticle.StatusPublished).Return(nil, tt.err)
+			handler := NewWorkflowHandler(transitionUC, nil)
+
+			router := setupTestRouter(nil)
+			router.POST("/articles/:id/publish", handler.Publish)
+
+			req := httptest.NewRequest(http.MethodPost, "/articles/3/publish", nil)
+			w := httptest.NewRecorder()
+
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.wantStatus, w.Code)
+			transitionUC.AssertExpectations(t)
+		})
+	}
+}
+
+func TestWorkflowHandler_BadRequest_InvalidID(t *testing.T) {
+	transitionUC := &mockTransitionArticleUseCase{}
+	handler := NewWorkflowHandler(transitionUC, nil)
+
+	router := setupTestRouter(nil)
+	router.POST("/articles/:id/submit", handler.Submit)
+
+	req := httptest.NewRequest(http.MethodPost, "/articles/abc/submit", nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	transitionUC.AssertNotCalled(t, "Execute")
+}
+
+func TestWorkflowHandler_Schedule(t *testing.T) {
+	publishAt := time.Date(2030, 1, 5, 9, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name       string
+		body       string
+		err        error
+		wantCall   bool
+		wantStatus int
+	}{
+		{name: "scheduled", body: `{"publish_at":"2030-01-05T09:00:00Z"}`, wantCall: true, wantStatus: http.StatusOK},
+		{name: "time already passed", body: `{"publish_at":"2030-01-05T09:00:00Z"}`, err: domainarticle.ErrPublishAtInPast, wantCall: true, wantStatus: http.StatusBadRequest},
+		{name: "not in review", body: `{"publish_at":"2030-01-05T09:00:00Z"}`, err: domainarticle.ErrInvalidTransition, wantCall: true, wantStatus: http.StatusConflict},
+		{name: "missing time", body: `{}`, wantStatus: http.StatusBadRequest},
+		{name: "invalid time", body: `{"publish_at":"tomorrow"}`, wantStatus: http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			scheduleUC := &mockScheduleArticleUseCase{}
+			if tt.wantCall {
+				call := scheduleUC.On("Execute", mock.Anything, testActor, int64(3), mock.MatchedBy(func(at *time.Time) bool {
+					return at != nil && at.Equal(publishAt)
+				}))
+				if tt.err != nil {
+					call.Return(nil, tt.err)
+				} else {
+					call.Return(&dto.ArticleResponse{ID: 3, PublishAt: &publishAt}, nil)
+				}
+			}
+			handler := NewWorkflowHandler(nil, scheduleUC)
+
+			router := setupTestRouter(nil)
+			router.POST("/articles/:id/schedule", handler.Schedule)
+
+			req := httptest.NewRequest(http.MethodPost, "/articles/3/schedule", bytes.NewBufferString(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.wantStatus, w.Code)
+			scheduleUC.AssertExpectations(t)
+		})
+	}
+}
+
+func TestWorkflowHandler_Unschedule(t *testing.T) {
+	scheduleUC := &mockScheduleArticleUseCase{}
+	scheduleUC.On("Execute", mock.Anything, testActor, int64(3), (*time.Time)(nil)).Return(&dto.ArticleResponse{ID: 3}, nil)
+	handler := NewWorkflowHandler(nil, scheduleUC)
+
+	router := setupTestRouter(nil)
+	router.DELETE("/articles/:id/schedule", handler.Unschedule)
+
+	req := httptest.NewRequest(http.MethodDelete, "/articles/3/schedule", nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	scheduleUC.AssertExpectations(t)
+}
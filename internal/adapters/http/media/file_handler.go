@@ -0,0 +1,63 @@
+package media
+
+import (
+	"context"
+	"io"
+	"log"
+	"mime"
+	"net/http"
+	"path"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rulzi/hexa-go/internal/adapters/http/response"
+	domainmedia "github.com/rulzi/hexa-go/internal/domain/media"
+)
+
+// OpenMediaFileUseCase is the interface for the open media file use case
+type OpenMediaFileUseCase interface {
+	Execute(ctx context.Context, path string) (*domainmedia.Media, io.ReadCloser, error)
+}
+
+// FileHandler serves the stored files of media
+type FileHandler struct {
+	openUseCase OpenMediaFileUseCase
+}
+
+// NewFileHandler creates a new FileHandler
+func NewFileHandler(openUseCase OpenMediaFileUseCase) *FileHandler {
+	return &FileHandler{
+		openUseCase: openUseCase,
+	}
+}
+
+// Serve handles GET /media/files/*path. The file is looked up through its media,
+// so files of media in the trash are not found even though they are still stored.
+func (h *FileHandler) Serve(c *gin.Context) {
+	mediaEntity, file, err := h.openUseCase.Execute(c.Request.Context(), strings.TrimPrefix(c.Param("path"), "/"))
+	if err != nil {
+		if err == domainmedia.ErrMediaNotFound {
+			response.ErrorResponseNotFound(c, err.Error())
+		} else {
+			response.ErrorResponseInternalServerError(c, err.Error())
+		}
+		return
+	}
+	defer func() {
+		if err := file.Close(); err != nil {
+			log.Printf("Failed to close file: %v", err)
+		}
+	}()
+
+	// Files on disk support range and conditional requests
+	if seeker, ok := file.(io.ReadSeeker); ok {
+		http.ServeContent(c.Writer, c.Request, path.Base(mediaEntity.Path), mediaEntity.UpdatedAt, seeker)
+		return
+	}
+
+	contentType := mime.TypeByExtension(path.Ext(mediaEntity.Path))
+	if contentType == "" {
+		contentType = "application/octet-stream"
+	}
+	c.DataFromReader(http.StatusOK, -1, contentType, file, nil)
+}
@@ -0,0 +1,92 @@
+package media
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	domainmedia "github.com/rulzi/hexa-go/internal/domain/media"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// mockOpenMediaFileUseCase is a mock implementation of OpenMediaFileUseCase
+type mockOpenMediaFileUseCase struct {
+	mock.Mock
+}
+
+func (m *mockOpenMediaFileUseCase) Execute(ctx context.Context, path string) (*domainmedia.Media, io.ReadCloser, error) {
+	args := m.Called(ctx, path)
+	if args.Get(0) == nil {
+		return nil, nil, args.Error(2)
+	}
+	return args.Get(0).(*domainmedia.Media), args.Get(1).(io.ReadCloser), args.Error(2)
+}
+
+func TestFileHandler_Serve(t *testing.T) {
+	tests := []struct {
+		name       string
+		setup      func(t *testing.T, uc *mockOpenMediaFileUseCase)
+		wantStatus int
+		wantBody   string
+	}{
+		{
+			name: "file on disk",
+			setup: func(t *testing.T, uc *mockOpenMediaFileUseCase) {
+				filePath := filepath.Join(t.TempDir(), "test.txt")
+				assert.NoError(t, os.WriteFile(filePath, []byte("stored file"), 0o644))
+				file, err := os.Open(filePath)
+				assert.NoError(t, err)
+				uc.On("Execute", mock.Anything, "2025/12/19/test.txt").
+					Return(&domainmedia.Media{ID: 1, Path: "2025/12/19/test.txt", UpdatedAt: time.Now()}, file, nil)
+			},
+			wantStatus: http.StatusOK,
+			wantBody:   "stored file",
+		},
+		{
+			name: "streamed file",
+			setup: func(t *testing.T, uc *mockOpenMediaFileUseCase) {
+				uc.On("Execute", mock.Anything, "2025/12/19/test.txt").
+					Return(&domainmedia.Media{ID: 1, Path: "2025/12/19/test.txt"}, io.NopCloser(strings.NewReader("streamed file")), nil)
+			},
+			wantStatus: http.StatusOK,
+			wantBody:   "streamed file",
+		},
+		{
+			name: "media in the trash",
+			setup: func(t *testing.T, uc *mockOpenMediaFileUseCase) {
+				uc.On("Execute", mock.Anything, "2025/12/19/test.txt").Return(nil, nil, domainmedia.ErrMediaNotFound)
+			},
+			wantStatus: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			openUC := &mockOpenMediaFileUseCase{}
+			handler := NewFileHandler(openUC)
+			tt.setup(t, openUC)
+
+			router := setupTestRouter(nil)
+			router.GET("/media/files/*path", handler.Serve)
+
+			req := httptest.NewRequest(http.MethodGet, "/media/files/2025/12/19/test.txt", nil)
+			w := httptest.NewRecorder()
+
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.wantStatus, w.Code)
+			if tt.wantBody != "" {
+				assert.Equal(t, tt.wantBody, w.Body.String())
+				assert.True(t, strings.HasPrefix(w.Header().Get("Content-Type"), "text/plain"))
+			}
+			openUC.AssertExpectations(t)
+		})
+	}
+}
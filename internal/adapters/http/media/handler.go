@@ -7,6 +7,7 @@ import (
 	"strconv"
 
 	"github.com/gin-gonic/gin"
+	"github.com/rulzi/hexa-go/internal/adapters/http/middleware"
 	"github.com/rulzi/hexa-go/internal/adapters/http/response"
 	"github.com/rulzi/hexa-go/internal/application/media/dto"
 	domainmedia "github.com/rulzi/hexa-go/internal/domain/media"
@@ -14,27 +15,27 @@ import (
 
 // CreateMediaUseCase is the interface for the create media use case
 type CreateMediaUseCase interface {
-	Execute(ctx context.Context, filename string, file io.Reader) (*dto.MediaResponse, error)
+	Execute(ctx context.Context, ownerID, actorID, orgID int64, filename string, file io.Reader) (*dto.MediaResponse, error)
 }
 
 // GetMediaUseCase is the interface for the get media use case
 type GetMediaUseCase interface {
-	Execute(ctx context.Context, id int64) (*dto.MediaResponse, error)
+	Execute(ctx context.Context, orgID, id int64) (*dto.MediaResponse, error)
 }
 
 // ListMediaUseCase is the interface for the list media use case
 type ListMediaUseCase interface {
-	Execute(ctx context.Context, limit, offset int) (*dto.ListMediaResponse, error)
+	Execute(ctx context.Context, orgID int64, limit, offset int) (*dto.ListMediaResponse, error)
 }
 
 // UpdateMediaUseCase is the interface for the update media use case
 type UpdateMediaUseCase interface {
-	Execute(ctx context.Context, id int64, filename string, file io.Reader) (*dto.MediaResponse, error)
+	Execute(ctx context.Context, actorID, orgID, id int64, filename string, file io.Reader) (*dto.MediaResponse, error)
 }
 
 // DeleteMediaUseCase is the interface for the delete media use case
 type DeleteMediaUseCase interface {
-	Execute(ctx context.Context, id int64) error
+	Execute(ctx context.Context, orgID, id int64) error
 }
 
 // Handler handles HTTP requests for media
@@ -85,7 +86,7 @@ func (h *Handler) Create(c *gin.Context) {
 	}()
 
 	// Execute use case
-	resp, err := h.createUseCase.Execute(c.Request.Context(), file.Filename, src)
+	resp, err := h.createUseCase.Execute(c.Request.Context(), c.GetInt64("user_id"), middleware.ActorID(c), c.GetInt64("org_id"), file.Filename, src)
 	if err != nil {
 		if err == domainmedia.ErrNameRequired || err == domainmedia.ErrPathRequired {
 			response.ErrorResponseBadRequest(c, err.Error())
@@ -106,7 +107,7 @@ func (h *Handler) Get(c *gin.Context) {
 		return
 	}
 
-	resp, err := h.getUseCase.Execute(c.Request.Context(), id)
+	resp, err := h.getUseCase.Execute(c.Request.Context(), c.GetInt64("org_id"), id)
 	if err != nil {
 		if err == domainmedia.ErrMediaNotFound {
 			response.ErrorResponseNotFound(c, err.Error())
@@ -124,7 +125,7 @@ func (h *Handler) List(c *gin.Context) {
 	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "10"))
 	offset, _ := strconv.Atoi(c.DefaultQuery("offset", "0"))
 
-	resp, err := h.listUseCase.Execute(c.Request.Context(), limit, offset)
+	resp, err := h.listUseCase.Execute(c.Request.Context(), c.GetInt64("org_id"), limit, offset)
 	if err != nil {
 		response.ErrorResponseInternalServerError(c, err.Error())
 		return
@@ -160,7 +161,7 @@ func (h *Handler) Update(c *gin.Context) {
 		}
 	}()
 
-	resp, err := h.updateUseCase.Execute(c.Request.Context(), id, file.Filename, src)
+	resp, err := h.updateUseCase.Execute(c.Request.Context(), middleware.ActorID(c), c.GetInt64("org_id"), id, file.Filename, src)
 	if err != nil {
 		switch err {
 		case domainmedia.ErrMediaNotFound:
@@ -184,7 +185,7 @@ func (h *Handler) Delete(c *gin.Context) {
 		return
 	}
 
-	err = h.deleteUseCase.Execute(c.Request.Context(), id)
+	err = h.deleteUseCase.Execute(c.Request.Context(), c.GetInt64("org_id"), id)
 	if err != nil {
 		if err == domainmedia.ErrMediaNotFound {
 			response.ErrorResponseNotFound(c, err.Error())
@@ -24,8 +24,8 @@ type mockCreateMediaUseCase struct {
 	mock.Mock
 }
 
-func (m *mockCreateMediaUseCase) Execute(ctx context.Context, filename string, file io.Reader) (*dto.MediaResponse, error) {
-	args := m.Called(ctx, filename, file)
+func (m *mockCreateMediaUseCase) Execute(ctx context.Context, ownerID, actorID, orgID int64, filename string, file io.Reader) (*dto.MediaResponse, error) {
+	args := m.Called(ctx, ownerID, actorID, orgID, filename, file)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
@@ -37,8 +37,8 @@ type mockGetMediaUseCase struct {
 	mock.Mock
 }
 
-func (m *mockGetMediaUseCase) Execute(ctx context.Context, id int64) (*dto.MediaResponse, error) {
-	args := m.Called(ctx, id)
+func (m *mockGetMediaUseCase) Execute(ctx context.Context, orgID, id int64) (*dto.MediaResponse, error) {
+	args := m.Called(ctx, orgID, id)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
@@ -50,8 +50,8 @@ type mockListMediaUseCase struct {
 	mock.Mock
 }
 
-func (m *mockListMediaUseCase) Execute(ctx context.Context, limit, offset int) (*dto.ListMediaResponse, error) {
-	args := m.Called(ctx, limit, offset)
+func (m *mockListMediaUseCase) Execute(ctx context.Context, orgID int64, limit, offset int) (*dto.ListMediaResponse, error) {
+	args := m.Called(ctx, orgID, limit, offset)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
@@ -63,8 +63,8 @@ type mockUpdateMediaUseCase struct {
 	mock.Mock
 }
 
-func (m *mockUpdateMediaUseCase) Execute(ctx context.Context, id int64, filename string, file io.Reader) (*dto.MediaResponse, error) {
-	args := m.Called(ctx, id, filename, file)
+func (m *mockUpdateMediaUseCase) Execute(ctx context.Context, actorID, orgID, id int64, filename string, file io.Reader) (*dto.MediaResponse, error) {
+	args := m.Called(ctx, actorID, orgID, id, filename, file)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
@@ -76,14 +76,21 @@ type mockDeleteMediaUseCase struct {
 	mock.Mock
 }
 
-func (m *mockDeleteMediaUseCase) Execute(ctx context.Context, id int64) error {
-	args := m.Called(ctx, id)
+func (m *mockDeleteMediaUseCase) Execute(ctx context.Context, orgID, id int64) error {
+	args := m.Called(ctx, orgID, id)
 	return args.Error(0)
 }
 
+// testOrgID is the organization every test request is signed in to
+const testOrgID = int64(1)
+
 func setupTestRouter(handler *Handler) *gin.Engine {
 	gin.SetMode(gin.TestMode)
 	router := gin.New()
+	router.Use(func(c *gin.Context) {
+		c.Set("org_id", testOrgID)
+		c.Next()
+	})
 	return router
 }
 
@@ -152,7 +159,7 @@ func TestHandler_Create_Success(t *testing.T) {
 	assert.NoError(t, err)
 
 	// Mock expects the file content to be read
-	createUC.On("Execute", mock.Anything, filename, mock.Anything).Return(expectedResp, nil)
+	createUC.On("Execute", mock.Anything, mock.Anything, mock.Anything, testOrgID, filename, mock.Anything).Return(expectedResp, nil)
 
 	router := setupTestRouter(handler)
 	router.POST("/media", handler.Create)
@@ -211,7 +218,7 @@ func TestHandler_Create_BadRequest_ValidationError(t *testing.T) {
 	body, contentType, err := createMultipartFormData(filename, fileContent)
 	assert.NoError(t, err)
 
-	createUC.On("Execute", mock.Anything, filename, mock.Anything).Return(nil, domainmedia.ErrNameRequired)
+	createUC.On("Execute", mock.Anything, mock.Anything, mock.Anything, testOrgID, filename, mock.Anything).Return(nil, domainmedia.ErrNameRequired)
 
 	router := setupTestRouter(handler)
 	router.POST("/media", handler.Create)
@@ -242,7 +249,7 @@ func TestHandler_Create_InternalServerError(t *testing.T) {
 	body, contentType, err := createMultipartFormData(filename, fileContent)
 	assert.NoError(t, err)
 
-	createUC.On("Execute", mock.Anything, filename, mock.Anything).Return(nil, errors.New("storage error"))
+	createUC.On("Execute", mock.Anything, mock.Anything, mock.Anything, testOrgID, filename, mock.Anything).Return(nil, errors.New("storage error"))
 
 	router := setupTestRouter(handler)
 	router.POST("/media", handler.Create)
@@ -276,7 +283,7 @@ func TestHandler_Get_Success(t *testing.T) {
 		UpdatedAt: time.Now(),
 	}
 
-	getUC.On("Execute", mock.Anything, mediaID).Return(expectedResp, nil)
+	getUC.On("Execute", mock.Anything, testOrgID, mediaID).Return(expectedResp, nil)
 
 	router := setupTestRouter(handler)
 	router.GET("/media/:id", handler.Get)
@@ -327,7 +334,7 @@ func TestHandler_Get_NotFound(t *testing.T) {
 	handler := NewHandler(createUC, getUC, listUC, updateUC, deleteUC)
 
 	mediaID := int64(999)
-	getUC.On("Execute", mock.Anything, mediaID).Return(nil, domainmedia.ErrMediaNotFound)
+	getUC.On("Execute", mock.Anything, testOrgID, mediaID).Return(nil, domainmedia.ErrMediaNotFound)
 
 	router := setupTestRouter(handler)
 	router.GET("/media/:id", handler.Get)
@@ -351,7 +358,7 @@ func TestHandler_Get_InternalServerError(t *testing.T) {
 	handler := NewHandler(createUC, getUC, listUC, updateUC, deleteUC)
 
 	mediaID := int64(1)
-	getUC.On("Execute", mock.Anything, mediaID).Return(nil, errors.New("database error"))
+	getUC.On("Execute", mock.Anything, testOrgID, mediaID).Return(nil, errors.New("database error"))
 
 	router := setupTestRouter(handler)
 	router.GET("/media/:id", handler.Get)
@@ -392,7 +399,7 @@ func TestHandler_List_Success(t *testing.T) {
 		Offset: offset,
 	}
 
-	listUC.On("Execute", mock.Anything, limit, offset).Return(expectedResp, nil)
+	listUC.On("Execute", mock.Anything, testOrgID, limit, offset).Return(expectedResp, nil)
 
 	router := setupTestRouter(handler)
 	router.GET("/media", handler.List)
@@ -430,7 +437,7 @@ func TestHandler_List_WithQueryParams(t *testing.T) {
 		Offset: offset,
 	}
 
-	listUC.On("Execute", mock.Anything, limit, offset).Return(expectedResp, nil)
+	listUC.On("Execute", mock.Anything, testOrgID, limit, offset).Return(expectedResp, nil)
 
 	router := setupTestRouter(handler)
 	router.GET("/media", handler.List)
@@ -455,7 +462,7 @@ func TestHandler_List_InternalServerError(t *testing.T) {
 
 	limit := 10
 	offset := 0
-	listUC.On("Execute", mock.Anything, limit, offset).Return(nil, errors.New("database error"))
+	listUC.On("Execute", mock.Anything, testOrgID, limit, offset).Return(nil, errors.New("database error"))
 
 	router := setupTestRouter(handler)
 	router.GET("/media", handler.List)
@@ -494,7 +501,7 @@ func TestHandler_Update_Success(t *testing.T) {
 	body, contentType, err := createMultipartFormData(filename, fileContent)
 	assert.NoError(t, err)
 
-	updateUC.On("Execute", mock.Anything, mediaID, filename, mock.Anything).Return(expectedResp, nil)
+	updateUC.On("Execute", mock.Anything, mock.Anything, testOrgID, mediaID, filename, mock.Anything).Return(expectedResp, nil)
 
 	router := setupTestRouter(handler)
 	router.PUT("/media/:id", handler.Update)
@@ -515,6 +522,46 @@ func TestHandler_Update_Success(t *testing.T) {
 	assert.Equal(t, "Media updated successfully", response["message"])
 }
 
+func TestHandler_Impersonation_RecordsActor(t *testing.T) {
+	createUC := &mockCreateMediaUseCase{}
+	updateUC := &mockUpdateMediaUseCase{}
+
+	handler := NewHandler(createUC, &mockGetMediaUseCase{}, &mockListMediaUseCase{}, updateUC, &mockDeleteMediaUseCase{})
+
+	// The impersonated user owns the upload, the admin is recorded as the actor
+	createUC.On("Execute", mock.Anything, int64(7), int64(1), testOrgID, "test.jpg", mock.Anything).Return(&dto.MediaResponse{ID: 1}, nil)
+	updateUC.On("Execute", mock.Anything, int64(1), testOrgID, int64(1), "test.jpg", mock.Anything).Return(&dto.MediaResponse{ID: 1}, nil)
+
+	router := setupTestRouter(handler)
+	router.Use(func(c *gin.Context) {
+		c.Set("user_id", int64(7))
+		c.Set("actor_id", int64(1))
+		c.Set("impersonated_by", int64(1))
+		c.Next()
+	})
+	router.POST("/media", handler.Create)
+	router.PUT("/media/:id", handler.Update)
+
+	body, contentType, err := createMultipartFormData("test.jpg", "test file content")
+	assert.NoError(t, err)
+	req := httptest.NewRequest(http.MethodPost, "/media", body)
+	req.Header.Set("Content-Type", contentType)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusCreated, w.Code)
+
+	body, contentType, err = createMultipartFormData("test.jpg", "test file content")
+	assert.NoError(t, err)
+	req = httptest.NewRequest(http.MethodPut, "/media/1", body)
+	req.Header.Set("Content-Type", contentType)
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	createUC.AssertExpectations(t)
+	updateUC.AssertExpectations(t)
+}
+
 func TestHandler_Update_BadRequest_InvalidID(t *testing.T) {
 	createUC := &mockCreateMediaUseCase{}
 	getUC := &mockGetMediaUseCase{}
@@ -583,7 +630,7 @@ func TestHandler_Update_BadRequest_ValidationError(t *testing.T) {
 	body, contentType, err := createMultipartFormData(filename, fileContent)
 	assert.NoError(t, err)
 
-	updateUC.On("Execute", mock.Anything, mediaID, filename, mock.Anything).Return(nil, domainmedia.ErrNameRequired)
+	updateUC.On("Execute", mock.Anything, mock.Anything, testOrgID, mediaID, filename, mock.Anything).Return(nil, domainmedia.ErrNameRequired)
 
 	router := setupTestRouter(handler)
 	router.PUT("/media/:id", handler.Update)
@@ -615,7 +662,7 @@ func TestHandler_Update_NotFound(t *testing.T) {
 	body, contentType, err := createMultipartFormData(filename, fileContent)
 	assert.NoError(t, err)
 
-	updateUC.On("Execute", mock.Anything, mediaID, filename, mock.Anything).Return(nil, domainmedia.ErrMediaNotFound)
+	updateUC.On("Execute", mock.Anything, mock.Anything, testOrgID, mediaID, filename, mock.Anything).Return(nil, domainmedia.ErrMediaNotFound)
 
 	router := setupTestRouter(handler)
 	router.PUT("/media/:id", handler.Update)
@@ -647,7 +694,7 @@ func TestHandler_Update_InternalServerError(t *testing.T) {
 	body, contentType, err := createMultipartFormData(filename, fileContent)
 	assert.NoError(t, err)
 
-	updateUC.On("Execute", mock.Anything, mediaID, filename, mock.Anything).Return(nil, errors.New("storage error"))
+	updateUC.On("Execute", mock.Anything, mock.Anything, testOrgID, mediaID, filename, mock.Anything).Return(nil, errors.New("storage error"))
 
 	router := setupTestRouter(handler)
 	router.PUT("/media/:id", handler.Update)
@@ -672,7 +719,7 @@ func TestHandler_Delete_Success(t *testing.T) {
 	handler := NewHandler(createUC, getUC, listUC, updateUC, deleteUC)
 
 	mediaID := int64(1)
-	deleteUC.On("Execute", mock.Anything, mediaID).Return(nil)
+	deleteUC.On("Execute", mock.Anything, testOrgID, mediaID).Return(nil)
 
 	router := setupTestRouter(handler)
 	router.DELETE("/media/:id", handler.Delete)
@@ -723,7 +770,7 @@ func TestHandler_Delete_NotFound(t *testing.T) {
 	handler := NewHandler(createUC, getUC, listUC, updateUC, deleteUC)
 
 	mediaID := int64(999)
-	deleteUC.On("Execute", mock.Anything, mediaID).Return(domainmedia.ErrMediaNotFound)
+	deleteUC.On("Execute", mock.Anything, testOrgID, mediaID).Return(domainmedia.ErrMediaNotFound)
 
 	router := setupTestRouter(handler)
 	router.DELETE("/media/:id", handler.Delete)
@@ -747,7 +794,7 @@ func TestHandler_Delete_InternalServerError(t *testing.T) {
 	handler := NewHandler(createUC, getUC, listUC, updateUC, deleteUC)
 
 	mediaID := int64(1)
-	deleteUC.On("Execute", mock.Anything, mediaID).Return(errors.New("database error"))
+	deleteUC.On("Execute", mock.Anything, testOrgID, mediaID).Return(errors.New("database error"))
 
 	router := setupTestRouter(handler)
 	router.DELETE("/media/:id", handler.Delete)
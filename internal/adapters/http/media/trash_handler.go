@@ -0,0 +1,70 @@
+package media
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rulzi/hexa-go/internal/adapters/http/response"
+	"github.com/rulzi/hexa-go/internal/application/media/dto"
+	domainmedia "github.com/rulzi/hexa-go/internal/domain/media"
+)
+
+// ListDeletedMediaUseCase is the interface for the list deleted media use case
+type ListDeletedMediaUseCase interface {
+	Execute(ctx context.Context, orgID int64, limit, offset int) (*dto.ListMediaResponse, error)
+}
+
+// RestoreMediaUseCase is the interface for the restore media use case
+type RestoreMediaUseCase interface {
+	Execute(ctx context.Context, orgID, id int64) (*dto.MediaResponse, error)
+}
+
+// TrashHandler handles HTTP requests for the media in the trash
+type TrashHandler struct {
+	listUseCase    ListDeletedMediaUseCase
+	restoreUseCase RestoreMediaUseCase
+}
+
+// NewTrashHandler creates a new TrashHandler
+func NewTrashHandler(listUseCase ListDeletedMediaUseCase, restoreUseCase RestoreMediaUseCase) *TrashHandler {
+	return &TrashHandler{
+		listUseCase:    listUseCase,
+		restoreUseCase: restoreUseCase,
+	}
+}
+
+// List handles GET /admin/trash/media
+func (h *TrashHandler) List(c *gin.Context) {
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "10"))
+	offset, _ := strconv.Atoi(c.DefaultQuery("offset", "0"))
+
+	resp, err := h.listUseCase.Execute(c.Request.Context(), c.GetInt64("org_id"), limit, offset)
+	if err != nil {
+		response.ErrorResponseInternalServerError(c, err.Error())
+		return
+	}
+
+	response.SuccessResponseOK(c, "Deleted media retrieved successfully", resp)
+}
+
+// Restore handles POST /admin/trash/media/:id/restore
+func (h *TrashHandler) Restore(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		response.ErrorResponseBadRequest(c, "invalid media id")
+		return
+	}
+
+	resp, err := h.restoreUseCase.Execute(c.Request.Context(), c.GetInt64("org_id"), id)
+	if err != nil {
+		if err == domainmedia.ErrMediaNotFound {
+			response.ErrorResponseNotFound(c, err.Error())
+		} else {
+			response.ErrorResponseInternalServerError(c, err.Error())
+		}
+		return
+	}
+
+	response.SuccessResponseOK(c, "Media restored successfully", resp)
+}
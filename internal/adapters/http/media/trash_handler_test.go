@@ -0,0 +1,90 @@
+package media
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/rulzi/hexa-go/internal/application/media/dto"
+	domainmedia "github.com/rulzi/hexa-go/internal/domain/media"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// mockListDeletedMediaUseCase is a mock implementation of ListDeletedMediaUseCase
+type mockListDeletedMediaUseCase struct {
+	mock.Mock
+}
+
+func (m *mockListDeletedMediaUseCase) Execute(ctx context.Context, orgID int64, limit, offset int) (*dto.ListMediaResponse, error) {
+	args := m.Called(ctx, orgID, limit, offset)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.ListMediaResponse), args.Error(1)
+}
+
+// mockRestoreMediaUseCase is a mock implementation of RestoreMediaUseCase
+type mockRestoreMediaUseCase struct {
+	mock.Mock
+}
+
+func (m *mockRestoreMediaUseCase) Execute(ctx context.Context, orgID, id int64) (*dto.MediaResponse, error) {
+	args := m.Called(ctx, orgID, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.MediaResponse), args.Error(1)
+}
+
+func TestTrashHandler_List(t *testing.T) {
+	listUC := &mockListDeletedMediaUseCase{}
+	handler := NewTrashHandler(listUC, nil)
+	listUC.On("Execute", mock.Anything, testOrgID, 10, 0).Return(&dto.ListMediaResponse{Limit: 10}, nil)
+
+	router := setupTestRouter(nil)
+	router.GET("/admin/trash/media", handler.List)
+
+	req := httptest.NewRequest(http.MethodGet, "/admin/trash/media", nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	listUC.AssertExpectations(t)
+}
+
+func TestTrashHandler_Restore(t *testing.T) {
+	tests := []struct {
+		name       string
+		err        error
+		wantStatus int
+	}{
+		{name: "restored", wantStatus: http.StatusOK},
+		{name: "not in trash", err: domainmedia.ErrMediaNotFound, wantStatus: http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			restoreUC := &mockRestoreMediaUseCase{}
+			if tt.err != nil {
+				restoreUC.On("Execute", mock.Anything, testOrgID, int64(3)).Return(nil, tt.err)
+			} else {
+				restoreUC.On("Execute", mock.Anything, testOrgID, int64(3)).Return(&dto.MediaResponse{ID: 3}, nil)
+			}
+			handler := NewTrashHandler(nil, restoreUC)
+
+			router := setupTestRouter(nil)
+			router.POST("/admin/trash/media/:id/restore", handler.Restore)
+
+			req := httptest.NewRequest(http.MethodPost, "/admin/trash/media/3/restore", nil)
+			w := httptest.NewRecorder()
+
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.wantStatus, w.Code)
+			restoreUC.AssertExpectations(t)
+		})
+	}
+}
@@ -1,6 +1,9 @@
 package middleware
 
 import (
+	"context"
+	"log"
+	"strconv"
 	"strings"
 
 	"github.com/gin-gonic/gin"
@@ -8,9 +11,44 @@ import (
 	domainuser "github.com/rulzi/hexa-go/internal/domain/user"
 )
 
-// AuthMiddleware creates a middleware for JWT authentication
-func AuthMiddleware(tokenValidator domainuser.TokenValidator) gin.HandlerFunc {
+// APIKeyAuthenticator resolves an API key to the claims of its owner
+type APIKeyAuthenticator interface {
+	Authenticate(ctx context.Context, key string) (*domainuser.TokenClaims, error)
+}
+
+// ImpersonationHeader marks the responses to requests made with an impersonation token, its value is the real actor
+const ImpersonationHeader = "X-Impersonated-By"
+
+// Authentication methods stored in the context under "auth_method"
+const (
+	AuthMethodBearer = "bearer"
+	AuthMethodAPIKey = "api_key"
+)
+
+// AuthMiddleware creates a middleware for JWT or API key authentication.
+// Tokens revoked through the revocation store, on their own or with their session,
+// are rejected when one is provided.
+// API keys in the X-API-Key header are only accepted when an authenticator is provided.
+// Requests made with an impersonation token are logged with the admin behind them.
+func AuthMiddleware(
+	tokenValidator domainuser.TokenValidator,
+	revocations domainuser.TokenRevocationStore,
+	apiKeys APIKeyAuthenticator,
+) gin.HandlerFunc {
 	return func(c *gin.Context) {
+		if apiKey := c.GetHeader("X-API-Key"); apiKey != "" && apiKeys != nil {
+			claims, err := apiKeys.Authenticate(c.Request.Context(), apiKey)
+			if err != nil {
+				response.ErrorResponseUnauthorized(c, domainuser.ErrInvalidAPIKey.Error())
+				c.Abort()
+				return
+			}
+
+			setClaims(c, claims, AuthMethodAPIKey)
+			c.Next()
+			return
+		}
+
 		authHeader := c.GetHeader("Authorization")
 		if authHeader == "" {
 			response.ErrorResponseUnauthorized(c, "authorization header is required")
@@ -36,9 +74,78 @@ func AuthMiddleware(tokenValidator domainuser.TokenValidator) gin.HandlerFunc {
 			return
 		}
 
-		// Set user info in context
-		c.Set("user_id", claims.UserID)
-		c.Set("user_email", claims.Email)
+		// Reject revoked tokens and tokens of revoked sessions
+		if revocations != nil && claims.TokenID != "" {
+			revoked, err := revocations.IsRevoked(c.Request.Context(), claims.TokenID)
+			if err != nil || revoked {
+				response.ErrorResponseUnauthorized(c, domainuser.ErrTokenRevoked.Error())
+				c.Abort()
+				return
+			}
+		}
+		if revocations != nil && claims.SessionID != "" {
+			revoked, err := revocations.IsRevoked(c.Request.Context(), domainuser.SessionRevocationID(claims.SessionID))
+			if err != nil || revoked {
+				response.ErrorResponseUnauthorized(c, domainuser.ErrSessionRevoked.Error())
+				c.Abort()
+				return
+			}
+		}
+
+		setClaims(c, claims, AuthMethodBearer)
+		c.Next()
+
+		if claims.IsImpersonation() {
+			log.Printf("Impersonation: actor %d as user %d in organization %d: %s %s %d",
+				claims.ActorID, claims.UserID, claims.OrgID, c.Request.Method, c.Request.URL.Path, c.Writer.Status())
+		}
+	}
+}
+
+// setClaims puts the authenticated user in the context, the same way for every authentication method
+func setClaims(c *gin.Context, claims *domainuser.TokenClaims, method string) {
+	c.Set("user_id", claims.UserID)
+	c.Set("user_email", claims.Email)
+	c.Set("user_role", string(claims.Role))
+	c.Set("org_id", claims.OrgID)
+	c.Set("email_verified", claims.EmailVerified)
+	c.Set("token_id", claims.TokenID)
+	c.Set("token_expires_at", claims.ExpiresAt)
+	c.Set("session_id", claims.SessionID)
+	c.Set("user_scopes", claims.Scopes)
+	c.Set("auth_method", method)
+	c.Set("actor_id", claims.UserID)
+	if claims.IsImpersonation() {
+		c.Set("actor_id", claims.ActorID)
+		c.Set("impersonated_by", claims.ActorID)
+		c.Header(ImpersonationHeader, strconv.FormatInt(claims.ActorID, 10))
+	}
+}
+
+// ActorID returns the user really making the request: the admin behind an impersonation token,
+// the authenticated user otherwise. Records of who did something store the actor.
+func ActorID(c *gin.Context) int64 {
+	if actorID := c.GetInt64("actor_id"); actorID != 0 {
+		return actorID
+	}
+	return c.GetInt64("user_id")
+}
+
+// RequireBearerToken creates a middleware that rejects requests authenticated with an API key.
+// It guards actions a leaked key must not be able to perform, such as creating more keys.
+// Impersonation tokens are rejected as well, admins acting as a user can't change their account.
+func RequireBearerToken() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if c.GetString("auth_method") == AuthMethodAPIKey {
+			response.ErrorResponseForbidden(c, "api keys cannot be used for this action")
+			c.Abort()
+			return
+		}
+		if c.GetInt64("impersonated_by") != 0 {
+			response.ErrorResponseForbidden(c, "impersonation tokens cannot be used for this action")
+			c.Abort()
+			return
+		}
 
 		c.Next()
 	}
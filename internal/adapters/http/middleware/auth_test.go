@@ -1,11 +1,13 @@
 package middleware
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	domainuser "github.com/rulzi/hexa-go/internal/domain/user"
@@ -26,6 +28,21 @@ func (m *mockTokenValidator) Validate(token string) (*domainuser.TokenClaims, er
 	return args.Get(0).(*domainuser.TokenClaims), args.Error(1)
 }
 
+// mockTokenRevocationStore is a mock implementation of TokenRevocationStore
+type mockTokenRevocationStore struct {
+	mock.Mock
+}
+
+func (m *mockTokenRevocationStore) Revoke(ctx context.Context, tokenID string, expiresAt time.Time) error {
+	args := m.Called(ctx, tokenID, expiresAt)
+	return args.Error(0)
+}
+
+func (m *mockTokenRevocationStore) IsRevoked(ctx context.Context, tokenID string) (bool, error) {
+	args := m.Called(ctx, tokenID)
+	return args.Bool(0), args.Error(1)
+}
+
 func setupTestRouter(middleware gin.HandlerFunc) *gin.Engine {
 	gin.SetMode(gin.TestMode)
 	router := gin.New()
@@ -38,7 +55,7 @@ func setupTestRouter(middleware gin.HandlerFunc) *gin.Engine {
 
 func TestAuthMiddleware_Success(t *testing.T) {
 	mockValidator := &mockTokenValidator{}
-	middleware := AuthMiddleware(mockValidator)
+	middleware := AuthMiddleware(mockValidator, nil, nil)
 
 	expectedClaims := &domainuser.TokenClaims{
 		UserID: 1,
@@ -67,7 +84,7 @@ func TestAuthMiddleware_Success(t *testing.T) {
 
 func TestAuthMiddleware_MissingAuthorizationHeader(t *testing.T) {
 	mockValidator := &mockTokenValidator{}
-	middleware := AuthMiddleware(mockValidator)
+	middleware := AuthMiddleware(mockValidator, nil, nil)
 
 	router := setupTestRouter(middleware)
 	req := httptest.NewRequest(http.MethodGet, "/test", nil)
@@ -88,7 +105,7 @@ func TestAuthMiddleware_MissingAuthorizationHeader(t *testing.T) {
 
 func TestAuthMiddleware_EmptyAuthorizationHeader(t *testing.T) {
 	mockValidator := &mockTokenValidator{}
-	middleware := AuthMiddleware(mockValidator)
+	middleware := AuthMiddleware(mockValidator, nil, nil)
 
 	router := setupTestRouter(middleware)
 	req := httptest.NewRequest(http.MethodGet, "/test", nil)
@@ -109,7 +126,7 @@ func TestAuthMiddleware_EmptyAuthorizationHeader(t *testing.T) {
 
 func TestAuthMiddleware_InvalidFormat_NoBearer(t *testing.T) {
 	mockValidator := &mockTokenValidator{}
-	middleware := AuthMiddleware(mockValidator)
+	middleware := AuthMiddleware(mockValidator, nil, nil)
 
 	router := setupTestRouter(middleware)
 	req := httptest.NewRequest(http.MethodGet, "/test", nil)
@@ -130,7 +147,7 @@ func TestAuthMiddleware_InvalidFormat_NoBearer(t *testing.T) {
 
 func TestAuthMiddleware_InvalidFormat_NoSpace(t *testing.T) {
 	mockValidator := &mockTokenValidator{}
-	middleware := AuthMiddleware(mockValidator)
+	middleware := AuthMiddleware(mockValidator, nil, nil)
 
 	router := setupTestRouter(middleware)
 	req := httptest.NewRequest(http.MethodGet, "/test", nil)
@@ -151,7 +168,7 @@ func TestAuthMiddleware_InvalidFormat_NoSpace(t *testing.T) {
 
 func TestAuthMiddleware_InvalidFormat_TooManyParts(t *testing.T) {
 	mockValidator := &mockTokenValidator{}
-	middleware := AuthMiddleware(mockValidator)
+	middleware := AuthMiddleware(mockValidator, nil, nil)
 
 	router := setupTestRouter(middleware)
 	req := httptest.NewRequest(http.MethodGet, "/test", nil)
@@ -172,7 +189,7 @@ func TestAuthMiddleware_InvalidFormat_TooManyParts(t *testing.T) {
 
 func TestAuthMiddleware_InvalidFormat_WrongPrefix(t *testing.T) {
 	mockValidator := &mockTokenValidator{}
-	middleware := AuthMiddleware(mockValidator)
+	middleware := AuthMiddleware(mockValidator, nil, nil)
 
 	router := setupTestRouter(middleware)
 	req := httptest.NewRequest(http.MethodGet, "/test", nil)
@@ -193,7 +210,7 @@ func TestAuthMiddleware_InvalidFormat_WrongPrefix(t *testing.T) {
 
 func TestAuthMiddleware_InvalidToken(t *testing.T) {
 	mockValidator := &mockTokenValidator{}
-	middleware := AuthMiddleware(mockValidator)
+	middleware := AuthMiddleware(mockValidator, nil, nil)
 
 	mockValidator.On("Validate", "invalid-token").Return(nil, errors.New("token expired"))
 
@@ -216,7 +233,7 @@ func TestAuthMiddleware_InvalidToken(t *testing.T) {
 
 func TestAuthMiddleware_ContextValuesSet(t *testing.T) {
 	mockValidator := &mockTokenValidator{}
-	middleware := AuthMiddleware(mockValidator)
+	middleware := AuthMiddleware(mockValidator, nil, nil)
 
 	expectedClaims := &domainuser.TokenClaims{
 		UserID: 123,
@@ -259,9 +276,43 @@ func TestAuthMiddleware_ContextValuesSet(t *testing.T) {
 	assert.Equal(t, "user@example.com", response["user_email"])
 }
 
+func TestAuthMiddleware_Impersonation(t *testing.T) {
+	mockValidator := &mockTokenValidator{}
+	middleware := AuthMiddleware(mockValidator, nil, nil)
+
+	mockValidator.On("Validate", "impersonation-token").Return(&domainuser.TokenClaims{UserID: 5, OrgID: 2, ActorID: 1}, nil)
+	mockValidator.On("Validate", "valid-token").Return(&domainuser.TokenClaims{UserID: 5, OrgID: 2}, nil)
+
+	router := gin.New()
+	router.Use(middleware)
+	router.GET("/test", func(c *gin.Context) {
+		c.JSON(http.StatusOK, gin.H{"user_id": c.GetInt64("user_id"), "actor_id": ActorID(c)})
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/test", nil)
+	req.Header.Set("Authorization", "Bearer impersonation-token")
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "1", w.Header().Get(ImpersonationHeader))
+	assert.JSONEq(t, `{"user_id":5,"actor_id":1}`, w.Body.String())
+
+	// Regular tokens act as the user and aren't marked
+	req = httptest.NewRequest(http.MethodGet, "/test", nil)
+	req.Header.Set("Authorization", "Bearer valid-token")
+	w = httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Empty(t, w.Header().Get(ImpersonationHeader))
+	assert.JSONEq(t, `{"user_id":5,"actor_id":5}`, w.Body.String())
+}
+
 func TestAuthMiddleware_AbortsOnError(t *testing.T) {
 	mockValidator := &mockTokenValidator{}
-	middleware := AuthMiddleware(mockValidator)
+	middleware := AuthMiddleware(mockValidator, nil, nil)
 
 	router := gin.New()
 	router.Use(middleware)
@@ -290,7 +341,7 @@ func TestAuthMiddleware_AbortsOnError(t *testing.T) {
 
 func TestAuthMiddleware_CaseSensitiveBearer(t *testing.T) {
 	mockValidator := &mockTokenValidator{}
-	middleware := AuthMiddleware(mockValidator)
+	middleware := AuthMiddleware(mockValidator, nil, nil)
 
 	router := setupTestRouter(middleware)
 	req := httptest.NewRequest(http.MethodGet, "/test", nil)
@@ -311,7 +362,7 @@ func TestAuthMiddleware_CaseSensitiveBearer(t *testing.T) {
 
 func TestAuthMiddleware_EmptyToken(t *testing.T) {
 	mockValidator := &mockTokenValidator{}
-	middleware := AuthMiddleware(mockValidator)
+	middleware := AuthMiddleware(mockValidator, nil, nil)
 
 	// Empty token should still call Validate with empty string
 	mockValidator.On("Validate", "").Return(nil, errors.New("empty token"))
@@ -333,3 +384,241 @@ func TestAuthMiddleware_EmptyToken(t *testing.T) {
 	assert.Equal(t, "invalid or expired token", response["message"])
 }
 
+
+func TestAuthMiddleware_RevokedToken(t *testing.T) {
+	mockValidator := &mockTokenValidator{}
+	mockRevocations := &mockTokenRevocationStore{}
+	middleware := AuthMiddleware(mockValidator, mockRevocations, nil)
+
+	claims := &domainuser.TokenClaims{UserID: 1, Email: "test@example.com", TokenID: "jti-1"}
+	mockValidator.On("Validate", "valid-token").Return(claims, nil)
+	mockRevocations.On("IsRevoked", mock.Anything, "jti-1").Return(true, nil)
+
+	router := setupTestRouter(middleware)
+
+	req := httptest.NewRequest(http.MethodGet, "/test", nil)
+	req.Header.Set("Authorization", "Bearer valid-token")
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+	mockRevocations.AssertExpectations(t)
+
+	var response map[string]interface{}
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	assert.NoError(t, err)
+	assert.Equal(t, "token has been revoked", response["message"])
+}
+
+func TestAuthMiddleware_RevocationCheckError(t *testing.T) {
+	mockValidator := &mockTokenValidator{}
+	mockRevocations := &mockTokenRevocationStore{}
+	middleware := AuthMiddleware(mockValidator, mockRevocations, nil)
+
+	claims := &domainuser.TokenClaims{UserID: 1, Email: "test@example.com", TokenID: "jti-1"}
+	mockValidator.On("Validate", "valid-token").Return(claims, nil)
+	mockRevocations.On("IsRevoked", mock.Anything, "jti-1").Return(false, errors.New("redis error"))
+
+	router := setupTestRouter(middleware)
+
+	req := httptest.NewRequest(http.MethodGet, "/test", nil)
+	req.Header.Set("Authorization", "Bearer valid-token")
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+}
+
+func TestAuthMiddleware_NotRevokedSetsTokenContext(t *testing.T) {
+	mockValidator := &mockTokenValidator{}
+	mockRevocations := &mockTokenRevocationStore{}
+	middleware := AuthMiddleware(mockValidator, mockRevocations, nil)
+
+	expiresAt := time.Now().Add(15 * time.Minute)
+	claims := &domainuser.TokenClaims{UserID: 1, Email: "test@example.com", TokenID: "jti-1", ExpiresAt: expiresAt}
+	mockValidator.On("Validate", "valid-token").Return(claims, nil)
+	mockRevocations.On("IsRevoked", mock.Anything, "jti-1").Return(false, nil)
+
+	router := gin.New()
+	router.Use(middleware)
+	router.GET("/test", func(c *gin.Context) {
+		assert.Equal(t, "jti-1", c.GetString("token_id"))
+		assert.Equal(t, expiresAt, c.GetTime("token_expires_at"))
+		c.JSON(http.StatusOK, gin.H{"message": "success"})
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/test", nil)
+	req.Header.Set("Authorization", "Bearer valid-token")
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	mockRevocations.AssertExpectations(t)
+}
+
+func TestAuthMiddleware_RevokedSession(t *testing.T) {
+	mockValidator := &mockTokenValidator{}
+	mockRevocations := &mockTokenRevocationStore{}
+	middleware := AuthMiddleware(mockValidator, mockRevocations, nil)
+
+	claims := &domainuser.TokenClaims{UserID: 1, Email: "test@example.com", TokenID: "jti-1", SessionID: "session-1"}
+	mockValidator.On("Validate", "valid-token").Return(claims, nil)
+	mockRevocations.On("IsRevoked", mock.Anything, "jti-1").Return(false, nil)
+	mockRevocations.On("IsRevoked", mock.Anything, "session:session-1").Return(true, nil)
+
+	router := setupTestRouter(middleware)
+
+	req := httptest.NewRequest(http.MethodGet, "/test", nil)
+	req.Header.Set("Authorization", "Bearer valid-token")
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+	mockRevocations.AssertExpectations(t)
+
+	var response map[string]interface{}
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	assert.NoError(t, err)
+	assert.Equal(t, "session has been revoked", response["message"])
+}
+
+func TestAuthMiddleware_ActiveSessionSetsContext(t *testing.T) {
+	mockValidator := &mockTokenValidator{}
+	mockRevocations := &mockTokenRevocationStore{}
+	middleware := AuthMiddleware(mockValidator, mockRevocations, nil)
+
+	claims := &domainuser.TokenClaims{UserID: 1, Email: "test@example.com", TokenID: "jti-1", SessionID: "session-1", OrgID: 4}
+	mockValidator.On("Validate", "valid-token").Return(claims, nil)
+	mockRevocations.On("IsRevoked", mock.Anything, "jti-1").Return(false, nil)
+	mockRevocations.On("IsRevoked", mock.Anything, "session:session-1").Return(false, nil)
+
+	router := gin.New()
+	router.Use(middleware)
+	router.GET("/test", func(c *gin.Context) {
+		assert.Equal(t, "session-1", c.GetString("session_id"))
+		assert.Equal(t, int64(4), c.GetInt64("org_id"))
+		c.JSON(http.StatusOK, gin.H{"message": "success"})
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/test", nil)
+	req.Header.Set("Authorization", "Bearer valid-token")
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	mockRevocations.AssertExpectations(t)
+}
+
+// mockAPIKeyAuthenticator is a mock implementation of APIKeyAuthenticator
+type mockAPIKeyAuthenticator struct {
+	mock.Mock
+}
+
+func (m *mockAPIKeyAuthenticator) Authenticate(ctx context.Context, key string) (*domainuser.TokenClaims, error) {
+	args := m.Called(ctx, key)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domainuser.TokenClaims), args.Error(1)
+}
+
+func TestAuthMiddleware_APIKey(t *testing.T) {
+	tests := []struct {
+		name       string
+		setup      func(authenticator *mockAPIKeyAuthenticator)
+		wantStatus int
+	}{
+		{
+			name: "valid key",
+			setup: func(authenticator *mockAPIKeyAuthenticator) {
+				authenticator.On("Authenticate", mock.Anything, "hxa_key").Return(&domainuser.TokenClaims{
+					UserID: 1,
+					Email:  "ci@example.com",
+					Role:   domainuser.RoleEditor,
+					OrgID:  4,
+					Scopes: []domainuser.Permission{domainuser.PermArticlesRead},
+				}, nil)
+			},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name: "invalid key",
+			setup: func(authenticator *mockAPIKeyAuthenticator) {
+				authenticator.On("Authenticate", mock.Anything, "hxa_key").Return(nil, domainuser.ErrInvalidAPIKey)
+			},
+			wantStatus: http.StatusUnauthorized,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockValidator := &mockTokenValidator{}
+			authenticator := &mockAPIKeyAuthenticator{}
+			tt.setup(authenticator)
+
+			gin.SetMode(gin.TestMode)
+			router := gin.New()
+			router.Use(AuthMiddleware(mockValidator, nil, authenticator))
+			router.GET("/test", func(c *gin.Context) {
+				assert.Equal(t, int64(1), c.GetInt64("user_id"))
+				assert.Equal(t, "editor", c.GetString("user_role"))
+				assert.Equal(t, int64(4), c.GetInt64("org_id"))
+				assert.Equal(t, AuthMethodAPIKey, c.GetString("auth_method"))
+				c.JSON(http.StatusOK, gin.H{"message": "success"})
+			})
+
+			req := httptest.NewRequest(http.MethodGet, "/test", nil)
+			req.Header.Set("X-API-Key", "hxa_key")
+			w := httptest.NewRecorder()
+
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.wantStatus, w.Code)
+			authenticator.AssertExpectations(t)
+			mockValidator.AssertNotCalled(t, "Validate", mock.Anything)
+		})
+	}
+}
+
+func TestRequireBearerToken(t *testing.T) {
+	tests := []struct {
+		name           string
+		method         string
+		impersonatedBy int64
+		wantStatus     int
+	}{
+		{name: "bearer token", method: AuthMethodBearer, wantStatus: http.StatusOK},
+		{name: "api key", method: AuthMethodAPIKey, wantStatus: http.StatusForbidden},
+		{name: "impersonation token", method: AuthMethodBearer, impersonatedBy: 1, wantStatus: http.StatusForbidden},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gin.SetMode(gin.TestMode)
+			router := gin.New()
+			router.Use(func(c *gin.Context) {
+				c.Set("auth_method", tt.method)
+				if tt.impersonatedBy != 0 {
+					c.Set("impersonated_by", tt.impersonatedBy)
+				}
+				c.Next()
+			})
+			router.Use(RequireBearerToken())
+			router.GET("/test", func(c *gin.Context) {
+				c.JSON(http.StatusOK, gin.H{"message": "success"})
+			})
+
+			req := httptest.NewRequest(http.MethodGet, "/test", nil)
+			w := httptest.NewRecorder()
+
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.wantStatus, w.Code)
+		})
+	}
+}
@@ -0,0 +1,57 @@
+package middleware
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/rulzi/hexa-go/internal/adapters/http/response"
+	domainuser "github.com/rulzi/hexa-go/internal/domain/user"
+)
+
+// RequireRole creates a middleware that only lets the given roles through.
+// It must run after AuthMiddleware, which puts the user role in the context.
+func RequireRole(roles ...domainuser.Role) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		role := domainuser.Role(c.GetString("user_role"))
+		for _, allowed := range roles {
+			if role == allowed {
+				c.Next()
+				return
+			}
+		}
+
+		response.ErrorResponseForbidden(c, "insufficient role")
+		c.Abort()
+	}
+}
+
+// RequirePermission creates a middleware that only lets roles granting the permission through,
+// further limited to the scopes of the API key when the request uses one.
+// It must run after AuthMiddleware, which puts the user role in the context.
+func RequirePermission(permission domainuser.Permission) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		role := domainuser.Role(c.GetString("user_role"))
+		if !role.HasPermission(permission) || !scopeAllows(c, permission) {
+			response.ErrorResponseForbidden(c, "insufficient permission")
+			c.Abort()
+			return
+		}
+
+		c.Next()
+	}
+}
+
+// scopeAllows reports whether the scopes of an API key include the permission.
+// Requests without scopes are only limited by the role.
+func scopeAllows(c *gin.Context, permission domainuser.Permission) bool {
+	scopes, _ := c.Get("user_scopes")
+	granted, _ := scopes.([]domainuser.Permission)
+	if len(granted) == 0 {
+		return true
+	}
+
+	for _, scope := range granted {
+		if scope == permission {
+			return true
+		}
+	}
+	return false
+}
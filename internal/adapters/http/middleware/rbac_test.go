@@ -0,0 +1,115 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	domainuser "github.com/rulzi/hexa-go/internal/domain/user"
+	"github.com/stretchr/testify/assert"
+)
+
+func setupRBACRouter(role string, guard gin.HandlerFunc) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.Use(func(c *gin.Context) {
+		if role != "" {
+			c.Set("user_role", role)
+		}
+		c.Next()
+	})
+	router.Use(guard)
+	router.GET("/test", func(c *gin.Context) {
+		c.JSON(http.StatusOK, gin.H{"message": "success"})
+	})
+	return router
+}
+
+func TestRequireRole(t *testing.T) {
+	tests := []struct {
+		name       string
+		role       string
+		wantStatus int
+	}{
+		{name: "allowed role", role: "admin", wantStatus: http.StatusOK},
+		{name: "second allowed role", role: "editor", wantStatus: http.StatusOK},
+		{name: "other role", role: "author", wantStatus: http.StatusForbidden},
+		{name: "missing role", role: "", wantStatus: http.StatusForbidden},
+		{name: "unknown role", role: "root", wantStatus: http.StatusForbidden},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router := setupRBACRouter(tt.role, RequireRole(domainuser.RoleAdmin, domainuser.RoleEditor))
+
+			req := httptest.NewRequest(http.MethodGet, "/test", nil)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.wantStatus, w.Code)
+		})
+	}
+}
+
+func TestRequirePermission(t *testing.T) {
+	tests := []struct {
+		name       string
+		role       string
+		permission domainuser.Permission
+		wantStatus int
+	}{
+		{name: "admin manages users", role: "admin", permission: domainuser.PermUsersWrite, wantStatus: http.StatusOK},
+		{name: "editor cannot manage users", role: "editor", permission: domainuser.PermUsersWrite, wantStatus: http.StatusForbidden},
+		{name: "author writes articles", role: "author", permission: domainuser.PermArticlesWrite, wantStatus: http.StatusOK},
+		{name: "reader cannot write articles", role: "reader", permission: domainuser.PermArticlesWrite, wantStatus: http.StatusForbidden},
+		{name: "reader reads articles", role: "reader", permission: domainuser.PermArticlesRead, wantStatus: http.StatusOK},
+		{name: "missing role", role: "", permission: domainuser.PermArticlesRead, wantStatus: http.StatusForbidden},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router := setupRBACRouter(tt.role, RequirePermission(tt.permission))
+
+			req := httptest.NewRequest(http.MethodGet, "/test", nil)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.wantStatus, w.Code)
+		})
+	}
+}
+
+func TestRequirePermission_APIKeyScopes(t *testing.T) {
+	tests := []struct {
+		name       string
+		scopes     []domainuser.Permission
+		wantStatus int
+	}{
+		{name: "unrestricted key", scopes: nil, wantStatus: http.StatusOK},
+		{name: "scope granted", scopes: []domainuser.Permission{domainuser.PermArticlesRead}, wantStatus: http.StatusOK},
+		{name: "scope missing", scopes: []domainuser.Permission{domainuser.PermMediaRead}, wantStatus: http.StatusForbidden},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gin.SetMode(gin.TestMode)
+			router := gin.New()
+			router.Use(func(c *gin.Context) {
+				c.Set("user_role", "admin")
+				c.Set("user_scopes", tt.scopes)
+				c.Next()
+			})
+			router.Use(RequirePermission(domainuser.PermArticlesRead))
+			router.GET("/test", func(c *gin.Context) {
+				c.JSON(http.StatusOK, gin.H{"message": "success"})
+			})
+
+			req := httptest.NewRequest(http.MethodGet, "/test", nil)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.wantStatus, w.Code)
+		})
+	}
+}
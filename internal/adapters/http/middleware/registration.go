@@ -0,0 +1,20 @@
+package middleware
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/rulzi/hexa-go/internal/adapters/http/response"
+	domainuser "github.com/rulzi/hexa-go/internal/domain/user"
+)
+
+// RequireOpenRegistration creates a middleware that blocks self sign-up unless the registration mode is open
+func RequireOpenRegistration(mode domainuser.RegistrationMode) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if !mode.AllowsSignUp() {
+			response.ErrorResponseForbidden(c, domainuser.ErrRegistrationClosed.Error())
+			c.Abort()
+			return
+		}
+
+		c.Next()
+	}
+}
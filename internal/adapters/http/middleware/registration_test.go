@@ -0,0 +1,39 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	domainuser "github.com/rulzi/hexa-go/internal/domain/user"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRequireOpenRegistration(t *testing.T) {
+	tests := []struct {
+		name       string
+		mode       domainuser.RegistrationMode
+		wantStatus int
+	}{
+		{name: "open lets everyone register", mode: domainuser.RegistrationOpen, wantStatus: http.StatusCreated},
+		{name: "invite only blocks sign-up", mode: domainuser.RegistrationInviteOnly, wantStatus: http.StatusForbidden},
+		{name: "disabled blocks sign-up", mode: domainuser.RegistrationDisabled, wantStatus: http.StatusForbidden},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gin.SetMode(gin.TestMode)
+			router := gin.New()
+			router.POST("/register", RequireOpenRegistration(tt.mode), func(c *gin.Context) {
+				c.JSON(http.StatusCreated, gin.H{"message": "success"})
+			})
+
+			req := httptest.NewRequest(http.MethodPost, "/register", nil)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.wantStatus, w.Code)
+		})
+	}
+}
@@ -0,0 +1,21 @@
+package middleware
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/rulzi/hexa-go/internal/adapters/http/response"
+	domainuser "github.com/rulzi/hexa-go/internal/domain/user"
+)
+
+// RequireVerifiedEmail creates a middleware that blocks unverified users when the policy restricts content.
+// It must run after AuthMiddleware, which puts the email verification state in the context.
+func RequireVerifiedEmail(policy domainuser.EmailVerificationPolicy) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if policy.BlocksContent() && !c.GetBool("email_verified") {
+			response.ErrorResponseForbidden(c, domainuser.ErrEmailNotVerified.Error())
+			c.Abort()
+			return
+		}
+
+		c.Next()
+	}
+}
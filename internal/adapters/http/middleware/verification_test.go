@@ -0,0 +1,46 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	domainuser "github.com/rulzi/hexa-go/internal/domain/user"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRequireVerifiedEmail(t *testing.T) {
+	tests := []struct {
+		name       string
+		policy     domainuser.EmailVerificationPolicy
+		verified   bool
+		wantStatus int
+	}{
+		{name: "policy none lets unverified through", policy: domainuser.VerificationPolicyNone, verified: false, wantStatus: http.StatusOK},
+		{name: "policy content blocks unverified", policy: domainuser.VerificationPolicyContent, verified: false, wantStatus: http.StatusForbidden},
+		{name: "policy content lets verified through", policy: domainuser.VerificationPolicyContent, verified: true, wantStatus: http.StatusOK},
+		{name: "policy login blocks unverified", policy: domainuser.VerificationPolicyLogin, verified: false, wantStatus: http.StatusForbidden},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gin.SetMode(gin.TestMode)
+			router := gin.New()
+			router.Use(func(c *gin.Context) {
+				c.Set("email_verified", tt.verified)
+				c.Next()
+			})
+			router.Use(RequireVerifiedEmail(tt.policy))
+			router.GET("/test", func(c *gin.Context) {
+				c.JSON(http.StatusOK, gin.H{"message": "success"})
+			})
+
+			req := httptest.NewRequest(http.MethodGet, "/test", nil)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.wantStatus, w.Code)
+		})
+	}
+}
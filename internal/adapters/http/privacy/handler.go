@@ -0,0 +1,192 @@
+package privacy
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rulzi/hexa-go/internal/adapters/http/middleware"
+	"github.com/rulzi/hexa-go/internal/adapters/http/response"
+	"github.com/rulzi/hexa-go/internal/application/privacy/dto"
+	domainprivacy "github.com/rulzi/hexa-go/internal/domain/privacy"
+	domainuser "github.com/rulzi/hexa-go/internal/domain/user"
+)
+
+// RequestExportUseCase is the interface for the request export use case
+type RequestExportUseCase interface {
+	Execute(ctx context.Context, orgID, userID, requestedBy int64) (*dto.DataRequestResponse, error)
+}
+
+// RequestErasureUseCase is the interface for the request erasure use case
+type RequestErasureUseCase interface {
+	Execute(ctx context.Context, orgID, userID, requestedBy int64) (*dto.DataRequestResponse, error)
+}
+
+// GetDataRequestUseCase is the interface for the get data request use case
+type GetDataRequestUseCase interface {
+	Execute(ctx context.Context, orgID, id, userID int64) (*dto.DataRequestResponse, error)
+}
+
+// DownloadExportUseCase is the interface for the download export use case
+type DownloadExportUseCase interface {
+	Execute(ctx context.Context, orgID, id, userID int64) (io.ReadCloser, error)
+}
+
+// Handler handles HTTP requests for data exports and account erasure (driving adapter).
+// Both run in the background, the routes file a request and report its status.
+type Handler struct {
+	exportUseCase   RequestExportUseCase
+	erasureUseCase  RequestErasureUseCase
+	getUseCase      GetDataRequestUseCase
+	downloadUseCase DownloadExportUseCase
+}
+
+// NewHandler creates a new privacy handler
+func NewHandler(
+	exportUseCase RequestExportUseCase,
+	erasureUseCase RequestErasureUseCase,
+	getUseCase GetDataRequestUseCase,
+	downloadUseCase DownloadExportUseCase,
+) *Handler {
+	return &Handler{
+		exportUseCase:   exportUseCase,
+		erasureUseCase:  erasureUseCase,
+		getUseCase:      getUseCase,
+		downloadUseCase: downloadUseCase,
+	}
+}
+
+// Export handles POST /users/me/export
+func (h *Handler) Export(c *gin.Context) {
+	h.file(c, h.exportUseCase, 0, c.GetInt64("user_id"), "Export requested")
+}
+
+// Erase handles POST /users/me/erasure
+func (h *Handler) Erase(c *gin.Context) {
+	h.file(c, h.erasureUseCase, 0, c.GetInt64("user_id"), "Erasure requested")
+}
+
+// Get handles GET /users/me/data-requests/:id
+func (h *Handler) Get(c *gin.Context) {
+	h.get(c, 0, c.GetInt64("user_id"))
+}
+
+// Download handles GET /users/me/data-requests/:id/download
+func (h *Handler) Download(c *gin.Context) {
+	h.download(c, 0, c.GetInt64("user_id"))
+}
+
+// AdminExport handles POST /admin/users/:id/export
+func (h *Handler) AdminExport(c *gin.Context) {
+	userID, ok := parseID(c, "invalid user id")
+	if !ok {
+		return
+	}
+	h.file(c, h.exportUseCase, c.GetInt64("org_id"), userID, "Export requested")
+}
+
+// AdminErase handles POST /admin/users/:id/erasure
+func (h *Handler) AdminErase(c *gin.Context) {
+	userID, ok := parseID(c, "invalid user id")
+	if !ok {
+		return
+	}
+	h.file(c, h.erasureUseCase, c.GetInt64("org_id"), userID, "Erasure requested")
+}
+
+// AdminGet handles GET /admin/data-requests/:id
+func (h *Handler) AdminGet(c *gin.Context) {
+	h.get(c, c.GetInt64("org_id"), 0)
+}
+
+// AdminDownload handles GET /admin/data-requests/:id/download
+func (h *Handler) AdminDownload(c *gin.Context) {
+	h.download(c, c.GetInt64("org_id"), 0)
+}
+
+// requestFiler files an export or an erasure
+type requestFiler interface {
+	Execute(ctx context.Context, orgID, userID, requestedBy int64) (*dto.DataRequestResponse, error)
+}
+
+// file files a request about userID on behalf of the current user,
+// the request covers the data of userID in orgID, orgID zero covers the whole account
+func (h *Handler) file(c *gin.Context, uc requestFiler, orgID, userID int64, message string) {
+	resp, err := uc.Execute(c.Request.Context(), orgID, userID, middleware.ActorID(c))
+	if err != nil {
+		switch err {
+		case domainuser.ErrUserNotFound:
+			response.ErrorResponseNotFound(c, err.Error())
+		case domainprivacy.ErrRequestInProgress, domainprivacy.ErrReassignTargetErased:
+			response.ErrorResponseConflict(c, err.Error())
+		default:
+			response.ErrorResponseInternalServerError(c, err.Error())
+		}
+		return
+	}
+
+	response.SuccessResponseAccepted(c, message, resp)
+}
+
+// get reports the status of a request, orgID and userID zero allow requests of any user
+func (h *Handler) get(c *gin.Context, orgID, userID int64) {
+	id, ok := parseID(c, "invalid request id")
+	if !ok {
+		return
+	}
+
+	resp, err := h.getUseCase.Execute(c.Request.Context(), orgID, id, userID)
+	if err != nil {
+		if err == domainprivacy.ErrRequestNotFound {
+			response.ErrorResponseNotFound(c, err.Error())
+		} else {
+			response.ErrorResponseInternalServerError(c, err.Error())
+		}
+		return
+	}
+
+	response.SuccessResponseOK(c, "Data request retrieved successfully", resp)
+}
+
+// download streams the archive of an export, orgID and userID zero allow exports of any user
+func (h *Handler) download(c *gin.Context, orgID, userID int64) {
+	id, ok := parseID(c, "invalid request id")
+	if !ok {
+		return
+	}
+
+	archive, err := h.downloadUseCase.Execute(c.Request.Context(), orgID, id, userID)
+	if err != nil {
+		switch err {
+		case domainprivacy.ErrRequestNotFound, domainprivacy.ErrExportExpired:
+			response.ErrorResponseNotFound(c, err.Error())
+		case domainprivacy.ErrExportNotReady:
+			response.ErrorResponseConflict(c, err.Error())
+		default:
+			response.ErrorResponseInternalServerError(c, err.Error())
+		}
+		return
+	}
+	defer func() {
+		if err := archive.Close(); err != nil {
+			log.Printf("Failed to close archive: %v", err)
+		}
+	}()
+
+	c.DataFromReader(response.StatusCode.OK(), -1, "application/zip", archive, map[string]string{
+		"Content-Disposition": fmt.Sprintf(`attachment; filename="export-%d.zip"`, id),
+	})
+}
+
+// parseID reads the :id path parameter and responds with 400 if it is not a number
+func parseID(c *gin.Context, message string) (int64, bool) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		response.ErrorResponseBadRequest(c, message)
+		return 0, false
+	}
+	return id, true
+}
@@ -0,0 +1,205 @@
+package privacy
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rulzi/hexa-go/internal/application/privacy/dto"
+	domainprivacy "github.com/rulzi/hexa-go/internal/domain/privacy"
+	domainuser "github.com/rulzi/hexa-go/internal/domain/user"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// mockRequestUseCase is a mock implementation of RequestExportUseCase and RequestErasureUseCase
+type mockRequestUseCase struct {
+	mock.Mock
+}
+
+func (m *mockRequestUseCase) Execute(ctx context.Context, orgID, userID, requestedBy int64) (*dto.DataRequestResponse, error) {
+	args := m.Called(ctx, orgID, userID, requestedBy)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.DataRequestResponse), args.Error(1)
+}
+
+// mockGetDataRequestUseCase is a mock implementation of GetDataRequestUseCase
+type mockGetDataRequestUseCase struct {
+	mock.Mock
+}
+
+func (m *mockGetDataRequestUseCase) Execute(ctx context.Context, orgID, id, userID int64) (*dto.DataRequestResponse, error) {
+	args := m.Called(ctx, orgID, id, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.DataRequestResponse), args.Error(1)
+}
+
+// mockDownloadExportUseCase is a mock implementation of DownloadExportUseCase
+type mockDownloadExportUseCase struct {
+	mock.Mock
+}
+
+func (m *mockDownloadExportUseCase) Execute(ctx context.Context, orgID, id, userID int64) (io.ReadCloser, error) {
+	args := m.Called(ctx, orgID, id, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(io.ReadCloser), args.Error(1)
+}
+
+// setupTestRouter serves the handler as user 1 signed in to organization 2
+func setupTestRouter() *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.Use(func(c *gin.Context) {
+		c.Set("user_id", int64(1))
+		c.Set("org_id", int64(2))
+		c.Next()
+	})
+	return router
+}
+
+func TestHandler_Export(t *testing.T) {
+	tests := []struct {
+		name       string
+		err        error
+		wantStatus int
+	}{
+		{name: "accepted", wantStatus: http.StatusAccepted},
+		{name: "already in progress", err: domainprivacy.ErrRequestInProgress, wantStatus: http.StatusConflict},
+		{name: "user gone", err: domainuser.ErrUserNotFound, wantStatus: http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exportUC := &mockRequestUseCase{}
+			handler := NewHandler(exportUC, nil, nil, nil)
+			if tt.err != nil {
+				exportUC.On("Execute", mock.Anything, int64(0), int64(1), int64(1)).Return(nil, tt.err)
+			} else {
+				exportUC.On("Execute", mock.Anything, int64(0), int64(1), int64(1)).Return(&dto.DataRequestResponse{ID: 9, Type: "export", Status: "pending"}, nil)
+			}
+
+			router := setupTestRouter()
+			router.POST("/users/me/export", handler.Export)
+
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/users/me/export", nil))
+
+			assert.Equal(t, tt.wantStatus, w.Code)
+			exportUC.AssertExpectations(t)
+		})
+	}
+}
+
+func TestHandler_AdminErase(t *testing.T) {
+	erasureUC := &mockRequestUseCase{}
+	handler := NewHandler(nil, erasureUC, nil, nil)
+	// Filed by the admin about user 5
+	erasureUC.On("Execute", mock.Anything, int64(2), int64(5), int64(1)).Return(&dto.DataRequestResponse{ID: 3, UserID: 5, Type: "erasure", Status: "pending"}, nil)
+
+	router := setupTestRouter()
+	router.POST("/admin/users/:id/erasure", handler.AdminErase)
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/admin/users/5/erasure", nil))
+	assert.Equal(t, http.StatusAccepted, w.Code)
+
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/admin/users/abc/erasure", nil))
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+
+	erasureUC.AssertExpectations(t)
+}
+
+func TestHandler_AdminExport_Impersonated(t *testing.T) {
+	exportUC := &mockRequestUseCase{}
+	handler := NewHandler(exportUC, nil, nil, nil)
+	// Filed while admin 7 acts as user 1, the request records the admin
+	exportUC.On("Execute", mock.Anything, int64(2), int64(5), int64(7)).Return(&dto.DataRequestResponse{ID: 3, UserID: 5, Type: "export", Status: "pending"}, nil)
+
+	router := setupTestRouter()
+	router.POST("/admin/users/:id/export", func(c *gin.Context) {
+		c.Set("actor_id", int64(7))
+		c.Next()
+	}, handler.AdminExport)
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/admin/users/5/export", nil))
+
+	assert.Equal(t, http.StatusAccepted, w.Code)
+	exportUC.AssertExpectations(t)
+}
+
+func TestHandler_Get(t *testing.T) {
+	getUC := &mockGetDataRequestUseCase{}
+	handler := NewHandler(nil, nil, getUC, nil)
+	getUC.On("Execute", mock.Anything, int64(0), int64(9), int64(1)).Return(&dto.DataRequestResponse{ID: 9, Status: "running"}, nil)
+	getUC.On("Execute", mock.Anything, int64(0), int64(10), int64(1)).Return(nil, domainprivacy.ErrRequestNotFound)
+	getUC.On("Execute", mock.Anything, int64(2), int64(10), int64(0)).Return(&dto.DataRequestResponse{ID: 10, UserID: 5}, nil)
+
+	router := setupTestRouter()
+	router.GET("/users/me/data-requests/:id", handler.Get)
+	router.GET("/admin/data-requests/:id", handler.AdminGet)
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/users/me/data-requests/9", nil))
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `"status":"running"`)
+
+	// Requests of other users are not visible
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/users/me/data-requests/10", nil))
+	assert.Equal(t, http.StatusNotFound, w.Code)
+
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/admin/data-requests/10", nil))
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	getUC.AssertExpectations(t)
+}
+
+func TestHandler_Download(t *testing.T) {
+	tests := []struct {
+		name       string
+		err        error
+		wantStatus int
+	}{
+		{name: "archive", wantStatus: http.StatusOK},
+		{name: "not ready", err: domainprivacy.ErrExportNotReady, wantStatus: http.StatusConflict},
+		{name: "expired", err: domainprivacy.ErrExportExpired, wantStatus: http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			downloadUC := &mockDownloadExportUseCase{}
+			handler := NewHandler(nil, nil, nil, downloadUC)
+			if tt.err != nil {
+				downloadUC.On("Execute", mock.Anything, int64(0), int64(9), int64(1)).Return(nil, tt.err)
+			} else {
+				downloadUC.On("Execute", mock.Anything, int64(0), int64(9), int64(1)).Return(io.NopCloser(strings.NewReader("PK zip")), nil)
+			}
+
+			router := setupTestRouter()
+			router.GET("/users/me/data-requests/:id/download", handler.Download)
+
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/users/me/data-requests/9/download", nil))
+
+			assert.Equal(t, tt.wantStatus, w.Code)
+			if tt.err == nil {
+				assert.Equal(t, "application/zip", w.Header().Get("Content-Type"))
+				assert.Equal(t, `attachment; filename="export-9.zip"`, w.Header().Get("Content-Disposition"))
+				assert.Equal(t, "PK zip", w.Body.String())
+			}
+		})
+	}
+}
@@ -98,6 +98,15 @@ func ErrorResponse(c *gin.Context, statusCode int, message string) {
 	})
 }
 
+// ErrorResponseWithData sends an error response with details the client can act on
+func ErrorResponseWithData(c *gin.Context, statusCode int, message string, data interface{}) {
+	c.JSON(statusCode, StandardResponse{
+		Status:  StatusError,
+		Message: message,
+		Data:    data,
+	})
+}
+
 // SuccessResponseOK sends a 200 OK success response
 func SuccessResponseOK(c *gin.Context, message string, data interface{}) {
 	SuccessResponse(c, StatusCode.OK(), message, data)
@@ -108,6 +117,11 @@ func SuccessResponseCreated(c *gin.Context, message string, data interface{}) {
 	SuccessResponse(c, StatusCode.Created(), message, data)
 }
 
+// SuccessResponseAccepted sends a 202 Accepted success response for work that continues in the background
+func SuccessResponseAccepted(c *gin.Context, message string, data interface{}) {
+	SuccessResponse(c, StatusCode.Accepted(), message, data)
+}
+
 // ErrorResponseBadRequest sends a 400 Bad Request error response
 func ErrorResponseBadRequest(c *gin.Context, message string) {
 	ErrorResponse(c, StatusCode.BadRequest(), message)
@@ -118,6 +132,11 @@ func ErrorResponseUnauthorized(c *gin.Context, message string) {
 	ErrorResponse(c, StatusCode.Unauthorized(), message)
 }
 
+// ErrorResponseForbidden sends a 403 Forbidden error response
+func ErrorResponseForbidden(c *gin.Context, message string) {
+	ErrorResponse(c, StatusCode.Forbidden(), message)
+}
+
 // ErrorResponseNotFound sends a 404 Not Found error response
 func ErrorResponseNotFound(c *gin.Context, message string) {
 	ErrorResponse(c, StatusCode.NotFound(), message)
@@ -128,6 +147,16 @@ func ErrorResponseConflict(c *gin.Context, message string) {
 	ErrorResponse(c, StatusCode.Conflict(), message)
 }
 
+// ErrorResponseUnprocessableEntity sends a 422 Unprocessable Entity error response with the details of the rejected input
+func ErrorResponseUnprocessableEntity(c *gin.Context, message string, data interface{}) {
+	ErrorResponseWithData(c, StatusCode.UnprocessableEntity(), message, data)
+}
+
+// ErrorResponseTooManyRequests sends a 429 Too Many Requests error response
+func ErrorResponseTooManyRequests(c *gin.Context, message string) {
+	ErrorResponse(c, StatusCode.TooManyRequests(), message)
+}
+
 // ErrorResponseInternalServerError sends a 500 Internal Server Error response
 func ErrorResponseInternalServerError(c *gin.Context, message string) {
 	ErrorResponse(c, StatusCode.InternalServerError(), message)
@@ -205,6 +205,20 @@ func TestSuccessResponseCreated(t *testing.T) {
 	assert.Equal(t, "Created message", response.Message)
 }
 
+func TestSuccessResponseAccepted(t *testing.T) {
+	c, w := setupTestContext()
+	
+	SuccessResponseAccepted(c, "Accepted message", map[string]string{"id": "1"})
+	
+	assert.Equal(t, http.StatusAccepted, w.Code)
+	
+	var response StandardResponse
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	assert.NoError(t, err)
+	assert.Equal(t, StatusSuccess, response.Status)
+	assert.Equal(t, "Accepted message", response.Message)
+}
+
 func TestErrorResponseBadRequest(t *testing.T) {
 	c, w := setupTestContext()
 	
@@ -233,6 +247,20 @@ func TestErrorResponseUnauthorized(t *testing.T) {
 	assert.Equal(t, "Unauthorized message", response.Message)
 }
 
+func TestErrorResponseForbidden(t *testing.T) {
+	c, w := setupTestContext()
+
+	ErrorResponseForbidden(c, "Forbidden message")
+
+	assert.Equal(t, http.StatusForbidden, w.Code)
+
+	var response StandardResponse
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	assert.NoError(t, err)
+	assert.Equal(t, StatusError, response.Status)
+	assert.Equal(t, "Forbidden message", response.Message)
+}
+
 func TestErrorResponseNotFound(t *testing.T) {
 	c, w := setupTestContext()
 	
@@ -261,6 +289,35 @@ func TestErrorResponseConflict(t *testing.T) {
 	assert.Equal(t, "Conflict message", response.Message)
 }
 
+func TestErrorResponseUnprocessableEntity(t *testing.T) {
+	c, w := setupTestContext()
+	
+	ErrorResponseUnprocessableEntity(c, "Invalid input", map[string]interface{}{"field": "reason"})
+	
+	assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
+	
+	var response StandardResponse
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	assert.NoError(t, err)
+	assert.Equal(t, StatusError, response.Status)
+	assert.Equal(t, "Invalid input", response.Message)
+	assert.Equal(t, map[string]interface{}{"field": "reason"}, response.Data)
+}
+
+func TestErrorResponseTooManyRequests(t *testing.T) {
+	c, w := setupTestContext()
+
+	ErrorResponseTooManyRequests(c, "Too many requests message")
+
+	assert.Equal(t, http.StatusTooManyRequests, w.Code)
+
+	var response StandardResponse
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	assert.NoError(t, err)
+	assert.Equal(t, StatusError, response.Status)
+	assert.Equal(t, "Too many requests message", response.Message)
+}
+
 func TestErrorResponseInternalServerError(t *testing.T) {
 	c, w := setupTestContext()
 	
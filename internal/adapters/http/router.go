@@ -1,10 +1,13 @@
 package http
 
 import (
+	"fmt"
+
 	"github.com/gin-gonic/gin"
 	httparticle "github.com/rulzi/hexa-go/internal/adapters/http/article"
 	httpmedia "github.com/rulzi/hexa-go/internal/adapters/http/media"
 	"github.com/rulzi/hexa-go/internal/adapters/http/middleware"
+	httpprivacy "github.com/rulzi/hexa-go/internal/adapters/http/privacy"
 	"github.com/rulzi/hexa-go/internal/adapters/http/response"
 	httpuser "github.com/rulzi/hexa-go/internal/adapters/http/user"
 	domainuser "github.com/rulzi/hexa-go/internal/domain/user"
@@ -12,77 +15,291 @@ import (
 
 // Router sets up the HTTP routes
 type Router struct {
-	userHandler     *httpuser.Handler
-	articleHandler  *httparticle.Handler
-	mediaHandler    *httpmedia.Handler
-	tokenValidator  domainuser.TokenValidator
-	storageBasePath string
+	userHandler         *httpuser.Handler
+	tokenHandler        *httpuser.TokenHandler
+	passwordHandler     *httpuser.PasswordHandler
+	verificationHandler *httpuser.VerificationHandler
+	activityHandler     *httpuser.LoginActivityHandler
+	twoFactorHandler    *httpuser.TwoFactorHandler
+	jwksHandler         *httpuser.JWKSHandler
+	apiKeyHandler       *httpuser.APIKeyHandler
+	identityHandler     *httpuser.IdentityHandler
+	profileHandler      *httpuser.ProfileHandler
+	sessionHandler      *httpuser.SessionHandler
+	organizationHandler *httpuser.OrganizationHandler
+	invitationHandler   *httpuser.InvitationHandler
+	impersonateHandler  *httpuser.ImpersonationHandler
+	userTrashHandler    *httpuser.TrashHandler
+	articleHandler      *httparticle.Handler
+	articleTrashHandler *httparticle.TrashHandler
+	articleWorkflow     *httparticle.WorkflowHandler
+	articleSlugHandler  *httparticle.SlugHandler
+	articleSearch       *httparticle.SearchHandler
+	tagHandler          *httparticle.TagHandler
+	categoryHandler     *httparticle.CategoryHandler
+	mediaHandler        *httpmedia.Handler
+	mediaTrashHandler   *httpmedia.TrashHandler
+	mediaFileHandler    *httpmedia.FileHandler
+	privacyHandler      *httpprivacy.Handler
+	tokenValidator      domainuser.TokenValidator
+	revocations         domainuser.TokenRevocationStore
+	apiKeys             middleware.APIKeyAuthenticator
+	verificationPolicy  domainuser.EmailVerificationPolicy
+	registrationMode    domainuser.RegistrationMode
+	trustedProxies      []string
 }
 
 // NewRouter creates a new router
-func NewRouter(userHandler *httpuser.Handler, articleHandler *httparticle.Handler, mediaHandler *httpmedia.Handler, tokenValidator domainuser.TokenValidator, storageBasePath string) *Router {
+func NewRouter(
+	userHandler *httpuser.Handler,
+	tokenHandler *httpuser.TokenHandler,
+	passwordHandler *httpuser.PasswordHandler,
+	verificationHandler *httpuser.VerificationHandler,
+	activityHandler *httpuser.LoginActivityHandler,
+	twoFactorHandler *httpuser.TwoFactorHandler,
+	jwksHandler *httpuser.JWKSHandler,
+	apiKeyHandler *httpuser.APIKeyHandler,
+	identityHandler *httpuser.IdentityHandler,
+	profileHandler *httpuser.ProfileHandler,
+	sessionHandler *httpuser.SessionHandler,
+	organizationHandler *httpuser.OrganizationHandler,
+	invitationHandler *httpuser.InvitationHandler,
+	impersonateHandler *httpuser.ImpersonationHandler,
+	userTrashHandler *httpuser.TrashHandler,
+	articleHandler *httparticle.Handler,
+	articleTrashHandler *httparticle.TrashHandler,
+	articleWorkflow *httparticle.WorkflowHandler,
+	articleSlugHandler *httparticle.SlugHandler,
+	articleSearch *httparticle.SearchHandler,
+	tagHandler *httparticle.TagHandler,
+	categoryHandler *httparticle.CategoryHandler,
+	mediaHandler *httpmedia.Handler,
+	mediaTrashHandler *httpmedia.TrashHandler,
+	mediaFileHandler *httpmedia.FileHandler,
+	privacyHandler *httpprivacy.Handler,
+	tokenValidator domainuser.TokenValidator,
+	revocations domainuser.TokenRevocationStore,
+	apiKeys middleware.APIKeyAuthenticator,
+	verificationPolicy domainuser.EmailVerificationPolicy,
+	registrationMode domainuser.RegistrationMode,
+	trustedProxies []string,
+) *Router {
 	return &Router{
-		userHandler:     userHandler,
-		articleHandler:  articleHandler,
-		mediaHandler:    mediaHandler,
-		tokenValidator:  tokenValidator,
-		storageBasePath: storageBasePath,
+		userHandler:         userHandler,
+		tokenHandler:        tokenHandler,
+		passwordHandler:     passwordHandler,
+		verificationHandler: verificationHandler,
+		activityHandler:     activityHandler,
+		twoFactorHandler:    twoFactorHandler,
+		jwksHandler:         jwksHandler,
+		apiKeyHandler:       apiKeyHandler,
+		identityHandler:     identityHandler,
+		profileHandler:      profileHandler,
+		sessionHandler:      sessionHandler,
+		organizationHandler: organizationHandler,
+		invitationHandler:   invitationHandler,
+		impersonateHandler:  impersonateHandler,
+		userTrashHandler:    userTrashHandler,
+		articleHandler:      articleHandler,
+		articleTrashHandler: articleTrashHandler,
+		articleWorkflow:     articleWorkflow,
+		articleSlugHandler:  articleSlugHandler,
+		articleSearch:       articleSearch,
+		tagHandler:          tagHandler,
+		categoryHandler:     categoryHandler,
+		mediaHandler:        mediaHandler,
+		mediaTrashHandler:   mediaTrashHandler,
+		mediaFileHandler:    mediaFileHandler,
+		privacyHandler:      privacyHandler,
+		tokenValidator:      tokenValidator,
+		revocations:         revocations,
+		apiKeys:             apiKeys,
+		verificationPolicy:  verificationPolicy,
+		registrationMode:    registrationMode,
+		trustedProxies:      trustedProxies,
 	}
 }
 
-// SetupRoutes configures all HTTP routes
-func (r *Router) SetupRoutes(engine *gin.Engine, debug bool) {
+// SetupRoutes configures all HTTP routes.
+// Client IPs are only read from forwarding headers set by the trusted proxies, with none the
+// connection address is used so that clients can't spoof their IP to get around per-IP limits.
+func (r *Router) SetupRoutes(engine *gin.Engine, debug bool) error {
+	if err := engine.SetTrustedProxies(r.trustedProxies); err != nil {
+		return fmt.Errorf("invalid trusted proxies: %w", err)
+	}
+
 	// Apply default middlewares
 	middleware.SetupDefaultMiddlewares(engine, debug)
 
 	api := engine.Group("/api/v1")
 	{
 		// Public routes (no authentication required)
-		// Media files endpoint (public access)
-		api.StaticFS("/media/files", gin.Dir(r.storageBasePath, false))
+		// Media files endpoint (public access), files of media in the trash are not served
+		api.GET("/media/files/*path", r.mediaFileHandler.Serve)
+		api.HEAD("/media/files/*path", r.mediaFileHandler.Serve)
+
+		// Signing up on one's own depends on the registration mode, invitees sign up with their invitation
+		requireOpenRegistration := middleware.RequireOpenRegistration(r.registrationMode)
 
 		users := api.Group("/users")
 		{
-			users.POST("/register", r.userHandler.Register) // Register
-			users.POST("/login", r.userHandler.Login)       // Login
+			users.POST("/register", requireOpenRegistration, r.userHandler.Register) // Register
+			users.POST("/login", r.userHandler.Login)                                // Login
+			users.POST("/refresh", r.tokenHandler.Refresh)                           // Rotate refresh token
+
+			users.POST("/invitations/accept", r.invitationHandler.Accept) // Accept an invitation, creating the account if needed
+
+			users.POST("/login/2fa", r.twoFactorHandler.Login) // Complete login with a two-factor code
+
+			users.POST("/password/forgot", r.passwordHandler.Forgot) // Request password reset
+			users.POST("/password/reset", r.passwordHandler.Reset)   // Reset password with token
+
+			users.GET("/verify", r.verificationHandler.Verify)         // Verify email with signed link
+			users.POST("/verify/resend", r.verificationHandler.Resend) // Resend verification link
+
+			// Sign-in with the identity provider, only when one is configured
+			if r.identityHandler != nil {
+				users.GET("/oidc/login", r.identityHandler.Login)       // Redirect to the identity provider
+				users.GET("/oidc/callback", r.identityHandler.Callback) // Redirect back with a one-time login code
+				users.POST("/oidc/token", r.identityHandler.Token)      // Trade the login code for tokens
+			}
 		}
 
 		// Protected routes (authentication required)
-		authMiddleware := middleware.AuthMiddleware(r.tokenValidator)
+		authMiddleware := middleware.AuthMiddleware(r.tokenValidator, r.revocations, r.apiKeys)
 		protected := api.Group("")
 		protected.Use(authMiddleware)
+		requireVerified := middleware.RequireVerifiedEmail(r.verificationPolicy)
+		requireBearer := middleware.RequireBearerToken()
 		{
 			usersProtected := protected.Group("/users")
 			{
-				usersProtected.POST("", r.userHandler.Create)
-				usersProtected.GET("", r.userHandler.List)
-				usersProtected.GET("/:id", r.userHandler.Get)
-				usersProtected.PUT("/:id", r.userHandler.Update)
-				usersProtected.DELETE("/:id", r.userHandler.Delete)
+				usersProtected.POST("/logout", r.tokenHandler.Logout)
+
+				// Account of the current user, changes need a login session
+				usersProtected.GET("/me", r.profileHandler.Get)
+				usersProtected.PUT("/me", requireBearer, r.profileHandler.Update)
+				usersProtected.DELETE("/me", requireBearer, r.profileHandler.Delete)
+				usersProtected.PUT("/me/password", requireBearer, r.profileHandler.ChangePassword)
+
+				// Sessions of the current user, managed only with a login session
+				usersProtected.GET("/me/sessions", requireBearer, r.sessionHandler.List)
+				usersProtected.DELETE("/me/sessions", requireBearer, r.sessionHandler.RevokeAll)
+				usersProtected.DELETE("/me/sessions/:id", requireBearer, r.sessionHandler.Revoke)
+
+				// Two-factor authentication of the current user
+				usersProtected.POST("/me/2fa/enroll", requireBearer, r.twoFactorHandler.Enroll)
+				usersProtected.POST("/me/2fa/confirm", requireBearer, r.twoFactorHandler.Confirm)
+				usersProtected.POST("/me/2fa/recovery-codes", requireBearer, r.twoFactorHandler.RegenerateRecoveryCodes)
+				usersProtected.POST("/me/2fa/disable", requireBearer, r.twoFactorHandler.Disable)
+
+				// API keys of the current user, managed only with a login session
+				usersProtected.POST("/me/api-keys", requireBearer, r.apiKeyHandler.Create)
+				usersProtected.GET("/me/api-keys", requireBearer, r.apiKeyHandler.List)
+				usersProtected.DELETE("/me/api-keys/:id", requireBearer, r.apiKeyHandler.Revoke)
+
+				// Export and erasure of the current user's data, filed only with a login session
+				usersProtected.POST("/me/export", requireBearer, r.privacyHandler.Export)
+				usersProtected.POST("/me/erasure", requireBearer, r.privacyHandler.Erase)
+				usersProtected.GET("/me/data-requests/:id", r.privacyHandler.Get)
+				usersProtected.GET("/me/data-requests/:id/download", requireBearer, r.privacyHandler.Download)
+
+				// User management is admin-only
+				usersProtected.POST("", middleware.RequirePermission(domainuser.PermUsersWrite), r.userHandler.Create)
+				usersProtected.GET("", middleware.RequirePermission(domainuser.PermUsersRead), r.userHandler.List)
+				usersProtected.GET("/:id", middleware.RequirePermission(domainuser.PermUsersRead), r.userHandler.Get)
+				usersProtected.PUT("/:id", middleware.RequirePermission(domainuser.PermUsersWrite), r.userHandler.Update)
+				usersProtected.DELETE("/:id", middleware.RequirePermission(domainuser.PermUsersWrite), r.userHandler.Delete)
+				usersProtected.GET("/:id/login-attempts", middleware.RequirePermission(domainuser.PermUsersRead), r.activityHandler.List)
+				usersProtected.POST("/invitations", middleware.RequirePermission(domainuser.PermUsersWrite), r.invitationHandler.Create)
+			}
+
+			// Organizations of the current user, switching issues tokens for a login session
+			organizations := protected.Group("/organizations")
+			{
+				organizations.GET("", r.organizationHandler.List)
+				organizations.POST("", requireBearer, r.organizationHandler.Create)
+				organizations.POST("/:id/switch", requireBearer, r.organizationHandler.Switch)
 			}
 
 			articlesProtected := protected.Group("/articles")
 			{
-				articlesProtected.POST("", r.articleHandler.Create)
-				articlesProtected.GET("", r.articleHandler.List)
-				articlesProtected.GET("/:id", r.articleHandler.Get)
-				articlesProtected.PUT("/:id", r.articleHandler.Update)
-				articlesProtected.DELETE("/:id", r.articleHandler.Delete)
+				articlesProtected.POST("", middleware.RequirePermission(domainuser.PermArticlesWrite), requireVerified, r.articleHandler.Create)
+				articlesProtected.GET("", middleware.RequirePermission(domainuser.PermArticlesRead), r.articleHandler.List)
+				articlesProtected.GET("/:id", middleware.RequirePermission(domainuser.PermArticlesRead), r.articleHandler.Get)
+				articlesProtected.GET("/by-slug/:slug", middleware.RequirePermission(domainuser.PermArticlesRead), r.articleSlugHandler.Get)
+				articlesProtected.GET("/search", middleware.RequirePermission(domainuser.PermArticlesRead), r.articleSearch.Search)
+				articlesProtected.PUT("/:id", middleware.RequirePermission(domainuser.PermArticlesWrite), r.articleHandler.Update)
+				articlesProtected.DELETE("/:id", middleware.RequirePermission(domainuser.PermArticlesWrite), r.articleHandler.Delete)
+
+				// Editorial workflow, who may move an article is checked against its author and status
+				articlesProtected.POST("/:id/submit", middleware.RequirePermission(domainuser.PermArticlesWrite), r.articleWorkflow.Submit)
+				articlesProtected.POST("/:id/publish", middleware.RequirePermission(domainuser.PermArticlesPublish), r.articleWorkflow.Publish)
+				articlesProtected.POST("/:id/archive", middleware.RequirePermission(domainuser.PermArticlesWrite), r.articleWorkflow.Archive)
+				articlesProtected.POST("/:id/reopen", middleware.RequirePermission(domainuser.PermArticlesWrite), r.articleWorkflow.Reopen)
+				articlesProtected.POST("/:id/schedule", middleware.RequirePermission(domainuser.PermArticlesPublish), r.articleWorkflow.Schedule)
+				articlesProtected.DELETE("/:id/schedule", middleware.RequirePermission(domainuser.PermArticlesPublish), r.articleWorkflow.Unschedule)
+
+				// Tags of an article, who may tag it is checked against its author
+				articlesProtected.GET("/:id/tags", middleware.RequirePermission(domainuser.PermArticlesRead), r.tagHandler.ListByArticle)
+				articlesProtected.PUT("/:id/tags", middleware.RequirePermission(domainuser.PermArticlesWrite), r.tagHandler.SetForArticle)
+			}
+
+			tags := protected.Group("/tags")
+			{
+				tags.GET("", middleware.RequirePermission(domainuser.PermArticlesRead), r.tagHandler.List)
+				tags.POST("", middleware.RequirePermission(domainuser.PermTaxonomyManage), r.tagHandler.Create)
+				tags.PUT("/:id", middleware.RequirePermission(domainuser.PermTaxonomyManage), r.tagHandler.Update)
+				tags.DELETE("/:id", middleware.RequirePermission(domainuser.PermTaxonomyManage), r.tagHandler.Delete)
+			}
+
+			categories := protected.Group("/categories")
+			{
+				categories.GET("", middleware.RequirePermission(domainuser.PermArticlesRead), r.categoryHandler.List)
+				categories.POST("", middleware.RequirePermission(domainuser.PermTaxonomyManage), r.categoryHandler.Create)
+				categories.PUT("/:id", middleware.RequirePermission(domainuser.PermTaxonomyManage), r.categoryHandler.Update)
+				categories.DELETE("/:id", middleware.RequirePermission(domainuser.PermTaxonomyManage), r.categoryHandler.Delete)
 			}
 
 			mediaProtected := protected.Group("/media")
 			{
-				mediaProtected.POST("", r.mediaHandler.Create)
-				mediaProtected.GET("", r.mediaHandler.List)
-				mediaProtected.GET("/:id", r.mediaHandler.Get)
-				mediaProtected.PUT("/:id", r.mediaHandler.Update)
-				mediaProtected.DELETE("/:id", r.mediaHandler.Delete)
+				mediaProtected.POST("", middleware.RequirePermission(domainuser.PermMediaWrite), requireVerified, r.mediaHandler.Create)
+				mediaProtected.GET("", middleware.RequirePermission(domainuser.PermMediaRead), r.mediaHandler.List)
+				mediaProtected.GET("/:id", middleware.RequirePermission(domainuser.PermMediaRead), r.mediaHandler.Get)
+				mediaProtected.PUT("/:id", middleware.RequirePermission(domainuser.PermMediaWrite), r.mediaHandler.Update)
+				mediaProtected.DELETE("/:id", middleware.RequirePermission(domainuser.PermMediaDelete), r.mediaHandler.Delete)
+			}
+
+			// Deleted items stay in the trash until purged, admins may restore them
+			trash := protected.Group("/admin/trash", middleware.RequirePermission(domainuser.PermTrashManage))
+			{
+				trash.GET("/users", r.userTrashHandler.List)
+				trash.POST("/users/:id/restore", r.userTrashHandler.Restore)
+				trash.GET("/articles", r.articleTrashHandler.List)
+				trash.POST("/articles/:id/restore", r.articleTrashHandler.Restore)
+				trash.GET("/media", r.mediaTrashHandler.List)
+				trash.POST("/media/:id/restore", r.mediaTrashHandler.Restore)
+			}
+
+			// Data export, erasure and impersonation on behalf of a user
+			admin := protected.Group("/admin")
+			{
+				admin.POST("/users/:id/impersonate", middleware.RequirePermission(domainuser.PermUsersImpersonate), requireBearer, r.impersonateHandler.Impersonate)
+				admin.POST("/users/:id/export", middleware.RequirePermission(domainuser.PermUsersWrite), r.privacyHandler.AdminExport)
+				admin.POST("/users/:id/erasure", middleware.RequirePermission(domainuser.PermUsersWrite), r.privacyHandler.AdminErase)
+				admin.GET("/data-requests/:id", middleware.RequirePermission(domainuser.PermUsersRead), r.privacyHandler.AdminGet)
+				admin.GET("/data-requests/:id/download", middleware.RequirePermission(domainuser.PermUsersRead), r.privacyHandler.AdminDownload)
 			}
 		}
 	}
 
+	// Public keys for verifying access tokens in other services
+	engine.GET("/.well-known/jwks.json", r.jwksHandler.JWKS)
+
 	// Health check endpoint
 	engine.GET("/health", func(c *gin.Context) {
 		response.SuccessResponseOK(c, "Service is healthy", gin.H{"status": "ok"})
 	})
+
+	return nil
 }
@@ -0,0 +1,467 @@
+package http
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	httparticle "github.com/rulzi/hexa-go/internal/adapters/http/article"
+	httpmedia "github.com/rulzi/hexa-go/internal/adapters/http/media"
+	"github.com/rulzi/hexa-go/internal/adapters/http/middleware"
+	httpprivacy "github.com/rulzi/hexa-go/internal/adapters/http/privacy"
+	httpuser "github.com/rulzi/hexa-go/internal/adapters/http/user"
+	domainuser "github.com/rulzi/hexa-go/internal/domain/user"
+	"github.com/stretchr/testify/assert"
+)
+
+// stubTokenValidator treats the bearer token as the role of the caller.
+// A ":unverified" suffix marks the caller's email as unverified,
+// an ":impersonated" suffix makes it a token of admin 9 acting as the caller.
+type stubTokenValidator struct{}
+
+func (stubTokenValidator) Validate(token string) (*domainuser.TokenClaims, error) {
+	token, impersonated := strings.CutSuffix(token, ":impersonated")
+	roleName, unverified := strings.CutSuffix(token, ":unverified")
+	role := domainuser.Role(roleName)
+	if !role.IsValid() {
+		return nil, errors.New("invalid token")
+	}
+	claims := &domainuser.TokenClaims{UserID: 1, Email: "test@example.com", Role: role, EmailVerified: !unverified}
+	if impersonated {
+		claims.ActorID = 9
+	}
+	return claims, nil
+}
+
+// stubAPIKeyAuthenticator treats "hxa_<role>" keys as keys of a user with that role.
+// A "/<permission>" suffix scopes the key to that permission.
+type stubAPIKeyAuthenticator struct{}
+
+func (stubAPIKeyAuthenticator) Authenticate(ctx context.Context, key string) (*domainuser.TokenClaims, error) {
+	roleName, found := strings.CutPrefix(key, "hxa_")
+	roleName, scope, scoped := strings.Cut(roleName, "/")
+	role := domainuser.Role(roleName)
+	if !found || !role.IsValid() {
+		return nil, domainuser.ErrInvalidAPIKey
+	}
+	claims := &domainuser.TokenClaims{UserID: 1, Email: "ci@example.com", Role: role, EmailVerified: true}
+	if scoped {
+		claims.Scopes = []domainuser.Permission{domainuser.Permission(scope)}
+	}
+	return claims, nil
+}
+
+func setupTestEngine() *gin.Engine {
+	return setupTestEngineWithPolicy(domainuser.VerificationPolicyNone)
+}
+
+func setupTestEngineWithPolicy(policy domainuser.EmailVerificationPolicy) *gin.Engine {
+	return setupTestEngineWith(policy, httpuser.NewIdentityHandler(nil, nil, nil, "", time.Minute, false))
+}
+
+func setupTestEngineWith(policy domainuser.EmailVerificationPolicy, identityHandler *httpuser.IdentityHandler) *gin.Engine {
+	return setupTestEngineWithMode(policy, identityHandler, domainuser.RegistrationOpen)
+}
+
+func setupTestEngineWithMode(
+	policy domainuser.EmailVerificationPolicy,
+	identityHandler *httpuser.IdentityHandler,
+	mode domainuser.RegistrationMode,
+) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	// Handlers are built without use cases, so allowed requests may panic; keep the output quiet
+	gin.DefaultErrorWriter = io.Discard
+
+	router := NewRouter(
+		httpuser.NewHandler(nil, nil, nil, nil, nil, nil),
+		httpuser.NewTokenHandler(nil, nil),
+		httpuser.NewPasswordHandler(nil, nil),
+		httpuser.NewVerificationHandler(nil, nil),
+		httpuser.NewLoginActivityHandler(nil),
+		httpuser.NewTwoFactorHandler(nil, nil, nil, nil, nil),
+		httpuser.NewJWKSHandler(nil),
+		httpuser.NewAPIKeyHandler(nil, nil, nil),
+		identityHandler,
+		httpuser.NewProfileHandler(nil, nil, nil, nil),
+		httpuser.NewSessionHandler(nil, nil, nil),
+		httpuser.NewOrganizationHandler(nil, nil, nil),
+		httpuser.NewInvitationHandler(nil, nil),
+		httpuser.NewImpersonationHandler(nil),
+		httpuser.NewTrashHandler(nil, nil),
+		httparticle.NewHandler(nil, nil, nil, nil, nil),
+		httparticle.NewTrashHandler(nil, nil),
+		httparticle.NewWorkflowHandler(nil, nil),
+		httparticle.NewSlugHandler(nil),
+		httparticle.NewSearchHandler(nil),
+		httparticle.NewTagHandler(nil, nil, nil, nil, nil, nil),
+		httparticle.NewCategoryHandler(nil, nil, nil, nil),
+		httpmedia.NewHandler(nil, nil, nil, nil, nil),
+		httpmedia.NewTrashHandler(nil, nil),
+		httpmedia.NewFileHandler(nil),
+		httpprivacy.NewHandler(nil, nil, nil, nil),
+		stubTokenValidator{},
+		nil,
+		stubAPIKeyAuthenticator{},
+		policy,
+		mode,
+		nil,
+	)
+
+	engine := gin.New()
+	if err := router.SetupRoutes(engine, false); err != nil {
+		panic(err)
+	}
+	return engine
+}
+
+func TestRouter_AccessMatrix(t *testing.T) {
+	admin := domainuser.RoleAdmin
+	editor := domainuser.RoleEditor
+	author := domainuser.RoleAuthor
+	reader := domainuser.RoleReader
+
+	routes := []struct {
+		method  string
+		path    string
+		allowed []domainuser.Role
+	}{
+		{http.MethodPost, "/api/v1/users", []domainuser.Role{admin}},
+		{http.MethodGet, "/api/v1/users", []domainuser.Role{admin}},
+		{http.MethodGet, "/api/v1/users/1", []domainuser.Role{admin}},
+		{http.MethodPut, "/api/v1/users/1", []domainuser.Role{admin}},
+		{http.MethodDelete, "/api/v1/users/1", []domainuser.Role{admin}},
+		{http.MethodGet, "/api/v1/users/1/login-attempts", []domainuser.Role{admin}},
+		{http.MethodPost, "/api/v1/users/invitations", []domainuser.Role{admin}},
+		{http.MethodPost, "/api/v1/admin/users/5/impersonate", []domainuser.Role{admin}},
+		{http.MethodPost, "/api/v1/users/logout", []domainuser.Role{admin, editor, author, reader}},
+		{http.MethodGet, "/api/v1/users/me", []domainuser.Role{admin, editor, author, reader}},
+		{http.MethodPut, "/api/v1/users/me", []domainuser.Role{admin, editor, author, reader}},
+		{http.MethodDelete, "/api/v1/users/me", []domainuser.Role{admin, editor, author, reader}},
+		{http.MethodPut, "/api/v1/users/me/password", []domainuser.Role{admin, editor, author, reader}},
+		{http.MethodGet, "/api/v1/users/me/sessions", []domainuser.Role{admin, editor, author, reader}},
+		{http.MethodDelete, "/api/v1/users/me/sessions", []domainuser.Role{admin, editor, author, reader}},
+		{http.MethodDelete, "/api/v1/users/me/sessions/abc", []domainuser.Role{admin, editor, author, reader}},
+		{http.MethodPost, "/api/v1/users/me/2fa/enroll", []domainuser.Role{admin, editor, author, reader}},
+		{http.MethodPost, "/api/v1/users/me/2fa/confirm", []domainuser.Role{admin, editor, author, reader}},
+		{http.MethodPost, "/api/v1/users/me/2fa/recovery-codes", []domainuser.Role{admin, editor, author, reader}},
+		{http.MethodPost, "/api/v1/users/me/2fa/disable", []domainuser.Role{admin, editor, author, reader}},
+		{http.MethodPost, "/api/v1/users/me/api-keys", []domainuser.Role{admin, editor, author, reader}},
+		{http.MethodGet, "/api/v1/users/me/api-keys", []domainuser.Role{admin, editor, author, reader}},
+		{http.MethodDelete, "/api/v1/users/me/api-keys/1", []domainuser.Role{admin, editor, author, reader}},
+		{http.MethodPost, "/api/v1/users/me/export", []domainuser.Role{admin, editor, author, reader}},
+		{http.MethodPost, "/api/v1/users/me/erasure", []domainuser.Role{admin, editor, author, reader}},
+		{http.MethodGet, "/api/v1/users/me/data-requests/1", []domainuser.Role{admin, editor, author, reader}},
+		{http.MethodGet, "/api/v1/users/me/data-requests/1/download", []domainuser.Role{admin, editor, author, reader}},
+		{http.MethodGet, "/api/v1/organizations", []domainuser.Role{admin, editor, author, reader}},
+		{http.MethodPost, "/api/v1/organizations", []domainuser.Role{admin, editor, author, reader}},
+		{http.MethodPost, "/api/v1/organizations/1/switch", []domainuser.Role{admin, editor, author, reader}},
+
+		{http.MethodPost, "/api/v1/articles", []domainuser.Role{admin, editor, author}},
+		{http.MethodGet, "/api/v1/articles", []domainuser.Role{admin, editor, author, reader}},
+		{http.MethodGet, "/api/v1/articles/1", []domainuser.Role{admin, editor, author, reader}},
+		{http.MethodGet, "/api/v1/articles/by-slug/hello-world", []domainuser.Role{admin, editor, author, reader}},
+		{http.MethodGet, "/api/v1/articles/search?q=go", []domainuser.Role{admin, editor, author, reader}},
+		{http.MethodGet, "/api/v1/articles/1/tags", []domainuser.Role{admin, editor, author, reader}},
+		{http.MethodPut, "/api/v1/articles/1/tags", []domainuser.Role{admin, editor, author}},
+		{http.MethodGet, "/api/v1/tags", []domainuser.Role{admin, editor, author, reader}},
+		{http.MethodPost, "/api/v1/tags", []domainuser.Role{admin, editor}},
+		{http.MethodPut, "/api/v1/tags/1", []domainuser.Role{admin, editor}},
+		{http.MethodDelete, "/api/v1/tags/1", []domainuser.Role{admin, editor}},
+		{http.MethodGet, "/api/v1/categories", []domainuser.Role{admin, editor, author, reader}},
+		{http.MethodPost, "/api/v1/categories", []domainuser.Role{admin, editor}},
+		{http.MethodPut, "/api/v1/categories/1", []domainuser.Role{admin, editor}},
+		{http.MethodDelete, "/api/v1/categories/1", []domainuser.Role{admin, editor}},
+		{http.MethodPut, "/api/v1/articles/1", []domainuser.Role{admin, editor, author}},
+		{http.MethodDelete, "/api/v1/articles/1", []domainuser.Role{admin, editor, author}},
+
+		{http.MethodPost, "/api/v1/media", []domainuser.Role{admin, editor, author}},
+		{http.MethodGet, "/api/v1/media", []domainuser.Role{admin, editor, author, reader}},
+		{http.MethodGet, "/api/v1/media/1", []domainuser.Role{admin, editor, author, reader}},
+		{http.MethodPut, "/api/v1/media/1", []domainuser.Role{admin, editor, author}},
+		{http.MethodDelete, "/api/v1/media/1", []domainuser.Role{admin, editor}},
+
+		{http.MethodGet, "/api/v1/admin/trash/users", []domainuser.Role{admin}},
+		{http.MethodPost, "/api/v1/admin/trash/users/1/restore", []domainuser.Role{admin}},
+		{http.MethodGet, "/api/v1/admin/trash/articles", []domainuser.Role{admin}},
+		{http.MethodPost, "/api/v1/admin/trash/articles/1/restore", []domainuser.Role{admin}},
+		{http.MethodGet, "/api/v1/admin/trash/media", []domainuser.Role{admin}},
+		{http.MethodPost, "/api/v1/admin/trash/media/1/restore", []domainuser.Role{admin}},
+		{http.MethodPost, "/api/v1/admin/users/1/export", []domainuser.Role{admin}},
+		{http.MethodPost, "/api/v1/admin/users/1/erasure", []domainuser.Role{admin}},
+		{http.MethodGet, "/api/v1/admin/data-requests/1", []domainuser.Role{admin}},
+		{http.MethodGet, "/api/v1/admin/data-requests/1/download", []domainuser.Role{admin}},
+	}
+
+	engine := setupTestEngine()
+
+	for _, route := range routes {
+		for _, role := range []domainuser.Role{admin, editor, author, reader} {
+			allowed := false
+			for _, r := range route.allowed {
+				if r == role {
+					allowed = true
+				}
+			}
+
+			t.Run(route.method+" "+route.path+" as "+string(role), func(t *testing.T) {
+				req := httptest.NewRequest(route.method, route.path, nil)
+				req.Header.Set("Authorization", "Bearer "+string(role))
+				w := httptest.NewRecorder()
+
+				engine.ServeHTTP(w, req)
+
+				assert.NotEqual(t, http.StatusUnauthorized, w.Code)
+				if allowed {
+					assert.NotEqual(t, http.StatusForbidden, w.Code)
+				} else {
+					assert.Equal(t, http.StatusForbidden, w.Code)
+				}
+			})
+		}
+
+		t.Run(route.method+" "+route.path+" unauthenticated", func(t *testing.T) {
+			req := httptest.NewRequest(route.method, route.path, nil)
+			w := httptest.NewRecorder()
+
+			engine.ServeHTTP(w, req)
+
+			assert.Equal(t, http.StatusUnauthorized, w.Code)
+		})
+	}
+}
+
+func TestRouter_PublicRoutes(t *testing.T) {
+	engine := setupTestEngine()
+
+	for _, path := range []string{"/api/v1/users/register", "/api/v1/users/login", "/api/v1/users/refresh",
+		"/api/v1/users/password/forgot", "/api/v1/users/password/reset", "/api/v1/users/verify/resend",
+		"/api/v1/users/login/2fa", "/api/v1/users/invitations/accept"} {
+		t.Run(path, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, path, nil)
+			w := httptest.NewRecorder()
+
+			engine.ServeHTTP(w, req)
+
+			assert.NotEqual(t, http.StatusUnauthorized, w.Code)
+			assert.NotEqual(t, http.StatusForbidden, w.Code)
+		})
+	}
+}
+
+func TestRouter_IdentityRoutes(t *testing.T) {
+	paths := map[string]string{
+		"/api/v1/users/oidc/login":    http.MethodGet,
+		"/api/v1/users/oidc/callback": http.MethodGet,
+		"/api/v1/users/oidc/token":    http.MethodPost,
+	}
+
+	engine := setupTestEngine()
+	for path, method := range paths {
+		t.Run(path, func(t *testing.T) {
+			req := httptest.NewRequest(method, path, nil)
+			w := httptest.NewRecorder()
+
+			engine.ServeHTTP(w, req)
+
+			assert.NotEqual(t, http.StatusNotFound, w.Code)
+			assert.NotEqual(t, http.StatusUnauthorized, w.Code)
+			assert.NotEqual(t, http.StatusForbidden, w.Code)
+		})
+	}
+
+	// Without an identity provider the routes don't exist
+	engine = setupTestEngineWith(domainuser.VerificationPolicyNone, nil)
+	for path, method := range paths {
+		t.Run(path+" without provider", func(t *testing.T) {
+			req := httptest.NewRequest(method, path, nil)
+			w := httptest.NewRecorder()
+
+			engine.ServeHTTP(w, req)
+
+			assert.Equal(t, http.StatusNotFound, w.Code)
+		})
+	}
+}
+
+func TestRouter_RegistrationMode(t *testing.T) {
+	tests := []struct {
+		mode         domainuser.RegistrationMode
+		wantRegister bool
+	}{
+		{mode: domainuser.RegistrationOpen, wantRegister: true},
+		{mode: domainuser.RegistrationInviteOnly, wantRegister: false},
+		{mode: domainuser.RegistrationDisabled, wantRegister: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.mode), func(t *testing.T) {
+			engine := setupTestEngineWithMode(domainuser.VerificationPolicyNone, nil, tt.mode)
+
+			req := httptest.NewRequest(http.MethodPost, "/api/v1/users/register", nil)
+			w := httptest.NewRecorder()
+
+			engine.ServeHTTP(w, req)
+
+			if tt.wantRegister {
+				assert.NotEqual(t, http.StatusForbidden, w.Code)
+			} else {
+				assert.Equal(t, http.StatusForbidden, w.Code)
+			}
+		})
+	}
+}
+
+func TestRouter_EmailVerificationPolicy(t *testing.T) {
+	tests := []struct {
+		name       string
+		policy     domainuser.EmailVerificationPolicy
+		token      string
+		wantStatus func(int) bool
+	}{
+		{name: "unverified allowed without policy", policy: domainuser.VerificationPolicyNone, token: "author:unverified", wantStatus: func(code int) bool { return code != http.StatusForbidden }},
+		{name: "unverified blocked by content policy", policy: domainuser.VerificationPolicyContent, token: "author:unverified", wantStatus: func(code int) bool { return code == http.StatusForbidden }},
+		{name: "verified allowed by content policy", policy: domainuser.VerificationPolicyContent, token: "author", wantStatus: func(code int) bool { return code != http.StatusForbidden }},
+	}
+
+	for _, tt := range tests {
+		engine := setupTestEngineWithPolicy(tt.policy)
+
+		for _, path := range []string{"/api/v1/articles", "/api/v1/media"} {
+			t.Run(tt.name+" "+path, func(t *testing.T) {
+				req := httptest.NewRequest(http.MethodPost, path, nil)
+				req.Header.Set("Authorization", "Bearer "+tt.token)
+				w := httptest.NewRecorder()
+
+				engine.ServeHTTP(w, req)
+
+				assert.True(t, tt.wantStatus(w.Code), "unexpected status %d", w.Code)
+			})
+		}
+	}
+
+	t.Run("reads are not gated", func(t *testing.T) {
+		engine := setupTestEngineWithPolicy(domainuser.VerificationPolicyContent)
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/articles", nil)
+		req.Header.Set("Authorization", "Bearer reader:unverified")
+		w := httptest.NewRecorder()
+
+		engine.ServeHTTP(w, req)
+
+		assert.NotEqual(t, http.StatusForbidden, w.Code)
+	})
+}
+
+func TestRouter_APIKeys(t *testing.T) {
+	engine := setupTestEngine()
+
+	tests := []struct {
+		name       string
+		method     string
+		path       string
+		key        string
+		wantStatus int
+	}{
+		{name: "unknown key", method: http.MethodGet, path: "/api/v1/articles", key: "nope", wantStatus: http.StatusUnauthorized},
+		{name: "scope outside the role", method: http.MethodGet, path: "/api/v1/users", key: "hxa_editor/users:read", wantStatus: http.StatusForbidden},
+		{name: "permission outside the scope", method: http.MethodPost, path: "/api/v1/articles", key: "hxa_editor/articles:read", wantStatus: http.StatusForbidden},
+		{name: "keys cannot manage keys", method: http.MethodPost, path: "/api/v1/users/me/api-keys", key: "hxa_admin", wantStatus: http.StatusForbidden},
+		{name: "keys cannot change two-factor settings", method: http.MethodPost, path: "/api/v1/users/me/2fa/disable", key: "hxa_admin", wantStatus: http.StatusForbidden},
+		{name: "keys cannot change the account", method: http.MethodPut, path: "/api/v1/users/me", key: "hxa_admin", wantStatus: http.StatusForbidden},
+		{name: "keys cannot delete the account", method: http.MethodDelete, path: "/api/v1/users/me", key: "hxa_admin", wantStatus: http.StatusForbidden},
+		{name: "keys cannot change the password", method: http.MethodPut, path: "/api/v1/users/me/password", key: "hxa_admin", wantStatus: http.StatusForbidden},
+		{name: "keys cannot manage sessions", method: http.MethodDelete, path: "/api/v1/users/me/sessions", key: "hxa_admin", wantStatus: http.StatusForbidden},
+		{name: "keys cannot switch organizations", method: http.MethodPost, path: "/api/v1/organizations/1/switch", key: "hxa_admin", wantStatus: http.StatusForbidden},
+		{name: "keys cannot impersonate", method: http.MethodPost, path: "/api/v1/admin/users/5/impersonate", key: "hxa_admin", wantStatus: http.StatusForbidden},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, tt.path, nil)
+			req.Header.Set("X-API-Key", tt.key)
+			w := httptest.NewRecorder()
+
+			engine.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.wantStatus, w.Code)
+		})
+	}
+
+	// Keys within their scope get past authentication and authorization
+	for _, key := range []string{"hxa_reader", "hxa_editor/articles:read"} {
+		t.Run("allowed "+key, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/articles", nil)
+			req.Header.Set("X-API-Key", key)
+			w := httptest.NewRecorder()
+
+			engine.ServeHTTP(w, req)
+
+			assert.NotEqual(t, http.StatusUnauthorized, w.Code)
+			assert.NotEqual(t, http.StatusForbidden, w.Code)
+		})
+	}
+}
+
+func TestRouter_ImpersonationTokens(t *testing.T) {
+	engine := setupTestEngine()
+
+	// Admins acting as a user can't change the account or credentials of that user
+	for _, route := range []struct{ method, path string }{
+		{http.MethodPut, "/api/v1/users/me/password"},
+		{http.MethodPost, "/api/v1/users/me/2fa/disable"},
+		{http.MethodPost, "/api/v1/users/me/api-keys"},
+		{http.MethodPost, "/api/v1/users/me/export"},
+		{http.MethodPost, "/api/v1/organizations/1/switch"},
+	} {
+		t.Run(route.method+" "+route.path, func(t *testing.T) {
+			req := httptest.NewRequest(route.method, route.path, nil)
+			req.Header.Set("Authorization", "Bearer author:impersonated")
+			w := httptest.NewRecorder()
+
+			engine.ServeHTTP(w, req)
+
+			assert.Equal(t, http.StatusForbidden, w.Code)
+			assert.Equal(t, "9", w.Header().Get(middleware.ImpersonationHeader))
+		})
+	}
+
+	// Everything the user may do is marked
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/articles", nil)
+	req.Header.Set("Authorization", "Bearer author:impersonated")
+	w := httptest.NewRecorder()
+
+	engine.ServeHTTP(w, req)
+
+	assert.NotEqual(t, http.StatusUnauthorized, w.Code)
+	assert.NotEqual(t, http.StatusForbidden, w.Code)
+	assert.Equal(t, "9", w.Header().Get(middleware.ImpersonationHeader))
+}
+
+func TestRouter_ForwardedForIsIgnoredWithoutTrustedProxies(t *testing.T) {
+	engine := setupTestEngine()
+	engine.GET("/ip", func(c *gin.Context) {
+		c.String(http.StatusOK, c.ClientIP())
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/ip", nil)
+	req.RemoteAddr = "198.51.100.7:4321"
+	req.Header.Set("X-Forwarded-For", "203.0.113.9")
+	w := httptest.NewRecorder()
+
+	engine.ServeHTTP(w, req)
+
+	assert.Equal(t, "198.51.100.7", w.Body.String())
+}
+
+func TestRouter_InvalidTrustedProxies(t *testing.T) {
+	router := NewRouter(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, "", "", []string{"not-an-ip"})
+
+	err := router.SetupRoutes(gin.New(), false)
+
+	assert.Error(t, err)
+}
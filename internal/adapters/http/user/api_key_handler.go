@@ -0,0 +1,96 @@
+package user
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rulzi/hexa-go/internal/adapters/http/response"
+	"github.com/rulzi/hexa-go/internal/application/user/dto"
+	domainuser "github.com/rulzi/hexa-go/internal/domain/user"
+)
+
+// CreateAPIKeyUseCase is the interface for the create API key use case
+type CreateAPIKeyUseCase interface {
+	Execute(ctx context.Context, userID, orgID int64, req dto.CreateAPIKeyRequest) (*dto.CreateAPIKeyResponse, error)
+}
+
+// ListAPIKeysUseCase is the interface for the list API keys use case
+type ListAPIKeysUseCase interface {
+	Execute(ctx context.Context, userID int64) ([]dto.APIKeyResponse, error)
+}
+
+// RevokeAPIKeyUseCase is the interface for the revoke API key use case
+type RevokeAPIKeyUseCase interface {
+	Execute(ctx context.Context, userID, id int64) error
+}
+
+// APIKeyHandler handles HTTP requests for the API keys of the current user
+type APIKeyHandler struct {
+	createUseCase CreateAPIKeyUseCase
+	listUseCase   ListAPIKeysUseCase
+	revokeUseCase RevokeAPIKeyUseCase
+}
+
+// NewAPIKeyHandler creates a new APIKeyHandler
+func NewAPIKeyHandler(createUseCase CreateAPIKeyUseCase, listUseCase ListAPIKeysUseCase, revokeUseCase RevokeAPIKeyUseCase) *APIKeyHandler {
+	return &APIKeyHandler{
+		createUseCase: createUseCase,
+		listUseCase:   listUseCase,
+		revokeUseCase: revokeUseCase,
+	}
+}
+
+// Create handles POST /users/me/api-keys
+func (h *APIKeyHandler) Create(c *gin.Context) {
+	var req dto.CreateAPIKeyRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.ErrorResponseBadRequest(c, err.Error())
+		return
+	}
+
+	resp, err := h.createUseCase.Execute(c.Request.Context(), c.GetInt64("user_id"), c.GetInt64("org_id"), req)
+	if err != nil {
+		if err == domainuser.ErrInvalidAPIKeyScope || err == domainuser.ErrInvalidAPIKeyExpiry {
+			response.ErrorResponseBadRequest(c, err.Error())
+		} else if err == domainuser.ErrUserNotFound {
+			response.ErrorResponseNotFound(c, err.Error())
+		} else {
+			response.ErrorResponseInternalServerError(c, err.Error())
+		}
+		return
+	}
+
+	response.SuccessResponseCreated(c, "API key created, store it now as it will not be shown again", resp)
+}
+
+// List handles GET /users/me/api-keys
+func (h *APIKeyHandler) List(c *gin.Context) {
+	resp, err := h.listUseCase.Execute(c.Request.Context(), c.GetInt64("user_id"))
+	if err != nil {
+		response.ErrorResponseInternalServerError(c, err.Error())
+		return
+	}
+
+	response.SuccessResponseOK(c, "API keys retrieved successfully", resp)
+}
+
+// Revoke handles DELETE /users/me/api-keys/:id
+func (h *APIKeyHandler) Revoke(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		response.ErrorResponseBadRequest(c, "invalid api key id")
+		return
+	}
+
+	if err := h.revokeUseCase.Execute(c.Request.Context(), c.GetInt64("user_id"), id); err != nil {
+		if err == domainuser.ErrAPIKeyNotFound {
+			response.ErrorResponseNotFound(c, err.Error())
+		} else {
+			response.ErrorResponseInternalServerError(c, err.Error())
+		}
+		return
+	}
+
+	response.SuccessResponseOK(c, "API key revoked successfully", nil)
+}
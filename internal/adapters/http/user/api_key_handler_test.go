@@ -0,0 +1,186 @@
+package user
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rulzi/hexa-go/internal/application/user/dto"
+	domainuser "github.com/rulzi/hexa-go/internal/domain/user"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// mockCreateAPIKeyUseCase is a mock implementation of CreateAPIKeyUseCase
+type mockCreateAPIKeyUseCase struct {
+	mock.Mock
+}
+
+func (m *mockCreateAPIKeyUseCase) Execute(ctx context.Context, userID, orgID int64, req dto.CreateAPIKeyRequest) (*dto.CreateAPIKeyResponse, error) {
+	args := m.Called(ctx, userID, orgID, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.CreateAPIKeyResponse), args.Error(1)
+}
+
+// mockListAPIKeysUseCase is a mock implementation of ListAPIKeysUseCase
+type mockListAPIKeysUseCase struct {
+	mock.Mock
+}
+
+func (m *mockListAPIKeysUseCase) Execute(ctx context.Context, userID int64) ([]dto.APIKeyResponse, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]dto.APIKeyResponse), args.Error(1)
+}
+
+// mockRevokeAPIKeyUseCase is a mock implementation of RevokeAPIKeyUseCase
+type mockRevokeAPIKeyUseCase struct {
+	mock.Mock
+}
+
+func (m *mockRevokeAPIKeyUseCase) Execute(ctx context.Context, userID, id int64) error {
+	args := m.Called(ctx, userID, id)
+	return args.Error(0)
+}
+
+func TestAPIKeyHandler_Create(t *testing.T) {
+	tests := []struct {
+		name       string
+		body       string
+		setup      func(uc *mockCreateAPIKeyUseCase)
+		wantStatus int
+	}{
+		{
+			name: "success",
+			body: `{"name":"ci","scopes":["articles:read"]}`,
+			setup: func(uc *mockCreateAPIKeyUseCase) {
+				uc.On("Execute", mock.Anything, int64(1), int64(1), dto.CreateAPIKeyRequest{Name: "ci", Scopes: []string{"articles:read"}}).
+					Return(&dto.CreateAPIKeyResponse{Key: "hxa_key"}, nil)
+			},
+			wantStatus: http.StatusCreated,
+		},
+		{
+			name:       "missing name",
+			body:       `{}`,
+			setup:      func(uc *mockCreateAPIKeyUseCase) {},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name: "invalid scope",
+			body: `{"name":"ci","scopes":["users:write"]}`,
+			setup: func(uc *mockCreateAPIKeyUseCase) {
+				uc.On("Execute", mock.Anything, int64(1), int64(1), mock.Anything).Return(nil, domainuser.ErrInvalidAPIKeyScope)
+			},
+			wantStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			createUC := &mockCreateAPIKeyUseCase{}
+			handler := NewAPIKeyHandler(createUC, nil, nil)
+			tt.setup(createUC)
+
+			gin.SetMode(gin.TestMode)
+			router := gin.New()
+			router.POST("/users/me/api-keys", withAuthContext(1, "jti", time.Now().Add(time.Hour)), handler.Create)
+
+			req := httptest.NewRequest(http.MethodPost, "/users/me/api-keys", bytes.NewBufferString(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.wantStatus, w.Code)
+			createUC.AssertExpectations(t)
+		})
+	}
+}
+
+func TestAPIKeyHandler_List(t *testing.T) {
+	listUC := &mockListAPIKeysUseCase{}
+	handler := NewAPIKeyHandler(nil, listUC, nil)
+	listUC.On("Execute", mock.Anything, int64(1)).Return([]dto.APIKeyResponse{{ID: 7, Name: "ci"}}, nil)
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.GET("/users/me/api-keys", withAuthContext(1, "jti", time.Now().Add(time.Hour)), handler.List)
+
+	req := httptest.NewRequest(http.MethodGet, "/users/me/api-keys", nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `"name":"ci"`)
+	listUC.AssertExpectations(t)
+}
+
+func TestAPIKeyHandler_Revoke(t *testing.T) {
+	tests := []struct {
+		name       string
+		path       string
+		setup      func(uc *mockRevokeAPIKeyUseCase)
+		wantStatus int
+	}{
+		{
+			name: "success",
+			path: "/users/me/api-keys/7",
+			setup: func(uc *mockRevokeAPIKeyUseCase) {
+				uc.On("Execute", mock.Anything, int64(1), int64(7)).Return(nil)
+			},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "invalid id",
+			path:       "/users/me/api-keys/abc",
+			setup:      func(uc *mockRevokeAPIKeyUseCase) {},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name: "not found",
+			path: "/users/me/api-keys/7",
+			setup: func(uc *mockRevokeAPIKeyUseCase) {
+				uc.On("Execute", mock.Anything, int64(1), int64(7)).Return(domainuser.ErrAPIKeyNotFound)
+			},
+			wantStatus: http.StatusNotFound,
+		},
+		{
+			name: "internal error",
+			path: "/users/me/api-keys/7",
+			setup: func(uc *mockRevokeAPIKeyUseCase) {
+				uc.On("Execute", mock.Anything, int64(1), int64(7)).Return(errors.New("database error"))
+			},
+			wantStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			revokeUC := &mockRevokeAPIKeyUseCase{}
+			handler := NewAPIKeyHandler(nil, nil, revokeUC)
+			tt.setup(revokeUC)
+
+			gin.SetMode(gin.TestMode)
+			router := gin.New()
+			router.DELETE("/users/me/api-keys/:id", withAuthContext(1, "jti", time.Now().Add(time.Hour)), handler.Revoke)
+
+			req := httptest.NewRequest(http.MethodDelete, tt.path, nil)
+			w := httptest.NewRecorder()
+
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.wantStatus, w.Code)
+			revokeUC.AssertExpectations(t)
+		})
+	}
+}
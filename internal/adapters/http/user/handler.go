@@ -2,6 +2,8 @@ package user
 
 import (
 	"context"
+	"errors"
+	"math"
 	"strconv"
 
 	"github.com/gin-gonic/gin"
@@ -17,22 +19,22 @@ type CreateUserUseCase interface {
 
 // GetUserUseCase is the interface for the get user use case
 type GetUserUseCase interface {
-	Execute(ctx context.Context, id int64) (*dto.UserResponse, error)
+	Execute(ctx context.Context, orgID, id int64) (*dto.UserResponse, error)
 }
 
 // ListUsersUseCase is the interface for the list users use case
 type ListUsersUseCase interface {
-	Execute(ctx context.Context, limit, offset int) (*dto.ListUsersResponse, error)
+	Execute(ctx context.Context, orgID int64, req dto.ListUsersRequest) (*dto.ListUsersResponse, error)
 }
 
 // UpdateUserUseCase is the interface for the update user use case
 type UpdateUserUseCase interface {
-	Execute(ctx context.Context, id int64, req dto.UpdateUserRequest) (*dto.UserResponse, error)
+	Execute(ctx context.Context, orgID, id int64, req dto.UpdateUserRequest) (*dto.UserResponse, error)
 }
 
 // DeleteUserUseCase is the interface for the delete user use case
 type DeleteUserUseCase interface {
-	Execute(ctx context.Context, id int64) error
+	Execute(ctx context.Context, orgID, id int64) error
 }
 
 // LoginUseCase is the interface for the login use case
@@ -69,16 +71,20 @@ func NewHandler(
 	}
 }
 
-// Create handles POST /users
+// Create handles POST /users, the user joins the organization of the admin
 func (h *Handler) Create(c *gin.Context) {
 	var req dto.CreateUserRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
 		response.ErrorResponseBadRequest(c, err.Error())
 		return
 	}
+	req.OrgID = c.GetInt64("org_id")
 
 	resp, err := h.createUseCase.Execute(c.Request.Context(), req)
 	if err != nil {
+		if respondWeakPassword(c, err) {
+			return
+		}
 		if err == domainuser.ErrEmailExists {
 			response.ErrorResponseConflict(c, err.Error())
 		} else {
@@ -98,7 +104,7 @@ func (h *Handler) Get(c *gin.Context) {
 		return
 	}
 
-	resp, err := h.getUseCase.Execute(c.Request.Context(), id)
+	resp, err := h.getUseCase.Execute(c.Request.Context(), c.GetInt64("org_id"), id)
 	if err != nil {
 		if err == domainuser.ErrUserNotFound {
 			response.ErrorResponseNotFound(c, err.Error())
@@ -113,12 +119,20 @@ func (h *Handler) Get(c *gin.Context) {
 
 // List handles GET /users
 func (h *Handler) List(c *gin.Context) {
-	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "10"))
-	offset, _ := strconv.Atoi(c.DefaultQuery("offset", "0"))
+	var req dto.ListUsersRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		response.ErrorResponseBadRequest(c, err.Error())
+		return
+	}
 
-	resp, err := h.listUseCase.Execute(c.Request.Context(), limit, offset)
+	resp, err := h.listUseCase.Execute(c.Request.Context(), c.GetInt64("org_id"), req)
 	if err != nil {
-		response.ErrorResponseInternalServerError(c, err.Error())
+		switch err {
+		case domainuser.ErrInvalidRole, domainuser.ErrInvalidSortField, domainuser.ErrInvalidSortDirection, domainuser.ErrInvalidDateRange:
+			response.ErrorResponseBadRequest(c, err.Error())
+		default:
+			response.ErrorResponseInternalServerError(c, err.Error())
+		}
 		return
 	}
 
@@ -139,13 +153,18 @@ func (h *Handler) Update(c *gin.Context) {
 		return
 	}
 
-	resp, err := h.updateUseCase.Execute(c.Request.Context(), id, req)
+	resp, err := h.updateUseCase.Execute(c.Request.Context(), c.GetInt64("org_id"), id, req)
 	if err != nil {
+		if respondWeakPassword(c, err) {
+			return
+		}
 		switch err {
 		case domainuser.ErrUserNotFound:
 			response.ErrorResponseNotFound(c, err.Error())
 		case domainuser.ErrEmailExists:
 			response.ErrorResponseConflict(c, err.Error())
+		case domainuser.ErrInvalidRole:
+			response.ErrorResponseBadRequest(c, err.Error())
 		default:
 			response.ErrorResponseInternalServerError(c, err.Error())
 		}
@@ -163,7 +182,7 @@ func (h *Handler) Delete(c *gin.Context) {
 		return
 	}
 
-	err = h.deleteUseCase.Execute(c.Request.Context(), id)
+	err = h.deleteUseCase.Execute(c.Request.Context(), c.GetInt64("org_id"), id)
 	if err != nil {
 		if err == domainuser.ErrUserNotFound {
 			response.ErrorResponseNotFound(c, err.Error())
@@ -184,8 +203,15 @@ func (h *Handler) Register(c *gin.Context) {
 		return
 	}
 
+	// Self-registered users always get the default role in the default organization
+	req.Role = ""
+	req.OrgID = 0
+
 	resp, err := h.createUseCase.Execute(c.Request.Context(), req)
 	if err != nil {
+		if respondWeakPassword(c, err) {
+			return
+		}
 		if err == domainuser.ErrEmailExists {
 			response.ErrorResponseConflict(c, err.Error())
 		} else {
@@ -204,16 +230,40 @@ func (h *Handler) Login(c *gin.Context) {
 		response.ErrorResponseBadRequest(c, err.Error())
 		return
 	}
+	req.IPAddress = c.ClientIP()
+	req.UserAgent = c.Request.UserAgent()
 
 	resp, err := h.loginUseCase.Execute(c.Request.Context(), req)
 	if err != nil {
-		if err == domainuser.ErrInvalidCredentials {
+		var locked *domainuser.LoginLockedError
+		if errors.As(err, &locked) {
+			// Round up so clients never retry before the lock is over
+			c.Header("Retry-After", strconv.Itoa(int(math.Ceil(locked.RetryAfter.Seconds()))))
+			response.ErrorResponseTooManyRequests(c, err.Error())
+		} else if err == domainuser.ErrInvalidCredentials {
 			response.ErrorResponseUnauthorized(c, err.Error())
+		} else if err == domainuser.ErrEmailNotVerified {
+			response.ErrorResponseForbidden(c, err.Error())
 		} else {
 			response.ErrorResponseInternalServerError(c, err.Error())
 		}
 		return
 	}
 
+	if resp.TwoFactorRequired {
+		response.SuccessResponseOK(c, "Two-factor authentication required", resp)
+		return
+	}
+
 	response.SuccessResponseOK(c, "Login successful", resp)
 }
+
+// respondWeakPassword answers 422 with every broken rule when err is a password policy error
+func respondWeakPassword(c *gin.Context, err error) bool {
+	var policyErr *domainuser.PasswordPolicyError
+	if !errors.As(err, &policyErr) {
+		return false
+	}
+	response.ErrorResponseUnprocessableEntity(c, err.Error(), gin.H{"violations": policyErr.Violations})
+	return true
+}
@@ -35,8 +35,8 @@ type mockGetUserUseCase struct {
 	mock.Mock
 }
 
-func (m *mockGetUserUseCase) Execute(ctx context.Context, id int64) (*dto.UserResponse, error) {
-	args := m.Called(ctx, id)
+func (m *mockGetUserUseCase) Execute(ctx context.Context, orgID, id int64) (*dto.UserResponse, error) {
+	args := m.Called(ctx, orgID, id)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
@@ -48,8 +48,8 @@ type mockListUsersUseCase struct {
 	mock.Mock
 }
 
-func (m *mockListUsersUseCase) Execute(ctx context.Context, limit, offset int) (*dto.ListUsersResponse, error) {
-	args := m.Called(ctx, limit, offset)
+func (m *mockListUsersUseCase) Execute(ctx context.Context, orgID int64, req dto.ListUsersRequest) (*dto.ListUsersResponse, error) {
+	args := m.Called(ctx, orgID, req)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
@@ -61,8 +61,8 @@ type mockUpdateUserUseCase struct {
 	mock.Mock
 }
 
-func (m *mockUpdateUserUseCase) Execute(ctx context.Context, id int64, req dto.UpdateUserRequest) (*dto.UserResponse, error) {
-	args := m.Called(ctx, id, req)
+func (m *mockUpdateUserUseCase) Execute(ctx context.Context, orgID, id int64, req dto.UpdateUserRequest) (*dto.UserResponse, error) {
+	args := m.Called(ctx, orgID, id, req)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
@@ -74,8 +74,8 @@ type mockDeleteUserUseCase struct {
 	mock.Mock
 }
 
-func (m *mockDeleteUserUseCase) Execute(ctx context.Context, id int64) error {
-	args := m.Called(ctx, id)
+func (m *mockDeleteUserUseCase) Execute(ctx context.Context, orgID, id int64) error {
+	args := m.Called(ctx, orgID, id)
 	return args.Error(0)
 }
 
@@ -95,6 +95,10 @@ func (m *mockLoginUseCase) Execute(ctx context.Context, req dto.LoginRequest) (*
 func setupTestRouter(handler *Handler) *gin.Engine {
 	gin.SetMode(gin.TestMode)
 	router := gin.New()
+	router.Use(func(c *gin.Context) {
+		c.Set("org_id", int64(1))
+		c.Next()
+	})
 	return router
 }
 
@@ -141,7 +145,10 @@ func TestHandler_Create_Success(t *testing.T) {
 		UpdatedAt: time.Now(),
 	}
 
-	createUC.On("Execute", mock.Anything, reqBody).Return(expectedResp, nil)
+	// The user joins the organization of the admin
+	expectedReq := reqBody
+	expectedReq.OrgID = 1
+	createUC.On("Execute", mock.Anything, expectedReq).Return(expectedResp, nil)
 
 	router := setupTestRouter(handler)
 	router.POST("/users", handler.Create)
@@ -202,7 +209,10 @@ func TestHandler_Create_Conflict_EmailExists(t *testing.T) {
 		Password: "password123",
 	}
 
-	createUC.On("Execute", mock.Anything, reqBody).Return(nil, domainuser.ErrEmailExists)
+	// The user joins the organization of the admin
+	expectedReq := reqBody
+	expectedReq.OrgID = 1
+	createUC.On("Execute", mock.Anything, expectedReq).Return(nil, domainuser.ErrEmailExists)
 
 	router := setupTestRouter(handler)
 	router.POST("/users", handler.Create)
@@ -218,6 +228,49 @@ func TestHandler_Create_Conflict_EmailExists(t *testing.T) {
 	createUC.AssertExpectations(t)
 }
 
+func TestHandler_Create_UnprocessableEntity_WeakPassword(t *testing.T) {
+	createUC := &mockCreateUserUseCase{}
+	handler := NewHandler(createUC, &mockGetUserUseCase{}, &mockListUsersUseCase{}, &mockUpdateUserUseCase{}, &mockDeleteUserUseCase{}, &mockLoginUseCase{})
+
+	reqBody := dto.CreateUserRequest{
+		Name:     "Test User",
+		Email:    "test@example.com",
+		Password: "test",
+	}
+
+	createUC.On("Execute", mock.Anything, mock.Anything).Return(nil, &domainuser.PasswordPolicyError{
+		Violations: []domainuser.PasswordViolation{
+			{Rule: domainuser.PasswordRuleMinLength, Message: "password must be at least 8 characters long"},
+			{Rule: domainuser.PasswordRulePersonalInfo, Message: "password must not contain your name or email"},
+		},
+	})
+
+	router := setupTestRouter(handler)
+	router.POST("/users", handler.Create)
+
+	body, _ := json.Marshal(reqBody)
+	req := httptest.NewRequest(http.MethodPost, "/users", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
+	createUC.AssertExpectations(t)
+
+	var response struct {
+		Status string `json:"status"`
+		Data   struct {
+			Violations []domainuser.PasswordViolation `json:"violations"`
+		} `json:"data"`
+	}
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	assert.NoError(t, err)
+	assert.Equal(t, "error", response.Status)
+	assert.Len(t, response.Data.Violations, 2)
+	assert.Equal(t, domainuser.PasswordRuleMinLength, response.Data.Violations[0].Rule)
+}
+
 func TestHandler_Create_InternalServerError(t *testing.T) {
 	createUC := &mockCreateUserUseCase{}
 	getUC := &mockGetUserUseCase{}
@@ -234,7 +287,10 @@ func TestHandler_Create_InternalServerError(t *testing.T) {
 		Password: "password123",
 	}
 
-	createUC.On("Execute", mock.Anything, reqBody).Return(nil, errors.New("database error"))
+	// The user joins the organization of the admin
+	expectedReq := reqBody
+	expectedReq.OrgID = 1
+	createUC.On("Execute", mock.Anything, expectedReq).Return(nil, errors.New("database error"))
 
 	router := setupTestRouter(handler)
 	router.POST("/users", handler.Create)
@@ -269,7 +325,7 @@ func TestHandler_Get_Success(t *testing.T) {
 		UpdatedAt: time.Now(),
 	}
 
-	getUC.On("Execute", mock.Anything, userID).Return(expectedResp, nil)
+	getUC.On("Execute", mock.Anything, int64(1), userID).Return(expectedResp, nil)
 
 	router := setupTestRouter(handler)
 	router.GET("/users/:id", handler.Get)
@@ -322,7 +378,7 @@ func TestHandler_Get_NotFound(t *testing.T) {
 	handler := NewHandler(createUC, getUC, listUC, updateUC, deleteUC, loginUC)
 
 	userID := int64(999)
-	getUC.On("Execute", mock.Anything, userID).Return(nil, domainuser.ErrUserNotFound)
+	getUC.On("Execute", mock.Anything, int64(1), userID).Return(nil, domainuser.ErrUserNotFound)
 
 	router := setupTestRouter(handler)
 	router.GET("/users/:id", handler.Get)
@@ -347,7 +403,7 @@ func TestHandler_Get_InternalServerError(t *testing.T) {
 	handler := NewHandler(createUC, getUC, listUC, updateUC, deleteUC, loginUC)
 
 	userID := int64(1)
-	getUC.On("Execute", mock.Anything, userID).Return(nil, errors.New("database error"))
+	getUC.On("Execute", mock.Anything, int64(1), userID).Return(nil, errors.New("database error"))
 
 	router := setupTestRouter(handler)
 	router.GET("/users/:id", handler.Get)
@@ -388,7 +444,7 @@ func TestHandler_List_Success(t *testing.T) {
 		Offset: offset,
 	}
 
-	listUC.On("Execute", mock.Anything, limit, offset).Return(expectedResp, nil)
+	listUC.On("Execute", mock.Anything, int64(1), dto.ListUsersRequest{}).Return(expectedResp, nil)
 
 	router := setupTestRouter(handler)
 	router.GET("/users", handler.List)
@@ -427,7 +483,7 @@ func TestHandler_List_WithQueryParams(t *testing.T) {
 		Offset: offset,
 	}
 
-	listUC.On("Execute", mock.Anything, limit, offset).Return(expectedResp, nil)
+	listUC.On("Execute", mock.Anything, int64(1), dto.ListUsersRequest{Limit: limit, Offset: offset}).Return(expectedResp, nil)
 
 	router := setupTestRouter(handler)
 	router.GET("/users", handler.List)
@@ -451,9 +507,7 @@ func TestHandler_List_InternalServerError(t *testing.T) {
 
 	handler := NewHandler(createUC, getUC, listUC, updateUC, deleteUC, loginUC)
 
-	limit := 10
-	offset := 0
-	listUC.On("Execute", mock.Anything, limit, offset).Return(nil, errors.New("database error"))
+	listUC.On("Execute", mock.Anything, int64(1), dto.ListUsersRequest{}).Return(nil, errors.New("database error"))
 
 	router := setupTestRouter(handler)
 	router.GET("/users", handler.List)
@@ -467,6 +521,75 @@ func TestHandler_List_InternalServerError(t *testing.T) {
 	listUC.AssertExpectations(t)
 }
 
+func TestHandler_List_WithFilters(t *testing.T) {
+	listUC := &mockListUsersUseCase{}
+	handler := NewHandler(&mockCreateUserUseCase{}, &mockGetUserUseCase{}, listUC, &mockUpdateUserUseCase{}, &mockDeleteUserUseCase{}, &mockLoginUseCase{})
+
+	from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	listUC.On("Execute", mock.Anything, int64(1), mock.MatchedBy(func(req dto.ListUsersRequest) bool {
+		return req.Search == "doe" && req.Role == "editor" && req.CreatedFrom != nil && req.CreatedFrom.Equal(from) &&
+			req.CreatedTo == nil && req.Sort == "name" && req.Order == "asc"
+	})).Return(&dto.ListUsersResponse{Users: []dto.UserResponse{}, Limit: 10}, nil)
+
+	router := setupTestRouter(handler)
+	router.GET("/users", handler.List)
+
+	req := httptest.NewRequest(http.MethodGet, "/users?q=doe&role=editor&created_from=2024-01-01T00:00:00Z&sort=name&order=asc", nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	listUC.AssertExpectations(t)
+}
+
+func TestHandler_List_InvalidQuery(t *testing.T) {
+	tests := []struct {
+		name  string
+		query string
+	}{
+		{name: "unknown sort field", query: "sort=password"},
+		{name: "unknown direction", query: "order=sideways"},
+		{name: "unknown role", query: "role=owner"},
+		{name: "malformed date", query: "created_from=yesterday"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			listUC := &mockListUsersUseCase{}
+			handler := NewHandler(&mockCreateUserUseCase{}, &mockGetUserUseCase{}, listUC, &mockUpdateUserUseCase{}, &mockDeleteUserUseCase{}, &mockLoginUseCase{})
+
+			router := setupTestRouter(handler)
+			router.GET("/users", handler.List)
+
+			req := httptest.NewRequest(http.MethodGet, "/users?"+tt.query, nil)
+			w := httptest.NewRecorder()
+
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, http.StatusBadRequest, w.Code)
+			listUC.AssertNotCalled(t, "Execute", mock.Anything, mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestHandler_List_InvalidDateRange(t *testing.T) {
+	listUC := &mockListUsersUseCase{}
+	handler := NewHandler(&mockCreateUserUseCase{}, &mockGetUserUseCase{}, listUC, &mockUpdateUserUseCase{}, &mockDeleteUserUseCase{}, &mockLoginUseCase{})
+
+	listUC.On("Execute", mock.Anything, int64(1), mock.Anything).Return(nil, domainuser.ErrInvalidDateRange)
+
+	router := setupTestRouter(handler)
+	router.GET("/users", handler.List)
+
+	req := httptest.NewRequest(http.MethodGet, "/users?created_from=2024-02-01T00:00:00Z&created_to=2024-01-01T00:00:00Z", nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
 func TestHandler_Update_Success(t *testing.T) {
 	createUC := &mockCreateUserUseCase{}
 	getUC := &mockGetUserUseCase{}
@@ -482,17 +605,19 @@ func TestHandler_Update_Success(t *testing.T) {
 		Name:     "Updated User",
 		Email:    "updated@example.com",
 		Password: "newpassword123",
+		Role:     "editor",
 	}
 
 	expectedResp := &dto.UserResponse{
 		ID:        userID,
 		Name:      reqBody.Name,
 		Email:     reqBody.Email,
+		Role:      reqBody.Role,
 		CreatedAt: time.Now(),
 		UpdatedAt: time.Now(),
 	}
 
-	updateUC.On("Execute", mock.Anything, userID, reqBody).Return(expectedResp, nil)
+	updateUC.On("Execute", mock.Anything, int64(1), userID, reqBody).Return(expectedResp, nil)
 
 	router := setupTestRouter(handler)
 	router.PUT("/users/:id", handler.Update)
@@ -525,8 +650,7 @@ func TestHandler_Update_BadRequest_InvalidID(t *testing.T) {
 	handler := NewHandler(createUC, getUC, listUC, updateUC, deleteUC, loginUC)
 
 	reqBody := dto.UpdateUserRequest{
-		Name:  "Updated User",
-		Email: "updated@example.com",
+		Role: "editor",
 	}
 
 	router := setupTestRouter(handler)
@@ -578,11 +702,10 @@ func TestHandler_Update_NotFound(t *testing.T) {
 
 	userID := int64(999)
 	reqBody := dto.UpdateUserRequest{
-		Name:  "Updated User",
-		Email: "updated@example.com",
+		Role: "editor",
 	}
 
-	updateUC.On("Execute", mock.Anything, userID, reqBody).Return(nil, domainuser.ErrUserNotFound)
+	updateUC.On("Execute", mock.Anything, int64(1), userID, reqBody).Return(nil, domainuser.ErrUserNotFound)
 
 	router := setupTestRouter(handler)
 	router.PUT("/users/:id", handler.Update)
@@ -614,7 +737,7 @@ func TestHandler_Update_Conflict_EmailExists(t *testing.T) {
 		Email: "existing@example.com",
 	}
 
-	updateUC.On("Execute", mock.Anything, userID, reqBody).Return(nil, domainuser.ErrEmailExists)
+	updateUC.On("Execute", mock.Anything, int64(1), userID, reqBody).Return(nil, domainuser.ErrEmailExists)
 
 	router := setupTestRouter(handler)
 	router.PUT("/users/:id", handler.Update)
@@ -630,6 +753,32 @@ func TestHandler_Update_Conflict_EmailExists(t *testing.T) {
 	updateUC.AssertExpectations(t)
 }
 
+func TestHandler_Update_UnprocessableEntity_WeakPassword(t *testing.T) {
+	updateUC := &mockUpdateUserUseCase{}
+	handler := NewHandler(&mockCreateUserUseCase{}, &mockGetUserUseCase{}, &mockListUsersUseCase{}, updateUC, &mockDeleteUserUseCase{}, &mockLoginUseCase{})
+
+	reqBody := dto.UpdateUserRequest{Password: "test"}
+
+	updateUC.On("Execute", mock.Anything, int64(1), int64(1), reqBody).Return(nil, &domainuser.PasswordPolicyError{
+		Violations: []domainuser.PasswordViolation{
+			{Rule: domainuser.PasswordRuleMinLength, Message: "password must be at least 8 characters long"},
+		},
+	})
+
+	router := setupTestRouter(handler)
+	router.PUT("/users/:id", handler.Update)
+
+	body, _ := json.Marshal(reqBody)
+	req := httptest.NewRequest(http.MethodPut, "/users/1", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
+	updateUC.AssertExpectations(t)
+}
+
 func TestHandler_Update_InternalServerError(t *testing.T) {
 	createUC := &mockCreateUserUseCase{}
 	getUC := &mockGetUserUseCase{}
@@ -642,11 +791,10 @@ func TestHandler_Update_InternalServerError(t *testing.T) {
 
 	userID := int64(1)
 	reqBody := dto.UpdateUserRequest{
-		Name:  "Updated User",
-		Email: "updated@example.com",
+		Role: "editor",
 	}
 
-	updateUC.On("Execute", mock.Anything, userID, reqBody).Return(nil, errors.New("database error"))
+	updateUC.On("Execute", mock.Anything, int64(1), userID, reqBody).Return(nil, errors.New("database error"))
 
 	router := setupTestRouter(handler)
 	router.PUT("/users/:id", handler.Update)
@@ -673,7 +821,7 @@ func TestHandler_Delete_Success(t *testing.T) {
 	handler := NewHandler(createUC, getUC, listUC, updateUC, deleteUC, loginUC)
 
 	userID := int64(1)
-	deleteUC.On("Execute", mock.Anything, userID).Return(nil)
+	deleteUC.On("Execute", mock.Anything, int64(1), userID).Return(nil)
 
 	router := setupTestRouter(handler)
 	router.DELETE("/users/:id", handler.Delete)
@@ -726,7 +874,7 @@ func TestHandler_Delete_NotFound(t *testing.T) {
 	handler := NewHandler(createUC, getUC, listUC, updateUC, deleteUC, loginUC)
 
 	userID := int64(999)
-	deleteUC.On("Execute", mock.Anything, userID).Return(domainuser.ErrUserNotFound)
+	deleteUC.On("Execute", mock.Anything, int64(1), userID).Return(domainuser.ErrUserNotFound)
 
 	router := setupTestRouter(handler)
 	router.DELETE("/users/:id", handler.Delete)
@@ -751,7 +899,7 @@ func TestHandler_Delete_InternalServerError(t *testing.T) {
 	handler := NewHandler(createUC, getUC, listUC, updateUC, deleteUC, loginUC)
 
 	userID := int64(1)
-	deleteUC.On("Execute", mock.Anything, userID).Return(errors.New("database error"))
+	deleteUC.On("Execute", mock.Anything, int64(1), userID).Return(errors.New("database error"))
 
 	router := setupTestRouter(handler)
 	router.DELETE("/users/:id", handler.Delete)
@@ -811,6 +959,41 @@ func TestHandler_Register_Success(t *testing.T) {
 	assert.Equal(t, "User registered successfully", response["message"])
 }
 
+func TestHandler_Register_IgnoresRequestedRole(t *testing.T) {
+	createUC := &mockCreateUserUseCase{}
+	getUC := &mockGetUserUseCase{}
+	listUC := &mockListUsersUseCase{}
+	updateUC := &mockUpdateUserUseCase{}
+	deleteUC := &mockDeleteUserUseCase{}
+	loginUC := &mockLoginUseCase{}
+
+	handler := NewHandler(createUC, getUC, listUC, updateUC, deleteUC, loginUC)
+
+	reqBody := dto.CreateUserRequest{
+		Name:     "Test User",
+		Email:    "test@example.com",
+		Password: "password123",
+		Role:     "admin",
+	}
+	expectedReq := reqBody
+	expectedReq.Role = ""
+
+	createUC.On("Execute", mock.Anything, expectedReq).Return(&dto.UserResponse{ID: 1, Role: "author"}, nil)
+
+	router := setupTestRouter(handler)
+	router.POST("/users/register", handler.Register)
+
+	body, _ := json.Marshal(reqBody)
+	req := httptest.NewRequest(http.MethodPost, "/users/register", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusCreated, w.Code)
+	createUC.AssertExpectations(t)
+}
+
 func TestHandler_Register_BadRequest_InvalidJSON(t *testing.T) {
 	createUC := &mockCreateUserUseCase{}
 	getUC := &mockGetUserUseCase{}
@@ -877,13 +1060,14 @@ func TestHandler_Login_Success(t *testing.T) {
 	handler := NewHandler(createUC, getUC, listUC, updateUC, deleteUC, loginUC)
 
 	reqBody := dto.LoginRequest{
-		Email:    "test@example.com",
-		Password: "password123",
+		Email:     "test@example.com",
+		Password:  "password123",
+		IPAddress: "192.0.2.1", // httptest client address
 	}
 
 	expectedResp := &dto.LoginResponse{
 		Token: "jwt_token_here",
-		User: dto.UserResponse{
+		User: &dto.UserResponse{
 			ID:        1,
 			Name:      "Test User",
 			Email:     reqBody.Email,
@@ -948,8 +1132,9 @@ func TestHandler_Login_Unauthorized_InvalidCredentials(t *testing.T) {
 	handler := NewHandler(createUC, getUC, listUC, updateUC, deleteUC, loginUC)
 
 	reqBody := dto.LoginRequest{
-		Email:    "test@example.com",
-		Password: "wrongpassword",
+		Email:     "test@example.com",
+		Password:  "wrongpassword",
+		IPAddress: "192.0.2.1", // httptest client address
 	}
 
 	loginUC.On("Execute", mock.Anything, reqBody).Return(nil, domainuser.ErrInvalidCredentials)
@@ -968,6 +1153,52 @@ func TestHandler_Login_Unauthorized_InvalidCredentials(t *testing.T) {
 	loginUC.AssertExpectations(t)
 }
 
+func TestHandler_Login_Forbidden_EmailNotVerified(t *testing.T) {
+	loginUC := &mockLoginUseCase{}
+	handler := NewHandler(&mockCreateUserUseCase{}, &mockGetUserUseCase{}, &mockListUsersUseCase{}, &mockUpdateUserUseCase{}, &mockDeleteUserUseCase{}, loginUC)
+
+	reqBody := dto.LoginRequest{
+		Email:     "test@example.com",
+		Password:  "password123",
+		IPAddress: "192.0.2.1", // httptest client address
+	}
+
+	loginUC.On("Execute", mock.Anything, reqBody).Return(nil, domainuser.ErrEmailNotVerified)
+
+	router := setupTestRouter(handler)
+	router.POST("/users/login", handler.Login)
+
+	body, _ := json.Marshal(reqBody)
+	req := httptest.NewRequest(http.MethodPost, "/users/login", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusForbidden, w.Code)
+	loginUC.AssertExpectations(t)
+}
+
+func TestHandler_Login_TooManyRequests(t *testing.T) {
+	loginUC := &mockLoginUseCase{}
+	handler := NewHandler(&mockCreateUserUseCase{}, &mockGetUserUseCase{}, &mockListUsersUseCase{}, &mockUpdateUserUseCase{}, &mockDeleteUserUseCase{}, loginUC)
+
+	loginUC.On("Execute", mock.Anything, mock.Anything).Return(nil, &domainuser.LoginLockedError{RetryAfter: 90500 * time.Millisecond})
+
+	router := setupTestRouter(handler)
+	router.POST("/users/login", handler.Login)
+
+	req := httptest.NewRequest(http.MethodPost, "/users/login", bytes.NewBufferString(`{"email":"test@example.com","password":"password123"}`))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusTooManyRequests, w.Code)
+	assert.Equal(t, "91", w.Header().Get("Retry-After"))
+	loginUC.AssertExpectations(t)
+}
+
 func TestHandler_Login_InternalServerError(t *testing.T) {
 	createUC := &mockCreateUserUseCase{}
 	getUC := &mockGetUserUseCase{}
@@ -979,8 +1210,9 @@ func TestHandler_Login_InternalServerError(t *testing.T) {
 	handler := NewHandler(createUC, getUC, listUC, updateUC, deleteUC, loginUC)
 
 	reqBody := dto.LoginRequest{
-		Email:    "test@example.com",
-		Password: "password123",
+		Email:     "test@example.com",
+		Password:  "password123",
+		IPAddress: "192.0.2.1", // httptest client address
 	}
 
 	loginUC.On("Execute", mock.Anything, reqBody).Return(nil, errors.New("database error"))
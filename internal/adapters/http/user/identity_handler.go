@@ -0,0 +1,163 @@
+package user
+
+import (
+	"context"
+	"crypto/subtle"
+	"net/http"
+	"net/url"
+	"path"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rulzi/hexa-go/internal/adapters/http/response"
+	"github.com/rulzi/hexa-go/internal/application/user/dto"
+	domainuser "github.com/rulzi/hexa-go/internal/domain/user"
+)
+
+// stateCookieName is the cookie binding a sign-in with the identity provider to the browser that started it
+const stateCookieName = "oidc_state"
+
+// StartIdentityLoginUseCase is the interface for the start identity login use case
+type StartIdentityLoginUseCase interface {
+	Execute(ctx context.Context) (authURL, state string, err error)
+}
+
+// CompleteIdentityLoginUseCase is the interface for the complete identity login use case
+type CompleteIdentityLoginUseCase interface {
+	Execute(ctx context.Context, req dto.IdentityCallbackRequest) (string, error)
+}
+
+// RedeemIdentityLoginUseCase is the interface for the redeem identity login use case
+type RedeemIdentityLoginUseCase interface {
+	Execute(ctx context.Context, req dto.IdentityLoginCodeRequest) (*dto.LoginResponse, error)
+}
+
+// IdentityHandler handles HTTP requests for signing in with an identity provider
+type IdentityHandler struct {
+	startUseCase     StartIdentityLoginUseCase
+	completeUseCase  CompleteIdentityLoginUseCase
+	redeemUseCase    RedeemIdentityLoginUseCase
+	loginRedirectURL string
+	stateTTL         time.Duration
+	secureCookie     bool
+}
+
+// NewIdentityHandler creates a new IdentityHandler.
+// Completed sign-ins are redirected to loginRedirectURL with a one-time code, the state cookie lives
+// for stateTTL and is only sent over HTTPS with secureCookie.
+func NewIdentityHandler(
+	startUseCase StartIdentityLoginUseCase,
+	completeUseCase CompleteIdentityLoginUseCase,
+	redeemUseCase RedeemIdentityLoginUseCase,
+	loginRedirectURL string,
+	stateTTL time.Duration,
+	secureCookie bool,
+) *IdentityHandler {
+	return &IdentityHandler{
+		startUseCase:     startUseCase,
+		completeUseCase:  completeUseCase,
+		redeemUseCase:    redeemUseCase,
+		loginRedirectURL: loginRedirectURL,
+		stateTTL:         stateTTL,
+		secureCookie:     secureCookie,
+	}
+}
+
+// Login handles GET /users/oidc/login by redirecting to the identity provider
+func (h *IdentityHandler) Login(c *gin.Context) {
+	authURL, state, err := h.startUseCase.Execute(c.Request.Context())
+	if err != nil {
+		response.ErrorResponseInternalServerError(c, err.Error())
+		return
+	}
+
+	// Only the hash of the state is kept in the browser. Lax, because the provider
+	// sends the browser back with a cross-site top-level redirect.
+	h.setStateCookie(c, domainuser.HashToken(state), int(h.stateTTL.Seconds()))
+
+	c.Redirect(http.StatusFound, authURL)
+}
+
+// Callback handles GET /users/oidc/callback?code=&state=.
+// A completed sign-in is redirected to the login redirect URL with a one-time code, never with tokens.
+func (h *IdentityHandler) Callback(c *gin.Context) {
+	// The state cookie is single use like the state itself
+	stateHash, cookieErr := c.Cookie(stateCookieName)
+	h.setStateCookie(c, "", -1)
+
+	// The provider reports cancelled or denied sign-ins instead of sending a code
+	if providerErr := c.Query("error"); providerErr != "" {
+		response.ErrorResponseUnauthorized(c, "identity provider sign-in failed: "+providerErr)
+		return
+	}
+
+	var req dto.IdentityCallbackRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		response.ErrorResponseBadRequest(c, err.Error())
+		return
+	}
+	req.IPAddress = c.ClientIP()
+	req.UserAgent = c.Request.UserAgent()
+
+	// A callback from another browser than the one that started the sign-in is a login CSRF
+	if cookieErr != nil || subtle.ConstantTimeCompare([]byte(stateHash), []byte(domainuser.HashToken(req.State))) != 1 {
+		response.ErrorResponseUnauthorized(c, domainuser.ErrInvalidAuthorizationState.Error())
+		return
+	}
+
+	code, err := h.completeUseCase.Execute(c.Request.Context(), req)
+	if err != nil {
+		if err == domainuser.ErrInvalidAuthorizationState || err == domainuser.ErrInvalidIdentity {
+			response.ErrorResponseUnauthorized(c, err.Error())
+		} else if err == domainuser.ErrIdentityNotProvisioned || err == domainuser.ErrEmailNotVerified {
+			response.ErrorResponseForbidden(c, err.Error())
+		} else if err == domainuser.ErrIdentityEmailNotVerified || err == domainuser.ErrIdentityAccountNotVerified {
+			response.ErrorResponseConflict(c, err.Error())
+		} else {
+			response.ErrorResponseInternalServerError(c, err.Error())
+		}
+		return
+	}
+
+	redirectURL, err := url.Parse(h.loginRedirectURL)
+	if err != nil {
+		response.ErrorResponseInternalServerError(c, err.Error())
+		return
+	}
+	query := redirectURL.Query()
+	query.Set("code", code)
+	redirectURL.RawQuery = query.Encode()
+
+	c.Header("Cache-Control", "no-store")
+	c.Header("Referrer-Policy", "no-referrer")
+	c.Redirect(http.StatusFound, redirectURL.String())
+}
+
+// Token handles POST /users/oidc/token, trading the one-time code of a sign-in for tokens
+func (h *IdentityHandler) Token(c *gin.Context) {
+	var req dto.IdentityLoginCodeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.ErrorResponseBadRequest(c, err.Error())
+		return
+	}
+	req.IPAddress = c.ClientIP()
+	req.UserAgent = c.Request.UserAgent()
+
+	resp, err := h.redeemUseCase.Execute(c.Request.Context(), req)
+	if err != nil {
+		if err == domainuser.ErrInvalidLoginCode {
+			response.ErrorResponseUnauthorized(c, err.Error())
+		} else {
+			response.ErrorResponseInternalServerError(c, err.Error())
+		}
+		return
+	}
+
+	response.SuccessResponseOK(c, "Login successful", resp)
+}
+
+// setStateCookie sets the state cookie for the login and callback routes only, a negative maxAge removes it
+func (h *IdentityHandler) setStateCookie(c *gin.Context, value string, maxAge int) {
+	c.SetSameSite(http.SameSiteLaxMode)
+	c.SetCookie(stateCookieName, value, maxAge, path.Dir(c.Request.URL.Path), "", h.secureCookie, true)
+}
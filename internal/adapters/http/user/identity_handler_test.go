@@ -0,0 +1,306 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rulzi/hexa-go/internal/application/user/dto"
+	domainuser "github.com/rulzi/hexa-go/internal/domain/user"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// mockStartIdentityLoginUseCase is a mock implementation of StartIdentityLoginUseCase
+type mockStartIdentityLoginUseCase struct {
+	mock.Mock
+}
+
+func (m *mockStartIdentityLoginUseCase) Execute(ctx context.Context) (string, string, error) {
+	args := m.Called(ctx)
+	return args.String(0), args.String(1), args.Error(2)
+}
+
+// mockCompleteIdentityLoginUseCase is a mock implementation of CompleteIdentityLoginUseCase
+type mockCompleteIdentityLoginUseCase struct {
+	mock.Mock
+}
+
+func (m *mockCompleteIdentityLoginUseCase) Execute(ctx context.Context, req dto.IdentityCallbackRequest) (string, error) {
+	args := m.Called(ctx, req)
+	return args.String(0), args.Error(1)
+}
+
+// mockRedeemIdentityLoginUseCase is a mock implementation of RedeemIdentityLoginUseCase
+type mockRedeemIdentityLoginUseCase struct {
+	mock.Mock
+}
+
+func (m *mockRedeemIdentityLoginUseCase) Execute(ctx context.Context, req dto.IdentityLoginCodeRequest) (*dto.LoginResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.LoginResponse), args.Error(1)
+}
+
+func TestIdentityHandler_Login(t *testing.T) {
+	tests := []struct {
+		name         string
+		setup        func(uc *mockStartIdentityLoginUseCase)
+		wantStatus   int
+		wantLocation string
+		wantCookie   bool
+	}{
+		{
+			name: "redirects to the identity provider",
+			setup: func(uc *mockStartIdentityLoginUseCase) {
+				uc.On("Execute", mock.Anything).Return("https://idp.example.com/authorize?state=abc", "abc", nil)
+			},
+			wantStatus:   http.StatusFound,
+			wantLocation: "https://idp.example.com/authorize?state=abc",
+			wantCookie:   true,
+		},
+		{
+			name: "identity provider unreachable",
+			setup: func(uc *mockStartIdentityLoginUseCase) {
+				uc.On("Execute", mock.Anything).Return("", "", errors.New("discovery failed"))
+			},
+			wantStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			startUC := &mockStartIdentityLoginUseCase{}
+			handler := NewIdentityHandler(startUC, nil, nil, "https://app.example.com/login", 10*time.Minute, true)
+			tt.setup(startUC)
+
+			gin.SetMode(gin.TestMode)
+			router := gin.New()
+			router.GET("/users/oidc/login", handler.Login)
+
+			req := httptest.NewRequest(http.MethodGet, "/users/oidc/login", nil)
+			w := httptest.NewRecorder()
+
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.wantStatus, w.Code)
+			assert.Equal(t, tt.wantLocation, w.Header().Get("Location"))
+			cookies := w.Result().Cookies()
+			if tt.wantCookie {
+				// The browser keeps the hash of the state, out of reach of scripts
+				require.Len(t, cookies, 1)
+				assert.Equal(t, "oidc_state", cookies[0].Name)
+				assert.Equal(t, domainuser.HashToken("abc"), cookies[0].Value)
+				assert.Equal(t, "/users/oidc", cookies[0].Path)
+				assert.Equal(t, 600, cookies[0].MaxAge)
+				assert.True(t, cookies[0].HttpOnly)
+				assert.True(t, cookies[0].Secure)
+				assert.Equal(t, http.SameSiteLaxMode, cookies[0].SameSite)
+			} else {
+				assert.Empty(t, cookies)
+			}
+			startUC.AssertExpectations(t)
+		})
+	}
+}
+
+func TestIdentityHandler_Callback(t *testing.T) {
+	stateCookie := &http.Cookie{Name: "oidc_state", Value: domainuser.HashToken("state-1")}
+
+	tests := []struct {
+		name         string
+		query        string
+		cookie       *http.Cookie
+		setup        func(uc *mockCompleteIdentityLoginUseCase)
+		wantStatus   int
+		wantLocation string
+	}{
+		{
+			name:   "redirects with a one-time code",
+			query:  "?code=code-1&state=state-1",
+			cookie: stateCookie,
+			setup: func(uc *mockCompleteIdentityLoginUseCase) {
+				uc.On("Execute", mock.Anything, dto.IdentityCallbackRequest{Code: "code-1", State: "state-1", IPAddress: "192.0.2.1"}).
+					Return("login-code", nil)
+			},
+			wantStatus:   http.StatusFound,
+			wantLocation: "https://app.example.com/login?code=login-code&next=%2F",
+		},
+		{
+			name:       "missing state cookie",
+			query:      "?code=code-1&state=state-1",
+			setup:      func(uc *mockCompleteIdentityLoginUseCase) {},
+			wantStatus: http.StatusUnauthorized,
+		},
+		{
+			name:       "state of another browser",
+			query:      "?code=code-1&state=state-1",
+			cookie:     &http.Cookie{Name: "oidc_state", Value: domainuser.HashToken("state-2")},
+			setup:      func(uc *mockCompleteIdentityLoginUseCase) {},
+			wantStatus: http.StatusUnauthorized,
+		},
+		{
+			name:       "sign-in denied at the provider",
+			query:      "?error=access_denied&state=state-1",
+			cookie:     stateCookie,
+			setup:      func(uc *mockCompleteIdentityLoginUseCase) {},
+			wantStatus: http.StatusUnauthorized,
+		},
+		{
+			name:       "missing code",
+			query:      "?state=state-1",
+			cookie:     stateCookie,
+			setup:      func(uc *mockCompleteIdentityLoginUseCase) {},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:   "replayed state",
+			query:  "?code=code-1&state=state-1",
+			cookie: stateCookie,
+			setup: func(uc *mockCompleteIdentityLoginUseCase) {
+				uc.On("Execute", mock.Anything, mock.Anything).Return("", domainuser.ErrInvalidAuthorizationState)
+			},
+			wantStatus: http.StatusUnauthorized,
+		},
+		{
+			name:   "invalid identity",
+			query:  "?code=code-1&state=state-1",
+			cookie: stateCookie,
+			setup: func(uc *mockCompleteIdentityLoginUseCase) {
+				uc.On("Execute", mock.Anything, mock.Anything).Return("", domainuser.ErrInvalidIdentity)
+			},
+			wantStatus: http.StatusUnauthorized,
+		},
+		{
+			name:   "not provisioned",
+			query:  "?code=code-1&state=state-1",
+			cookie: stateCookie,
+			setup: func(uc *mockCompleteIdentityLoginUseCase) {
+				uc.On("Execute", mock.Anything, mock.Anything).Return("", domainuser.ErrIdentityNotProvisioned)
+			},
+			wantStatus: http.StatusForbidden,
+		},
+		{
+			name:   "unverified email of an existing account",
+			query:  "?code=code-1&state=state-1",
+			cookie: stateCookie,
+			setup: func(uc *mockCompleteIdentityLoginUseCase) {
+				uc.On("Execute", mock.Anything, mock.Anything).Return("", domainuser.ErrIdentityEmailNotVerified)
+			},
+			wantStatus: http.StatusConflict,
+		},
+		{
+			name:   "unverified local account",
+			query:  "?code=code-1&state=state-1",
+			cookie: stateCookie,
+			setup: func(uc *mockCompleteIdentityLoginUseCase) {
+				uc.On("Execute", mock.Anything, mock.Anything).Return("", domainuser.ErrIdentityAccountNotVerified)
+			},
+			wantStatus: http.StatusConflict,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			completeUC := &mockCompleteIdentityLoginUseCase{}
+			handler := NewIdentityHandler(nil, completeUC, nil, "https://app.example.com/login?next=/", 10*time.Minute, true)
+			tt.setup(completeUC)
+
+			gin.SetMode(gin.TestMode)
+			router := gin.New()
+			router.GET("/users/oidc/callback", handler.Callback)
+
+			req := httptest.NewRequest(http.MethodGet, "/users/oidc/callback"+tt.query, nil)
+			if tt.cookie != nil {
+				req.AddCookie(tt.cookie)
+			}
+			w := httptest.NewRecorder()
+
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.wantStatus, w.Code)
+			assert.Equal(t, tt.wantLocation, w.Header().Get("Location"))
+			// Tokens are never part of the callback response
+			assert.NotContains(t, w.Body.String(), "token")
+			// The state cookie is removed whatever the outcome
+			cookies := w.Result().Cookies()
+			require.Len(t, cookies, 1)
+			assert.Equal(t, "oidc_state", cookies[0].Name)
+			assert.Less(t, cookies[0].MaxAge, 0)
+			completeUC.AssertExpectations(t)
+			if tt.cookie != stateCookie {
+				completeUC.AssertNotCalled(t, "Execute", mock.Anything, mock.Anything)
+			}
+		})
+	}
+}
+
+func TestIdentityHandler_Token(t *testing.T) {
+	tests := []struct {
+		name       string
+		body       string
+		setup      func(uc *mockRedeemIdentityLoginUseCase)
+		wantStatus int
+	}{
+		{
+			name: "code is traded for tokens",
+			body: `{"code":"login-code"}`,
+			setup: func(uc *mockRedeemIdentityLoginUseCase) {
+				uc.On("Execute", mock.Anything, dto.IdentityLoginCodeRequest{Code: "login-code", IPAddress: "192.0.2.1"}).
+					Return(&dto.LoginResponse{Token: "access", RefreshToken: "refresh"}, nil)
+			},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "missing code",
+			body:       `{}`,
+			setup:      func(uc *mockRedeemIdentityLoginUseCase) {},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name: "redeemed or expired code",
+			body: `{"code":"login-code"}`,
+			setup: func(uc *mockRedeemIdentityLoginUseCase) {
+				uc.On("Execute", mock.Anything, mock.Anything).Return(nil, domainuser.ErrInvalidLoginCode)
+			},
+			wantStatus: http.StatusUnauthorized,
+		},
+		{
+			name: "store unavailable",
+			body: `{"code":"login-code"}`,
+			setup: func(uc *mockRedeemIdentityLoginUseCase) {
+				uc.On("Execute", mock.Anything, mock.Anything).Return(nil, errors.New("redis down"))
+			},
+			wantStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			redeemUC := &mockRedeemIdentityLoginUseCase{}
+			handler := NewIdentityHandler(nil, nil, redeemUC, "https://app.example.com/login", 10*time.Minute, true)
+			tt.setup(redeemUC)
+
+			gin.SetMode(gin.TestMode)
+			router := gin.New()
+			router.POST("/users/oidc/token", handler.Token)
+
+			req := httptest.NewRequest(http.MethodPost, "/users/oidc/token", strings.NewReader(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.wantStatus, w.Code)
+			redeemUC.AssertExpectations(t)
+		})
+	}
+}
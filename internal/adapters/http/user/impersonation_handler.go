@@ -0,0 +1,52 @@
+package user
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rulzi/hexa-go/internal/adapters/http/response"
+	"github.com/rulzi/hexa-go/internal/application/user/dto"
+	domainuser "github.com/rulzi/hexa-go/internal/domain/user"
+)
+
+// ImpersonateUserUseCase is the interface for the impersonate user use case
+type ImpersonateUserUseCase interface {
+	Execute(ctx context.Context, orgID, actorID, id int64, userAgent, ipAddress string) (*dto.ImpersonationResponse, error)
+}
+
+// ImpersonationHandler handles HTTP requests for admins acting as another user
+type ImpersonationHandler struct {
+	impersonateUseCase ImpersonateUserUseCase
+}
+
+// NewImpersonationHandler creates a new ImpersonationHandler
+func NewImpersonationHandler(impersonateUseCase ImpersonateUserUseCase) *ImpersonationHandler {
+	return &ImpersonationHandler{
+		impersonateUseCase: impersonateUseCase,
+	}
+}
+
+// Impersonate handles POST /admin/users/:id/impersonate
+func (h *ImpersonationHandler) Impersonate(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		response.ErrorResponseBadRequest(c, "invalid user id")
+		return
+	}
+
+	resp, err := h.impersonateUseCase.Execute(c.Request.Context(), c.GetInt64("org_id"), c.GetInt64("user_id"), id, c.Request.UserAgent(), c.ClientIP())
+	if err != nil {
+		switch err {
+		case domainuser.ErrUserNotFound:
+			response.ErrorResponseNotFound(c, err.Error())
+		case domainuser.ErrCannotImpersonate:
+			response.ErrorResponseForbidden(c, err.Error())
+		default:
+			response.ErrorResponseInternalServerError(c, err.Error())
+		}
+		return
+	}
+
+	response.SuccessResponseCreated(c, "Impersonation token issued", resp)
+}
@@ -0,0 +1,77 @@
+package user
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rulzi/hexa-go/internal/application/user/dto"
+	domainuser "github.com/rulzi/hexa-go/internal/domain/user"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// mockImpersonateUserUseCase is a mock implementation of ImpersonateUserUseCase
+type mockImpersonateUserUseCase struct {
+	mock.Mock
+}
+
+func (m *mockImpersonateUserUseCase) Execute(ctx context.Context, orgID, actorID, id int64, userAgent, ipAddress string) (*dto.ImpersonationResponse, error) {
+	args := m.Called(ctx, orgID, actorID, id, userAgent, ipAddress)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.ImpersonationResponse), args.Error(1)
+}
+
+func TestImpersonationHandler_Impersonate(t *testing.T) {
+	tests := []struct {
+		name       string
+		id         string
+		err        error
+		wantStatus int
+	}{
+		{name: "success", id: "5", wantStatus: http.StatusCreated},
+		{name: "invalid id", id: "abc", wantStatus: http.StatusBadRequest},
+		{name: "user not found", id: "5", err: domainuser.ErrUserNotFound, wantStatus: http.StatusNotFound},
+		{name: "admin target", id: "5", err: domainuser.ErrCannotImpersonate, wantStatus: http.StatusForbidden},
+		{name: "internal error", id: "5", err: errors.New("database error"), wantStatus: http.StatusInternalServerError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			impersonateUC := &mockImpersonateUserUseCase{}
+			handler := NewImpersonationHandler(impersonateUC)
+			if tt.err != nil {
+				impersonateUC.On("Execute", mock.Anything, int64(1), int64(1), int64(5), mock.Anything, mock.Anything).Return(nil, tt.err)
+			} else {
+				impersonateUC.On("Execute", mock.Anything, int64(1), int64(1), int64(5), mock.Anything, mock.Anything).
+					Return(&dto.ImpersonationResponse{Token: "impersonation-token", ImpersonatedBy: 1, User: dto.UserResponse{ID: 5}}, nil).Maybe()
+			}
+
+			gin.SetMode(gin.TestMode)
+			router := gin.New()
+			router.POST("/admin/users/:id/impersonate", withAuthContext(1, "jti", time.Now().Add(time.Hour)), handler.Impersonate)
+
+			req := httptest.NewRequest(http.MethodPost, "/admin/users/"+tt.id+"/impersonate", nil)
+			w := httptest.NewRecorder()
+
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.wantStatus, w.Code)
+			if tt.wantStatus == http.StatusCreated {
+				var body struct {
+					Data dto.ImpersonationResponse `json:"data"`
+				}
+				assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+				assert.Equal(t, "impersonation-token", body.Data.Token)
+				assert.Equal(t, int64(1), body.Data.ImpersonatedBy)
+			}
+		})
+	}
+}
@@ -0,0 +1,90 @@
+package user
+
+import (
+	"context"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rulzi/hexa-go/internal/adapters/http/middleware"
+	"github.com/rulzi/hexa-go/internal/adapters/http/response"
+	"github.com/rulzi/hexa-go/internal/application/user/dto"
+	domainuser "github.com/rulzi/hexa-go/internal/domain/user"
+)
+
+// CreateInvitationUseCase is the interface for the create invitation use case
+type CreateInvitationUseCase interface {
+	Execute(ctx context.Context, orgID, invitedBy int64, req dto.CreateInvitationRequest) (*dto.InvitationResponse, error)
+}
+
+// AcceptInvitationUseCase is the interface for the accept invitation use case
+type AcceptInvitationUseCase interface {
+	Execute(ctx context.Context, req dto.AcceptInvitationRequest) (*dto.UserResponse, error)
+}
+
+// InvitationHandler handles HTTP requests for inviting users
+type InvitationHandler struct {
+	createUseCase CreateInvitationUseCase
+	acceptUseCase AcceptInvitationUseCase
+}
+
+// NewInvitationHandler creates a new InvitationHandler
+func NewInvitationHandler(createUseCase CreateInvitationUseCase, acceptUseCase AcceptInvitationUseCase) *InvitationHandler {
+	return &InvitationHandler{
+		createUseCase: createUseCase,
+		acceptUseCase: acceptUseCase,
+	}
+}
+
+// Create handles POST /users/invitations, the invitee joins the organization of the admin
+func (h *InvitationHandler) Create(c *gin.Context) {
+	var req dto.CreateInvitationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.ErrorResponseBadRequest(c, err.Error())
+		return
+	}
+
+	resp, err := h.createUseCase.Execute(c.Request.Context(), c.GetInt64("org_id"), middleware.ActorID(c), req)
+	if err != nil {
+		switch err {
+		case domainuser.ErrInvalidRole, domainuser.ErrInvalidInvitationExpiry:
+			response.ErrorResponseBadRequest(c, err.Error())
+		case domainuser.ErrRegistrationClosed:
+			response.ErrorResponseForbidden(c, err.Error())
+		case domainuser.ErrAlreadyMember:
+			response.ErrorResponseConflict(c, err.Error())
+		default:
+			response.ErrorResponseInternalServerError(c, err.Error())
+		}
+		return
+	}
+
+	response.SuccessResponseCreated(c, "Invitation sent", resp)
+}
+
+// Accept handles POST /users/invitations/accept
+func (h *InvitationHandler) Accept(c *gin.Context) {
+	var req dto.AcceptInvitationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.ErrorResponseBadRequest(c, err.Error())
+		return
+	}
+
+	resp, err := h.acceptUseCase.Execute(c.Request.Context(), req)
+	if err != nil {
+		if respondWeakPassword(c, err) {
+			return
+		}
+		switch err {
+		case domainuser.ErrInvalidInvitation, domainuser.ErrNameRequired, domainuser.ErrPasswordRequired:
+			response.ErrorResponseBadRequest(c, err.Error())
+		case domainuser.ErrRegistrationClosed:
+			response.ErrorResponseForbidden(c, err.Error())
+		case domainuser.ErrEmailExists:
+			response.ErrorResponseConflict(c, err.Error())
+		default:
+			response.ErrorResponseInternalServerError(c, err.Error())
+		}
+		return
+	}
+
+	response.SuccessResponseCreated(c, "Invitation accepted", resp)
+}
@@ -0,0 +1,127 @@
+package user
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rulzi/hexa-go/internal/application/user/dto"
+	domainuser "github.com/rulzi/hexa-go/internal/domain/user"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// mockCreateInvitationUseCase is a mock implementation of CreateInvitationUseCase
+type mockCreateInvitationUseCase struct {
+	mock.Mock
+}
+
+func (m *mockCreateInvitationUseCase) Execute(ctx context.Context, orgID, invitedBy int64, req dto.CreateInvitationRequest) (*dto.InvitationResponse, error) {
+	args := m.Called(ctx, orgID, invitedBy, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.InvitationResponse), args.Error(1)
+}
+
+// mockAcceptInvitationUseCase is a mock implementation of AcceptInvitationUseCase
+type mockAcceptInvitationUseCase struct {
+	mock.Mock
+}
+
+func (m *mockAcceptInvitationUseCase) Execute(ctx context.Context, req dto.AcceptInvitationRequest) (*dto.UserResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.UserResponse), args.Error(1)
+}
+
+func TestInvitationHandler_Create(t *testing.T) {
+	tests := []struct {
+		name       string
+		body       string
+		err        error
+		wantStatus int
+	}{
+		{name: "success", body: `{"email":"new@example.com","role":"editor"}`, wantStatus: http.StatusCreated},
+		{name: "invalid email", body: `{"email":"nope"}`, wantStatus: http.StatusBadRequest},
+		{name: "unknown role", body: `{"email":"new@example.com","role":"owner"}`, wantStatus: http.StatusBadRequest},
+		{name: "expiry in the past", body: `{"email":"new@example.com"}`, err: domainuser.ErrInvalidInvitationExpiry, wantStatus: http.StatusBadRequest},
+		{name: "registration disabled", body: `{"email":"new@example.com"}`, err: domainuser.ErrRegistrationClosed, wantStatus: http.StatusForbidden},
+		{name: "already a member", body: `{"email":"new@example.com"}`, err: domainuser.ErrAlreadyMember, wantStatus: http.StatusConflict},
+		{name: "internal error", body: `{"email":"new@example.com"}`, err: errors.New("database error"), wantStatus: http.StatusInternalServerError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			createUC := &mockCreateInvitationUseCase{}
+			handler := NewInvitationHandler(createUC, nil)
+			if tt.err != nil {
+				createUC.On("Execute", mock.Anything, int64(1), int64(1), mock.Anything).Return(nil, tt.err)
+			} else {
+				createUC.On("Execute", mock.Anything, int64(1), int64(1), mock.Anything).
+					Return(&dto.InvitationResponse{ID: 5, OrgID: 1, Email: "new@example.com", Role: "editor"}, nil).Maybe()
+			}
+
+			gin.SetMode(gin.TestMode)
+			router := gin.New()
+			router.POST("/users/invitations", withAuthContext(1, "jti", time.Now().Add(time.Hour)), handler.Create)
+
+			req := httptest.NewRequest(http.MethodPost, "/users/invitations", bytes.NewBufferString(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.wantStatus, w.Code)
+			assert.NotContains(t, w.Body.String(), "token")
+		})
+	}
+}
+
+func TestInvitationHandler_Accept(t *testing.T) {
+	tests := []struct {
+		name       string
+		body       string
+		err        error
+		wantStatus int
+	}{
+		{name: "success", body: `{"token":"invite-token","name":"New User","password":"password123"}`, wantStatus: http.StatusCreated},
+		{name: "missing token", body: `{"name":"New User","password":"password123"}`, wantStatus: http.StatusBadRequest},
+		{name: "weak password", body: `{"token":"invite-token","name":"New User","password":"password123"}`, err: &domainuser.PasswordPolicyError{}, wantStatus: http.StatusUnprocessableEntity},
+		{name: "invalid invitation", body: `{"token":"invite-token","name":"New User","password":"password123"}`, err: domainuser.ErrInvalidInvitation, wantStatus: http.StatusBadRequest},
+		{name: "registration disabled", body: `{"token":"invite-token","name":"New User","password":"password123"}`, err: domainuser.ErrRegistrationClosed, wantStatus: http.StatusForbidden},
+		{name: "email already registered", body: `{"token":"invite-token","name":"New User","password":"password123"}`, err: domainuser.ErrEmailExists, wantStatus: http.StatusConflict},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			acceptUC := &mockAcceptInvitationUseCase{}
+			handler := NewInvitationHandler(nil, acceptUC)
+			req := dto.AcceptInvitationRequest{Token: "invite-token", Name: "New User", Password: "password123"}
+			if tt.err != nil {
+				acceptUC.On("Execute", mock.Anything, req).Return(nil, tt.err)
+			} else {
+				acceptUC.On("Execute", mock.Anything, req).Return(&dto.UserResponse{ID: 9, Email: "new@example.com"}, nil).Maybe()
+			}
+
+			gin.SetMode(gin.TestMode)
+			router := gin.New()
+			router.POST("/users/invitations/accept", handler.Accept)
+
+			httpReq := httptest.NewRequest(http.MethodPost, "/users/invitations/accept", bytes.NewBufferString(tt.body))
+			httpReq.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+
+			router.ServeHTTP(w, httpReq)
+
+			assert.Equal(t, tt.wantStatus, w.Code)
+		})
+	}
+}
@@ -0,0 +1,27 @@
+package user
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	domainuser "github.com/rulzi/hexa-go/internal/domain/user"
+)
+
+// JWKSHandler publishes the public keys that verify access tokens
+type JWKSHandler struct {
+	keys domainuser.PublicKeyProvider
+}
+
+// NewJWKSHandler creates a new JWKSHandler
+func NewJWKSHandler(keys domainuser.PublicKeyProvider) *JWKSHandler {
+	return &JWKSHandler{
+		keys: keys,
+	}
+}
+
+// JWKS handles GET /.well-known/jwks.json
+func (h *JWKSHandler) JWKS(c *gin.Context) {
+	// Served as a plain JWK Set (RFC 7517) so standard JWT libraries can consume it
+	c.Header("Cache-Control", "public, max-age=300")
+	c.JSON(http.StatusOK, gin.H{"keys": h.keys.PublicKeys()})
+}
@@ -0,0 +1,47 @@
+package user
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	domainuser "github.com/rulzi/hexa-go/internal/domain/user"
+	"github.com/stretchr/testify/assert"
+)
+
+// stubPublicKeyProvider returns a fixed set of keys
+type stubPublicKeyProvider []domainuser.PublicKey
+
+func (s stubPublicKeyProvider) PublicKeys() []domainuser.PublicKey {
+	return s
+}
+
+func TestJWKSHandler_JWKS(t *testing.T) {
+	keys := stubPublicKeyProvider{
+		{KeyID: "2024-01", KeyType: "OKP", Algorithm: "EdDSA", Use: "sig", Curve: "Ed25519", X: "abc"},
+	}
+	handler := NewJWKSHandler(keys)
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.GET("/.well-known/jwks.json", handler.JWKS)
+
+	req := httptest.NewRequest(http.MethodGet, "/.well-known/jwks.json", nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "public, max-age=300", w.Header().Get("Cache-Control"))
+
+	var body struct {
+		Keys []map[string]string `json:"keys"`
+	}
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+	assert.Len(t, body.Keys, 1)
+	assert.Equal(t, "2024-01", body.Keys[0]["kid"])
+	assert.Equal(t, "Ed25519", body.Keys[0]["crv"])
+	assert.NotContains(t, body.Keys[0], "n")
+}
@@ -0,0 +1,51 @@
+package user
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rulzi/hexa-go/internal/adapters/http/response"
+	"github.com/rulzi/hexa-go/internal/application/user/dto"
+	domainuser "github.com/rulzi/hexa-go/internal/domain/user"
+)
+
+// ListLoginAttemptsUseCase is the interface for the list login attempts use case
+type ListLoginAttemptsUseCase interface {
+	Execute(ctx context.Context, orgID, userID int64, limit int) (*dto.ListLoginAttemptsResponse, error)
+}
+
+// LoginActivityHandler handles HTTP requests for the login activity of users
+type LoginActivityHandler struct {
+	listUseCase ListLoginAttemptsUseCase
+}
+
+// NewLoginActivityHandler creates a new LoginActivityHandler
+func NewLoginActivityHandler(listUseCase ListLoginAttemptsUseCase) *LoginActivityHandler {
+	return &LoginActivityHandler{
+		listUseCase: listUseCase,
+	}
+}
+
+// List handles GET /users/:id/login-attempts
+func (h *LoginActivityHandler) List(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		response.ErrorResponseBadRequest(c, "invalid user id")
+		return
+	}
+
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "20"))
+
+	resp, err := h.listUseCase.Execute(c.Request.Context(), c.GetInt64("org_id"), id, limit)
+	if err != nil {
+		if err == domainuser.ErrUserNotFound {
+			response.ErrorResponseNotFound(c, err.Error())
+		} else {
+			response.ErrorResponseInternalServerError(c, err.Error())
+		}
+		return
+	}
+
+	response.SuccessResponseOK(c, "Login attempts retrieved successfully", resp)
+}
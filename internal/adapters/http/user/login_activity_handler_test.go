@@ -0,0 +1,89 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rulzi/hexa-go/internal/application/user/dto"
+	domainuser "github.com/rulzi/hexa-go/internal/domain/user"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// mockListLoginAttemptsUseCase is a mock implementation of ListLoginAttemptsUseCase
+type mockListLoginAttemptsUseCase struct {
+	mock.Mock
+}
+
+func (m *mockListLoginAttemptsUseCase) Execute(ctx context.Context, orgID, userID int64, limit int) (*dto.ListLoginAttemptsResponse, error) {
+	args := m.Called(ctx, orgID, userID, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.ListLoginAttemptsResponse), args.Error(1)
+}
+
+func TestLoginActivityHandler_List(t *testing.T) {
+	tests := []struct {
+		name       string
+		path       string
+		setup      func(uc *mockListLoginAttemptsUseCase)
+		wantStatus int
+	}{
+		{
+			name: "success",
+			path: "/users/1/login-attempts?limit=5",
+			setup: func(uc *mockListLoginAttemptsUseCase) {
+				uc.On("Execute", mock.Anything, int64(1), int64(1), 5).Return(&dto.ListLoginAttemptsResponse{Limit: 5}, nil)
+			},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "invalid user id",
+			path:       "/users/abc/login-attempts",
+			setup:      func(uc *mockListLoginAttemptsUseCase) {},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name: "user not found",
+			path: "/users/1/login-attempts",
+			setup: func(uc *mockListLoginAttemptsUseCase) {
+				uc.On("Execute", mock.Anything, int64(1), int64(1), 20).Return(nil, domainuser.ErrUserNotFound)
+			},
+			wantStatus: http.StatusNotFound,
+		},
+		{
+			name: "internal error",
+			path: "/users/1/login-attempts",
+			setup: func(uc *mockListLoginAttemptsUseCase) {
+				uc.On("Execute", mock.Anything, int64(1), int64(1), 20).Return(nil, errors.New("database error"))
+			},
+			wantStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			listUC := &mockListLoginAttemptsUseCase{}
+			handler := NewLoginActivityHandler(listUC)
+			tt.setup(listUC)
+
+			gin.SetMode(gin.TestMode)
+			router := gin.New()
+			router.GET("/users/:id/login-attempts", withAuthContext(1, "jti", time.Now().Add(time.Hour)), handler.List)
+
+			req := httptest.NewRequest(http.MethodGet, tt.path, nil)
+			w := httptest.NewRecorder()
+
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.wantStatus, w.Code)
+			listUC.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,103 @@
+package user
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rulzi/hexa-go/internal/adapters/http/response"
+	"github.com/rulzi/hexa-go/internal/application/user/dto"
+	domainuser "github.com/rulzi/hexa-go/internal/domain/user"
+)
+
+// CreateOrganizationUseCase is the interface for the create organization use case
+type CreateOrganizationUseCase interface {
+	Execute(ctx context.Context, userID int64, req dto.CreateOrganizationRequest) (*dto.OrganizationResponse, error)
+}
+
+// ListOrganizationsUseCase is the interface for the list organizations use case
+type ListOrganizationsUseCase interface {
+	Execute(ctx context.Context, userID, currentOrgID int64) ([]dto.OrganizationResponse, error)
+}
+
+// SwitchOrganizationUseCase is the interface for the switch organization use case
+type SwitchOrganizationUseCase interface {
+	Execute(ctx context.Context, userID, orgID int64, sessionID string) (*dto.TokenResponse, error)
+}
+
+// OrganizationHandler handles HTTP requests for the organizations of the current user
+type OrganizationHandler struct {
+	createUseCase CreateOrganizationUseCase
+	listUseCase   ListOrganizationsUseCase
+	switchUseCase SwitchOrganizationUseCase
+}
+
+// NewOrganizationHandler creates a new OrganizationHandler
+func NewOrganizationHandler(
+	createUseCase CreateOrganizationUseCase,
+	listUseCase ListOrganizationsUseCase,
+	switchUseCase SwitchOrganizationUseCase,
+) *OrganizationHandler {
+	return &OrganizationHandler{
+		createUseCase: createUseCase,
+		listUseCase:   listUseCase,
+		switchUseCase: switchUseCase,
+	}
+}
+
+// Create handles POST /organizations
+func (h *OrganizationHandler) Create(c *gin.Context) {
+	var req dto.CreateOrganizationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.ErrorResponseBadRequest(c, err.Error())
+		return
+	}
+
+	resp, err := h.createUseCase.Execute(c.Request.Context(), c.GetInt64("user_id"), req)
+	if err != nil {
+		if err == domainuser.ErrOrganizationNameRequired {
+			response.ErrorResponseBadRequest(c, err.Error())
+		} else {
+			response.ErrorResponseInternalServerError(c, err.Error())
+		}
+		return
+	}
+
+	response.SuccessResponseCreated(c, "Organization created successfully", resp)
+}
+
+// List handles GET /organizations
+func (h *OrganizationHandler) List(c *gin.Context) {
+	resp, err := h.listUseCase.Execute(c.Request.Context(), c.GetInt64("user_id"), c.GetInt64("org_id"))
+	if err != nil {
+		response.ErrorResponseInternalServerError(c, err.Error())
+		return
+	}
+
+	response.SuccessResponseOK(c, "Organizations retrieved successfully", resp)
+}
+
+// Switch handles POST /organizations/:id/switch.
+// The new tokens act in the organization, the current ones keep acting in the old one until they expire.
+func (h *OrganizationHandler) Switch(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil || id <= 0 {
+		response.ErrorResponseBadRequest(c, "invalid organization id")
+		return
+	}
+
+	resp, err := h.switchUseCase.Execute(c.Request.Context(), c.GetInt64("user_id"), id, c.GetString("session_id"))
+	if err != nil {
+		switch err {
+		case domainuser.ErrOrganizationNotFound:
+			response.ErrorResponseNotFound(c, err.Error())
+		case domainuser.ErrUserNotFound:
+			response.ErrorResponseUnauthorized(c, err.Error())
+		default:
+			response.ErrorResponseInternalServerError(c, err.Error())
+		}
+		return
+	}
+
+	response.SuccessResponseOK(c, "Organization switched successfully", resp)
+}
@@ -0,0 +1,192 @@
+package user
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rulzi/hexa-go/internal/application/user/dto"
+	domainuser "github.com/rulzi/hexa-go/internal/domain/user"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// mockCreateOrganizationUseCase is a mock implementation of CreateOrganizationUseCase
+type mockCreateOrganizationUseCase struct {
+	mock.Mock
+}
+
+func (m *mockCreateOrganizationUseCase) Execute(ctx context.Context, userID int64, req dto.CreateOrganizationRequest) (*dto.OrganizationResponse, error) {
+	args := m.Called(ctx, userID, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.OrganizationResponse), args.Error(1)
+}
+
+// mockListOrganizationsUseCase is a mock implementation of ListOrganizationsUseCase
+type mockListOrganizationsUseCase struct {
+	mock.Mock
+}
+
+func (m *mockListOrganizationsUseCase) Execute(ctx context.Context, userID, currentOrgID int64) ([]dto.OrganizationResponse, error) {
+	args := m.Called(ctx, userID, currentOrgID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]dto.OrganizationResponse), args.Error(1)
+}
+
+// mockSwitchOrganizationUseCase is a mock implementation of SwitchOrganizationUseCase
+type mockSwitchOrganizationUseCase struct {
+	mock.Mock
+}
+
+func (m *mockSwitchOrganizationUseCase) Execute(ctx context.Context, userID, orgID int64, sessionID string) (*dto.TokenResponse, error) {
+	args := m.Called(ctx, userID, orgID, sessionID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.TokenResponse), args.Error(1)
+}
+
+func TestOrganizationHandler_Create(t *testing.T) {
+	tests := []struct {
+		name       string
+		body       string
+		setup      func(uc *mockCreateOrganizationUseCase)
+		wantStatus int
+	}{
+		{
+			name: "success",
+			body: `{"name":"Acme"}`,
+			setup: func(uc *mockCreateOrganizationUseCase) {
+				uc.On("Execute", mock.Anything, int64(1), dto.CreateOrganizationRequest{Name: "Acme"}).
+					Return(&dto.OrganizationResponse{ID: 4, Name: "Acme", Role: "admin"}, nil)
+			},
+			wantStatus: http.StatusCreated,
+		},
+		{
+			name:       "missing name",
+			body:       `{}`,
+			setup:      func(uc *mockCreateOrganizationUseCase) {},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name: "internal error",
+			body: `{"name":"Acme"}`,
+			setup: func(uc *mockCreateOrganizationUseCase) {
+				uc.On("Execute", mock.Anything, int64(1), mock.Anything).Return(nil, errors.New("database error"))
+			},
+			wantStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			createUC := &mockCreateOrganizationUseCase{}
+			handler := NewOrganizationHandler(createUC, nil, nil)
+			tt.setup(createUC)
+
+			gin.SetMode(gin.TestMode)
+			router := gin.New()
+			router.POST("/organizations", withAuthContext(1, "jti", time.Now().Add(time.Hour)), handler.Create)
+
+			req := httptest.NewRequest(http.MethodPost, "/organizations", bytes.NewBufferString(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.wantStatus, w.Code)
+			createUC.AssertExpectations(t)
+		})
+	}
+}
+
+func TestOrganizationHandler_List(t *testing.T) {
+	listUC := &mockListOrganizationsUseCase{}
+	handler := NewOrganizationHandler(nil, listUC, nil)
+	listUC.On("Execute", mock.Anything, int64(1), int64(1)).Return([]dto.OrganizationResponse{
+		{ID: 1, Name: "Default", Role: "author", Current: true},
+	}, nil)
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.GET("/organizations", withAuthContext(1, "jti", time.Now().Add(time.Hour)), handler.List)
+
+	req := httptest.NewRequest(http.MethodGet, "/organizations", nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `"current":true`)
+	listUC.AssertExpectations(t)
+}
+
+func TestOrganizationHandler_Switch(t *testing.T) {
+	tests := []struct {
+		name       string
+		id         string
+		setup      func(uc *mockSwitchOrganizationUseCase)
+		wantStatus int
+	}{
+		{
+			name: "success",
+			id:   "4",
+			setup: func(uc *mockSwitchOrganizationUseCase) {
+				uc.On("Execute", mock.Anything, int64(1), int64(4), "session-1").
+					Return(&dto.TokenResponse{Token: "access_token", RefreshToken: "refresh_token"}, nil)
+			},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "invalid id",
+			id:         "abc",
+			setup:      func(uc *mockSwitchOrganizationUseCase) {},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name: "not a member",
+			id:   "4",
+			setup: func(uc *mockSwitchOrganizationUseCase) {
+				uc.On("Execute", mock.Anything, int64(1), int64(4), "session-1").Return(nil, domainuser.ErrOrganizationNotFound)
+			},
+			wantStatus: http.StatusNotFound,
+		},
+		{
+			name: "internal error",
+			id:   "4",
+			setup: func(uc *mockSwitchOrganizationUseCase) {
+				uc.On("Execute", mock.Anything, int64(1), int64(4), "session-1").Return(nil, errors.New("database error"))
+			},
+			wantStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			switchUC := &mockSwitchOrganizationUseCase{}
+			handler := NewOrganizationHandler(nil, nil, switchUC)
+			tt.setup(switchUC)
+
+			gin.SetMode(gin.TestMode)
+			router := gin.New()
+			router.POST("/organizations/:id/switch", withSessionContext(1, "session-1"), handler.Switch)
+
+			req := httptest.NewRequest(http.MethodPost, "/organizations/"+tt.id+"/switch", nil)
+			w := httptest.NewRecorder()
+
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.wantStatus, w.Code)
+			switchUC.AssertExpectations(t)
+		})
+	}
+}
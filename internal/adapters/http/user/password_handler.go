@@ -0,0 +1,74 @@
+package user
+
+import (
+	"context"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rulzi/hexa-go/internal/adapters/http/response"
+	"github.com/rulzi/hexa-go/internal/application/user/dto"
+	domainuser "github.com/rulzi/hexa-go/internal/domain/user"
+)
+
+// ForgotPasswordUseCase is the interface for the forgot password use case
+type ForgotPasswordUseCase interface {
+	Execute(ctx context.Context, req dto.ForgotPasswordRequest) error
+}
+
+// ResetPasswordUseCase is the interface for the reset password use case
+type ResetPasswordUseCase interface {
+	Execute(ctx context.Context, req dto.ResetPasswordRequest) error
+}
+
+// PasswordHandler handles HTTP requests for password recovery
+type PasswordHandler struct {
+	forgotUseCase ForgotPasswordUseCase
+	resetUseCase  ResetPasswordUseCase
+}
+
+// NewPasswordHandler creates a new PasswordHandler
+func NewPasswordHandler(forgotUseCase ForgotPasswordUseCase, resetUseCase ResetPasswordUseCase) *PasswordHandler {
+	return &PasswordHandler{
+		forgotUseCase: forgotUseCase,
+		resetUseCase:  resetUseCase,
+	}
+}
+
+// Forgot handles POST /users/password/forgot
+func (h *PasswordHandler) Forgot(c *gin.Context) {
+	var req dto.ForgotPasswordRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.ErrorResponseBadRequest(c, err.Error())
+		return
+	}
+
+	if err := h.forgotUseCase.Execute(c.Request.Context(), req); err != nil {
+		response.ErrorResponseInternalServerError(c, err.Error())
+		return
+	}
+
+	// Same response whether or not the email is registered
+	response.SuccessResponseOK(c, "If the email is registered, a password reset link has been sent", nil)
+}
+
+// Reset handles POST /users/password/reset
+func (h *PasswordHandler) Reset(c *gin.Context) {
+	var req dto.ResetPasswordRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.ErrorResponseBadRequest(c, err.Error())
+		return
+	}
+
+	if err := h.resetUseCase.Execute(c.Request.Context(), req); err != nil {
+		if respondWeakPassword(c, err) {
+			return
+		}
+		if err == domainuser.ErrInvalidResetToken {
+			response.ErrorResponseBadRequest(c, err.Error())
+		} else {
+			response.ErrorResponseInternalServerError(c, err.Error())
+		}
+		return
+	}
+
+	response.SuccessResponseOK(c, "Password reset successfully", nil)
+}
@@ -0,0 +1,165 @@
+package user
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rulzi/hexa-go/internal/application/user/dto"
+	domainuser "github.com/rulzi/hexa-go/internal/domain/user"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// mockForgotPasswordUseCase is a mock implementation of ForgotPasswordUseCase
+type mockForgotPasswordUseCase struct {
+	mock.Mock
+}
+
+func (m *mockForgotPasswordUseCase) Execute(ctx context.Context, req dto.ForgotPasswordRequest) error {
+	args := m.Called(ctx, req)
+	return args.Error(0)
+}
+
+// mockResetPasswordUseCase is a mock implementation of ResetPasswordUseCase
+type mockResetPasswordUseCase struct {
+	mock.Mock
+}
+
+func (m *mockResetPasswordUseCase) Execute(ctx context.Context, req dto.ResetPasswordRequest) error {
+	args := m.Called(ctx, req)
+	return args.Error(0)
+}
+
+func TestNewPasswordHandler(t *testing.T) {
+	forgotUC := &mockForgotPasswordUseCase{}
+	resetUC := &mockResetPasswordUseCase{}
+
+	handler := NewPasswordHandler(forgotUC, resetUC)
+
+	assert.NotNil(t, handler)
+	assert.Equal(t, forgotUC, handler.forgotUseCase)
+	assert.Equal(t, resetUC, handler.resetUseCase)
+}
+
+func TestPasswordHandler_Forgot(t *testing.T) {
+	tests := []struct {
+		name       string
+		body       string
+		setup      func(uc *mockForgotPasswordUseCase)
+		wantStatus int
+	}{
+		{
+			name: "success",
+			body: `{"email":"test@example.com"}`,
+			setup: func(uc *mockForgotPasswordUseCase) {
+				uc.On("Execute", mock.Anything, dto.ForgotPasswordRequest{Email: "test@example.com"}).Return(nil)
+			},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "invalid email",
+			body:       `{"email":"not-an-email"}`,
+			setup:      func(uc *mockForgotPasswordUseCase) {},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name: "internal error",
+			body: `{"email":"test@example.com"}`,
+			setup: func(uc *mockForgotPasswordUseCase) {
+				uc.On("Execute", mock.Anything, mock.Anything).Return(errors.New("database error"))
+			},
+			wantStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			forgotUC := &mockForgotPasswordUseCase{}
+			handler := NewPasswordHandler(forgotUC, &mockResetPasswordUseCase{})
+			tt.setup(forgotUC)
+
+			gin.SetMode(gin.TestMode)
+			router := gin.New()
+			router.POST("/users/password/forgot", handler.Forgot)
+
+			req := httptest.NewRequest(http.MethodPost, "/users/password/forgot", bytes.NewBufferString(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.wantStatus, w.Code)
+			forgotUC.AssertExpectations(t)
+		})
+	}
+}
+
+func TestPasswordHandler_Reset(t *testing.T) {
+	tests := []struct {
+		name       string
+		body       string
+		setup      func(uc *mockResetPasswordUseCase)
+		wantStatus int
+	}{
+		{
+			name: "success",
+			body: `{"token":"reset_token","password":"new_password"}`,
+			setup: func(uc *mockResetPasswordUseCase) {
+				uc.On("Execute", mock.Anything, dto.ResetPasswordRequest{Token: "reset_token", Password: "new_password"}).Return(nil)
+			},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name: "password too short",
+			body: `{"token":"reset_token","password":"123"}`,
+			setup: func(uc *mockResetPasswordUseCase) {
+				uc.On("Execute", mock.Anything, mock.Anything).Return(&domainuser.PasswordPolicyError{
+					Violations: []domainuser.PasswordViolation{{Rule: domainuser.PasswordRuleMinLength, Message: "too short"}},
+				})
+			},
+			wantStatus: http.StatusUnprocessableEntity,
+		},
+		{
+			name: "invalid token",
+			body: `{"token":"reset_token","password":"new_password"}`,
+			setup: func(uc *mockResetPasswordUseCase) {
+				uc.On("Execute", mock.Anything, mock.Anything).Return(domainuser.ErrInvalidResetToken)
+			},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name: "internal error",
+			body: `{"token":"reset_token","password":"new_password"}`,
+			setup: func(uc *mockResetPasswordUseCase) {
+				uc.On("Execute", mock.Anything, mock.Anything).Return(errors.New("database error"))
+			},
+			wantStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resetUC := &mockResetPasswordUseCase{}
+			handler := NewPasswordHandler(&mockForgotPasswordUseCase{}, resetUC)
+			tt.setup(resetUC)
+
+			gin.SetMode(gin.TestMode)
+			router := gin.New()
+			router.POST("/users/password/reset", handler.Reset)
+
+			req := httptest.NewRequest(http.MethodPost, "/users/password/reset", bytes.NewBufferString(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.wantStatus, w.Code)
+			resetUC.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,129 @@
+package user
+
+import (
+	"context"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rulzi/hexa-go/internal/adapters/http/response"
+	"github.com/rulzi/hexa-go/internal/application/user/dto"
+	domainuser "github.com/rulzi/hexa-go/internal/domain/user"
+)
+
+// UpdateProfileUseCase is the interface for the update profile use case
+type UpdateProfileUseCase interface {
+	Execute(ctx context.Context, orgID, userID int64, req dto.UpdateProfileRequest) (*dto.UserResponse, error)
+}
+
+// DeleteAccountUseCase is the interface for the delete account use case
+type DeleteAccountUseCase interface {
+	Execute(ctx context.Context, userID int64) error
+}
+
+// ChangePasswordUseCase is the interface for the change password use case
+type ChangePasswordUseCase interface {
+	Execute(ctx context.Context, userID int64, req dto.ChangePasswordRequest) error
+}
+
+// ProfileHandler handles HTTP requests of users managing their own account.
+// The user always comes from the authenticated context, never from the URL.
+type ProfileHandler struct {
+	getUseCase            GetUserUseCase
+	updateUseCase         UpdateProfileUseCase
+	deleteUseCase         DeleteAccountUseCase
+	changePasswordUseCase ChangePasswordUseCase
+}
+
+// NewProfileHandler creates a new ProfileHandler
+func NewProfileHandler(
+	getUseCase GetUserUseCase,
+	updateUseCase UpdateProfileUseCase,
+	deleteUseCase DeleteAccountUseCase,
+	changePasswordUseCase ChangePasswordUseCase,
+) *ProfileHandler {
+	return &ProfileHandler{
+		getUseCase:            getUseCase,
+		updateUseCase:         updateUseCase,
+		deleteUseCase:         deleteUseCase,
+		changePasswordUseCase: changePasswordUseCase,
+	}
+}
+
+// Get handles GET /users/me
+func (h *ProfileHandler) Get(c *gin.Context) {
+	resp, err := h.getUseCase.Execute(c.Request.Context(), c.GetInt64("org_id"), c.GetInt64("user_id"))
+	if err != nil {
+		if err == domainuser.ErrUserNotFound {
+			response.ErrorResponseNotFound(c, err.Error())
+		} else {
+			response.ErrorResponseInternalServerError(c, err.Error())
+		}
+		return
+	}
+
+	response.SuccessResponseOK(c, "User retrieved successfully", resp)
+}
+
+// Update handles PUT /users/me
+func (h *ProfileHandler) Update(c *gin.Context) {
+	var req dto.UpdateProfileRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.ErrorResponseBadRequest(c, err.Error())
+		return
+	}
+
+	resp, err := h.updateUseCase.Execute(c.Request.Context(), c.GetInt64("org_id"), c.GetInt64("user_id"), req)
+	if err != nil {
+		switch err {
+		case domainuser.ErrUserNotFound:
+			response.ErrorResponseNotFound(c, err.Error())
+		case domainuser.ErrEmailExists:
+			response.ErrorResponseConflict(c, err.Error())
+		default:
+			response.ErrorResponseInternalServerError(c, err.Error())
+		}
+		return
+	}
+
+	response.SuccessResponseOK(c, "User updated successfully", resp)
+}
+
+// Delete handles DELETE /users/me
+func (h *ProfileHandler) Delete(c *gin.Context) {
+	err := h.deleteUseCase.Execute(c.Request.Context(), c.GetInt64("user_id"))
+	if err != nil {
+		if err == domainuser.ErrUserNotFound {
+			response.ErrorResponseNotFound(c, err.Error())
+		} else {
+			response.ErrorResponseInternalServerError(c, err.Error())
+		}
+		return
+	}
+
+	response.SuccessResponseOK(c, "User deleted successfully", nil)
+}
+
+// ChangePassword handles PUT /users/me/password
+func (h *ProfileHandler) ChangePassword(c *gin.Context) {
+	var req dto.ChangePasswordRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.ErrorResponseBadRequest(c, err.Error())
+		return
+	}
+
+	err := h.changePasswordUseCase.Execute(c.Request.Context(), c.GetInt64("user_id"), req)
+	if err != nil {
+		if respondWeakPassword(c, err) {
+			return
+		}
+		if err == domainuser.ErrIncorrectPassword {
+			response.ErrorResponseBadRequest(c, err.Error())
+		} else if err == domainuser.ErrUserNotFound {
+			response.ErrorResponseNotFound(c, err.Error())
+		} else {
+			response.ErrorResponseInternalServerError(c, err.Error())
+		}
+		return
+	}
+
+	response.SuccessResponseOK(c, "Password changed successfully, please sign in again", nil)
+}
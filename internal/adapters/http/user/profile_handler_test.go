@@ -0,0 +1,248 @@
+package user
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rulzi/hexa-go/internal/application/user/dto"
+	domainuser "github.com/rulzi/hexa-go/internal/domain/user"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// mockUpdateProfileUseCase is a mock implementation of UpdateProfileUseCase
+type mockUpdateProfileUseCase struct {
+	mock.Mock
+}
+
+func (m *mockUpdateProfileUseCase) Execute(ctx context.Context, orgID, userID int64, req dto.UpdateProfileRequest) (*dto.UserResponse, error) {
+	args := m.Called(ctx, orgID, userID, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.UserResponse), args.Error(1)
+}
+
+// mockDeleteAccountUseCase is a mock implementation of DeleteAccountUseCase
+type mockDeleteAccountUseCase struct {
+	mock.Mock
+}
+
+func (m *mockDeleteAccountUseCase) Execute(ctx context.Context, userID int64) error {
+	args := m.Called(ctx, userID)
+	return args.Error(0)
+}
+
+// mockChangePasswordUseCase is a mock implementation of ChangePasswordUseCase
+type mockChangePasswordUseCase struct {
+	mock.Mock
+}
+
+func (m *mockChangePasswordUseCase) Execute(ctx context.Context, userID int64, req dto.ChangePasswordRequest) error {
+	args := m.Called(ctx, userID, req)
+	return args.Error(0)
+}
+
+func TestProfileHandler_Get(t *testing.T) {
+	tests := []struct {
+		name       string
+		setup      func(uc *mockGetUserUseCase)
+		wantStatus int
+	}{
+		{
+			name: "success",
+			setup: func(uc *mockGetUserUseCase) {
+				uc.On("Execute", mock.Anything, int64(1), int64(7)).Return(&dto.UserResponse{ID: 7, Name: "Test User"}, nil)
+			},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name: "user deleted",
+			setup: func(uc *mockGetUserUseCase) {
+				uc.On("Execute", mock.Anything, int64(1), int64(7)).Return(nil, domainuser.ErrUserNotFound)
+			},
+			wantStatus: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			getUC := &mockGetUserUseCase{}
+			handler := NewProfileHandler(getUC, nil, nil, nil)
+			tt.setup(getUC)
+
+			gin.SetMode(gin.TestMode)
+			router := gin.New()
+			router.GET("/users/me", withAuthContext(7, "jti-1", time.Now().Add(time.Minute)), handler.Get)
+
+			req := httptest.NewRequest(http.MethodGet, "/users/me", nil)
+			w := httptest.NewRecorder()
+
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.wantStatus, w.Code)
+			getUC.AssertExpectations(t)
+		})
+	}
+}
+
+func TestProfileHandler_Update(t *testing.T) {
+	tests := []struct {
+		name       string
+		body       string
+		setup      func(uc *mockUpdateProfileUseCase)
+		wantStatus int
+	}{
+		{
+			name: "success",
+			body: `{"name":"New Name","email":"test@example.com"}`,
+			setup: func(uc *mockUpdateProfileUseCase) {
+				uc.On("Execute", mock.Anything, int64(1), int64(7), dto.UpdateProfileRequest{Name: "New Name", Email: "test@example.com"}).
+					Return(&dto.UserResponse{ID: 7, Name: "New Name"}, nil)
+			},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "invalid email",
+			body:       `{"name":"New Name","email":"not-an-email"}`,
+			setup:      func(uc *mockUpdateProfileUseCase) {},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name: "email taken",
+			body: `{"name":"New Name","email":"taken@example.com"}`,
+			setup: func(uc *mockUpdateProfileUseCase) {
+				uc.On("Execute", mock.Anything, int64(1), int64(7), mock.Anything).Return(nil, domainuser.ErrEmailExists)
+			},
+			wantStatus: http.StatusConflict,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			updateUC := &mockUpdateProfileUseCase{}
+			handler := NewProfileHandler(nil, updateUC, nil, nil)
+			tt.setup(updateUC)
+
+			gin.SetMode(gin.TestMode)
+			router := gin.New()
+			router.PUT("/users/me", withAuthContext(7, "jti-1", time.Now().Add(time.Minute)), handler.Update)
+
+			req := httptest.NewRequest(http.MethodPut, "/users/me", bytes.NewBufferString(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.wantStatus, w.Code)
+			updateUC.AssertExpectations(t)
+		})
+	}
+}
+
+func TestProfileHandler_Delete(t *testing.T) {
+	tests := []struct {
+		name       string
+		setup      func(uc *mockDeleteAccountUseCase)
+		wantStatus int
+	}{
+		{
+			name: "success",
+			setup: func(uc *mockDeleteAccountUseCase) {
+				uc.On("Execute", mock.Anything, int64(7)).Return(nil)
+			},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name: "error on delete",
+			setup: func(uc *mockDeleteAccountUseCase) {
+				uc.On("Execute", mock.Anything, int64(7)).Return(errors.New("database error"))
+			},
+			wantStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			deleteUC := &mockDeleteAccountUseCase{}
+			handler := NewProfileHandler(nil, nil, deleteUC, nil)
+			tt.setup(deleteUC)
+
+			gin.SetMode(gin.TestMode)
+			router := gin.New()
+			router.DELETE("/users/me", withAuthContext(7, "jti-1", time.Now().Add(time.Minute)), handler.Delete)
+
+			req := httptest.NewRequest(http.MethodDelete, "/users/me", nil)
+			w := httptest.NewRecorder()
+
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.wantStatus, w.Code)
+			deleteUC.AssertExpectations(t)
+		})
+	}
+}
+
+func TestProfileHandler_ChangePassword(t *testing.T) {
+	tests := []struct {
+		name       string
+		body       string
+		setup      func(uc *mockChangePasswordUseCase)
+		wantStatus int
+	}{
+		{
+			name: "success",
+			body: `{"current_password":"old_password","new_password":"new_password"}`,
+			setup: func(uc *mockChangePasswordUseCase) {
+				uc.On("Execute", mock.Anything, int64(7), dto.ChangePasswordRequest{CurrentPassword: "old_password", NewPassword: "new_password"}).
+					Return(nil)
+			},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name: "new password too short",
+			body: `{"current_password":"old_password","new_password":"short"}`,
+			setup: func(uc *mockChangePasswordUseCase) {
+				uc.On("Execute", mock.Anything, int64(7), mock.Anything).Return(&domainuser.PasswordPolicyError{
+					Violations: []domainuser.PasswordViolation{{Rule: domainuser.PasswordRuleMinLength, Message: "too short"}},
+				})
+			},
+			wantStatus: http.StatusUnprocessableEntity,
+		},
+		{
+			name: "wrong current password",
+			body: `{"current_password":"wrong","new_password":"new_password"}`,
+			setup: func(uc *mockChangePasswordUseCase) {
+				uc.On("Execute", mock.Anything, int64(7), mock.Anything).Return(domainuser.ErrIncorrectPassword)
+			},
+			wantStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			changePasswordUC := &mockChangePasswordUseCase{}
+			handler := NewProfileHandler(nil, nil, nil, changePasswordUC)
+			tt.setup(changePasswordUC)
+
+			gin.SetMode(gin.TestMode)
+			router := gin.New()
+			router.PUT("/users/me/password", withAuthContext(7, "jti-1", time.Now().Add(time.Minute)), handler.ChangePassword)
+
+			req := httptest.NewRequest(http.MethodPut, "/users/me/password", bytes.NewBufferString(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.wantStatus, w.Code)
+			changePasswordUC.AssertExpectations(t)
+		})
+	}
+}
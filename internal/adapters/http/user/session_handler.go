@@ -0,0 +1,76 @@
+package user
+
+import (
+	"context"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rulzi/hexa-go/internal/adapters/http/response"
+	"github.com/rulzi/hexa-go/internal/application/user/dto"
+	domainuser "github.com/rulzi/hexa-go/internal/domain/user"
+)
+
+// ListSessionsUseCase is the interface for the list sessions use case
+type ListSessionsUseCase interface {
+	Execute(ctx context.Context, userID int64, currentSessionID string) ([]dto.SessionResponse, error)
+}
+
+// RevokeSessionUseCase is the interface for the revoke session use case
+type RevokeSessionUseCase interface {
+	Execute(ctx context.Context, userID int64, sessionID string) error
+}
+
+// RevokeAllSessionsUseCase is the interface for the revoke all sessions use case
+type RevokeAllSessionsUseCase interface {
+	Execute(ctx context.Context, userID int64) error
+}
+
+// SessionHandler handles HTTP requests for the sessions of the current user
+type SessionHandler struct {
+	listUseCase      ListSessionsUseCase
+	revokeUseCase    RevokeSessionUseCase
+	revokeAllUseCase RevokeAllSessionsUseCase
+}
+
+// NewSessionHandler creates a new SessionHandler
+func NewSessionHandler(listUseCase ListSessionsUseCase, revokeUseCase RevokeSessionUseCase, revokeAllUseCase RevokeAllSessionsUseCase) *SessionHandler {
+	return &SessionHandler{
+		listUseCase:      listUseCase,
+		revokeUseCase:    revokeUseCase,
+		revokeAllUseCase: revokeAllUseCase,
+	}
+}
+
+// List handles GET /users/me/sessions
+func (h *SessionHandler) List(c *gin.Context) {
+	resp, err := h.listUseCase.Execute(c.Request.Context(), c.GetInt64("user_id"), c.GetString("session_id"))
+	if err != nil {
+		response.ErrorResponseInternalServerError(c, err.Error())
+		return
+	}
+
+	response.SuccessResponseOK(c, "Sessions retrieved successfully", resp)
+}
+
+// Revoke handles DELETE /users/me/sessions/:id
+func (h *SessionHandler) Revoke(c *gin.Context) {
+	if err := h.revokeUseCase.Execute(c.Request.Context(), c.GetInt64("user_id"), c.Param("id")); err != nil {
+		if err == domainuser.ErrSessionNotFound {
+			response.ErrorResponseNotFound(c, err.Error())
+		} else {
+			response.ErrorResponseInternalServerError(c, err.Error())
+		}
+		return
+	}
+
+	response.SuccessResponseOK(c, "Session revoked successfully", nil)
+}
+
+// RevokeAll handles DELETE /users/me/sessions
+func (h *SessionHandler) RevokeAll(c *gin.Context) {
+	if err := h.revokeAllUseCase.Execute(c.Request.Context(), c.GetInt64("user_id")); err != nil {
+		response.ErrorResponseInternalServerError(c, err.Error())
+		return
+	}
+
+	response.SuccessResponseOK(c, "All sessions revoked successfully", nil)
+}
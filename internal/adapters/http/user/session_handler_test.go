@@ -0,0 +1,167 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rulzi/hexa-go/internal/application/user/dto"
+	domainuser "github.com/rulzi/hexa-go/internal/domain/user"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// mockListSessionsUseCase is a mock implementation of ListSessionsUseCase
+type mockListSessionsUseCase struct {
+	mock.Mock
+}
+
+func (m *mockListSessionsUseCase) Execute(ctx context.Context, userID int64, currentSessionID string) ([]dto.SessionResponse, error) {
+	args := m.Called(ctx, userID, currentSessionID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]dto.SessionResponse), args.Error(1)
+}
+
+// mockRevokeSessionUseCase is a mock implementation of RevokeSessionUseCase
+type mockRevokeSessionUseCase struct {
+	mock.Mock
+}
+
+func (m *mockRevokeSessionUseCase) Execute(ctx context.Context, userID int64, sessionID string) error {
+	args := m.Called(ctx, userID, sessionID)
+	return args.Error(0)
+}
+
+// mockRevokeAllSessionsUseCase is a mock implementation of RevokeAllSessionsUseCase
+type mockRevokeAllSessionsUseCase struct {
+	mock.Mock
+}
+
+func (m *mockRevokeAllSessionsUseCase) Execute(ctx context.Context, userID int64) error {
+	args := m.Called(ctx, userID)
+	return args.Error(0)
+}
+
+// withSessionContext sets the authenticated user and its session like the auth middleware
+func withSessionContext(userID int64, sessionID string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Set("user_id", userID)
+		c.Set("session_id", sessionID)
+		c.Next()
+	}
+}
+
+func TestSessionHandler_List(t *testing.T) {
+	listUC := &mockListSessionsUseCase{}
+	handler := NewSessionHandler(listUC, nil, nil)
+	listUC.On("Execute", mock.Anything, int64(1), "session-1").Return([]dto.SessionResponse{
+		{ID: "session-1", UserAgent: "Mozilla/5.0", LastSeenAt: time.Now(), Current: true},
+	}, nil)
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.GET("/users/me/sessions", withSessionContext(1, "session-1"), handler.List)
+
+	req := httptest.NewRequest(http.MethodGet, "/users/me/sessions", nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `"current":true`)
+	listUC.AssertExpectations(t)
+}
+
+func TestSessionHandler_Revoke(t *testing.T) {
+	tests := []struct {
+		name       string
+		setup      func(uc *mockRevokeSessionUseCase)
+		wantStatus int
+	}{
+		{
+			name: "success",
+			setup: func(uc *mockRevokeSessionUseCase) {
+				uc.On("Execute", mock.Anything, int64(1), "session-2").Return(nil)
+			},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name: "not found",
+			setup: func(uc *mockRevokeSessionUseCase) {
+				uc.On("Execute", mock.Anything, int64(1), "session-2").Return(domainuser.ErrSessionNotFound)
+			},
+			wantStatus: http.StatusNotFound,
+		},
+		{
+			name: "internal error",
+			setup: func(uc *mockRevokeSessionUseCase) {
+				uc.On("Execute", mock.Anything, int64(1), "session-2").Return(errors.New("database error"))
+			},
+			wantStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			revokeUC := &mockRevokeSessionUseCase{}
+			handler := NewSessionHandler(nil, revokeUC, nil)
+			tt.setup(revokeUC)
+
+			gin.SetMode(gin.TestMode)
+			router := gin.New()
+			router.DELETE("/users/me/sessions/:id", withSessionContext(1, "session-1"), handler.Revoke)
+
+			req := httptest.NewRequest(http.MethodDelete, "/users/me/sessions/session-2", nil)
+			w := httptest.NewRecorder()
+
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.wantStatus, w.Code)
+			revokeUC.AssertExpectations(t)
+		})
+	}
+}
+
+func TestSessionHandler_RevokeAll(t *testing.T) {
+	tests := []struct {
+		name       string
+		err        error
+		wantStatus int
+	}{
+		{
+			name:       "success",
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "internal error",
+			err:        errors.New("database error"),
+			wantStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			revokeAllUC := &mockRevokeAllSessionsUseCase{}
+			handler := NewSessionHandler(nil, nil, revokeAllUC)
+			revokeAllUC.On("Execute", mock.Anything, int64(1)).Return(tt.err)
+
+			gin.SetMode(gin.TestMode)
+			router := gin.New()
+			router.DELETE("/users/me/sessions", withSessionContext(1, "session-1"), handler.RevokeAll)
+
+			req := httptest.NewRequest(http.MethodDelete, "/users/me/sessions", nil)
+			w := httptest.NewRecorder()
+
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.wantStatus, w.Code)
+			revokeAllUC.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,86 @@
+package user
+
+import (
+	"context"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rulzi/hexa-go/internal/adapters/http/response"
+	"github.com/rulzi/hexa-go/internal/application/user/dto"
+	domainuser "github.com/rulzi/hexa-go/internal/domain/user"
+)
+
+// RefreshTokenUseCase is the interface for the refresh token use case
+type RefreshTokenUseCase interface {
+	Execute(ctx context.Context, req dto.RefreshTokenRequest) (*dto.TokenResponse, error)
+}
+
+// LogoutUseCase is the interface for the logout use case
+type LogoutUseCase interface {
+	Execute(ctx context.Context, userID int64, tokenID string, tokenExpiresAt time.Time, req dto.LogoutRequest) error
+}
+
+// TokenHandler handles HTTP requests for token refresh and logout
+type TokenHandler struct {
+	refreshUseCase RefreshTokenUseCase
+	logoutUseCase  LogoutUseCase
+}
+
+// NewTokenHandler creates a new TokenHandler
+func NewTokenHandler(refreshUseCase RefreshTokenUseCase, logoutUseCase LogoutUseCase) *TokenHandler {
+	return &TokenHandler{
+		refreshUseCase: refreshUseCase,
+		logoutUseCase:  logoutUseCase,
+	}
+}
+
+// Refresh handles POST /users/refresh
+func (h *TokenHandler) Refresh(c *gin.Context) {
+	var req dto.RefreshTokenRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.ErrorResponseBadRequest(c, err.Error())
+		return
+	}
+
+	resp, err := h.refreshUseCase.Execute(c.Request.Context(), req)
+	if err != nil {
+		switch err {
+		case domainuser.ErrInvalidRefreshToken, domainuser.ErrRefreshTokenReused:
+			response.ErrorResponseUnauthorized(c, err.Error())
+		default:
+			response.ErrorResponseInternalServerError(c, err.Error())
+		}
+		return
+	}
+
+	response.SuccessResponseOK(c, "Token refreshed successfully", resp)
+}
+
+// Logout handles POST /users/logout
+func (h *TokenHandler) Logout(c *gin.Context) {
+	var req dto.LogoutRequest
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			response.ErrorResponseBadRequest(c, err.Error())
+			return
+		}
+	}
+
+	err := h.logoutUseCase.Execute(
+		c.Request.Context(),
+		c.GetInt64("user_id"),
+		c.GetString("token_id"),
+		c.GetTime("token_expires_at"),
+		req,
+	)
+	if err != nil {
+		if err == domainuser.ErrInvalidRefreshToken {
+			response.ErrorResponseBadRequest(c, err.Error())
+		} else {
+			response.ErrorResponseInternalServerError(c, err.Error())
+		}
+		return
+	}
+
+	response.SuccessResponseOK(c, "Logout successful", nil)
+}
@@ -0,0 +1,214 @@
+package user
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rulzi/hexa-go/internal/application/user/dto"
+	domainuser "github.com/rulzi/hexa-go/internal/domain/user"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// mockRefreshTokenUseCase is a mock implementation of RefreshTokenUseCase
+type mockRefreshTokenUseCase struct {
+	mock.Mock
+}
+
+func (m *mockRefreshTokenUseCase) Execute(ctx context.Context, req dto.RefreshTokenRequest) (*dto.TokenResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.TokenResponse), args.Error(1)
+}
+
+// mockLogoutUseCase is a mock implementation of LogoutUseCase
+type mockLogoutUseCase struct {
+	mock.Mock
+}
+
+func (m *mockLogoutUseCase) Execute(ctx context.Context, userID int64, tokenID string, tokenExpiresAt time.Time, req dto.LogoutRequest) error {
+	args := m.Called(ctx, userID, tokenID, tokenExpiresAt, req)
+	return args.Error(0)
+}
+
+// withAuthContext sets the values AuthMiddleware would put in the context
+func withAuthContext(userID int64, tokenID string, expiresAt time.Time) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Set("user_id", userID)
+		c.Set("org_id", int64(1))
+		c.Set("token_id", tokenID)
+		c.Set("token_expires_at", expiresAt)
+		c.Next()
+	}
+}
+
+func TestNewTokenHandler(t *testing.T) {
+	refreshUC := &mockRefreshTokenUseCase{}
+	logoutUC := &mockLogoutUseCase{}
+
+	handler := NewTokenHandler(refreshUC, logoutUC)
+
+	assert.NotNil(t, handler)
+	assert.Equal(t, refreshUC, handler.refreshUseCase)
+	assert.Equal(t, logoutUC, handler.logoutUseCase)
+}
+
+func TestTokenHandler_Refresh(t *testing.T) {
+	tests := []struct {
+		name       string
+		body       string
+		setup      func(uc *mockRefreshTokenUseCase)
+		wantStatus int
+	}{
+		{
+			name: "success",
+			body: `{"refresh_token":"refresh_token"}`,
+			setup: func(uc *mockRefreshTokenUseCase) {
+				uc.On("Execute", mock.Anything, dto.RefreshTokenRequest{RefreshToken: "refresh_token"}).
+					Return(&dto.TokenResponse{Token: "access", RefreshToken: "new_refresh"}, nil)
+			},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "missing refresh token",
+			body:       `{}`,
+			setup:      func(uc *mockRefreshTokenUseCase) {},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name: "invalid refresh token",
+			body: `{"refresh_token":"refresh_token"}`,
+			setup: func(uc *mockRefreshTokenUseCase) {
+				uc.On("Execute", mock.Anything, mock.Anything).Return(nil, domainuser.ErrInvalidRefreshToken)
+			},
+			wantStatus: http.StatusUnauthorized,
+		},
+		{
+			name: "reused refresh token",
+			body: `{"refresh_token":"refresh_token"}`,
+			setup: func(uc *mockRefreshTokenUseCase) {
+				uc.On("Execute", mock.Anything, mock.Anything).Return(nil, domainuser.ErrRefreshTokenReused)
+			},
+			wantStatus: http.StatusUnauthorized,
+		},
+		{
+			name: "internal error",
+			body: `{"refresh_token":"refresh_token"}`,
+			setup: func(uc *mockRefreshTokenUseCase) {
+				uc.On("Execute", mock.Anything, mock.Anything).Return(nil, errors.New("database error"))
+			},
+			wantStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			refreshUC := &mockRefreshTokenUseCase{}
+			handler := NewTokenHandler(refreshUC, &mockLogoutUseCase{})
+			tt.setup(refreshUC)
+
+			gin.SetMode(gin.TestMode)
+			router := gin.New()
+			router.POST("/users/refresh", handler.Refresh)
+
+			req := httptest.NewRequest(http.MethodPost, "/users/refresh", bytes.NewBufferString(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.wantStatus, w.Code)
+			refreshUC.AssertExpectations(t)
+		})
+	}
+}
+
+func TestTokenHandler_Logout_Success(t *testing.T) {
+	logoutUC := &mockLogoutUseCase{}
+	handler := NewTokenHandler(&mockRefreshTokenUseCase{}, logoutUC)
+
+	expiresAt := time.Now().Add(15 * time.Minute)
+	reqBody := dto.LogoutRequest{RefreshToken: "refresh_token"}
+	logoutUC.On("Execute", mock.Anything, int64(1), "jti-1", expiresAt, reqBody).Return(nil)
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.POST("/users/logout", withAuthContext(1, "jti-1", expiresAt), handler.Logout)
+
+	body, _ := json.Marshal(reqBody)
+	req := httptest.NewRequest(http.MethodPost, "/users/logout", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	logoutUC.AssertExpectations(t)
+
+	var response map[string]interface{}
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	assert.NoError(t, err)
+	assert.Equal(t, "Logout successful", response["message"])
+}
+
+func TestTokenHandler_Logout_WithoutBody(t *testing.T) {
+	logoutUC := &mockLogoutUseCase{}
+	handler := NewTokenHandler(&mockRefreshTokenUseCase{}, logoutUC)
+
+	expiresAt := time.Now().Add(15 * time.Minute)
+	logoutUC.On("Execute", mock.Anything, int64(1), "jti-1", expiresAt, dto.LogoutRequest{}).Return(nil)
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.POST("/users/logout", withAuthContext(1, "jti-1", expiresAt), handler.Logout)
+
+	req := httptest.NewRequest(http.MethodPost, "/users/logout", nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	logoutUC.AssertExpectations(t)
+}
+
+func TestTokenHandler_Logout_Errors(t *testing.T) {
+	tests := []struct {
+		name       string
+		err        error
+		wantStatus int
+	}{
+		{name: "refresh token of another user", err: domainuser.ErrInvalidRefreshToken, wantStatus: http.StatusBadRequest},
+		{name: "internal error", err: errors.New("redis error"), wantStatus: http.StatusInternalServerError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			logoutUC := &mockLogoutUseCase{}
+			handler := NewTokenHandler(&mockRefreshTokenUseCase{}, logoutUC)
+
+			expiresAt := time.Now().Add(15 * time.Minute)
+			logoutUC.On("Execute", mock.Anything, int64(1), "jti-1", expiresAt, mock.Anything).Return(tt.err)
+
+			gin.SetMode(gin.TestMode)
+			router := gin.New()
+			router.POST("/users/logout", withAuthContext(1, "jti-1", expiresAt), handler.Logout)
+
+			req := httptest.NewRequest(http.MethodPost, "/users/logout", bytes.NewBufferString(`{"refresh_token":"x"}`))
+			req.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.wantStatus, w.Code)
+		})
+	}
+}
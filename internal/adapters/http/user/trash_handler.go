@@ -0,0 +1,73 @@
+package user
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rulzi/hexa-go/internal/adapters/http/response"
+	"github.com/rulzi/hexa-go/internal/application/user/dto"
+	domainuser "github.com/rulzi/hexa-go/internal/domain/user"
+)
+
+// ListDeletedUsersUseCase is the interface for the list deleted users use case
+type ListDeletedUsersUseCase interface {
+	Execute(ctx context.Context, orgID int64, limit, offset int) (*dto.ListUsersResponse, error)
+}
+
+// RestoreUserUseCase is the interface for the restore user use case
+type RestoreUserUseCase interface {
+	Execute(ctx context.Context, orgID, id int64) (*dto.UserResponse, error)
+}
+
+// TrashHandler handles HTTP requests for the users in the trash
+type TrashHandler struct {
+	listUseCase    ListDeletedUsersUseCase
+	restoreUseCase RestoreUserUseCase
+}
+
+// NewTrashHandler creates a new TrashHandler
+func NewTrashHandler(listUseCase ListDeletedUsersUseCase, restoreUseCase RestoreUserUseCase) *TrashHandler {
+	return &TrashHandler{
+		listUseCase:    listUseCase,
+		restoreUseCase: restoreUseCase,
+	}
+}
+
+// List handles GET /admin/trash/users
+func (h *TrashHandler) List(c *gin.Context) {
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "10"))
+	offset, _ := strconv.Atoi(c.DefaultQuery("offset", "0"))
+
+	resp, err := h.listUseCase.Execute(c.Request.Context(), c.GetInt64("org_id"), limit, offset)
+	if err != nil {
+		response.ErrorResponseInternalServerError(c, err.Error())
+		return
+	}
+
+	response.SuccessResponseOK(c, "Deleted users retrieved successfully", resp)
+}
+
+// Restore handles POST /admin/trash/users/:id/restore
+func (h *TrashHandler) Restore(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		response.ErrorResponseBadRequest(c, "invalid user id")
+		return
+	}
+
+	resp, err := h.restoreUseCase.Execute(c.Request.Context(), c.GetInt64("org_id"), id)
+	if err != nil {
+		switch err {
+		case domainuser.ErrUserNotFound:
+			response.ErrorResponseNotFound(c, err.Error())
+		case domainuser.ErrEmailExists:
+			response.ErrorResponseConflict(c, err.Error())
+		default:
+			response.ErrorResponseInternalServerError(c, err.Error())
+		}
+		return
+	}
+
+	response.SuccessResponseOK(c, "User restored successfully", resp)
+}
@@ -0,0 +1,134 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rulzi/hexa-go/internal/application/user/dto"
+	domainuser "github.com/rulzi/hexa-go/internal/domain/user"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// mockListDeletedUsersUseCase is a mock implementation of ListDeletedUsersUseCase
+type mockListDeletedUsersUseCase struct {
+	mock.Mock
+}
+
+func (m *mockListDeletedUsersUseCase) Execute(ctx context.Context, orgID int64, limit, offset int) (*dto.ListUsersResponse, error) {
+	args := m.Called(ctx, orgID, limit, offset)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.ListUsersResponse), args.Error(1)
+}
+
+// mockRestoreUserUseCase is a mock implementation of RestoreUserUseCase
+type mockRestoreUserUseCase struct {
+	mock.Mock
+}
+
+func (m *mockRestoreUserUseCase) Execute(ctx context.Context, orgID, id int64) (*dto.UserResponse, error) {
+	args := m.Called(ctx, orgID, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.UserResponse), args.Error(1)
+}
+
+func TestTrashHandler_List(t *testing.T) {
+	listUC := &mockListDeletedUsersUseCase{}
+	handler := NewTrashHandler(listUC, nil)
+	deletedAt := time.Now()
+	listUC.On("Execute", mock.Anything, int64(1), 20, 40).Return(&dto.ListUsersResponse{
+		Users: []dto.UserResponse{{ID: 7, Email: "john@example.com", DeletedAt: &deletedAt}},
+		Total: 41,
+		Limit: 20, Offset: 40,
+	}, nil)
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.GET("/admin/trash/users", withAuthContext(1, "jti", time.Now().Add(time.Hour)), handler.List)
+
+	req := httptest.NewRequest(http.MethodGet, "/admin/trash/users?limit=20&offset=40", nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `"deleted_at"`)
+	listUC.AssertExpectations(t)
+}
+
+func TestTrashHandler_Restore(t *testing.T) {
+	tests := []struct {
+		name       string
+		id         string
+		setup      func(uc *mockRestoreUserUseCase)
+		wantStatus int
+	}{
+		{
+			name: "restored",
+			id:   "7",
+			setup: func(uc *mockRestoreUserUseCase) {
+				uc.On("Execute", mock.Anything, int64(1), int64(7)).Return(&dto.UserResponse{ID: 7}, nil)
+			},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "invalid id",
+			id:         "abc",
+			setup:      func(uc *mockRestoreUserUseCase) {},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name: "not in trash",
+			id:   "7",
+			setup: func(uc *mockRestoreUserUseCase) {
+				uc.On("Execute", mock.Anything, int64(1), int64(7)).Return(nil, domainuser.ErrUserNotFound)
+			},
+			wantStatus: http.StatusNotFound,
+		},
+		{
+			name: "email taken meanwhile",
+			id:   "7",
+			setup: func(uc *mockRestoreUserUseCase) {
+				uc.On("Execute", mock.Anything, int64(1), int64(7)).Return(nil, domainuser.ErrEmailExists)
+			},
+			wantStatus: http.StatusConflict,
+		},
+		{
+			name: "repository error",
+			id:   "7",
+			setup: func(uc *mockRestoreUserUseCase) {
+				uc.On("Execute", mock.Anything, int64(1), int64(7)).Return(nil, errors.New("database error"))
+			},
+			wantStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			restoreUC := &mockRestoreUserUseCase{}
+			tt.setup(restoreUC)
+			handler := NewTrashHandler(nil, restoreUC)
+
+			gin.SetMode(gin.TestMode)
+			router := gin.New()
+			router.POST("/admin/trash/users/:id/restore", withAuthContext(1, "jti", time.Now().Add(time.Hour)), handler.Restore)
+
+			req := httptest.NewRequest(http.MethodPost, "/admin/trash/users/"+tt.id+"/restore", nil)
+			w := httptest.NewRecorder()
+
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.wantStatus, w.Code)
+			restoreUC.AssertExpectations(t)
+		})
+	}
+}
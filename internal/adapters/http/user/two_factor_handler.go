@@ -0,0 +1,175 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"math"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rulzi/hexa-go/internal/adapters/http/response"
+	"github.com/rulzi/hexa-go/internal/application/user/dto"
+	domainuser "github.com/rulzi/hexa-go/internal/domain/user"
+)
+
+// EnrollTwoFactorUseCase is the interface for the enroll two-factor use case
+type EnrollTwoFactorUseCase interface {
+	Execute(ctx context.Context, userID int64) (*dto.TwoFactorEnrollmentResponse, error)
+}
+
+// ConfirmTwoFactorUseCase is the interface for the confirm two-factor use case
+type ConfirmTwoFactorUseCase interface {
+	Execute(ctx context.Context, userID int64, req dto.TwoFactorCodeRequest) (*dto.RecoveryCodesResponse, error)
+}
+
+// RegenerateRecoveryCodesUseCase is the interface for the regenerate recovery codes use case
+type RegenerateRecoveryCodesUseCase interface {
+	Execute(ctx context.Context, userID int64, req dto.TwoFactorCodeRequest) (*dto.RecoveryCodesResponse, error)
+}
+
+// DisableTwoFactorUseCase is the interface for the disable two-factor use case
+type DisableTwoFactorUseCase interface {
+	Execute(ctx context.Context, userID int64, req dto.TwoFactorCodeRequest) error
+}
+
+// LoginTwoFactorUseCase is the interface for the login two-factor use case
+type LoginTwoFactorUseCase interface {
+	Execute(ctx context.Context, req dto.LoginTwoFactorRequest) (*dto.LoginResponse, error)
+}
+
+// TwoFactorHandler handles HTTP requests for two-factor authentication
+type TwoFactorHandler struct {
+	enrollUseCase        EnrollTwoFactorUseCase
+	confirmUseCase       ConfirmTwoFactorUseCase
+	recoveryCodesUseCase RegenerateRecoveryCodesUseCase
+	disableUseCase       DisableTwoFactorUseCase
+	loginUseCase         LoginTwoFactorUseCase
+}
+
+// NewTwoFactorHandler creates a new TwoFactorHandler
+func NewTwoFactorHandler(
+	enrollUseCase EnrollTwoFactorUseCase,
+	confirmUseCase ConfirmTwoFactorUseCase,
+	recoveryCodesUseCase RegenerateRecoveryCodesUseCase,
+	disableUseCase DisableTwoFactorUseCase,
+	loginUseCase LoginTwoFactorUseCase,
+) *TwoFactorHandler {
+	return &TwoFactorHandler{
+		enrollUseCase:        enrollUseCase,
+		confirmUseCase:       confirmUseCase,
+		recoveryCodesUseCase: recoveryCodesUseCase,
+		disableUseCase:       disableUseCase,
+		loginUseCase:         loginUseCase,
+	}
+}
+
+// Enroll handles POST /users/me/2fa/enroll
+func (h *TwoFactorHandler) Enroll(c *gin.Context) {
+	resp, err := h.enrollUseCase.Execute(c.Request.Context(), c.GetInt64("user_id"))
+	if err != nil {
+		if err == domainuser.ErrTwoFactorAlreadyEnabled {
+			response.ErrorResponseConflict(c, err.Error())
+		} else if err == domainuser.ErrUserNotFound {
+			response.ErrorResponseNotFound(c, err.Error())
+		} else {
+			response.ErrorResponseInternalServerError(c, err.Error())
+		}
+		return
+	}
+
+	response.SuccessResponseOK(c, "Scan the provisioning URI and confirm with a code", resp)
+}
+
+// Confirm handles POST /users/me/2fa/confirm
+func (h *TwoFactorHandler) Confirm(c *gin.Context) {
+	var req dto.TwoFactorCodeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.ErrorResponseBadRequest(c, err.Error())
+		return
+	}
+
+	resp, err := h.confirmUseCase.Execute(c.Request.Context(), c.GetInt64("user_id"), req)
+	if err != nil {
+		respondTwoFactorError(c, err)
+		return
+	}
+
+	response.SuccessResponseOK(c, "Two-factor authentication enabled, store the recovery codes safely", resp)
+}
+
+// RegenerateRecoveryCodes handles POST /users/me/2fa/recovery-codes
+func (h *TwoFactorHandler) RegenerateRecoveryCodes(c *gin.Context) {
+	var req dto.TwoFactorCodeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.ErrorResponseBadRequest(c, err.Error())
+		return
+	}
+
+	resp, err := h.recoveryCodesUseCase.Execute(c.Request.Context(), c.GetInt64("user_id"), req)
+	if err != nil {
+		respondTwoFactorError(c, err)
+		return
+	}
+
+	response.SuccessResponseOK(c, "Recovery codes regenerated successfully", resp)
+}
+
+// Disable handles POST /users/me/2fa/disable
+func (h *TwoFactorHandler) Disable(c *gin.Context) {
+	var req dto.TwoFactorCodeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.ErrorResponseBadRequest(c, err.Error())
+		return
+	}
+
+	if err := h.disableUseCase.Execute(c.Request.Context(), c.GetInt64("user_id"), req); err != nil {
+		respondTwoFactorError(c, err)
+		return
+	}
+
+	response.SuccessResponseOK(c, "Two-factor authentication disabled successfully", nil)
+}
+
+// Login handles POST /users/login/2fa
+func (h *TwoFactorHandler) Login(c *gin.Context) {
+	var req dto.LoginTwoFactorRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.ErrorResponseBadRequest(c, err.Error())
+		return
+	}
+	req.IPAddress = c.ClientIP()
+	req.UserAgent = c.Request.UserAgent()
+
+	resp, err := h.loginUseCase.Execute(c.Request.Context(), req)
+	if err != nil {
+		var locked *domainuser.LoginLockedError
+		if errors.As(err, &locked) {
+			c.Header("Retry-After", strconv.Itoa(int(math.Ceil(locked.RetryAfter.Seconds()))))
+			response.ErrorResponseTooManyRequests(c, err.Error())
+		} else if err == domainuser.ErrInvalidTwoFactorChallenge || err == domainuser.ErrInvalidTwoFactorCode {
+			response.ErrorResponseUnauthorized(c, err.Error())
+		} else {
+			response.ErrorResponseInternalServerError(c, err.Error())
+		}
+		return
+	}
+
+	response.SuccessResponseOK(c, "Login successful", resp)
+}
+
+// respondTwoFactorError maps errors of the two-factor management use cases to responses
+func respondTwoFactorError(c *gin.Context, err error) {
+	var locked *domainuser.LoginLockedError
+	if errors.As(err, &locked) {
+		c.Header("Retry-After", strconv.Itoa(int(math.Ceil(locked.RetryAfter.Seconds()))))
+		response.ErrorResponseTooManyRequests(c, err.Error())
+	} else if err == domainuser.ErrInvalidTwoFactorCode {
+		response.ErrorResponseBadRequest(c, err.Error())
+	} else if err == domainuser.ErrTwoFactorNotEnrolled || err == domainuser.ErrTwoFactorNotEnabled {
+		response.ErrorResponseNotFound(c, err.Error())
+	} else if err == domainuser.ErrTwoFactorAlreadyEnabled {
+		response.ErrorResponseConflict(c, err.Error())
+	} else {
+		response.ErrorResponseInternalServerError(c, err.Error())
+	}
+}
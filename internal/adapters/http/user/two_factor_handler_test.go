@@ -0,0 +1,279 @@
+package user
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rulzi/hexa-go/internal/application/user/dto"
+	domainuser "github.com/rulzi/hexa-go/internal/domain/user"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// mockEnrollTwoFactorUseCase is a mock implementation of EnrollTwoFactorUseCase
+type mockEnrollTwoFactorUseCase struct {
+	mock.Mock
+}
+
+func (m *mockEnrollTwoFactorUseCase) Execute(ctx context.Context, userID int64) (*dto.TwoFactorEnrollmentResponse, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.TwoFactorEnrollmentResponse), args.Error(1)
+}
+
+// mockRecoveryCodesUseCase is a mock implementation of ConfirmTwoFactorUseCase and RegenerateRecoveryCodesUseCase
+type mockRecoveryCodesUseCase struct {
+	mock.Mock
+}
+
+func (m *mockRecoveryCodesUseCase) Execute(ctx context.Context, userID int64, req dto.TwoFactorCodeRequest) (*dto.RecoveryCodesResponse, error) {
+	args := m.Called(ctx, userID, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.RecoveryCodesResponse), args.Error(1)
+}
+
+// mockDisableTwoFactorUseCase is a mock implementation of DisableTwoFactorUseCase
+type mockDisableTwoFactorUseCase struct {
+	mock.Mock
+}
+
+func (m *mockDisableTwoFactorUseCase) Execute(ctx context.Context, userID int64, req dto.TwoFactorCodeRequest) error {
+	args := m.Called(ctx, userID, req)
+	return args.Error(0)
+}
+
+// mockLoginTwoFactorUseCase is a mock implementation of LoginTwoFactorUseCase
+type mockLoginTwoFactorUseCase struct {
+	mock.Mock
+}
+
+func (m *mockLoginTwoFactorUseCase) Execute(ctx context.Context, req dto.LoginTwoFactorRequest) (*dto.LoginResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.LoginResponse), args.Error(1)
+}
+
+func TestTwoFactorHandler_Enroll(t *testing.T) {
+	tests := []struct {
+		name       string
+		setup      func(uc *mockEnrollTwoFactorUseCase)
+		wantStatus int
+	}{
+		{
+			name: "success",
+			setup: func(uc *mockEnrollTwoFactorUseCase) {
+				uc.On("Execute", mock.Anything, int64(1)).
+					Return(&dto.TwoFactorEnrollmentResponse{Secret: "SECRET", ProvisioningURI: "otpauth://totp/test"}, nil)
+			},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name: "already enabled",
+			setup: func(uc *mockEnrollTwoFactorUseCase) {
+				uc.On("Execute", mock.Anything, int64(1)).Return(nil, domainuser.ErrTwoFactorAlreadyEnabled)
+			},
+			wantStatus: http.StatusConflict,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			enrollUC := &mockEnrollTwoFactorUseCase{}
+			handler := NewTwoFactorHandler(enrollUC, nil, nil, nil, nil)
+			tt.setup(enrollUC)
+
+			gin.SetMode(gin.TestMode)
+			router := gin.New()
+			router.POST("/users/me/2fa/enroll", withAuthContext(1, "jti", time.Now().Add(time.Hour)), handler.Enroll)
+
+			req := httptest.NewRequest(http.MethodPost, "/users/me/2fa/enroll", nil)
+			w := httptest.NewRecorder()
+
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.wantStatus, w.Code)
+			enrollUC.AssertExpectations(t)
+		})
+	}
+}
+
+func TestTwoFactorHandler_Confirm(t *testing.T) {
+	tests := []struct {
+		name       string
+		body       string
+		setup      func(uc *mockRecoveryCodesUseCase)
+		wantStatus int
+	}{
+		{
+			name: "success",
+			body: `{"code":"123456"}`,
+			setup: func(uc *mockRecoveryCodesUseCase) {
+				uc.On("Execute", mock.Anything, int64(1), dto.TwoFactorCodeRequest{Code: "123456"}).
+					Return(&dto.RecoveryCodesResponse{RecoveryCodes: []string{"abcde-12345"}}, nil)
+			},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "missing code",
+			body:       `{}`,
+			setup:      func(uc *mockRecoveryCodesUseCase) {},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name: "invalid code",
+			body: `{"code":"000000"}`,
+			setup: func(uc *mockRecoveryCodesUseCase) {
+				uc.On("Execute", mock.Anything, int64(1), dto.TwoFactorCodeRequest{Code: "000000"}).Return(nil, domainuser.ErrInvalidTwoFactorCode)
+			},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name: "not enrolled",
+			body: `{"code":"123456"}`,
+			setup: func(uc *mockRecoveryCodesUseCase) {
+				uc.On("Execute", mock.Anything, int64(1), dto.TwoFactorCodeRequest{Code: "123456"}).Return(nil, domainuser.ErrTwoFactorNotEnrolled)
+			},
+			wantStatus: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			confirmUC := &mockRecoveryCodesUseCase{}
+			handler := NewTwoFactorHandler(nil, confirmUC, nil, nil, nil)
+			tt.setup(confirmUC)
+
+			gin.SetMode(gin.TestMode)
+			router := gin.New()
+			router.POST("/users/me/2fa/confirm", withAuthContext(1, "jti", time.Now().Add(time.Hour)), handler.Confirm)
+
+			req := httptest.NewRequest(http.MethodPost, "/users/me/2fa/confirm", bytes.NewBufferString(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.wantStatus, w.Code)
+			confirmUC.AssertExpectations(t)
+		})
+	}
+}
+
+func TestTwoFactorHandler_Disable(t *testing.T) {
+	tests := []struct {
+		name       string
+		err        error
+		wantStatus int
+	}{
+		{name: "success", wantStatus: http.StatusOK},
+		{name: "not enabled", err: domainuser.ErrTwoFactorNotEnabled, wantStatus: http.StatusNotFound},
+		{name: "locked after wrong codes", err: &domainuser.LoginLockedError{RetryAfter: time.Minute}, wantStatus: http.StatusTooManyRequests},
+		{name: "internal error", err: errors.New("database error"), wantStatus: http.StatusInternalServerError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			disableUC := &mockDisableTwoFactorUseCase{}
+			handler := NewTwoFactorHandler(nil, nil, nil, disableUC, nil)
+			disableUC.On("Execute", mock.Anything, int64(1), dto.TwoFactorCodeRequest{Code: "123456"}).Return(tt.err)
+
+			gin.SetMode(gin.TestMode)
+			router := gin.New()
+			router.POST("/users/me/2fa/disable", withAuthContext(1, "jti", time.Now().Add(time.Hour)), handler.Disable)
+
+			req := httptest.NewRequest(http.MethodPost, "/users/me/2fa/disable", bytes.NewBufferString(`{"code":"123456"}`))
+			req.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.wantStatus, w.Code)
+			disableUC.AssertExpectations(t)
+		})
+	}
+}
+
+func TestTwoFactorHandler_Login(t *testing.T) {
+	tests := []struct {
+		name           string
+		body           string
+		setup          func(uc *mockLoginTwoFactorUseCase)
+		wantStatus     int
+		wantRetryAfter string
+	}{
+		{
+			name: "success",
+			body: `{"challenge_token":"challenge","code":"123456"}`,
+			setup: func(uc *mockLoginTwoFactorUseCase) {
+				uc.On("Execute", mock.Anything, dto.LoginTwoFactorRequest{ChallengeToken: "challenge", Code: "123456", IPAddress: "192.0.2.1"}).
+					Return(&dto.LoginResponse{Token: "access", RefreshToken: "refresh"}, nil)
+			},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "missing challenge token",
+			body:       `{"code":"123456"}`,
+			setup:      func(uc *mockLoginTwoFactorUseCase) {},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name: "invalid code",
+			body: `{"challenge_token":"challenge","code":"000000"}`,
+			setup: func(uc *mockLoginTwoFactorUseCase) {
+				uc.On("Execute", mock.Anything, mock.Anything).Return(nil, domainuser.ErrInvalidTwoFactorCode)
+			},
+			wantStatus: http.StatusUnauthorized,
+		},
+		{
+			name: "expired challenge",
+			body: `{"challenge_token":"challenge","code":"123456"}`,
+			setup: func(uc *mockLoginTwoFactorUseCase) {
+				uc.On("Execute", mock.Anything, mock.Anything).Return(nil, domainuser.ErrInvalidTwoFactorChallenge)
+			},
+			wantStatus: http.StatusUnauthorized,
+		},
+		{
+			name: "locked",
+			body: `{"challenge_token":"challenge","code":"123456"}`,
+			setup: func(uc *mockLoginTwoFactorUseCase) {
+				uc.On("Execute", mock.Anything, mock.Anything).Return(nil, &domainuser.LoginLockedError{RetryAfter: 90 * time.Second})
+			},
+			wantStatus:     http.StatusTooManyRequests,
+			wantRetryAfter: "90",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			loginUC := &mockLoginTwoFactorUseCase{}
+			handler := NewTwoFactorHandler(nil, nil, nil, nil, loginUC)
+			tt.setup(loginUC)
+
+			gin.SetMode(gin.TestMode)
+			router := gin.New()
+			router.POST("/users/login/2fa", handler.Login)
+
+			req := httptest.NewRequest(http.MethodPost, "/users/login/2fa", bytes.NewBufferString(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.wantStatus, w.Code)
+			assert.Equal(t, tt.wantRetryAfter, w.Header().Get("Retry-After"))
+			loginUC.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,71 @@
+package user
+
+import (
+	"context"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rulzi/hexa-go/internal/adapters/http/response"
+	"github.com/rulzi/hexa-go/internal/application/user/dto"
+	domainuser "github.com/rulzi/hexa-go/internal/domain/user"
+)
+
+// VerifyEmailUseCase is the interface for the verify email use case
+type VerifyEmailUseCase interface {
+	Execute(ctx context.Context, token string) error
+}
+
+// ResendVerificationUseCase is the interface for the resend verification use case
+type ResendVerificationUseCase interface {
+	Execute(ctx context.Context, req dto.ResendVerificationRequest) error
+}
+
+// VerificationHandler handles HTTP requests for email verification
+type VerificationHandler struct {
+	verifyUseCase VerifyEmailUseCase
+	resendUseCase ResendVerificationUseCase
+}
+
+// NewVerificationHandler creates a new VerificationHandler
+func NewVerificationHandler(verifyUseCase VerifyEmailUseCase, resendUseCase ResendVerificationUseCase) *VerificationHandler {
+	return &VerificationHandler{
+		verifyUseCase: verifyUseCase,
+		resendUseCase: resendUseCase,
+	}
+}
+
+// Verify handles GET /users/verify?token=
+func (h *VerificationHandler) Verify(c *gin.Context) {
+	token := c.Query("token")
+	if token == "" {
+		response.ErrorResponseBadRequest(c, "token is required")
+		return
+	}
+
+	if err := h.verifyUseCase.Execute(c.Request.Context(), token); err != nil {
+		if err == domainuser.ErrInvalidVerificationToken {
+			response.ErrorResponseBadRequest(c, err.Error())
+		} else {
+			response.ErrorResponseInternalServerError(c, err.Error())
+		}
+		return
+	}
+
+	response.SuccessResponseOK(c, "Email verified successfully", nil)
+}
+
+// Resend handles POST /users/verify/resend
+func (h *VerificationHandler) Resend(c *gin.Context) {
+	var req dto.ResendVerificationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.ErrorResponseBadRequest(c, err.Error())
+		return
+	}
+
+	if err := h.resendUseCase.Execute(c.Request.Context(), req); err != nil {
+		response.ErrorResponseInternalServerError(c, err.Error())
+		return
+	}
+
+	// Same response whether or not the email is registered or already verified
+	response.SuccessResponseOK(c, "If the email is registered and unverified, a verification link has been sent", nil)
+}
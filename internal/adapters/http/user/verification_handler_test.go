@@ -0,0 +1,141 @@
+package user
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rulzi/hexa-go/internal/application/user/dto"
+	domainuser "github.com/rulzi/hexa-go/internal/domain/user"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// mockVerifyEmailUseCase is a mock implementation of VerifyEmailUseCase
+type mockVerifyEmailUseCase struct {
+	mock.Mock
+}
+
+func (m *mockVerifyEmailUseCase) Execute(ctx context.Context, token string) error {
+	args := m.Called(ctx, token)
+	return args.Error(0)
+}
+
+// mockResendVerificationUseCase is a mock implementation of ResendVerificationUseCase
+type mockResendVerificationUseCase struct {
+	mock.Mock
+}
+
+func (m *mockResendVerificationUseCase) Execute(ctx context.Context, req dto.ResendVerificationRequest) error {
+	args := m.Called(ctx, req)
+	return args.Error(0)
+}
+
+func TestVerificationHandler_Verify(t *testing.T) {
+	tests := []struct {
+		name       string
+		query      string
+		setup      func(uc *mockVerifyEmailUseCase)
+		wantStatus int
+	}{
+		{
+			name:  "success",
+			query: "?token=signed",
+			setup: func(uc *mockVerifyEmailUseCase) {
+				uc.On("Execute", mock.Anything, "signed").Return(nil)
+			},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "missing token",
+			query:      "",
+			setup:      func(uc *mockVerifyEmailUseCase) {},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:  "invalid token",
+			query: "?token=signed",
+			setup: func(uc *mockVerifyEmailUseCase) {
+				uc.On("Execute", mock.Anything, "signed").Return(domainuser.ErrInvalidVerificationToken)
+			},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:  "internal error",
+			query: "?token=signed",
+			setup: func(uc *mockVerifyEmailUseCase) {
+				uc.On("Execute", mock.Anything, "signed").Return(errors.New("database error"))
+			},
+			wantStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			verifyUC := &mockVerifyEmailUseCase{}
+			handler := NewVerificationHandler(verifyUC, &mockResendVerificationUseCase{})
+			tt.setup(verifyUC)
+
+			gin.SetMode(gin.TestMode)
+			router := gin.New()
+			router.GET("/users/verify", handler.Verify)
+
+			req := httptest.NewRequest(http.MethodGet, "/users/verify"+tt.query, nil)
+			w := httptest.NewRecorder()
+
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.wantStatus, w.Code)
+			verifyUC.AssertExpectations(t)
+		})
+	}
+}
+
+func TestVerificationHandler_Resend(t *testing.T) {
+	tests := []struct {
+		name       string
+		body       string
+		setup      func(uc *mockResendVerificationUseCase)
+		wantStatus int
+	}{
+		{
+			name: "success",
+			body: `{"email":"test@example.com"}`,
+			setup: func(uc *mockResendVerificationUseCase) {
+				uc.On("Execute", mock.Anything, dto.ResendVerificationRequest{Email: "test@example.com"}).Return(nil)
+			},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "invalid email",
+			body:       `{"email":"not-an-email"}`,
+			setup:      func(uc *mockResendVerificationUseCase) {},
+			wantStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resendUC := &mockResendVerificationUseCase{}
+			handler := NewVerificationHandler(&mockVerifyEmailUseCase{}, resendUC)
+			tt.setup(resendUC)
+
+			gin.SetMode(gin.TestMode)
+			router := gin.New()
+			router.POST("/users/verify/resend", handler.Resend)
+
+			req := httptest.NewRequest(http.MethodPost, "/users/verify/resend", bytes.NewBufferString(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.wantStatus, w.Code)
+			resendUC.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,43 @@
+package job
+
+import (
+	"context"
+	"log"
+	"time"
+)
+
+// DataRequestProcessor works through the pending data export and erasure requests
+type DataRequestProcessor interface {
+	Execute(ctx context.Context) (int, error)
+}
+
+// DataRequestJob processes data-subject requests in the background (driving adapter)
+type DataRequestJob struct {
+	processor DataRequestProcessor
+	interval  time.Duration
+}
+
+// NewDataRequestJob creates a new DataRequestJob polling for requests on every interval
+func NewDataRequestJob(processor DataRequestProcessor, interval time.Duration) *DataRequestJob {
+	return &DataRequestJob{
+		processor: processor,
+		interval:  interval,
+	}
+}
+
+// Run processes requests right away and then on every interval until the context is cancelled
+func (j *DataRequestJob) Run(ctx context.Context) {
+	runEvery(ctx, j.interval, j.RunOnce)
+}
+
+// RunOnce processes the pending requests once, errors are logged.
+// Running on several instances at the same time is safe, every request is claimed by one worker.
+func (j *DataRequestJob) RunOnce(ctx context.Context) {
+	processed, err := j.processor.Execute(ctx)
+	if err != nil {
+		log.Printf("Failed to process data requests: %v", err)
+	}
+	if processed > 0 {
+		log.Printf("Processed %d data requests", processed)
+	}
+}
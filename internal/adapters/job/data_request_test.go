@@ -0,0 +1,54 @@
+package job
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// stubProcessor counts how often it ran
+type stubProcessor struct {
+	runs int
+	err  error
+}
+
+func (p *stubProcessor) Execute(ctx context.Context) (int, error) {
+	p.runs++
+	return 1, p.err
+}
+
+func TestDataRequestJob_RunOnce(t *testing.T) {
+	processor := &stubProcessor{err: errors.New("database error")}
+	job := NewDataRequestJob(processor, time.Minute)
+
+	// Errors are logged, the job keeps going
+	job.RunOnce(context.Background())
+	job.RunOnce(context.Background())
+
+	assert.Equal(t, 2, processor.runs)
+}
+
+func TestDataRequestJob_Run(t *testing.T) {
+	processor := &stubProcessor{}
+	job := NewDataRequestJob(processor, time.Hour)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	done := make(chan struct{})
+	go func() {
+		job.Run(ctx)
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("Run did not stop after the context was cancelled")
+	}
+	// The first run happens right away
+	assert.Equal(t, 1, processor.runs)
+}
@@ -0,0 +1,23 @@
+package job
+
+import (
+	"context"
+	"time"
+)
+
+// runEvery calls fn right away and then on every interval until the context is cancelled.
+// A run that takes longer than the interval delays the next one instead of overlapping it.
+func runEvery(ctx context.Context, interval time.Duration, fn func(ctx context.Context)) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		fn(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
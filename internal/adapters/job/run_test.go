@@ -0,0 +1,37 @@
+package job
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestRunEvery(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	runs := make(chan struct{}, 10)
+	done := make(chan struct{})
+	go func() {
+		runEvery(ctx, 10*time.Millisecond, func(ctx context.Context) {
+			runs <- struct{}{}
+		})
+		close(done)
+	}()
+
+	// The first run happens right away, the next ones on every interval
+	for i := 0; i < 3; i++ {
+		select {
+		case <-runs:
+		case <-time.After(time.Second):
+			t.Fatalf("run %d did not happen", i+1)
+		}
+	}
+
+	cancel()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("runEvery did not stop after the context was cancelled")
+	}
+}
@@ -0,0 +1,43 @@
+package job
+
+import (
+	"context"
+	"log"
+	"time"
+)
+
+// ScheduledPublisher publishes the articles whose scheduled publish time has come
+type ScheduledPublisher interface {
+	Execute(ctx context.Context) (int, error)
+}
+
+// ScheduledPublishJob publishes scheduled articles in the background (driving adapter)
+type ScheduledPublishJob struct {
+	publisher ScheduledPublisher
+	interval  time.Duration
+}
+
+// NewScheduledPublishJob creates a new ScheduledPublishJob looking for due articles on every interval
+func NewScheduledPublishJob(publisher ScheduledPublisher, interval time.Duration) *ScheduledPublishJob {
+	return &ScheduledPublishJob{
+		publisher: publisher,
+		interval:  interval,
+	}
+}
+
+// Run publishes due articles right away and then on every interval until the context is cancelled
+func (j *ScheduledPublishJob) Run(ctx context.Context) {
+	runEvery(ctx, j.interval, j.RunOnce)
+}
+
+// RunOnce publishes the due articles once, errors are logged.
+// Running on several instances at the same time is safe, every article is published by one of them.
+func (j *ScheduledPublishJob) RunOnce(ctx context.Context) {
+	published, err := j.publisher.Execute(ctx)
+	if err != nil {
+		log.Printf("Failed to publish scheduled articles: %v", err)
+	}
+	if published > 0 {
+		log.Printf("Published %d scheduled articles", published)
+	}
+}
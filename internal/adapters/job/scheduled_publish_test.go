@@ -0,0 +1,43 @@
+package job
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestScheduledPublishJob_RunOnce(t *testing.T) {
+	publisher := &stubProcessor{err: errors.New("database error")}
+	job := NewScheduledPublishJob(publisher, time.Minute)
+
+	// Errors are logged, the job keeps going
+	job.RunOnce(context.Background())
+	job.RunOnce(context.Background())
+
+	assert.Equal(t, 2, publisher.runs)
+}
+
+func TestScheduledPublishJob_Run(t *testing.T) {
+	publisher := &stubProcessor{}
+	job := NewScheduledPublishJob(publisher, time.Hour)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	done := make(chan struct{})
+	go func() {
+		job.Run(ctx)
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("Run did not stop after the context was cancelled")
+	}
+	// The first run happens right away
+	assert.Equal(t, 1, publisher.runs)
+}
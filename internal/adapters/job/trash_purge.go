@@ -0,0 +1,58 @@
+package job
+
+import (
+	"context"
+	"log"
+	"time"
+)
+
+// Purger permanently deletes the items moved to the trash before a point in time
+type Purger interface {
+	Execute(ctx context.Context, deletedBefore time.Time) (int64, error)
+}
+
+// TrashPurgeStep is one kind of item purged by the job, the name is only used in logs
+type TrashPurgeStep struct {
+	Name   string
+	Purger Purger
+}
+
+// TrashPurgeJob hard-deletes trashed items once they are older than the retention period (driving adapter)
+type TrashPurgeJob struct {
+	steps     []TrashPurgeStep
+	retention time.Duration
+	interval  time.Duration
+	now       func() time.Time
+}
+
+// NewTrashPurgeJob creates a new TrashPurgeJob running the steps in the given order
+func NewTrashPurgeJob(steps []TrashPurgeStep, retention, interval time.Duration) *TrashPurgeJob {
+	return &TrashPurgeJob{
+		steps:     steps,
+		retention: retention,
+		interval:  interval,
+		now:       time.Now,
+	}
+}
+
+// Run purges right away and then on every interval until the context is cancelled
+func (j *TrashPurgeJob) Run(ctx context.Context) {
+	runEvery(ctx, j.interval, j.RunOnce)
+}
+
+// RunOnce runs every step once. A failing step is logged and does not stop the others.
+// Running on several instances at the same time is safe, every step is idempotent.
+func (j *TrashPurgeJob) RunOnce(ctx context.Context) {
+	deletedBefore := j.now().Add(-j.retention)
+
+	for _, step := range j.steps {
+		purged, err := step.Purger.Execute(ctx, deletedBefore)
+		if err != nil {
+			log.Printf("Failed to purge %s from the trash: %v", step.Name, err)
+			continue
+		}
+		if purged > 0 {
+			log.Printf("Purged %d %s from the trash", purged, step.Name)
+		}
+	}
+}
@@ -0,0 +1,61 @@
+package job
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// stubPurger records the cutoffs it was called with
+type stubPurger struct {
+	calls []time.Time
+	err   error
+}
+
+func (p *stubPurger) Execute(ctx context.Context, deletedBefore time.Time) (int64, error) {
+	p.calls = append(p.calls, deletedBefore)
+	return int64(len(p.calls)), p.err
+}
+
+func TestTrashPurgeJob_RunOnce(t *testing.T) {
+	articles := &stubPurger{err: errors.New("database error")}
+	users := &stubPurger{}
+	job := NewTrashPurgeJob([]TrashPurgeStep{
+		{Name: "articles", Purger: articles},
+		{Name: "users", Purger: users},
+	}, 30*24*time.Hour, time.Hour)
+
+	now := time.Date(2025, 12, 31, 12, 0, 0, 0, time.UTC)
+	job.now = func() time.Time { return now }
+
+	job.RunOnce(context.Background())
+
+	// A failing step does not stop the ones after it
+	assert.Equal(t, []time.Time{time.Date(2025, 12, 1, 12, 0, 0, 0, time.UTC)}, articles.calls)
+	assert.Equal(t, articles.calls, users.calls)
+}
+
+func TestTrashPurgeJob_Run(t *testing.T) {
+	purger := &stubPurger{}
+	job := NewTrashPurgeJob([]TrashPurgeStep{{Name: "media", Purger: purger}}, time.Hour, time.Hour)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	done := make(chan struct{})
+	go func() {
+		job.Run(ctx)
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("Run did not stop after the context was cancelled")
+	}
+	// The first purge runs right away
+	assert.Len(t, purger.calls, 1)
+}
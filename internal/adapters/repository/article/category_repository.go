@@ -0,0 +1,131 @@
+package article
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"log"
+
+	"github.com/go-sql-driver/mysql"
+	domainarticle "github.com/rulzi/hexa-go/internal/domain/article"
+)
+
+// MySQLCategoryRepository is the MySQL implementation of article.CategoryRepository (driven adapter)
+type MySQLCategoryRepository struct {
+	db *sql.DB
+}
+
+// NewMySQLCategoryRepository creates a new MySQLCategoryRepository
+func NewMySQLCategoryRepository(db *sql.DB) *MySQLCategoryRepository {
+	return &MySQLCategoryRepository{db: db}
+}
+
+// Create creates a new category
+func (r *MySQLCategoryRepository) Create(ctx context.Context, c *domainarticle.Category) (*domainarticle.Category, error) {
+	query := `INSERT INTO categories (org_id, parent_id, name, slug, created_at, updated_at, created_by, updated_by) VALUES (?, ?, ?, ?, ?, ?, ?, ?)`
+
+	result, err := r.db.ExecContext(ctx, query, c.OrgID, c.ParentID, c.Name, c.Slug, c.CreatedAt, c.UpdatedAt, c.CreatedBy, c.UpdatedBy)
+	if err != nil {
+		return nil, mapDuplicate(err, domainarticle.ErrCategoryExists)
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return nil, err
+	}
+
+	c.ID = id
+	return c, nil
+}
+
+// GetByID retrieves a category of an organization by ID
+func (r *MySQLCategoryRepository) GetByID(ctx context.Context, orgID, id int64) (*domainarticle.Category, error) {
+	query := `SELECT id, org_id, parent_id, name, slug, created_at, updated_at FROM categories WHERE id = ? AND org_id = ?`
+
+	c := &domainarticle.Category{}
+	var parentID sql.NullInt64
+	err := r.db.QueryRowContext(ctx, query, id, orgID).Scan(&c.ID, &c.OrgID, &parentID, &c.Name, &c.Slug, &c.CreatedAt, &c.UpdatedAt)
+	if err == sql.ErrNoRows {
+		return nil, domainarticle.ErrCategoryNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	if parentID.Valid {
+		c.ParentID = &parentID.Int64
+	}
+
+	return c, nil
+}
+
+// Update renames or moves a category within its organization
+func (r *MySQLCategoryRepository) Update(ctx context.Context, c *domainarticle.Category) (*domainarticle.Category, error) {
+	query := `UPDATE categories SET parent_id = ?, name = ?, slug = ?, updated_at = ?, updated_by = ? WHERE id = ? AND org_id = ?`
+
+	_, err := r.db.ExecContext(ctx, query, c.ParentID, c.Name, c.Slug, c.UpdatedAt, c.UpdatedBy, c.ID, c.OrgID)
+	if err != nil {
+		return nil, mapDuplicate(err, domainarticle.ErrCategoryExists)
+	}
+
+	return c, nil
+}
+
+// Delete deletes a category of an organization. The foreign key on parent_id refuses
+// to delete a category with subcategories, the one on articles clears their category.
+func (r *MySQLCategoryRepository) Delete(ctx context.Context, orgID, id int64) error {
+	query := `DELETE FROM categories WHERE id = ? AND org_id = ?`
+
+	result, err := r.db.ExecContext(ctx, query, id, orgID)
+	var mysqlErr *mysql.MySQLError
+	if errors.As(err, &mysqlErr) && mysqlErr.Number == 1451 {
+		return domainarticle.ErrCategoryHasChildren
+	}
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return domainarticle.ErrCategoryNotFound
+	}
+
+	return nil
+}
+
+// List retrieves every category of an organization
+func (r *MySQLCategoryRepository) List(ctx context.Context, orgID int64) ([]*domainarticle.Category, error) {
+	query := `SELECT id, org_id, parent_id, name, slug, created_at, updated_at FROM categories WHERE org_id = ? ORDER BY name ASC`
+
+	rows, err := r.db.QueryContext(ctx, query, orgID)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := rows.Close(); err != nil {
+			log.Printf("Failed to close rows: %v", err)
+		}
+	}()
+
+	var categories []*domainarticle.Category
+	for rows.Next() {
+		c := &domainarticle.Category{}
+		var parentID sql.NullInt64
+		if err := rows.Scan(&c.ID, &c.OrgID, &parentID, &c.Name, &c.Slug, &c.CreatedAt, &c.UpdatedAt); err != nil {
+			return nil, err
+		}
+		if parentID.Valid {
+			c.ParentID = &parentID.Int64
+		}
+		categories = append(categories, c)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return categories, nil
+}
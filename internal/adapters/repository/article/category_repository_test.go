@@ -0,0 +1,161 @@
+package article
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-sql-driver/mysql"
+	domainarticle "github.com/rulzi/hexa-go/internal/domain/article"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMySQLCategoryRepository_Create(t *testing.T) {
+	parentID := int64(2)
+
+	tests := []struct {
+		name    string
+		setup   func(mock sqlmock.Sqlmock)
+		wantErr error
+	}{
+		{
+			name: "success create category",
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec("INSERT INTO categories").
+					WithArgs(int64(1), &parentID, "Football", "football", sqlmock.AnyArg(), sqlmock.AnyArg(), int64(3), int64(3)).
+					WillReturnResult(sqlmock.NewResult(5, 1))
+			},
+		},
+		{
+			name: "duplicate slug",
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec("INSERT INTO categories").
+					WillReturnError(&mysql.MySQLError{Number: 1062, Message: "Duplicate entry"})
+			},
+			wantErr: domainarticle.ErrCategoryExists,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+			}
+			defer func() {
+				mock.ExpectClose()
+				if err := db.Close(); err != nil {
+					t.Fatalf("Failed to close database connection: %v", err)
+				}
+			}()
+
+			repo := NewMySQLCategoryRepository(db)
+			tt.setup(mock)
+
+			category, err := repo.Create(context.Background(), &domainarticle.Category{OrgID: 1, ParentID: &parentID, Name: "Football", Slug: "football", CreatedAt: time.Now(), UpdatedAt: time.Now(), CreatedBy: 3, UpdatedBy: 3})
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.Nil(t, category)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, int64(5), category.ID)
+			}
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestMySQLCategoryRepository_Delete(t *testing.T) {
+	tests := []struct {
+		name    string
+		setup   func(mock sqlmock.Sqlmock)
+		wantErr error
+	}{
+		{
+			name: "success delete category",
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec("DELETE FROM categories WHERE id = \\? AND org_id = \\?").
+					WithArgs(int64(5), int64(1)).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+		},
+		{
+			name: "category not found",
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec("DELETE FROM categories WHERE id = \\? AND org_id = \\?").
+					WithArgs(int64(5), int64(1)).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+			},
+			wantErr: domainarticle.ErrCategoryNotFound,
+		},
+		{
+			name: "category has subcategories",
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec("DELETE FROM categories WHERE id = \\? AND org_id = \\?").
+					WithArgs(int64(5), int64(1)).
+					WillReturnError(&mysql.MySQLError{Number: 1451, Message: "Cannot delete or update a parent row"})
+			},
+			wantErr: domainarticle.ErrCategoryHasChildren,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+			}
+			defer func() {
+				mock.ExpectClose()
+				if err := db.Close(); err != nil {
+					t.Fatalf("Failed to close database connection: %v", err)
+				}
+			}()
+
+			repo := NewMySQLCategoryRepository(db)
+			tt.setup(mock)
+
+			err = repo.Delete(context.Background(), 1, 5)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestMySQLCategoryRepository_List(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer func() {
+		mock.ExpectClose()
+		if err := db.Close(); err != nil {
+			t.Fatalf("Failed to close database connection: %v", err)
+		}
+	}()
+
+	repo := NewMySQLCategoryRepository(db)
+	rows := sqlmock.NewRows([]string{"id", "org_id", "parent_id", "name", "slug", "created_at", "updated_at"}).
+		AddRow(5, 1, 2, "Football", "football", time.Now(), time.Now()).
+		AddRow(2, 1, nil, "Sport", "sport", time.Now(), time.Now())
+	mock.ExpectQuery("SELECT id, org_id, parent_id, name, slug, created_at, updated_at FROM categories WHERE org_id = \\?").
+		WithArgs(int64(1)).
+		WillReturnRows(rows)
+
+	categories, err := repo.List(context.Background(), 1)
+
+	assert.NoError(t, err)
+	assert.Len(t, categories, 2)
+	assert.Equal(t, int64(2), *categories[0].ParentID)
+	assert.Nil(t, categories[1].ParentID)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,132 @@
+package article
+
+import (
+	"context"
+	"math"
+	"sort"
+	"sync"
+
+	domainarticle "github.com/rulzi/hexa-go/internal/domain/article"
+)
+
+// bm25K1 is the term frequency saturation of the ranking, see MemorySearchIndex.Search
+const bm25K1 = 1.2
+
+// MemorySearchIndex implements article.SearchIndex in process memory.
+// It is used in tests and when MySQL FULLTEXT search is not available, it is not shared between
+// replicas and must be filled from the repository at startup. It only sees the changes made by its
+// own process, so it must not be used with more than one replica.
+type MemorySearchIndex struct {
+	mu   sync.RWMutex
+	docs map[int64]*searchDocument
+}
+
+// searchDocument is an indexed article with the frequencies of the words of its title and content
+type searchDocument struct {
+	article *domainarticle.Article
+	title   map[string]int
+	content map[string]int
+}
+
+// NewMemorySearchIndex creates a new empty MemorySearchIndex
+func NewMemorySearchIndex() *MemorySearchIndex {
+	return &MemorySearchIndex{docs: make(map[int64]*searchDocument)}
+}
+
+// Index implements SearchIndex interface, it keeps a copy of the article
+func (s *MemorySearchIndex) Index(ctx context.Context, a *domainarticle.Article) error {
+	copied := *a
+	doc := &searchDocument{
+		article: &copied,
+		title:   termFrequencies(a.Title),
+		content: termFrequencies(a.Content),
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.docs[a.ID] = doc
+	return nil
+}
+
+// Remove implements SearchIndex interface
+func (s *MemorySearchIndex) Remove(ctx context.Context, id int64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.docs, id)
+	return nil
+}
+
+// Search implements SearchIndex interface. Articles are ranked with BM25 over the articles the actor may view,
+// an occurrence in the title counting twice as much as one in the content.
+func (s *MemorySearchIndex) Search(ctx context.Context, q domainarticle.SearchQuery) (*domainarticle.SearchResult, error) {
+	terms := domainarticle.SearchTerms(q.Text)
+	if len(terms) == 0 {
+		return nil, domainarticle.ErrSearchQueryRequired
+	}
+
+	s.mu.RLock()
+	var visible []*searchDocument
+	for _, doc := range s.docs {
+		if q.Actor.CanView(doc.article) {
+			visible = append(visible, doc)
+		}
+	}
+	s.mu.RUnlock()
+
+	// Rare words weigh more than common ones
+	idf := make(map[string]float64, len(terms))
+	for _, term := range terms {
+		var n int
+		for _, doc := range visible {
+			if doc.title[term] > 0 || doc.content[term] > 0 {
+				n++
+			}
+		}
+		idf[term] = math.Log(1 + (float64(len(visible))-float64(n)+0.5)/(float64(n)+0.5))
+	}
+
+	var hits []*domainarticle.SearchHit
+	for _, doc := range visible {
+		var score float64
+		for _, term := range terms {
+			tf := float64(2*doc.title[term] + doc.content[term])
+			if tf > 0 {
+				score += idf[term] * tf * (bm25K1 + 1) / (tf + bm25K1)
+			}
+		}
+		if score > 0 {
+			copied := *doc.article
+			hits = append(hits, &domainarticle.SearchHit{Article: &copied, Score: score})
+		}
+	}
+
+	sort.Slice(hits, func(i, j int) bool {
+		if hits[i].Score != hits[j].Score {
+			return hits[i].Score > hits[j].Score
+		}
+		return hits[i].Article.ID > hits[j].Article.ID
+	})
+
+	result := &domainarticle.SearchResult{Total: int64(len(hits))}
+	if q.Offset < len(hits) {
+		hits = hits[q.Offset:]
+		if q.Limit < len(hits) {
+			hits = hits[:q.Limit]
+		}
+		for _, hit := range hits {
+			hit.Snippet = domainarticle.Snippet(hit.Article.Content, terms)
+		}
+		result.Hits = hits
+	}
+
+	return result, nil
+}
+
+// termFrequencies counts the occurrences of every word of a text
+func termFrequencies(text string) map[string]int {
+	freq := make(map[string]int)
+	for _, word := range domainarticle.SearchWords(text) {
+		freq[word]++
+	}
+	return freq
+}
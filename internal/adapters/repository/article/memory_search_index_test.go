@@ -0,0 +1,90 @@
+package article
+
+import (
+	"context"
+	"testing"
+
+	domainarticle "github.com/rulzi/hexa-go/internal/domain/article"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestMemorySearchIndex(t *testing.T) *MemorySearchIndex {
+	index := NewMemorySearchIndex()
+	articles := []*domainarticle.Article{
+		{ID: 1, OrgID: 1, AuthorID: 2, Status: domainarticle.StatusPublished, Title: "Cooking pasta", Content: "Boil water, add salt and cook the pasta for ten minutes."},
+		{ID: 2, OrgID: 1, AuthorID: 3, Status: domainarticle.StatusPublished, Title: "Go generics", Content: "Type parameters make Go code reusable."},
+		{ID: 3, OrgID: 1, AuthorID: 3, Status: domainarticle.StatusPublished, Title: "Concurrency", Content: "Goroutines and channels are the heart of Go."},
+		{ID: 4, OrgID: 1, AuthorID: 3, Status: domainarticle.StatusDraft, Title: "Go draft", Content: "Unfinished notes about Go."},
+		{ID: 5, OrgID: 2, AuthorID: 4, Status: domainarticle.StatusPublished, Title: "Go elsewhere", Content: "Another organization writes about Go."},
+	}
+	for _, a := range articles {
+		require.NoError(t, index.Index(context.Background(), a))
+	}
+	return index
+}
+
+func TestMemorySearchIndex_Search_Ranking(t *testing.T) {
+	index := newTestMemorySearchIndex(t)
+
+	result, err := index.Search(context.Background(), domainarticle.SearchQuery{Text: "go", Actor: domainarticle.Actor{UserID: 2, OrgID: 1}, Limit: 10})
+
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), result.Total)
+	require.Len(t, result.Hits, 2)
+	// The title match ranks first, the draft and the other organization are hidden
+	assert.Equal(t, int64(2), result.Hits[0].Article.ID)
+	assert.Equal(t, int64(3), result.Hits[1].Article.ID)
+	assert.Greater(t, result.Hits[0].Score, result.Hits[1].Score)
+	assert.Equal(t, "Goroutines and channels are the heart of <mark>Go</mark>.", result.Hits[1].Snippet)
+}
+
+func TestMemorySearchIndex_Search_Visibility(t *testing.T) {
+	index := newTestMemorySearchIndex(t)
+
+	result, err := index.Search(context.Background(), domainarticle.SearchQuery{Text: "go", Actor: domainarticle.Actor{UserID: 3, OrgID: 1}, Limit: 10})
+
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), result.Total)
+}
+
+func TestMemorySearchIndex_Search_Pagination(t *testing.T) {
+	index := newTestMemorySearchIndex(t)
+	actor := domainarticle.Actor{UserID: 3, OrgID: 1}
+
+	page, err := index.Search(context.Background(), domainarticle.SearchQuery{Text: "go pasta", Actor: actor, Limit: 2, Offset: 2})
+	require.NoError(t, err)
+	assert.Equal(t, int64(4), page.Total)
+	assert.Len(t, page.Hits, 2)
+
+	past, err := index.Search(context.Background(), domainarticle.SearchQuery{Text: "go pasta", Actor: actor, Limit: 2, Offset: 10})
+	require.NoError(t, err)
+	assert.Equal(t, int64(4), past.Total)
+	assert.Empty(t, past.Hits)
+}
+
+func TestMemorySearchIndex_IndexAndRemove(t *testing.T) {
+	ctx := context.Background()
+	index := newTestMemorySearchIndex(t)
+	reader := domainarticle.Actor{UserID: 2, OrgID: 1}
+
+	require.NoError(t, index.Index(ctx, &domainarticle.Article{ID: 1, OrgID: 1, AuthorID: 2, Status: domainarticle.StatusPublished, Title: "Baking bread", Content: "Flour and water."}))
+	result, err := index.Search(ctx, domainarticle.SearchQuery{Text: "pasta", Actor: reader, Limit: 10})
+	require.NoError(t, err)
+	assert.Zero(t, result.Total)
+
+	require.NoError(t, index.Remove(ctx, 2))
+	require.NoError(t, index.Remove(ctx, 42))
+	result, err = index.Search(ctx, domainarticle.SearchQuery{Text: "generics", Actor: reader, Limit: 10})
+	require.NoError(t, err)
+	assert.Zero(t, result.Total)
+}
+
+func TestMemorySearchIndex_Search_EmptyQuery(t *testing.T) {
+	index := NewMemorySearchIndex()
+
+	result, err := index.Search(context.Background(), domainarticle.SearchQuery{Text: " - ", Limit: 10})
+
+	assert.ErrorIs(t, err, domainarticle.ErrSearchQueryRequired)
+	assert.Nil(t, result)
+}
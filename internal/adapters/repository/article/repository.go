@@ -4,6 +4,8 @@ import (
 	"context"
 	"database/sql"
 	"log"
+	"strings"
+	"time"
 
 	domainarticle "github.com/rulzi/hexa-go/internal/domain/article"
 )
@@ -18,16 +20,17 @@ func NewMySQLRepository(db *sql.DB) *MySQLRepository {
 	return &MySQLRepository{db: db}
 }
 
-// Create creates a new article
+// Create creates a new article, returns ErrSlugTaken if another article of the organization has its slug
 func (r *MySQLRepository) Create(ctx context.Context, a *domainarticle.Article) (*domainarticle.Article, error) {
 	query := `
-		INSERT INTO articles (title, content, author_id, created_at, updated_at)
-		VALUES (?, ?, ?, ?, ?)
+		INSERT INTO articles (title, slug, content, author_id, org_id, category_id, status, published_at, publish_at, created_at, updated_at, created_by, updated_by)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
 	`
 
-	result, err := r.db.ExecContext(ctx, query, a.Title, a.Content, a.AuthorID, a.CreatedAt, a.UpdatedAt)
+	result, err := r.db.ExecContext(ctx, query, a.Title, a.Slug, a.Content, a.AuthorID, a.OrgID, a.CategoryID, a.Status, a.PublishedAt, a.PublishAt, a.CreatedAt, a.UpdatedAt, a.CreatedBy, a.UpdatedBy)
 	if err != nil {
-		return nil, err
+		// The slug is the only unique key written
+		return nil, mapDuplicate(err, domainarticle.ErrSlugTaken)
 	}
 
 	id, err := result.LastInsertId()
@@ -39,55 +42,181 @@ func (r *MySQLRepository) Create(ctx context.Context, a *domainarticle.Article)
 	return a, nil
 }
 
-// GetByID retrieves an article by ID
-func (r *MySQLRepository) GetByID(ctx context.Context, id int64) (*domainarticle.Article, error) {
+// GetByID retrieves an article of an organization by ID
+func (r *MySQLRepository) GetByID(ctx context.Context, orgID, id int64) (*domainarticle.Article, error) {
+	query := `
+		SELECT id, title, slug, content, author_id, org_id, category_id, status, published_at, publish_at, created_at, updated_at, deleted_at
+		FROM articles
+		WHERE id = ? AND org_id = ? AND deleted_at IS NULL
+	`
+
+	return r.getOne(ctx, query, id, orgID)
+}
+
+// GetBySlug retrieves an article of an organization by its current slug
+func (r *MySQLRepository) GetBySlug(ctx context.Context, orgID int64, slug string) (*domainarticle.Article, error) {
 	query := `
-		SELECT id, title, content, author_id, created_at, updated_at
+		SELECT id, title, slug, content, author_id, org_id, category_id, status, published_at, publish_at, created_at, updated_at, deleted_at
 		FROM articles
-		WHERE id = ?
+		WHERE org_id = ? AND slug = ? AND deleted_at IS NULL
+	`
+
+	return r.getOne(ctx, query, orgID, slug)
+}
+
+// GetByPreviousSlug retrieves an article of an organization by a slug it had before its title changed
+func (r *MySQLRepository) GetByPreviousSlug(ctx context.Context, orgID int64, slug string) (*domainarticle.Article, error) {
+	query := `
+		SELECT a.id, a.title, a.slug, a.content, a.author_id, a.org_id, a.category_id, a.status, a.published_at, a.publish_at, a.created_at, a.updated_at, a.deleted_at
+		FROM article_slugs s
+		INNER JOIN articles a ON a.id = s.article_id
+		WHERE s.org_id = ? AND s.slug = ? AND a.deleted_at IS NULL
+	`
+
+	return r.getOne(ctx, query, orgID, slug)
+}
+
+// SlugTaken reports whether a slug is used by another article of an organization, as its current
+// slug or as a previous one. Articles in the trash keep their slugs so that they can be restored.
+func (r *MySQLRepository) SlugTaken(ctx context.Context, orgID int64, slug string, excludeID int64) (bool, error) {
+	query := `
+		SELECT EXISTS (
+			SELECT 1 FROM articles WHERE org_id = ? AND slug = ? AND id <> ?
+			UNION ALL
+			SELECT 1 FROM article_slugs WHERE org_id = ? AND slug = ? AND article_id <> ?
+		)
+	`
+
+	var taken bool
+	err := r.db.QueryRowContext(ctx, query, orgID, slug, excludeID, orgID, slug, excludeID).Scan(&taken)
+	if err != nil {
+		return false, err
+	}
+
+	return taken, nil
+}
+
+// AddPreviousSlug records a slug an article no longer uses
+func (r *MySQLRepository) AddPreviousSlug(ctx context.Context, orgID, articleID int64, slug string) error {
+	query := `
+		INSERT INTO article_slugs (org_id, article_id, slug, created_at)
+		VALUES (?, ?, ?, ?)
+		ON DUPLICATE KEY UPDATE article_id = VALUES(article_id)
 	`
 
+	_, err := r.db.ExecContext(ctx, query, orgID, articleID, slug, time.Now())
+	return err
+}
+
+// getOne runs a query selecting a single article, returns ErrArticleNotFound if there is none
+func (r *MySQLRepository) getOne(ctx context.Context, query string, args ...interface{}) (*domainarticle.Article, error) {
+	a, err := scanArticle(r.db.QueryRowContext(ctx, query, args...))
+	if err == sql.ErrNoRows {
+		return nil, domainarticle.ErrArticleNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return a, nil
+}
+
+// queryArticles runs a query selecting articles
+func (r *MySQLRepository) queryArticles(ctx context.Context, query string, args ...interface{}) ([]*domainarticle.Article, error) {
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := rows.Close(); err != nil {
+			log.Printf("Failed to close rows: %v", err)
+		}
+	}()
+
+	var articles []*domainarticle.Article
+	for rows.Next() {
+		a, err := scanArticle(rows)
+		if err != nil {
+			return nil, err
+		}
+		articles = append(articles, a)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return articles, nil
+}
+
+// rowScanner is implemented by *sql.Row and *sql.Rows
+type rowScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+// scanArticle scans an article row selecting the columns id through deleted_at.
+// Columns selected after them are scanned into extra.
+func scanArticle(row rowScanner, extra ...interface{}) (*domainarticle.Article, error) {
 	a := &domainarticle.Article{}
-	err := r.db.QueryRowContext(ctx, query, id).Scan(
+	var publishedAt, publishAt, deletedAt sql.NullTime
+	var categoryID sql.NullInt64
+	dest := []interface{}{
 		&a.ID,
 		&a.Title,
+		&a.Slug,
 		&a.Content,
 		&a.AuthorID,
+		&a.OrgID,
+		&categoryID,
+		&a.Status,
+		&publishedAt,
+		&publishAt,
 		&a.CreatedAt,
 		&a.UpdatedAt,
-	)
-
-	if err == sql.ErrNoRows {
-		return nil, domainarticle.ErrArticleNotFound
+		&deletedAt,
 	}
-	if err != nil {
+	if err := row.Scan(append(dest, extra...)...); err != nil {
 		return nil, err
 	}
 
+	if categoryID.Valid {
+		a.CategoryID = &categoryID.Int64
+	}
+	if publishedAt.Valid {
+		a.PublishedAt = &publishedAt.Time
+	}
+	if publishAt.Valid {
+		a.PublishAt = &publishAt.Time
+	}
+	if deletedAt.Valid {
+		a.DeletedAt = &deletedAt.Time
+	}
+
 	return a, nil
 }
 
-// Update updates an existing article
+// Update updates an existing article within its organization,
+// returns ErrSlugTaken if another article of the organization has its slug
 func (r *MySQLRepository) Update(ctx context.Context, a *domainarticle.Article) (*domainarticle.Article, error) {
 	query := `
 		UPDATE articles
-		SET title = ?, content = ?, updated_at = ?
-		WHERE id = ?
+		SET title = ?, slug = ?, content = ?, category_id = ?, status = ?, published_at = ?, publish_at = ?, updated_at = ?, updated_by = ?
+		WHERE id = ? AND org_id = ? AND deleted_at IS NULL
 	`
 
-	_, err := r.db.ExecContext(ctx, query, a.Title, a.Content, a.UpdatedAt, a.ID)
+	_, err := r.db.ExecContext(ctx, query, a.Title, a.Slug, a.Content, a.CategoryID, a.Status, a.PublishedAt, a.PublishAt, a.UpdatedAt, a.UpdatedBy, a.ID, a.OrgID)
 	if err != nil {
-		return nil, err
+		return nil, mapDuplicate(err, domainarticle.ErrSlugTaken)
 	}
 
 	return a, nil
 }
 
-// Delete deletes an article by ID
-func (r *MySQLRepository) Delete(ctx context.Context, id int64) error {
-	query := `DELETE FROM articles WHERE id = ?`
+// Delete moves an article of an organization to the trash by setting deleted_at
+func (r *MySQLRepository) Delete(ctx context.Context, orgID, id int64) error {
+	query := `UPDATE articles SET deleted_at = ? WHERE id = ? AND org_id = ? AND deleted_at IS NULL`
 
-	result, err := r.db.ExecContext(ctx, query, id)
+	result, err := r.db.ExecContext(ctx, query, time.Now(), id, orgID)
 	if err != nil {
 		return err
 	}
@@ -104,60 +233,113 @@ func (r *MySQLRepository) Delete(ctx context.Context, id int64) error {
 	return nil
 }
 
-// List retrieves all articles with pagination
-func (r *MySQLRepository) List(ctx context.Context, limit, offset int) ([]*domainarticle.Article, error) {
+// List retrieves the articles of an organization selected by the filter with pagination
+func (r *MySQLRepository) List(ctx context.Context, filter domainarticle.ListFilter, limit, offset int) ([]*domainarticle.Article, error) {
+	where, args := filterClause(filter)
 	query := `
-		SELECT id, title, content, author_id, created_at, updated_at
+		SELECT id, title, slug, content, author_id, org_id, category_id, status, published_at, publish_at, created_at, updated_at, deleted_at
 		FROM articles
+		WHERE ` + where + `
 		ORDER BY created_at DESC
 		LIMIT ? OFFSET ?
 	`
 
-	rows, err := r.db.QueryContext(ctx, query, limit, offset)
+	return r.queryArticles(ctx, query, append(args, limit, offset)...)
+}
+
+// ListByAuthor retrieves the articles of an author in an organization with pagination
+func (r *MySQLRepository) ListByAuthor(ctx context.Context, orgID, authorID int64, limit, offset int) ([]*domainarticle.Article, error) {
+	query := `
+		SELECT id, title, slug, content, author_id, org_id, category_id, status, published_at, publish_at, created_at, updated_at, deleted_at
+		FROM articles
+		WHERE org_id = ? AND author_id = ? AND deleted_at IS NULL
+		ORDER BY created_at DESC
+		LIMIT ? OFFSET ?
+	`
+
+	return r.queryArticles(ctx, query, orgID, authorID, limit, offset)
+}
+
+// Count returns the total number of articles of an organization selected by the filter
+func (r *MySQLRepository) Count(ctx context.Context, filter domainarticle.ListFilter) (int64, error) {
+	where, args := filterClause(filter)
+	query := `SELECT COUNT(*) FROM articles WHERE ` + where
+
+	var count int64
+	err := r.db.QueryRowContext(ctx, query, args...).Scan(&count)
 	if err != nil {
-		return nil, err
+		return 0, err
 	}
-	defer func() {
-		if err := rows.Close(); err != nil {
-			log.Printf("Failed to close rows: %v", err)
-		}
-	}()
 
-	var articles []*domainarticle.Article
-	for rows.Next() {
-		a := &domainarticle.Article{}
-		err := rows.Scan(
-			&a.ID,
-			&a.Title,
-			&a.Content,
-			&a.AuthorID,
-			&a.CreatedAt,
-			&a.UpdatedAt,
-		)
-		if err != nil {
-			return nil, err
-		}
-		articles = append(articles, a)
+	return count, nil
+}
+
+// CountByAuthor returns the total number of articles of an author in an organization
+func (r *MySQLRepository) CountByAuthor(ctx context.Context, orgID, authorID int64) (int64, error) {
+	query := `SELECT COUNT(*) FROM articles WHERE org_id = ? AND author_id = ? AND deleted_at IS NULL`
+
+	var count int64
+	err := r.db.QueryRowContext(ctx, query, orgID, authorID).Scan(&count)
+	if err != nil {
+		return 0, err
 	}
 
-	if err = rows.Err(); err != nil {
-		return nil, err
+	return count, nil
+}
+
+// Restore takes an article of an organization out of the trash
+func (r *MySQLRepository) Restore(ctx context.Context, orgID, id int64) error {
+	query := `UPDATE articles SET deleted_at = NULL WHERE id = ? AND org_id = ? AND deleted_at IS NOT NULL`
+
+	result, err := r.db.ExecContext(ctx, query, id, orgID)
+	if err != nil {
+		return err
 	}
 
-	return articles, nil
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return domainarticle.ErrArticleNotFound
+	}
+
+	return nil
 }
 
-// ListByAuthor retrieves articles by author ID with pagination
-func (r *MySQLRepository) ListByAuthor(ctx context.Context, authorID int64, limit, offset int) ([]*domainarticle.Article, error) {
+// ListDeleted retrieves the articles in the trash of an organization with pagination, most recently deleted first
+func (r *MySQLRepository) ListDeleted(ctx context.Context, orgID int64, limit, offset int) ([]*domainarticle.Article, error) {
 	query := `
-		SELECT id, title, content, author_id, created_at, updated_at
+		SELECT id, title, slug, content, author_id, org_id, category_id, status, published_at, publish_at, created_at, updated_at, deleted_at
 		FROM articles
-		WHERE author_id = ?
-		ORDER BY created_at DESC
+		WHERE org_id = ? AND deleted_at IS NOT NULL
+		ORDER BY deleted_at DESC
 		LIMIT ? OFFSET ?
 	`
 
-	rows, err := r.db.QueryContext(ctx, query, authorID, limit, offset)
+	return r.queryArticles(ctx, query, orgID, limit, offset)
+}
+
+// CountDeleted returns the number of articles in the trash of an organization
+func (r *MySQLRepository) CountDeleted(ctx context.Context, orgID int64) (int64, error) {
+	query := `SELECT COUNT(*) FROM articles WHERE org_id = ? AND deleted_at IS NOT NULL`
+
+	var count int64
+	err := r.db.QueryRowContext(ctx, query, orgID).Scan(&count)
+	if err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+// ListIDsByCategory returns the IDs of the articles of an organization in a category,
+// including the articles in the trash
+func (r *MySQLRepository) ListIDsByCategory(ctx context.Context, orgID, categoryID int64) ([]int64, error) {
+	query := `SELECT id FROM articles WHERE org_id = ? AND category_id = ?`
+
+	rows, err := r.db.QueryContext(ctx, query, orgID, categoryID)
 	if err != nil {
 		return nil, err
 	}
@@ -167,52 +349,165 @@ func (r *MySQLRepository) ListByAuthor(ctx context.Context, authorID int64, limi
 		}
 	}()
 
-	var articles []*domainarticle.Article
+	var ids []int64
 	for rows.Next() {
-		a := &domainarticle.Article{}
-		err := rows.Scan(
-			&a.ID,
-			&a.Title,
-			&a.Content,
-			&a.AuthorID,
-			&a.CreatedAt,
-			&a.UpdatedAt,
-		)
-		if err != nil {
+		var id int64
+		if err := rows.Scan(&id); err != nil {
 			return nil, err
 		}
-		articles = append(articles, a)
+		ids = append(ids, id)
 	}
 
 	if err = rows.Err(); err != nil {
 		return nil, err
 	}
 
-	return articles, nil
+	return ids, nil
 }
 
-// Count returns the total number of articles
-func (r *MySQLRepository) Count(ctx context.Context) (int64, error) {
-	query := `SELECT COUNT(*) FROM articles`
+// PurgeDeletedBefore permanently deletes the articles moved to the trash before the given time
+func (r *MySQLRepository) PurgeDeletedBefore(ctx context.Context, before time.Time) (int64, error) {
+	query := `DELETE FROM articles WHERE deleted_at IS NOT NULL AND deleted_at < ?`
 
-	var count int64
-	err := r.db.QueryRowContext(ctx, query).Scan(&count)
+	result, err := r.db.ExecContext(ctx, query, before)
 	if err != nil {
 		return 0, err
 	}
 
-	return count, nil
+	return result.RowsAffected()
 }
 
-// CountByAuthor returns the total number of articles by author
-func (r *MySQLRepository) CountByAuthor(ctx context.Context, authorID int64) (int64, error) {
-	query := `SELECT COUNT(*) FROM articles WHERE author_id = ?`
+// ListAllByAuthor retrieves the articles of an author with pagination, including the articles in the trash
+func (r *MySQLRepository) ListAllByAuthor(ctx context.Context, authorID int64, limit, offset int) ([]*domainarticle.Article, error) {
+	query := `
+		SELECT id, title, slug, content, author_id, org_id, category_id, status, published_at, publish_at, created_at, updated_at, deleted_at
+		FROM articles
+		WHERE author_id = ?
+		ORDER BY id ASC
+		LIMIT ? OFFSET ?
+	`
 
-	var count int64
-	err := r.db.QueryRowContext(ctx, query, authorID).Scan(&count)
+	return r.queryArticles(ctx, query, authorID, limit, offset)
+}
+
+// DeleteByAuthor permanently deletes every article of an author in an organization, including the
+// articles in the trash. orgID zero covers every organization.
+func (r *MySQLRepository) DeleteByAuthor(ctx context.Context, orgID, authorID int64) (int64, error) {
+	query := `DELETE FROM articles WHERE author_id = ?`
+	args := []interface{}{authorID}
+	if orgID != 0 {
+		query += ` AND org_id = ?`
+		args = append(args, orgID)
+	}
+
+	result, err := r.db.ExecContext(ctx, query, args...)
 	if err != nil {
 		return 0, err
 	}
 
-	return count, nil
+	return result.RowsAffected()
+}
+
+// ReassignAuthor moves every article of an author in an organization, including the articles in the
+// trash, to another author. orgID zero covers every organization.
+func (r *MySQLRepository) ReassignAuthor(ctx context.Context, orgID, fromID, toID int64) (int64, error) {
+	query := `UPDATE articles SET author_id = ?, updated_at = ? WHERE author_id = ?`
+	args := []interface{}{toID, time.Now(), fromID}
+	if orgID != 0 {
+		query += ` AND org_id = ?`
+		args = append(args, orgID)
+	}
+
+	result, err := r.db.ExecContext(ctx, query, args...)
+	if err != nil {
+		return 0, err
+	}
+
+	return result.RowsAffected()
+}
+
+// ListDueForPublishing retrieves the articles in review scheduled to go live at or before the given time, across every organization
+func (r *MySQLRepository) ListDueForPublishing(ctx context.Context, now time.Time, limit int) ([]*domainarticle.Article, error) {
+	query := `
+		SELECT id, title, slug, content, author_id, org_id, category_id, status, published_at, publish_at, created_at, updated_at, deleted_at
+		FROM articles
+		WHERE status = ? AND publish_at IS NOT NULL AND publish_at <= ? AND deleted_at IS NULL
+		ORDER BY publish_at ASC, id ASC
+		LIMIT ?
+	`
+
+	return r.queryArticles(ctx, query, domainarticle.StatusInReview, now, limit)
+}
+
+// ListAfter retrieves the articles not in the trash with an ID above afterID by ID, across every organization
+func (r *MySQLRepository) ListAfter(ctx context.Context, afterID int64, limit int) ([]*domainarticle.Article, error) {
+	query := `
+		SELECT id, title, slug, content, author_id, org_id, category_id, status, published_at, publish_at, created_at, updated_at, deleted_at
+		FROM articles
+		WHERE id > ? AND deleted_at IS NULL
+		ORDER BY id ASC
+		LIMIT ?
+	`
+
+	return r.queryArticles(ctx, query, afterID, limit)
+}
+
+// PublishIfDue stores a published scheduled article only if it is still due.
+// The conditional update makes sure only one scheduler publishes an article, and that an
+// article sent back to draft or rescheduled in the meantime is left alone.
+func (r *MySQLRepository) PublishIfDue(ctx context.Context, a *domainarticle.Article, now time.Time) (bool, error) {
+	query := `
+		UPDATE articles
+		SET status = ?, published_at = ?, publish_at = NULL, updated_at = ?, updated_by = ?
+		WHERE id = ? AND status = ? AND publish_at IS NOT NULL AND publish_at <= ? AND deleted_at IS NULL
+	`
+
+	result, err := r.db.ExecContext(ctx, query, a.Status, a.PublishedAt, a.UpdatedAt, a.UpdatedBy, a.ID, domainarticle.StatusInReview, now)
+	if err != nil {
+		return false, err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return rowsAffected > 0, nil
+}
+
+// filterClause builds the WHERE clause selecting the articles of the filter.
+// Only the placeholders are built dynamically, every value is passed as an argument.
+func filterClause(filter domainarticle.ListFilter) (string, []interface{}) {
+	visible := []string{"status = ?"}
+	args := []interface{}{filter.OrgID, domainarticle.StatusPublished}
+
+	if filter.AuthorID != 0 {
+		visible = append(visible, "author_id = ?")
+		args = append(args, filter.AuthorID)
+	}
+	if len(filter.Statuses) > 0 {
+		visible = append(visible, "status IN ("+placeholders(len(filter.Statuses))+")")
+		for _, status := range filter.Statuses {
+			args = append(args, status)
+		}
+	}
+
+	where := "org_id = ? AND deleted_at IS NULL AND (" + strings.Join(visible, " OR ") + ")"
+	if len(filter.CategoryIDs) > 0 {
+		where += " AND category_id IN (" + placeholders(len(filter.CategoryIDs)) + ")"
+		for _, id := range filter.CategoryIDs {
+			args = append(args, id)
+		}
+	}
+	if filter.TagID != 0 {
+		where += " AND id IN (SELECT article_id FROM article_tags WHERE tag_id = ?)"
+		args = append(args, filter.TagID)
+	}
+
+	return where, args
+}
+
+// placeholders returns n comma separated placeholders
+func placeholders(n int) string {
+	return strings.TrimSuffix(strings.Repeat("?, ", n), ", ")
 }
@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-sql-driver/mysql"
 	domainarticle "github.com/rulzi/hexa-go/internal/domain/article"
 	"github.com/stretchr/testify/assert"
 )
@@ -41,14 +42,19 @@ func TestMySQLRepository_Create(t *testing.T) {
 			name: "success create article",
 			article: &domainarticle.Article{
 				Title:     "Test Article",
+				Slug:      "test-article",
 				Content:   "This is a test article content",
 				AuthorID:  1,
+				OrgID:     1,
+				Status:    domainarticle.StatusDraft,
 				CreatedAt: time.Now(),
 				UpdatedAt: time.Now(),
+				CreatedBy: 1,
+				UpdatedBy: 1,
 			},
 			setup: func(mock sqlmock.Sqlmock) {
 				mock.ExpectExec("INSERT INTO articles").
-					WithArgs("Test Article", "This is a test article content", int64(1), sqlmock.AnyArg(), sqlmock.AnyArg()).
+					WithArgs("Test Article", "test-article", "This is a test article content", int64(1), int64(1), nil, domainarticle.StatusDraft, sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), int64(1), int64(1)).
 					WillReturnResult(sqlmock.NewResult(1, 1))
 			},
 			wantErr: false,
@@ -63,14 +69,19 @@ func TestMySQLRepository_Create(t *testing.T) {
 			name: "error on database exec",
 			article: &domainarticle.Article{
 				Title:     "Test Article",
+				Slug:      "test-article",
 				Content:   "This is a test article content",
 				AuthorID:  1,
+				OrgID:     1,
+				Status:    domainarticle.StatusDraft,
 				CreatedAt: time.Now(),
 				UpdatedAt: time.Now(),
+				CreatedBy: 1,
+				UpdatedBy: 1,
 			},
 			setup: func(mock sqlmock.Sqlmock) {
 				mock.ExpectExec("INSERT INTO articles").
-					WithArgs("Test Article", "This is a test article content", int64(1), sqlmock.AnyArg(), sqlmock.AnyArg()).
+					WithArgs("Test Article", "test-article", "This is a test article content", int64(1), int64(1), nil, domainarticle.StatusDraft, sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), int64(1), int64(1)).
 					WillReturnError(errors.New("database error"))
 			},
 			wantErr: true,
@@ -79,14 +90,19 @@ func TestMySQLRepository_Create(t *testing.T) {
 			name: "error on last insert id",
 			article: &domainarticle.Article{
 				Title:     "Test Article",
+				Slug:      "test-article",
 				Content:   "This is a test article content",
 				AuthorID:  1,
+				OrgID:     1,
+				Status:    domainarticle.StatusDraft,
 				CreatedAt: time.Now(),
 				UpdatedAt: time.Now(),
+				CreatedBy: 1,
+				UpdatedBy: 1,
 			},
 			setup: func(mock sqlmock.Sqlmock) {
 				mock.ExpectExec("INSERT INTO articles").
-					WithArgs("Test Article", "This is a test article content", int64(1), sqlmock.AnyArg(), sqlmock.AnyArg()).
+					WithArgs("Test Article", "test-article", "This is a test article content", int64(1), int64(1), nil, domainarticle.StatusDraft, sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), int64(1), int64(1)).
 					WillReturnResult(sqlmock.NewErrorResult(errors.New("last insert id error")))
 			},
 			wantErr: true,
@@ -139,10 +155,10 @@ func TestMySQLRepository_GetByID(t *testing.T) {
 			name: "success get article by id",
 			id:   1,
 			setup: func(mock sqlmock.Sqlmock) {
-				rows := sqlmock.NewRows([]string{"id", "title", "content", "author_id", "created_at", "updated_at"}).
-					AddRow(1, "Test Article", "Test Content", 1, time.Now(), time.Now())
-				mock.ExpectQuery("SELECT id, title, content, author_id, created_at, updated_at").
-					WithArgs(1).
+				rows := sqlmock.NewRows([]string{"id", "title", "slug", "content", "author_id", "org_id", "category_id", "status", "published_at", "publish_at", "created_at", "updated_at", "deleted_at"}).
+					AddRow(1, "Test Article", "test-article", "Test Content", 1, 1, nil, "published", nil, nil, time.Now(), time.Now(), nil)
+				mock.ExpectQuery("SELECT id, title, slug, content, author_id, org_id, category_id, status, published_at, publish_at, created_at, updated_at, deleted_at").
+					WithArgs(1, 1).
 					WillReturnRows(rows)
 			},
 			wantErr: false,
@@ -157,8 +173,8 @@ func TestMySQLRepository_GetByID(t *testing.T) {
 			name: "article not found",
 			id:   999,
 			setup: func(mock sqlmock.Sqlmock) {
-				mock.ExpectQuery("SELECT id, title, content, author_id, created_at, updated_at").
-					WithArgs(999).
+				mock.ExpectQuery("SELECT id, title, slug, content, author_id, org_id, category_id, status, published_at, publish_at, created_at, updated_at, deleted_at").
+					WithArgs(999, 1).
 					WillReturnError(sql.ErrNoRows)
 			},
 			wantErr: true,
@@ -170,8 +186,8 @@ func TestMySQLRepository_GetByID(t *testing.T) {
 			name: "database error",
 			id:   1,
 			setup: func(mock sqlmock.Sqlmock) {
-				mock.ExpectQuery("SELECT id, title, content, author_id, created_at, updated_at").
-					WithArgs(1).
+				mock.ExpectQuery("SELECT id, title, slug, content, author_id, org_id, category_id, status, published_at, publish_at, created_at, updated_at, deleted_at").
+					WithArgs(1, 1).
 					WillReturnError(errors.New("database error"))
 			},
 			wantErr: true,
@@ -194,7 +210,7 @@ func TestMySQLRepository_GetByID(t *testing.T) {
 			repo := NewMySQLRepository(db)
 			tt.setup(mock)
 
-			result, err := repo.GetByID(context.Background(), tt.id)
+			result, err := repo.GetByID(context.Background(), 1, tt.id)
 
 			if tt.wantErr {
 				assert.Error(t, err)
@@ -219,6 +235,29 @@ func TestMySQLRepository_GetByID(t *testing.T) {
 	}
 }
 
+func TestMySQLRepository_Create_SlugTaken(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer func() {
+		mock.ExpectClose()
+		if err := db.Close(); err != nil {
+			t.Fatalf("Failed to close database connection: %v", err)
+		}
+	}()
+
+	repo := NewMySQLRepository(db)
+	mock.ExpectExec("INSERT INTO articles").
+		WillReturnError(&mysql.MySQLError{Number: 1062, Message: "Duplicate entry '1-test-article' for key 'idx_articles_org_id_slug'"})
+
+	result, err := repo.Create(context.Background(), &domainarticle.Article{Title: "Test Article", Slug: "test-article", OrgID: 1})
+
+	assert.Nil(t, result)
+	assert.Equal(t, domainarticle.ErrSlugTaken, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
 func TestMySQLRepository_Update(t *testing.T) {
 	tests := []struct {
 		name    string
@@ -231,13 +270,17 @@ func TestMySQLRepository_Update(t *testing.T) {
 			name: "success update article",
 			article: &domainarticle.Article{
 				ID:        1,
+				OrgID:     1,
 				Title:     "Updated Article",
+				Slug:      "updated-article",
 				Content:   "Updated Content",
+				Status:    domainarticle.StatusPublished,
 				UpdatedAt: time.Now(),
+				UpdatedBy: 2,
 			},
 			setup: func(mock sqlmock.Sqlmock) {
 				mock.ExpectExec("UPDATE articles").
-					WithArgs("Updated Article", "Updated Content", sqlmock.AnyArg(), 1).
+					WithArgs("Updated Article", "updated-article", "Updated Content", nil, domainarticle.StatusPublished, sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), int64(2), 1, 1).
 					WillReturnResult(sqlmock.NewResult(0, 1))
 			},
 			wantErr: false,
@@ -251,13 +294,17 @@ func TestMySQLRepository_Update(t *testing.T) {
 			name: "error on database exec",
 			article: &domainarticle.Article{
 				ID:        1,
+				OrgID:     1,
 				Title:     "Updated Article",
+				Slug:      "updated-article",
 				Content:   "Updated Content",
+				Status:    domainarticle.StatusPublished,
 				UpdatedAt: time.Now(),
+				UpdatedBy: 2,
 			},
 			setup: func(mock sqlmock.Sqlmock) {
 				mock.ExpectExec("UPDATE articles").
-					WithArgs("Updated Article", "Updated Content", sqlmock.AnyArg(), 1).
+					WithArgs("Updated Article", "updated-article", "Updated Content", nil, domainarticle.StatusPublished, sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), int64(2), 1, 1).
 					WillReturnError(errors.New("database error"))
 			},
 			wantErr: true,
@@ -309,8 +356,8 @@ func TestMySQLRepository_Delete(t *testing.T) {
 			name: "success delete article",
 			id:   1,
 			setup: func(mock sqlmock.Sqlmock) {
-				mock.ExpectExec("DELETE FROM articles").
-					WithArgs(1).
+				mock.ExpectExec("UPDATE articles SET deleted_at").
+					WithArgs(sqlmock.AnyArg(), 1, 1).
 					WillReturnResult(sqlmock.NewResult(0, 1))
 			},
 			wantErr: false,
@@ -319,8 +366,8 @@ func TestMySQLRepository_Delete(t *testing.T) {
 			name: "article not found",
 			id:   999,
 			setup: func(mock sqlmock.Sqlmock) {
-				mock.ExpectExec("DELETE FROM articles").
-					WithArgs(999).
+				mock.ExpectExec("UPDATE articles SET deleted_at").
+					WithArgs(sqlmock.AnyArg(), 999, 1).
 					WillReturnResult(sqlmock.NewResult(0, 0))
 			},
 			wantErr: true,
@@ -329,8 +376,8 @@ func TestMySQLRepository_Delete(t *testing.T) {
 			name: "error on database exec",
 			id:   1,
 			setup: func(mock sqlmock.Sqlmock) {
-				mock.ExpectExec("DELETE FROM articles").
-					WithArgs(1).
+				mock.ExpectExec("UPDATE articles SET deleted_at").
+					WithArgs(sqlmock.AnyArg(), 1, 1).
 					WillReturnError(errors.New("database error"))
 			},
 			wantErr: true,
@@ -339,8 +386,8 @@ func TestMySQLRepository_Delete(t *testing.T) {
 			name: "error on rows affected",
 			id:   1,
 			setup: func(mock sqlmock.Sqlmock) {
-				mock.ExpectExec("DELETE FROM articles").
-					WithArgs(1).
+				mock.ExpectExec("UPDATE articles SET deleted_at").
+					WithArgs(sqlmock.AnyArg(), 1, 1).
 					WillReturnResult(sqlmock.NewErrorResult(errors.New("rows affected error")))
 			},
 			wantErr: true,
@@ -363,7 +410,7 @@ func TestMySQLRepository_Delete(t *testing.T) {
 			repo := NewMySQLRepository(db)
 			tt.setup(mock)
 
-			err = repo.Delete(context.Background(), tt.id)
+			err = repo.Delete(context.Background(), 1, tt.id)
 
 			if tt.wantErr {
 				assert.Error(t, err)
@@ -393,11 +440,11 @@ func TestMySQLRepository_List(t *testing.T) {
 			limit:  10,
 			offset: 0,
 			setup: func(mock sqlmock.Sqlmock) {
-				rows := sqlmock.NewRows([]string{"id", "title", "content", "author_id", "created_at", "updated_at"}).
-					AddRow(1, "Article 1", "Content 1", 1, time.Now(), time.Now()).
-					AddRow(2, "Article 2", "Content 2", 1, time.Now(), time.Now())
-				mock.ExpectQuery("SELECT id, title, content, author_id, created_at, updated_at").
-					WithArgs(10, 0).
+				rows := sqlmock.NewRows([]string{"id", "title", "slug", "content", "author_id", "org_id", "category_id", "status", "published_at", "publish_at", "created_at", "updated_at", "deleted_at"}).
+					AddRow(1, "Article 1", "article-1", "Content 1", 1, 1, nil, "published", nil, nil, time.Now(), time.Now(), nil).
+					AddRow(2, "Article 2", "article-2", "Content 2", 1, 1, nil, "published", nil, nil, time.Now(), time.Now(), nil)
+				mock.ExpectQuery("SELECT id, title, slug, content, author_id, org_id, category_id, status, published_at, publish_at, created_at, updated_at, deleted_at").
+					WithArgs(1, "published", 7, 10, 0).
 					WillReturnRows(rows)
 			},
 			wantErr: false,
@@ -414,9 +461,9 @@ func TestMySQLRepository_List(t *testing.T) {
 			limit:  10,
 			offset: 0,
 			setup: func(mock sqlmock.Sqlmock) {
-				rows := sqlmock.NewRows([]string{"id", "title", "content", "author_id", "created_at", "updated_at"})
-				mock.ExpectQuery("SELECT id, title, content, author_id, created_at, updated_at").
-					WithArgs(10, 0).
+				rows := sqlmock.NewRows([]string{"id", "title", "slug", "content", "author_id", "org_id", "category_id", "status", "published_at", "publish_at", "created_at", "updated_at", "deleted_at"})
+				mock.ExpectQuery("SELECT id, title, slug, content, author_id, org_id, category_id, status, published_at, publish_at, created_at, updated_at, deleted_at").
+					WithArgs(1, "published", 7, 10, 0).
 					WillReturnRows(rows)
 			},
 			wantErr: false,
@@ -432,8 +479,8 @@ func TestMySQLRepository_List(t *testing.T) {
 			limit:  10,
 			offset: 0,
 			setup: func(mock sqlmock.Sqlmock) {
-				mock.ExpectQuery("SELECT id, title, content, author_id, created_at, updated_at").
-					WithArgs(10, 0).
+				mock.ExpectQuery("SELECT id, title, slug, content, author_id, org_id, category_id, status, published_at, publish_at, created_at, updated_at, deleted_at").
+					WithArgs(1, "published", 7, 10, 0).
 					WillReturnError(errors.New("database error"))
 			},
 			wantErr: true,
@@ -443,10 +490,10 @@ func TestMySQLRepository_List(t *testing.T) {
 			limit:  10,
 			offset: 0,
 			setup: func(mock sqlmock.Sqlmock) {
-				rows := sqlmock.NewRows([]string{"id", "title", "content", "author_id", "created_at", "updated_at"}).
-					AddRow("invalid", "Article 1", "Content 1", 1, time.Now(), time.Now())
-				mock.ExpectQuery("SELECT id, title, content, author_id, created_at, updated_at").
-					WithArgs(10, 0).
+				rows := sqlmock.NewRows([]string{"id", "title", "slug", "content", "author_id", "org_id", "category_id", "status", "published_at", "publish_at", "created_at", "updated_at", "deleted_at"}).
+					AddRow("invalid", "Article 1", "article-1", "Content 1", 1, 1, nil, "published", nil, nil, time.Now(), time.Now(), nil)
+				mock.ExpectQuery("SELECT id, title, slug, content, author_id, org_id, category_id, status, published_at, publish_at, created_at, updated_at, deleted_at").
+					WithArgs(1, "published", 7, 10, 0).
 					WillReturnRows(rows)
 			},
 			wantErr: true,
@@ -456,11 +503,11 @@ func TestMySQLRepository_List(t *testing.T) {
 			limit:  10,
 			offset: 0,
 			setup: func(mock sqlmock.Sqlmock) {
-				rows := sqlmock.NewRows([]string{"id", "title", "content", "author_id", "created_at", "updated_at"}).
-					AddRow(1, "Article 1", "Content 1", 1, time.Now(), time.Now()).
+				rows := sqlmock.NewRows([]string{"id", "title", "slug", "content", "author_id", "org_id", "category_id", "status", "published_at", "publish_at", "created_at", "updated_at", "deleted_at"}).
+					AddRow(1, "Article 1", "article-1", "Content 1", 1, 1, nil, "published", nil, nil, time.Now(), time.Now(), nil).
 					RowError(0, errors.New("row error"))
-				mock.ExpectQuery("SELECT id, title, content, author_id, created_at, updated_at").
-					WithArgs(10, 0).
+				mock.ExpectQuery("SELECT id, title, slug, content, author_id, org_id, category_id, status, published_at, publish_at, created_at, updated_at, deleted_at").
+					WithArgs(1, "published", 7, 10, 0).
 					WillReturnRows(rows)
 			},
 			wantErr: true,
@@ -483,7 +530,7 @@ func TestMySQLRepository_List(t *testing.T) {
 			repo := NewMySQLRepository(db)
 			tt.setup(mock)
 
-			result, err := repo.List(context.Background(), tt.limit, tt.offset)
+			result, err := repo.List(context.Background(), domainarticle.ListFilter{OrgID: 1, AuthorID: 7}, tt.limit, tt.offset)
 
 			if tt.wantErr {
 				assert.Error(t, err)
@@ -522,11 +569,11 @@ func TestMySQLRepository_ListByAuthor(t *testing.T) {
 			limit:    10,
 			offset:   0,
 			setup: func(mock sqlmock.Sqlmock) {
-				rows := sqlmock.NewRows([]string{"id", "title", "content", "author_id", "created_at", "updated_at"}).
-					AddRow(1, "Article 1", "Content 1", 1, time.Now(), time.Now()).
-					AddRow(2, "Article 2", "Content 2", 1, time.Now(), time.Now())
-				mock.ExpectQuery("SELECT id, title, content, author_id, created_at, updated_at").
-					WithArgs(1, 10, 0).
+				rows := sqlmock.NewRows([]string{"id", "title", "slug", "content", "author_id", "org_id", "category_id", "status", "published_at", "publish_at", "created_at", "updated_at", "deleted_at"}).
+					AddRow(1, "Article 1", "article-1", "Content 1", 1, 1, nil, "published", nil, nil, time.Now(), time.Now(), nil).
+					AddRow(2, "Article 2", "article-2", "Content 2", 1, 1, nil, "published", nil, nil, time.Now(), time.Now(), nil)
+				mock.ExpectQuery("SELECT id, title, slug, content, author_id, org_id, category_id, status, published_at, publish_at, created_at, updated_at, deleted_at").
+					WithArgs(1, 1, 10, 0).
 					WillReturnRows(rows)
 			},
 			wantErr: false,
@@ -546,9 +593,9 @@ func TestMySQLRepository_ListByAuthor(t *testing.T) {
 			limit:    10,
 			offset:   0,
 			setup: func(mock sqlmock.Sqlmock) {
-				rows := sqlmock.NewRows([]string{"id", "title", "content", "author_id", "created_at", "updated_at"})
-				mock.ExpectQuery("SELECT id, title, content, author_id, created_at, updated_at").
-					WithArgs(1, 10, 0).
+				rows := sqlmock.NewRows([]string{"id", "title", "slug", "content", "author_id", "org_id", "category_id", "status", "published_at", "publish_at", "created_at", "updated_at", "deleted_at"})
+				mock.ExpectQuery("SELECT id, title, slug, content, author_id, org_id, category_id, status, published_at, publish_at, created_at, updated_at, deleted_at").
+					WithArgs(1, 1, 10, 0).
 					WillReturnRows(rows)
 			},
 			wantErr: false,
@@ -565,8 +612,8 @@ func TestMySQLRepository_ListByAuthor(t *testing.T) {
 			limit:    10,
 			offset:   0,
 			setup: func(mock sqlmock.Sqlmock) {
-				mock.ExpectQuery("SELECT id, title, content, author_id, created_at, updated_at").
-					WithArgs(1, 10, 0).
+				mock.ExpectQuery("SELECT id, title, slug, content, author_id, org_id, category_id, status, published_at, publish_at, created_at, updated_at, deleted_at").
+					WithArgs(1, 1, 10, 0).
 					WillReturnError(errors.New("database error"))
 			},
 			wantErr: true,
@@ -577,10 +624,10 @@ func TestMySQLRepository_ListByAuthor(t *testing.T) {
 			limit:    10,
 			offset:   0,
 			setup: func(mock sqlmock.Sqlmock) {
-				rows := sqlmock.NewRows([]string{"id", "title", "content", "author_id", "created_at", "updated_at"}).
-					AddRow("invalid", "Article 1", "Content 1", 1, time.Now(), time.Now())
-				mock.ExpectQuery("SELECT id, title, content, author_id, created_at, updated_at").
-					WithArgs(1, 10, 0).
+				rows := sqlmock.NewRows([]string{"id", "title", "slug", "content", "author_id", "org_id", "category_id", "status", "published_at", "publish_at", "created_at", "updated_at", "deleted_at"}).
+					AddRow("invalid", "Article 1", "article-1", "Content 1", 1, 1, nil, "published", nil, nil, time.Now(), time.Now(), nil)
+				mock.ExpectQuery("SELECT id, title, slug, content, author_id, org_id, category_id, status, published_at, publish_at, created_at, updated_at, deleted_at").
+					WithArgs(1, 1, 10, 0).
 					WillReturnRows(rows)
 			},
 			wantErr: true,
@@ -591,11 +638,11 @@ func TestMySQLRepository_ListByAuthor(t *testing.T) {
 			limit:    10,
 			offset:   0,
 			setup: func(mock sqlmock.Sqlmock) {
-				rows := sqlmock.NewRows([]string{"id", "title", "content", "author_id", "created_at", "updated_at"}).
-					AddRow(1, "Article 1", "Content 1", 1, time.Now(), time.Now()).
+				rows := sqlmock.NewRows([]string{"id", "title", "slug", "content", "author_id", "org_id", "category_id", "status", "published_at", "publish_at", "created_at", "updated_at", "deleted_at"}).
+					AddRow(1, "Article 1", "article-1", "Content 1", 1, 1, nil, "published", nil, nil, time.Now(), time.Now(), nil).
 					RowError(0, errors.New("row error"))
-				mock.ExpectQuery("SELECT id, title, content, author_id, created_at, updated_at").
-					WithArgs(1, 10, 0).
+				mock.ExpectQuery("SELECT id, title, slug, content, author_id, org_id, category_id, status, published_at, publish_at, created_at, updated_at, deleted_at").
+					WithArgs(1, 1, 10, 0).
 					WillReturnRows(rows)
 			},
 			wantErr: true,
@@ -618,7 +665,7 @@ func TestMySQLRepository_ListByAuthor(t *testing.T) {
 			repo := NewMySQLRepository(db)
 			tt.setup(mock)
 
-			result, err := repo.ListByAuthor(context.Background(), tt.authorID, tt.limit, tt.offset)
+			result, err := repo.ListByAuthor(context.Background(), 1, tt.authorID, tt.limit, tt.offset)
 
 			if tt.wantErr {
 				assert.Error(t, err)
@@ -641,6 +688,67 @@ func TestMySQLRepository_ListByAuthor(t *testing.T) {
 	}
 }
 
+func TestMySQLRepository_List_StatusFilter(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer func() {
+		mock.ExpectClose()
+		if err := db.Close(); err != nil {
+			t.Fatalf("Failed to close database connection: %v", err)
+		}
+	}()
+
+	repo := NewMySQLRepository(db)
+	publishedAt := time.Now().Add(-time.Hour)
+	rows := sqlmock.NewRows([]string{"id", "title", "slug", "content", "author_id", "org_id", "category_id", "status", "published_at", "publish_at", "created_at", "updated_at", "deleted_at"}).
+		AddRow(1, "Published", "published", "Content", 7, 1, nil, "published", publishedAt, nil, time.Now(), time.Now(), nil).
+		AddRow(2, "In review", "in-review", "Content", 8, 1, nil, "in_review", nil, nil, time.Now(), time.Now(), nil)
+	mock.ExpectQuery("WHERE org_id = \\? AND deleted_at IS NULL AND \\(status = \\? OR status IN \\(\\?, \\?\\)\\)").
+		WithArgs(1, "published", "draft", "in_review", 10, 0).
+		WillReturnRows(rows)
+
+	filter := domainarticle.ListFilter{OrgID: 1, Statuses: []domainarticle.Status{domainarticle.StatusDraft, domainarticle.StatusInReview}}
+	articles, err := repo.List(context.Background(), filter, 10, 0)
+
+	assert.NoError(t, err)
+	assert.Len(t, articles, 2)
+	assert.Equal(t, domainarticle.StatusPublished, articles[0].Status)
+	assert.True(t, publishedAt.Equal(*articles[0].PublishedAt))
+	assert.Equal(t, domainarticle.StatusInReview, articles[1].Status)
+	assert.Nil(t, articles[1].PublishedAt)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestMySQLRepository_List_TaxonomyFilter(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer func() {
+		mock.ExpectClose()
+		if err := db.Close(); err != nil {
+			t.Fatalf("Failed to close database connection: %v", err)
+		}
+	}()
+
+	repo := NewMySQLRepository(db)
+	rows := sqlmock.NewRows([]string{"id", "title", "slug", "content", "author_id", "org_id", "category_id", "status", "published_at", "publish_at", "created_at", "updated_at", "deleted_at"}).
+		AddRow(1, "Football", "football", "Content", 7, 1, 3, "published", nil, nil, time.Now(), time.Now(), nil)
+	mock.ExpectQuery("AND category_id IN \\(\\?, \\?\\) AND id IN \\(SELECT article_id FROM article_tags WHERE tag_id = \\?\\)").
+		WithArgs(1, "published", 2, 3, 5, 10, 0).
+		WillReturnRows(rows)
+
+	filter := domainarticle.ListFilter{OrgID: 1, CategoryIDs: []int64{2, 3}, TagID: 5}
+	articles, err := repo.List(context.Background(), filter, 10, 0)
+
+	assert.NoError(t, err)
+	assert.Len(t, articles, 1)
+	assert.Equal(t, int64(3), *articles[0].CategoryID)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
 func TestMySQLRepository_Count(t *testing.T) {
 	tests := []struct {
 		name    string
@@ -654,6 +762,7 @@ func TestMySQLRepository_Count(t *testing.T) {
 				rows := sqlmock.NewRows([]string{"COUNT(*)"}).
 					AddRow(42)
 				mock.ExpectQuery("SELECT COUNT\\(\\*\\) FROM articles").
+					WithArgs(1, "published", 7).
 					WillReturnRows(rows)
 			},
 			want:    42,
@@ -665,6 +774,7 @@ func TestMySQLRepository_Count(t *testing.T) {
 				rows := sqlmock.NewRows([]string{"COUNT(*)"}).
 					AddRow(0)
 				mock.ExpectQuery("SELECT COUNT\\(\\*\\) FROM articles").
+					WithArgs(1, "published", 7).
 					WillReturnRows(rows)
 			},
 			want:    0,
@@ -674,6 +784,7 @@ func TestMySQLRepository_Count(t *testing.T) {
 			name: "error on database query",
 			setup: func(mock sqlmock.Sqlmock) {
 				mock.ExpectQuery("SELECT COUNT\\(\\*\\) FROM articles").
+					WithArgs(1, "published", 7).
 					WillReturnError(errors.New("database error"))
 			},
 			want:    0,
@@ -697,7 +808,7 @@ func TestMySQLRepository_Count(t *testing.T) {
 			repo := NewMySQLRepository(db)
 			tt.setup(mock)
 
-			result, err := repo.Count(context.Background())
+			result, err := repo.Count(context.Background(), domainarticle.ListFilter{OrgID: 1, AuthorID: 7})
 
 			if tt.wantErr {
 				assert.Error(t, err)
@@ -726,8 +837,8 @@ func TestMySQLRepository_CountByAuthor(t *testing.T) {
 			setup: func(mock sqlmock.Sqlmock) {
 				rows := sqlmock.NewRows([]string{"COUNT(*)"}).
 					AddRow(10)
-				mock.ExpectQuery("SELECT COUNT\\(\\*\\) FROM articles WHERE author_id = \\?").
-					WithArgs(1).
+				mock.ExpectQuery("SELECT COUNT\\(\\*\\) FROM articles WHERE org_id = \\? AND author_id = \\?").
+					WithArgs(1, 1).
 					WillReturnRows(rows)
 			},
 			want:    10,
@@ -739,8 +850,8 @@ func TestMySQLRepository_CountByAuthor(t *testing.T) {
 			setup: func(mock sqlmock.Sqlmock) {
 				rows := sqlmock.NewRows([]string{"COUNT(*)"}).
 					AddRow(0)
-				mock.ExpectQuery("SELECT COUNT\\(\\*\\) FROM articles WHERE author_id = \\?").
-					WithArgs(1).
+				mock.ExpectQuery("SELECT COUNT\\(\\*\\) FROM articles WHERE org_id = \\? AND author_id = \\?").
+					WithArgs(1, 1).
 					WillReturnRows(rows)
 			},
 			want:    0,
@@ -750,8 +861,8 @@ func TestMySQLRepository_CountByAuthor(t *testing.T) {
 			name:     "error on database query",
 			authorID: 1,
 			setup: func(mock sqlmock.Sqlmock) {
-				mock.ExpectQuery("SELECT COUNT\\(\\*\\) FROM articles WHERE author_id = \\?").
-					WithArgs(1).
+				mock.ExpectQuery("SELECT COUNT\\(\\*\\) FROM articles WHERE org_id = \\? AND author_id = \\?").
+					WithArgs(1, 1).
 					WillReturnError(errors.New("database error"))
 			},
 			want:    0,
@@ -775,7 +886,7 @@ func TestMySQLRepository_CountByAuthor(t *testing.T) {
 			repo := NewMySQLRepository(db)
 			tt.setup(mock)
 
-			result, err := repo.CountByAuthor(context.Background(), tt.authorID)
+			result, err := repo.CountByAuthor(context.Background(), 1, tt.authorID)
 
 			if tt.wantErr {
 				assert.Error(t, err)
@@ -789,3 +900,467 @@ func TestMySQLRepository_CountByAuthor(t *testing.T) {
 		})
 	}
 }
+
+func TestMySQLRepository_Restore(t *testing.T) {
+	tests := []struct {
+		name    string
+		id      int64
+		setup   func(mock sqlmock.Sqlmock)
+		wantErr error
+	}{
+		{
+			name: "success restore article",
+			id:   1,
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec("UPDATE articles SET deleted_at = NULL WHERE id = \\? AND org_id = \\? AND deleted_at IS NOT NULL").
+					WithArgs(1, 1).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+		},
+		{
+			name: "article not in trash",
+			id:   999,
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec("UPDATE articles SET deleted_at = NULL").
+					WithArgs(999, 1).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+			},
+			wantErr: domainarticle.ErrArticleNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+			}
+			defer func() {
+				mock.ExpectClose()
+				if err := db.Close(); err != nil {
+					t.Fatalf("Failed to close database connection: %v", err)
+				}
+			}()
+
+			repo := NewMySQLRepository(db)
+			tt.setup(mock)
+
+			err = repo.Restore(context.Background(), 1, tt.id)
+
+			assert.Equal(t, tt.wantErr, err)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestMySQLRepository_ListDeleted(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer func() {
+		mock.ExpectClose()
+		if err := db.Close(); err != nil {
+			t.Fatalf("Failed to close database connection: %v", err)
+		}
+	}()
+
+	repo := NewMySQLRepository(db)
+	deletedAt := time.Now()
+	rows := sqlmock.NewRows([]string{"id", "title", "slug", "content", "author_id", "org_id", "category_id", "status", "published_at", "publish_at", "created_at", "updated_at", "deleted_at"}).
+		AddRow(1, "Test Article", "test-article", "Test Content", 1, 1, nil, "published", nil, nil, time.Now(), time.Now(), deletedAt)
+	mock.ExpectQuery("WHERE org_id = \\? AND deleted_at IS NOT NULL\\s+ORDER BY deleted_at DESC").
+		WithArgs(1, 10, 0).
+		WillReturnRows(rows)
+
+	articles, err := repo.ListDeleted(context.Background(), 1, 10, 0)
+
+	assert.NoError(t, err)
+	assert.Len(t, articles, 1)
+	if assert.NotNil(t, articles[0].DeletedAt) {
+		assert.True(t, deletedAt.Equal(*articles[0].DeletedAt))
+	}
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestMySQLRepository_CountDeleted(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer func() {
+		mock.ExpectClose()
+		if err := db.Close(); err != nil {
+			t.Fatalf("Failed to close database connection: %v", err)
+		}
+	}()
+
+	repo := NewMySQLRepository(db)
+	mock.ExpectQuery("SELECT COUNT\\(\\*\\) FROM articles WHERE org_id = \\? AND deleted_at IS NOT NULL").
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"COUNT(*)"}).AddRow(4))
+
+	count, err := repo.CountDeleted(context.Background(), 1)
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(4), count)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestMySQLRepository_ListIDsByCategory(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer func() {
+		mock.ExpectClose()
+		if err := db.Close(); err != nil {
+			t.Fatalf("Failed to close database connection: %v", err)
+		}
+	}()
+
+	repo := NewMySQLRepository(db)
+	mock.ExpectQuery("SELECT id FROM articles WHERE org_id = \\? AND category_id = \\?$").
+		WithArgs(int64(1), int64(3)).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(4).AddRow(7))
+
+	ids, err := repo.ListIDsByCategory(context.Background(), 1, 3)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{4, 7}, ids)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestMySQLRepository_PurgeDeletedBefore(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer func() {
+		mock.ExpectClose()
+		if err := db.Close(); err != nil {
+			t.Fatalf("Failed to close database connection: %v", err)
+		}
+	}()
+
+	repo := NewMySQLRepository(db)
+	before := time.Now().AddDate(0, 0, -30)
+	mock.ExpectExec("DELETE FROM articles WHERE deleted_at IS NOT NULL AND deleted_at < \\?").
+		WithArgs(before).
+		WillReturnResult(sqlmock.NewResult(0, 5))
+
+	purged, err := repo.PurgeDeletedBefore(context.Background(), before)
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(5), purged)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestMySQLRepository_ListAllByAuthor(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer func() {
+		mock.ExpectClose()
+		if err := db.Close(); err != nil {
+			t.Fatalf("Failed to close database connection: %v", err)
+		}
+	}()
+
+	repo := NewMySQLRepository(db)
+	deletedAt := time.Now()
+	rows := sqlmock.NewRows([]string{"id", "title", "slug", "content", "author_id", "org_id", "category_id", "status", "published_at", "publish_at", "created_at", "updated_at", "deleted_at"}).
+		AddRow(1, "Live Article", "live-article", "Content", 7, 1, nil, "published", nil, nil, time.Now(), time.Now(), nil).
+		AddRow(2, "Trashed Article", "trashed-article", "Content", 7, 1, nil, "published", nil, nil, time.Now(), time.Now(), deletedAt)
+	mock.ExpectQuery("FROM articles\\s+WHERE author_id = \\?\\s+ORDER BY id ASC").
+		WithArgs(int64(7), 100, 0).
+		WillReturnRows(rows)
+
+	articles, err := repo.ListAllByAuthor(context.Background(), 7, 100, 0)
+
+	assert.NoError(t, err)
+	if assert.Len(t, articles, 2) {
+		assert.Nil(t, articles[0].DeletedAt)
+		if assert.NotNil(t, articles[1].DeletedAt) {
+			assert.True(t, deletedAt.Equal(*articles[1].DeletedAt))
+		}
+	}
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestMySQLRepository_DeleteByAuthor(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer func() {
+		mock.ExpectClose()
+		if err := db.Close(); err != nil {
+			t.Fatalf("Failed to close database connection: %v", err)
+		}
+	}()
+
+	repo := NewMySQLRepository(db)
+	mock.ExpectExec("DELETE FROM articles WHERE author_id = \\?$").
+		WithArgs(int64(7)).
+		WillReturnResult(sqlmock.NewResult(0, 3))
+	mock.ExpectExec("DELETE FROM articles WHERE author_id = \\? AND org_id = \\?").
+		WithArgs(int64(7), int64(2)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	deleted, err := repo.DeleteByAuthor(context.Background(), 0, 7)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(3), deleted)
+
+	deleted, err = repo.DeleteByAuthor(context.Background(), 2, 7)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), deleted)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestMySQLRepository_ReassignAuthor(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer func() {
+		mock.ExpectClose()
+		if err := db.Close(); err != nil {
+			t.Fatalf("Failed to close database connection: %v", err)
+		}
+	}()
+
+	repo := NewMySQLRepository(db)
+	mock.ExpectExec("UPDATE articles SET author_id = \\?, updated_at = \\? WHERE author_id = \\?$").
+		WithArgs(int64(1), sqlmock.AnyArg(), int64(7)).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectExec("UPDATE articles SET author_id = \\?, updated_at = \\? WHERE author_id = \\? AND org_id = \\?").
+		WithArgs(int64(1), sqlmock.AnyArg(), int64(7), int64(2)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	moved, err := repo.ReassignAuthor(context.Background(), 0, 7, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), moved)
+
+	moved, err = repo.ReassignAuthor(context.Background(), 2, 7, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), moved)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestMySQLRepository_ListDueForPublishing(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer func() {
+		mock.ExpectClose()
+		if err := db.Close(); err != nil {
+			t.Fatalf("Failed to close database connection: %v", err)
+		}
+	}()
+
+	repo := NewMySQLRepository(db)
+	now := time.Now()
+	publishAt := now.Add(-time.Minute)
+	rows := sqlmock.NewRows([]string{"id", "title", "slug", "content", "author_id", "org_id", "category_id", "status", "published_at", "publish_at", "created_at", "updated_at", "deleted_at"}).
+		AddRow(3, "Scheduled", "scheduled", "Content", 7, 2, nil, "in_review", nil, publishAt, time.Now(), time.Now(), nil)
+	mock.ExpectQuery("WHERE status = \\? AND publish_at IS NOT NULL AND publish_at <= \\? AND deleted_at IS NULL").
+		WithArgs("in_review", now, 50).
+		WillReturnRows(rows)
+
+	articles, err := repo.ListDueForPublishing(context.Background(), now, 50)
+
+	assert.NoError(t, err)
+	assert.Len(t, articles, 1)
+	assert.Equal(t, int64(2), articles[0].OrgID)
+	assert.True(t, publishAt.Equal(*articles[0].PublishAt))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestMySQLRepository_ListAfter(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer func() {
+		mock.ExpectClose()
+		if err := db.Close(); err != nil {
+			t.Fatalf("Failed to close database connection: %v", err)
+		}
+	}()
+
+	repo := NewMySQLRepository(db)
+	rows := sqlmock.NewRows([]string{"id", "title", "slug", "content", "author_id", "org_id", "category_id", "status", "published_at", "publish_at", "created_at", "updated_at", "deleted_at"}).
+		AddRow(11, "First", "first", "Content", 7, 1, nil, "draft", nil, nil, time.Now(), time.Now(), nil).
+		AddRow(12, "Second", "second", "Content", 8, 2, 4, "published", time.Now(), nil, time.Now(), time.Now(), nil)
+	mock.ExpectQuery("WHERE id > \\? AND deleted_at IS NULL\\s+ORDER BY id ASC").
+		WithArgs(10, 100).
+		WillReturnRows(rows)
+
+	articles, err := repo.ListAfter(context.Background(), 10, 100)
+
+	assert.NoError(t, err)
+	assert.Len(t, articles, 2)
+	assert.Equal(t, int64(12), articles[1].ID)
+	assert.Equal(t, int64(4), *articles[1].CategoryID)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestMySQLRepository_PublishIfDue(t *testing.T) {
+	tests := []struct {
+		name     string
+		affected int64
+		want     bool
+	}{
+		{name: "published by this scheduler", affected: 1, want: true},
+		{name: "published by another scheduler or moved meanwhile", affected: 0, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+			}
+			defer func() {
+				mock.ExpectClose()
+				if err := db.Close(); err != nil {
+					t.Fatalf("Failed to close database connection: %v", err)
+				}
+			}()
+
+			repo := NewMySQLRepository(db)
+			now := time.Now()
+			article := &domainarticle.Article{ID: 3, OrgID: 2, Status: domainarticle.StatusPublished, PublishedAt: &now, UpdatedAt: now}
+			mock.ExpectExec("UPDATE articles").
+				WithArgs("published", &now, now, int64(0), 3, "in_review", now).
+				WillReturnResult(sqlmock.NewResult(0, tt.affected))
+
+			published, err := repo.PublishIfDue(context.Background(), article, now)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, published)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestMySQLRepository_GetBySlug(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer func() {
+		mock.ExpectClose()
+		if err := db.Close(); err != nil {
+			t.Fatalf("Failed to close database connection: %v", err)
+		}
+	}()
+
+	repo := NewMySQLRepository(db)
+	rows := sqlmock.NewRows([]string{"id", "title", "slug", "content", "author_id", "org_id", "category_id", "status", "published_at", "publish_at", "created_at", "updated_at", "deleted_at"}).
+		AddRow(1, "Test Article", "test-article", "Test Content", 1, 1, nil, "published", nil, nil, time.Now(), time.Now(), nil)
+	mock.ExpectQuery("WHERE org_id = \\? AND slug = \\? AND deleted_at IS NULL").
+		WithArgs(1, "test-article").
+		WillReturnRows(rows)
+	mock.ExpectQuery("WHERE org_id = \\? AND slug = \\? AND deleted_at IS NULL").
+		WithArgs(1, "missing").
+		WillReturnError(sql.ErrNoRows)
+
+	article, err := repo.GetBySlug(context.Background(), 1, "test-article")
+	assert.NoError(t, err)
+	assert.Equal(t, "test-article", article.Slug)
+
+	article, err = repo.GetBySlug(context.Background(), 1, "missing")
+	assert.Equal(t, domainarticle.ErrArticleNotFound, err)
+	assert.Nil(t, article)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestMySQLRepository_GetByPreviousSlug(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer func() {
+		mock.ExpectClose()
+		if err := db.Close(); err != nil {
+			t.Fatalf("Failed to close database connection: %v", err)
+		}
+	}()
+
+	repo := NewMySQLRepository(db)
+	rows := sqlmock.NewRows([]string{"id", "title", "slug", "content", "author_id", "org_id", "category_id", "status", "published_at", "publish_at", "created_at", "updated_at", "deleted_at"}).
+		AddRow(1, "Renamed Article", "renamed-article", "Test Content", 1, 1, nil, "published", nil, nil, time.Now(), time.Now(), nil)
+	mock.ExpectQuery("FROM article_slugs s\\s+INNER JOIN articles a ON a.id = s.article_id\\s+WHERE s.org_id = \\? AND s.slug = \\? AND a.deleted_at IS NULL").
+		WithArgs(1, "test-article").
+		WillReturnRows(rows)
+
+	article, err := repo.GetByPreviousSlug(context.Background(), 1, "test-article")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "renamed-article", article.Slug)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestMySQLRepository_SlugTaken(t *testing.T) {
+	tests := []struct {
+		name  string
+		taken bool
+	}{
+		{name: "slug used by another article", taken: true},
+		{name: "slug free", taken: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+			}
+			defer func() {
+				mock.ExpectClose()
+				if err := db.Close(); err != nil {
+					t.Fatalf("Failed to close database connection: %v", err)
+				}
+			}()
+
+			repo := NewMySQLRepository(db)
+			mock.ExpectQuery("SELECT EXISTS").
+				WithArgs(1, "test-article", 3, 1, "test-article", 3).
+				WillReturnRows(sqlmock.NewRows([]string{"taken"}).AddRow(tt.taken))
+
+			taken, err := repo.SlugTaken(context.Background(), 1, "test-article", 3)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.taken, taken)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestMySQLRepository_AddPreviousSlug(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer func() {
+		mock.ExpectClose()
+		if err := db.Close(); err != nil {
+			t.Fatalf("Failed to close database connection: %v", err)
+		}
+	}()
+
+	repo := NewMySQLRepository(db)
+	mock.ExpectExec("INSERT INTO article_slugs").
+		WithArgs(1, 3, "test-article", sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	err = repo.AddPreviousSlug(context.Background(), 1, 3, "test-article")
+
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,90 @@
+package article
+
+import (
+	"context"
+	"database/sql"
+	"log"
+	"strings"
+
+	domainarticle "github.com/rulzi/hexa-go/internal/domain/article"
+)
+
+// MySQLSearchIndex is the MySQL FULLTEXT implementation of article.SearchIndex (driven adapter).
+// It searches the articles table through its FULLTEXT indexes, which MySQL keeps up to date itself.
+type MySQLSearchIndex struct {
+	db *sql.DB
+}
+
+// NewMySQLSearchIndex creates a new MySQLSearchIndex
+func NewMySQLSearchIndex(db *sql.DB) *MySQLSearchIndex {
+	return &MySQLSearchIndex{db: db}
+}
+
+// Index does nothing, the FULLTEXT indexes follow the articles table
+func (s *MySQLSearchIndex) Index(ctx context.Context, a *domainarticle.Article) error {
+	return nil
+}
+
+// Remove does nothing, the FULLTEXT indexes follow the articles table
+func (s *MySQLSearchIndex) Remove(ctx context.Context, id int64) error {
+	return nil
+}
+
+// Search retrieves a page of the articles the actor may view matching the query by relevance.
+// A match in the title counts three times as much as a match in the content.
+func (s *MySQLSearchIndex) Search(ctx context.Context, q domainarticle.SearchQuery) (*domainarticle.SearchResult, error) {
+	terms := domainarticle.SearchTerms(q.Text)
+	if len(terms) == 0 {
+		return nil, domainarticle.ErrSearchQueryRequired
+	}
+	text := strings.Join(terms, " ")
+
+	where, args := filterClause(q.Actor.ListFilter())
+	where += " AND MATCH(title, content) AGAINST (? IN NATURAL LANGUAGE MODE)"
+	args = append(args, text)
+
+	var total int64
+	err := s.db.QueryRowContext(ctx, `SELECT COUNT(*) FROM articles WHERE `+where, args...).Scan(&total)
+	if err != nil {
+		return nil, err
+	}
+
+	query := `
+		SELECT id, title, slug, content, author_id, org_id, category_id, status, published_at, publish_at, created_at, updated_at, deleted_at,
+			MATCH(title) AGAINST (? IN NATURAL LANGUAGE MODE) * 2 + MATCH(title, content) AGAINST (? IN NATURAL LANGUAGE MODE) AS score
+		FROM articles
+		WHERE ` + where + `
+		ORDER BY score DESC, id DESC
+		LIMIT ? OFFSET ?
+	`
+
+	rows, err := s.db.QueryContext(ctx, query, append(append([]interface{}{text, text}, args...), q.Limit, q.Offset)...)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := rows.Close(); err != nil {
+			log.Printf("Failed to close rows: %v", err)
+		}
+	}()
+
+	result := &domainarticle.SearchResult{Total: total}
+	for rows.Next() {
+		var score float64
+		a, err := scanArticle(rows, &score)
+		if err != nil {
+			return nil, err
+		}
+		result.Hits = append(result.Hits, &domainarticle.SearchHit{
+			Article: a,
+			Score:   score,
+			Snippet: domainarticle.Snippet(a.Content, terms),
+		})
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
@@ -0,0 +1,93 @@
+package article
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	domainarticle "github.com/rulzi/hexa-go/internal/domain/article"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMySQLSearchIndex_Search(t *testing.T) {
+	columns := []string{"id", "title", "slug", "content", "author_id", "org_id", "category_id", "status", "published_at", "publish_at", "created_at", "updated_at", "deleted_at", "score"}
+	reader := domainarticle.Actor{UserID: 2, OrgID: 1}
+
+	tests := []struct {
+		name      string
+		text      string
+		setup     func(mock sqlmock.Sqlmock)
+		wantTotal int64
+		wantHits  int
+		wantErr   error
+	}{
+		{
+			name: "success search",
+			text: "Go, generics!",
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery("SELECT COUNT\\(\\*\\) FROM articles WHERE org_id = \\? AND deleted_at IS NULL AND \\(status = \\? OR author_id = \\?\\) AND MATCH\\(title, content\\) AGAINST \\(\\? IN NATURAL LANGUAGE MODE\\)").
+					WithArgs(int64(1), "published", int64(2), "go generics").
+					WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(12))
+				rows := sqlmock.NewRows(columns).
+					AddRow(5, "Go generics", "go-generics", "Generics landed in Go 1.18", 2, 1, nil, "published", time.Now(), nil, time.Now(), time.Now(), nil, 3.5).
+					AddRow(4, "Type parameters", "type-parameters", "How generics work", 3, 1, 7, "published", time.Now(), nil, time.Now(), time.Now(), nil, 0.8)
+				mock.ExpectQuery("ORDER BY score DESC, id DESC\\s+LIMIT \\? OFFSET \\?").
+					WithArgs("go generics", "go generics", int64(1), "published", int64(2), "go generics", 2, 10).
+					WillReturnRows(rows)
+			},
+			wantTotal: 12,
+			wantHits:  2,
+		},
+		{
+			name:    "no word to look for",
+			text:    "?!",
+			setup:   func(mock sqlmock.Sqlmock) {},
+			wantErr: domainarticle.ErrSearchQueryRequired,
+		},
+		{
+			name: "error on database query",
+			text: "go",
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery("SELECT COUNT\\(\\*\\) FROM articles").
+					WillReturnError(errors.New("database error"))
+			},
+			wantErr: errors.New("database error"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+			}
+			defer func() {
+				mock.ExpectClose()
+				if err := db.Close(); err != nil {
+					t.Fatalf("Failed to close database connection: %v", err)
+				}
+			}()
+
+			index := NewMySQLSearchIndex(db)
+			tt.setup(mock)
+
+			result, err := index.Search(context.Background(), domainarticle.SearchQuery{Text: tt.text, Actor: reader, Limit: 2, Offset: 10})
+
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+				assert.Nil(t, result)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.wantTotal, result.Total)
+				assert.Len(t, result.Hits, tt.wantHits)
+				assert.Equal(t, 3.5, result.Hits[0].Score)
+				assert.Equal(t, "<mark>Generics</mark> landed in <mark>Go</mark> 1.18", result.Hits[0].Snippet)
+				assert.Equal(t, int64(7), *result.Hits[1].Article.CategoryID)
+			}
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
@@ -0,0 +1,220 @@
+package article
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"log"
+
+	"github.com/go-sql-driver/mysql"
+	domainarticle "github.com/rulzi/hexa-go/internal/domain/article"
+)
+
+// MySQLTagRepository is the MySQL implementation of article.TagRepository (driven adapter)
+type MySQLTagRepository struct {
+	db *sql.DB
+}
+
+// NewMySQLTagRepository creates a new MySQLTagRepository
+func NewMySQLTagRepository(db *sql.DB) *MySQLTagRepository {
+	return &MySQLTagRepository{db: db}
+}
+
+// Create creates a new tag
+func (r *MySQLTagRepository) Create(ctx context.Context, t *domainarticle.Tag) (*domainarticle.Tag, error) {
+	query := `INSERT INTO tags (org_id, name, slug, created_at, updated_at, created_by, updated_by) VALUES (?, ?, ?, ?, ?, ?, ?)`
+
+	result, err := r.db.ExecContext(ctx, query, t.OrgID, t.Name, t.Slug, t.CreatedAt, t.UpdatedAt, t.CreatedBy, t.UpdatedBy)
+	if err != nil {
+		return nil, mapDuplicate(err, domainarticle.ErrTagExists)
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return nil, err
+	}
+
+	t.ID = id
+	return t, nil
+}
+
+// GetByID retrieves a tag of an organization by ID
+func (r *MySQLTagRepository) GetByID(ctx context.Context, orgID, id int64) (*domainarticle.Tag, error) {
+	query := `SELECT id, org_id, name, slug, created_at, updated_at FROM tags WHERE id = ? AND org_id = ?`
+
+	t := &domainarticle.Tag{}
+	err := r.db.QueryRowContext(ctx, query, id, orgID).Scan(&t.ID, &t.OrgID, &t.Name, &t.Slug, &t.CreatedAt, &t.UpdatedAt)
+	if err == sql.ErrNoRows {
+		return nil, domainarticle.ErrTagNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return t, nil
+}
+
+// GetByIDs retrieves the tags of an organization among the given IDs
+func (r *MySQLTagRepository) GetByIDs(ctx context.Context, orgID int64, ids []int64) ([]*domainarticle.Tag, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	query := `SELECT id, org_id, name, slug, created_at, updated_at FROM tags WHERE org_id = ? AND id IN (` + placeholders(len(ids)) + `) ORDER BY name ASC`
+	args := []interface{}{orgID}
+	for _, id := range ids {
+		args = append(args, id)
+	}
+
+	return r.queryTags(ctx, query, args...)
+}
+
+// Update renames a tag within its organization
+func (r *MySQLTagRepository) Update(ctx context.Context, t *domainarticle.Tag) (*domainarticle.Tag, error) {
+	query := `UPDATE tags SET name = ?, slug = ?, updated_at = ?, updated_by = ? WHERE id = ? AND org_id = ?`
+
+	_, err := r.db.ExecContext(ctx, query, t.Name, t.Slug, t.UpdatedAt, t.UpdatedBy, t.ID, t.OrgID)
+	if err != nil {
+		return nil, mapDuplicate(err, domainarticle.ErrTagExists)
+	}
+
+	return t, nil
+}
+
+// Delete deletes a tag of an organization, the article_tags rows go with it
+func (r *MySQLTagRepository) Delete(ctx context.Context, orgID, id int64) error {
+	query := `DELETE FROM tags WHERE id = ? AND org_id = ?`
+
+	result, err := r.db.ExecContext(ctx, query, id, orgID)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return domainarticle.ErrTagNotFound
+	}
+
+	return nil
+}
+
+// List retrieves the tags of an organization, each counting the articles selected by the filter
+func (r *MySQLTagRepository) List(ctx context.Context, filter domainarticle.ListFilter) ([]*domainarticle.TagCount, error) {
+	filter.TagID = 0
+	where, args := filterClause(filter)
+	query := `
+		SELECT t.id, t.org_id, t.name, t.slug, t.created_at, t.updated_at,
+			(SELECT COUNT(*) FROM articles WHERE ` + where + ` AND id IN (SELECT article_id FROM article_tags WHERE tag_id = t.id)) AS article_count
+		FROM tags t
+		WHERE t.org_id = ?
+		ORDER BY t.name ASC
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, append(args, filter.OrgID)...)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := rows.Close(); err != nil {
+			log.Printf("Failed to close rows: %v", err)
+		}
+	}()
+
+	var tags []*domainarticle.TagCount
+	for rows.Next() {
+		t := &domainarticle.Tag{}
+		count := &domainarticle.TagCount{Tag: t}
+		if err := rows.Scan(&t.ID, &t.OrgID, &t.Name, &t.Slug, &t.CreatedAt, &t.UpdatedAt, &count.ArticleCount); err != nil {
+			return nil, err
+		}
+		tags = append(tags, count)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return tags, nil
+}
+
+// ListByArticle retrieves the tags of an article
+func (r *MySQLTagRepository) ListByArticle(ctx context.Context, orgID, articleID int64) ([]*domainarticle.Tag, error) {
+	query := `
+		SELECT t.id, t.org_id, t.name, t.slug, t.created_at, t.updated_at
+		FROM tags t
+		INNER JOIN article_tags at ON at.tag_id = t.id
+		WHERE t.org_id = ? AND at.article_id = ?
+		ORDER BY t.name ASC
+	`
+
+	return r.queryTags(ctx, query, orgID, articleID)
+}
+
+// SetArticleTags replaces the tags of an article in a single transaction
+func (r *MySQLTagRepository) SetArticleTags(ctx context.Context, articleID int64, tagIDs []int64) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer rollback(tx)
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM article_tags WHERE article_id = ?`, articleID); err != nil {
+		return err
+	}
+
+	for _, tagID := range tagIDs {
+		if _, err := tx.ExecContext(ctx, `INSERT INTO article_tags (article_id, tag_id) VALUES (?, ?)`, articleID, tagID); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+// queryTags runs a query selecting tags
+func (r *MySQLTagRepository) queryTags(ctx context.Context, query string, args ...interface{}) ([]*domainarticle.Tag, error) {
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := rows.Close(); err != nil {
+			log.Printf("Failed to close rows: %v", err)
+		}
+	}()
+
+	var tags []*domainarticle.Tag
+	for rows.Next() {
+		t := &domainarticle.Tag{}
+		if err := rows.Scan(&t.ID, &t.OrgID, &t.Name, &t.Slug, &t.CreatedAt, &t.UpdatedAt); err != nil {
+			return nil, err
+		}
+		tags = append(tags, t)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return tags, nil
+}
+
+// mapDuplicate turns a unique key violation into the given domain error
+func mapDuplicate(err, duplicate error) error {
+	var mysqlErr *mysql.MySQLError
+	if errors.As(err, &mysqlErr) && mysqlErr.Number == 1062 {
+		return duplicate
+	}
+	return err
+}
+
+// rollback rolls back a transaction that was not committed
+func rollback(tx *sql.Tx) {
+	if err := tx.Rollback(); err != nil && err != sql.ErrTxDone {
+		log.Printf("Failed to rollback transaction: %v", err)
+	}
+}
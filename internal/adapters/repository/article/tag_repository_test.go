@@ -0,0 +1,241 @@
+package article
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-sql-driver/mysql"
+	domainarticle "github.com/rulzi/hexa-go/internal/domain/article"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMySQLTagRepository_Create(t *testing.T) {
+	tests := []struct {
+		name    string
+		setup   func(mock sqlmock.Sqlmock)
+		wantErr error
+	}{
+		{
+			name: "success create tag",
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec("INSERT INTO tags").
+					WithArgs(int64(1), "Sport", "sport", sqlmock.AnyArg(), sqlmock.AnyArg(), int64(3), int64(3)).
+					WillReturnResult(sqlmock.NewResult(4, 1))
+			},
+		},
+		{
+			name: "duplicate slug",
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec("INSERT INTO tags").
+					WillReturnError(&mysql.MySQLError{Number: 1062, Message: "Duplicate entry"})
+			},
+			wantErr: domainarticle.ErrTagExists,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+			}
+			defer func() {
+				mock.ExpectClose()
+				if err := db.Close(); err != nil {
+					t.Fatalf("Failed to close database connection: %v", err)
+				}
+			}()
+
+			repo := NewMySQLTagRepository(db)
+			tt.setup(mock)
+
+			tag, err := repo.Create(context.Background(), &domainarticle.Tag{OrgID: 1, Name: "Sport", Slug: "sport", CreatedAt: time.Now(), UpdatedAt: time.Now(), CreatedBy: 3, UpdatedBy: 3})
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.Nil(t, tag)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, int64(4), tag.ID)
+			}
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestMySQLTagRepository_Delete(t *testing.T) {
+	tests := []struct {
+		name    string
+		setup   func(mock sqlmock.Sqlmock)
+		wantErr error
+	}{
+		{
+			name: "success delete tag",
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec("DELETE FROM tags WHERE id = \\? AND org_id = \\?").
+					WithArgs(int64(4), int64(1)).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+		},
+		{
+			name: "tag not found",
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec("DELETE FROM tags WHERE id = \\? AND org_id = \\?").
+					WithArgs(int64(4), int64(1)).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+			},
+			wantErr: domainarticle.ErrTagNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+			}
+			defer func() {
+				mock.ExpectClose()
+				if err := db.Close(); err != nil {
+					t.Fatalf("Failed to close database connection: %v", err)
+				}
+			}()
+
+			repo := NewMySQLTagRepository(db)
+			tt.setup(mock)
+
+			err = repo.Delete(context.Background(), 1, 4)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestMySQLTagRepository_List(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer func() {
+		mock.ExpectClose()
+		if err := db.Close(); err != nil {
+			t.Fatalf("Failed to close database connection: %v", err)
+		}
+	}()
+
+	repo := NewMySQLTagRepository(db)
+	rows := sqlmock.NewRows([]string{"id", "org_id", "name", "slug", "created_at", "updated_at", "article_count"}).
+		AddRow(2, 1, "Football", "football", time.Now(), time.Now(), 3).
+		AddRow(1, 1, "Sport", "sport", time.Now(), time.Now(), 0)
+	// The tag filter is dropped: each tag counts its own articles
+	mock.ExpectQuery("SELECT t.id, t.org_id, t.name, t.slug, t.created_at, t.updated_at,\\s+\\(SELECT COUNT\\(\\*\\) FROM articles WHERE org_id = \\? AND deleted_at IS NULL AND \\(status = \\? OR author_id = \\?\\) AND category_id IN \\(\\?\\) AND id IN \\(SELECT article_id FROM article_tags WHERE tag_id = t.id\\)\\) AS article_count").
+		WithArgs(int64(1), domainarticle.StatusPublished, int64(7), int64(3), int64(1)).
+		WillReturnRows(rows)
+
+	filter := domainarticle.ListFilter{OrgID: 1, AuthorID: 7, TagID: 2, CategoryIDs: []int64{3}}
+	tags, err := repo.List(context.Background(), filter)
+
+	assert.NoError(t, err)
+	assert.Len(t, tags, 2)
+	assert.Equal(t, "football", tags[0].Tag.Slug)
+	assert.Equal(t, int64(3), tags[0].ArticleCount)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestMySQLTagRepository_GetByIDs_Empty(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer func() {
+		mock.ExpectClose()
+		if err := db.Close(); err != nil {
+			t.Fatalf("Failed to close database connection: %v", err)
+		}
+	}()
+
+	repo := NewMySQLTagRepository(db)
+
+	tags, err := repo.GetByIDs(context.Background(), 1, nil)
+
+	assert.NoError(t, err)
+	assert.Empty(t, tags)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestMySQLTagRepository_SetArticleTags(t *testing.T) {
+	tests := []struct {
+		name    string
+		setup   func(mock sqlmock.Sqlmock)
+		wantErr bool
+	}{
+		{
+			name: "success replace tags",
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec("DELETE FROM article_tags WHERE article_id = \\?").
+					WithArgs(int64(9)).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec("INSERT INTO article_tags").
+					WithArgs(int64(9), int64(1)).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec("INSERT INTO article_tags").
+					WithArgs(int64(9), int64(2)).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name: "insert error rolls back",
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec("DELETE FROM article_tags WHERE article_id = \\?").
+					WithArgs(int64(9)).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec("INSERT INTO article_tags").
+					WithArgs(int64(9), int64(1)).
+					WillReturnError(errors.New("database error"))
+				mock.ExpectRollback()
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+			}
+			defer func() {
+				mock.ExpectClose()
+				if err := db.Close(); err != nil {
+					t.Fatalf("Failed to close database connection: %v", err)
+				}
+			}()
+
+			repo := NewMySQLTagRepository(db)
+			tt.setup(mock)
+
+			err = repo.SetArticleTags(context.Background(), 9, []int64{1, 2})
+
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
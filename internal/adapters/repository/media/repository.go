@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"log"
+	"time"
 
 	domainmedia "github.com/rulzi/hexa-go/internal/domain/media"
 )
@@ -21,11 +22,17 @@ func NewMySQLRepository(db *sql.DB) *MySQLRepository {
 // Create creates a new media
 func (r *MySQLRepository) Create(ctx context.Context, m *domainmedia.Media) (*domainmedia.Media, error) {
 	query := `
-		INSERT INTO media (name, path, created_at, updated_at)
-		VALUES (?, ?, ?, ?)
+		INSERT INTO media (owner_id, org_id, name, path, created_at, updated_at, created_by, updated_by)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?)
 	`
 
-	result, err := r.db.ExecContext(ctx, query, m.Name, m.Path, m.CreatedAt, m.UpdatedAt)
+	// Media created without an uploader are not linked to a user
+	var ownerID sql.NullInt64
+	if m.OwnerID != 0 {
+		ownerID = sql.NullInt64{Int64: m.OwnerID, Valid: true}
+	}
+
+	result, err := r.db.ExecContext(ctx, query, ownerID, m.OrgID, m.Name, m.Path, m.CreatedAt, m.UpdatedAt, m.CreatedBy, m.UpdatedBy)
 	if err != nil {
 		return nil, err
 	}
@@ -39,17 +46,46 @@ func (r *MySQLRepository) Create(ctx context.Context, m *domainmedia.Media) (*do
 	return m, nil
 }
 
-// GetByID retrieves a media by ID
-func (r *MySQLRepository) GetByID(ctx context.Context, id int64) (*domainmedia.Media, error) {
+// GetByID retrieves a media of an organization by ID
+func (r *MySQLRepository) GetByID(ctx context.Context, orgID, id int64) (*domainmedia.Media, error) {
+	query := `
+		SELECT id, org_id, name, path, created_at, updated_at
+		FROM media
+		WHERE id = ? AND org_id = ? AND deleted_at IS NULL
+	`
+
+	m := &domainmedia.Media{}
+	err := r.db.QueryRowContext(ctx, query, id, orgID).Scan(
+		&m.ID,
+		&m.OrgID,
+		&m.Name,
+		&m.Path,
+		&m.CreatedAt,
+		&m.UpdatedAt,
+	)
+
+	if err == sql.ErrNoRows {
+		return nil, domainmedia.ErrMediaNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// GetByPath retrieves the media stored at a path in any organization, media in the trash are not found
+func (r *MySQLRepository) GetByPath(ctx context.Context, path string) (*domainmedia.Media, error) {
 	query := `
-		SELECT id, name, path, created_at, updated_at
+		SELECT id, org_id, name, path, created_at, updated_at
 		FROM media
-		WHERE id = ?
+		WHERE path = ? AND deleted_at IS NULL
 	`
 
 	m := &domainmedia.Media{}
-	err := r.db.QueryRowContext(ctx, query, id).Scan(
+	err := r.db.QueryRowContext(ctx, query, path).Scan(
 		&m.ID,
+		&m.OrgID,
 		&m.Name,
 		&m.Path,
 		&m.CreatedAt,
@@ -70,11 +106,11 @@ func (r *MySQLRepository) GetByID(ctx context.Context, id int64) (*domainmedia.M
 func (r *MySQLRepository) Update(ctx context.Context, m *domainmedia.Media) (*domainmedia.Media, error) {
 	query := `
 		UPDATE media
-		SET name = ?, path = ?, updated_at = ?
-		WHERE id = ?
+		SET name = ?, path = ?, updated_at = ?, updated_by = ?
+		WHERE id = ? AND org_id = ? AND deleted_at IS NULL
 	`
 
-	_, err := r.db.ExecContext(ctx, query, m.Name, m.Path, m.UpdatedAt, m.ID)
+	_, err := r.db.ExecContext(ctx, query, m.Name, m.Path, m.UpdatedAt, m.UpdatedBy, m.ID, m.OrgID)
 	if err != nil {
 		return nil, err
 	}
@@ -82,11 +118,11 @@ func (r *MySQLRepository) Update(ctx context.Context, m *domainmedia.Media) (*do
 	return m, nil
 }
 
-// Delete deletes a media by ID
-func (r *MySQLRepository) Delete(ctx context.Context, id int64) error {
-	query := `DELETE FROM media WHERE id = ?`
+// Delete moves a media of an organization to the trash by setting deleted_at
+func (r *MySQLRepository) Delete(ctx context.Context, orgID, id int64) error {
+	query := `UPDATE media SET deleted_at = ? WHERE id = ? AND org_id = ? AND deleted_at IS NULL`
 
-	result, err := r.db.ExecContext(ctx, query, id)
+	result, err := r.db.ExecContext(ctx, query, time.Now(), id, orgID)
 	if err != nil {
 		return err
 	}
@@ -103,16 +139,17 @@ func (r *MySQLRepository) Delete(ctx context.Context, id int64) error {
 	return nil
 }
 
-// List retrieves all media with pagination
-func (r *MySQLRepository) List(ctx context.Context, limit, offset int) ([]*domainmedia.Media, error) {
+// List retrieves the media of an organization with pagination
+func (r *MySQLRepository) List(ctx context.Context, orgID int64, limit, offset int) ([]*domainmedia.Media, error) {
 	query := `
-		SELECT id, name, path, created_at, updated_at
+		SELECT id, org_id, name, path, created_at, updated_at
 		FROM media
+		WHERE org_id = ? AND deleted_at IS NULL
 		ORDER BY created_at DESC
 		LIMIT ? OFFSET ?
 	`
 
-	rows, err := r.db.QueryContext(ctx, query, limit, offset)
+	rows, err := r.db.QueryContext(ctx, query, orgID, limit, offset)
 	if err != nil {
 		return nil, err
 	}
@@ -127,6 +164,7 @@ func (r *MySQLRepository) List(ctx context.Context, limit, offset int) ([]*domai
 		m := &domainmedia.Media{}
 		err := rows.Scan(
 			&m.ID,
+			&m.OrgID,
 			&m.Name,
 			&m.Path,
 			&m.CreatedAt,
@@ -145,15 +183,203 @@ func (r *MySQLRepository) List(ctx context.Context, limit, offset int) ([]*domai
 	return mediaList, nil
 }
 
-// Count returns the total number of media
-func (r *MySQLRepository) Count(ctx context.Context) (int64, error) {
-	query := `SELECT COUNT(*) FROM media`
+// Count returns the number of media of an organization
+func (r *MySQLRepository) Count(ctx context.Context, orgID int64) (int64, error) {
+	query := `SELECT COUNT(*) FROM media WHERE org_id = ? AND deleted_at IS NULL`
+
+	var count int64
+	err := r.db.QueryRowContext(ctx, query, orgID).Scan(&count)
+	if err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+// Restore takes a media of an organization out of the trash
+func (r *MySQLRepository) Restore(ctx context.Context, orgID, id int64) error {
+	query := `UPDATE media SET deleted_at = NULL WHERE id = ? AND org_id = ? AND deleted_at IS NOT NULL`
+
+	result, err := r.db.ExecContext(ctx, query, id, orgID)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return domainmedia.ErrMediaNotFound
+	}
+
+	return nil
+}
+
+// ListDeleted retrieves the media in the trash of an organization with pagination, most recently deleted first
+func (r *MySQLRepository) ListDeleted(ctx context.Context, orgID int64, limit, offset int) ([]*domainmedia.Media, error) {
+	query := `
+		SELECT id, org_id, name, path, created_at, updated_at, deleted_at
+		FROM media
+		WHERE org_id = ? AND deleted_at IS NOT NULL
+		ORDER BY deleted_at DESC
+		LIMIT ? OFFSET ?
+	`
+
+	return r.queryDeleted(ctx, query, orgID, limit, offset)
+}
+
+// CountDeleted returns the number of media in the trash of an organization
+func (r *MySQLRepository) CountDeleted(ctx context.Context, orgID int64) (int64, error) {
+	query := `SELECT COUNT(*) FROM media WHERE org_id = ? AND deleted_at IS NOT NULL`
 
 	var count int64
-	err := r.db.QueryRowContext(ctx, query).Scan(&count)
+	err := r.db.QueryRowContext(ctx, query, orgID).Scan(&count)
 	if err != nil {
 		return 0, err
 	}
 
 	return count, nil
 }
+
+// ListDeletedBefore retrieves up to limit media moved to the trash before the given time, oldest first
+func (r *MySQLRepository) ListDeletedBefore(ctx context.Context, before time.Time, limit int) ([]*domainmedia.Media, error) {
+	query := `
+		SELECT id, org_id, name, path, created_at, updated_at, deleted_at
+		FROM media
+		WHERE deleted_at IS NOT NULL AND deleted_at < ?
+		ORDER BY deleted_at ASC
+		LIMIT ?
+	`
+
+	return r.queryDeleted(ctx, query, before, limit)
+}
+
+// Purge permanently deletes a media that is in the trash
+func (r *MySQLRepository) Purge(ctx context.Context, id int64) error {
+	query := `DELETE FROM media WHERE id = ? AND deleted_at IS NOT NULL`
+
+	result, err := r.db.ExecContext(ctx, query, id)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return domainmedia.ErrMediaNotFound
+	}
+
+	return nil
+}
+
+// ListAllByOwner retrieves the media uploaded by a user with pagination, including the media in the trash
+func (r *MySQLRepository) ListAllByOwner(ctx context.Context, ownerID int64, limit, offset int) ([]*domainmedia.Media, error) {
+	query := `
+		SELECT id, owner_id, org_id, name, path, created_at, updated_at, deleted_at
+		FROM media
+		WHERE owner_id = ?
+		ORDER BY id ASC
+		LIMIT ? OFFSET ?
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, ownerID, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := rows.Close(); err != nil {
+			log.Printf("Failed to close rows: %v", err)
+		}
+	}()
+
+	var mediaList []*domainmedia.Media
+	for rows.Next() {
+		m := &domainmedia.Media{}
+		var deletedAt sql.NullTime
+		err := rows.Scan(
+			&m.ID,
+			&m.OwnerID,
+			&m.OrgID,
+			&m.Name,
+			&m.Path,
+			&m.CreatedAt,
+			&m.UpdatedAt,
+			&deletedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		if deletedAt.Valid {
+			m.DeletedAt = &deletedAt.Time
+		}
+		mediaList = append(mediaList, m)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return mediaList, nil
+}
+
+// ReassignOwner moves every media of a user in an organization, including the media in the trash,
+// to another user. orgID zero covers every organization.
+func (r *MySQLRepository) ReassignOwner(ctx context.Context, orgID, fromID, toID int64) (int64, error) {
+	query := `UPDATE media SET owner_id = ? WHERE owner_id = ?`
+	args := []interface{}{toID, fromID}
+	if orgID != 0 {
+		query += ` AND org_id = ?`
+		args = append(args, orgID)
+	}
+
+	result, err := r.db.ExecContext(ctx, query, args...)
+	if err != nil {
+		return 0, err
+	}
+
+	return result.RowsAffected()
+}
+
+// queryDeleted runs a query selecting trashed media rows including deleted_at
+func (r *MySQLRepository) queryDeleted(ctx context.Context, query string, args ...interface{}) ([]*domainmedia.Media, error) {
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := rows.Close(); err != nil {
+			log.Printf("Failed to close rows: %v", err)
+		}
+	}()
+
+	var mediaList []*domainmedia.Media
+	for rows.Next() {
+		m := &domainmedia.Media{}
+		var deletedAt time.Time
+		err := rows.Scan(
+			&m.ID,
+			&m.OrgID,
+			&m.Name,
+			&m.Path,
+			&m.CreatedAt,
+			&m.UpdatedAt,
+			&deletedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		m.DeletedAt = &deletedAt
+		mediaList = append(mediaList, m)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return mediaList, nil
+}
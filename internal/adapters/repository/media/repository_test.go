@@ -40,6 +40,7 @@ func TestMySQLRepository_Create(t *testing.T) {
 		{
 			name: "success create media",
 			media: &domainmedia.Media{
+				OrgID:     1,
 				Name:      "test-image.jpg",
 				Path:      "/storage/2025/12/19/test-image.jpg",
 				CreatedAt: time.Now(),
@@ -47,7 +48,7 @@ func TestMySQLRepository_Create(t *testing.T) {
 			},
 			setup: func(mock sqlmock.Sqlmock) {
 				mock.ExpectExec("INSERT INTO media").
-					WithArgs("test-image.jpg", "/storage/2025/12/19/test-image.jpg", sqlmock.AnyArg(), sqlmock.AnyArg()).
+					WithArgs(sql.NullInt64{}, int64(1), "test-image.jpg", "/storage/2025/12/19/test-image.jpg", sqlmock.AnyArg(), sqlmock.AnyArg(), int64(0), int64(0)).
 					WillReturnResult(sqlmock.NewResult(1, 1))
 			},
 			wantErr: false,
@@ -57,9 +58,33 @@ func TestMySQLRepository_Create(t *testing.T) {
 				assert.Equal(t, "/storage/2025/12/19/test-image.jpg", media.Path)
 			},
 		},
+		{
+			name: "success create media with owner",
+			media: &domainmedia.Media{
+				OwnerID:   7,
+				OrgID:     1,
+				Name:      "test-image.jpg",
+				Path:      "/storage/2025/12/19/test-image.jpg",
+				CreatedAt: time.Now(),
+				UpdatedAt: time.Now(),
+				CreatedBy: 3,
+				UpdatedBy: 3,
+			},
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec("INSERT INTO media \\(owner_id").
+					WithArgs(sql.NullInt64{Int64: 7, Valid: true}, int64(1), "test-image.jpg", "/storage/2025/12/19/test-image.jpg", sqlmock.AnyArg(), sqlmock.AnyArg(), int64(3), int64(3)).
+					WillReturnResult(sqlmock.NewResult(2, 1))
+			},
+			wantErr: false,
+			check: func(t *testing.T, media *domainmedia.Media) {
+				assert.Equal(t, int64(2), media.ID)
+				assert.Equal(t, int64(7), media.OwnerID)
+			},
+		},
 		{
 			name: "error on database exec",
 			media: &domainmedia.Media{
+				OrgID:     1,
 				Name:      "test-image.jpg",
 				Path:      "/storage/2025/12/19/test-image.jpg",
 				CreatedAt: time.Now(),
@@ -67,7 +92,7 @@ func TestMySQLRepository_Create(t *testing.T) {
 			},
 			setup: func(mock sqlmock.Sqlmock) {
 				mock.ExpectExec("INSERT INTO media").
-					WithArgs("test-image.jpg", "/storage/2025/12/19/test-image.jpg", sqlmock.AnyArg(), sqlmock.AnyArg()).
+					WithArgs(sql.NullInt64{}, int64(1), "test-image.jpg", "/storage/2025/12/19/test-image.jpg", sqlmock.AnyArg(), sqlmock.AnyArg(), int64(0), int64(0)).
 					WillReturnError(errors.New("database error"))
 			},
 			wantErr: true,
@@ -75,6 +100,7 @@ func TestMySQLRepository_Create(t *testing.T) {
 		{
 			name: "error on last insert id",
 			media: &domainmedia.Media{
+				OrgID:     1,
 				Name:      "test-image.jpg",
 				Path:      "/storage/2025/12/19/test-image.jpg",
 				CreatedAt: time.Now(),
@@ -82,7 +108,7 @@ func TestMySQLRepository_Create(t *testing.T) {
 			},
 			setup: func(mock sqlmock.Sqlmock) {
 				mock.ExpectExec("INSERT INTO media").
-					WithArgs("test-image.jpg", "/storage/2025/12/19/test-image.jpg", sqlmock.AnyArg(), sqlmock.AnyArg()).
+					WithArgs(sql.NullInt64{}, int64(1), "test-image.jpg", "/storage/2025/12/19/test-image.jpg", sqlmock.AnyArg(), sqlmock.AnyArg(), int64(0), int64(0)).
 					WillReturnResult(sqlmock.NewErrorResult(errors.New("last insert id error")))
 			},
 			wantErr: true,
@@ -135,10 +161,10 @@ func TestMySQLRepository_GetByID(t *testing.T) {
 			name: "success get media by id",
 			id:   1,
 			setup: func(mock sqlmock.Sqlmock) {
-				rows := sqlmock.NewRows([]string{"id", "name", "path", "created_at", "updated_at"}).
-					AddRow(1, "test-image.jpg", "/storage/2025/12/19/test-image.jpg", time.Now(), time.Now())
-				mock.ExpectQuery("SELECT id, name, path, created_at, updated_at").
-					WithArgs(1).
+				rows := sqlmock.NewRows([]string{"id", "org_id", "name", "path", "created_at", "updated_at"}).
+					AddRow(1, 1, "test-image.jpg", "/storage/2025/12/19/test-image.jpg", time.Now(), time.Now())
+				mock.ExpectQuery("SELECT id, org_id, name, path, created_at, updated_at").
+					WithArgs(1, 1).
 					WillReturnRows(rows)
 			},
 			wantErr: false,
@@ -152,8 +178,8 @@ func TestMySQLRepository_GetByID(t *testing.T) {
 			name: "media not found",
 			id:   999,
 			setup: func(mock sqlmock.Sqlmock) {
-				mock.ExpectQuery("SELECT id, name, path, created_at, updated_at").
-					WithArgs(999).
+				mock.ExpectQuery("SELECT id, org_id, name, path, created_at, updated_at").
+					WithArgs(999, 1).
 					WillReturnError(sql.ErrNoRows)
 			},
 			wantErr: true,
@@ -165,8 +191,8 @@ func TestMySQLRepository_GetByID(t *testing.T) {
 			name: "database error",
 			id:   1,
 			setup: func(mock sqlmock.Sqlmock) {
-				mock.ExpectQuery("SELECT id, name, path, created_at, updated_at").
-					WithArgs(1).
+				mock.ExpectQuery("SELECT id, org_id, name, path, created_at, updated_at").
+					WithArgs(1, 1).
 					WillReturnError(errors.New("database error"))
 			},
 			wantErr: true,
@@ -189,7 +215,7 @@ func TestMySQLRepository_GetByID(t *testing.T) {
 			repo := NewMySQLRepository(db)
 			tt.setup(mock)
 
-			result, err := repo.GetByID(context.Background(), tt.id)
+			result, err := repo.GetByID(context.Background(), 1, tt.id)
 
 			if tt.wantErr {
 				assert.Error(t, err)
@@ -226,13 +252,14 @@ func TestMySQLRepository_Update(t *testing.T) {
 			name: "success update media",
 			media: &domainmedia.Media{
 				ID:        1,
+				OrgID:     1,
 				Name:      "updated-image.jpg",
 				Path:      "/storage/2025/12/19/updated-image.jpg",
 				UpdatedAt: time.Now(),
 			},
 			setup: func(mock sqlmock.Sqlmock) {
 				mock.ExpectExec("UPDATE media").
-					WithArgs("updated-image.jpg", "/storage/2025/12/19/updated-image.jpg", sqlmock.AnyArg(), 1).
+					WithArgs("updated-image.jpg", "/storage/2025/12/19/updated-image.jpg", sqlmock.AnyArg(), int64(0), 1, 1).
 					WillReturnResult(sqlmock.NewResult(0, 1))
 			},
 			wantErr: false,
@@ -246,13 +273,14 @@ func TestMySQLRepository_Update(t *testing.T) {
 			name: "error on database exec",
 			media: &domainmedia.Media{
 				ID:        1,
+				OrgID:     1,
 				Name:      "updated-image.jpg",
 				Path:      "/storage/2025/12/19/updated-image.jpg",
 				UpdatedAt: time.Now(),
 			},
 			setup: func(mock sqlmock.Sqlmock) {
 				mock.ExpectExec("UPDATE media").
-					WithArgs("updated-image.jpg", "/storage/2025/12/19/updated-image.jpg", sqlmock.AnyArg(), 1).
+					WithArgs("updated-image.jpg", "/storage/2025/12/19/updated-image.jpg", sqlmock.AnyArg(), int64(0), 1, 1).
 					WillReturnError(errors.New("database error"))
 			},
 			wantErr: true,
@@ -304,8 +332,8 @@ func TestMySQLRepository_Delete(t *testing.T) {
 			name: "success delete media",
 			id:   1,
 			setup: func(mock sqlmock.Sqlmock) {
-				mock.ExpectExec("DELETE FROM media").
-					WithArgs(1).
+				mock.ExpectExec("UPDATE media SET deleted_at").
+					WithArgs(sqlmock.AnyArg(), 1, 1).
 					WillReturnResult(sqlmock.NewResult(0, 1))
 			},
 			wantErr: false,
@@ -314,8 +342,8 @@ func TestMySQLRepository_Delete(t *testing.T) {
 			name: "media not found",
 			id:   999,
 			setup: func(mock sqlmock.Sqlmock) {
-				mock.ExpectExec("DELETE FROM media").
-					WithArgs(999).
+				mock.ExpectExec("UPDATE media SET deleted_at").
+					WithArgs(sqlmock.AnyArg(), 999, 1).
 					WillReturnResult(sqlmock.NewResult(0, 0))
 			},
 			wantErr: true,
@@ -324,8 +352,8 @@ func TestMySQLRepository_Delete(t *testing.T) {
 			name: "error on database exec",
 			id:   1,
 			setup: func(mock sqlmock.Sqlmock) {
-				mock.ExpectExec("DELETE FROM media").
-					WithArgs(1).
+				mock.ExpectExec("UPDATE media SET deleted_at").
+					WithArgs(sqlmock.AnyArg(), 1, 1).
 					WillReturnError(errors.New("database error"))
 			},
 			wantErr: true,
@@ -334,8 +362,8 @@ func TestMySQLRepository_Delete(t *testing.T) {
 			name: "error on rows affected",
 			id:   1,
 			setup: func(mock sqlmock.Sqlmock) {
-				mock.ExpectExec("DELETE FROM media").
-					WithArgs(1).
+				mock.ExpectExec("UPDATE media SET deleted_at").
+					WithArgs(sqlmock.AnyArg(), 1, 1).
 					WillReturnResult(sqlmock.NewErrorResult(errors.New("rows affected error")))
 			},
 			wantErr: true,
@@ -358,7 +386,7 @@ func TestMySQLRepository_Delete(t *testing.T) {
 			repo := NewMySQLRepository(db)
 			tt.setup(mock)
 
-			err = repo.Delete(context.Background(), tt.id)
+			err = repo.Delete(context.Background(), 1, tt.id)
 
 			if tt.wantErr {
 				assert.Error(t, err)
@@ -388,11 +416,11 @@ func TestMySQLRepository_List(t *testing.T) {
 			limit:  10,
 			offset: 0,
 			setup: func(mock sqlmock.Sqlmock) {
-				rows := sqlmock.NewRows([]string{"id", "name", "path", "created_at", "updated_at"}).
-					AddRow(1, "image1.jpg", "/storage/2025/12/19/image1.jpg", time.Now(), time.Now()).
-					AddRow(2, "image2.jpg", "/storage/2025/12/19/image2.jpg", time.Now(), time.Now())
-				mock.ExpectQuery("SELECT id, name, path, created_at, updated_at").
-					WithArgs(10, 0).
+				rows := sqlmock.NewRows([]string{"id", "org_id", "name", "path", "created_at", "updated_at"}).
+					AddRow(1, 1, "image1.jpg", "/storage/2025/12/19/image1.jpg", time.Now(), time.Now()).
+					AddRow(2, 1, "image2.jpg", "/storage/2025/12/19/image2.jpg", time.Now(), time.Now())
+				mock.ExpectQuery("SELECT id, org_id, name, path, created_at, updated_at").
+					WithArgs(1, 10, 0).
 					WillReturnRows(rows)
 			},
 			wantErr: false,
@@ -411,9 +439,9 @@ func TestMySQLRepository_List(t *testing.T) {
 			limit:  10,
 			offset: 0,
 			setup: func(mock sqlmock.Sqlmock) {
-				rows := sqlmock.NewRows([]string{"id", "name", "path", "created_at", "updated_at"})
-				mock.ExpectQuery("SELECT id, name, path, created_at, updated_at").
-					WithArgs(10, 0).
+				rows := sqlmock.NewRows([]string{"id", "org_id", "name", "path", "created_at", "updated_at"})
+				mock.ExpectQuery("SELECT id, org_id, name, path, created_at, updated_at").
+					WithArgs(1, 10, 0).
 					WillReturnRows(rows)
 			},
 			wantErr: false,
@@ -429,8 +457,8 @@ func TestMySQLRepository_List(t *testing.T) {
 			limit:  10,
 			offset: 0,
 			setup: func(mock sqlmock.Sqlmock) {
-				mock.ExpectQuery("SELECT id, name, path, created_at, updated_at").
-					WithArgs(10, 0).
+				mock.ExpectQuery("SELECT id, org_id, name, path, created_at, updated_at").
+					WithArgs(1, 10, 0).
 					WillReturnError(errors.New("database error"))
 			},
 			wantErr: true,
@@ -440,10 +468,10 @@ func TestMySQLRepository_List(t *testing.T) {
 			limit:  10,
 			offset: 0,
 			setup: func(mock sqlmock.Sqlmock) {
-				rows := sqlmock.NewRows([]string{"id", "name", "path", "created_at", "updated_at"}).
-					AddRow("invalid", "image1.jpg", "/storage/2025/12/19/image1.jpg", time.Now(), time.Now())
-				mock.ExpectQuery("SELECT id, name, path, created_at, updated_at").
-					WithArgs(10, 0).
+				rows := sqlmock.NewRows([]string{"id", "org_id", "name", "path", "created_at", "updated_at"}).
+					AddRow("invalid", 1, "image1.jpg", "/storage/2025/12/19/image1.jpg", time.Now(), time.Now())
+				mock.ExpectQuery("SELECT id, org_id, name, path, created_at, updated_at").
+					WithArgs(1, 10, 0).
 					WillReturnRows(rows)
 			},
 			wantErr: true,
@@ -453,11 +481,11 @@ func TestMySQLRepository_List(t *testing.T) {
 			limit:  10,
 			offset: 0,
 			setup: func(mock sqlmock.Sqlmock) {
-				rows := sqlmock.NewRows([]string{"id", "name", "path", "created_at", "updated_at"}).
-					AddRow(1, "image1.jpg", "/storage/2025/12/19/image1.jpg", time.Now(), time.Now()).
+				rows := sqlmock.NewRows([]string{"id", "org_id", "name", "path", "created_at", "updated_at"}).
+					AddRow(1, 1, "image1.jpg", "/storage/2025/12/19/image1.jpg", time.Now(), time.Now()).
 					RowError(0, errors.New("row error"))
-				mock.ExpectQuery("SELECT id, name, path, created_at, updated_at").
-					WithArgs(10, 0).
+				mock.ExpectQuery("SELECT id, org_id, name, path, created_at, updated_at").
+					WithArgs(1, 10, 0).
 					WillReturnRows(rows)
 			},
 			wantErr: true,
@@ -480,7 +508,7 @@ func TestMySQLRepository_List(t *testing.T) {
 			repo := NewMySQLRepository(db)
 			tt.setup(mock)
 
-			result, err := repo.List(context.Background(), tt.limit, tt.offset)
+			result, err := repo.List(context.Background(), 1, tt.limit, tt.offset)
 
 			if tt.wantErr {
 				assert.Error(t, err)
@@ -516,6 +544,7 @@ func TestMySQLRepository_Count(t *testing.T) {
 				rows := sqlmock.NewRows([]string{"COUNT(*)"}).
 					AddRow(42)
 				mock.ExpectQuery("SELECT COUNT\\(\\*\\) FROM media").
+					WithArgs(1).
 					WillReturnRows(rows)
 			},
 			want:    42,
@@ -527,6 +556,7 @@ func TestMySQLRepository_Count(t *testing.T) {
 				rows := sqlmock.NewRows([]string{"COUNT(*)"}).
 					AddRow(0)
 				mock.ExpectQuery("SELECT COUNT\\(\\*\\) FROM media").
+					WithArgs(1).
 					WillReturnRows(rows)
 			},
 			want:    0,
@@ -536,6 +566,7 @@ func TestMySQLRepository_Count(t *testing.T) {
 			name: "error on database query",
 			setup: func(mock sqlmock.Sqlmock) {
 				mock.ExpectQuery("SELECT COUNT\\(\\*\\) FROM media").
+					WithArgs(1).
 					WillReturnError(errors.New("database error"))
 			},
 			want:    0,
@@ -559,7 +590,7 @@ func TestMySQLRepository_Count(t *testing.T) {
 			repo := NewMySQLRepository(db)
 			tt.setup(mock)
 
-			result, err := repo.Count(context.Background())
+			result, err := repo.Count(context.Background(), 1)
 
 			if tt.wantErr {
 				assert.Error(t, err)
@@ -573,3 +604,310 @@ func TestMySQLRepository_Count(t *testing.T) {
 		})
 	}
 }
+
+func TestMySQLRepository_Restore(t *testing.T) {
+	tests := []struct {
+		name    string
+		id      int64
+		setup   func(mock sqlmock.Sqlmock)
+		wantErr error
+	}{
+		{
+			name: "success restore media",
+			id:   1,
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec("UPDATE media SET deleted_at = NULL WHERE id = \\? AND org_id = \\? AND deleted_at IS NOT NULL").
+					WithArgs(1, 1).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+		},
+		{
+			name: "media not in trash",
+			id:   999,
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec("UPDATE media SET deleted_at = NULL").
+					WithArgs(999, 1).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+			},
+			wantErr: domainmedia.ErrMediaNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+			}
+			defer func() {
+				mock.ExpectClose()
+				if err := db.Close(); err != nil {
+					t.Fatalf("Failed to close database connection: %v", err)
+				}
+			}()
+
+			repo := NewMySQLRepository(db)
+			tt.setup(mock)
+
+			err = repo.Restore(context.Background(), 1, tt.id)
+
+			assert.Equal(t, tt.wantErr, err)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestMySQLRepository_ListDeleted(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer func() {
+		mock.ExpectClose()
+		if err := db.Close(); err != nil {
+			t.Fatalf("Failed to close database connection: %v", err)
+		}
+	}()
+
+	repo := NewMySQLRepository(db)
+	deletedAt := time.Now()
+	rows := sqlmock.NewRows([]string{"id", "org_id", "name", "path", "created_at", "updated_at", "deleted_at"}).
+		AddRow(1, 1, "photo.jpg", "uploads/photo.jpg", time.Now(), time.Now(), deletedAt)
+	mock.ExpectQuery("WHERE org_id = \\? AND deleted_at IS NOT NULL\\s+ORDER BY deleted_at DESC").
+		WithArgs(1, 10, 0).
+		WillReturnRows(rows)
+
+	mediaList, err := repo.ListDeleted(context.Background(), 1, 10, 0)
+
+	assert.NoError(t, err)
+	assert.Len(t, mediaList, 1)
+	if assert.NotNil(t, mediaList[0].DeletedAt) {
+		assert.True(t, deletedAt.Equal(*mediaList[0].DeletedAt))
+	}
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestMySQLRepository_CountDeleted(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer func() {
+		mock.ExpectClose()
+		if err := db.Close(); err != nil {
+			t.Fatalf("Failed to close database connection: %v", err)
+		}
+	}()
+
+	repo := NewMySQLRepository(db)
+	mock.ExpectQuery("SELECT COUNT\\(\\*\\) FROM media WHERE org_id = \\? AND deleted_at IS NOT NULL").
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"COUNT(*)"}).AddRow(2))
+
+	count, err := repo.CountDeleted(context.Background(), 1)
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), count)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestMySQLRepository_GetByPath(t *testing.T) {
+	tests := []struct {
+		name    string
+		setup   func(mock sqlmock.Sqlmock)
+		wantErr error
+	}{
+		{
+			name: "live media",
+			setup: func(mock sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows([]string{"id", "org_id", "name", "path", "created_at", "updated_at"}).
+					AddRow(1, 2, "photo.jpg", "2025/12/19/photo.jpg", time.Now(), time.Now())
+				mock.ExpectQuery("WHERE path = \\? AND deleted_at IS NULL").
+					WithArgs("2025/12/19/photo.jpg").
+					WillReturnRows(rows)
+			},
+		},
+		{
+			name: "unknown or in the trash",
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery("WHERE path = \\? AND deleted_at IS NULL").
+					WithArgs("2025/12/19/photo.jpg").
+					WillReturnError(sql.ErrNoRows)
+			},
+			wantErr: domainmedia.ErrMediaNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+			}
+			defer func() {
+				mock.ExpectClose()
+				if err := db.Close(); err != nil {
+					t.Fatalf("Failed to close database connection: %v", err)
+				}
+			}()
+
+			repo := NewMySQLRepository(db)
+			tt.setup(mock)
+
+			m, err := repo.GetByPath(context.Background(), "2025/12/19/photo.jpg")
+
+			if tt.wantErr != nil {
+				assert.Equal(t, tt.wantErr, err)
+				assert.Nil(t, m)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, int64(2), m.OrgID)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestMySQLRepository_ListDeletedBefore(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer func() {
+		mock.ExpectClose()
+		if err := db.Close(); err != nil {
+			t.Fatalf("Failed to close database connection: %v", err)
+		}
+	}()
+
+	repo := NewMySQLRepository(db)
+	before := time.Now().AddDate(0, 0, -30)
+	rows := sqlmock.NewRows([]string{"id", "org_id", "name", "path", "created_at", "updated_at", "deleted_at"}).
+		AddRow(1, 1, "photo.jpg", "uploads/photo.jpg", time.Now(), time.Now(), before.Add(-time.Hour))
+	mock.ExpectQuery("WHERE deleted_at IS NOT NULL AND deleted_at < \\?\\s+ORDER BY deleted_at ASC").
+		WithArgs(before, 100).
+		WillReturnRows(rows)
+
+	mediaList, err := repo.ListDeletedBefore(context.Background(), before, 100)
+
+	assert.NoError(t, err)
+	assert.Len(t, mediaList, 1)
+	assert.Equal(t, "uploads/photo.jpg", mediaList[0].Path)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestMySQLRepository_Purge(t *testing.T) {
+	tests := []struct {
+		name    string
+		id      int64
+		setup   func(mock sqlmock.Sqlmock)
+		wantErr error
+	}{
+		{
+			name: "success purge media",
+			id:   1,
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec("DELETE FROM media WHERE id = \\? AND deleted_at IS NOT NULL").
+					WithArgs(1).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+		},
+		{
+			name: "media not in trash",
+			id:   1,
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec("DELETE FROM media").
+					WithArgs(1).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+			},
+			wantErr: domainmedia.ErrMediaNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+			}
+			defer func() {
+				mock.ExpectClose()
+				if err := db.Close(); err != nil {
+					t.Fatalf("Failed to close database connection: %v", err)
+				}
+			}()
+
+			repo := NewMySQLRepository(db)
+			tt.setup(mock)
+
+			err = repo.Purge(context.Background(), tt.id)
+
+			assert.Equal(t, tt.wantErr, err)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestMySQLRepository_ListAllByOwner(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer func() {
+		mock.ExpectClose()
+		if err := db.Close(); err != nil {
+			t.Fatalf("Failed to close database connection: %v", err)
+		}
+	}()
+
+	repo := NewMySQLRepository(db)
+	deletedAt := time.Now()
+	rows := sqlmock.NewRows([]string{"id", "owner_id", "org_id", "name", "path", "created_at", "updated_at", "deleted_at"}).
+		AddRow(1, 7, 1, "photo.jpg", "uploads/photo.jpg", time.Now(), time.Now(), nil).
+		AddRow(2, 7, 1, "old.jpg", "uploads/old.jpg", time.Now(), time.Now(), deletedAt)
+	mock.ExpectQuery("FROM media\\s+WHERE owner_id = \\?\\s+ORDER BY id ASC").
+		WithArgs(int64(7), 100, 0).
+		WillReturnRows(rows)
+
+	mediaList, err := repo.ListAllByOwner(context.Background(), 7, 100, 0)
+
+	assert.NoError(t, err)
+	if assert.Len(t, mediaList, 2) {
+		assert.Equal(t, int64(7), mediaList[0].OwnerID)
+		assert.Nil(t, mediaList[0].DeletedAt)
+		if assert.NotNil(t, mediaList[1].DeletedAt) {
+			assert.True(t, deletedAt.Equal(*mediaList[1].DeletedAt))
+		}
+	}
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestMySQLRepository_ReassignOwner(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer func() {
+		mock.ExpectClose()
+		if err := db.Close(); err != nil {
+			t.Fatalf("Failed to close database connection: %v", err)
+		}
+	}()
+
+	repo := NewMySQLRepository(db)
+	mock.ExpectExec("UPDATE media SET owner_id = \\? WHERE owner_id = \\?$").
+		WithArgs(int64(1), int64(7)).
+		WillReturnResult(sqlmock.NewResult(0, 3))
+	mock.ExpectExec("UPDATE media SET owner_id = \\? WHERE owner_id = \\? AND org_id = \\?").
+		WithArgs(int64(1), int64(7), int64(2)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	moved, err := repo.ReassignOwner(context.Background(), 0, 7, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(3), moved)
+
+	moved, err = repo.ReassignOwner(context.Background(), 2, 7, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), moved)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,232 @@
+package privacy
+
+import (
+	"context"
+	"database/sql"
+	"log"
+	"time"
+
+	domainprivacy "github.com/rulzi/hexa-go/internal/domain/privacy"
+)
+
+// claimAttempts is how often ClaimNext tries again when another worker claimed the same request first
+const claimAttempts = 3
+
+// maxErrorLength is the size of the error column
+const maxErrorLength = 1024
+
+// MySQLRepository is the MySQL implementation of privacy.Repository (driven adapter)
+type MySQLRepository struct {
+	db *sql.DB
+}
+
+// NewMySQLRepository creates a new MySQLRepository
+func NewMySQLRepository(db *sql.DB) *MySQLRepository {
+	return &MySQLRepository{db: db}
+}
+
+// Create stores a new request
+func (r *MySQLRepository) Create(ctx context.Context, request *domainprivacy.DataRequest) (*domainprivacy.DataRequest, error) {
+	query := `
+		INSERT INTO data_requests (user_id, org_id, requested_by, type, status, created_at)
+		VALUES (?, ?, ?, ?, ?, ?)
+	`
+
+	result, err := r.db.ExecContext(ctx, query, request.UserID, request.OrgID, request.RequestedBy, request.Type, request.Status, request.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return nil, err
+	}
+
+	request.ID = id
+	return request, nil
+}
+
+// GetByID retrieves a request by ID
+func (r *MySQLRepository) GetByID(ctx context.Context, id int64) (*domainprivacy.DataRequest, error) {
+	query := `
+		SELECT id, user_id, org_id, requested_by, type, status, archive_path, error, created_at, started_at, completed_at, expires_at
+		FROM data_requests
+		WHERE id = ?
+	`
+
+	request, err := scanRequest(r.db.QueryRowContext(ctx, query, id))
+	if err == sql.ErrNoRows {
+		return nil, domainprivacy.ErrRequestNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return request, nil
+}
+
+// FindActive returns the pending or running request of a user of the given type covering orgID
+func (r *MySQLRepository) FindActive(ctx context.Context, orgID, userID int64, requestType domainprivacy.RequestType) (*domainprivacy.DataRequest, error) {
+	query := `
+		SELECT id, user_id, org_id, requested_by, type, status, archive_path, error, created_at, started_at, completed_at, expires_at
+		FROM data_requests
+		WHERE user_id = ? AND org_id = ? AND type = ? AND status IN (?, ?)
+		ORDER BY id DESC
+		LIMIT 1
+	`
+
+	request, err := scanRequest(r.db.QueryRowContext(ctx, query, userID, orgID, requestType, domainprivacy.StatusPending, domainprivacy.StatusRunning))
+	if err == sql.ErrNoRows {
+		return nil, domainprivacy.ErrRequestNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return request, nil
+}
+
+// ClaimNext marks the oldest pending or stale request as running and returns it.
+// The conditional update makes sure only one worker claims a request.
+func (r *MySQLRepository) ClaimNext(ctx context.Context, now, staleBefore time.Time) (*domainprivacy.DataRequest, error) {
+	selectQuery := `
+		SELECT id
+		FROM data_requests
+		WHERE status = ? OR (status = ? AND started_at < ?)
+		ORDER BY id ASC
+		LIMIT 1
+	`
+	claimQuery := `
+		UPDATE data_requests
+		SET status = ?, started_at = ?
+		WHERE id = ? AND (status = ? OR (status = ? AND started_at < ?))
+	`
+
+	for attempt := 0; attempt < claimAttempts; attempt++ {
+		var id int64
+		err := r.db.QueryRowContext(ctx, selectQuery, domainprivacy.StatusPending, domainprivacy.StatusRunning, staleBefore).Scan(&id)
+		if err == sql.ErrNoRows {
+			return nil, domainprivacy.ErrRequestNotFound
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		result, err := r.db.ExecContext(ctx, claimQuery,
+			domainprivacy.StatusRunning, now,
+			id, domainprivacy.StatusPending, domainprivacy.StatusRunning, staleBefore,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		rowsAffected, err := result.RowsAffected()
+		if err != nil {
+			return nil, err
+		}
+		if rowsAffected == 1 {
+			return r.GetByID(ctx, id)
+		}
+		// Claimed by another worker in the meantime
+	}
+
+	// Busy with other workers, what is left is picked up on the next run
+	return nil, domainprivacy.ErrRequestNotFound
+}
+
+// Update saves the status, archive and timestamps of a request
+func (r *MySQLRepository) Update(ctx context.Context, request *domainprivacy.DataRequest) error {
+	query := `
+		UPDATE data_requests
+		SET status = ?, archive_path = ?, error = ?, started_at = ?, completed_at = ?, expires_at = ?
+		WHERE id = ?
+	`
+
+	message := request.Error
+	if len(message) > maxErrorLength {
+		message = message[:maxErrorLength]
+	}
+
+	_, err := r.db.ExecContext(ctx, query,
+		request.Status, request.ArchivePath, message,
+		request.StartedAt, request.CompletedAt, request.ExpiresAt,
+		request.ID,
+	)
+	return err
+}
+
+// ListExpiredExports returns up to limit completed exports whose archive expired before the given time
+func (r *MySQLRepository) ListExpiredExports(ctx context.Context, before time.Time, limit int) ([]*domainprivacy.DataRequest, error) {
+	query := `
+		SELECT id, user_id, org_id, requested_by, type, status, archive_path, error, created_at, started_at, completed_at, expires_at
+		FROM data_requests
+		WHERE type = ? AND status = ? AND expires_at < ?
+		ORDER BY expires_at ASC
+		LIMIT ?
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, domainprivacy.RequestExport, domainprivacy.StatusCompleted, before, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := rows.Close(); err != nil {
+			log.Printf("Failed to close rows: %v", err)
+		}
+	}()
+
+	var requests []*domainprivacy.DataRequest
+	for rows.Next() {
+		request, err := scanRequest(rows)
+		if err != nil {
+			return nil, err
+		}
+		requests = append(requests, request)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return requests, nil
+}
+
+// rowScanner is implemented by *sql.Row and *sql.Rows
+type rowScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+// scanRequest reads a request row selected with all its columns
+func scanRequest(row rowScanner) (*domainprivacy.DataRequest, error) {
+	request := &domainprivacy.DataRequest{}
+	var startedAt, completedAt, expiresAt sql.NullTime
+	err := row.Scan(
+		&request.ID,
+		&request.UserID,
+		&request.OrgID,
+		&request.RequestedBy,
+		&request.Type,
+		&request.Status,
+		&request.ArchivePath,
+		&request.Error,
+		&request.CreatedAt,
+		&startedAt,
+		&completedAt,
+		&expiresAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if startedAt.Valid {
+		request.StartedAt = &startedAt.Time
+	}
+	if completedAt.Valid {
+		request.CompletedAt = &completedAt.Time
+	}
+	if expiresAt.Valid {
+		request.ExpiresAt = &expiresAt.Time
+	}
+
+	return request, nil
+}
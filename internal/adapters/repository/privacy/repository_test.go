@@ -0,0 +1,283 @@
+package privacy
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	domainprivacy "github.com/rulzi/hexa-go/internal/domain/privacy"
+	"github.com/stretchr/testify/assert"
+)
+
+var requestColumns = []string{"id", "user_id", "org_id", "requested_by", "type", "status", "archive_path", "error", "created_at", "started_at", "completed_at", "expires_at"}
+
+func TestMySQLRepository_Create(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer func() {
+		mock.ExpectClose()
+		if err := db.Close(); err != nil {
+			t.Fatalf("Failed to close database connection: %v", err)
+		}
+	}()
+
+	repo := NewMySQLRepository(db)
+	mock.ExpectExec("INSERT INTO data_requests").
+		WithArgs(int64(1), int64(2), int64(1), "export", "pending", sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(9, 1))
+
+	request, err := repo.Create(context.Background(), &domainprivacy.DataRequest{
+		UserID:      1,
+		OrgID:       2,
+		RequestedBy: 1,
+		Type:        domainprivacy.RequestExport,
+		Status:      domainprivacy.StatusPending,
+		CreatedAt:   time.Now(),
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(9), request.ID)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestMySQLRepository_GetByID(t *testing.T) {
+	tests := []struct {
+		name    string
+		setup   func(mock sqlmock.Sqlmock)
+		wantErr error
+	}{
+		{
+			name: "success",
+			setup: func(mock sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(requestColumns).
+					AddRow(9, 1, 0, 1, "export", "completed", "export-9.zip", "", time.Now(), time.Now(), time.Now(), time.Now())
+				mock.ExpectQuery("FROM data_requests\\s+WHERE id = \\?").
+					WithArgs(int64(9)).
+					WillReturnRows(rows)
+			},
+		},
+		{
+			name: "not found",
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery("FROM data_requests\\s+WHERE id = \\?").
+					WithArgs(int64(9)).
+					WillReturnRows(sqlmock.NewRows(requestColumns))
+			},
+			wantErr: domainprivacy.ErrRequestNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+			}
+			defer func() {
+				mock.ExpectClose()
+				if err := db.Close(); err != nil {
+					t.Fatalf("Failed to close database connection: %v", err)
+				}
+			}()
+
+			repo := NewMySQLRepository(db)
+			tt.setup(mock)
+
+			request, err := repo.GetByID(context.Background(), 9)
+
+			if tt.wantErr != nil {
+				assert.Equal(t, tt.wantErr, err)
+				assert.Nil(t, request)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, domainprivacy.StatusCompleted, request.Status)
+				assert.Equal(t, "export-9.zip", request.ArchivePath)
+				assert.NotNil(t, request.ExpiresAt)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestMySQLRepository_FindActive(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer func() {
+		mock.ExpectClose()
+		if err := db.Close(); err != nil {
+			t.Fatalf("Failed to close database connection: %v", err)
+		}
+	}()
+
+	repo := NewMySQLRepository(db)
+	mock.ExpectQuery("WHERE user_id = \\? AND org_id = \\? AND type = \\? AND status IN \\(\\?, \\?\\)").
+		WithArgs(int64(1), int64(0), "erasure", "pending", "running").
+		WillReturnRows(sqlmock.NewRows(requestColumns))
+
+	request, err := repo.FindActive(context.Background(), 0, 1, domainprivacy.RequestErasure)
+
+	assert.Nil(t, request)
+	assert.Equal(t, domainprivacy.ErrRequestNotFound, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestMySQLRepository_ClaimNext(t *testing.T) {
+	now := time.Now()
+	staleBefore := now.Add(-time.Hour)
+
+	tests := []struct {
+		name    string
+		setup   func(mock sqlmock.Sqlmock)
+		wantID  int64
+		wantErr error
+	}{
+		{
+			name: "claims the oldest request",
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery("SELECT id\\s+FROM data_requests").
+					WithArgs("pending", "running", staleBefore).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(4))
+				mock.ExpectExec("UPDATE data_requests\\s+SET status = \\?, started_at = \\?").
+					WithArgs("running", now, int64(4), "pending", "running", staleBefore).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectQuery("WHERE id = \\?").
+					WithArgs(int64(4)).
+					WillReturnRows(sqlmock.NewRows(requestColumns).
+						AddRow(4, 1, 0, 1, "export", "running", "", "", now, now, nil, nil))
+			},
+			wantID: 4,
+		},
+		{
+			name: "another worker was faster",
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery("SELECT id\\s+FROM data_requests").
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(4))
+				mock.ExpectExec("UPDATE data_requests").
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectQuery("SELECT id\\s+FROM data_requests").
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(5))
+				mock.ExpectExec("UPDATE data_requests").
+					WithArgs("running", now, int64(5), "pending", "running", staleBefore).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectQuery("WHERE id = \\?").
+					WithArgs(int64(5)).
+					WillReturnRows(sqlmock.NewRows(requestColumns).
+						AddRow(5, 2, 0, 2, "erasure", "running", "", "", now, now, nil, nil))
+			},
+			wantID: 5,
+		},
+		{
+			name: "nothing to do",
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery("SELECT id\\s+FROM data_requests").
+					WillReturnRows(sqlmock.NewRows([]string{"id"}))
+			},
+			wantErr: domainprivacy.ErrRequestNotFound,
+		},
+		{
+			name: "database error",
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery("SELECT id\\s+FROM data_requests").
+					WillReturnError(errors.New("database error"))
+			},
+			wantErr: errors.New("database error"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+			}
+			defer func() {
+				mock.ExpectClose()
+				if err := db.Close(); err != nil {
+					t.Fatalf("Failed to close database connection: %v", err)
+				}
+			}()
+
+			repo := NewMySQLRepository(db)
+			tt.setup(mock)
+
+			request, err := repo.ClaimNext(context.Background(), now, staleBefore)
+
+			if tt.wantErr != nil {
+				assert.Equal(t, tt.wantErr, err)
+				assert.Nil(t, request)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.wantID, request.ID)
+				assert.Equal(t, domainprivacy.StatusRunning, request.Status)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestMySQLRepository_Update(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer func() {
+		mock.ExpectClose()
+		if err := db.Close(); err != nil {
+			t.Fatalf("Failed to close database connection: %v", err)
+		}
+	}()
+
+	repo := NewMySQLRepository(db)
+	now := time.Now()
+	longError := strings.Repeat("x", maxErrorLength+10)
+	mock.ExpectExec("UPDATE data_requests\\s+SET status = \\?, archive_path = \\?, error = \\?").
+		WithArgs("failed", "", longError[:maxErrorLength], &now, &now, nil, int64(9)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	err = repo.Update(context.Background(), &domainprivacy.DataRequest{
+		ID:          9,
+		Status:      domainprivacy.StatusFailed,
+		Error:       longError,
+		StartedAt:   &now,
+		CompletedAt: &now,
+	})
+
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestMySQLRepository_ListExpiredExports(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer func() {
+		mock.ExpectClose()
+		if err := db.Close(); err != nil {
+			t.Fatalf("Failed to close database connection: %v", err)
+		}
+	}()
+
+	repo := NewMySQLRepository(db)
+	now := time.Now()
+	rows := sqlmock.NewRows(requestColumns).
+		AddRow(3, 1, 0, 1, "export", "completed", "export-3.zip", "", now, now, now, now.Add(-time.Minute))
+	mock.ExpectQuery("WHERE type = \\? AND status = \\? AND expires_at < \\?").
+		WithArgs("export", "completed", now, 100).
+		WillReturnRows(rows)
+
+	requests, err := repo.ListExpiredExports(context.Background(), now, 100)
+
+	assert.NoError(t, err)
+	if assert.Len(t, requests, 1) {
+		assert.Equal(t, "export-3.zip", requests[0].ArchivePath)
+	}
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,189 @@
+package user
+
+import (
+	"context"
+	"database/sql"
+	"log"
+	"strings"
+	"time"
+
+	domainuser "github.com/rulzi/hexa-go/internal/domain/user"
+)
+
+// MySQLAPIKeyRepository is the MySQL implementation of user.APIKeyRepository (driven adapter)
+type MySQLAPIKeyRepository struct {
+	db *sql.DB
+}
+
+// NewMySQLAPIKeyRepository creates a new MySQLAPIKeyRepository
+func NewMySQLAPIKeyRepository(db *sql.DB) *MySQLAPIKeyRepository {
+	return &MySQLAPIKeyRepository{db: db}
+}
+
+// Create stores a new API key
+func (r *MySQLAPIKeyRepository) Create(ctx context.Context, k *domainuser.APIKey) (*domainuser.APIKey, error) {
+	query := `
+		INSERT INTO api_keys (user_id, org_id, name, prefix, key_hash, scopes, expires_at, created_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+	`
+
+	result, err := r.db.ExecContext(ctx, query, k.UserID, k.OrgID, k.Name, k.Prefix, k.KeyHash, joinScopes(k.Scopes), k.ExpiresAt, k.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return nil, err
+	}
+
+	k.ID = id
+	return k, nil
+}
+
+// GetByHash retrieves an API key by the hash of its value
+func (r *MySQLAPIKeyRepository) GetByHash(ctx context.Context, keyHash string) (*domainuser.APIKey, error) {
+	query := `
+		SELECT id, user_id, org_id, name, prefix, key_hash, scopes, expires_at, last_used_at, revoked_at, created_at
+		FROM api_keys
+		WHERE key_hash = ?
+	`
+
+	k, err := scanAPIKey(r.db.QueryRowContext(ctx, query, keyHash))
+	if err == sql.ErrNoRows {
+		return nil, domainuser.ErrInvalidAPIKey
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return k, nil
+}
+
+// ListByUser returns the keys of a user that have not been revoked, newest first
+func (r *MySQLAPIKeyRepository) ListByUser(ctx context.Context, userID int64) ([]*domainuser.APIKey, error) {
+	query := `
+		SELECT id, user_id, org_id, name, prefix, key_hash, scopes, expires_at, last_used_at, revoked_at, created_at
+		FROM api_keys
+		WHERE user_id = ? AND revoked_at IS NULL
+		ORDER BY created_at DESC, id DESC
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := rows.Close(); err != nil {
+			log.Printf("Failed to close rows: %v", err)
+		}
+	}()
+
+	var keys []*domainuser.APIKey
+	for rows.Next() {
+		k, err := scanAPIKey(rows)
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, k)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return keys, nil
+}
+
+// Revoke revokes an active key of the user
+func (r *MySQLAPIKeyRepository) Revoke(ctx context.Context, userID, id int64) error {
+	query := `UPDATE api_keys SET revoked_at = ? WHERE id = ? AND user_id = ? AND revoked_at IS NULL`
+
+	result, err := r.db.ExecContext(ctx, query, time.Now(), id, userID)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return domainuser.ErrAPIKeyNotFound
+	}
+
+	return nil
+}
+
+// TouchLastUsed records when the key was last used
+func (r *MySQLAPIKeyRepository) TouchLastUsed(ctx context.Context, id int64, at time.Time) error {
+	query := `UPDATE api_keys SET last_used_at = ? WHERE id = ?`
+
+	_, err := r.db.ExecContext(ctx, query, at, id)
+	return err
+}
+
+// rowScanner is implemented by *sql.Row and *sql.Rows
+type rowScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+// scanAPIKey reads an API key row selected with all its columns
+func scanAPIKey(row rowScanner) (*domainuser.APIKey, error) {
+	k := &domainuser.APIKey{}
+	var scopes string
+	var expiresAt, lastUsedAt, revokedAt sql.NullTime
+	err := row.Scan(
+		&k.ID,
+		&k.UserID,
+		&k.OrgID,
+		&k.Name,
+		&k.Prefix,
+		&k.KeyHash,
+		&scopes,
+		&expiresAt,
+		&lastUsedAt,
+		&revokedAt,
+		&k.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	k.Scopes = splitScopes(scopes)
+	if expiresAt.Valid {
+		k.ExpiresAt = &expiresAt.Time
+	}
+	if lastUsedAt.Valid {
+		k.LastUsedAt = &lastUsedAt.Time
+	}
+	if revokedAt.Valid {
+		k.RevokedAt = &revokedAt.Time
+	}
+
+	return k, nil
+}
+
+// joinScopes stores scopes as a comma separated list, empty for unrestricted keys
+func joinScopes(scopes []domainuser.Permission) string {
+	parts := make([]string, len(scopes))
+	for i, scope := range scopes {
+		parts[i] = string(scope)
+	}
+	return strings.Join(parts, ",")
+}
+
+// splitScopes is the inverse of joinScopes
+func splitScopes(scopes string) []domainuser.Permission {
+	if scopes == "" {
+		return nil
+	}
+
+	parts := strings.Split(scopes, ",")
+	permissions := make([]domainuser.Permission, len(parts))
+	for i, part := range parts {
+		permissions[i] = domainuser.Permission(part)
+	}
+	return permissions
+}
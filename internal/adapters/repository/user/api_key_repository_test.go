@@ -0,0 +1,249 @@
+package user
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	domainuser "github.com/rulzi/hexa-go/internal/domain/user"
+	"github.com/stretchr/testify/assert"
+)
+
+var apiKeyColumns = []string{"id", "user_id", "org_id", "name", "prefix", "key_hash", "scopes", "expires_at", "last_used_at", "revoked_at", "created_at"}
+
+func TestMySQLAPIKeyRepository_Create(t *testing.T) {
+	tests := []struct {
+		name    string
+		setup   func(mock sqlmock.Sqlmock)
+		wantErr bool
+	}{
+		{
+			name: "success create api key",
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec("INSERT INTO api_keys").
+					WithArgs(int64(1), int64(2), "ci", "hxa_abcdefgh", "hash-1", "articles:read,media:read", nil, sqlmock.AnyArg()).
+					WillReturnResult(sqlmock.NewResult(3, 1))
+			},
+		},
+		{
+			name: "error on database exec",
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec("INSERT INTO api_keys").
+					WillReturnError(errors.New("database error"))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+			}
+			defer func() {
+				mock.ExpectClose()
+				if err := db.Close(); err != nil {
+					t.Fatalf("Failed to close database connection: %v", err)
+				}
+			}()
+
+			repo := NewMySQLAPIKeyRepository(db)
+			tt.setup(mock)
+
+			result, err := repo.Create(context.Background(), &domainuser.APIKey{
+				UserID:    1,
+				OrgID:     2,
+				Name:      "ci",
+				Prefix:    "hxa_abcdefgh",
+				KeyHash:   "hash-1",
+				Scopes:    []domainuser.Permission{domainuser.PermArticlesRead, domainuser.PermMediaRead},
+				CreatedAt: time.Now(),
+			})
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Nil(t, result)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, int64(3), result.ID)
+			}
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestMySQLAPIKeyRepository_GetByHash(t *testing.T) {
+	expiresAt := time.Now().Add(time.Hour)
+
+	tests := []struct {
+		name    string
+		setup   func(mock sqlmock.Sqlmock)
+		wantErr error
+		check   func(t *testing.T, key *domainuser.APIKey)
+	}{
+		{
+			name: "scoped key with expiry",
+			setup: func(mock sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(apiKeyColumns).
+					AddRow(3, 1, 2, "ci", "hxa_abcdefgh", "hash-1", "articles:read,media:read", expiresAt, nil, nil, time.Now())
+				mock.ExpectQuery("SELECT (.+) FROM api_keys WHERE key_hash = ?").
+					WithArgs("hash-1").
+					WillReturnRows(rows)
+			},
+			check: func(t *testing.T, key *domainuser.APIKey) {
+				assert.Equal(t, int64(3), key.ID)
+				assert.Equal(t, int64(2), key.OrgID)
+				assert.Equal(t, []domainuser.Permission{domainuser.PermArticlesRead, domainuser.PermMediaRead}, key.Scopes)
+				assert.NotNil(t, key.ExpiresAt)
+				assert.Nil(t, key.LastUsedAt)
+				assert.Nil(t, key.RevokedAt)
+			},
+		},
+		{
+			name: "unrestricted key",
+			setup: func(mock sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(apiKeyColumns).
+					AddRow(3, 1, 2, "ci", "hxa_abcdefgh", "hash-1", "", nil, time.Now(), nil, time.Now())
+				mock.ExpectQuery("SELECT (.+) FROM api_keys WHERE key_hash = ?").
+					WithArgs("hash-1").
+					WillReturnRows(rows)
+			},
+			check: func(t *testing.T, key *domainuser.APIKey) {
+				assert.Empty(t, key.Scopes)
+				assert.Nil(t, key.ExpiresAt)
+				assert.NotNil(t, key.LastUsedAt)
+			},
+		},
+		{
+			name: "key not found",
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery("SELECT (.+) FROM api_keys WHERE key_hash = ?").
+					WithArgs("hash-1").
+					WillReturnError(sql.ErrNoRows)
+			},
+			wantErr: domainuser.ErrInvalidAPIKey,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+			}
+			defer func() {
+				mock.ExpectClose()
+				if err := db.Close(); err != nil {
+					t.Fatalf("Failed to close database connection: %v", err)
+				}
+			}()
+
+			repo := NewMySQLAPIKeyRepository(db)
+			tt.setup(mock)
+
+			result, err := repo.GetByHash(context.Background(), "hash-1")
+
+			if tt.wantErr != nil {
+				assert.Equal(t, tt.wantErr, err)
+				assert.Nil(t, result)
+			} else {
+				assert.NoError(t, err)
+				tt.check(t, result)
+			}
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestMySQLAPIKeyRepository_ListByUser(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer func() {
+		mock.ExpectClose()
+		if err := db.Close(); err != nil {
+			t.Fatalf("Failed to close database connection: %v", err)
+		}
+	}()
+
+	repo := NewMySQLAPIKeyRepository(db)
+	rows := sqlmock.NewRows(apiKeyColumns).
+		AddRow(4, 1, 2, "deploy", "hxa_ijklmnop", "hash-2", "", nil, nil, nil, time.Now()).
+		AddRow(3, 1, 2, "ci", "hxa_abcdefgh", "hash-1", "articles:read", nil, nil, nil, time.Now())
+	mock.ExpectQuery("SELECT (.+) FROM api_keys WHERE user_id = \\? AND revoked_at IS NULL").
+		WithArgs(int64(1)).
+		WillReturnRows(rows)
+
+	keys, err := repo.ListByUser(context.Background(), 1)
+
+	assert.NoError(t, err)
+	assert.Len(t, keys, 2)
+	assert.Equal(t, "deploy", keys[0].Name)
+	assert.Equal(t, []domainuser.Permission{domainuser.PermArticlesRead}, keys[1].Scopes)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestMySQLAPIKeyRepository_Revoke(t *testing.T) {
+	tests := []struct {
+		name         string
+		rowsAffected int64
+		wantErr      error
+	}{
+		{name: "revokes active key", rowsAffected: 1},
+		{name: "unknown or foreign key", rowsAffected: 0, wantErr: domainuser.ErrAPIKeyNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+			}
+			defer func() {
+				mock.ExpectClose()
+				if err := db.Close(); err != nil {
+					t.Fatalf("Failed to close database connection: %v", err)
+				}
+			}()
+
+			repo := NewMySQLAPIKeyRepository(db)
+			mock.ExpectExec("UPDATE api_keys SET revoked_at").
+				WithArgs(sqlmock.AnyArg(), int64(3), int64(1)).
+				WillReturnResult(sqlmock.NewResult(0, tt.rowsAffected))
+
+			err = repo.Revoke(context.Background(), 1, 3)
+
+			assert.Equal(t, tt.wantErr, err)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestMySQLAPIKeyRepository_TouchLastUsed(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer func() {
+		mock.ExpectClose()
+		if err := db.Close(); err != nil {
+			t.Fatalf("Failed to close database connection: %v", err)
+		}
+	}()
+
+	repo := NewMySQLAPIKeyRepository(db)
+	usedAt := time.Now()
+	mock.ExpectExec("UPDATE api_keys SET last_used_at").
+		WithArgs(usedAt, int64(3)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	assert.NoError(t, repo.TouchLastUsed(context.Background(), 3, usedAt))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,74 @@
+package user
+
+import (
+	"context"
+	"database/sql"
+
+	domainuser "github.com/rulzi/hexa-go/internal/domain/user"
+)
+
+// MySQLIdentityRepository is the MySQL implementation of user.IdentityRepository (driven adapter)
+type MySQLIdentityRepository struct {
+	db *sql.DB
+}
+
+// NewMySQLIdentityRepository creates a new MySQLIdentityRepository
+func NewMySQLIdentityRepository(db *sql.DB) *MySQLIdentityRepository {
+	return &MySQLIdentityRepository{db: db}
+}
+
+// GetByProviderSubject retrieves the link of a provider subject
+func (r *MySQLIdentityRepository) GetByProviderSubject(ctx context.Context, provider, subject string) (*domainuser.UserIdentity, error) {
+	query := `
+		SELECT id, user_id, provider, subject, email, created_at
+		FROM user_identities
+		WHERE provider = ? AND subject = ?
+	`
+
+	identity := &domainuser.UserIdentity{}
+	err := r.db.QueryRowContext(ctx, query, provider, subject).Scan(
+		&identity.ID,
+		&identity.UserID,
+		&identity.Provider,
+		&identity.Subject,
+		&identity.Email,
+		&identity.CreatedAt,
+	)
+	if err == sql.ErrNoRows {
+		return nil, domainuser.ErrIdentityNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return identity, nil
+}
+
+// Create links an identity to a user
+func (r *MySQLIdentityRepository) Create(ctx context.Context, identity *domainuser.UserIdentity) (*domainuser.UserIdentity, error) {
+	query := `
+		INSERT INTO user_identities (user_id, provider, subject, email, created_at)
+		VALUES (?, ?, ?, ?, ?)
+	`
+
+	result, err := r.db.ExecContext(ctx, query, identity.UserID, identity.Provider, identity.Subject, identity.Email, identity.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return nil, err
+	}
+
+	identity.ID = id
+	return identity, nil
+}
+
+// DeleteByUser removes every identity linked to a user
+func (r *MySQLIdentityRepository) DeleteByUser(ctx context.Context, userID int64) error {
+	query := `DELETE FROM user_identities WHERE user_id = ?`
+
+	_, err := r.db.ExecContext(ctx, query, userID)
+	return err
+}
@@ -0,0 +1,168 @@
+package user
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	domainuser "github.com/rulzi/hexa-go/internal/domain/user"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMySQLIdentityRepository_GetByProviderSubject(t *testing.T) {
+	now := time.Now()
+
+	tests := []struct {
+		name    string
+		setup   func(mock sqlmock.Sqlmock)
+		want    *domainuser.UserIdentity
+		wantErr error
+	}{
+		{
+			name: "success get identity",
+			setup: func(mock sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows([]string{"id", "user_id", "provider", "subject", "email", "created_at"}).
+					AddRow(3, 1, "corp", "sub-1", "jane@example.com", now)
+				mock.ExpectQuery("SELECT (.+) FROM user_identities WHERE provider = \\? AND subject = \\?").
+					WithArgs("corp", "sub-1").
+					WillReturnRows(rows)
+			},
+			want: &domainuser.UserIdentity{ID: 3, UserID: 1, Provider: "corp", Subject: "sub-1", Email: "jane@example.com", CreatedAt: now},
+		},
+		{
+			name: "identity not linked",
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery("SELECT (.+) FROM user_identities").
+					WithArgs("corp", "sub-1").
+					WillReturnError(sql.ErrNoRows)
+			},
+			wantErr: domainuser.ErrIdentityNotFound,
+		},
+		{
+			name: "error on database query",
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery("SELECT (.+) FROM user_identities").
+					WithArgs("corp", "sub-1").
+					WillReturnError(errors.New("database error"))
+			},
+			wantErr: errors.New("database error"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+			}
+			defer func() {
+				mock.ExpectClose()
+				if err := db.Close(); err != nil {
+					t.Fatalf("Failed to close database connection: %v", err)
+				}
+			}()
+
+			repo := NewMySQLIdentityRepository(db)
+			tt.setup(mock)
+
+			identity, err := repo.GetByProviderSubject(context.Background(), "corp", "sub-1")
+
+			if tt.wantErr != nil {
+				assert.Equal(t, tt.wantErr, err)
+				assert.Nil(t, identity)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, identity)
+			}
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestMySQLIdentityRepository_Create(t *testing.T) {
+	tests := []struct {
+		name    string
+		setup   func(mock sqlmock.Sqlmock)
+		wantErr bool
+	}{
+		{
+			name: "success link identity",
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec("INSERT INTO user_identities").
+					WithArgs(int64(1), "corp", "sub-1", "jane@example.com", sqlmock.AnyArg()).
+					WillReturnResult(sqlmock.NewResult(3, 1))
+			},
+		},
+		{
+			name: "error on duplicate subject",
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec("INSERT INTO user_identities").
+					WillReturnError(errors.New("duplicate entry"))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+			}
+			defer func() {
+				mock.ExpectClose()
+				if err := db.Close(); err != nil {
+					t.Fatalf("Failed to close database connection: %v", err)
+				}
+			}()
+
+			repo := NewMySQLIdentityRepository(db)
+			tt.setup(mock)
+
+			identity, err := repo.Create(context.Background(), &domainuser.UserIdentity{
+				UserID:    1,
+				Provider:  "corp",
+				Subject:   "sub-1",
+				Email:     "jane@example.com",
+				CreatedAt: time.Now(),
+			})
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Nil(t, identity)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, int64(3), identity.ID)
+			}
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestMySQLIdentityRepository_DeleteByUser(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer func() {
+		mock.ExpectClose()
+		if err := db.Close(); err != nil {
+			t.Fatalf("Failed to close database connection: %v", err)
+		}
+	}()
+
+	repo := NewMySQLIdentityRepository(db)
+	mock.ExpectExec("DELETE FROM user_identities WHERE user_id = \\?").
+		WithArgs(int64(1)).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+
+	err = repo.DeleteByUser(context.Background(), 1)
+
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,101 @@
+package user
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	domainuser "github.com/rulzi/hexa-go/internal/domain/user"
+)
+
+// MySQLInvitationRepository is the MySQL implementation of user.InvitationRepository (driven adapter)
+type MySQLInvitationRepository struct {
+	db *sql.DB
+}
+
+// NewMySQLInvitationRepository creates a new MySQLInvitationRepository
+func NewMySQLInvitationRepository(db *sql.DB) *MySQLInvitationRepository {
+	return &MySQLInvitationRepository{db: db}
+}
+
+// Create stores a new invitation
+func (r *MySQLInvitationRepository) Create(ctx context.Context, i *domainuser.Invitation) (*domainuser.Invitation, error) {
+	query := `
+		INSERT INTO invitations (org_id, email, role, token_hash, invited_by, expires_at, created_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?)
+	`
+
+	result, err := r.db.ExecContext(ctx, query, i.OrgID, i.Email, string(i.Role), i.TokenHash, i.InvitedBy, i.ExpiresAt, i.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return nil, err
+	}
+
+	i.ID = id
+	return i, nil
+}
+
+// GetByHash retrieves an invitation by the hash of its token
+func (r *MySQLInvitationRepository) GetByHash(ctx context.Context, tokenHash string) (*domainuser.Invitation, error) {
+	query := `
+		SELECT id, org_id, email, role, token_hash, invited_by, expires_at, accepted_at, created_at
+		FROM invitations
+		WHERE token_hash = ?
+	`
+
+	i := &domainuser.Invitation{}
+	var role string
+	var invitedBy sql.NullInt64
+	var acceptedAt sql.NullTime
+	err := r.db.QueryRowContext(ctx, query, tokenHash).Scan(
+		&i.ID,
+		&i.OrgID,
+		&i.Email,
+		&role,
+		&i.TokenHash,
+		&invitedBy,
+		&i.ExpiresAt,
+		&acceptedAt,
+		&i.CreatedAt,
+	)
+
+	if err == sql.ErrNoRows {
+		return nil, domainuser.ErrInvalidInvitation
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	i.Role = domainuser.Role(role)
+	i.InvitedBy = invitedBy.Int64
+	if acceptedAt.Valid {
+		i.AcceptedAt = &acceptedAt.Time
+	}
+
+	return i, nil
+}
+
+// MarkAccepted consumes an invitation if it has not been accepted yet
+func (r *MySQLInvitationRepository) MarkAccepted(ctx context.Context, id int64) error {
+	query := `UPDATE invitations SET accepted_at = ? WHERE id = ? AND accepted_at IS NULL`
+
+	result, err := r.db.ExecContext(ctx, query, time.Now(), id)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return domainuser.ErrInvalidInvitation
+	}
+
+	return nil
+}
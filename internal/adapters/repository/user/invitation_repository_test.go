@@ -0,0 +1,227 @@
+package user
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	domainuser "github.com/rulzi/hexa-go/internal/domain/user"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewMySQLInvitationRepository(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer func() {
+		mock.ExpectClose()
+		if err := db.Close(); err != nil {
+			t.Fatalf("Failed to close database connection: %v", err)
+		}
+	}()
+
+	repo := NewMySQLInvitationRepository(db)
+	assert.NotNil(t, repo)
+	assert.Equal(t, db, repo.db)
+}
+
+func TestMySQLInvitationRepository_Create(t *testing.T) {
+	tests := []struct {
+		name    string
+		setup   func(mock sqlmock.Sqlmock)
+		wantErr bool
+	}{
+		{
+			name: "success create invitation",
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec("INSERT INTO invitations").
+					WithArgs(int64(2), "new@example.com", "editor", "hash-1", int64(1), sqlmock.AnyArg(), sqlmock.AnyArg()).
+					WillReturnResult(sqlmock.NewResult(5, 1))
+			},
+			wantErr: false,
+		},
+		{
+			name: "error on database exec",
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec("INSERT INTO invitations").
+					WithArgs(int64(2), "new@example.com", "editor", "hash-1", int64(1), sqlmock.AnyArg(), sqlmock.AnyArg()).
+					WillReturnError(errors.New("database error"))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+			}
+			defer func() {
+				mock.ExpectClose()
+				if err := db.Close(); err != nil {
+					t.Fatalf("Failed to close database connection: %v", err)
+				}
+			}()
+
+			repo := NewMySQLInvitationRepository(db)
+			tt.setup(mock)
+
+			result, err := repo.Create(context.Background(), &domainuser.Invitation{
+				OrgID:     2,
+				Email:     "new@example.com",
+				Role:      domainuser.RoleEditor,
+				TokenHash: "hash-1",
+				InvitedBy: 1,
+				ExpiresAt: time.Now().Add(time.Hour),
+				CreatedAt: time.Now(),
+			})
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Nil(t, result)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, int64(5), result.ID)
+			}
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestMySQLInvitationRepository_GetByHash(t *testing.T) {
+	columns := []string{"id", "org_id", "email", "role", "token_hash", "invited_by", "expires_at", "accepted_at", "created_at"}
+	acceptedAt := time.Now()
+
+	tests := []struct {
+		name    string
+		setup   func(mock sqlmock.Sqlmock)
+		wantErr error
+		check   func(t *testing.T, invitation *domainuser.Invitation)
+	}{
+		{
+			name: "pending invitation",
+			setup: func(mock sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(columns).
+					AddRow(1, 2, "new@example.com", "editor", "hash-1", 3, time.Now().Add(time.Hour), nil, time.Now())
+				mock.ExpectQuery("SELECT id, org_id, email, role, token_hash, invited_by, expires_at, accepted_at, created_at").
+					WithArgs("hash-1").
+					WillReturnRows(rows)
+			},
+			check: func(t *testing.T, invitation *domainuser.Invitation) {
+				assert.Equal(t, int64(1), invitation.ID)
+				assert.Equal(t, int64(2), invitation.OrgID)
+				assert.Equal(t, domainuser.RoleEditor, invitation.Role)
+				assert.Equal(t, int64(3), invitation.InvitedBy)
+				assert.Nil(t, invitation.AcceptedAt)
+			},
+		},
+		{
+			name: "accepted invitation",
+			setup: func(mock sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(columns).
+					AddRow(1, 2, "new@example.com", "editor", "hash-1", 3, time.Now().Add(time.Hour), acceptedAt, time.Now())
+				mock.ExpectQuery("SELECT id, org_id, email, role, token_hash, invited_by, expires_at, accepted_at, created_at").
+					WithArgs("hash-1").
+					WillReturnRows(rows)
+			},
+			check: func(t *testing.T, invitation *domainuser.Invitation) {
+				assert.NotNil(t, invitation.AcceptedAt)
+				assert.True(t, invitation.IsAccepted())
+			},
+		},
+		{
+			name: "invitation not found",
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery("SELECT id, org_id, email, role, token_hash, invited_by, expires_at, accepted_at, created_at").
+					WithArgs("hash-1").
+					WillReturnError(sql.ErrNoRows)
+			},
+			wantErr: domainuser.ErrInvalidInvitation,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+			}
+			defer func() {
+				mock.ExpectClose()
+				if err := db.Close(); err != nil {
+					t.Fatalf("Failed to close database connection: %v", err)
+				}
+			}()
+
+			repo := NewMySQLInvitationRepository(db)
+			tt.setup(mock)
+
+			result, err := repo.GetByHash(context.Background(), "hash-1")
+
+			if tt.wantErr != nil {
+				assert.Equal(t, tt.wantErr, err)
+				assert.Nil(t, result)
+			} else {
+				assert.NoError(t, err)
+				tt.check(t, result)
+			}
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestMySQLInvitationRepository_MarkAccepted(t *testing.T) {
+	tests := []struct {
+		name    string
+		setup   func(mock sqlmock.Sqlmock)
+		wantErr error
+	}{
+		{
+			name: "success mark accepted",
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec("UPDATE invitations SET accepted_at").
+					WithArgs(sqlmock.AnyArg(), int64(1)).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+		},
+		{
+			name: "already accepted",
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec("UPDATE invitations SET accepted_at").
+					WithArgs(sqlmock.AnyArg(), int64(1)).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+			},
+			wantErr: domainuser.ErrInvalidInvitation,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+			}
+			defer func() {
+				mock.ExpectClose()
+				if err := db.Close(); err != nil {
+					t.Fatalf("Failed to close database connection: %v", err)
+				}
+			}()
+
+			repo := NewMySQLInvitationRepository(db)
+			tt.setup(mock)
+
+			err = repo.MarkAccepted(context.Background(), 1)
+
+			assert.Equal(t, tt.wantErr, err)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
@@ -0,0 +1,99 @@
+package user
+
+import (
+	"context"
+	"database/sql"
+	"log"
+
+	domainuser "github.com/rulzi/hexa-go/internal/domain/user"
+)
+
+// MySQLLoginAttemptRepository is the MySQL implementation of user.LoginAttemptRepository (driven adapter)
+type MySQLLoginAttemptRepository struct {
+	db *sql.DB
+}
+
+// NewMySQLLoginAttemptRepository creates a new MySQLLoginAttemptRepository
+func NewMySQLLoginAttemptRepository(db *sql.DB) *MySQLLoginAttemptRepository {
+	return &MySQLLoginAttemptRepository{db: db}
+}
+
+// Create stores a login attempt
+func (r *MySQLLoginAttemptRepository) Create(ctx context.Context, a *domainuser.LoginAttempt) error {
+	query := `
+		INSERT INTO login_attempts (user_id, email, ip_address, user_agent, success, created_at)
+		VALUES (?, ?, ?, ?, ?, ?)
+	`
+
+	// Attempts for unknown emails are not linked to a user
+	var userID sql.NullInt64
+	if a.UserID != 0 {
+		userID = sql.NullInt64{Int64: a.UserID, Valid: true}
+	}
+
+	result, err := r.db.ExecContext(ctx, query, userID, a.Email, a.IPAddress, a.UserAgent, a.Success, a.CreatedAt)
+	if err != nil {
+		return err
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return err
+	}
+
+	a.ID = id
+	return nil
+}
+
+// ListByUser returns the most recent attempts of a user, newest first
+func (r *MySQLLoginAttemptRepository) ListByUser(ctx context.Context, userID int64, limit int) ([]*domainuser.LoginAttempt, error) {
+	query := `
+		SELECT id, user_id, email, ip_address, user_agent, success, created_at
+		FROM login_attempts
+		WHERE user_id = ?
+		ORDER BY created_at DESC, id DESC
+		LIMIT ?
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, userID, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := rows.Close(); err != nil {
+			log.Printf("Failed to close rows: %v", err)
+		}
+	}()
+
+	var attempts []*domainuser.LoginAttempt
+	for rows.Next() {
+		a := &domainuser.LoginAttempt{}
+		err := rows.Scan(
+			&a.ID,
+			&a.UserID,
+			&a.Email,
+			&a.IPAddress,
+			&a.UserAgent,
+			&a.Success,
+			&a.CreatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		attempts = append(attempts, a)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return attempts, nil
+}
+
+// DeleteByUser removes every attempt of a user
+func (r *MySQLLoginAttemptRepository) DeleteByUser(ctx context.Context, userID int64) error {
+	query := `DELETE FROM login_attempts WHERE user_id = ?`
+
+	_, err := r.db.ExecContext(ctx, query, userID)
+	return err
+}
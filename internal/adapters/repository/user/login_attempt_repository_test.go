@@ -0,0 +1,165 @@
+package user
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	domainuser "github.com/rulzi/hexa-go/internal/domain/user"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMySQLLoginAttemptRepository_Create(t *testing.T) {
+	tests := []struct {
+		name    string
+		attempt *domainuser.LoginAttempt
+		setup   func(mock sqlmock.Sqlmock)
+		wantErr bool
+	}{
+		{
+			name:    "success create attempt for known user",
+			attempt: &domainuser.LoginAttempt{UserID: 1, Email: "test@example.com", IPAddress: "10.0.0.1", UserAgent: "curl", Success: true, CreatedAt: time.Now()},
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec("INSERT INTO login_attempts").
+					WithArgs(sql.NullInt64{Int64: 1, Valid: true}, "test@example.com", "10.0.0.1", "curl", true, sqlmock.AnyArg()).
+					WillReturnResult(sqlmock.NewResult(3, 1))
+			},
+		},
+		{
+			name:    "unknown email is stored without user",
+			attempt: &domainuser.LoginAttempt{Email: "nobody@example.com", IPAddress: "10.0.0.1", CreatedAt: time.Now()},
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec("INSERT INTO login_attempts").
+					WithArgs(sql.NullInt64{}, "nobody@example.com", "10.0.0.1", "", false, sqlmock.AnyArg()).
+					WillReturnResult(sqlmock.NewResult(3, 1))
+			},
+		},
+		{
+			name:    "error on database exec",
+			attempt: &domainuser.LoginAttempt{UserID: 1, Email: "test@example.com", CreatedAt: time.Now()},
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec("INSERT INTO login_attempts").
+					WillReturnError(errors.New("database error"))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+			}
+			defer func() {
+				mock.ExpectClose()
+				if err := db.Close(); err != nil {
+					t.Fatalf("Failed to close database connection: %v", err)
+				}
+			}()
+
+			repo := NewMySQLLoginAttemptRepository(db)
+			tt.setup(mock)
+
+			err = repo.Create(context.Background(), tt.attempt)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, int64(3), tt.attempt.ID)
+			}
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestMySQLLoginAttemptRepository_ListByUser(t *testing.T) {
+	columns := []string{"id", "user_id", "email", "ip_address", "user_agent", "success", "created_at"}
+
+	tests := []struct {
+		name    string
+		setup   func(mock sqlmock.Sqlmock)
+		wantLen int
+		wantErr bool
+	}{
+		{
+			name: "success list attempts",
+			setup: func(mock sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(columns).
+					AddRow(2, 1, "test@example.com", "10.0.0.1", "curl", true, time.Now()).
+					AddRow(1, 1, "test@example.com", "10.0.0.2", "curl", false, time.Now().Add(-time.Minute))
+				mock.ExpectQuery("SELECT id, user_id, email, ip_address, user_agent, success, created_at").
+					WithArgs(int64(1), 20).
+					WillReturnRows(rows)
+			},
+			wantLen: 2,
+		},
+		{
+			name: "error on database query",
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery("SELECT id, user_id, email, ip_address, user_agent, success, created_at").
+					WithArgs(int64(1), 20).
+					WillReturnError(errors.New("database error"))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+			}
+			defer func() {
+				mock.ExpectClose()
+				if err := db.Close(); err != nil {
+					t.Fatalf("Failed to close database connection: %v", err)
+				}
+			}()
+
+			repo := NewMySQLLoginAttemptRepository(db)
+			tt.setup(mock)
+
+			attempts, err := repo.ListByUser(context.Background(), 1, 20)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Len(t, attempts, tt.wantLen)
+				assert.False(t, attempts[1].Success)
+			}
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestMySQLLoginAttemptRepository_DeleteByUser(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer func() {
+		mock.ExpectClose()
+		if err := db.Close(); err != nil {
+			t.Fatalf("Failed to close database connection: %v", err)
+		}
+	}()
+
+	repo := NewMySQLLoginAttemptRepository(db)
+	mock.ExpectExec("DELETE FROM login_attempts WHERE user_id = \\?").
+		WithArgs(int64(1)).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+
+	err = repo.DeleteByUser(context.Background(), 1)
+
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,189 @@
+package user
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"log"
+
+	"github.com/go-sql-driver/mysql"
+	domainuser "github.com/rulzi/hexa-go/internal/domain/user"
+)
+
+// MySQLOrganizationRepository is the MySQL implementation of user.OrganizationRepository (driven adapter)
+type MySQLOrganizationRepository struct {
+	db *sql.DB
+}
+
+// NewMySQLOrganizationRepository creates a new MySQLOrganizationRepository
+func NewMySQLOrganizationRepository(db *sql.DB) *MySQLOrganizationRepository {
+	return &MySQLOrganizationRepository{db: db}
+}
+
+// Create creates a new organization
+func (r *MySQLOrganizationRepository) Create(ctx context.Context, org *domainuser.Organization) (*domainuser.Organization, error) {
+	query := `INSERT INTO organizations (name, created_at, updated_at) VALUES (?, ?, ?)`
+
+	result, err := r.db.ExecContext(ctx, query, org.Name, org.CreatedAt, org.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return nil, err
+	}
+
+	org.ID = id
+	return org, nil
+}
+
+// GetByID retrieves an organization by ID
+func (r *MySQLOrganizationRepository) GetByID(ctx context.Context, id int64) (*domainuser.Organization, error) {
+	query := `SELECT id, name, created_at, updated_at FROM organizations WHERE id = ?`
+
+	org := &domainuser.Organization{}
+	err := r.db.QueryRowContext(ctx, query, id).Scan(&org.ID, &org.Name, &org.CreatedAt, &org.UpdatedAt)
+	if err == sql.ErrNoRows {
+		return nil, domainuser.ErrOrganizationNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return org, nil
+}
+
+// ListByUser returns the organizations a user belongs to, oldest membership first
+func (r *MySQLOrganizationRepository) ListByUser(ctx context.Context, userID int64) ([]*domainuser.OrganizationMembership, error) {
+	query := `
+		SELECT o.id, o.name, o.created_at, o.updated_at, m.role, m.created_at
+		FROM organization_members m
+		INNER JOIN organizations o ON o.id = m.org_id
+		WHERE m.user_id = ?
+		ORDER BY m.created_at ASC, o.id ASC
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := rows.Close(); err != nil {
+			log.Printf("Failed to close rows: %v", err)
+		}
+	}()
+
+	var memberships []*domainuser.OrganizationMembership
+	for rows.Next() {
+		org := &domainuser.Organization{}
+		membership := &domainuser.OrganizationMembership{Organization: org}
+		err := rows.Scan(
+			&org.ID,
+			&org.Name,
+			&org.CreatedAt,
+			&org.UpdatedAt,
+			&membership.Role,
+			&membership.JoinedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		memberships = append(memberships, membership)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return memberships, nil
+}
+
+// AddMember adds a user to an organization
+func (r *MySQLOrganizationRepository) AddMember(ctx context.Context, membership *domainuser.Membership) error {
+	query := `INSERT INTO organization_members (org_id, user_id, role, created_at) VALUES (?, ?, ?, ?)`
+
+	_, err := r.db.ExecContext(ctx, query, membership.OrgID, membership.UserID, membership.Role, membership.CreatedAt)
+	var mysqlErr *mysql.MySQLError
+	if errors.As(err, &mysqlErr) && mysqlErr.Number == 1062 {
+		return domainuser.ErrAlreadyMember
+	}
+	return err
+}
+
+// GetMembership retrieves the membership of a user in an organization
+func (r *MySQLOrganizationRepository) GetMembership(ctx context.Context, orgID, userID int64) (*domainuser.Membership, error) {
+	query := `SELECT org_id, user_id, role, created_at FROM organization_members WHERE org_id = ? AND user_id = ?`
+
+	membership := &domainuser.Membership{}
+	err := r.db.QueryRowContext(ctx, query, orgID, userID).Scan(
+		&membership.OrgID,
+		&membership.UserID,
+		&membership.Role,
+		&membership.CreatedAt,
+	)
+	if err == sql.ErrNoRows {
+		return nil, domainuser.ErrNotMember
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return membership, nil
+}
+
+// UpdateMemberRole changes the role of a member
+func (r *MySQLOrganizationRepository) UpdateMemberRole(ctx context.Context, orgID, userID int64, role domainuser.Role) error {
+	query := `UPDATE organization_members SET role = ? WHERE org_id = ? AND user_id = ?`
+
+	result, err := r.db.ExecContext(ctx, query, role, orgID, userID)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	// MySQL counts changed rows only, a member that already has the role is looked up
+	if rowsAffected == 0 {
+		_, err = r.GetMembership(ctx, orgID, userID)
+		return err
+	}
+
+	return nil
+}
+
+// RemoveMember removes a user from an organization
+func (r *MySQLOrganizationRepository) RemoveMember(ctx context.Context, orgID, userID int64) error {
+	query := `DELETE FROM organization_members WHERE org_id = ? AND user_id = ?`
+
+	result, err := r.db.ExecContext(ctx, query, orgID, userID)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return domainuser.ErrNotMember
+	}
+
+	return nil
+}
+
+// CountMemberships returns the number of organizations a user belongs to
+func (r *MySQLOrganizationRepository) CountMemberships(ctx context.Context, userID int64) (int64, error) {
+	query := `SELECT COUNT(*) FROM organization_members WHERE user_id = ?`
+
+	var count int64
+	if err := r.db.QueryRowContext(ctx, query, userID).Scan(&count); err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
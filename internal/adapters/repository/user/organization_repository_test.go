@@ -0,0 +1,288 @@
+package user
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-sql-driver/mysql"
+	domainuser "github.com/rulzi/hexa-go/internal/domain/user"
+	"github.com/stretchr/testify/assert"
+)
+
+func newOrganizationRepositoryMock(t *testing.T) (*MySQLOrganizationRepository, sqlmock.Sqlmock, func()) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+
+	return NewMySQLOrganizationRepository(db), mock, func() {
+		mock.ExpectClose()
+		if err := db.Close(); err != nil {
+			t.Fatalf("Failed to close database connection: %v", err)
+		}
+	}
+}
+
+func TestMySQLOrganizationRepository_Create(t *testing.T) {
+	repo, mock, closeDB := newOrganizationRepositoryMock(t)
+	defer closeDB()
+
+	now := time.Now()
+	mock.ExpectExec("INSERT INTO organizations \\(name, created_at, updated_at\\)").
+		WithArgs("Acme", now, now).
+		WillReturnResult(sqlmock.NewResult(4, 1))
+
+	org, err := repo.Create(context.Background(), &domainuser.Organization{Name: "Acme", CreatedAt: now, UpdatedAt: now})
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(4), org.ID)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestMySQLOrganizationRepository_GetByID(t *testing.T) {
+	now := time.Now()
+
+	tests := []struct {
+		name    string
+		setup   func(mock sqlmock.Sqlmock)
+		want    *domainuser.Organization
+		wantErr error
+	}{
+		{
+			name: "success get organization",
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery("SELECT id, name, created_at, updated_at FROM organizations WHERE id = \\?").
+					WithArgs(int64(4)).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "name", "created_at", "updated_at"}).AddRow(4, "Acme", now, now))
+			},
+			want: &domainuser.Organization{ID: 4, Name: "Acme", CreatedAt: now, UpdatedAt: now},
+		},
+		{
+			name: "organization not found",
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery("FROM organizations").
+					WithArgs(int64(4)).
+					WillReturnError(sql.ErrNoRows)
+			},
+			wantErr: domainuser.ErrOrganizationNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo, mock, closeDB := newOrganizationRepositoryMock(t)
+			defer closeDB()
+			tt.setup(mock)
+
+			org, err := repo.GetByID(context.Background(), 4)
+
+			assert.Equal(t, tt.wantErr, err)
+			assert.Equal(t, tt.want, org)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestMySQLOrganizationRepository_ListByUser(t *testing.T) {
+	repo, mock, closeDB := newOrganizationRepositoryMock(t)
+	defer closeDB()
+
+	now := time.Now()
+	rows := sqlmock.NewRows([]string{"id", "name", "created_at", "updated_at", "role", "joined_at"}).
+		AddRow(1, "Default", now, now, "author", now).
+		AddRow(4, "Acme", now, now, "admin", now)
+	mock.ExpectQuery("FROM organization_members m\\s+INNER JOIN organizations o ON o.id = m.org_id\\s+WHERE m.user_id = \\?").
+		WithArgs(int64(7)).
+		WillReturnRows(rows)
+
+	memberships, err := repo.ListByUser(context.Background(), 7)
+
+	assert.NoError(t, err)
+	if assert.Len(t, memberships, 2) {
+		assert.Equal(t, "Default", memberships[0].Organization.Name)
+		assert.Equal(t, domainuser.RoleAuthor, memberships[0].Role)
+		assert.Equal(t, int64(4), memberships[1].Organization.ID)
+		assert.Equal(t, domainuser.RoleAdmin, memberships[1].Role)
+	}
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestMySQLOrganizationRepository_AddMember(t *testing.T) {
+	now := time.Now()
+	membership := &domainuser.Membership{OrgID: 4, UserID: 7, Role: domainuser.RoleEditor, CreatedAt: now}
+
+	tests := []struct {
+		name    string
+		result  error
+		wantErr error
+	}{
+		{name: "success add member"},
+		{name: "already a member", result: &mysql.MySQLError{Number: 1062}, wantErr: domainuser.ErrAlreadyMember},
+		{name: "database error", result: errors.New("database error"), wantErr: errors.New("database error")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo, mock, closeDB := newOrganizationRepositoryMock(t)
+			defer closeDB()
+
+			exec := mock.ExpectExec("INSERT INTO organization_members \\(org_id, user_id, role, created_at\\)").
+				WithArgs(int64(4), int64(7), domainuser.RoleEditor, now)
+			if tt.result != nil {
+				exec.WillReturnError(tt.result)
+			} else {
+				exec.WillReturnResult(sqlmock.NewResult(0, 1))
+			}
+
+			err := repo.AddMember(context.Background(), membership)
+
+			assert.Equal(t, tt.wantErr, err)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestMySQLOrganizationRepository_GetMembership(t *testing.T) {
+	now := time.Now()
+
+	tests := []struct {
+		name    string
+		setup   func(mock sqlmock.Sqlmock)
+		want    *domainuser.Membership
+		wantErr error
+	}{
+		{
+			name: "success get membership",
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery("SELECT org_id, user_id, role, created_at FROM organization_members WHERE org_id = \\? AND user_id = \\?").
+					WithArgs(int64(4), int64(7)).
+					WillReturnRows(sqlmock.NewRows([]string{"org_id", "user_id", "role", "created_at"}).AddRow(4, 7, "editor", now))
+			},
+			want: &domainuser.Membership{OrgID: 4, UserID: 7, Role: domainuser.RoleEditor, CreatedAt: now},
+		},
+		{
+			name: "not a member",
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery("FROM organization_members").
+					WithArgs(int64(4), int64(7)).
+					WillReturnError(sql.ErrNoRows)
+			},
+			wantErr: domainuser.ErrNotMember,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo, mock, closeDB := newOrganizationRepositoryMock(t)
+			defer closeDB()
+			tt.setup(mock)
+
+			membership, err := repo.GetMembership(context.Background(), 4, 7)
+
+			assert.Equal(t, tt.wantErr, err)
+			assert.Equal(t, tt.want, membership)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestMySQLOrganizationRepository_UpdateMemberRole(t *testing.T) {
+	now := time.Now()
+
+	tests := []struct {
+		name    string
+		setup   func(mock sqlmock.Sqlmock)
+		wantErr error
+	}{
+		{
+			name: "success update role",
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec("UPDATE organization_members SET role = \\? WHERE org_id = \\? AND user_id = \\?").
+					WithArgs(domainuser.RoleAdmin, int64(4), int64(7)).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+		},
+		{
+			name: "role unchanged",
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec("UPDATE organization_members").
+					WithArgs(domainuser.RoleAdmin, int64(4), int64(7)).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectQuery("FROM organization_members").
+					WithArgs(int64(4), int64(7)).
+					WillReturnRows(sqlmock.NewRows([]string{"org_id", "user_id", "role", "created_at"}).AddRow(4, 7, "admin", now))
+			},
+		},
+		{
+			name: "not a member",
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec("UPDATE organization_members").
+					WithArgs(domainuser.RoleAdmin, int64(4), int64(7)).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectQuery("FROM organization_members").
+					WithArgs(int64(4), int64(7)).
+					WillReturnError(sql.ErrNoRows)
+			},
+			wantErr: domainuser.ErrNotMember,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo, mock, closeDB := newOrganizationRepositoryMock(t)
+			defer closeDB()
+			tt.setup(mock)
+
+			err := repo.UpdateMemberRole(context.Background(), 4, 7, domainuser.RoleAdmin)
+
+			assert.Equal(t, tt.wantErr, err)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestMySQLOrganizationRepository_RemoveMember(t *testing.T) {
+	tests := []struct {
+		name         string
+		rowsAffected int64
+		wantErr      error
+	}{
+		{name: "success remove member", rowsAffected: 1},
+		{name: "not a member", rowsAffected: 0, wantErr: domainuser.ErrNotMember},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo, mock, closeDB := newOrganizationRepositoryMock(t)
+			defer closeDB()
+
+			mock.ExpectExec("DELETE FROM organization_members WHERE org_id = \\? AND user_id = \\?").
+				WithArgs(int64(4), int64(7)).
+				WillReturnResult(sqlmock.NewResult(0, tt.rowsAffected))
+
+			err := repo.RemoveMember(context.Background(), 4, 7)
+
+			assert.Equal(t, tt.wantErr, err)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestMySQLOrganizationRepository_CountMemberships(t *testing.T) {
+	repo, mock, closeDB := newOrganizationRepositoryMock(t)
+	defer closeDB()
+
+	mock.ExpectQuery("SELECT COUNT\\(\\*\\) FROM organization_members WHERE user_id = \\?").
+		WithArgs(int64(7)).
+		WillReturnRows(sqlmock.NewRows([]string{"COUNT(*)"}).AddRow(2))
+
+	count, err := repo.CountMemberships(context.Background(), 7)
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), count)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,92 @@
+package user
+
+import (
+	"context"
+	"database/sql"
+	"log"
+	"time"
+)
+
+// MySQLPasswordHistoryRepository is the MySQL implementation of user.PasswordHistoryRepository (driven adapter)
+type MySQLPasswordHistoryRepository struct {
+	db *sql.DB
+}
+
+// NewMySQLPasswordHistoryRepository creates a new MySQLPasswordHistoryRepository
+func NewMySQLPasswordHistoryRepository(db *sql.DB) *MySQLPasswordHistoryRepository {
+	return &MySQLPasswordHistoryRepository{db: db}
+}
+
+// Add records a password hash the user now has
+func (r *MySQLPasswordHistoryRepository) Add(ctx context.Context, userID int64, hash string, createdAt time.Time) error {
+	query := `
+		INSERT INTO password_history (user_id, password_hash, created_at)
+		VALUES (?, ?, ?)
+	`
+
+	_, err := r.db.ExecContext(ctx, query, userID, hash, createdAt)
+	return err
+}
+
+// ListRecent returns the most recent password hashes of a user, newest first
+func (r *MySQLPasswordHistoryRepository) ListRecent(ctx context.Context, userID int64, limit int) ([]string, error) {
+	query := `
+		SELECT password_hash
+		FROM password_history
+		WHERE user_id = ?
+		ORDER BY created_at DESC, id DESC
+		LIMIT ?
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, userID, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := rows.Close(); err != nil {
+			log.Printf("Failed to close rows: %v", err)
+		}
+	}()
+
+	var hashes []string
+	for rows.Next() {
+		var hash string
+		if err := rows.Scan(&hash); err != nil {
+			return nil, err
+		}
+		hashes = append(hashes, hash)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return hashes, nil
+}
+
+// Prune deletes all but the most recent keep password hashes of a user
+func (r *MySQLPasswordHistoryRepository) Prune(ctx context.Context, userID int64, keep int) error {
+	// MySQL doesn't allow LIMIT in an IN subquery, the derived table works around it
+	query := `
+		DELETE FROM password_history
+		WHERE user_id = ? AND id NOT IN (
+			SELECT id FROM (
+				SELECT id FROM password_history
+				WHERE user_id = ?
+				ORDER BY created_at DESC, id DESC
+				LIMIT ?
+			) AS recent
+		)
+	`
+
+	_, err := r.db.ExecContext(ctx, query, userID, userID, keep)
+	return err
+}
+
+// DeleteByUser removes every password hash of a user
+func (r *MySQLPasswordHistoryRepository) DeleteByUser(ctx context.Context, userID int64) error {
+	query := `DELETE FROM password_history WHERE user_id = ?`
+
+	_, err := r.db.ExecContext(ctx, query, userID)
+	return err
+}
@@ -0,0 +1,109 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func newPasswordHistoryRepository(t *testing.T) (*MySQLPasswordHistoryRepository, sqlmock.Sqlmock) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	t.Cleanup(func() {
+		mock.ExpectClose()
+		if err := db.Close(); err != nil {
+			t.Fatalf("Failed to close database connection: %v", err)
+		}
+	})
+	return NewMySQLPasswordHistoryRepository(db), mock
+}
+
+func TestMySQLPasswordHistoryRepository_Add(t *testing.T) {
+	repo, mock := newPasswordHistoryRepository(t)
+	now := time.Now()
+	mock.ExpectExec("INSERT INTO password_history").
+		WithArgs(int64(1), "hash", now).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	err := repo.Add(context.Background(), 1, "hash", now)
+
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestMySQLPasswordHistoryRepository_ListRecent(t *testing.T) {
+	tests := []struct {
+		name    string
+		setup   func(mock sqlmock.Sqlmock)
+		want    []string
+		wantErr bool
+	}{
+		{
+			name: "success list hashes",
+			setup: func(mock sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows([]string{"password_hash"}).AddRow("newest").AddRow("older")
+				mock.ExpectQuery("SELECT password_hash FROM password_history").
+					WithArgs(int64(1), 5).
+					WillReturnRows(rows)
+			},
+			want: []string{"newest", "older"},
+		},
+		{
+			name: "error on database query",
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery("SELECT password_hash FROM password_history").
+					WithArgs(int64(1), 5).
+					WillReturnError(errors.New("database error"))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo, mock := newPasswordHistoryRepository(t)
+			tt.setup(mock)
+
+			hashes, err := repo.ListRecent(context.Background(), 1, 5)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, hashes)
+			}
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestMySQLPasswordHistoryRepository_Prune(t *testing.T) {
+	repo, mock := newPasswordHistoryRepository(t)
+	mock.ExpectExec("DELETE FROM password_history WHERE user_id = \\? AND id NOT IN").
+		WithArgs(int64(1), int64(1), 5).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	err := repo.Prune(context.Background(), 1, 5)
+
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestMySQLPasswordHistoryRepository_DeleteByUser(t *testing.T) {
+	repo, mock := newPasswordHistoryRepository(t)
+	mock.ExpectExec("DELETE FROM password_history WHERE user_id = \\?").
+		WithArgs(int64(1)).
+		WillReturnResult(sqlmock.NewResult(0, 3))
+
+	err := repo.DeleteByUser(context.Background(), 1)
+
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
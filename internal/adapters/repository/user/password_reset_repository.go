@@ -0,0 +1,102 @@
+package user
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	domainuser "github.com/rulzi/hexa-go/internal/domain/user"
+)
+
+// MySQLPasswordResetRepository is the MySQL implementation of user.PasswordResetRepository (driven adapter)
+type MySQLPasswordResetRepository struct {
+	db *sql.DB
+}
+
+// NewMySQLPasswordResetRepository creates a new MySQLPasswordResetRepository
+func NewMySQLPasswordResetRepository(db *sql.DB) *MySQLPasswordResetRepository {
+	return &MySQLPasswordResetRepository{db: db}
+}
+
+// Create stores a new password reset token
+func (r *MySQLPasswordResetRepository) Create(ctx context.Context, t *domainuser.PasswordResetToken) (*domainuser.PasswordResetToken, error) {
+	query := `
+		INSERT INTO password_reset_tokens (user_id, token_hash, expires_at, created_at)
+		VALUES (?, ?, ?, ?)
+	`
+
+	result, err := r.db.ExecContext(ctx, query, t.UserID, t.TokenHash, t.ExpiresAt, t.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return nil, err
+	}
+
+	t.ID = id
+	return t, nil
+}
+
+// GetByHash retrieves a password reset token by the hash of its value
+func (r *MySQLPasswordResetRepository) GetByHash(ctx context.Context, tokenHash string) (*domainuser.PasswordResetToken, error) {
+	query := `
+		SELECT id, user_id, token_hash, expires_at, used_at, created_at
+		FROM password_reset_tokens
+		WHERE token_hash = ?
+	`
+
+	t := &domainuser.PasswordResetToken{}
+	var usedAt sql.NullTime
+	err := r.db.QueryRowContext(ctx, query, tokenHash).Scan(
+		&t.ID,
+		&t.UserID,
+		&t.TokenHash,
+		&t.ExpiresAt,
+		&usedAt,
+		&t.CreatedAt,
+	)
+
+	if err == sql.ErrNoRows {
+		return nil, domainuser.ErrInvalidResetToken
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if usedAt.Valid {
+		t.UsedAt = &usedAt.Time
+	}
+
+	return t, nil
+}
+
+// MarkUsed consumes a password reset token if it has not been used yet
+func (r *MySQLPasswordResetRepository) MarkUsed(ctx context.Context, id int64) error {
+	query := `UPDATE password_reset_tokens SET used_at = ? WHERE id = ? AND used_at IS NULL`
+
+	result, err := r.db.ExecContext(ctx, query, time.Now(), id)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return domainuser.ErrInvalidResetToken
+	}
+
+	return nil
+}
+
+// InvalidateByUser consumes every unused password reset token of a user
+func (r *MySQLPasswordResetRepository) InvalidateByUser(ctx context.Context, userID int64) error {
+	query := `UPDATE password_reset_tokens SET used_at = ? WHERE user_id = ? AND used_at IS NULL`
+
+	_, err := r.db.ExecContext(ctx, query, time.Now(), userID)
+	return err
+}
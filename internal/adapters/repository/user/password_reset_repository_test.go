@@ -0,0 +1,245 @@
+package user
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	domainuser "github.com/rulzi/hexa-go/internal/domain/user"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewMySQLPasswordResetRepository(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer func() {
+		mock.ExpectClose()
+		if err := db.Close(); err != nil {
+			t.Fatalf("Failed to close database connection: %v", err)
+		}
+	}()
+
+	repo := NewMySQLPasswordResetRepository(db)
+	assert.NotNil(t, repo)
+	assert.Equal(t, db, repo.db)
+}
+
+func TestMySQLPasswordResetRepository_Create(t *testing.T) {
+	tests := []struct {
+		name    string
+		setup   func(mock sqlmock.Sqlmock)
+		wantErr bool
+	}{
+		{
+			name: "success create password reset token",
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec("INSERT INTO password_reset_tokens").
+					WithArgs(int64(1), "hash-1", sqlmock.AnyArg(), sqlmock.AnyArg()).
+					WillReturnResult(sqlmock.NewResult(5, 1))
+			},
+			wantErr: false,
+		},
+		{
+			name: "error on database exec",
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec("INSERT INTO password_reset_tokens").
+					WithArgs(int64(1), "hash-1", sqlmock.AnyArg(), sqlmock.AnyArg()).
+					WillReturnError(errors.New("database error"))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+			}
+			defer func() {
+				mock.ExpectClose()
+				if err := db.Close(); err != nil {
+					t.Fatalf("Failed to close database connection: %v", err)
+				}
+			}()
+
+			repo := NewMySQLPasswordResetRepository(db)
+			tt.setup(mock)
+
+			result, err := repo.Create(context.Background(), &domainuser.PasswordResetToken{
+				UserID:    1,
+				TokenHash: "hash-1",
+				ExpiresAt: time.Now().Add(time.Hour),
+				CreatedAt: time.Now(),
+			})
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Nil(t, result)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, int64(5), result.ID)
+			}
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestMySQLPasswordResetRepository_GetByHash(t *testing.T) {
+	columns := []string{"id", "user_id", "token_hash", "expires_at", "used_at", "created_at"}
+	usedAt := time.Now()
+
+	tests := []struct {
+		name    string
+		setup   func(mock sqlmock.Sqlmock)
+		wantErr error
+		check   func(t *testing.T, token *domainuser.PasswordResetToken)
+	}{
+		{
+			name: "unused token",
+			setup: func(mock sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(columns).
+					AddRow(1, 2, "hash-1", time.Now().Add(time.Hour), nil, time.Now())
+				mock.ExpectQuery("SELECT id, user_id, token_hash, expires_at, used_at, created_at").
+					WithArgs("hash-1").
+					WillReturnRows(rows)
+			},
+			check: func(t *testing.T, token *domainuser.PasswordResetToken) {
+				assert.Equal(t, int64(1), token.ID)
+				assert.Equal(t, int64(2), token.UserID)
+				assert.Nil(t, token.UsedAt)
+			},
+		},
+		{
+			name: "used token",
+			setup: func(mock sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(columns).
+					AddRow(1, 2, "hash-1", time.Now().Add(time.Hour), usedAt, time.Now())
+				mock.ExpectQuery("SELECT id, user_id, token_hash, expires_at, used_at, created_at").
+					WithArgs("hash-1").
+					WillReturnRows(rows)
+			},
+			check: func(t *testing.T, token *domainuser.PasswordResetToken) {
+				assert.NotNil(t, token.UsedAt)
+				assert.True(t, token.IsUsed())
+			},
+		},
+		{
+			name: "token not found",
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery("SELECT id, user_id, token_hash, expires_at, used_at, created_at").
+					WithArgs("hash-1").
+					WillReturnError(sql.ErrNoRows)
+			},
+			wantErr: domainuser.ErrInvalidResetToken,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+			}
+			defer func() {
+				mock.ExpectClose()
+				if err := db.Close(); err != nil {
+					t.Fatalf("Failed to close database connection: %v", err)
+				}
+			}()
+
+			repo := NewMySQLPasswordResetRepository(db)
+			tt.setup(mock)
+
+			result, err := repo.GetByHash(context.Background(), "hash-1")
+
+			if tt.wantErr != nil {
+				assert.Equal(t, tt.wantErr, err)
+				assert.Nil(t, result)
+			} else {
+				assert.NoError(t, err)
+				tt.check(t, result)
+			}
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestMySQLPasswordResetRepository_MarkUsed(t *testing.T) {
+	tests := []struct {
+		name    string
+		setup   func(mock sqlmock.Sqlmock)
+		wantErr error
+	}{
+		{
+			name: "success mark used",
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec("UPDATE password_reset_tokens SET used_at").
+					WithArgs(sqlmock.AnyArg(), int64(1)).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+		},
+		{
+			name: "already used",
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec("UPDATE password_reset_tokens SET used_at").
+					WithArgs(sqlmock.AnyArg(), int64(1)).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+			},
+			wantErr: domainuser.ErrInvalidResetToken,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+			}
+			defer func() {
+				mock.ExpectClose()
+				if err := db.Close(); err != nil {
+					t.Fatalf("Failed to close database connection: %v", err)
+				}
+			}()
+
+			repo := NewMySQLPasswordResetRepository(db)
+			tt.setup(mock)
+
+			err = repo.MarkUsed(context.Background(), 1)
+
+			assert.Equal(t, tt.wantErr, err)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestMySQLPasswordResetRepository_InvalidateByUser(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer func() {
+		mock.ExpectClose()
+		if err := db.Close(); err != nil {
+			t.Fatalf("Failed to close database connection: %v", err)
+		}
+	}()
+
+	mock.ExpectExec("UPDATE password_reset_tokens SET used_at (.+) WHERE user_id").
+		WithArgs(sqlmock.AnyArg(), int64(1)).
+		WillReturnError(errors.New("database error"))
+
+	repo := NewMySQLPasswordResetRepository(db)
+	err = repo.InvalidateByUser(context.Background(), 1)
+
+	assert.Error(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,112 @@
+package user
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	domainuser "github.com/rulzi/hexa-go/internal/domain/user"
+)
+
+// MySQLRefreshTokenRepository is the MySQL implementation of user.RefreshTokenRepository (driven adapter)
+type MySQLRefreshTokenRepository struct {
+	db *sql.DB
+}
+
+// NewMySQLRefreshTokenRepository creates a new MySQLRefreshTokenRepository
+func NewMySQLRefreshTokenRepository(db *sql.DB) *MySQLRefreshTokenRepository {
+	return &MySQLRefreshTokenRepository{db: db}
+}
+
+// Create stores a new refresh token
+func (r *MySQLRefreshTokenRepository) Create(ctx context.Context, t *domainuser.RefreshToken) (*domainuser.RefreshToken, error) {
+	query := `
+		INSERT INTO refresh_tokens (user_id, family_id, org_id, token_hash, expires_at, created_at)
+		VALUES (?, ?, ?, ?, ?, ?)
+	`
+
+	result, err := r.db.ExecContext(ctx, query, t.UserID, t.FamilyID, t.OrgID, t.TokenHash, t.ExpiresAt, t.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return nil, err
+	}
+
+	t.ID = id
+	return t, nil
+}
+
+// GetByHash retrieves a refresh token by the hash of its value
+func (r *MySQLRefreshTokenRepository) GetByHash(ctx context.Context, tokenHash string) (*domainuser.RefreshToken, error) {
+	query := `
+		SELECT id, user_id, family_id, org_id, token_hash, expires_at, revoked_at, created_at
+		FROM refresh_tokens
+		WHERE token_hash = ?
+	`
+
+	t := &domainuser.RefreshToken{}
+	var revokedAt sql.NullTime
+	err := r.db.QueryRowContext(ctx, query, tokenHash).Scan(
+		&t.ID,
+		&t.UserID,
+		&t.FamilyID,
+		&t.OrgID,
+		&t.TokenHash,
+		&t.ExpiresAt,
+		&revokedAt,
+		&t.CreatedAt,
+	)
+
+	if err == sql.ErrNoRows {
+		return nil, domainuser.ErrInvalidRefreshToken
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if revokedAt.Valid {
+		t.RevokedAt = &revokedAt.Time
+	}
+
+	return t, nil
+}
+
+// Revoke revokes a single refresh token if it is still active
+func (r *MySQLRefreshTokenRepository) Revoke(ctx context.Context, id int64) error {
+	query := `UPDATE refresh_tokens SET revoked_at = ? WHERE id = ? AND revoked_at IS NULL`
+
+	result, err := r.db.ExecContext(ctx, query, time.Now(), id)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return domainuser.ErrInvalidRefreshToken
+	}
+
+	return nil
+}
+
+// RevokeFamily revokes every active token of a family
+func (r *MySQLRefreshTokenRepository) RevokeFamily(ctx context.Context, familyID string) error {
+	query := `UPDATE refresh_tokens SET revoked_at = ? WHERE family_id = ? AND revoked_at IS NULL`
+
+	_, err := r.db.ExecContext(ctx, query, time.Now(), familyID)
+	return err
+}
+
+// RevokeByUser revokes every active token issued to a user
+func (r *MySQLRefreshTokenRepository) RevokeByUser(ctx context.Context, userID int64) error {
+	query := `UPDATE refresh_tokens SET revoked_at = ? WHERE user_id = ? AND revoked_at IS NULL`
+
+	_, err := r.db.ExecContext(ctx, query, time.Now(), userID)
+	return err
+}
@@ -0,0 +1,272 @@
+package user
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	domainuser "github.com/rulzi/hexa-go/internal/domain/user"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewMySQLRefreshTokenRepository(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer func() {
+		mock.ExpectClose()
+		if err := db.Close(); err != nil {
+			t.Fatalf("Failed to close database connection: %v", err)
+		}
+	}()
+
+	repo := NewMySQLRefreshTokenRepository(db)
+	assert.NotNil(t, repo)
+	assert.Equal(t, db, repo.db)
+}
+
+func TestMySQLRefreshTokenRepository_Create(t *testing.T) {
+	tests := []struct {
+		name    string
+		setup   func(mock sqlmock.Sqlmock)
+		wantErr bool
+	}{
+		{
+			name: "success create refresh token",
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec("INSERT INTO refresh_tokens").
+					WithArgs(int64(1), "family-1", int64(2), "hash-1", sqlmock.AnyArg(), sqlmock.AnyArg()).
+					WillReturnResult(sqlmock.NewResult(5, 1))
+			},
+			wantErr: false,
+		},
+		{
+			name: "error on database exec",
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec("INSERT INTO refresh_tokens").
+					WithArgs(int64(1), "family-1", int64(2), "hash-1", sqlmock.AnyArg(), sqlmock.AnyArg()).
+					WillReturnError(errors.New("database error"))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+			}
+			defer func() {
+				mock.ExpectClose()
+				if err := db.Close(); err != nil {
+					t.Fatalf("Failed to close database connection: %v", err)
+				}
+			}()
+
+			repo := NewMySQLRefreshTokenRepository(db)
+			tt.setup(mock)
+
+			result, err := repo.Create(context.Background(), &domainuser.RefreshToken{
+				UserID:    1,
+				FamilyID:  "family-1",
+				OrgID:     2,
+				TokenHash: "hash-1",
+				ExpiresAt: time.Now().Add(time.Hour),
+				CreatedAt: time.Now(),
+			})
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Nil(t, result)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, int64(5), result.ID)
+			}
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestMySQLRefreshTokenRepository_GetByHash(t *testing.T) {
+	columns := []string{"id", "user_id", "family_id", "org_id", "token_hash", "expires_at", "revoked_at", "created_at"}
+	revokedAt := time.Now()
+
+	tests := []struct {
+		name    string
+		setup   func(mock sqlmock.Sqlmock)
+		wantErr error
+		check   func(t *testing.T, token *domainuser.RefreshToken)
+	}{
+		{
+			name: "active token",
+			setup: func(mock sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(columns).
+					AddRow(1, 2, "family-1", 1, "hash-1", time.Now().Add(time.Hour), nil, time.Now())
+				mock.ExpectQuery("SELECT id, user_id, family_id, org_id, token_hash, expires_at, revoked_at, created_at").
+					WithArgs("hash-1").
+					WillReturnRows(rows)
+			},
+			check: func(t *testing.T, token *domainuser.RefreshToken) {
+				assert.Equal(t, int64(1), token.ID)
+				assert.Equal(t, int64(2), token.UserID)
+				assert.Equal(t, "family-1", token.FamilyID)
+				assert.Equal(t, int64(1), token.OrgID)
+				assert.Nil(t, token.RevokedAt)
+			},
+		},
+		{
+			name: "revoked token",
+			setup: func(mock sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(columns).
+					AddRow(1, 2, "family-1", 1, "hash-1", time.Now().Add(time.Hour), revokedAt, time.Now())
+				mock.ExpectQuery("SELECT id, user_id, family_id, org_id, token_hash, expires_at, revoked_at, created_at").
+					WithArgs("hash-1").
+					WillReturnRows(rows)
+			},
+			check: func(t *testing.T, token *domainuser.RefreshToken) {
+				assert.NotNil(t, token.RevokedAt)
+				assert.True(t, token.IsRevoked())
+			},
+		},
+		{
+			name: "token not found",
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery("SELECT id, user_id, family_id, org_id, token_hash, expires_at, revoked_at, created_at").
+					WithArgs("hash-1").
+					WillReturnError(sql.ErrNoRows)
+			},
+			wantErr: domainuser.ErrInvalidRefreshToken,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+			}
+			defer func() {
+				mock.ExpectClose()
+				if err := db.Close(); err != nil {
+					t.Fatalf("Failed to close database connection: %v", err)
+				}
+			}()
+
+			repo := NewMySQLRefreshTokenRepository(db)
+			tt.setup(mock)
+
+			result, err := repo.GetByHash(context.Background(), "hash-1")
+
+			if tt.wantErr != nil {
+				assert.Equal(t, tt.wantErr, err)
+				assert.Nil(t, result)
+			} else {
+				assert.NoError(t, err)
+				tt.check(t, result)
+			}
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestMySQLRefreshTokenRepository_Revoke(t *testing.T) {
+	tests := []struct {
+		name    string
+		setup   func(mock sqlmock.Sqlmock)
+		wantErr error
+	}{
+		{
+			name: "success revoke",
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec("UPDATE refresh_tokens SET revoked_at").
+					WithArgs(sqlmock.AnyArg(), int64(1)).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+		},
+		{
+			name: "already revoked",
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec("UPDATE refresh_tokens SET revoked_at").
+					WithArgs(sqlmock.AnyArg(), int64(1)).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+			},
+			wantErr: domainuser.ErrInvalidRefreshToken,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+			}
+			defer func() {
+				mock.ExpectClose()
+				if err := db.Close(); err != nil {
+					t.Fatalf("Failed to close database connection: %v", err)
+				}
+			}()
+
+			repo := NewMySQLRefreshTokenRepository(db)
+			tt.setup(mock)
+
+			err = repo.Revoke(context.Background(), 1)
+
+			assert.Equal(t, tt.wantErr, err)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestMySQLRefreshTokenRepository_RevokeFamily(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer func() {
+		mock.ExpectClose()
+		if err := db.Close(); err != nil {
+			t.Fatalf("Failed to close database connection: %v", err)
+		}
+	}()
+
+	mock.ExpectExec("UPDATE refresh_tokens SET revoked_at (.+) WHERE family_id").
+		WithArgs(sqlmock.AnyArg(), "family-1").
+		WillReturnResult(sqlmock.NewResult(0, 3))
+
+	repo := NewMySQLRefreshTokenRepository(db)
+	err = repo.RevokeFamily(context.Background(), "family-1")
+
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestMySQLRefreshTokenRepository_RevokeByUser(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer func() {
+		mock.ExpectClose()
+		if err := db.Close(); err != nil {
+			t.Fatalf("Failed to close database connection: %v", err)
+		}
+	}()
+
+	mock.ExpectExec("UPDATE refresh_tokens SET revoked_at (.+) WHERE user_id").
+		WithArgs(sqlmock.AnyArg(), int64(1)).
+		WillReturnError(errors.New("database error"))
+
+	repo := NewMySQLRefreshTokenRepository(db)
+	err = repo.RevokeByUser(context.Background(), 1)
+
+	assert.Error(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
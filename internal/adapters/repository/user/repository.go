@@ -3,8 +3,12 @@ package user
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"log"
+	"strings"
+	"time"
 
+	"github.com/go-sql-driver/mysql"
 	domainuser "github.com/rulzi/hexa-go/internal/domain/user"
 )
 
@@ -21,13 +25,13 @@ func NewMySQLRepository(db *sql.DB) *MySQLRepository {
 // Create creates a new user
 func (r *MySQLRepository) Create(ctx context.Context, u *domainuser.User) (*domainuser.User, error) {
 	query := `
-		INSERT INTO users (name, email, password, created_at, updated_at)
-		VALUES (?, ?, ?, ?, ?)
+		INSERT INTO users (name, email, password, role, email_verified_at, created_at, updated_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?)
 	`
 
-	result, err := r.db.ExecContext(ctx, query, u.Name, u.Email, u.Password, u.CreatedAt, u.UpdatedAt)
+	result, err := r.db.ExecContext(ctx, query, u.Name, u.Email, u.Password, u.Role, u.EmailVerifiedAt, u.CreatedAt, u.UpdatedAt)
 	if err != nil {
-		return nil, err
+		return nil, mapDuplicateEmail(err)
 	}
 
 	id, err := result.LastInsertId()
@@ -39,20 +43,22 @@ func (r *MySQLRepository) Create(ctx context.Context, u *domainuser.User) (*doma
 	return u, nil
 }
 
-// GetByID retrieves a user by ID
-func (r *MySQLRepository) GetByID(ctx context.Context, id int64) (*domainuser.User, error) {
-	query := `
-		SELECT id, name, email, password, created_at, updated_at
+// GetByID retrieves a user by ID, a member of orgID unless orgID is zero
+func (r *MySQLRepository) GetByID(ctx context.Context, orgID, id int64) (*domainuser.User, error) {
+	query, args := memberOf(`
+		SELECT id, name, email, password, role, email_verified_at, created_at, updated_at
 		FROM users
-		WHERE id = ?
-	`
+		WHERE id = ? AND deleted_at IS NULL`, orgID, id)
 
 	u := &domainuser.User{}
-	err := r.db.QueryRowContext(ctx, query, id).Scan(
+	var emailVerifiedAt sql.NullTime
+	err := r.db.QueryRowContext(ctx, query, args...).Scan(
 		&u.ID,
 		&u.Name,
 		&u.Email,
 		&u.Password,
+		&u.Role,
+		&emailVerifiedAt,
 		&u.CreatedAt,
 		&u.UpdatedAt,
 	)
@@ -64,23 +70,30 @@ func (r *MySQLRepository) GetByID(ctx context.Context, id int64) (*domainuser.Us
 		return nil, err
 	}
 
+	if emailVerifiedAt.Valid {
+		u.EmailVerifiedAt = &emailVerifiedAt.Time
+	}
+
 	return u, nil
 }
 
 // GetByEmail retrieves a user by email
 func (r *MySQLRepository) GetByEmail(ctx context.Context, email string) (*domainuser.User, error) {
 	query := `
-		SELECT id, name, email, password, created_at, updated_at
+		SELECT id, name, email, password, role, email_verified_at, created_at, updated_at
 		FROM users
-		WHERE email = ?
+		WHERE email = ? AND deleted_at IS NULL
 	`
 
 	u := &domainuser.User{}
+	var emailVerifiedAt sql.NullTime
 	err := r.db.QueryRowContext(ctx, query, email).Scan(
 		&u.ID,
 		&u.Name,
 		&u.Email,
 		&u.Password,
+		&u.Role,
+		&emailVerifiedAt,
 		&u.CreatedAt,
 		&u.UpdatedAt,
 	)
@@ -92,30 +105,33 @@ func (r *MySQLRepository) GetByEmail(ctx context.Context, email string) (*domain
 		return nil, err
 	}
 
+	if emailVerifiedAt.Valid {
+		u.EmailVerifiedAt = &emailVerifiedAt.Time
+	}
+
 	return u, nil
 }
 
-// Update updates an existing user
-func (r *MySQLRepository) Update(ctx context.Context, u *domainuser.User) (*domainuser.User, error) {
-	query := `
+// Update updates an existing user, a member of orgID unless orgID is zero
+func (r *MySQLRepository) Update(ctx context.Context, orgID int64, u *domainuser.User) (*domainuser.User, error) {
+	query, args := memberOf(`
 		UPDATE users
-		SET name = ?, email = ?, password = ?, updated_at = ?
-		WHERE id = ?
-	`
+		SET name = ?, email = ?, password = ?, role = ?, email_verified_at = ?, updated_at = ?
+		WHERE id = ? AND deleted_at IS NULL`, orgID, u.Name, u.Email, u.Password, u.Role, u.EmailVerifiedAt, u.UpdatedAt, u.ID)
 
-	_, err := r.db.ExecContext(ctx, query, u.Name, u.Email, u.Password, u.UpdatedAt, u.ID)
+	_, err := r.db.ExecContext(ctx, query, args...)
 	if err != nil {
-		return nil, err
+		return nil, mapDuplicateEmail(err)
 	}
 
 	return u, nil
 }
 
-// Delete deletes a user by ID
-func (r *MySQLRepository) Delete(ctx context.Context, id int64) error {
-	query := `DELETE FROM users WHERE id = ?`
+// Delete moves a user to the trash by setting deleted_at, a member of orgID unless orgID is zero
+func (r *MySQLRepository) Delete(ctx context.Context, orgID, id int64) error {
+	query, args := memberOf(`UPDATE users SET deleted_at = ? WHERE id = ? AND deleted_at IS NULL`, orgID, time.Now(), id)
 
-	result, err := r.db.ExecContext(ctx, query, id)
+	result, err := r.db.ExecContext(ctx, query, args...)
 	if err != nil {
 		return err
 	}
@@ -132,16 +148,207 @@ func (r *MySQLRepository) Delete(ctx context.Context, id int64) error {
 	return nil
 }
 
-// List retrieves all users with pagination
-func (r *MySQLRepository) List(ctx context.Context, limit, offset int) ([]*domainuser.User, error) {
+// List retrieves the members of an organization with pagination, with their role in the organization
+func (r *MySQLRepository) List(ctx context.Context, orgID int64, limit, offset int) ([]*domainuser.User, error) {
 	query := `
-		SELECT id, name, email, password, created_at, updated_at
-		FROM users
-		ORDER BY created_at DESC
+		SELECT u.id, u.name, u.email, u.password, m.role, u.email_verified_at, u.created_at, u.updated_at
+		FROM users u
+		INNER JOIN organization_members m ON m.user_id = u.id AND m.org_id = ?
+		WHERE u.deleted_at IS NULL
+		ORDER BY u.created_at DESC
+		LIMIT ? OFFSET ?
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, orgID, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := rows.Close(); err != nil {
+			log.Printf("Failed to close rows: %v", err)
+		}
+	}()
+
+	var users []*domainuser.User
+	for rows.Next() {
+		u := &domainuser.User{}
+		var emailVerifiedAt sql.NullTime
+		err := rows.Scan(
+			&u.ID,
+			&u.Name,
+			&u.Email,
+			&u.Password,
+			&u.Role,
+			&emailVerifiedAt,
+			&u.CreatedAt,
+			&u.UpdatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		if emailVerifiedAt.Valid {
+			u.EmailVerifiedAt = &emailVerifiedAt.Time
+		}
+		users = append(users, u)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return users, nil
+}
+
+// Count returns the number of members of an organization
+func (r *MySQLRepository) Count(ctx context.Context, orgID int64) (int64, error) {
+	query := `
+		SELECT COUNT(*)
+		FROM users u
+		INNER JOIN organization_members m ON m.user_id = u.id AND m.org_id = ?
+		WHERE u.deleted_at IS NULL
+	`
+
+	var count int64
+	err := r.db.QueryRowContext(ctx, query, orgID).Scan(&count)
+	if err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+// sortColumns maps the fields users can be ordered by to their column.
+// Only these columns ever end up in an ORDER BY clause.
+var sortColumns = map[domainuser.SortField]string{
+	domainuser.SortByCreatedAt: "u.created_at",
+	domainuser.SortByName:      "u.name",
+	domainuser.SortByEmail:     "u.email",
+	domainuser.SortByRole:      "m.role",
+}
+
+// likeEscaper escapes the wildcards of a LIKE pattern
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// Find retrieves the members of an organization matching the criteria, with their role in the organization,
+// and the number of members matching it
+func (r *MySQLRepository) Find(ctx context.Context, criteria domainuser.Criteria) ([]*domainuser.User, int64, error) {
+	if err := criteria.Validate(); err != nil {
+		return nil, 0, err
+	}
+
+	from := `
+		FROM users u
+		INNER JOIN organization_members m ON m.user_id = u.id AND m.org_id = ?
+		WHERE u.deleted_at IS NULL`
+	args := []interface{}{criteria.OrgID}
+
+	if criteria.Search != "" {
+		pattern := "%" + likeEscaper.Replace(criteria.Search) + "%"
+		from += " AND (u.name LIKE ? OR u.email LIKE ?)"
+		args = append(args, pattern, pattern)
+	}
+	if criteria.Role != "" {
+		from += " AND m.role = ?"
+		args = append(args, criteria.Role)
+	}
+	if criteria.CreatedFrom != nil {
+		from += " AND u.created_at >= ?"
+		args = append(args, *criteria.CreatedFrom)
+	}
+	if criteria.CreatedTo != nil {
+		from += " AND u.created_at < ?"
+		args = append(args, *criteria.CreatedTo)
+	}
+
+	var total int64
+	if err := r.db.QueryRowContext(ctx, "SELECT COUNT(*)"+from, args...).Scan(&total); err != nil {
+		return nil, 0, err
+	}
+
+	// The ID breaks ties so pages don't overlap
+	direction := "DESC"
+	if criteria.SortDir == domainuser.SortAsc {
+		direction = "ASC"
+	}
+	query := `
+		SELECT u.id, u.name, u.email, u.password, m.role, u.email_verified_at, u.created_at, u.updated_at` + from + `
+		ORDER BY ` + sortColumns[criteria.SortBy] + " " + direction + ", u.id " + direction + `
+		LIMIT ? OFFSET ?
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, append(args, criteria.Limit, criteria.Offset)...)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer func() {
+		if err := rows.Close(); err != nil {
+			log.Printf("Failed to close rows: %v", err)
+		}
+	}()
+
+	var users []*domainuser.User
+	for rows.Next() {
+		u := &domainuser.User{}
+		var emailVerifiedAt sql.NullTime
+		err := rows.Scan(
+			&u.ID,
+			&u.Name,
+			&u.Email,
+			&u.Password,
+			&u.Role,
+			&emailVerifiedAt,
+			&u.CreatedAt,
+			&u.UpdatedAt,
+		)
+		if err != nil {
+			return nil, 0, err
+		}
+		if emailVerifiedAt.Valid {
+			u.EmailVerifiedAt = &emailVerifiedAt.Time
+		}
+		users = append(users, u)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, 0, err
+	}
+
+	return users, total, nil
+}
+
+// Restore takes a user out of the trash, a member of orgID unless orgID is zero
+func (r *MySQLRepository) Restore(ctx context.Context, orgID, id int64) error {
+	query, args := memberOf(`UPDATE users SET deleted_at = NULL WHERE id = ? AND deleted_at IS NOT NULL`, orgID, id)
+
+	result, err := r.db.ExecContext(ctx, query, args...)
+	if err != nil {
+		return mapDuplicateEmail(err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return domainuser.ErrUserNotFound
+	}
+
+	return nil
+}
+
+// ListDeleted retrieves the members of an organization that are in the trash with pagination, most recently deleted first
+func (r *MySQLRepository) ListDeleted(ctx context.Context, orgID int64, limit, offset int) ([]*domainuser.User, error) {
+	query := `
+		SELECT u.id, u.name, u.email, u.password, m.role, u.email_verified_at, u.created_at, u.updated_at, u.deleted_at
+		FROM users u
+		INNER JOIN organization_members m ON m.user_id = u.id AND m.org_id = ?
+		WHERE u.deleted_at IS NOT NULL
+		ORDER BY u.deleted_at DESC
 		LIMIT ? OFFSET ?
 	`
 
-	rows, err := r.db.QueryContext(ctx, query, limit, offset)
+	rows, err := r.db.QueryContext(ctx, query, orgID, limit, offset)
 	if err != nil {
 		return nil, err
 	}
@@ -154,17 +361,26 @@ func (r *MySQLRepository) List(ctx context.Context, limit, offset int) ([]*domai
 	var users []*domainuser.User
 	for rows.Next() {
 		u := &domainuser.User{}
+		var emailVerifiedAt sql.NullTime
+		var deletedAt time.Time
 		err := rows.Scan(
 			&u.ID,
 			&u.Name,
 			&u.Email,
 			&u.Password,
+			&u.Role,
+			&emailVerifiedAt,
 			&u.CreatedAt,
 			&u.UpdatedAt,
+			&deletedAt,
 		)
 		if err != nil {
 			return nil, err
 		}
+		if emailVerifiedAt.Valid {
+			u.EmailVerifiedAt = &emailVerifiedAt.Time
+		}
+		u.DeletedAt = &deletedAt
 		users = append(users, u)
 	}
 
@@ -175,15 +391,103 @@ func (r *MySQLRepository) List(ctx context.Context, limit, offset int) ([]*domai
 	return users, nil
 }
 
-// Count returns the total number of users
-func (r *MySQLRepository) Count(ctx context.Context) (int64, error) {
-	query := `SELECT COUNT(*) FROM users`
+// CountDeleted returns the number of members of an organization that are in the trash
+func (r *MySQLRepository) CountDeleted(ctx context.Context, orgID int64) (int64, error) {
+	query := `
+		SELECT COUNT(*)
+		FROM users u
+		INNER JOIN organization_members m ON m.user_id = u.id AND m.org_id = ?
+		WHERE u.deleted_at IS NOT NULL
+	`
 
 	var count int64
-	err := r.db.QueryRowContext(ctx, query).Scan(&count)
+	err := r.db.QueryRowContext(ctx, query, orgID).Scan(&count)
 	if err != nil {
 		return 0, err
 	}
 
 	return count, nil
 }
+
+// ListDeletedIDsBefore retrieves the IDs of up to limit users moved to the trash before the given time, oldest first.
+// Users who still author articles are left out, they cannot be purged.
+func (r *MySQLRepository) ListDeletedIDsBefore(ctx context.Context, before time.Time, limit int) ([]int64, error) {
+	query := `
+		SELECT u.id
+		FROM users u
+		WHERE u.deleted_at IS NOT NULL AND u.deleted_at < ?
+			AND NOT EXISTS (SELECT 1 FROM articles a WHERE a.author_id = u.id)
+		ORDER BY u.deleted_at ASC
+		LIMIT ?
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, before, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := rows.Close(); err != nil {
+			log.Printf("Failed to close rows: %v", err)
+		}
+	}()
+
+	var ids []int64
+	for rows.Next() {
+		var id int64
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return ids, nil
+}
+
+// Purge permanently deletes a user that is in the trash. The foreign key on articles
+// refuses to delete a user who still authors articles.
+func (r *MySQLRepository) Purge(ctx context.Context, id int64) error {
+	query := `DELETE FROM users WHERE id = ? AND deleted_at IS NOT NULL`
+
+	result, err := r.db.ExecContext(ctx, query, id)
+	var mysqlErr *mysql.MySQLError
+	if errors.As(err, &mysqlErr) && mysqlErr.Number == 1451 {
+		return domainuser.ErrUserHasArticles
+	}
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return domainuser.ErrUserNotFound
+	}
+
+	return nil
+}
+
+// memberOf restricts a query on the users table to members of orgID, orgID zero leaves it as is
+func memberOf(query string, orgID int64, args ...interface{}) (string, []interface{}) {
+	if orgID == 0 {
+		return query, args
+	}
+	query += " AND EXISTS (SELECT 1 FROM organization_members m WHERE m.user_id = users.id AND m.org_id = ?)"
+	return query, append(args, orgID)
+}
+
+// mapDuplicateEmail turns a unique key violation into ErrEmailExists. Trashed users still
+// hold their email, so GetByEmail does not see them but the unique index does.
+func mapDuplicateEmail(err error) error {
+	var mysqlErr *mysql.MySQLError
+	if errors.As(err, &mysqlErr) && mysqlErr.Number == 1062 {
+		return domainuser.ErrEmailExists
+	}
+	return err
+}
@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-sql-driver/mysql"
 	domainuser "github.com/rulzi/hexa-go/internal/domain/user"
 	"github.com/stretchr/testify/assert"
 )
@@ -48,7 +49,7 @@ func TestMySQLRepository_Create(t *testing.T) {
 			},
 			setup: func(mock sqlmock.Sqlmock) {
 				mock.ExpectExec("INSERT INTO users").
-					WithArgs("John Doe", "john@example.com", "hashedpassword", sqlmock.AnyArg(), sqlmock.AnyArg()).
+					WithArgs("John Doe", "john@example.com", "hashedpassword", sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg()).
 					WillReturnResult(sqlmock.NewResult(1, 1))
 			},
 			wantErr: false,
@@ -69,7 +70,7 @@ func TestMySQLRepository_Create(t *testing.T) {
 			},
 			setup: func(mock sqlmock.Sqlmock) {
 				mock.ExpectExec("INSERT INTO users").
-					WithArgs("John Doe", "john@example.com", "hashedpassword", sqlmock.AnyArg(), sqlmock.AnyArg()).
+					WithArgs("John Doe", "john@example.com", "hashedpassword", sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg()).
 					WillReturnError(errors.New("database error"))
 			},
 			wantErr: true,
@@ -85,7 +86,7 @@ func TestMySQLRepository_Create(t *testing.T) {
 			},
 			setup: func(mock sqlmock.Sqlmock) {
 				mock.ExpectExec("INSERT INTO users").
-					WithArgs("John Doe", "john@example.com", "hashedpassword", sqlmock.AnyArg(), sqlmock.AnyArg()).
+					WithArgs("John Doe", "john@example.com", "hashedpassword", sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg()).
 					WillReturnResult(sqlmock.NewErrorResult(errors.New("last insert id error")))
 			},
 			wantErr: true,
@@ -129,6 +130,7 @@ func TestMySQLRepository_Create(t *testing.T) {
 func TestMySQLRepository_GetByID(t *testing.T) {
 	tests := []struct {
 		name    string
+		orgID   int64
 		id      int64
 		setup   func(mock sqlmock.Sqlmock)
 		wantErr bool
@@ -138,9 +140,9 @@ func TestMySQLRepository_GetByID(t *testing.T) {
 			name: "success get user by id",
 			id:   1,
 			setup: func(mock sqlmock.Sqlmock) {
-				rows := sqlmock.NewRows([]string{"id", "name", "email", "password", "created_at", "updated_at"}).
-					AddRow(1, "John Doe", "john@example.com", "hashedpassword", time.Now(), time.Now())
-				mock.ExpectQuery("SELECT id, name, email, password, created_at, updated_at").
+				rows := sqlmock.NewRows([]string{"id", "name", "email", "password", "role", "email_verified_at", "created_at", "updated_at"}).
+					AddRow(1, "John Doe", "john@example.com", "hashedpassword", "author", nil, time.Now(), time.Now())
+				mock.ExpectQuery("SELECT id, name, email, password, role, email_verified_at, created_at, updated_at").
 					WithArgs(1).
 					WillReturnRows(rows)
 			},
@@ -150,13 +152,27 @@ func TestMySQLRepository_GetByID(t *testing.T) {
 				assert.Equal(t, "John Doe", user.Name)
 				assert.Equal(t, "john@example.com", user.Email)
 				assert.Equal(t, "hashedpassword", user.Password)
+				assert.Equal(t, domainuser.RoleAuthor, user.Role)
 			},
 		},
+		{
+			name:  "member of the organization",
+			orgID: 2,
+			id:    1,
+			setup: func(mock sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows([]string{"id", "name", "email", "password", "role", "email_verified_at", "created_at", "updated_at"}).
+					AddRow(1, "John Doe", "john@example.com", "hashedpassword", "author", nil, time.Now(), time.Now())
+				mock.ExpectQuery("AND EXISTS \\(SELECT 1 FROM organization_members m WHERE m.user_id = users.id AND m.org_id = \\?\\)").
+					WithArgs(1, 2).
+					WillReturnRows(rows)
+			},
+			wantErr: false,
+		},
 		{
 			name: "user not found",
 			id:   999,
 			setup: func(mock sqlmock.Sqlmock) {
-				mock.ExpectQuery("SELECT id, name, email, password, created_at, updated_at").
+				mock.ExpectQuery("SELECT id, name, email, password, role, email_verified_at, created_at, updated_at").
 					WithArgs(999).
 					WillReturnError(sql.ErrNoRows)
 			},
@@ -169,7 +185,7 @@ func TestMySQLRepository_GetByID(t *testing.T) {
 			name: "database error",
 			id:   1,
 			setup: func(mock sqlmock.Sqlmock) {
-				mock.ExpectQuery("SELECT id, name, email, password, created_at, updated_at").
+				mock.ExpectQuery("SELECT id, name, email, password, role, email_verified_at, created_at, updated_at").
 					WithArgs(1).
 					WillReturnError(errors.New("database error"))
 			},
@@ -193,7 +209,7 @@ func TestMySQLRepository_GetByID(t *testing.T) {
 			repo := NewMySQLRepository(db)
 			tt.setup(mock)
 
-			result, err := repo.GetByID(context.Background(), tt.id)
+			result, err := repo.GetByID(context.Background(), tt.orgID, tt.id)
 
 			if tt.wantErr {
 				assert.Error(t, err)
@@ -230,9 +246,9 @@ func TestMySQLRepository_GetByEmail(t *testing.T) {
 			name:  "success get user by email",
 			email: "john@example.com",
 			setup: func(mock sqlmock.Sqlmock) {
-				rows := sqlmock.NewRows([]string{"id", "name", "email", "password", "created_at", "updated_at"}).
-					AddRow(1, "John Doe", "john@example.com", "hashedpassword", time.Now(), time.Now())
-				mock.ExpectQuery("SELECT id, name, email, password, created_at, updated_at").
+				rows := sqlmock.NewRows([]string{"id", "name", "email", "password", "role", "email_verified_at", "created_at", "updated_at"}).
+					AddRow(1, "John Doe", "john@example.com", "hashedpassword", "author", nil, time.Now(), time.Now())
+				mock.ExpectQuery("SELECT id, name, email, password, role, email_verified_at, created_at, updated_at").
 					WithArgs("john@example.com").
 					WillReturnRows(rows)
 			},
@@ -242,13 +258,30 @@ func TestMySQLRepository_GetByEmail(t *testing.T) {
 				assert.Equal(t, "John Doe", user.Name)
 				assert.Equal(t, "john@example.com", user.Email)
 				assert.Equal(t, "hashedpassword", user.Password)
+				assert.False(t, user.IsEmailVerified())
+			},
+		},
+		{
+			name:  "success get verified user by email",
+			email: "john@example.com",
+			setup: func(mock sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows([]string{"id", "name", "email", "password", "role", "email_verified_at", "created_at", "updated_at"}).
+					AddRow(1, "John Doe", "john@example.com", "hashedpassword", "author", time.Now(), time.Now(), time.Now())
+				mock.ExpectQuery("SELECT id, name, email, password, role, email_verified_at, created_at, updated_at").
+					WithArgs("john@example.com").
+					WillReturnRows(rows)
+			},
+			wantErr: false,
+			check: func(t *testing.T, user *domainuser.User) {
+				assert.NotNil(t, user.EmailVerifiedAt)
+				assert.True(t, user.IsEmailVerified())
 			},
 		},
 		{
 			name:  "user not found",
 			email: "notfound@example.com",
 			setup: func(mock sqlmock.Sqlmock) {
-				mock.ExpectQuery("SELECT id, name, email, password, created_at, updated_at").
+				mock.ExpectQuery("SELECT id, name, email, password, role, email_verified_at, created_at, updated_at").
 					WithArgs("notfound@example.com").
 					WillReturnError(sql.ErrNoRows)
 			},
@@ -261,7 +294,7 @@ func TestMySQLRepository_GetByEmail(t *testing.T) {
 			name:  "database error",
 			email: "john@example.com",
 			setup: func(mock sqlmock.Sqlmock) {
-				mock.ExpectQuery("SELECT id, name, email, password, created_at, updated_at").
+				mock.ExpectQuery("SELECT id, name, email, password, role, email_verified_at, created_at, updated_at").
 					WithArgs("john@example.com").
 					WillReturnError(errors.New("database error"))
 			},
@@ -329,7 +362,7 @@ func TestMySQLRepository_Update(t *testing.T) {
 			},
 			setup: func(mock sqlmock.Sqlmock) {
 				mock.ExpectExec("UPDATE users").
-					WithArgs("John Updated", "john.updated@example.com", "newhashedpassword", sqlmock.AnyArg(), 1).
+					WithArgs("John Updated", "john.updated@example.com", "newhashedpassword", sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), 1).
 					WillReturnResult(sqlmock.NewResult(0, 1))
 			},
 			wantErr: false,
@@ -350,7 +383,7 @@ func TestMySQLRepository_Update(t *testing.T) {
 			},
 			setup: func(mock sqlmock.Sqlmock) {
 				mock.ExpectExec("UPDATE users").
-					WithArgs("John Updated", "john.updated@example.com", "newhashedpassword", sqlmock.AnyArg(), 1).
+					WithArgs("John Updated", "john.updated@example.com", "newhashedpassword", sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), 1).
 					WillReturnError(errors.New("database error"))
 			},
 			wantErr: true,
@@ -373,7 +406,7 @@ func TestMySQLRepository_Update(t *testing.T) {
 			repo := NewMySQLRepository(db)
 			tt.setup(mock)
 
-			result, err := repo.Update(context.Background(), tt.user)
+			result, err := repo.Update(context.Background(), 0, tt.user)
 
 			if tt.wantErr {
 				assert.Error(t, err)
@@ -394,6 +427,7 @@ func TestMySQLRepository_Update(t *testing.T) {
 func TestMySQLRepository_Delete(t *testing.T) {
 	tests := []struct {
 		name    string
+		orgID   int64
 		id      int64
 		setup   func(mock sqlmock.Sqlmock)
 		wantErr bool
@@ -402,18 +436,29 @@ func TestMySQLRepository_Delete(t *testing.T) {
 			name: "success delete user",
 			id:   1,
 			setup: func(mock sqlmock.Sqlmock) {
-				mock.ExpectExec("DELETE FROM users").
-					WithArgs(1).
+				mock.ExpectExec("UPDATE users SET deleted_at").
+					WithArgs(sqlmock.AnyArg(), 1).
 					WillReturnResult(sqlmock.NewResult(0, 1))
 			},
 			wantErr: false,
 		},
+		{
+			name:  "member of another organization",
+			orgID: 2,
+			id:    1,
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec("UPDATE users SET deleted_at = \\? WHERE id = \\? AND deleted_at IS NULL AND EXISTS").
+					WithArgs(sqlmock.AnyArg(), 1, 2).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+			},
+			wantErr: true,
+		},
 		{
 			name: "user not found",
 			id:   999,
 			setup: func(mock sqlmock.Sqlmock) {
-				mock.ExpectExec("DELETE FROM users").
-					WithArgs(999).
+				mock.ExpectExec("UPDATE users SET deleted_at").
+					WithArgs(sqlmock.AnyArg(), 999).
 					WillReturnResult(sqlmock.NewResult(0, 0))
 			},
 			wantErr: true,
@@ -422,8 +467,8 @@ func TestMySQLRepository_Delete(t *testing.T) {
 			name: "error on database exec",
 			id:   1,
 			setup: func(mock sqlmock.Sqlmock) {
-				mock.ExpectExec("DELETE FROM users").
-					WithArgs(1).
+				mock.ExpectExec("UPDATE users SET deleted_at").
+					WithArgs(sqlmock.AnyArg(), 1).
 					WillReturnError(errors.New("database error"))
 			},
 			wantErr: true,
@@ -432,8 +477,8 @@ func TestMySQLRepository_Delete(t *testing.T) {
 			name: "error on rows affected",
 			id:   1,
 			setup: func(mock sqlmock.Sqlmock) {
-				mock.ExpectExec("DELETE FROM users").
-					WithArgs(1).
+				mock.ExpectExec("UPDATE users SET deleted_at").
+					WithArgs(sqlmock.AnyArg(), 1).
 					WillReturnResult(sqlmock.NewErrorResult(errors.New("rows affected error")))
 			},
 			wantErr: true,
@@ -456,7 +501,7 @@ func TestMySQLRepository_Delete(t *testing.T) {
 			repo := NewMySQLRepository(db)
 			tt.setup(mock)
 
-			err = repo.Delete(context.Background(), tt.id)
+			err = repo.Delete(context.Background(), tt.orgID, tt.id)
 
 			if tt.wantErr {
 				assert.Error(t, err)
@@ -486,11 +531,11 @@ func TestMySQLRepository_List(t *testing.T) {
 			limit:  10,
 			offset: 0,
 			setup: func(mock sqlmock.Sqlmock) {
-				rows := sqlmock.NewRows([]string{"id", "name", "email", "password", "created_at", "updated_at"}).
-					AddRow(1, "John Doe", "john@example.com", "hashedpassword", time.Now(), time.Now()).
-					AddRow(2, "Jane Doe", "jane@example.com", "hashedpassword2", time.Now(), time.Now())
-				mock.ExpectQuery("SELECT id, name, email, password, created_at, updated_at").
-					WithArgs(10, 0).
+				rows := sqlmock.NewRows([]string{"id", "name", "email", "password", "role", "email_verified_at", "created_at", "updated_at"}).
+					AddRow(1, "John Doe", "john@example.com", "hashedpassword", "author", nil, time.Now(), time.Now()).
+					AddRow(2, "Jane Doe", "jane@example.com", "hashedpassword2", "author", nil, time.Now(), time.Now())
+				mock.ExpectQuery("SELECT u.id, u.name, u.email, u.password, m.role, u.email_verified_at, u.created_at, u.updated_at").
+					WithArgs(int64(1), 10, 0).
 					WillReturnRows(rows)
 			},
 			wantErr: false,
@@ -507,9 +552,9 @@ func TestMySQLRepository_List(t *testing.T) {
 			limit:  10,
 			offset: 0,
 			setup: func(mock sqlmock.Sqlmock) {
-				rows := sqlmock.NewRows([]string{"id", "name", "email", "password", "created_at", "updated_at"})
-				mock.ExpectQuery("SELECT id, name, email, password, created_at, updated_at").
-					WithArgs(10, 0).
+				rows := sqlmock.NewRows([]string{"id", "name", "email", "password", "role", "email_verified_at", "created_at", "updated_at"})
+				mock.ExpectQuery("SELECT u.id, u.name, u.email, u.password, m.role, u.email_verified_at, u.created_at, u.updated_at").
+					WithArgs(int64(1), 10, 0).
 					WillReturnRows(rows)
 			},
 			wantErr: false,
@@ -525,8 +570,8 @@ func TestMySQLRepository_List(t *testing.T) {
 			limit:  10,
 			offset: 0,
 			setup: func(mock sqlmock.Sqlmock) {
-				mock.ExpectQuery("SELECT id, name, email, password, created_at, updated_at").
-					WithArgs(10, 0).
+				mock.ExpectQuery("SELECT u.id, u.name, u.email, u.password, m.role, u.email_verified_at, u.created_at, u.updated_at").
+					WithArgs(int64(1), 10, 0).
 					WillReturnError(errors.New("database error"))
 			},
 			wantErr: true,
@@ -536,10 +581,10 @@ func TestMySQLRepository_List(t *testing.T) {
 			limit:  10,
 			offset: 0,
 			setup: func(mock sqlmock.Sqlmock) {
-				rows := sqlmock.NewRows([]string{"id", "name", "email", "password", "created_at", "updated_at"}).
-					AddRow("invalid", "John Doe", "john@example.com", "hashedpassword", time.Now(), time.Now())
-				mock.ExpectQuery("SELECT id, name, email, password, created_at, updated_at").
-					WithArgs(10, 0).
+				rows := sqlmock.NewRows([]string{"id", "name", "email", "password", "role", "email_verified_at", "created_at", "updated_at"}).
+					AddRow("invalid", "John Doe", "john@example.com", "hashedpassword", "author", nil, time.Now(), time.Now())
+				mock.ExpectQuery("SELECT u.id, u.name, u.email, u.password, m.role, u.email_verified_at, u.created_at, u.updated_at").
+					WithArgs(int64(1), 10, 0).
 					WillReturnRows(rows)
 			},
 			wantErr: true,
@@ -549,11 +594,11 @@ func TestMySQLRepository_List(t *testing.T) {
 			limit:  10,
 			offset: 0,
 			setup: func(mock sqlmock.Sqlmock) {
-				rows := sqlmock.NewRows([]string{"id", "name", "email", "password", "created_at", "updated_at"}).
-					AddRow(1, "John Doe", "john@example.com", "hashedpassword", time.Now(), time.Now()).
+				rows := sqlmock.NewRows([]string{"id", "name", "email", "password", "role", "email_verified_at", "created_at", "updated_at"}).
+					AddRow(1, "John Doe", "john@example.com", "hashedpassword", "author", nil, time.Now(), time.Now()).
 					RowError(0, errors.New("row error"))
-				mock.ExpectQuery("SELECT id, name, email, password, created_at, updated_at").
-					WithArgs(10, 0).
+				mock.ExpectQuery("SELECT u.id, u.name, u.email, u.password, m.role, u.email_verified_at, u.created_at, u.updated_at").
+					WithArgs(int64(1), 10, 0).
 					WillReturnRows(rows)
 			},
 			wantErr: true,
@@ -576,7 +621,7 @@ func TestMySQLRepository_List(t *testing.T) {
 			repo := NewMySQLRepository(db)
 			tt.setup(mock)
 
-			result, err := repo.List(context.Background(), tt.limit, tt.offset)
+			result, err := repo.List(context.Background(), 1, tt.limit, tt.offset)
 
 			if tt.wantErr {
 				assert.Error(t, err)
@@ -611,7 +656,8 @@ func TestMySQLRepository_Count(t *testing.T) {
 			setup: func(mock sqlmock.Sqlmock) {
 				rows := sqlmock.NewRows([]string{"COUNT(*)"}).
 					AddRow(42)
-				mock.ExpectQuery("SELECT COUNT\\(\\*\\) FROM users").
+				mock.ExpectQuery("SELECT COUNT\\(\\*\\) FROM users u\\s+INNER JOIN organization_members m").
+					WithArgs(int64(1)).
 					WillReturnRows(rows)
 			},
 			want:    42,
@@ -622,7 +668,8 @@ func TestMySQLRepository_Count(t *testing.T) {
 			setup: func(mock sqlmock.Sqlmock) {
 				rows := sqlmock.NewRows([]string{"COUNT(*)"}).
 					AddRow(0)
-				mock.ExpectQuery("SELECT COUNT\\(\\*\\) FROM users").
+				mock.ExpectQuery("SELECT COUNT\\(\\*\\) FROM users u\\s+INNER JOIN organization_members m").
+					WithArgs(int64(1)).
 					WillReturnRows(rows)
 			},
 			want:    0,
@@ -631,7 +678,8 @@ func TestMySQLRepository_Count(t *testing.T) {
 		{
 			name: "error on database query",
 			setup: func(mock sqlmock.Sqlmock) {
-				mock.ExpectQuery("SELECT COUNT\\(\\*\\) FROM users").
+				mock.ExpectQuery("SELECT COUNT\\(\\*\\) FROM users u\\s+INNER JOIN organization_members m").
+					WithArgs(int64(1)).
 					WillReturnError(errors.New("database error"))
 			},
 			want:    0,
@@ -655,7 +703,7 @@ func TestMySQLRepository_Count(t *testing.T) {
 			repo := NewMySQLRepository(db)
 			tt.setup(mock)
 
-			result, err := repo.Count(context.Background())
+			result, err := repo.Count(context.Background(), 1)
 
 			if tt.wantErr {
 				assert.Error(t, err)
@@ -669,3 +717,315 @@ func TestMySQLRepository_Count(t *testing.T) {
 		})
 	}
 }
+
+func TestMySQLRepository_Find(t *testing.T) {
+	columns := []string{"id", "name", "email", "password", "role", "email_verified_at", "created_at", "updated_at"}
+	from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		criteria  domainuser.Criteria
+		setup     func(mock sqlmock.Sqlmock)
+		wantErr   error
+		wantTotal int64
+		wantLen   int
+	}{
+		{
+			name:     "default order",
+			criteria: domainuser.Criteria{OrgID: 1, Limit: 10},
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery("SELECT COUNT\\(\\*\\) FROM users u\\s+INNER JOIN organization_members m ON m.user_id = u.id AND m.org_id = \\? WHERE u.deleted_at IS NULL$").
+					WithArgs(int64(1)).
+					WillReturnRows(sqlmock.NewRows([]string{"COUNT(*)"}).AddRow(2))
+				mock.ExpectQuery("ORDER BY u.created_at DESC, u.id DESC LIMIT \\? OFFSET \\?").
+					WithArgs(int64(1), 10, 0).
+					WillReturnRows(sqlmock.NewRows(columns).
+						AddRow(2, "Jane Doe", "jane@example.com", "hashed", "editor", nil, time.Now(), time.Now()).
+						AddRow(1, "John Doe", "john@example.com", "hashed", "author", nil, time.Now(), time.Now()))
+			},
+			wantTotal: 2,
+			wantLen:   2,
+		},
+		{
+			name: "every filter",
+			criteria: domainuser.Criteria{
+				OrgID:       1,
+				Search:      "50%_off",
+				Role:        domainuser.RoleEditor,
+				CreatedFrom: &from,
+				CreatedTo:   &to,
+				SortBy:      domainuser.SortByRole,
+				SortDir:     domainuser.SortAsc,
+				Limit:       20,
+				Offset:      40,
+			},
+			setup: func(mock sqlmock.Sqlmock) {
+				where := "WHERE u.deleted_at IS NULL AND \\(u.name LIKE \\? OR u.email LIKE \\?\\) AND m.role = \\? AND u.created_at >= \\? AND u.created_at < \\?"
+				mock.ExpectQuery("SELECT COUNT\\(\\*\\) .* "+where).
+					WithArgs(int64(1), `%50\%\_off%`, `%50\%\_off%`, domainuser.RoleEditor, from, to).
+					WillReturnRows(sqlmock.NewRows([]string{"COUNT(*)"}).AddRow(41))
+				mock.ExpectQuery(where+" ORDER BY m.role ASC, u.id ASC LIMIT \\? OFFSET \\?").
+					WithArgs(int64(1), `%50\%\_off%`, `%50\%\_off%`, domainuser.RoleEditor, from, to, 20, 40).
+					WillReturnRows(sqlmock.NewRows(columns).
+						AddRow(3, "50%_off", "deals@example.com", "hashed", "editor", nil, time.Now(), time.Now()))
+			},
+			wantTotal: 41,
+			wantLen:   1,
+		},
+		{
+			name:     "unknown sort field never reaches the database",
+			criteria: domainuser.Criteria{OrgID: 1, SortBy: "password; DROP TABLE users"},
+			setup:    func(mock sqlmock.Sqlmock) {},
+			wantErr:  domainuser.ErrInvalidSortField,
+		},
+		{
+			name:     "error on count",
+			criteria: domainuser.Criteria{OrgID: 1, Limit: 10},
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery("SELECT COUNT").
+					WillReturnError(errors.New("database error"))
+			},
+			wantErr: errors.New("database error"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+			}
+			defer func() {
+				mock.ExpectClose()
+				if err := db.Close(); err != nil {
+					t.Fatalf("Failed to close database connection: %v", err)
+				}
+			}()
+
+			tt.setup(mock)
+
+			repo := NewMySQLRepository(db)
+			users, total, err := repo.Find(context.Background(), tt.criteria)
+
+			if tt.wantErr != nil {
+				assert.Equal(t, tt.wantErr, err)
+				assert.Nil(t, users)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.wantTotal, total)
+				assert.Len(t, users, tt.wantLen)
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("there were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
+
+func TestMySQLRepository_Create_DuplicateEmail(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer func() {
+		mock.ExpectClose()
+		if err := db.Close(); err != nil {
+			t.Fatalf("Failed to close database connection: %v", err)
+		}
+	}()
+
+	repo := NewMySQLRepository(db)
+	mock.ExpectExec("INSERT INTO users").
+		WillReturnError(&mysql.MySQLError{Number: 1062, Message: "Duplicate entry 'john@example.com' for key 'email'"})
+
+	result, err := repo.Create(context.Background(), &domainuser.User{Name: "John Doe", Email: "john@example.com", Password: "hashedpassword"})
+
+	assert.Nil(t, result)
+	assert.Equal(t, domainuser.ErrEmailExists, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestMySQLRepository_Restore(t *testing.T) {
+	tests := []struct {
+		name    string
+		id      int64
+		setup   func(mock sqlmock.Sqlmock)
+		wantErr error
+	}{
+		{
+			name: "success restore user",
+			id:   1,
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec("UPDATE users SET deleted_at = NULL WHERE id = \\? AND deleted_at IS NOT NULL").
+					WithArgs(1).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+		},
+		{
+			name: "user not in trash",
+			id:   999,
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec("UPDATE users SET deleted_at = NULL").
+					WithArgs(999).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+			},
+			wantErr: domainuser.ErrUserNotFound,
+		},
+		{
+			name: "email taken by another user",
+			id:   1,
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec("UPDATE users SET deleted_at = NULL").
+					WithArgs(1).
+					WillReturnError(&mysql.MySQLError{Number: 1062})
+			},
+			wantErr: domainuser.ErrEmailExists,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+			}
+			defer func() {
+				mock.ExpectClose()
+				if err := db.Close(); err != nil {
+					t.Fatalf("Failed to close database connection: %v", err)
+				}
+			}()
+
+			repo := NewMySQLRepository(db)
+			tt.setup(mock)
+
+			err = repo.Restore(context.Background(), 0, tt.id)
+
+			assert.Equal(t, tt.wantErr, err)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestMySQLRepository_ListDeleted(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer func() {
+		mock.ExpectClose()
+		if err := db.Close(); err != nil {
+			t.Fatalf("Failed to close database connection: %v", err)
+		}
+	}()
+
+	repo := NewMySQLRepository(db)
+	deletedAt := time.Now()
+	rows := sqlmock.NewRows([]string{"id", "name", "email", "password", "role", "email_verified_at", "created_at", "updated_at", "deleted_at"}).
+		AddRow(1, "John Doe", "john@example.com", "hashedpassword", "author", nil, time.Now(), time.Now(), deletedAt)
+	mock.ExpectQuery("INNER JOIN organization_members m ON m.user_id = u.id AND m.org_id = \\?\\s+WHERE u.deleted_at IS NOT NULL\\s+ORDER BY u.deleted_at DESC").
+		WithArgs(int64(1), 10, 0).
+		WillReturnRows(rows)
+
+	users, err := repo.ListDeleted(context.Background(), 1, 10, 0)
+
+	assert.NoError(t, err)
+	assert.Len(t, users, 1)
+	assert.Equal(t, int64(1), users[0].ID)
+	if assert.NotNil(t, users[0].DeletedAt) {
+		assert.True(t, deletedAt.Equal(*users[0].DeletedAt))
+	}
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestMySQLRepository_CountDeleted(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer func() {
+		mock.ExpectClose()
+		if err := db.Close(); err != nil {
+			t.Fatalf("Failed to close database connection: %v", err)
+		}
+	}()
+
+	repo := NewMySQLRepository(db)
+	mock.ExpectQuery("SELECT COUNT\\(\\*\\) FROM users u\\s+INNER JOIN organization_members m ON m.user_id = u.id AND m.org_id = \\?\\s+WHERE u.deleted_at IS NOT NULL").
+		WithArgs(int64(1)).
+		WillReturnRows(sqlmock.NewRows([]string{"COUNT(*)"}).AddRow(3))
+
+	count, err := repo.CountDeleted(context.Background(), 1)
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(3), count)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestMySQLRepository_ListDeletedIDsBefore(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer func() {
+		mock.ExpectClose()
+		if err := db.Close(); err != nil {
+			t.Fatalf("Failed to close database connection: %v", err)
+		}
+	}()
+
+	repo := NewMySQLRepository(db)
+	before := time.Now().AddDate(0, 0, -30)
+	mock.ExpectQuery("SELECT u.id\\s+FROM users u\\s+WHERE u.deleted_at IS NOT NULL AND u.deleted_at < \\?\\s+AND NOT EXISTS \\(SELECT 1 FROM articles a WHERE a.author_id = u.id\\)\\s+ORDER BY u.deleted_at ASC").
+		WithArgs(before, 100).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(3).AddRow(5))
+
+	ids, err := repo.ListDeletedIDsBefore(context.Background(), before, 100)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{3, 5}, ids)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestMySQLRepository_Purge(t *testing.T) {
+	tests := []struct {
+		name     string
+		affected int64
+		execErr  error
+		wantErr  error
+	}{
+		{name: "success purge user", affected: 1},
+		{name: "user not in trash", affected: 0, wantErr: domainuser.ErrUserNotFound},
+		{name: "user still authors articles", execErr: &mysql.MySQLError{Number: 1451}, wantErr: domainuser.ErrUserHasArticles},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+			}
+			defer func() {
+				mock.ExpectClose()
+				if err := db.Close(); err != nil {
+					t.Fatalf("Failed to close database connection: %v", err)
+				}
+			}()
+
+			repo := NewMySQLRepository(db)
+			expect := mock.ExpectExec("DELETE FROM users WHERE id = \\? AND deleted_at IS NOT NULL").WithArgs(7)
+			if tt.execErr != nil {
+				expect.WillReturnError(tt.execErr)
+			} else {
+				expect.WillReturnResult(sqlmock.NewResult(0, tt.affected))
+			}
+
+			err = repo.Purge(context.Background(), 7)
+
+			assert.Equal(t, tt.wantErr, err)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
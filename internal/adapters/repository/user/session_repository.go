@@ -0,0 +1,140 @@
+package user
+
+import (
+	"context"
+	"database/sql"
+	"log"
+	"time"
+
+	domainuser "github.com/rulzi/hexa-go/internal/domain/user"
+)
+
+// MySQLSessionRepository is the MySQL implementation of user.SessionRepository (driven adapter)
+type MySQLSessionRepository struct {
+	db *sql.DB
+}
+
+// NewMySQLSessionRepository creates a new MySQLSessionRepository
+func NewMySQLSessionRepository(db *sql.DB) *MySQLSessionRepository {
+	return &MySQLSessionRepository{db: db}
+}
+
+// Create stores a new session
+func (r *MySQLSessionRepository) Create(ctx context.Context, s *domainuser.Session) error {
+	query := `
+		INSERT INTO sessions (id, user_id, actor_id, user_agent, ip_address, created_at, last_seen_at, expires_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+	`
+
+	_, err := r.db.ExecContext(ctx, query, s.ID, s.UserID, s.ActorID, s.UserAgent, s.IPAddress, s.CreatedAt, s.LastSeenAt, s.ExpiresAt)
+	return err
+}
+
+// GetByID retrieves a session by its ID
+func (r *MySQLSessionRepository) GetByID(ctx context.Context, id string) (*domainuser.Session, error) {
+	query := `
+		SELECT id, user_id, actor_id, user_agent, ip_address, created_at, last_seen_at, expires_at, revoked_at
+		FROM sessions
+		WHERE id = ?
+	`
+
+	s, err := scanSession(r.db.QueryRowContext(ctx, query, id))
+	if err == sql.ErrNoRows {
+		return nil, domainuser.ErrSessionNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
+
+// ListActiveByUser returns the sessions of a user that are neither revoked nor expired, most recently seen first
+func (r *MySQLSessionRepository) ListActiveByUser(ctx context.Context, userID int64) ([]*domainuser.Session, error) {
+	query := `
+		SELECT id, user_id, actor_id, user_agent, ip_address, created_at, last_seen_at, expires_at, revoked_at
+		FROM sessions
+		WHERE user_id = ? AND revoked_at IS NULL AND expires_at > ?
+		ORDER BY last_seen_at DESC, created_at DESC
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, userID, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := rows.Close(); err != nil {
+			log.Printf("Failed to close rows: %v", err)
+		}
+	}()
+
+	var sessions []*domainuser.Session
+	for rows.Next() {
+		s, err := scanSession(rows)
+		if err != nil {
+			return nil, err
+		}
+		sessions = append(sessions, s)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return sessions, nil
+}
+
+// Touch records activity on an active session and extends its expiry
+func (r *MySQLSessionRepository) Touch(ctx context.Context, id string, lastSeenAt, expiresAt time.Time) error {
+	query := `UPDATE sessions SET last_seen_at = ?, expires_at = ? WHERE id = ? AND revoked_at IS NULL`
+
+	_, err := r.db.ExecContext(ctx, query, lastSeenAt, expiresAt, id)
+	return err
+}
+
+// Revoke revokes a session if it is still active
+func (r *MySQLSessionRepository) Revoke(ctx context.Context, id string) error {
+	query := `UPDATE sessions SET revoked_at = ? WHERE id = ? AND revoked_at IS NULL`
+
+	result, err := r.db.ExecContext(ctx, query, time.Now(), id)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return domainuser.ErrSessionNotFound
+	}
+
+	return nil
+}
+
+// scanSession scans a session row
+func scanSession(row rowScanner) (*domainuser.Session, error) {
+	s := &domainuser.Session{}
+	var revokedAt sql.NullTime
+	err := row.Scan(
+		&s.ID,
+		&s.UserID,
+		&s.ActorID,
+		&s.UserAgent,
+		&s.IPAddress,
+		&s.CreatedAt,
+		&s.LastSeenAt,
+		&s.ExpiresAt,
+		&revokedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if revokedAt.Valid {
+		s.RevokedAt = &revokedAt.Time
+	}
+
+	return s, nil
+}
@@ -0,0 +1,236 @@
+package user
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	domainuser "github.com/rulzi/hexa-go/internal/domain/user"
+	"github.com/stretchr/testify/assert"
+)
+
+var sessionColumns = []string{"id", "user_id", "actor_id", "user_agent", "ip_address", "created_at", "last_seen_at", "expires_at", "revoked_at"}
+
+func newTestSessionRepository(t *testing.T) (*MySQLSessionRepository, sqlmock.Sqlmock) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	t.Cleanup(func() {
+		mock.ExpectClose()
+		if err := db.Close(); err != nil {
+			t.Fatalf("Failed to close database connection: %v", err)
+		}
+	})
+
+	return NewMySQLSessionRepository(db), mock
+}
+
+func TestMySQLSessionRepository_Create(t *testing.T) {
+	repo, mock := newTestSessionRepository(t)
+	now := time.Now()
+
+	mock.ExpectExec("INSERT INTO sessions").
+		WithArgs("session-1", int64(1), int64(0), "Mozilla/5.0", "10.0.0.1", now, now, now.Add(time.Hour)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	err := repo.Create(context.Background(), &domainuser.Session{
+		ID:         "session-1",
+		UserID:     1,
+		UserAgent:  "Mozilla/5.0",
+		IPAddress:  "10.0.0.1",
+		CreatedAt:  now,
+		LastSeenAt: now,
+		ExpiresAt:  now.Add(time.Hour),
+	})
+
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestMySQLSessionRepository_GetByID(t *testing.T) {
+	revokedAt := time.Now()
+
+	tests := []struct {
+		name    string
+		setup   func(mock sqlmock.Sqlmock)
+		wantErr error
+		check   func(t *testing.T, s *domainuser.Session)
+	}{
+		{
+			name: "active session",
+			setup: func(mock sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(sessionColumns).
+					AddRow("session-1", 1, 0, "Mozilla/5.0", "10.0.0.1", time.Now(), time.Now(), time.Now().Add(time.Hour), nil)
+				mock.ExpectQuery("SELECT (.+) FROM sessions WHERE id = ?").
+					WithArgs("session-1").
+					WillReturnRows(rows)
+			},
+			check: func(t *testing.T, s *domainuser.Session) {
+				assert.Equal(t, "session-1", s.ID)
+				assert.Equal(t, int64(1), s.UserID)
+				assert.Equal(t, "Mozilla/5.0", s.UserAgent)
+				assert.Nil(t, s.RevokedAt)
+			},
+		},
+		{
+			name: "revoked session",
+			setup: func(mock sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(sessionColumns).
+					AddRow("session-1", 1, 0, "", "10.0.0.1", time.Now(), time.Now(), time.Now().Add(time.Hour), revokedAt)
+				mock.ExpectQuery("SELECT (.+) FROM sessions WHERE id = ?").
+					WithArgs("session-1").
+					WillReturnRows(rows)
+			},
+			check: func(t *testing.T, s *domainuser.Session) {
+				assert.NotNil(t, s.RevokedAt)
+				assert.False(t, s.IsActive(time.Now()))
+			},
+		},
+		{
+			name: "session not found",
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery("SELECT (.+) FROM sessions WHERE id = ?").
+					WithArgs("session-1").
+					WillReturnError(sql.ErrNoRows)
+			},
+			wantErr: domainuser.ErrSessionNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo, mock := newTestSessionRepository(t)
+			tt.setup(mock)
+
+			result, err := repo.GetByID(context.Background(), "session-1")
+
+			if tt.wantErr != nil {
+				assert.Equal(t, tt.wantErr, err)
+				assert.Nil(t, result)
+			} else {
+				assert.NoError(t, err)
+				tt.check(t, result)
+			}
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestMySQLSessionRepository_ListActiveByUser(t *testing.T) {
+	tests := []struct {
+		name    string
+		setup   func(mock sqlmock.Sqlmock)
+		want    []string
+		wantErr bool
+	}{
+		{
+			name: "active sessions",
+			setup: func(mock sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(sessionColumns).
+					AddRow("session-2", 1, 2, "curl/8.0", "10.0.0.2", time.Now(), time.Now(), time.Now().Add(time.Hour), nil).
+					AddRow("session-1", 1, 0, "Mozilla/5.0", "10.0.0.1", time.Now(), time.Now(), time.Now().Add(time.Hour), nil)
+				mock.ExpectQuery("SELECT (.+) FROM sessions WHERE user_id = \\? AND revoked_at IS NULL AND expires_at > \\?").
+					WithArgs(int64(1), sqlmock.AnyArg()).
+					WillReturnRows(rows)
+			},
+			want: []string{"session-2", "session-1"},
+		},
+		{
+			name: "no sessions",
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery("SELECT (.+) FROM sessions").
+					WithArgs(int64(1), sqlmock.AnyArg()).
+					WillReturnRows(sqlmock.NewRows(sessionColumns))
+			},
+		},
+		{
+			name: "error on database query",
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery("SELECT (.+) FROM sessions").
+					WithArgs(int64(1), sqlmock.AnyArg()).
+					WillReturnError(errors.New("database error"))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo, mock := newTestSessionRepository(t)
+			tt.setup(mock)
+
+			result, err := repo.ListActiveByUser(context.Background(), 1)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Nil(t, result)
+			} else {
+				assert.NoError(t, err)
+				var ids []string
+				for _, s := range result {
+					ids = append(ids, s.ID)
+				}
+				assert.Equal(t, tt.want, ids)
+			}
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestMySQLSessionRepository_Touch(t *testing.T) {
+	repo, mock := newTestSessionRepository(t)
+	now := time.Now()
+
+	mock.ExpectExec("UPDATE sessions SET last_seen_at = \\?, expires_at = \\? WHERE id = \\? AND revoked_at IS NULL").
+		WithArgs(now, now.Add(time.Hour), "session-1").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	err := repo.Touch(context.Background(), "session-1", now, now.Add(time.Hour))
+
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestMySQLSessionRepository_Revoke(t *testing.T) {
+	tests := []struct {
+		name    string
+		setup   func(mock sqlmock.Sqlmock)
+		wantErr error
+	}{
+		{
+			name: "success revoke",
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec("UPDATE sessions SET revoked_at").
+					WithArgs(sqlmock.AnyArg(), "session-1").
+					WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+		},
+		{
+			name: "already revoked",
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec("UPDATE sessions SET revoked_at").
+					WithArgs(sqlmock.AnyArg(), "session-1").
+					WillReturnResult(sqlmock.NewResult(0, 0))
+			},
+			wantErr: domainuser.ErrSessionNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo, mock := newTestSessionRepository(t)
+			tt.setup(mock)
+
+			err := repo.Revoke(context.Background(), "session-1")
+
+			assert.Equal(t, tt.wantErr, err)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
@@ -0,0 +1,156 @@
+package user
+
+import (
+	"context"
+	"database/sql"
+	"log"
+	"time"
+
+	domainuser "github.com/rulzi/hexa-go/internal/domain/user"
+)
+
+// MySQLTwoFactorRepository is the MySQL implementation of user.TwoFactorRepository (driven adapter)
+type MySQLTwoFactorRepository struct {
+	db *sql.DB
+}
+
+// NewMySQLTwoFactorRepository creates a new MySQLTwoFactorRepository
+func NewMySQLTwoFactorRepository(db *sql.DB) *MySQLTwoFactorRepository {
+	return &MySQLTwoFactorRepository{db: db}
+}
+
+// GetByUser returns the enrollment of a user
+func (r *MySQLTwoFactorRepository) GetByUser(ctx context.Context, userID int64) (*domainuser.TwoFactor, error) {
+	query := `
+		SELECT user_id, secret, enabled_at, last_used_step, created_at, updated_at
+		FROM user_two_factor
+		WHERE user_id = ?
+	`
+
+	t := &domainuser.TwoFactor{}
+	var enabledAt sql.NullTime
+	err := r.db.QueryRowContext(ctx, query, userID).Scan(
+		&t.UserID,
+		&t.Secret,
+		&enabledAt,
+		&t.LastUsedStep,
+		&t.CreatedAt,
+		&t.UpdatedAt,
+	)
+
+	if err == sql.ErrNoRows {
+		return nil, domainuser.ErrTwoFactorNotEnrolled
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if enabledAt.Valid {
+		t.EnabledAt = &enabledAt.Time
+	}
+
+	return t, nil
+}
+
+// Save creates or replaces the enrollment of a user.
+// The last used time step is left alone, UseTimeStep is its only writer.
+func (r *MySQLTwoFactorRepository) Save(ctx context.Context, t *domainuser.TwoFactor) error {
+	query := `
+		INSERT INTO user_two_factor (user_id, secret, enabled_at, created_at, updated_at)
+		VALUES (?, ?, ?, ?, ?)
+		ON DUPLICATE KEY UPDATE secret = VALUES(secret), enabled_at = VALUES(enabled_at), updated_at = VALUES(updated_at)
+	`
+
+	_, err := r.db.ExecContext(ctx, query, t.UserID, t.Secret, t.EnabledAt, t.CreatedAt, t.UpdatedAt)
+	return err
+}
+
+// Delete removes the enrollment and the recovery codes of a user
+func (r *MySQLTwoFactorRepository) Delete(ctx context.Context, userID int64) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer rollback(tx)
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM user_recovery_codes WHERE user_id = ?`, userID); err != nil {
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, `DELETE FROM user_two_factor WHERE user_id = ?`, userID); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// ReplaceRecoveryCodes replaces all recovery codes of a user with the given hashes
+func (r *MySQLTwoFactorRepository) ReplaceRecoveryCodes(ctx context.Context, userID int64, codeHashes []string) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer rollback(tx)
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM user_recovery_codes WHERE user_id = ?`, userID); err != nil {
+		return err
+	}
+
+	now := time.Now()
+	for _, codeHash := range codeHashes {
+		query := `INSERT INTO user_recovery_codes (user_id, code_hash, created_at) VALUES (?, ?, ?)`
+		if _, err := tx.ExecContext(ctx, query, userID, codeHash, now); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+// UseRecoveryCode consumes an unused recovery code
+func (r *MySQLTwoFactorRepository) UseRecoveryCode(ctx context.Context, userID int64, codeHash string) error {
+	query := `UPDATE user_recovery_codes SET used_at = ? WHERE user_id = ? AND code_hash = ? AND used_at IS NULL`
+
+	result, err := r.db.ExecContext(ctx, query, time.Now(), userID, codeHash)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return domainuser.ErrInvalidTwoFactorCode
+	}
+
+	return nil
+}
+
+// UseTimeStep records the time step of an accepted one-time password if it is later than the last one
+func (r *MySQLTwoFactorRepository) UseTimeStep(ctx context.Context, userID, step int64) error {
+	query := `UPDATE user_two_factor SET last_used_step = ? WHERE user_id = ? AND last_used_step < ?`
+
+	result, err := r.db.ExecContext(ctx, query, step, userID, step)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return domainuser.ErrInvalidTwoFactorCode
+	}
+
+	return nil
+}
+
+// rollback rolls back a transaction that was not committed
+func rollback(tx *sql.Tx) {
+	if err := tx.Rollback(); err != nil && err != sql.ErrTxDone {
+		log.Printf("Failed to rollback transaction: %v", err)
+	}
+}
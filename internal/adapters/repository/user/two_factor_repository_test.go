@@ -0,0 +1,243 @@
+package user
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	domainuser "github.com/rulzi/hexa-go/internal/domain/user"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestTwoFactorRepository(t *testing.T) (*MySQLTwoFactorRepository, sqlmock.Sqlmock, func()) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+
+	cleanup := func() {
+		mock.ExpectClose()
+		if err := db.Close(); err != nil {
+			t.Fatalf("Failed to close database connection: %v", err)
+		}
+	}
+
+	return NewMySQLTwoFactorRepository(db), mock, cleanup
+}
+
+func TestMySQLTwoFactorRepository_GetByUser(t *testing.T) {
+	columns := []string{"user_id", "secret", "enabled_at", "last_used_step", "created_at", "updated_at"}
+
+	tests := []struct {
+		name         string
+		setup        func(mock sqlmock.Sqlmock)
+		wantErr      error
+		enabled      bool
+		lastUsedStep int64
+	}{
+		{
+			name: "pending enrollment",
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery("SELECT user_id, secret, enabled_at, last_used_step, created_at, updated_at").
+					WithArgs(int64(1)).
+					WillReturnRows(sqlmock.NewRows(columns).AddRow(1, "SECRET", nil, 0, time.Now(), time.Now()))
+			},
+		},
+		{
+			name: "enabled enrollment",
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery("SELECT user_id, secret, enabled_at, last_used_step, created_at, updated_at").
+					WithArgs(int64(1)).
+					WillReturnRows(sqlmock.NewRows(columns).AddRow(1, "SECRET", time.Now(), 100, time.Now(), time.Now()))
+			},
+			enabled:      true,
+			lastUsedStep: 100,
+		},
+		{
+			name: "not enrolled",
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery("SELECT user_id, secret, enabled_at, last_used_step, created_at, updated_at").
+					WithArgs(int64(1)).
+					WillReturnError(sql.ErrNoRows)
+			},
+			wantErr: domainuser.ErrTwoFactorNotEnrolled,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo, mock, cleanup := newTestTwoFactorRepository(t)
+			defer cleanup()
+			tt.setup(mock)
+
+			result, err := repo.GetByUser(context.Background(), 1)
+
+			if tt.wantErr != nil {
+				assert.Equal(t, tt.wantErr, err)
+				assert.Nil(t, result)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, "SECRET", result.Secret)
+				assert.Equal(t, tt.enabled, result.IsEnabled())
+				assert.Equal(t, tt.lastUsedStep, result.LastUsedStep)
+			}
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestMySQLTwoFactorRepository_Save(t *testing.T) {
+	repo, mock, cleanup := newTestTwoFactorRepository(t)
+	defer cleanup()
+
+	mock.ExpectExec("INSERT INTO user_two_factor").
+		WithArgs(int64(1), "SECRET", sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	err := repo.Save(context.Background(), &domainuser.TwoFactor{UserID: 1, Secret: "SECRET", CreatedAt: time.Now(), UpdatedAt: time.Now()})
+
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestMySQLTwoFactorRepository_Delete(t *testing.T) {
+	tests := []struct {
+		name    string
+		setup   func(mock sqlmock.Sqlmock)
+		wantErr bool
+	}{
+		{
+			name: "success delete",
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec("DELETE FROM user_recovery_codes").WithArgs(int64(1)).WillReturnResult(sqlmock.NewResult(0, 10))
+				mock.ExpectExec("DELETE FROM user_two_factor").WithArgs(int64(1)).WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name: "error rolls back",
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec("DELETE FROM user_recovery_codes").WithArgs(int64(1)).WillReturnError(errors.New("database error"))
+				mock.ExpectRollback()
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo, mock, cleanup := newTestTwoFactorRepository(t)
+			defer cleanup()
+			tt.setup(mock)
+
+			err := repo.Delete(context.Background(), 1)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestMySQLTwoFactorRepository_ReplaceRecoveryCodes(t *testing.T) {
+	repo, mock, cleanup := newTestTwoFactorRepository(t)
+	defer cleanup()
+
+	mock.ExpectBegin()
+	mock.ExpectExec("DELETE FROM user_recovery_codes").WithArgs(int64(1)).WillReturnResult(sqlmock.NewResult(0, 10))
+	mock.ExpectExec("INSERT INTO user_recovery_codes").WithArgs(int64(1), "hash-1", sqlmock.AnyArg()).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec("INSERT INTO user_recovery_codes").WithArgs(int64(1), "hash-2", sqlmock.AnyArg()).WillReturnResult(sqlmock.NewResult(2, 1))
+	mock.ExpectCommit()
+
+	err := repo.ReplaceRecoveryCodes(context.Background(), 1, []string{"hash-1", "hash-2"})
+
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestMySQLTwoFactorRepository_UseRecoveryCode(t *testing.T) {
+	tests := []struct {
+		name    string
+		setup   func(mock sqlmock.Sqlmock)
+		wantErr error
+	}{
+		{
+			name: "unused code",
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec("UPDATE user_recovery_codes SET used_at").
+					WithArgs(sqlmock.AnyArg(), int64(1), "hash-1").
+					WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+		},
+		{
+			name: "unknown or used code",
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec("UPDATE user_recovery_codes SET used_at").
+					WithArgs(sqlmock.AnyArg(), int64(1), "hash-1").
+					WillReturnResult(sqlmock.NewResult(0, 0))
+			},
+			wantErr: domainuser.ErrInvalidTwoFactorCode,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo, mock, cleanup := newTestTwoFactorRepository(t)
+			defer cleanup()
+			tt.setup(mock)
+
+			err := repo.UseRecoveryCode(context.Background(), 1, "hash-1")
+
+			assert.Equal(t, tt.wantErr, err)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestMySQLTwoFactorRepository_UseTimeStep(t *testing.T) {
+	tests := []struct {
+		name    string
+		setup   func(mock sqlmock.Sqlmock)
+		wantErr error
+	}{
+		{
+			name: "later step",
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec("UPDATE user_two_factor SET last_used_step").
+					WithArgs(int64(100), int64(1), int64(100)).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+		},
+		{
+			name: "same or earlier step",
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec("UPDATE user_two_factor SET last_used_step").
+					WithArgs(int64(100), int64(1), int64(100)).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+			},
+			wantErr: domainuser.ErrInvalidTwoFactorCode,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo, mock, cleanup := newTestTwoFactorRepository(t)
+			defer cleanup()
+			tt.setup(mock)
+
+			err := repo.UseTimeStep(context.Background(), 1, 100)
+
+			assert.Equal(t, tt.wantErr, err)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
@@ -1,14 +1,55 @@
 package dto
 
+import "time"
+
 // CreateArticleRequest represents the request DTO for creating an article
 type CreateArticleRequest struct {
-	Title    string `json:"title" binding:"required"`
-	Content  string `json:"content" binding:"required"`
-	AuthorID int64  `json:"author_id" binding:"required"`
+	Title      string `json:"title" binding:"required"`
+	Content    string `json:"content" binding:"required"`
+	CategoryID *int64 `json:"category_id"` // Optional
 }
 
-// UpdateArticleRequest represents the request DTO for updating an article
+// UpdateArticleRequest represents the request DTO for updating an article.
+// The category is replaced as well, a missing category_id takes the article out of its category.
 type UpdateArticleRequest struct {
-	Title   string `json:"title" binding:"required"`
-	Content string `json:"content" binding:"required"`
+	Title      string `json:"title" binding:"required"`
+	Content    string `json:"content" binding:"required"`
+	CategoryID *int64 `json:"category_id"`
+}
+
+// ListArticlesRequest represents the query for listing articles.
+// CategoryID also lists the articles of its subcategories.
+type ListArticlesRequest struct {
+	TagID      int64 `form:"tag_id"`
+	CategoryID int64 `form:"category_id"`
+	Limit      int   `form:"limit"`
+	Offset     int   `form:"offset"`
+}
+
+// SearchArticlesRequest represents the query for searching articles by title and content
+type SearchArticlesRequest struct {
+	Query  string `form:"q"`
+	Limit  int    `form:"limit"`
+	Offset int    `form:"offset"`
+}
+
+// TagRequest represents the request DTO for creating or renaming a tag
+type TagRequest struct {
+	Name string `json:"name" binding:"required"`
+}
+
+// CategoryRequest represents the request DTO for creating or updating a category
+type CategoryRequest struct {
+	Name     string `json:"name" binding:"required"`
+	ParentID *int64 `json:"parent_id"` // Nil for a top level category
+}
+
+// SetArticleTagsRequest represents the request DTO for replacing the tags of an article
+type SetArticleTagsRequest struct {
+	TagIDs []int64 `json:"tag_ids"`
+}
+
+// ScheduleArticleRequest represents the request DTO for scheduling the publication of an article
+type ScheduleArticleRequest struct {
+	PublishAt time.Time `json:"publish_at" binding:"required"`
 }
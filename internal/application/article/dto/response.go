@@ -4,12 +4,19 @@ import "time"
 
 // ArticleResponse represents the response DTO for article
 type ArticleResponse struct {
-	ID        int64     `json:"id"`
-	Title     string    `json:"title"`
-	Content   string    `json:"content"`
-	AuthorID  int64     `json:"author_id"`
-	CreatedAt time.Time `json:"created_at"`
-	UpdatedAt time.Time `json:"updated_at"`
+	ID          int64      `json:"id"`
+	Title       string     `json:"title"`
+	Slug        string     `json:"slug"`
+	Content     string     `json:"content"`
+	AuthorID    int64      `json:"author_id"`
+	OrgID       int64      `json:"org_id"`
+	CategoryID  *int64     `json:"category_id,omitempty"`
+	Status      string     `json:"status"`
+	PublishedAt *time.Time `json:"published_at,omitempty"`
+	PublishAt   *time.Time `json:"publish_at,omitempty"`
+	CreatedAt   time.Time  `json:"created_at"`
+	UpdatedAt   time.Time  `json:"updated_at"`
+	DeletedAt   *time.Time `json:"deleted_at,omitempty"`
 }
 
 // ListArticlesResponse represents the response DTO for listing articles
@@ -19,3 +26,58 @@ type ListArticlesResponse struct {
 	Limit    int               `json:"limit"`
 	Offset   int               `json:"offset"`
 }
+
+// ArticleBySlugResponse represents the response DTO for an article looked up by slug.
+// RedirectTo holds the current slug when the article was found by a previous one.
+type ArticleBySlugResponse struct {
+	Article    ArticleResponse `json:"article"`
+	RedirectTo string          `json:"redirect_to,omitempty"`
+}
+
+// SearchHitResponse represents an article found by a search.
+// Snippet is HTML escaped with the matching words wrapped in <mark></mark>.
+type SearchHitResponse struct {
+	Article ArticleResponse `json:"article"`
+	Score   float64         `json:"score"`
+	Snippet string          `json:"snippet"`
+}
+
+// SearchArticlesResponse represents the response DTO for searching articles, hits come by relevance
+type SearchArticlesResponse struct {
+	Query  string              `json:"query"`
+	Hits   []SearchHitResponse `json:"hits"`
+	Total  int64               `json:"total"`
+	Limit  int                 `json:"limit"`
+	Offset int                 `json:"offset"`
+}
+
+// TagResponse represents the response DTO for tag
+type TagResponse struct {
+	ID           int64     `json:"id"`
+	Name         string    `json:"name"`
+	Slug         string    `json:"slug"`
+	ArticleCount *int64    `json:"article_count,omitempty"` // Only set on tag listings
+	CreatedAt    time.Time `json:"created_at"`
+	UpdatedAt    time.Time `json:"updated_at"`
+}
+
+// ListTagsResponse represents the response DTO for listing tags
+type ListTagsResponse struct {
+	Tags []TagResponse `json:"tags"`
+}
+
+// CategoryResponse represents the response DTO for category, listings nest subcategories under Children
+type CategoryResponse struct {
+	ID        int64              `json:"id"`
+	ParentID  *int64             `json:"parent_id,omitempty"`
+	Name      string             `json:"name"`
+	Slug      string             `json:"slug"`
+	CreatedAt time.Time          `json:"created_at"`
+	UpdatedAt time.Time          `json:"updated_at"`
+	Children  []CategoryResponse `json:"children,omitempty"`
+}
+
+// ListCategoriesResponse represents the response DTO for listing categories as a tree
+type ListCategoriesResponse struct {
+	Categories []CategoryResponse `json:"categories"`
+}
@@ -0,0 +1,114 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	domainarticle "github.com/rulzi/hexa-go/internal/domain/article"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestDeleteArticlesByAuthorUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+	repo := &mockArticleRepository{}
+	cache := &mockArticleCache{}
+	listCache := &mockArticleListCache{}
+	index := &mockSearchIndex{}
+	uc := NewDeleteArticlesByAuthorUseCase(repo, cache, listCache, index)
+
+	// A full batch makes the use case read the next one
+	batch := make([]*domainarticle.Article, authorBatchSize)
+	for i := range batch {
+		batch[i] = &domainarticle.Article{ID: int64(i + 1), AuthorID: 7}
+	}
+	repo.On("ListAllByAuthor", ctx, int64(7), authorBatchSize, 0).Return(batch, nil)
+	repo.On("ListAllByAuthor", ctx, int64(7), authorBatchSize, authorBatchSize).Return([]*domainarticle.Article{{ID: 500, AuthorID: 7}}, nil)
+	repo.On("DeleteByAuthor", ctx, int64(0), int64(7)).Return(int64(authorBatchSize+1), nil)
+	for _, a := range batch {
+		cache.On("Delete", ctx, a.ID).Return(nil)
+	}
+	cache.On("Delete", ctx, int64(500)).Return(nil)
+	cache.On("InvalidateList", ctx).Return(nil)
+	listCache.On("InvalidateArticleList", ctx).Return(nil)
+	index.On("Remove", ctx, mock.Anything).Return(nil)
+
+	deleted, err := uc.Execute(ctx, 0, 7)
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(authorBatchSize+1), deleted)
+	repo.AssertExpectations(t)
+	cache.AssertExpectations(t)
+	listCache.AssertExpectations(t)
+	index.AssertNumberOfCalls(t, "Remove", authorBatchSize+1)
+}
+
+func TestDeleteArticlesByAuthorUseCase_Execute_Error(t *testing.T) {
+	ctx := context.Background()
+	repo := &mockArticleRepository{}
+	cache := &mockArticleCache{}
+	uc := NewDeleteArticlesByAuthorUseCase(repo, cache, nil, nil)
+
+	repo.On("ListAllByAuthor", ctx, int64(7), authorBatchSize, 0).Return([]*domainarticle.Article{{ID: 1, AuthorID: 7}}, nil)
+	repo.On("DeleteByAuthor", ctx, int64(0), int64(7)).Return(int64(0), errors.New("database error"))
+
+	_, err := uc.Execute(ctx, 0, 7)
+
+	assert.Error(t, err)
+	cache.AssertNotCalled(t, "Delete")
+}
+
+func TestDeleteArticlesByAuthorUseCase_Execute_Organization(t *testing.T) {
+	ctx := context.Background()
+	repo := &mockArticleRepository{}
+	cache := &mockArticleCache{}
+	uc := NewDeleteArticlesByAuthorUseCase(repo, cache, nil, nil)
+
+	repo.On("ListAllByAuthor", ctx, int64(7), authorBatchSize, 0).Return([]*domainarticle.Article{
+		{ID: 1, AuthorID: 7, OrgID: 2},
+		{ID: 2, AuthorID: 7, OrgID: 3},
+	}, nil)
+	repo.On("DeleteByAuthor", ctx, int64(2), int64(7)).Return(int64(1), nil)
+	cache.On("Delete", ctx, int64(1)).Return(nil)
+	cache.On("InvalidateList", ctx).Return(nil)
+
+	deleted, err := uc.Execute(ctx, 2, 7)
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), deleted)
+	repo.AssertExpectations(t)
+	cache.AssertNotCalled(t, "Delete", ctx, int64(2))
+}
+
+func TestReassignArticlesUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+	repo := &mockArticleRepository{}
+	cache := &mockArticleCache{}
+	listCache := &mockArticleListCache{}
+	index := &mockSearchIndex{}
+	uc := NewReassignArticlesUseCase(repo, cache, listCache, index)
+
+	// Articles in the trash are not indexed
+	deletedAt := time.Now()
+	repo.On("ListAllByAuthor", ctx, int64(7), authorBatchSize, 0).Return([]*domainarticle.Article{{ID: 3, AuthorID: 7}, {ID: 4, AuthorID: 7, DeletedAt: &deletedAt}}, nil)
+	repo.On("ReassignAuthor", ctx, int64(0), int64(7), int64(1)).Return(int64(1), nil)
+	cache.On("Delete", ctx, int64(3)).Return(nil)
+	cache.On("Delete", ctx, int64(4)).Return(nil)
+	cache.On("InvalidateList", ctx).Return(nil)
+	listCache.On("InvalidateArticleList", ctx).Return(nil)
+	index.On("Index", ctx, mock.MatchedBy(func(a *domainarticle.Article) bool {
+		return a.ID == 3 && a.AuthorID == 1
+	})).Return(nil)
+
+	moved, err := uc.Execute(ctx, 0, 7, 1)
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), moved)
+	repo.AssertExpectations(t)
+	cache.AssertExpectations(t)
+	listCache.AssertExpectations(t)
+	index.AssertExpectations(t)
+	index.AssertNumberOfCalls(t, "Index", 1)
+}
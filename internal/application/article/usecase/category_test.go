@@ -0,0 +1,238 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/rulzi/hexa-go/internal/application/article/dto"
+	domainarticle "github.com/rulzi/hexa-go/internal/domain/article"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func int64Ptr(v int64) *int64 {
+	return &v
+}
+
+// sportCategories is the tree Sport > Football > Premier League, next to News
+var sportCategories = []*domainarticle.Category{
+	{ID: 1, OrgID: 1, Name: "Sport", Slug: "sport"},
+	{ID: 2, OrgID: 1, ParentID: int64Ptr(1), Name: "Football", Slug: "football"},
+	{ID: 3, OrgID: 1, ParentID: int64Ptr(2), Name: "Premier League", Slug: "premier-league"},
+	{ID: 4, OrgID: 1, Name: "News", Slug: "news"},
+}
+
+func TestCreateCategoryUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+	categoryRepo := &mockCategoryRepository{}
+	uc := NewCreateCategoryUseCase(categoryRepo)
+
+	categoryRepo.On("GetByID", ctx, int64(1), int64(1)).Return(sportCategories[0], nil)
+	categoryRepo.On("Create", ctx, mock.MatchedBy(func(c *domainarticle.Category) bool {
+		return c.OrgID == 1 && *c.ParentID == 1 && c.Slug == "tennis" && c.CreatedBy == 2 && c.UpdatedBy == 2
+	})).Return(&domainarticle.Category{ID: 5, OrgID: 1, ParentID: int64Ptr(1), Name: "Tennis", Slug: "tennis"}, nil)
+
+	result, err := uc.Execute(ctx, reader, dto.CategoryRequest{Name: "Tennis", ParentID: int64Ptr(1)})
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(5), result.ID)
+	categoryRepo.AssertExpectations(t)
+}
+
+func TestCreateCategoryUseCase_Execute_UnknownParent(t *testing.T) {
+	ctx := context.Background()
+	categoryRepo := &mockCategoryRepository{}
+	uc := NewCreateCategoryUseCase(categoryRepo)
+
+	categoryRepo.On("GetByID", ctx, int64(1), int64(9)).Return(nil, domainarticle.ErrCategoryNotFound)
+
+	result, err := uc.Execute(ctx, reader, dto.CategoryRequest{Name: "Tennis", ParentID: int64Ptr(9)})
+
+	assert.ErrorIs(t, err, domainarticle.ErrCategoryNotFound)
+	assert.Nil(t, result)
+	categoryRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+}
+
+func TestUpdateCategoryUseCase_Execute(t *testing.T) {
+	tests := []struct {
+		name     string
+		id       int64
+		parentID *int64
+		wantErr  error
+	}{
+		{name: "move under another category", id: 2, parentID: int64Ptr(4)},
+		{name: "move to the top level", id: 3},
+		{name: "move under itself", id: 2, parentID: int64Ptr(2), wantErr: domainarticle.ErrCategoryCycle},
+		{name: "move under a subcategory", id: 1, parentID: int64Ptr(3), wantErr: domainarticle.ErrCategoryCycle},
+		{name: "unknown parent", id: 2, parentID: int64Ptr(9), wantErr: domainarticle.ErrCategoryNotFound},
+		{name: "unknown category", id: 9, wantErr: domainarticle.ErrCategoryNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			categoryRepo := &mockCategoryRepository{}
+			listCache := &mockArticleListCache{}
+			uc := NewUpdateCategoryUseCase(categoryRepo, listCache)
+
+			// Work on a copy, the use case moves the category it updates
+			categories := make([]*domainarticle.Category, len(sportCategories))
+			for i, c := range sportCategories {
+				copied := *c
+				categories[i] = &copied
+			}
+			categoryRepo.On("List", ctx, int64(1)).Return(categories, nil)
+			categoryRepo.On("Update", ctx, mock.Anything).Return(&domainarticle.Category{ID: tt.id, OrgID: 1, ParentID: tt.parentID, Name: "Renamed", Slug: "renamed"}, nil)
+			listCache.On("InvalidateArticleList", ctx).Return(nil)
+
+			result, err := uc.Execute(ctx, reader, tt.id, dto.CategoryRequest{Name: "Renamed", ParentID: tt.parentID})
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.Nil(t, result)
+				categoryRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.parentID, result.ParentID)
+			assert.Equal(t, "renamed", result.Slug)
+			listCache.AssertExpectations(t)
+		})
+	}
+}
+
+func TestCategoryUseCases_Execute_Impersonated(t *testing.T) {
+	ctx := context.Background()
+	categoryRepo := &mockCategoryRepository{}
+	listCache := &mockArticleListCache{}
+
+	// The admin impersonating the user is recorded on the category
+	actor := domainarticle.Actor{UserID: 2, OrgID: 1, ActorID: 9}
+	categoryRepo.On("Create", ctx, mock.MatchedBy(func(c *domainarticle.Category) bool {
+		return c.CreatedBy == 9 && c.UpdatedBy == 9
+	})).Return(&domainarticle.Category{ID: 5, OrgID: 1, Name: "Tennis", Slug: "tennis"}, nil)
+	categoryRepo.On("List", ctx, int64(1)).Return([]*domainarticle.Category{{ID: 4, OrgID: 1, Name: "News", Slug: "news", CreatedBy: 2, UpdatedBy: 2}}, nil)
+	categoryRepo.On("Update", ctx, mock.MatchedBy(func(c *domainarticle.Category) bool {
+		return c.CreatedBy == 2 && c.UpdatedBy == 9
+	})).Return(&domainarticle.Category{ID: 4, OrgID: 1, Name: "Renamed", Slug: "renamed"}, nil)
+	listCache.On("InvalidateArticleList", ctx).Return(nil)
+
+	_, err := NewCreateCategoryUseCase(categoryRepo).Execute(ctx, actor, dto.CategoryRequest{Name: "Tennis"})
+	assert.NoError(t, err)
+
+	_, err = NewUpdateCategoryUseCase(categoryRepo, listCache).Execute(ctx, actor, 4, dto.CategoryRequest{Name: "Renamed"})
+	assert.NoError(t, err)
+
+	categoryRepo.AssertExpectations(t)
+}
+
+func TestDeleteCategoryUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+	categoryRepo := &mockCategoryRepository{}
+	articleRepo := &mockArticleRepository{}
+	cache := &mockArticleCache{}
+	listCache := &mockArticleListCache{}
+	uc := NewDeleteCategoryUseCase(categoryRepo, articleRepo, cache, listCache)
+
+	articleRepo.On("ListIDsByCategory", ctx, int64(1), int64(1)).Return([]int64{4, 7}, nil)
+	categoryRepo.On("Delete", ctx, int64(1), int64(1)).Return(nil)
+	// The cached articles, by ID and by slug, still carry the deleted category
+	cache.On("Delete", ctx, int64(4)).Return(nil)
+	cache.On("Delete", ctx, int64(7)).Return(nil)
+	cache.On("InvalidateList", ctx).Return(nil)
+	listCache.On("InvalidateArticleList", ctx).Return(nil)
+
+	err := uc.Execute(ctx, reader, 1)
+
+	assert.NoError(t, err)
+	cache.AssertExpectations(t)
+	listCache.AssertExpectations(t)
+}
+
+func TestDeleteCategoryUseCase_Execute_HasChildren(t *testing.T) {
+	ctx := context.Background()
+	categoryRepo := &mockCategoryRepository{}
+	articleRepo := &mockArticleRepository{}
+	cache := &mockArticleCache{}
+	listCache := &mockArticleListCache{}
+	uc := NewDeleteCategoryUseCase(categoryRepo, articleRepo, cache, listCache)
+
+	articleRepo.On("ListIDsByCategory", ctx, int64(1), int64(1)).Return([]int64{4}, nil)
+	categoryRepo.On("Delete", ctx, int64(1), int64(1)).Return(domainarticle.ErrCategoryHasChildren)
+
+	err := uc.Execute(ctx, reader, 1)
+
+	assert.ErrorIs(t, err, domainarticle.ErrCategoryHasChildren)
+	cache.AssertNotCalled(t, "Delete", mock.Anything, mock.Anything)
+	listCache.AssertNotCalled(t, "InvalidateArticleList", mock.Anything)
+}
+
+func TestListCategoriesUseCase_Execute_Tree(t *testing.T) {
+	ctx := context.Background()
+	categoryRepo := &mockCategoryRepository{}
+	uc := NewListCategoriesUseCase(categoryRepo)
+
+	categoryRepo.On("List", ctx, int64(1)).Return(sportCategories, nil)
+
+	result, err := uc.Execute(ctx, reader)
+
+	assert.NoError(t, err)
+	assert.Len(t, result.Categories, 2)
+	assert.Equal(t, "sport", result.Categories[0].Slug)
+	assert.Equal(t, "football", result.Categories[0].Children[0].Slug)
+	assert.Equal(t, "premier-league", result.Categories[0].Children[0].Children[0].Slug)
+	assert.Empty(t, result.Categories[1].Children)
+}
+
+func TestListArticlesUseCase_Execute_ByCategory(t *testing.T) {
+	ctx := context.Background()
+	repo := &mockArticleRepository{}
+	categoryRepo := &mockCategoryRepository{}
+	uc := NewListArticlesUseCase(repo, nil, nil, nil, categoryRepo)
+
+	// Listing Football also lists Premier League
+	filter := domainarticle.ListFilter{OrgID: 1, AuthorID: 2, CategoryIDs: []int64{2, 3}}
+	categoryRepo.On("List", ctx, int64(1)).Return(sportCategories, nil)
+	repo.On("List", ctx, filter, 10, 0).Return([]*domainarticle.Article{}, nil)
+	repo.On("Count", ctx, filter).Return(int64(0), nil)
+
+	result, err := uc.Execute(ctx, reader, dto.ListArticlesRequest{CategoryID: 2})
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), result.Total)
+	repo.AssertExpectations(t)
+}
+
+func TestCreateArticleUseCase_Execute_UnknownCategory(t *testing.T) {
+	ctx := context.Background()
+	repo := &mockArticleRepository{}
+	categoryRepo := &mockCategoryRepository{}
+	uc := NewCreateArticleUseCase(repo, domainarticle.NewService(repo), nil, categoryRepo, nil)
+
+	categoryRepo.On("GetByID", ctx, int64(1), int64(9)).Return(nil, domainarticle.ErrCategoryNotFound)
+
+	result, err := uc.Execute(ctx, reader, dto.CreateArticleRequest{Title: "Title", Content: "Content", CategoryID: int64Ptr(9)})
+
+	assert.ErrorIs(t, err, domainarticle.ErrCategoryNotFound)
+	assert.Nil(t, result)
+	repo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+}
+
+func TestUpdateArticleUseCase_Execute_Category(t *testing.T) {
+	ctx := context.Background()
+	repo := &mockArticleRepository{}
+	categoryRepo := &mockCategoryRepository{}
+	uc := NewUpdateArticleUseCase(repo, domainarticle.NewService(repo), nil, nil, categoryRepo, nil)
+
+	repo.On("GetByID", ctx, int64(1), int64(1)).Return(&domainarticle.Article{ID: 1, Title: "Title", Slug: "title", AuthorID: 2, OrgID: 1}, nil)
+	categoryRepo.On("GetByID", ctx, int64(1), int64(2)).Return(sportCategories[1], nil)
+	repo.On("Update", ctx, mock.MatchedBy(func(a *domainarticle.Article) bool {
+		return a.CategoryID != nil && *a.CategoryID == 2
+	})).Return(&domainarticle.Article{ID: 1, Title: "Title", Slug: "title", AuthorID: 2, OrgID: 1, CategoryID: int64Ptr(2)}, nil)
+
+	result, err := uc.Execute(ctx, reader, 1, dto.UpdateArticleRequest{Title: "Title", Content: "Content", CategoryID: int64Ptr(2)})
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), *result.CategoryID)
+	repo.AssertExpectations(t)
+}
@@ -13,6 +13,8 @@ type CreateArticleUseCase struct {
 	articleRepo    domainarticle.Repository
 	articleService *domainarticle.Service
 	cache          domainarticle.Cache
+	categoryRepo   domainarticle.CategoryRepository
+	searchIndex    domainarticle.SearchIndex
 }
 
 // NewCreateArticleUseCase creates a new CreateArticleUseCase
@@ -20,23 +22,34 @@ func NewCreateArticleUseCase(
 	articleRepo domainarticle.Repository,
 	articleService *domainarticle.Service,
 	cache domainarticle.Cache,
+	categoryRepo domainarticle.CategoryRepository,
+	searchIndex domainarticle.SearchIndex,
 ) *CreateArticleUseCase {
 	return &CreateArticleUseCase{
 		articleRepo:    articleRepo,
 		articleService: articleService,
 		cache:          cache,
+		categoryRepo:   categoryRepo,
+		searchIndex:    searchIndex,
 	}
 }
 
-// Execute executes the create article use case
-func (uc *CreateArticleUseCase) Execute(ctx context.Context, req dto.CreateArticleRequest) (*dto.ArticleResponse, error) {
-	// Create article entity
+// Execute executes the create article use case.
+// The actor becomes the author of the article, which belongs to the
+// organization the actor is signed in to.
+func (uc *CreateArticleUseCase) Execute(ctx context.Context, actor domainarticle.Actor, req dto.CreateArticleRequest) (*dto.ArticleResponse, error) {
+	// Create article entity, new articles are drafts until they are reviewed and published
 	newArticle := &domainarticle.Article{
-		Title:     req.Title,
-		Content:   req.Content,
-		AuthorID:  req.AuthorID,
-		CreatedAt: time.Now(),
-		UpdatedAt: time.Now(),
+		Title:      req.Title,
+		Content:    req.Content,
+		AuthorID:   actor.UserID,
+		OrgID:      actor.OrgID,
+		CategoryID: req.CategoryID,
+		Status:     domainarticle.StatusDraft,
+		CreatedAt:  time.Now(),
+		UpdatedAt:  time.Now(),
+		CreatedBy:  actor.RecordedID(),
+		UpdatedBy:  actor.RecordedID(),
 	}
 
 	// Validate entity
@@ -44,8 +57,16 @@ func (uc *CreateArticleUseCase) Execute(ctx context.Context, req dto.CreateArtic
 		return nil, err
 	}
 
+	if err := checkCategory(ctx, uc.categoryRepo, actor.OrgID, req.CategoryID); err != nil {
+		return nil, err
+	}
+
+	if err := uc.articleService.AssignSlug(ctx, newArticle); err != nil {
+		return nil, err
+	}
+
 	// Save to repository
-	createdArticle, err := uc.articleRepo.Create(ctx, newArticle)
+	createdArticle, err := saveWithSlug(ctx, uc.articleService, newArticle, uc.articleRepo.Create)
 	if err != nil {
 		return nil, err
 	}
@@ -54,14 +75,55 @@ func (uc *CreateArticleUseCase) Execute(ctx context.Context, req dto.CreateArtic
 	if uc.cache != nil {
 		_ = uc.cache.InvalidateList(ctx)
 	}
+	indexArticle(ctx, uc.searchIndex, createdArticle)
 
 	// Return response DTO
 	return &dto.ArticleResponse{
-		ID:        createdArticle.ID,
-		Title:     createdArticle.Title,
-		Content:   createdArticle.Content,
-		AuthorID:  createdArticle.AuthorID,
-		CreatedAt: createdArticle.CreatedAt,
-		UpdatedAt: createdArticle.UpdatedAt,
+		ID:          createdArticle.ID,
+		Title:       createdArticle.Title,
+		Slug:        createdArticle.Slug,
+		Content:     createdArticle.Content,
+		AuthorID:    createdArticle.AuthorID,
+		OrgID:       createdArticle.OrgID,
+		CategoryID:  createdArticle.CategoryID,
+		Status:      string(createdArticle.Status),
+		PublishedAt: createdArticle.PublishedAt,
+		PublishAt:   createdArticle.PublishAt,
+		CreatedAt:   createdArticle.CreatedAt,
+		UpdatedAt:   createdArticle.UpdatedAt,
 	}, nil
 }
+
+// maxSaveAttempts bounds the writes of an article whose slug other articles keep taking
+const maxSaveAttempts = 3
+
+// saveWithSlug writes the article with save. Another article may take the slug between
+// the check and the write, the write is then retried with the next free slug.
+func saveWithSlug(
+	ctx context.Context,
+	service *domainarticle.Service,
+	a *domainarticle.Article,
+	save func(context.Context, *domainarticle.Article) (*domainarticle.Article, error),
+) (*domainarticle.Article, error) {
+	for attempt := 1; ; attempt++ {
+		saved, err := save(ctx, a)
+		if err != domainarticle.ErrSlugTaken {
+			return saved, err
+		}
+		if attempt == maxSaveAttempts {
+			return nil, domainarticle.ErrSlugUnavailable
+		}
+		if err := service.AssignSlug(ctx, a); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// checkCategory makes sure the category of an article belongs to the organization of the article
+func checkCategory(ctx context.Context, categoryRepo domainarticle.CategoryRepository, orgID int64, categoryID *int64) error {
+	if categoryID == nil {
+		return nil
+	}
+	_, err := categoryRepo.GetByID(ctx, orgID, *categoryID)
+	return err
+}
@@ -0,0 +1,54 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/rulzi/hexa-go/internal/application/article/dto"
+	domainarticle "github.com/rulzi/hexa-go/internal/domain/article"
+)
+
+// CreateCategoryUseCase handles the creation of a new category
+type CreateCategoryUseCase struct {
+	categoryRepo domainarticle.CategoryRepository
+}
+
+// NewCreateCategoryUseCase creates a new CreateCategoryUseCase
+func NewCreateCategoryUseCase(categoryRepo domainarticle.CategoryRepository) *CreateCategoryUseCase {
+	return &CreateCategoryUseCase{
+		categoryRepo: categoryRepo,
+	}
+}
+
+// Execute executes the create category use case in the organization of the actor.
+// The parent, if any, must be a category of the same organization.
+func (uc *CreateCategoryUseCase) Execute(ctx context.Context, actor domainarticle.Actor, req dto.CategoryRequest) (*dto.CategoryResponse, error) {
+	newCategory := &domainarticle.Category{
+		OrgID:     actor.OrgID,
+		ParentID:  req.ParentID,
+		Name:      req.Name,
+		Slug:      domainarticle.Slugify(req.Name),
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+		CreatedBy: actor.RecordedID(),
+		UpdatedBy: actor.RecordedID(),
+	}
+
+	if err := newCategory.Validate(); err != nil {
+		return nil, err
+	}
+
+	if newCategory.ParentID != nil {
+		if _, err := uc.categoryRepo.GetByID(ctx, actor.OrgID, *newCategory.ParentID); err != nil {
+			return nil, err
+		}
+	}
+
+	createdCategory, err := uc.categoryRepo.Create(ctx, newCategory)
+	if err != nil {
+		return nil, err
+	}
+
+	response := categoryResponse(createdCategory)
+	return &response, nil
+}
@@ -0,0 +1,46 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/rulzi/hexa-go/internal/application/article/dto"
+	domainarticle "github.com/rulzi/hexa-go/internal/domain/article"
+)
+
+// CreateTagUseCase handles the creation of a new tag
+type CreateTagUseCase struct {
+	tagRepo domainarticle.TagRepository
+}
+
+// NewCreateTagUseCase creates a new CreateTagUseCase
+func NewCreateTagUseCase(tagRepo domainarticle.TagRepository) *CreateTagUseCase {
+	return &CreateTagUseCase{
+		tagRepo: tagRepo,
+	}
+}
+
+// Execute executes the create tag use case in the organization of the actor
+func (uc *CreateTagUseCase) Execute(ctx context.Context, actor domainarticle.Actor, req dto.TagRequest) (*dto.TagResponse, error) {
+	newTag := &domainarticle.Tag{
+		OrgID:     actor.OrgID,
+		Name:      req.Name,
+		Slug:      domainarticle.Slugify(req.Name),
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+		CreatedBy: actor.RecordedID(),
+		UpdatedBy: actor.RecordedID(),
+	}
+
+	if err := newTag.Validate(); err != nil {
+		return nil, err
+	}
+
+	createdTag, err := uc.tagRepo.Create(ctx, newTag)
+	if err != nil {
+		return nil, err
+	}
+
+	response := tagResponse(createdTag)
+	return &response, nil
+}
@@ -17,7 +17,7 @@ func TestNewCreateArticleUseCase(t *testing.T) {
 	service := domainarticle.NewService(repo)
 	cache := &mockArticleCache{}
 
-	uc := NewCreateArticleUseCase(repo, service, cache)
+	uc := NewCreateArticleUseCase(repo, service, cache, nil, nil)
 
 	assert.NotNil(t, uc)
 	assert.Equal(t, repo, uc.articleRepo)
@@ -30,38 +30,48 @@ func TestCreateArticleUseCase_Execute_Success(t *testing.T) {
 	repo := &mockArticleRepository{}
 	service := domainarticle.NewService(repo)
 	cache := &mockArticleCache{}
+	index := &mockSearchIndex{}
 
-	uc := NewCreateArticleUseCase(repo, service, cache)
+	uc := NewCreateArticleUseCase(repo, service, cache, nil, index)
 
+	actor := domainarticle.Actor{UserID: 1, OrgID: 1}
 	req := dto.CreateArticleRequest{
-		Title:    "Test Article",
-		Content:  "Test Content",
-		AuthorID: 1,
+		Title:   "Test Article",
+		Content: "Test Content",
 	}
 
 	expectedArticle := &domainarticle.Article{
 		ID:        1,
 		Title:     req.Title,
+		Slug:      "test-article",
 		Content:   req.Content,
-		AuthorID:  req.AuthorID,
+		AuthorID:  actor.UserID,
+		OrgID:     actor.OrgID,
 		CreatedAt: time.Now(),
 		UpdatedAt: time.Now(),
 	}
 
-	repo.On("Create", ctx, mock.AnythingOfType("*article.Article")).Return(expectedArticle, nil)
+	repo.On("SlugTaken", ctx, int64(1), "test-article", int64(0)).Return(false, nil)
+	repo.On("Create", ctx, mock.MatchedBy(func(a *domainarticle.Article) bool {
+		return a.AuthorID == actor.UserID && a.OrgID == actor.OrgID && a.Status == domainarticle.StatusDraft && a.Slug == "test-article"
+	})).Return(expectedArticle, nil)
 	cache.On("InvalidateList", ctx).Return(nil)
+	index.On("Index", ctx, expectedArticle).Return(nil)
 
-	result, err := uc.Execute(ctx, req)
+	result, err := uc.Execute(ctx, actor, req)
 
 	assert.NoError(t, err)
 	assert.NotNil(t, result)
 	assert.Equal(t, expectedArticle.ID, result.ID)
 	assert.Equal(t, expectedArticle.Title, result.Title)
+	assert.Equal(t, "test-article", result.Slug)
 	assert.Equal(t, expectedArticle.Content, result.Content)
 	assert.Equal(t, expectedArticle.AuthorID, result.AuthorID)
+	assert.Equal(t, expectedArticle.OrgID, result.OrgID)
 
 	repo.AssertExpectations(t)
 	cache.AssertExpectations(t)
+	index.AssertExpectations(t)
 }
 
 func TestCreateArticleUseCase_Execute_ValidationError(t *testing.T) {
@@ -70,41 +80,42 @@ func TestCreateArticleUseCase_Execute_ValidationError(t *testing.T) {
 	service := domainarticle.NewService(repo)
 	cache := &mockArticleCache{}
 
-	uc := NewCreateArticleUseCase(repo, service, cache)
+	uc := NewCreateArticleUseCase(repo, service, cache, nil, nil)
 
 	tests := []struct {
-		name string
-		req  dto.CreateArticleRequest
+		name  string
+		actor domainarticle.Actor
+		req   dto.CreateArticleRequest
 	}{
 		{
-			name: "empty title",
+			name:  "empty title",
+			actor: domainarticle.Actor{UserID: 1, OrgID: 1},
 			req: dto.CreateArticleRequest{
-				Title:    "",
-				Content:  "Test Content",
-				AuthorID: 1,
+				Title:   "",
+				Content: "Test Content",
 			},
 		},
 		{
-			name: "empty content",
+			name:  "empty content",
+			actor: domainarticle.Actor{UserID: 1, OrgID: 1},
 			req: dto.CreateArticleRequest{
-				Title:    "Test Title",
-				Content:  "",
-				AuthorID: 1,
+				Title:   "Test Title",
+				Content: "",
 			},
 		},
 		{
-			name: "invalid author id",
+			name:  "anonymous actor",
+			actor: domainarticle.Actor{},
 			req: dto.CreateArticleRequest{
-				Title:    "Test Title",
-				Content:  "Test Content",
-				AuthorID: 0,
+				Title:   "Test Title",
+				Content: "Test Content",
 			},
 		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			result, err := uc.Execute(ctx, tt.req)
+			result, err := uc.Execute(ctx, tt.actor, tt.req)
 
 			assert.Error(t, err)
 			assert.Nil(t, result)
@@ -119,18 +130,19 @@ func TestCreateArticleUseCase_Execute_RepositoryError(t *testing.T) {
 	service := domainarticle.NewService(repo)
 	cache := &mockArticleCache{}
 
-	uc := NewCreateArticleUseCase(repo, service, cache)
+	uc := NewCreateArticleUseCase(repo, service, cache, nil, nil)
 
+	actor := domainarticle.Actor{UserID: 1, OrgID: 1}
 	req := dto.CreateArticleRequest{
-		Title:    "Test Article",
-		Content:  "Test Content",
-		AuthorID: 1,
+		Title:   "Test Article",
+		Content: "Test Content",
 	}
 
 	repoError := errors.New("repository error")
+	repo.On("SlugTaken", ctx, int64(1), "test-article", int64(0)).Return(false, nil)
 	repo.On("Create", ctx, mock.AnythingOfType("*article.Article")).Return(nil, repoError)
 
-	result, err := uc.Execute(ctx, req)
+	result, err := uc.Execute(ctx, actor, req)
 
 	assert.Error(t, err)
 	assert.Equal(t, repoError, err)
@@ -144,28 +156,123 @@ func TestCreateArticleUseCase_Execute_WithNilCache(t *testing.T) {
 	repo := &mockArticleRepository{}
 	service := domainarticle.NewService(repo)
 
-	uc := NewCreateArticleUseCase(repo, service, nil)
+	uc := NewCreateArticleUseCase(repo, service, nil, nil, nil)
 
+	actor := domainarticle.Actor{UserID: 1, OrgID: 1}
 	req := dto.CreateArticleRequest{
-		Title:    "Test Article",
-		Content:  "Test Content",
-		AuthorID: 1,
+		Title:   "Test Article",
+		Content: "Test Content",
 	}
 
 	expectedArticle := &domainarticle.Article{
 		ID:        1,
 		Title:     req.Title,
 		Content:   req.Content,
-		AuthorID:  req.AuthorID,
+		AuthorID:  actor.UserID,
 		CreatedAt: time.Now(),
 		UpdatedAt: time.Now(),
 	}
 
+	repo.On("SlugTaken", ctx, int64(1), "test-article", int64(0)).Return(false, nil)
 	repo.On("Create", ctx, mock.AnythingOfType("*article.Article")).Return(expectedArticle, nil)
 
-	result, err := uc.Execute(ctx, req)
+	result, err := uc.Execute(ctx, actor, req)
 
 	assert.NoError(t, err)
 	assert.NotNil(t, result)
 	repo.AssertExpectations(t)
 }
+
+func TestCreateArticleUseCase_Execute_Impersonated(t *testing.T) {
+	ctx := context.Background()
+	repo := &mockArticleRepository{}
+	service := domainarticle.NewService(repo)
+
+	uc := NewCreateArticleUseCase(repo, service, nil, nil, nil)
+
+	// The user stays the author, the admin impersonating them is recorded as the creator
+	actor := domainarticle.Actor{UserID: 1, OrgID: 1, ActorID: 9}
+	req := dto.CreateArticleRequest{
+		Title:   "Test Article",
+		Content: "Test Content",
+	}
+
+	repo.On("SlugTaken", ctx, int64(1), "test-article", int64(0)).Return(false, nil)
+	repo.On("Create", ctx, mock.MatchedBy(func(a *domainarticle.Article) bool {
+		return a.AuthorID == 1 && a.CreatedBy == 9 && a.UpdatedBy == 9
+	})).Return(&domainarticle.Article{ID: 1, AuthorID: 1, CreatedBy: 9, UpdatedBy: 9}, nil)
+
+	result, err := uc.Execute(ctx, actor, req)
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), result.AuthorID)
+	repo.AssertExpectations(t)
+}
+
+func TestCreateArticleUseCase_Execute_SlugTaken(t *testing.T) {
+	ctx := context.Background()
+	repo := &mockArticleRepository{}
+	uc := NewCreateArticleUseCase(repo, domainarticle.NewService(repo), nil, nil, nil)
+
+	actor := domainarticle.Actor{UserID: 1, OrgID: 1}
+	req := dto.CreateArticleRequest{
+		Title:   "Test Article",
+		Content: "Test Content",
+	}
+
+	repo.On("SlugTaken", ctx, int64(1), "test-article", int64(0)).Return(true, nil)
+	repo.On("SlugTaken", ctx, int64(1), "test-article-2", int64(0)).Return(false, nil)
+	repo.On("Create", ctx, mock.MatchedBy(func(a *domainarticle.Article) bool {
+		return a.Slug == "test-article-2"
+	})).Return(&domainarticle.Article{ID: 2, Title: req.Title, Slug: "test-article-2", AuthorID: 1, OrgID: 1}, nil)
+
+	result, err := uc.Execute(ctx, actor, req)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "test-article-2", result.Slug)
+	repo.AssertExpectations(t)
+}
+
+func TestCreateArticleUseCase_Execute_SlugTakenConcurrently(t *testing.T) {
+	ctx := context.Background()
+	repo := &mockArticleRepository{}
+	uc := NewCreateArticleUseCase(repo, domainarticle.NewService(repo), nil, nil, nil)
+
+	actor := domainarticle.Actor{UserID: 1, OrgID: 1}
+	req := dto.CreateArticleRequest{
+		Title:   "Test Article",
+		Content: "Test Content",
+	}
+
+	// Another article takes the slug between the check and the insert
+	repo.On("SlugTaken", ctx, int64(1), "test-article", int64(0)).Return(false, nil).Once()
+	repo.On("Create", ctx, mock.MatchedBy(func(a *domainarticle.Article) bool {
+		return a.Slug == "test-article"
+	})).Return(nil, domainarticle.ErrSlugTaken).Once()
+	repo.On("SlugTaken", ctx, int64(1), "test-article", int64(0)).Return(true, nil).Once()
+	repo.On("SlugTaken", ctx, int64(1), "test-article-2", int64(0)).Return(false, nil)
+	repo.On("Create", ctx, mock.MatchedBy(func(a *domainarticle.Article) bool {
+		return a.Slug == "test-article-2"
+	})).Return(&domainarticle.Article{ID: 2, Title: req.Title, Slug: "test-article-2", AuthorID: 1, OrgID: 1}, nil)
+
+	result, err := uc.Execute(ctx, actor, req)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "test-article-2", result.Slug)
+	repo.AssertExpectations(t)
+}
+
+func TestCreateArticleUseCase_Execute_SlugKeepsBeingTaken(t *testing.T) {
+	ctx := context.Background()
+	repo := &mockArticleRepository{}
+	uc := NewCreateArticleUseCase(repo, domainarticle.NewService(repo), nil, nil, nil)
+
+	repo.On("SlugTaken", ctx, int64(1), mock.Anything, int64(0)).Return(false, nil)
+	repo.On("Create", ctx, mock.AnythingOfType("*article.Article")).Return(nil, domainarticle.ErrSlugTaken)
+
+	result, err := uc.Execute(ctx, domainarticle.Actor{UserID: 1, OrgID: 1}, dto.CreateArticleRequest{Title: "Test Article", Content: "Test Content"})
+
+	assert.Nil(t, result)
+	assert.Equal(t, domainarticle.ErrSlugUnavailable, err)
+	repo.AssertNumberOfCalls(t, "Create", maxSaveAttempts)
+}
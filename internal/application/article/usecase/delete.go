@@ -11,21 +11,24 @@ type DeleteArticleUseCase struct {
 	articleRepo domainarticle.Repository
 	cache       domainarticle.Cache
 	listCache   ArticleListCache
+	searchIndex domainarticle.SearchIndex
 }
 
 // NewDeleteArticleUseCase creates a new DeleteArticleUseCase
-func NewDeleteArticleUseCase(articleRepo domainarticle.Repository, cache domainarticle.Cache, listCache ArticleListCache) *DeleteArticleUseCase {
+func NewDeleteArticleUseCase(articleRepo domainarticle.Repository, cache domainarticle.Cache, listCache ArticleListCache, searchIndex domainarticle.SearchIndex) *DeleteArticleUseCase {
 	return &DeleteArticleUseCase{
 		articleRepo: articleRepo,
 		cache:       cache,
 		listCache:   listCache,
+		searchIndex: searchIndex,
 	}
 }
 
-// Execute executes the delete article use case
-func (uc *DeleteArticleUseCase) Execute(ctx context.Context, id int64) error {
+// Execute executes the delete article use case.
+// Only the author of the article or an admin may delete it.
+func (uc *DeleteArticleUseCase) Execute(ctx context.Context, actor domainarticle.Actor, id int64) error {
 	// Check if article exists
-	existingArticle, err := uc.articleRepo.GetByID(ctx, id)
+	existingArticle, err := uc.articleRepo.GetByID(ctx, actor.OrgID, id)
 	if err != nil {
 		return err
 	}
@@ -34,8 +37,12 @@ func (uc *DeleteArticleUseCase) Execute(ctx context.Context, id int64) error {
 		return domainarticle.ErrArticleNotFound
 	}
 
+	if !actor.CanModify(existingArticle) {
+		return domainarticle.ErrForbidden
+	}
+
 	// Delete article
-	if err := uc.articleRepo.Delete(ctx, id); err != nil {
+	if err := uc.articleRepo.Delete(ctx, actor.OrgID, id); err != nil {
 		return err
 	}
 
@@ -47,6 +54,7 @@ func (uc *DeleteArticleUseCase) Execute(ctx context.Context, id int64) error {
 	if uc.listCache != nil {
 		_ = uc.listCache.InvalidateArticleList(ctx)
 	}
+	unindexArticles(ctx, uc.searchIndex, []int64{id})
 
 	return nil
 }
@@ -0,0 +1,91 @@
+package usecase
+
+import (
+	"context"
+
+	domainarticle "github.com/rulzi/hexa-go/internal/domain/article"
+)
+
+// authorBatchSize is how many articles are read per query when walking the articles of an author
+const authorBatchSize = 100
+
+// DeleteArticlesByAuthorUseCase permanently deletes every article of an author, including the trash.
+// It is used when the author's account or their data in an organization is erased.
+type DeleteArticlesByAuthorUseCase struct {
+	articleRepo domainarticle.Repository
+	cache       domainarticle.Cache
+	listCache   ArticleListCache
+	searchIndex domainarticle.SearchIndex
+}
+
+// NewDeleteArticlesByAuthorUseCase creates a new DeleteArticlesByAuthorUseCase
+func NewDeleteArticlesByAuthorUseCase(articleRepo domainarticle.Repository, cache domainarticle.Cache, listCache ArticleListCache, searchIndex domainarticle.SearchIndex) *DeleteArticlesByAuthorUseCase {
+	return &DeleteArticlesByAuthorUseCase{
+		articleRepo: articleRepo,
+		cache:       cache,
+		listCache:   listCache,
+		searchIndex: searchIndex,
+	}
+}
+
+// Execute deletes the articles of the author in orgID and returns how many were removed,
+// orgID zero deletes them in every organization
+func (uc *DeleteArticlesByAuthorUseCase) Execute(ctx context.Context, orgID, authorID int64) (int64, error) {
+	// The IDs are needed for the cache and the search index once the rows are gone
+	articles, err := articlesByAuthor(ctx, uc.articleRepo, orgID, authorID)
+	if err != nil {
+		return 0, err
+	}
+	ids := articleIDs(articles)
+
+	deleted, err := uc.articleRepo.DeleteByAuthor(ctx, orgID, authorID)
+	if err != nil {
+		return 0, err
+	}
+
+	evictArticles(ctx, uc.cache, uc.listCache, ids)
+	unindexArticles(ctx, uc.searchIndex, ids)
+	return deleted, nil
+}
+
+// articlesByAuthor returns every article of an author in orgID, including the trash.
+// orgID zero returns the articles of every organization.
+func articlesByAuthor(ctx context.Context, repo domainarticle.Repository, orgID, authorID int64) ([]*domainarticle.Article, error) {
+	var all []*domainarticle.Article
+	for offset := 0; ; offset += authorBatchSize {
+		articles, err := repo.ListAllByAuthor(ctx, authorID, authorBatchSize, offset)
+		if err != nil {
+			return nil, err
+		}
+		for _, a := range articles {
+			if orgID == 0 || a.OrgID == orgID {
+				all = append(all, a)
+			}
+		}
+		if len(articles) < authorBatchSize {
+			return all, nil
+		}
+	}
+}
+
+// articleIDs returns the IDs of the articles
+func articleIDs(articles []*domainarticle.Article) []int64 {
+	ids := make([]int64, 0, len(articles))
+	for _, a := range articles {
+		ids = append(ids, a.ID)
+	}
+	return ids
+}
+
+// evictArticles removes the articles from the cache and invalidates the list caches
+func evictArticles(ctx context.Context, cache domainarticle.Cache, listCache ArticleListCache, ids []int64) {
+	if cache != nil {
+		for _, id := range ids {
+			_ = cache.Delete(ctx, id)
+		}
+		_ = cache.InvalidateList(ctx)
+	}
+	if listCache != nil {
+		_ = listCache.InvalidateArticleList(ctx)
+	}
+}
@@ -0,0 +1,49 @@
+package usecase
+
+import (
+	"context"
+
+	domainarticle "github.com/rulzi/hexa-go/internal/domain/article"
+)
+
+// DeleteCategoryUseCase handles deleting a category
+type DeleteCategoryUseCase struct {
+	categoryRepo domainarticle.CategoryRepository
+	articleRepo  domainarticle.Repository
+	cache        domainarticle.Cache
+	listCache    ArticleListCache
+}
+
+// NewDeleteCategoryUseCase creates a new DeleteCategoryUseCase
+func NewDeleteCategoryUseCase(
+	categoryRepo domainarticle.CategoryRepository,
+	articleRepo domainarticle.Repository,
+	cache domainarticle.Cache,
+	listCache ArticleListCache,
+) *DeleteCategoryUseCase {
+	return &DeleteCategoryUseCase{
+		categoryRepo: categoryRepo,
+		articleRepo:  articleRepo,
+		cache:        cache,
+		listCache:    listCache,
+	}
+}
+
+// Execute executes the delete category use case.
+// Its articles are left without category, a category with subcategories can't be deleted.
+func (uc *DeleteCategoryUseCase) Execute(ctx context.Context, actor domainarticle.Actor, id int64) error {
+	// The articles are looked up first, the database takes them out of the category on delete
+	articleIDs, err := uc.articleRepo.ListIDsByCategory(ctx, actor.OrgID, id)
+	if err != nil {
+		return err
+	}
+
+	if err := uc.categoryRepo.Delete(ctx, actor.OrgID, id); err != nil {
+		return err
+	}
+
+	// Cached copies of the articles still carry the category
+	evictArticles(ctx, uc.cache, uc.listCache, articleIDs)
+
+	return nil
+}
@@ -0,0 +1,35 @@
+package usecase
+
+import (
+	"context"
+
+	domainarticle "github.com/rulzi/hexa-go/internal/domain/article"
+)
+
+// DeleteTagUseCase handles deleting a tag
+type DeleteTagUseCase struct {
+	tagRepo   domainarticle.TagRepository
+	listCache ArticleListCache
+}
+
+// NewDeleteTagUseCase creates a new DeleteTagUseCase
+func NewDeleteTagUseCase(tagRepo domainarticle.TagRepository, listCache ArticleListCache) *DeleteTagUseCase {
+	return &DeleteTagUseCase{
+		tagRepo:   tagRepo,
+		listCache: listCache,
+	}
+}
+
+// Execute executes the delete tag use case, the tag is taken off its articles
+func (uc *DeleteTagUseCase) Execute(ctx context.Context, actor domainarticle.Actor, id int64) error {
+	if err := uc.tagRepo.Delete(ctx, actor.OrgID, id); err != nil {
+		return err
+	}
+
+	// Lists filtered by the tag are gone
+	if uc.listCache != nil {
+		_ = uc.listCache.InvalidateArticleList(ctx)
+	}
+
+	return nil
+}
@@ -15,7 +15,7 @@ func TestNewDeleteArticleUseCase(t *testing.T) {
 	cache := &mockArticleCache{}
 	listCache := &mockArticleListCache{}
 
-	uc := NewDeleteArticleUseCase(repo, cache, listCache)
+	uc := NewDeleteArticleUseCase(repo, cache, listCache, nil)
 
 	assert.NotNil(t, uc)
 	assert.Equal(t, repo, uc.articleRepo)
@@ -28,8 +28,9 @@ func TestDeleteArticleUseCase_Execute_Success(t *testing.T) {
 	repo := &mockArticleRepository{}
 	cache := &mockArticleCache{}
 	listCache := &mockArticleListCache{}
+	index := &mockSearchIndex{}
 
-	uc := NewDeleteArticleUseCase(repo, cache, listCache)
+	uc := NewDeleteArticleUseCase(repo, cache, listCache, index)
 
 	articleID := int64(1)
 	existingArticle := &domainarticle.Article{
@@ -37,22 +38,76 @@ func TestDeleteArticleUseCase_Execute_Success(t *testing.T) {
 		Title:     "Test Article",
 		Content:   "Test Content",
 		AuthorID:  1,
+		OrgID:     1,
 		CreatedAt: time.Now(),
 		UpdatedAt: time.Now(),
 	}
 
-	repo.On("GetByID", ctx, articleID).Return(existingArticle, nil)
-	repo.On("Delete", ctx, articleID).Return(nil)
+	repo.On("GetByID", ctx, int64(1), articleID).Return(existingArticle, nil)
+	repo.On("Delete", ctx, int64(1), articleID).Return(nil)
 	cache.On("Delete", ctx, articleID).Return(nil)
 	cache.On("InvalidateList", ctx).Return(nil)
 	listCache.On("InvalidateArticleList", ctx).Return(nil)
+	index.On("Remove", ctx, articleID).Return(nil)
 
-	err := uc.Execute(ctx, articleID)
+	err := uc.Execute(ctx, domainarticle.Actor{UserID: 1, OrgID: 1}, articleID)
 
 	assert.NoError(t, err)
 	repo.AssertExpectations(t)
 	cache.AssertExpectations(t)
 	listCache.AssertExpectations(t)
+	index.AssertExpectations(t)
+}
+
+func TestDeleteArticleUseCase_Execute_Forbidden(t *testing.T) {
+	ctx := context.Background()
+	repo := &mockArticleRepository{}
+	cache := &mockArticleCache{}
+	listCache := &mockArticleListCache{}
+
+	uc := NewDeleteArticleUseCase(repo, cache, listCache, nil)
+
+	articleID := int64(1)
+	existingArticle := &domainarticle.Article{
+		ID:       articleID,
+		Title:    "Test Article",
+		Content:  "Test Content",
+		AuthorID: 1,
+		OrgID:    1,
+	}
+
+	repo.On("GetByID", ctx, int64(1), articleID).Return(existingArticle, nil)
+
+	err := uc.Execute(ctx, domainarticle.Actor{UserID: 2, OrgID: 1}, articleID)
+
+	assert.ErrorIs(t, err, domainarticle.ErrForbidden)
+	repo.AssertNotCalled(t, "Delete")
+	cache.AssertNotCalled(t, "Delete")
+	listCache.AssertNotCalled(t, "InvalidateArticleList")
+}
+
+func TestDeleteArticleUseCase_Execute_AdminCanDeleteAnyArticle(t *testing.T) {
+	ctx := context.Background()
+	repo := &mockArticleRepository{}
+
+	uc := NewDeleteArticleUseCase(repo, nil, nil, nil)
+
+	articleID := int64(1)
+	existingArticle := &domainarticle.Article{
+		ID:       articleID,
+		Title:    "Test Article",
+		Content:  "Test Content",
+		AuthorID: 1,
+		OrgID:    1,
+	}
+
+	repo.On("GetByID", ctx, int64(1), articleID).Return(existingArticle, nil)
+	repo.On("Delete", ctx, int64(1), articleID).Return(nil)
+
+	err := uc.Execute(ctx, domainarticle.Actor{UserID: 2, OrgID: 1, IsAdmin: true}, articleID)
+
+	assert.NoError(t, err)
+	repo.AssertExpectations(t)
 }
 
 func TestDeleteArticleUseCase_Execute_ArticleNotFound(t *testing.T) {
@@ -61,13 +116,13 @@ func TestDeleteArticleUseCase_Execute_ArticleNotFound(t *testing.T) {
 	cache := &mockArticleCache{}
 	listCache := &mockArticleListCache{}
 
-	uc := NewDeleteArticleUseCase(repo, cache, listCache)
+	uc := NewDeleteArticleUseCase(repo, cache, listCache, nil)
 
 	articleID := int64(1)
 
-	repo.On("GetByID", ctx, articleID).Return(nil, nil)
+	repo.On("GetByID", ctx, int64(1), articleID).Return(nil, nil)
 
-	err := uc.Execute(ctx, articleID)
+	err := uc.Execute(ctx, domainarticle.Actor{UserID: 1, OrgID: 1}, articleID)
 
 	assert.Error(t, err)
 	assert.Equal(t, domainarticle.ErrArticleNotFound, err)
@@ -82,14 +137,14 @@ func TestDeleteArticleUseCase_Execute_GetByIDError(t *testing.T) {
 	cache := &mockArticleCache{}
 	listCache := &mockArticleListCache{}
 
-	uc := NewDeleteArticleUseCase(repo, cache, listCache)
+	uc := NewDeleteArticleUseCase(repo, cache, listCache, nil)
 
 	articleID := int64(1)
 	repoError := errors.New("database error")
 
-	repo.On("GetByID", ctx, articleID).Return(nil, repoError)
+	repo.On("GetByID", ctx, int64(1), articleID).Return(nil, repoError)
 
-	err := uc.Execute(ctx, articleID)
+	err := uc.Execute(ctx, domainarticle.Actor{UserID: 1, OrgID: 1}, articleID)
 
 	assert.Error(t, err)
 	assert.Equal(t, repoError, err)
@@ -103,7 +158,7 @@ func TestDeleteArticleUseCase_Execute_DeleteError(t *testing.T) {
 	cache := &mockArticleCache{}
 	listCache := &mockArticleListCache{}
 
-	uc := NewDeleteArticleUseCase(repo, cache, listCache)
+	uc := NewDeleteArticleUseCase(repo, cache, listCache, nil)
 
 	articleID := int64(1)
 	existingArticle := &domainarticle.Article{
@@ -111,15 +166,16 @@ func TestDeleteArticleUseCase_Execute_DeleteError(t *testing.T) {
 		Title:     "Test Article",
 		Content:   "Test Content",
 		AuthorID:  1,
+		OrgID:     1,
 		CreatedAt: time.Now(),
 		UpdatedAt: time.Now(),
 	}
 	deleteError := errors.New("delete error")
 
-	repo.On("GetByID", ctx, articleID).Return(existingArticle, nil)
-	repo.On("Delete", ctx, articleID).Return(deleteError)
+	repo.On("GetByID", ctx, int64(1), articleID).Return(existingArticle, nil)
+	repo.On("Delete", ctx, int64(1), articleID).Return(deleteError)
 
-	err := uc.Execute(ctx, articleID)
+	err := uc.Execute(ctx, domainarticle.Actor{UserID: 1, OrgID: 1}, articleID)
 
 	assert.Error(t, err)
 	assert.Equal(t, deleteError, err)
@@ -132,7 +188,7 @@ func TestDeleteArticleUseCase_Execute_WithNilCache(t *testing.T) {
 	repo := &mockArticleRepository{}
 	listCache := &mockArticleListCache{}
 
-	uc := NewDeleteArticleUseCase(repo, nil, listCache)
+	uc := NewDeleteArticleUseCase(repo, nil, listCache, nil)
 
 	articleID := int64(1)
 	existingArticle := &domainarticle.Article{
@@ -140,15 +196,16 @@ func TestDeleteArticleUseCase_Execute_WithNilCache(t *testing.T) {
 		Title:     "Test Article",
 		Content:   "Test Content",
 		AuthorID:  1,
+		OrgID:     1,
 		CreatedAt: time.Now(),
 		UpdatedAt: time.Now(),
 	}
 
-	repo.On("GetByID", ctx, articleID).Return(existingArticle, nil)
-	repo.On("Delete", ctx, articleID).Return(nil)
+	repo.On("GetByID", ctx, int64(1), articleID).Return(existingArticle, nil)
+	repo.On("Delete", ctx, int64(1), articleID).Return(nil)
 	listCache.On("InvalidateArticleList", ctx).Return(nil)
 
-	err := uc.Execute(ctx, articleID)
+	err := uc.Execute(ctx, domainarticle.Actor{UserID: 1, OrgID: 1}, articleID)
 
 	assert.NoError(t, err)
 	repo.AssertExpectations(t)
@@ -160,7 +217,7 @@ func TestDeleteArticleUseCase_Execute_WithNilListCache(t *testing.T) {
 	repo := &mockArticleRepository{}
 	cache := &mockArticleCache{}
 
-	uc := NewDeleteArticleUseCase(repo, cache, nil)
+	uc := NewDeleteArticleUseCase(repo, cache, nil, nil)
 
 	articleID := int64(1)
 	existingArticle := &domainarticle.Article{
@@ -168,16 +225,17 @@ func TestDeleteArticleUseCase_Execute_WithNilListCache(t *testing.T) {
 		Title:     "Test Article",
 		Content:   "Test Content",
 		AuthorID:  1,
+		OrgID:     1,
 		CreatedAt: time.Now(),
 		UpdatedAt: time.Now(),
 	}
 
-	repo.On("GetByID", ctx, articleID).Return(existingArticle, nil)
-	repo.On("Delete", ctx, articleID).Return(nil)
+	repo.On("GetByID", ctx, int64(1), articleID).Return(existingArticle, nil)
+	repo.On("Delete", ctx, int64(1), articleID).Return(nil)
 	cache.On("Delete", ctx, articleID).Return(nil)
 	cache.On("InvalidateList", ctx).Return(nil)
 
-	err := uc.Execute(ctx, articleID)
+	err := uc.Execute(ctx, domainarticle.Actor{UserID: 1, OrgID: 1}, articleID)
 
 	assert.NoError(t, err)
 	repo.AssertExpectations(t)
@@ -21,25 +21,33 @@ func NewGetArticleUseCase(articleRepo domainarticle.Repository, cache domainarti
 	}
 }
 
-// Execute executes the get article use case
-func (uc *GetArticleUseCase) Execute(ctx context.Context, id int64) (*dto.ArticleResponse, error) {
-	// Try to get from cache first
+// Execute executes the get article use case.
+// Articles of other organizations and articles the actor may not view are reported as not found.
+func (uc *GetArticleUseCase) Execute(ctx context.Context, actor domainarticle.Actor, id int64) (*dto.ArticleResponse, error) {
+	// Try to get from cache first. The cache is keyed by ID only, so an article
+	// cached for another organization or one the actor may not view is skipped.
 	if uc.cache != nil {
 		cached, err := uc.cache.Get(ctx, id)
-		if err == nil && cached != nil {
+		if err == nil && cached != nil && actor.CanView(cached) {
 			return &dto.ArticleResponse{
-				ID:        cached.ID,
-				Title:     cached.Title,
-				Content:   cached.Content,
-				AuthorID:  cached.AuthorID,
-				CreatedAt: cached.CreatedAt,
-				UpdatedAt: cached.UpdatedAt,
+				ID:          cached.ID,
+				Title:       cached.Title,
+				Slug:        cached.Slug,
+				Content:     cached.Content,
+				AuthorID:    cached.AuthorID,
+				OrgID:       cached.OrgID,
+				CategoryID:  cached.CategoryID,
+				Status:      string(cached.Status),
+				PublishedAt: cached.PublishedAt,
+				PublishAt:   cached.PublishAt,
+				CreatedAt:   cached.CreatedAt,
+				UpdatedAt:   cached.UpdatedAt,
 			}, nil
 		}
 	}
 
 	// Get from repository
-	articleEntity, err := uc.articleRepo.GetByID(ctx, id)
+	articleEntity, err := uc.articleRepo.GetByID(ctx, actor.OrgID, id)
 	if err != nil {
 		return nil, err
 	}
@@ -48,13 +56,23 @@ func (uc *GetArticleUseCase) Execute(ctx context.Context, id int64) (*dto.Articl
 		return nil, domainarticle.ErrArticleNotFound
 	}
 
+	if !actor.CanView(articleEntity) {
+		return nil, domainarticle.ErrArticleNotFound
+	}
+
 	response := &dto.ArticleResponse{
-		ID:        articleEntity.ID,
-		Title:     articleEntity.Title,
-		Content:   articleEntity.Content,
-		AuthorID:  articleEntity.AuthorID,
-		CreatedAt: articleEntity.CreatedAt,
-		UpdatedAt: articleEntity.UpdatedAt,
+		ID:          articleEntity.ID,
+		Title:       articleEntity.Title,
+		Slug:        articleEntity.Slug,
+		Content:     articleEntity.Content,
+		AuthorID:    articleEntity.AuthorID,
+		OrgID:       articleEntity.OrgID,
+		CategoryID:  articleEntity.CategoryID,
+		Status:      string(articleEntity.Status),
+		PublishedAt: articleEntity.PublishedAt,
+		PublishAt:   articleEntity.PublishAt,
+		CreatedAt:   articleEntity.CreatedAt,
+		UpdatedAt:   articleEntity.UpdatedAt,
 	}
 
 	// Store in cache
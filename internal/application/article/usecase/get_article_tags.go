@@ -0,0 +1,46 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/rulzi/hexa-go/internal/application/article/dto"
+	domainarticle "github.com/rulzi/hexa-go/internal/domain/article"
+)
+
+// GetArticleTagsUseCase handles retrieving the tags of an article
+type GetArticleTagsUseCase struct {
+	articleRepo domainarticle.Repository
+	tagRepo     domainarticle.TagRepository
+}
+
+// NewGetArticleTagsUseCase creates a new GetArticleTagsUseCase
+func NewGetArticleTagsUseCase(articleRepo domainarticle.Repository, tagRepo domainarticle.TagRepository) *GetArticleTagsUseCase {
+	return &GetArticleTagsUseCase{
+		articleRepo: articleRepo,
+		tagRepo:     tagRepo,
+	}
+}
+
+// Execute executes the get article tags use case, an article the actor may not view is reported as not found
+func (uc *GetArticleTagsUseCase) Execute(ctx context.Context, actor domainarticle.Actor, articleID int64) (*dto.ListTagsResponse, error) {
+	existingArticle, err := uc.articleRepo.GetByID(ctx, actor.OrgID, articleID)
+	if err != nil {
+		return nil, err
+	}
+
+	if existingArticle == nil || !actor.CanView(existingArticle) {
+		return nil, domainarticle.ErrArticleNotFound
+	}
+
+	tags, err := uc.tagRepo.ListByArticle(ctx, actor.OrgID, articleID)
+	if err != nil {
+		return nil, err
+	}
+
+	tagResponses := make([]dto.TagResponse, len(tags))
+	for i, t := range tags {
+		tagResponses[i] = tagResponse(t)
+	}
+
+	return &dto.ListTagsResponse{Tags: tagResponses}, nil
+}
@@ -0,0 +1,85 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/rulzi/hexa-go/internal/application/article/dto"
+	domainarticle "github.com/rulzi/hexa-go/internal/domain/article"
+)
+
+// GetArticleBySlugUseCase handles retrieving an article by its slug
+type GetArticleBySlugUseCase struct {
+	articleRepo domainarticle.Repository
+	cache       domainarticle.Cache
+}
+
+// NewGetArticleBySlugUseCase creates a new GetArticleBySlugUseCase
+func NewGetArticleBySlugUseCase(articleRepo domainarticle.Repository, cache domainarticle.Cache) *GetArticleBySlugUseCase {
+	return &GetArticleBySlugUseCase{
+		articleRepo: articleRepo,
+		cache:       cache,
+	}
+}
+
+// Execute executes the get article by slug use case.
+// A slug the article had before its title changed still resolves, the response then
+// carries the current slug as a redirect hint. Articles the actor may not view are reported as not found.
+func (uc *GetArticleBySlugUseCase) Execute(ctx context.Context, actor domainarticle.Actor, slug string) (*dto.ArticleBySlugResponse, error) {
+	// Try to get from cache first, only current slugs are cached
+	if uc.cache != nil {
+		cached, err := uc.cache.GetBySlug(ctx, actor.OrgID, slug)
+		if err == nil && cached != nil && cached.Slug == slug && actor.CanView(cached) {
+			return &dto.ArticleBySlugResponse{Article: articleBySlugResponse(cached)}, nil
+		}
+	}
+
+	// Get from repository, falling back to the previous slugs
+	articleEntity, err := uc.articleRepo.GetBySlug(ctx, actor.OrgID, slug)
+	if err != nil && err != domainarticle.ErrArticleNotFound {
+		return nil, err
+	}
+	if articleEntity == nil {
+		articleEntity, err = uc.articleRepo.GetByPreviousSlug(ctx, actor.OrgID, slug)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if articleEntity == nil {
+		return nil, domainarticle.ErrArticleNotFound
+	}
+
+	if !actor.CanView(articleEntity) {
+		return nil, domainarticle.ErrArticleNotFound
+	}
+
+	response := &dto.ArticleBySlugResponse{Article: articleBySlugResponse(articleEntity)}
+	if articleEntity.Slug != slug {
+		response.RedirectTo = articleEntity.Slug
+	}
+
+	// Store in cache
+	if uc.cache != nil {
+		_ = uc.cache.Set(ctx, articleEntity.ID, articleEntity)
+	}
+
+	return response, nil
+}
+
+// articleBySlugResponse converts an article entity to its response DTO
+func articleBySlugResponse(a *domainarticle.Article) dto.ArticleResponse {
+	return dto.ArticleResponse{
+		ID:          a.ID,
+		Title:       a.Title,
+		Slug:        a.Slug,
+		Content:     a.Content,
+		AuthorID:    a.AuthorID,
+		OrgID:       a.OrgID,
+		CategoryID:  a.CategoryID,
+		Status:      string(a.Status),
+		PublishedAt: a.PublishedAt,
+		PublishAt:   a.PublishAt,
+		CreatedAt:   a.CreatedAt,
+		UpdatedAt:   a.UpdatedAt,
+	}
+}
@@ -0,0 +1,126 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	domainarticle "github.com/rulzi/hexa-go/internal/domain/article"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetArticleBySlugUseCase_Execute_SuccessFromCache(t *testing.T) {
+	ctx := context.Background()
+	repo := &mockArticleRepository{}
+	cache := &mockArticleCache{}
+
+	uc := NewGetArticleBySlugUseCase(repo, cache)
+
+	cachedArticle := &domainarticle.Article{
+		ID:        1,
+		Title:     "Cached Article",
+		Slug:      "cached-article",
+		Content:   "Cached Content",
+		AuthorID:  1,
+		OrgID:     1,
+		Status:    domainarticle.StatusPublished,
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}
+
+	cache.On("GetBySlug", ctx, int64(1), "cached-article").Return(cachedArticle, nil)
+
+	result, err := uc.Execute(ctx, reader, "cached-article")
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), result.Article.ID)
+	assert.Empty(t, result.RedirectTo)
+	cache.AssertExpectations(t)
+	repo.AssertNotCalled(t, "GetBySlug")
+}
+
+func TestGetArticleBySlugUseCase_Execute_SuccessFromRepository(t *testing.T) {
+	ctx := context.Background()
+	repo := &mockArticleRepository{}
+	cache := &mockArticleCache{}
+
+	uc := NewGetArticleBySlugUseCase(repo, cache)
+
+	articleEntity := &domainarticle.Article{ID: 1, Title: "Test Article", Slug: "test-article", AuthorID: 1, OrgID: 1, Status: domainarticle.StatusPublished}
+
+	cache.On("GetBySlug", ctx, int64(1), "test-article").Return(nil, nil)
+	repo.On("GetBySlug", ctx, int64(1), "test-article").Return(articleEntity, nil)
+	cache.On("Set", ctx, int64(1), articleEntity).Return(nil)
+
+	result, err := uc.Execute(ctx, reader, "test-article")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "test-article", result.Article.Slug)
+	assert.Empty(t, result.RedirectTo)
+	repo.AssertExpectations(t)
+	cache.AssertExpectations(t)
+}
+
+func TestGetArticleBySlugUseCase_Execute_PreviousSlug(t *testing.T) {
+	ctx := context.Background()
+	repo := &mockArticleRepository{}
+
+	uc := NewGetArticleBySlugUseCase(repo, nil)
+
+	articleEntity := &domainarticle.Article{ID: 1, Title: "Renamed Article", Slug: "renamed-article", AuthorID: 1, OrgID: 1, Status: domainarticle.StatusPublished}
+
+	repo.On("GetBySlug", ctx, int64(1), "test-article").Return(nil, domainarticle.ErrArticleNotFound)
+	repo.On("GetByPreviousSlug", ctx, int64(1), "test-article").Return(articleEntity, nil)
+
+	result, err := uc.Execute(ctx, reader, "test-article")
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), result.Article.ID)
+	assert.Equal(t, "renamed-article", result.RedirectTo)
+	repo.AssertExpectations(t)
+}
+
+func TestGetArticleBySlugUseCase_Execute_NotFound(t *testing.T) {
+	tests := []struct {
+		name    string
+		article *domainarticle.Article
+	}{
+		{name: "unknown slug"},
+		{name: "draft of another author", article: &domainarticle.Article{ID: 1, Slug: "test-article", AuthorID: 1, OrgID: 1, Status: domainarticle.StatusDraft}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			repo := &mockArticleRepository{}
+			uc := NewGetArticleBySlugUseCase(repo, nil)
+
+			if tt.article != nil {
+				repo.On("GetBySlug", ctx, int64(1), "test-article").Return(tt.article, nil)
+			} else {
+				repo.On("GetBySlug", ctx, int64(1), "test-article").Return(nil, domainarticle.ErrArticleNotFound)
+				repo.On("GetByPreviousSlug", ctx, int64(1), "test-article").Return(nil, domainarticle.ErrArticleNotFound)
+			}
+
+			result, err := uc.Execute(ctx, reader, "test-article")
+
+			assert.Equal(t, domainarticle.ErrArticleNotFound, err)
+			assert.Nil(t, result)
+		})
+	}
+}
+
+func TestGetArticleBySlugUseCase_Execute_RepositoryError(t *testing.T) {
+	ctx := context.Background()
+	repo := &mockArticleRepository{}
+	uc := NewGetArticleBySlugUseCase(repo, nil)
+
+	repo.On("GetBySlug", ctx, int64(1), "test-article").Return(nil, errors.New("database error"))
+
+	result, err := uc.Execute(ctx, reader, "test-article")
+
+	assert.Error(t, err)
+	assert.Nil(t, result)
+	repo.AssertNotCalled(t, "GetByPreviousSlug")
+}
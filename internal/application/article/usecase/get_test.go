@@ -10,6 +10,9 @@ import (
 	"github.com/stretchr/testify/assert"
 )
 
+// reader is a member of organization 1 who didn't write the articles of the tests
+var reader = domainarticle.Actor{UserID: 2, OrgID: 1}
+
 func TestNewGetArticleUseCase(t *testing.T) {
 	repo := &mockArticleRepository{}
 	cache := &mockArticleCache{}
@@ -34,13 +37,15 @@ func TestGetArticleUseCase_Execute_SuccessFromCache(t *testing.T) {
 		Title:     "Cached Article",
 		Content:   "Cached Content",
 		AuthorID:  1,
+		OrgID:     1,
+		Status:    domainarticle.StatusPublished,
 		CreatedAt: time.Now(),
 		UpdatedAt: time.Now(),
 	}
 
 	cache.On("Get", ctx, articleID).Return(cachedArticle, nil)
 
-	result, err := uc.Execute(ctx, articleID)
+	result, err := uc.Execute(ctx, reader, articleID)
 
 	assert.NoError(t, err)
 	assert.NotNil(t, result)
@@ -66,15 +71,17 @@ func TestGetArticleUseCase_Execute_SuccessFromRepository(t *testing.T) {
 		Title:     "Test Article",
 		Content:   "Test Content",
 		AuthorID:  1,
+		OrgID:     1,
+		Status:    domainarticle.StatusPublished,
 		CreatedAt: time.Now(),
 		UpdatedAt: time.Now(),
 	}
 
 	cache.On("Get", ctx, articleID).Return(nil, errors.New("cache miss"))
-	repo.On("GetByID", ctx, articleID).Return(articleEntity, nil)
+	repo.On("GetByID", ctx, int64(1), articleID).Return(articleEntity, nil)
 	cache.On("Set", ctx, articleID, articleEntity).Return(nil)
 
-	result, err := uc.Execute(ctx, articleID)
+	result, err := uc.Execute(ctx, reader, articleID)
 
 	assert.NoError(t, err)
 	assert.NotNil(t, result)
@@ -97,9 +104,9 @@ func TestGetArticleUseCase_Execute_ArticleNotFound(t *testing.T) {
 	articleID := int64(1)
 
 	cache.On("Get", ctx, articleID).Return(nil, errors.New("cache miss"))
-	repo.On("GetByID", ctx, articleID).Return(nil, nil)
+	repo.On("GetByID", ctx, int64(1), articleID).Return(nil, nil)
 
-	result, err := uc.Execute(ctx, articleID)
+	result, err := uc.Execute(ctx, reader, articleID)
 
 	assert.Error(t, err)
 	assert.Equal(t, domainarticle.ErrArticleNotFound, err)
@@ -121,9 +128,9 @@ func TestGetArticleUseCase_Execute_RepositoryError(t *testing.T) {
 	repoError := errors.New("database error")
 
 	cache.On("Get", ctx, articleID).Return(nil, errors.New("cache miss"))
-	repo.On("GetByID", ctx, articleID).Return(nil, repoError)
+	repo.On("GetByID", ctx, int64(1), articleID).Return(nil, repoError)
 
-	result, err := uc.Execute(ctx, articleID)
+	result, err := uc.Execute(ctx, reader, articleID)
 
 	assert.Error(t, err)
 	assert.Equal(t, repoError, err)
@@ -146,13 +153,15 @@ func TestGetArticleUseCase_Execute_WithNilCache(t *testing.T) {
 		Title:     "Test Article",
 		Content:   "Test Content",
 		AuthorID:  1,
+		OrgID:     1,
+		Status:    domainarticle.StatusPublished,
 		CreatedAt: time.Now(),
 		UpdatedAt: time.Now(),
 	}
 
-	repo.On("GetByID", ctx, articleID).Return(articleEntity, nil)
+	repo.On("GetByID", ctx, int64(1), articleID).Return(articleEntity, nil)
 
-	result, err := uc.Execute(ctx, articleID)
+	result, err := uc.Execute(ctx, reader, articleID)
 
 	assert.NoError(t, err)
 	assert.NotNil(t, result)
@@ -174,16 +183,18 @@ func TestGetArticleUseCase_Execute_CacheErrorButContinue(t *testing.T) {
 		Title:     "Test Article",
 		Content:   "Test Content",
 		AuthorID:  1,
+		OrgID:     1,
+		Status:    domainarticle.StatusPublished,
 		CreatedAt: time.Now(),
 		UpdatedAt: time.Now(),
 	}
 
 	// Cache returns error but we continue to repository
 	cache.On("Get", ctx, articleID).Return(nil, errors.New("cache error"))
-	repo.On("GetByID", ctx, articleID).Return(articleEntity, nil)
+	repo.On("GetByID", ctx, int64(1), articleID).Return(articleEntity, nil)
 	cache.On("Set", ctx, articleID, articleEntity).Return(nil)
 
-	result, err := uc.Execute(ctx, articleID)
+	result, err := uc.Execute(ctx, reader, articleID)
 
 	assert.NoError(t, err)
 	assert.NotNil(t, result)
@@ -192,3 +203,58 @@ func TestGetArticleUseCase_Execute_CacheErrorButContinue(t *testing.T) {
 	cache.AssertExpectations(t)
 	repo.AssertExpectations(t)
 }
+
+func TestGetArticleUseCase_Execute_OtherOrganization(t *testing.T) {
+	ctx := context.Background()
+	repo := &mockArticleRepository{}
+	cache := &mockArticleCache{}
+
+	uc := NewGetArticleUseCase(repo, cache)
+
+	// The article is cached, but it belongs to another organization
+	cache.On("Get", ctx, int64(1)).Return(&domainarticle.Article{ID: 1, Title: "Cached Article", OrgID: 2}, nil)
+	repo.On("GetByID", ctx, int64(1), int64(1)).Return(nil, nil)
+
+	result, err := uc.Execute(ctx, reader, 1)
+
+	assert.Equal(t, domainarticle.ErrArticleNotFound, err)
+	assert.Nil(t, result)
+	repo.AssertExpectations(t)
+}
+
+func TestGetArticleUseCase_Execute_Draft(t *testing.T) {
+	draft := &domainarticle.Article{ID: 1, Title: "Draft", AuthorID: 1, OrgID: 1, Status: domainarticle.StatusDraft}
+
+	tests := []struct {
+		name    string
+		actor   domainarticle.Actor
+		wantErr error
+	}{
+		{name: "author sees own draft", actor: domainarticle.Actor{UserID: 1, OrgID: 1}},
+		{name: "admin sees draft", actor: domainarticle.Actor{UserID: 3, OrgID: 1, IsAdmin: true}},
+		{name: "reader doesn't see draft", actor: reader, wantErr: domainarticle.ErrArticleNotFound},
+		{name: "editor doesn't see draft before review", actor: domainarticle.Actor{UserID: 3, OrgID: 1, IsEditor: true}, wantErr: domainarticle.ErrArticleNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			repo := &mockArticleRepository{}
+			cache := &mockArticleCache{}
+			cache.On("Get", ctx, int64(1)).Return(draft, nil)
+			repo.On("GetByID", ctx, int64(1), int64(1)).Return(draft, nil).Maybe()
+
+			uc := NewGetArticleUseCase(repo, cache)
+			result, err := uc.Execute(ctx, tt.actor, 1)
+
+			if tt.wantErr != nil {
+				assert.Equal(t, tt.wantErr, err)
+				assert.Nil(t, result)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, "draft", result.Status)
+			repo.AssertNotCalled(t, "GetByID")
+		})
+	}
+}
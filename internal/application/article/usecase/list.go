@@ -9,31 +9,45 @@ import (
 
 // ListArticlesUseCase handles listing articles with pagination
 type ListArticlesUseCase struct {
-	articleRepo domainarticle.Repository
-	cache       domainarticle.Cache
-	dtoCache    ArticleListCache // Keep DTO cache for list caching (performance optimization)
+	articleRepo  domainarticle.Repository
+	cache        domainarticle.Cache
+	dtoCache     ArticleListCache // Keep DTO cache for list caching (performance optimization)
+	tagRepo      domainarticle.TagRepository
+	categoryRepo domainarticle.CategoryRepository
 }
 
 // ArticleListCache defines the interface for article list caching (DTO-based for performance)
-// This is a secondary adapter interface for list caching
+// This is a secondary adapter interface for list caching, lists are cached per filter
 type ArticleListCache interface {
-	GetArticleList(ctx context.Context, limit, offset int) (*dto.ListArticlesResponse, error)
-	SetArticleList(ctx context.Context, limit, offset int, listResp *dto.ListArticlesResponse) error
+	GetArticleList(ctx context.Context, filter domainarticle.ListFilter, limit, offset int) (*dto.ListArticlesResponse, error)
+	SetArticleList(ctx context.Context, filter domainarticle.ListFilter, limit, offset int, listResp *dto.ListArticlesResponse) error
 	InvalidateArticleList(ctx context.Context) error
 }
 
 // NewListArticlesUseCase creates a new ListArticlesUseCase
-func NewListArticlesUseCase(articleRepo domainarticle.Repository, cache domainarticle.Cache, dtoCache ArticleListCache) *ListArticlesUseCase {
+func NewListArticlesUseCase(
+	articleRepo domainarticle.Repository,
+	cache domainarticle.Cache,
+	dtoCache ArticleListCache,
+	tagRepo domainarticle.TagRepository,
+	categoryRepo domainarticle.CategoryRepository,
+) *ListArticlesUseCase {
 	return &ListArticlesUseCase{
-		articleRepo: articleRepo,
-		cache:       cache,
-		dtoCache:    dtoCache,
+		articleRepo:  articleRepo,
+		cache:        cache,
+		dtoCache:     dtoCache,
+		tagRepo:      tagRepo,
+		categoryRepo: categoryRepo,
 	}
 }
 
-// Execute executes the list articles use case
-func (uc *ListArticlesUseCase) Execute(ctx context.Context, limit, offset int) (*dto.ListArticlesResponse, error) {
+// Execute executes the list articles use case for the articles of the organization of the actor.
+// Only the articles the actor may view are listed, see domainarticle.Actor.CanView.
+// The list is narrowed down to a tag or to a category and its subcategories when requested,
+// an unknown tag or category returns ErrTagNotFound or ErrCategoryNotFound.
+func (uc *ListArticlesUseCase) Execute(ctx context.Context, actor domainarticle.Actor, req dto.ListArticlesRequest) (*dto.ListArticlesResponse, error) {
 	// Default pagination
+	limit, offset := req.Limit, req.Offset
 	if limit <= 0 {
 		limit = 10
 	}
@@ -41,22 +55,42 @@ func (uc *ListArticlesUseCase) Execute(ctx context.Context, limit, offset int) (
 		offset = 0
 	}
 
+	filter := actor.ListFilter()
+
+	if req.TagID != 0 {
+		if _, err := uc.tagRepo.GetByID(ctx, actor.OrgID, req.TagID); err != nil {
+			return nil, err
+		}
+		filter.TagID = req.TagID
+	}
+
+	if req.CategoryID != 0 {
+		categories, err := uc.categoryRepo.List(ctx, actor.OrgID)
+		if err != nil {
+			return nil, err
+		}
+		filter.CategoryIDs, err = domainarticle.CategorySubtree(categories, req.CategoryID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	// Try to get from cache first (using DTO cache for performance)
 	if uc.dtoCache != nil {
-		cached, err := uc.dtoCache.GetArticleList(ctx, limit, offset)
+		cached, err := uc.dtoCache.GetArticleList(ctx, filter, limit, offset)
 		if err == nil && cached != nil {
 			return cached, nil
 		}
 	}
 
 	// Get articles from repository
-	articles, err := uc.articleRepo.List(ctx, limit, offset)
+	articles, err := uc.articleRepo.List(ctx, filter, limit, offset)
 	if err != nil {
 		return nil, err
 	}
 
 	// Get total count
-	total, err := uc.articleRepo.Count(ctx)
+	total, err := uc.articleRepo.Count(ctx, filter)
 	if err != nil {
 		return nil, err
 	}
@@ -65,12 +99,18 @@ func (uc *ListArticlesUseCase) Execute(ctx context.Context, limit, offset int) (
 	articleResponses := make([]dto.ArticleResponse, len(articles))
 	for i, a := range articles {
 		articleResponses[i] = dto.ArticleResponse{
-			ID:        a.ID,
-			Title:     a.Title,
-			Content:   a.Content,
-			AuthorID:  a.AuthorID,
-			CreatedAt: a.CreatedAt,
-			UpdatedAt: a.UpdatedAt,
+			ID:          a.ID,
+			Title:       a.Title,
+			Slug:        a.Slug,
+			Content:     a.Content,
+			AuthorID:    a.AuthorID,
+			OrgID:       a.OrgID,
+			CategoryID:  a.CategoryID,
+			Status:      string(a.Status),
+			PublishedAt: a.PublishedAt,
+			PublishAt:   a.PublishAt,
+			CreatedAt:   a.CreatedAt,
+			UpdatedAt:   a.UpdatedAt,
 		}
 	}
 
@@ -83,7 +123,7 @@ func (uc *ListArticlesUseCase) Execute(ctx context.Context, limit, offset int) (
 
 	// Store in cache (using DTO cache for performance)
 	if uc.dtoCache != nil {
-		_ = uc.dtoCache.SetArticleList(ctx, limit, offset, response)
+		_ = uc.dtoCache.SetArticleList(ctx, filter, limit, offset, response)
 	}
 
 	return response, nil
@@ -0,0 +1,65 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/rulzi/hexa-go/internal/application/article/dto"
+	domainarticle "github.com/rulzi/hexa-go/internal/domain/article"
+)
+
+// ListCategoriesUseCase handles listing the categories of an organization as a tree
+type ListCategoriesUseCase struct {
+	categoryRepo domainarticle.CategoryRepository
+}
+
+// NewListCategoriesUseCase creates a new ListCategoriesUseCase
+func NewListCategoriesUseCase(categoryRepo domainarticle.CategoryRepository) *ListCategoriesUseCase {
+	return &ListCategoriesUseCase{
+		categoryRepo: categoryRepo,
+	}
+}
+
+// Execute executes the list categories use case for the organization of the actor.
+// Top level categories are listed by name, each with its subcategories nested under it.
+func (uc *ListCategoriesUseCase) Execute(ctx context.Context, actor domainarticle.Actor) (*dto.ListCategoriesResponse, error) {
+	categories, err := uc.categoryRepo.List(ctx, actor.OrgID)
+	if err != nil {
+		return nil, err
+	}
+
+	children := make(map[int64][]*domainarticle.Category)
+	var roots []*domainarticle.Category
+	for _, c := range categories {
+		if c.ParentID == nil {
+			roots = append(roots, c)
+		} else {
+			children[*c.ParentID] = append(children[*c.ParentID], c)
+		}
+	}
+
+	return &dto.ListCategoriesResponse{Categories: categoryTree(roots, children)}, nil
+}
+
+// categoryTree converts categories and, recursively, their subcategories to response DTOs
+func categoryTree(categories []*domainarticle.Category, children map[int64][]*domainarticle.Category) []dto.CategoryResponse {
+	responses := make([]dto.CategoryResponse, len(categories))
+	for i, c := range categories {
+		responses[i] = categoryResponse(c)
+		if len(children[c.ID]) > 0 {
+			responses[i].Children = categoryTree(children[c.ID], children)
+		}
+	}
+	return responses
+}
+
+// categoryResponse converts a category to its response DTO
+func categoryResponse(c *domainarticle.Category) dto.CategoryResponse {
+	return dto.CategoryResponse{
+		ID:        c.ID,
+		ParentID:  c.ParentID,
+		Name:      c.Name,
+		Slug:      c.Slug,
+		CreatedAt: c.CreatedAt,
+		UpdatedAt: c.UpdatedAt,
+	}
+}
@@ -0,0 +1,67 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/rulzi/hexa-go/internal/application/article/dto"
+	domainarticle "github.com/rulzi/hexa-go/internal/domain/article"
+)
+
+// ListDeletedArticlesUseCase handles listing the articles in the trash
+type ListDeletedArticlesUseCase struct {
+	articleRepo domainarticle.Repository
+}
+
+// NewListDeletedArticlesUseCase creates a new ListDeletedArticlesUseCase
+func NewListDeletedArticlesUseCase(articleRepo domainarticle.Repository) *ListDeletedArticlesUseCase {
+	return &ListDeletedArticlesUseCase{
+		articleRepo: articleRepo,
+	}
+}
+
+// Execute executes the list deleted articles use case for the trash of an organization
+func (uc *ListDeletedArticlesUseCase) Execute(ctx context.Context, orgID int64, limit, offset int) (*dto.ListArticlesResponse, error) {
+	// Default pagination
+	if limit <= 0 {
+		limit = 10
+	}
+	if offset < 0 {
+		offset = 0
+	}
+
+	articles, err := uc.articleRepo.ListDeleted(ctx, orgID, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+
+	total, err := uc.articleRepo.CountDeleted(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+
+	articleResponses := make([]dto.ArticleResponse, len(articles))
+	for i, a := range articles {
+		articleResponses[i] = dto.ArticleResponse{
+			ID:          a.ID,
+			Title:       a.Title,
+			Slug:        a.Slug,
+			Content:     a.Content,
+			AuthorID:    a.AuthorID,
+			OrgID:       a.OrgID,
+			CategoryID:  a.CategoryID,
+			Status:      string(a.Status),
+			PublishedAt: a.PublishedAt,
+			PublishAt:   a.PublishAt,
+			CreatedAt:   a.CreatedAt,
+			UpdatedAt:   a.UpdatedAt,
+			DeletedAt:   a.DeletedAt,
+		}
+	}
+
+	return &dto.ListArticlesResponse{
+		Articles: articleResponses,
+		Total:    total,
+		Limit:    limit,
+		Offset:   offset,
+	}, nil
+}
@@ -0,0 +1,49 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/rulzi/hexa-go/internal/application/article/dto"
+	domainarticle "github.com/rulzi/hexa-go/internal/domain/article"
+)
+
+// ListTagsUseCase handles listing the tags of an organization with their article counts
+type ListTagsUseCase struct {
+	tagRepo domainarticle.TagRepository
+}
+
+// NewListTagsUseCase creates a new ListTagsUseCase
+func NewListTagsUseCase(tagRepo domainarticle.TagRepository) *ListTagsUseCase {
+	return &ListTagsUseCase{
+		tagRepo: tagRepo,
+	}
+}
+
+// Execute executes the list tags use case for the organization of the actor.
+// Each tag counts the articles the actor may view, the same articles filtering the list by that tag returns.
+func (uc *ListTagsUseCase) Execute(ctx context.Context, actor domainarticle.Actor) (*dto.ListTagsResponse, error) {
+	tags, err := uc.tagRepo.List(ctx, actor.ListFilter())
+	if err != nil {
+		return nil, err
+	}
+
+	tagResponses := make([]dto.TagResponse, len(tags))
+	for i, t := range tags {
+		count := t.ArticleCount
+		tagResponses[i] = tagResponse(t.Tag)
+		tagResponses[i].ArticleCount = &count
+	}
+
+	return &dto.ListTagsResponse{Tags: tagResponses}, nil
+}
+
+// tagResponse converts a tag to its response DTO
+func tagResponse(t *domainarticle.Tag) dto.TagResponse {
+	return dto.TagResponse{
+		ID:        t.ID,
+		Name:      t.Name,
+		Slug:      t.Slug,
+		CreatedAt: t.CreatedAt,
+		UpdatedAt: t.UpdatedAt,
+	}
+}
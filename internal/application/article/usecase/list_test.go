@@ -12,12 +12,15 @@ import (
 	"github.com/stretchr/testify/mock"
 )
 
+// readerFilter lists the published articles of organization 1 and the articles reader wrote
+var readerFilter = domainarticle.ListFilter{OrgID: 1, AuthorID: 2}
+
 func TestNewListArticlesUseCase(t *testing.T) {
 	repo := &mockArticleRepository{}
 	cache := &mockArticleCache{}
 	dtoCache := &mockArticleListCache{}
 
-	uc := NewListArticlesUseCase(repo, cache, dtoCache)
+	uc := NewListArticlesUseCase(repo, cache, dtoCache, nil, nil)
 
 	assert.NotNil(t, uc)
 	assert.Equal(t, repo, uc.articleRepo)
@@ -31,7 +34,7 @@ func TestListArticlesUseCase_Execute_SuccessFromCache(t *testing.T) {
 	cache := &mockArticleCache{}
 	dtoCache := &mockArticleListCache{}
 
-	uc := NewListArticlesUseCase(repo, cache, dtoCache)
+	uc := NewListArticlesUseCase(repo, cache, dtoCache, nil, nil)
 
 	limit := 10
 	offset := 0
@@ -51,9 +54,9 @@ func TestListArticlesUseCase_Execute_SuccessFromCache(t *testing.T) {
 		Offset: offset,
 	}
 
-	dtoCache.On("GetArticleList", ctx, limit, offset).Return(cachedResponse, nil)
+	dtoCache.On("GetArticleList", ctx, readerFilter, limit, offset).Return(cachedResponse, nil)
 
-	result, err := uc.Execute(ctx, limit, offset)
+	result, err := uc.Execute(ctx, reader, dto.ListArticlesRequest{Limit: limit, Offset: offset})
 
 	assert.NoError(t, err)
 	assert.NotNil(t, result)
@@ -72,7 +75,7 @@ func TestListArticlesUseCase_Execute_SuccessFromRepository(t *testing.T) {
 	cache := &mockArticleCache{}
 	dtoCache := &mockArticleListCache{}
 
-	uc := NewListArticlesUseCase(repo, cache, dtoCache)
+	uc := NewListArticlesUseCase(repo, cache, dtoCache, nil, nil)
 
 	limit := 10
 	offset := 0
@@ -96,12 +99,12 @@ func TestListArticlesUseCase_Execute_SuccessFromRepository(t *testing.T) {
 	}
 	total := int64(2)
 
-	dtoCache.On("GetArticleList", ctx, limit, offset).Return(nil, errors.New("cache miss"))
-	repo.On("List", ctx, limit, offset).Return(articles, nil)
-	repo.On("Count", ctx).Return(total, nil)
-	dtoCache.On("SetArticleList", ctx, limit, offset, mock.AnythingOfType("*dto.ListArticlesResponse")).Return(nil)
+	dtoCache.On("GetArticleList", ctx, readerFilter, limit, offset).Return(nil, errors.New("cache miss"))
+	repo.On("List", ctx, readerFilter, limit, offset).Return(articles, nil)
+	repo.On("Count", ctx, readerFilter).Return(total, nil)
+	dtoCache.On("SetArticleList", ctx, readerFilter, limit, offset, mock.AnythingOfType("*dto.ListArticlesResponse")).Return(nil)
 
-	result, err := uc.Execute(ctx, limit, offset)
+	result, err := uc.Execute(ctx, reader, dto.ListArticlesRequest{Limit: limit, Offset: offset})
 
 	assert.NoError(t, err)
 	assert.NotNil(t, result)
@@ -122,18 +125,18 @@ func TestListArticlesUseCase_Execute_DefaultPagination(t *testing.T) {
 	cache := &mockArticleCache{}
 	dtoCache := &mockArticleListCache{}
 
-	uc := NewListArticlesUseCase(repo, cache, dtoCache)
+	uc := NewListArticlesUseCase(repo, cache, dtoCache, nil, nil)
 
 	// Test with invalid limit and offset
 	articles := []*domainarticle.Article{}
 	total := int64(0)
 
-	dtoCache.On("GetArticleList", ctx, 10, 0).Return(nil, errors.New("cache miss"))
-	repo.On("List", ctx, 10, 0).Return(articles, nil)
-	repo.On("Count", ctx).Return(total, nil)
-	dtoCache.On("SetArticleList", ctx, 10, 0, mock.AnythingOfType("*dto.ListArticlesResponse")).Return(nil)
+	dtoCache.On("GetArticleList", ctx, readerFilter, 10, 0).Return(nil, errors.New("cache miss"))
+	repo.On("List", ctx, readerFilter, 10, 0).Return(articles, nil)
+	repo.On("Count", ctx, readerFilter).Return(total, nil)
+	dtoCache.On("SetArticleList", ctx, readerFilter, 10, 0, mock.AnythingOfType("*dto.ListArticlesResponse")).Return(nil)
 
-	result, err := uc.Execute(ctx, -1, -1)
+	result, err := uc.Execute(ctx, reader, dto.ListArticlesRequest{Limit: -1, Offset: -1})
 
 	assert.NoError(t, err)
 	assert.NotNil(t, result)
@@ -150,16 +153,16 @@ func TestListArticlesUseCase_Execute_ListError(t *testing.T) {
 	cache := &mockArticleCache{}
 	dtoCache := &mockArticleListCache{}
 
-	uc := NewListArticlesUseCase(repo, cache, dtoCache)
+	uc := NewListArticlesUseCase(repo, cache, dtoCache, nil, nil)
 
 	limit := 10
 	offset := 0
 	listError := errors.New("list error")
 
-	dtoCache.On("GetArticleList", ctx, limit, offset).Return(nil, errors.New("cache miss"))
-	repo.On("List", ctx, limit, offset).Return(nil, listError)
+	dtoCache.On("GetArticleList", ctx, readerFilter, limit, offset).Return(nil, errors.New("cache miss"))
+	repo.On("List", ctx, readerFilter, limit, offset).Return(nil, listError)
 
-	result, err := uc.Execute(ctx, limit, offset)
+	result, err := uc.Execute(ctx, reader, dto.ListArticlesRequest{Limit: limit, Offset: offset})
 
 	assert.Error(t, err)
 	assert.Equal(t, listError, err)
@@ -176,7 +179,7 @@ func TestListArticlesUseCase_Execute_CountError(t *testing.T) {
 	cache := &mockArticleCache{}
 	dtoCache := &mockArticleListCache{}
 
-	uc := NewListArticlesUseCase(repo, cache, dtoCache)
+	uc := NewListArticlesUseCase(repo, cache, dtoCache, nil, nil)
 
 	limit := 10
 	offset := 0
@@ -192,11 +195,11 @@ func TestListArticlesUseCase_Execute_CountError(t *testing.T) {
 	}
 	countError := errors.New("count error")
 
-	dtoCache.On("GetArticleList", ctx, limit, offset).Return(nil, errors.New("cache miss"))
-	repo.On("List", ctx, limit, offset).Return(articles, nil)
-	repo.On("Count", ctx).Return(int64(0), countError)
+	dtoCache.On("GetArticleList", ctx, readerFilter, limit, offset).Return(nil, errors.New("cache miss"))
+	repo.On("List", ctx, readerFilter, limit, offset).Return(articles, nil)
+	repo.On("Count", ctx, readerFilter).Return(int64(0), countError)
 
-	result, err := uc.Execute(ctx, limit, offset)
+	result, err := uc.Execute(ctx, reader, dto.ListArticlesRequest{Limit: limit, Offset: offset})
 
 	assert.Error(t, err)
 	assert.Equal(t, countError, err)
@@ -211,7 +214,7 @@ func TestListArticlesUseCase_Execute_WithNilDtoCache(t *testing.T) {
 	repo := &mockArticleRepository{}
 	cache := &mockArticleCache{}
 
-	uc := NewListArticlesUseCase(repo, cache, nil)
+	uc := NewListArticlesUseCase(repo, cache, nil, nil, nil)
 
 	limit := 10
 	offset := 0
@@ -227,10 +230,10 @@ func TestListArticlesUseCase_Execute_WithNilDtoCache(t *testing.T) {
 	}
 	total := int64(1)
 
-	repo.On("List", ctx, limit, offset).Return(articles, nil)
-	repo.On("Count", ctx).Return(total, nil)
+	repo.On("List", ctx, readerFilter, limit, offset).Return(articles, nil)
+	repo.On("Count", ctx, readerFilter).Return(total, nil)
 
-	result, err := uc.Execute(ctx, limit, offset)
+	result, err := uc.Execute(ctx, reader, dto.ListArticlesRequest{Limit: limit, Offset: offset})
 
 	assert.NoError(t, err)
 	assert.NotNil(t, result)
@@ -246,19 +249,19 @@ func TestListArticlesUseCase_Execute_EmptyList(t *testing.T) {
 	cache := &mockArticleCache{}
 	dtoCache := &mockArticleListCache{}
 
-	uc := NewListArticlesUseCase(repo, cache, dtoCache)
+	uc := NewListArticlesUseCase(repo, cache, dtoCache, nil, nil)
 
 	limit := 10
 	offset := 0
 	articles := []*domainarticle.Article{}
 	total := int64(0)
 
-	dtoCache.On("GetArticleList", ctx, limit, offset).Return(nil, errors.New("cache miss"))
-	repo.On("List", ctx, limit, offset).Return(articles, nil)
-	repo.On("Count", ctx).Return(total, nil)
-	dtoCache.On("SetArticleList", ctx, limit, offset, mock.AnythingOfType("*dto.ListArticlesResponse")).Return(nil)
+	dtoCache.On("GetArticleList", ctx, readerFilter, limit, offset).Return(nil, errors.New("cache miss"))
+	repo.On("List", ctx, readerFilter, limit, offset).Return(articles, nil)
+	repo.On("Count", ctx, readerFilter).Return(total, nil)
+	dtoCache.On("SetArticleList", ctx, readerFilter, limit, offset, mock.AnythingOfType("*dto.ListArticlesResponse")).Return(nil)
 
-	result, err := uc.Execute(ctx, limit, offset)
+	result, err := uc.Execute(ctx, reader, dto.ListArticlesRequest{Limit: limit, Offset: offset})
 
 	assert.NoError(t, err)
 	assert.NotNil(t, result)
@@ -269,3 +272,19 @@ func TestListArticlesUseCase_Execute_EmptyList(t *testing.T) {
 	repo.AssertExpectations(t)
 }
 
+func TestListArticlesUseCase_Execute_FilterOfActor(t *testing.T) {
+	ctx := context.Background()
+	repo := &mockArticleRepository{}
+	editor := domainarticle.Actor{UserID: 3, OrgID: 1, IsEditor: true}
+	editorFilter := domainarticle.ListFilter{OrgID: 1, AuthorID: 3, Statuses: []domainarticle.Status{domainarticle.StatusInReview}}
+
+	uc := NewListArticlesUseCase(repo, nil, nil, nil, nil)
+
+	repo.On("List", ctx, editorFilter, 10, 0).Return([]*domainarticle.Article{}, nil)
+	repo.On("Count", ctx, editorFilter).Return(int64(0), nil)
+
+	_, err := uc.Execute(ctx, editor, dto.ListArticlesRequest{Limit: 10})
+
+	assert.NoError(t, err)
+	repo.AssertExpectations(t)
+}
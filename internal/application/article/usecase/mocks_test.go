@@ -2,6 +2,7 @@ package usecase
 
 import (
 	"context"
+	"time"
 
 	"github.com/rulzi/hexa-go/internal/application/article/dto"
 	domainarticle "github.com/rulzi/hexa-go/internal/domain/article"
@@ -21,14 +22,40 @@ func (m *mockArticleRepository) Create(ctx context.Context, article *domainartic
 	return args.Get(0).(*domainarticle.Article), args.Error(1)
 }
 
-func (m *mockArticleRepository) GetByID(ctx context.Context, id int64) (*domainarticle.Article, error) {
-	args := m.Called(ctx, id)
+func (m *mockArticleRepository) GetByID(ctx context.Context, orgID, id int64) (*domainarticle.Article, error) {
+	args := m.Called(ctx, orgID, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domainarticle.Article), args.Error(1)
+}
+
+func (m *mockArticleRepository) GetBySlug(ctx context.Context, orgID int64, slug string) (*domainarticle.Article, error) {
+	args := m.Called(ctx, orgID, slug)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domainarticle.Article), args.Error(1)
+}
+
+func (m *mockArticleRepository) GetByPreviousSlug(ctx context.Context, orgID int64, slug string) (*domainarticle.Article, error) {
+	args := m.Called(ctx, orgID, slug)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
 	return args.Get(0).(*domainarticle.Article), args.Error(1)
 }
 
+func (m *mockArticleRepository) SlugTaken(ctx context.Context, orgID int64, slug string, excludeID int64) (bool, error) {
+	args := m.Called(ctx, orgID, slug, excludeID)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *mockArticleRepository) AddPreviousSlug(ctx context.Context, orgID, articleID int64, slug string) error {
+	args := m.Called(ctx, orgID, articleID, slug)
+	return args.Error(0)
+}
+
 func (m *mockArticleRepository) Update(ctx context.Context, article *domainarticle.Article) (*domainarticle.Article, error) {
 	args := m.Called(ctx, article)
 	if args.Get(0) == nil {
@@ -37,34 +64,34 @@ func (m *mockArticleRepository) Update(ctx context.Context, article *domainartic
 	return args.Get(0).(*domainarticle.Article), args.Error(1)
 }
 
-func (m *mockArticleRepository) Delete(ctx context.Context, id int64) error {
-	args := m.Called(ctx, id)
+func (m *mockArticleRepository) Delete(ctx context.Context, orgID, id int64) error {
+	args := m.Called(ctx, orgID, id)
 	return args.Error(0)
 }
 
-func (m *mockArticleRepository) List(ctx context.Context, limit, offset int) ([]*domainarticle.Article, error) {
-	args := m.Called(ctx, limit, offset)
+func (m *mockArticleRepository) List(ctx context.Context, filter domainarticle.ListFilter, limit, offset int) ([]*domainarticle.Article, error) {
+	args := m.Called(ctx, filter, limit, offset)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
 	return args.Get(0).([]*domainarticle.Article), args.Error(1)
 }
 
-func (m *mockArticleRepository) ListByAuthor(ctx context.Context, authorID int64, limit, offset int) ([]*domainarticle.Article, error) {
-	args := m.Called(ctx, authorID, limit, offset)
+func (m *mockArticleRepository) ListByAuthor(ctx context.Context, orgID, authorID int64, limit, offset int) ([]*domainarticle.Article, error) {
+	args := m.Called(ctx, orgID, authorID, limit, offset)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
 	return args.Get(0).([]*domainarticle.Article), args.Error(1)
 }
 
-func (m *mockArticleRepository) Count(ctx context.Context) (int64, error) {
-	args := m.Called(ctx)
+func (m *mockArticleRepository) Count(ctx context.Context, filter domainarticle.ListFilter) (int64, error) {
+	args := m.Called(ctx, filter)
 	return args.Get(0).(int64), args.Error(1)
 }
 
-func (m *mockArticleRepository) CountByAuthor(ctx context.Context, authorID int64) (int64, error) {
-	args := m.Called(ctx, authorID)
+func (m *mockArticleRepository) CountByAuthor(ctx context.Context, orgID, authorID int64) (int64, error) {
+	args := m.Called(ctx, orgID, authorID)
 	return args.Get(0).(int64), args.Error(1)
 }
 
@@ -81,6 +108,14 @@ func (m *mockArticleCache) Get(ctx context.Context, id int64) (*domainarticle.Ar
 	return args.Get(0).(*domainarticle.Article), args.Error(1)
 }
 
+func (m *mockArticleCache) GetBySlug(ctx context.Context, orgID int64, slug string) (*domainarticle.Article, error) {
+	args := m.Called(ctx, orgID, slug)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domainarticle.Article), args.Error(1)
+}
+
 func (m *mockArticleCache) Set(ctx context.Context, id int64, article *domainarticle.Article) error {
 	args := m.Called(ctx, id, article)
 	return args.Error(0)
@@ -101,16 +136,16 @@ type mockArticleListCache struct {
 	mock.Mock
 }
 
-func (m *mockArticleListCache) GetArticleList(ctx context.Context, limit, offset int) (*dto.ListArticlesResponse, error) {
-	args := m.Called(ctx, limit, offset)
+func (m *mockArticleListCache) GetArticleList(ctx context.Context, filter domainarticle.ListFilter, limit, offset int) (*dto.ListArticlesResponse, error) {
+	args := m.Called(ctx, filter, limit, offset)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
 	return args.Get(0).(*dto.ListArticlesResponse), args.Error(1)
 }
 
-func (m *mockArticleListCache) SetArticleList(ctx context.Context, limit, offset int, listResp *dto.ListArticlesResponse) error {
-	args := m.Called(ctx, limit, offset, listResp)
+func (m *mockArticleListCache) SetArticleList(ctx context.Context, filter domainarticle.ListFilter, limit, offset int, listResp *dto.ListArticlesResponse) error {
+	args := m.Called(ctx, filter, limit, offset, listResp)
 	return args.Error(0)
 }
 
@@ -119,3 +154,201 @@ func (m *mockArticleListCache) InvalidateArticleList(ctx context.Context) error
 	return args.Error(0)
 }
 
+
+func (m *mockArticleRepository) Restore(ctx context.Context, orgID, id int64) error {
+	args := m.Called(ctx, orgID, id)
+	return args.Error(0)
+}
+
+func (m *mockArticleRepository) ListDeleted(ctx context.Context, orgID int64, limit, offset int) ([]*domainarticle.Article, error) {
+	args := m.Called(ctx, orgID, limit, offset)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domainarticle.Article), args.Error(1)
+}
+
+func (m *mockArticleRepository) CountDeleted(ctx context.Context, orgID int64) (int64, error) {
+	args := m.Called(ctx, orgID)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *mockArticleRepository) PurgeDeletedBefore(ctx context.Context, before time.Time) (int64, error) {
+	args := m.Called(ctx, before)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *mockArticleRepository) ListAllByAuthor(ctx context.Context, authorID int64, limit, offset int) ([]*domainarticle.Article, error) {
+	args := m.Called(ctx, authorID, limit, offset)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domainarticle.Article), args.Error(1)
+}
+
+func (m *mockArticleRepository) DeleteByAuthor(ctx context.Context, orgID, authorID int64) (int64, error) {
+	args := m.Called(ctx, orgID, authorID)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *mockArticleRepository) ReassignAuthor(ctx context.Context, orgID, fromID, toID int64) (int64, error) {
+	args := m.Called(ctx, orgID, fromID, toID)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *mockArticleRepository) ListIDsByCategory(ctx context.Context, orgID, categoryID int64) ([]int64, error) {
+	args := m.Called(ctx, orgID, categoryID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]int64), args.Error(1)
+}
+
+func (m *mockArticleRepository) ListDueForPublishing(ctx context.Context, now time.Time, limit int) ([]*domainarticle.Article, error) {
+	args := m.Called(ctx, now, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domainarticle.Article), args.Error(1)
+}
+
+func (m *mockArticleRepository) PublishIfDue(ctx context.Context, article *domainarticle.Article, now time.Time) (bool, error) {
+	args := m.Called(ctx, article, now)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *mockArticleRepository) ListAfter(ctx context.Context, afterID int64, limit int) ([]*domainarticle.Article, error) {
+	args := m.Called(ctx, afterID, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domainarticle.Article), args.Error(1)
+}
+
+// mockTagRepository is a mock implementation of TagRepository
+type mockTagRepository struct {
+	mock.Mock
+}
+
+func (m *mockTagRepository) Create(ctx context.Context, tag *domainarticle.Tag) (*domainarticle.Tag, error) {
+	args := m.Called(ctx, tag)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domainarticle.Tag), args.Error(1)
+}
+
+func (m *mockTagRepository) GetByID(ctx context.Context, orgID, id int64) (*domainarticle.Tag, error) {
+	args := m.Called(ctx, orgID, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domainarticle.Tag), args.Error(1)
+}
+
+func (m *mockTagRepository) GetByIDs(ctx context.Context, orgID int64, ids []int64) ([]*domainarticle.Tag, error) {
+	args := m.Called(ctx, orgID, ids)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domainarticle.Tag), args.Error(1)
+}
+
+func (m *mockTagRepository) Update(ctx context.Context, tag *domainarticle.Tag) (*domainarticle.Tag, error) {
+	args := m.Called(ctx, tag)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domainarticle.Tag), args.Error(1)
+}
+
+func (m *mockTagRepository) Delete(ctx context.Context, orgID, id int64) error {
+	args := m.Called(ctx, orgID, id)
+	return args.Error(0)
+}
+
+func (m *mockTagRepository) List(ctx context.Context, filter domainarticle.ListFilter) ([]*domainarticle.TagCount, error) {
+	args := m.Called(ctx, filter)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domainarticle.TagCount), args.Error(1)
+}
+
+func (m *mockTagRepository) ListByArticle(ctx context.Context, orgID, articleID int64) ([]*domainarticle.Tag, error) {
+	args := m.Called(ctx, orgID, articleID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domainarticle.Tag), args.Error(1)
+}
+
+func (m *mockTagRepository) SetArticleTags(ctx context.Context, articleID int64, tagIDs []int64) error {
+	args := m.Called(ctx, articleID, tagIDs)
+	return args.Error(0)
+}
+
+// mockCategoryRepository is a mock implementation of CategoryRepository
+type mockCategoryRepository struct {
+	mock.Mock
+}
+
+func (m *mockCategoryRepository) Create(ctx context.Context, category *domainarticle.Category) (*domainarticle.Category, error) {
+	args := m.Called(ctx, category)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domainarticle.Category), args.Error(1)
+}
+
+func (m *mockCategoryRepository) GetByID(ctx context.Context, orgID, id int64) (*domainarticle.Category, error) {
+	args := m.Called(ctx, orgID, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domainarticle.Category), args.Error(1)
+}
+
+func (m *mockCategoryRepository) Update(ctx context.Context, category *domainarticle.Category) (*domainarticle.Category, error) {
+	args := m.Called(ctx, category)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domainarticle.Category), args.Error(1)
+}
+
+func (m *mockCategoryRepository) Delete(ctx context.Context, orgID, id int64) error {
+	args := m.Called(ctx, orgID, id)
+	return args.Error(0)
+}
+
+func (m *mockCategoryRepository) List(ctx context.Context, orgID int64) ([]*domainarticle.Category, error) {
+	args := m.Called(ctx, orgID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domainarticle.Category), args.Error(1)
+}
+
+// mockSearchIndex is a mock implementation of SearchIndex
+type mockSearchIndex struct {
+	mock.Mock
+}
+
+func (m *mockSearchIndex) Index(ctx context.Context, article *domainarticle.Article) error {
+	args := m.Called(ctx, article)
+	return args.Error(0)
+}
+
+func (m *mockSearchIndex) Remove(ctx context.Context, id int64) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *mockSearchIndex) Search(ctx context.Context, query domainarticle.SearchQuery) (*domainarticle.SearchResult, error) {
+	args := m.Called(ctx, query)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domainarticle.SearchResult), args.Error(1)
+}
@@ -0,0 +1,74 @@
+package usecase
+
+import (
+	"context"
+	"log"
+	"time"
+
+	domainarticle "github.com/rulzi/hexa-go/internal/domain/article"
+)
+
+// scheduledBatchSize is how many due articles are published per run, the rest waits for the next run
+const scheduledBatchSize = 100
+
+// PublishScheduledArticlesUseCase publishes the articles whose scheduled publish time has come.
+// It is run by the scheduler job on every replica, each article is published by only one of them.
+type PublishScheduledArticlesUseCase struct {
+	articleRepo    domainarticle.Repository
+	articleService *domainarticle.Service
+	cache          domainarticle.Cache
+	searchIndex    domainarticle.SearchIndex
+	now            func() time.Time
+}
+
+// NewPublishScheduledArticlesUseCase creates a new PublishScheduledArticlesUseCase
+func NewPublishScheduledArticlesUseCase(
+	articleRepo domainarticle.Repository,
+	articleService *domainarticle.Service,
+	cache domainarticle.Cache,
+	searchIndex domainarticle.SearchIndex,
+) *PublishScheduledArticlesUseCase {
+	return &PublishScheduledArticlesUseCase{
+		articleRepo:    articleRepo,
+		articleService: articleService,
+		cache:          cache,
+		searchIndex:    searchIndex,
+		now:            time.Now,
+	}
+}
+
+// Execute publishes the due articles and returns how many this run published.
+// Articles another replica published first are skipped.
+func (uc *PublishScheduledArticlesUseCase) Execute(ctx context.Context) (int, error) {
+	now := uc.now()
+	due, err := uc.articleRepo.ListDueForPublishing(ctx, now, scheduledBatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	var published []int64
+	// The articles published before a failure are evicted too
+	defer func() {
+		if len(published) > 0 {
+			evictArticles(ctx, uc.cache, nil, published)
+		}
+	}()
+
+	for _, a := range due {
+		if err := uc.articleService.PublishScheduled(a, now); err != nil {
+			log.Printf("Failed to publish scheduled article %d: %v", a.ID, err)
+			continue
+		}
+
+		ok, err := uc.articleRepo.PublishIfDue(ctx, a, now)
+		if err != nil {
+			return len(published), err
+		}
+		if ok {
+			published = append(published, a.ID)
+			indexArticle(ctx, uc.searchIndex, a)
+		}
+	}
+
+	return len(published), nil
+}
@@ -0,0 +1,80 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	domainarticle "github.com/rulzi/hexa-go/internal/domain/article"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestPublishScheduledArticlesUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+	repo := &mockArticleRepository{}
+	cache := &mockArticleCache{}
+	index := &mockSearchIndex{}
+	uc := NewPublishScheduledArticlesUseCase(repo, domainarticle.NewService(repo), cache, index)
+
+	now := time.Date(2026, 1, 5, 9, 0, 0, 0, time.UTC)
+	uc.now = func() time.Time { return now }
+	due := now.Add(-time.Minute)
+
+	mine := &domainarticle.Article{ID: 1, AuthorID: 2, OrgID: 1, Status: domainarticle.StatusInReview, PublishAt: &due}
+	taken := &domainarticle.Article{ID: 2, AuthorID: 2, OrgID: 3, Status: domainarticle.StatusInReview, PublishAt: &due}
+
+	repo.On("ListDueForPublishing", ctx, now, scheduledBatchSize).Return([]*domainarticle.Article{mine, taken}, nil)
+	repo.On("PublishIfDue", ctx, mine, now).Return(true, nil)
+	// Another replica published this one first
+	repo.On("PublishIfDue", ctx, taken, now).Return(false, nil)
+	cache.On("Delete", ctx, int64(1)).Return(nil)
+	cache.On("InvalidateList", ctx).Return(nil)
+	index.On("Index", ctx, mine).Return(nil)
+
+	published, err := uc.Execute(ctx)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 1, published)
+	assert.Equal(t, domainarticle.StatusPublished, mine.Status)
+	assert.Equal(t, now, *mine.PublishedAt)
+	assert.Nil(t, mine.PublishAt)
+	repo.AssertExpectations(t)
+	cache.AssertExpectations(t)
+	cache.AssertNotCalled(t, "Delete", ctx, int64(2))
+	index.AssertExpectations(t)
+	index.AssertNotCalled(t, "Index", ctx, taken)
+}
+
+func TestPublishScheduledArticlesUseCase_Execute_NothingDue(t *testing.T) {
+	ctx := context.Background()
+	repo := &mockArticleRepository{}
+	cache := &mockArticleCache{}
+	uc := NewPublishScheduledArticlesUseCase(repo, domainarticle.NewService(repo), cache, nil)
+
+	repo.On("ListDueForPublishing", ctx, mock.Anything, scheduledBatchSize).Return(nil, nil)
+
+	published, err := uc.Execute(ctx)
+
+	assert.NoError(t, err)
+	assert.Zero(t, published)
+	cache.AssertNotCalled(t, "InvalidateList", mock.Anything)
+}
+
+func TestPublishScheduledArticlesUseCase_Execute_Error(t *testing.T) {
+	ctx := context.Background()
+	repo := &mockArticleRepository{}
+	uc := NewPublishScheduledArticlesUseCase(repo, domainarticle.NewService(repo), nil, nil)
+
+	due := time.Now().Add(-time.Minute)
+	article := &domainarticle.Article{ID: 1, AuthorID: 2, OrgID: 1, Status: domainarticle.StatusInReview, PublishAt: &due}
+
+	repo.On("ListDueForPublishing", ctx, mock.Anything, scheduledBatchSize).Return([]*domainarticle.Article{article}, nil)
+	repo.On("PublishIfDue", ctx, article, mock.Anything).Return(false, errors.New("database error"))
+
+	published, err := uc.Execute(ctx)
+
+	assert.Error(t, err)
+	assert.Zero(t, published)
+}
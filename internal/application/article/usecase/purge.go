@@ -0,0 +1,25 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	domainarticle "github.com/rulzi/hexa-go/internal/domain/article"
+)
+
+// PurgeArticlesUseCase permanently deletes the articles that stayed in the trash past the retention period
+type PurgeArticlesUseCase struct {
+	articleRepo domainarticle.Repository
+}
+
+// NewPurgeArticlesUseCase creates a new PurgeArticlesUseCase
+func NewPurgeArticlesUseCase(articleRepo domainarticle.Repository) *PurgeArticlesUseCase {
+	return &PurgeArticlesUseCase{
+		articleRepo: articleRepo,
+	}
+}
+
+// Execute purges the articles moved to the trash before deletedBefore and returns how many were removed
+func (uc *PurgeArticlesUseCase) Execute(ctx context.Context, deletedBefore time.Time) (int64, error) {
+	return uc.articleRepo.PurgeDeletedBefore(ctx, deletedBefore)
+}
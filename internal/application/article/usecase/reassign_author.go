@@ -0,0 +1,50 @@
+package usecase
+
+import (
+	"context"
+
+	domainarticle "github.com/rulzi/hexa-go/internal/domain/article"
+)
+
+// ReassignArticlesUseCase moves every article of an author, including the trash, to another author.
+// It is used to keep the content of an account, or of its data in an organization, that is erased.
+type ReassignArticlesUseCase struct {
+	articleRepo domainarticle.Repository
+	cache       domainarticle.Cache
+	listCache   ArticleListCache
+	searchIndex domainarticle.SearchIndex
+}
+
+// NewReassignArticlesUseCase creates a new ReassignArticlesUseCase
+func NewReassignArticlesUseCase(articleRepo domainarticle.Repository, cache domainarticle.Cache, listCache ArticleListCache, searchIndex domainarticle.SearchIndex) *ReassignArticlesUseCase {
+	return &ReassignArticlesUseCase{
+		articleRepo: articleRepo,
+		cache:       cache,
+		listCache:   listCache,
+		searchIndex: searchIndex,
+	}
+}
+
+// Execute moves the articles of fromID in orgID to toID and returns how many were moved,
+// orgID zero moves them in every organization
+func (uc *ReassignArticlesUseCase) Execute(ctx context.Context, orgID, fromID, toID int64) (int64, error) {
+	articles, err := articlesByAuthor(ctx, uc.articleRepo, orgID, fromID)
+	if err != nil {
+		return 0, err
+	}
+
+	moved, err := uc.articleRepo.ReassignAuthor(ctx, orgID, fromID, toID)
+	if err != nil {
+		return 0, err
+	}
+
+	// Cached and indexed copies still name the previous author
+	evictArticles(ctx, uc.cache, uc.listCache, articleIDs(articles))
+	for _, a := range articles {
+		if a.DeletedAt == nil {
+			a.AuthorID = toID
+			indexArticle(ctx, uc.searchIndex, a)
+		}
+	}
+	return moved, nil
+}
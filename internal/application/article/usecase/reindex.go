@@ -0,0 +1,47 @@
+package usecase
+
+import (
+	"context"
+
+	domainarticle "github.com/rulzi/hexa-go/internal/domain/article"
+)
+
+// reindexBatchSize is how many articles are read per query when rebuilding the search index
+const reindexBatchSize = 500
+
+// ReindexArticlesUseCase fills the search index with every article not in the trash.
+// It is run at startup for search indexes that don't persist, like the in-process one.
+type ReindexArticlesUseCase struct {
+	articleRepo domainarticle.Repository
+	searchIndex domainarticle.SearchIndex
+}
+
+// NewReindexArticlesUseCase creates a new ReindexArticlesUseCase
+func NewReindexArticlesUseCase(articleRepo domainarticle.Repository, searchIndex domainarticle.SearchIndex) *ReindexArticlesUseCase {
+	return &ReindexArticlesUseCase{
+		articleRepo: articleRepo,
+		searchIndex: searchIndex,
+	}
+}
+
+// Execute indexes the articles in batches and returns how many were indexed
+func (uc *ReindexArticlesUseCase) Execute(ctx context.Context) (int, error) {
+	var indexed int
+	var afterID int64
+	for {
+		articles, err := uc.articleRepo.ListAfter(ctx, afterID, reindexBatchSize)
+		if err != nil {
+			return indexed, err
+		}
+		for _, a := range articles {
+			if err := uc.searchIndex.Index(ctx, a); err != nil {
+				return indexed, err
+			}
+			indexed++
+			afterID = a.ID
+		}
+		if len(articles) < reindexBatchSize {
+			return indexed, nil
+		}
+	}
+}
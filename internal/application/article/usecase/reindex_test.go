@@ -0,0 +1,49 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	domainarticle "github.com/rulzi/hexa-go/internal/domain/article"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestReindexArticlesUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+	repo := &mockArticleRepository{}
+	index := &mockSearchIndex{}
+	uc := NewReindexArticlesUseCase(repo, index)
+
+	// A full batch makes the use case read the next one, starting after its last article
+	batch := make([]*domainarticle.Article, reindexBatchSize)
+	for i := range batch {
+		batch[i] = &domainarticle.Article{ID: int64(i + 1)}
+	}
+	repo.On("ListAfter", ctx, int64(0), reindexBatchSize).Return(batch, nil)
+	repo.On("ListAfter", ctx, int64(reindexBatchSize), reindexBatchSize).Return([]*domainarticle.Article{{ID: 900}}, nil)
+	index.On("Index", ctx, mock.Anything).Return(nil)
+
+	indexed, err := uc.Execute(ctx)
+
+	assert.NoError(t, err)
+	assert.Equal(t, reindexBatchSize+1, indexed)
+	repo.AssertExpectations(t)
+	index.AssertNumberOfCalls(t, "Index", reindexBatchSize+1)
+}
+
+func TestReindexArticlesUseCase_Execute_Error(t *testing.T) {
+	ctx := context.Background()
+	repo := &mockArticleRepository{}
+	index := &mockSearchIndex{}
+	uc := NewReindexArticlesUseCase(repo, index)
+
+	repo.On("ListAfter", ctx, int64(0), reindexBatchSize).Return(nil, errors.New("database error"))
+
+	indexed, err := uc.Execute(ctx)
+
+	assert.Error(t, err)
+	assert.Zero(t, indexed)
+	index.AssertNotCalled(t, "Index", mock.Anything, mock.Anything)
+}
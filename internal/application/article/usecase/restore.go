@@ -0,0 +1,62 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/rulzi/hexa-go/internal/application/article/dto"
+	domainarticle "github.com/rulzi/hexa-go/internal/domain/article"
+)
+
+// RestoreArticleUseCase handles taking an article out of the trash
+type RestoreArticleUseCase struct {
+	articleRepo domainarticle.Repository
+	cache       domainarticle.Cache
+	listCache   ArticleListCache
+	searchIndex domainarticle.SearchIndex
+}
+
+// NewRestoreArticleUseCase creates a new RestoreArticleUseCase
+func NewRestoreArticleUseCase(articleRepo domainarticle.Repository, cache domainarticle.Cache, listCache ArticleListCache, searchIndex domainarticle.SearchIndex) *RestoreArticleUseCase {
+	return &RestoreArticleUseCase{
+		articleRepo: articleRepo,
+		cache:       cache,
+		listCache:   listCache,
+		searchIndex: searchIndex,
+	}
+}
+
+// Execute executes the restore article use case
+func (uc *RestoreArticleUseCase) Execute(ctx context.Context, orgID, id int64) (*dto.ArticleResponse, error) {
+	if err := uc.articleRepo.Restore(ctx, orgID, id); err != nil {
+		return nil, err
+	}
+
+	// The article shows up in listings again
+	if uc.cache != nil {
+		_ = uc.cache.InvalidateList(ctx)
+	}
+	if uc.listCache != nil {
+		_ = uc.listCache.InvalidateArticleList(ctx)
+	}
+
+	a, err := uc.articleRepo.GetByID(ctx, orgID, id)
+	if err != nil {
+		return nil, err
+	}
+	indexArticle(ctx, uc.searchIndex, a)
+
+	return &dto.ArticleResponse{
+		ID:          a.ID,
+		Title:       a.Title,
+		Slug:        a.Slug,
+		Content:     a.Content,
+		AuthorID:    a.AuthorID,
+		OrgID:       a.OrgID,
+		CategoryID:  a.CategoryID,
+		Status:      string(a.Status),
+		PublishedAt: a.PublishedAt,
+		PublishAt:   a.PublishAt,
+		CreatedAt:   a.CreatedAt,
+		UpdatedAt:   a.UpdatedAt,
+	}, nil
+}
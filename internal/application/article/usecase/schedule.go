@@ -0,0 +1,78 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/rulzi/hexa-go/internal/application/article/dto"
+	domainarticle "github.com/rulzi/hexa-go/internal/domain/article"
+)
+
+// ScheduleArticleUseCase handles queueing an article in review to be published at a given time
+type ScheduleArticleUseCase struct {
+	articleRepo    domainarticle.Repository
+	articleService *domainarticle.Service
+	cache          domainarticle.Cache
+	listCache      ArticleListCache
+}
+
+// NewScheduleArticleUseCase creates a new ScheduleArticleUseCase
+func NewScheduleArticleUseCase(
+	articleRepo domainarticle.Repository,
+	articleService *domainarticle.Service,
+	cache domainarticle.Cache,
+	listCache ArticleListCache,
+) *ScheduleArticleUseCase {
+	return &ScheduleArticleUseCase{
+		articleRepo:    articleRepo,
+		articleService: articleService,
+		cache:          cache,
+		listCache:      listCache,
+	}
+}
+
+// Execute schedules the article to go live at publishAt on behalf of the actor, a nil publishAt cancels the schedule.
+// Articles the actor may not view are reported as not found.
+func (uc *ScheduleArticleUseCase) Execute(ctx context.Context, actor domainarticle.Actor, id int64, publishAt *time.Time) (*dto.ArticleResponse, error) {
+	existingArticle, err := uc.articleRepo.GetByID(ctx, actor.OrgID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if existingArticle == nil || !actor.CanView(existingArticle) {
+		return nil, domainarticle.ErrArticleNotFound
+	}
+
+	if err := uc.articleService.Schedule(actor, existingArticle, publishAt, time.Now()); err != nil {
+		return nil, err
+	}
+
+	updatedArticle, err := uc.articleRepo.Update(ctx, existingArticle)
+	if err != nil {
+		return nil, err
+	}
+
+	// Invalidate cache, the lists show the publish time too
+	if uc.cache != nil {
+		_ = uc.cache.Delete(ctx, id)
+		_ = uc.cache.InvalidateList(ctx)
+	}
+	if uc.listCache != nil {
+		_ = uc.listCache.InvalidateArticleList(ctx)
+	}
+
+	return &dto.ArticleResponse{
+		ID:          updatedArticle.ID,
+		Title:       updatedArticle.Title,
+		Slug:        updatedArticle.Slug,
+		Content:     updatedArticle.Content,
+		AuthorID:    updatedArticle.AuthorID,
+		OrgID:       updatedArticle.OrgID,
+		CategoryID:  updatedArticle.CategoryID,
+		Status:      string(updatedArticle.Status),
+		PublishedAt: updatedArticle.PublishedAt,
+		PublishAt:   updatedArticle.PublishAt,
+		CreatedAt:   updatedArticle.CreatedAt,
+		UpdatedAt:   updatedArticle.UpdatedAt,
+	}, nil
+}
@@ -0,0 +1,74 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	domainarticle "github.com/rulzi/hexa-go/internal/domain/article"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestScheduleArticleUseCase_Execute_Success(t *testing.T) {
+	ctx := context.Background()
+	repo := &mockArticleRepository{}
+	cache := &mockArticleCache{}
+	listCache := &mockArticleListCache{}
+
+	uc := NewScheduleArticleUseCase(repo, domainarticle.NewService(repo), cache, listCache)
+
+	publishAt := time.Now().Add(time.Hour)
+	existingArticle := &domainarticle.Article{ID: 1, Title: "Test Article", AuthorID: 2, OrgID: 1, Status: domainarticle.StatusInReview}
+
+	repo.On("GetByID", ctx, int64(1), int64(1)).Return(existingArticle, nil)
+	repo.On("Update", ctx, mock.MatchedBy(func(a *domainarticle.Article) bool {
+		return a.PublishAt != nil && a.PublishAt.Equal(publishAt) && a.Status == domainarticle.StatusInReview
+	})).Return(existingArticle, nil)
+	cache.On("Delete", ctx, int64(1)).Return(nil)
+	cache.On("InvalidateList", ctx).Return(nil)
+	listCache.On("InvalidateArticleList", ctx).Return(nil)
+
+	editor := domainarticle.Actor{UserID: 5, OrgID: 1, IsEditor: true}
+	result, err := uc.Execute(ctx, editor, 1, &publishAt)
+
+	assert.NoError(t, err)
+	assert.Equal(t, &publishAt, result.PublishAt)
+	repo.AssertExpectations(t)
+	cache.AssertExpectations(t)
+	listCache.AssertExpectations(t)
+}
+
+func TestScheduleArticleUseCase_Execute_Errors(t *testing.T) {
+	later := time.Now().Add(time.Hour)
+	earlier := time.Now().Add(-time.Hour)
+
+	tests := []struct {
+		name      string
+		actor     domainarticle.Actor
+		status    domainarticle.Status
+		publishAt *time.Time
+		wantErr   error
+	}{
+		{name: "author can't schedule", actor: domainarticle.Actor{UserID: 2, OrgID: 1}, status: domainarticle.StatusInReview, publishAt: &later, wantErr: domainarticle.ErrForbidden},
+		{name: "draft is not ready", actor: domainarticle.Actor{UserID: 9, OrgID: 1, IsAdmin: true}, status: domainarticle.StatusDraft, publishAt: &later, wantErr: domainarticle.ErrInvalidTransition},
+		{name: "time already passed", actor: domainarticle.Actor{UserID: 5, OrgID: 1, IsEditor: true}, status: domainarticle.StatusInReview, publishAt: &earlier, wantErr: domainarticle.ErrPublishAtInPast},
+		{name: "draft of another author is hidden", actor: domainarticle.Actor{UserID: 5, OrgID: 1, IsEditor: true}, status: domainarticle.StatusDraft, publishAt: &later, wantErr: domainarticle.ErrArticleNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			repo := &mockArticleRepository{}
+			uc := NewScheduleArticleUseCase(repo, domainarticle.NewService(repo), nil, nil)
+
+			repo.On("GetByID", ctx, int64(1), int64(1)).Return(&domainarticle.Article{ID: 1, AuthorID: 2, OrgID: 1, Status: tt.status}, nil)
+
+			result, err := uc.Execute(ctx, tt.actor, 1, tt.publishAt)
+
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Nil(t, result)
+			repo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+		})
+	}
+}
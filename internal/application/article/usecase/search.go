@@ -0,0 +1,103 @@
+package usecase
+
+import (
+	"context"
+	"log"
+	"strings"
+
+	"github.com/rulzi/hexa-go/internal/application/article/dto"
+	domainarticle "github.com/rulzi/hexa-go/internal/domain/article"
+)
+
+// SearchArticlesUseCase handles searching the articles by title and content
+type SearchArticlesUseCase struct {
+	searchIndex domainarticle.SearchIndex
+}
+
+// NewSearchArticlesUseCase creates a new SearchArticlesUseCase
+func NewSearchArticlesUseCase(searchIndex domainarticle.SearchIndex) *SearchArticlesUseCase {
+	return &SearchArticlesUseCase{searchIndex: searchIndex}
+}
+
+// Execute searches the articles of the organization of the actor, most relevant first.
+// Only the articles the actor may view are found, see domainarticle.Actor.CanView.
+// A query without any word returns ErrSearchQueryRequired.
+func (uc *SearchArticlesUseCase) Execute(ctx context.Context, actor domainarticle.Actor, req dto.SearchArticlesRequest) (*dto.SearchArticlesResponse, error) {
+	text := strings.TrimSpace(req.Query)
+	if len(domainarticle.SearchTerms(text)) == 0 {
+		return nil, domainarticle.ErrSearchQueryRequired
+	}
+
+	// Default pagination
+	limit, offset := req.Limit, req.Offset
+	if limit <= 0 {
+		limit = 10
+	}
+	if offset < 0 {
+		offset = 0
+	}
+
+	result, err := uc.searchIndex.Search(ctx, domainarticle.SearchQuery{
+		Text:   text,
+		Actor:  actor,
+		Limit:  limit,
+		Offset: offset,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	hits := make([]dto.SearchHitResponse, len(result.Hits))
+	for i, hit := range result.Hits {
+		a := hit.Article
+		hits[i] = dto.SearchHitResponse{
+			Article: dto.ArticleResponse{
+				ID:          a.ID,
+				Title:       a.Title,
+				Slug:        a.Slug,
+				Content:     a.Content,
+				AuthorID:    a.AuthorID,
+				OrgID:       a.OrgID,
+				CategoryID:  a.CategoryID,
+				Status:      string(a.Status),
+				PublishedAt: a.PublishedAt,
+				PublishAt:   a.PublishAt,
+				CreatedAt:   a.CreatedAt,
+				UpdatedAt:   a.UpdatedAt,
+			},
+			Score:   hit.Score,
+			Snippet: hit.Snippet,
+		}
+	}
+
+	return &dto.SearchArticlesResponse{
+		Query:  text,
+		Hits:   hits,
+		Total:  result.Total,
+		Limit:  limit,
+		Offset: offset,
+	}, nil
+}
+
+// indexArticle adds the article to the search index or refreshes its indexed copy.
+// The change is already stored, a failure only leaves search results stale until the next reindex.
+func indexArticle(ctx context.Context, searchIndex domainarticle.SearchIndex, a *domainarticle.Article) {
+	if searchIndex == nil {
+		return
+	}
+	if err := searchIndex.Index(ctx, a); err != nil {
+		log.Printf("Failed to index article %d: %v", a.ID, err)
+	}
+}
+
+// unindexArticles takes the articles out of the search index
+func unindexArticles(ctx context.Context, searchIndex domainarticle.SearchIndex, ids []int64) {
+	if searchIndex == nil {
+		return
+	}
+	for _, id := range ids {
+		if err := searchIndex.Remove(ctx, id); err != nil {
+			log.Printf("Failed to remove article %d from the search index: %v", id, err)
+		}
+	}
+}
@@ -0,0 +1,80 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/rulzi/hexa-go/internal/application/article/dto"
+	domainarticle "github.com/rulzi/hexa-go/internal/domain/article"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestSearchArticlesUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+	index := &mockSearchIndex{}
+	uc := NewSearchArticlesUseCase(index)
+
+	index.On("Search", ctx, domainarticle.SearchQuery{Text: "go generics", Actor: reader, Limit: 10, Offset: 0}).Return(&domainarticle.SearchResult{
+		Hits: []*domainarticle.SearchHit{
+			{Article: &domainarticle.Article{ID: 4, Title: "Go generics", Status: domainarticle.StatusPublished}, Score: 2.5, Snippet: "<mark>Go</mark> <mark>generics</mark>"},
+		},
+		Total: 1,
+	}, nil)
+
+	result, err := uc.Execute(ctx, reader, dto.SearchArticlesRequest{Query: "  go generics ", Offset: -1})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "go generics", result.Query)
+	assert.Equal(t, int64(1), result.Total)
+	assert.Equal(t, 10, result.Limit)
+	assert.Equal(t, 0, result.Offset)
+	assert.Len(t, result.Hits, 1)
+	assert.Equal(t, int64(4), result.Hits[0].Article.ID)
+	assert.Equal(t, "published", result.Hits[0].Article.Status)
+	assert.Equal(t, 2.5, result.Hits[0].Score)
+	assert.Equal(t, "<mark>Go</mark> <mark>generics</mark>", result.Hits[0].Snippet)
+	index.AssertExpectations(t)
+}
+
+func TestSearchArticlesUseCase_Execute_QueryRequired(t *testing.T) {
+	index := &mockSearchIndex{}
+	uc := NewSearchArticlesUseCase(index)
+
+	for _, q := range []string{"", "   ", "?!"} {
+		result, err := uc.Execute(context.Background(), reader, dto.SearchArticlesRequest{Query: q})
+
+		assert.ErrorIs(t, err, domainarticle.ErrSearchQueryRequired)
+		assert.Nil(t, result)
+	}
+	index.AssertNotCalled(t, "Search", mock.Anything, mock.Anything)
+}
+
+func TestSearchArticlesUseCase_Execute_IndexError(t *testing.T) {
+	ctx := context.Background()
+	index := &mockSearchIndex{}
+	uc := NewSearchArticlesUseCase(index)
+
+	index.On("Search", ctx, mock.Anything).Return(nil, errors.New("database error"))
+
+	result, err := uc.Execute(ctx, reader, dto.SearchArticlesRequest{Query: "go", Limit: 5, Offset: 20})
+
+	assert.Error(t, err)
+	assert.Nil(t, result)
+}
+
+func TestIndexArticle_FailureIsIgnored(t *testing.T) {
+	ctx := context.Background()
+	index := &mockSearchIndex{}
+	a := &domainarticle.Article{ID: 3}
+	index.On("Index", ctx, a).Return(errors.New("index unavailable"))
+	index.On("Remove", ctx, int64(3)).Return(errors.New("index unavailable"))
+
+	indexArticle(ctx, index, a)
+	unindexArticles(ctx, index, []int64{3})
+	indexArticle(ctx, nil, a)
+	unindexArticles(ctx, nil, []int64{3})
+
+	index.AssertExpectations(t)
+}
@@ -0,0 +1,79 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/rulzi/hexa-go/internal/application/article/dto"
+	domainarticle "github.com/rulzi/hexa-go/internal/domain/article"
+)
+
+// SetArticleTagsUseCase handles replacing the tags of an article
+type SetArticleTagsUseCase struct {
+	articleRepo domainarticle.Repository
+	tagRepo     domainarticle.TagRepository
+	listCache   ArticleListCache
+}
+
+// NewSetArticleTagsUseCase creates a new SetArticleTagsUseCase
+func NewSetArticleTagsUseCase(articleRepo domainarticle.Repository, tagRepo domainarticle.TagRepository, listCache ArticleListCache) *SetArticleTagsUseCase {
+	return &SetArticleTagsUseCase{
+		articleRepo: articleRepo,
+		tagRepo:     tagRepo,
+		listCache:   listCache,
+	}
+}
+
+// Execute executes the set article tags use case.
+// Only the author of the article or an admin may tag it, every tag must belong to the organization of the article.
+func (uc *SetArticleTagsUseCase) Execute(ctx context.Context, actor domainarticle.Actor, articleID int64, req dto.SetArticleTagsRequest) (*dto.ListTagsResponse, error) {
+	existingArticle, err := uc.articleRepo.GetByID(ctx, actor.OrgID, articleID)
+	if err != nil {
+		return nil, err
+	}
+
+	if existingArticle == nil {
+		return nil, domainarticle.ErrArticleNotFound
+	}
+
+	if !actor.CanModify(existingArticle) {
+		return nil, domainarticle.ErrForbidden
+	}
+
+	tagIDs := uniqueIDs(req.TagIDs)
+	tags, err := uc.tagRepo.GetByIDs(ctx, actor.OrgID, tagIDs)
+	if err != nil {
+		return nil, err
+	}
+	if len(tags) != len(tagIDs) {
+		return nil, domainarticle.ErrTagNotFound
+	}
+
+	if err := uc.tagRepo.SetArticleTags(ctx, articleID, tagIDs); err != nil {
+		return nil, err
+	}
+
+	// Lists filtered by a tag the article gained or lost are stale
+	if uc.listCache != nil {
+		_ = uc.listCache.InvalidateArticleList(ctx)
+	}
+
+	tagResponses := make([]dto.TagResponse, len(tags))
+	for i, t := range tags {
+		tagResponses[i] = tagResponse(t)
+	}
+
+	return &dto.ListTagsResponse{Tags: tagResponses}, nil
+}
+
+// uniqueIDs returns the IDs without duplicates, in their first order
+func uniqueIDs(ids []int64) []int64 {
+	seen := make(map[int64]bool, len(ids))
+	unique := make([]int64, 0, len(ids))
+	for _, id := range ids {
+		if !seen[id] {
+			seen[id] = true
+			unique = append(unique, id)
+		}
+	}
+	return unique
+}
@@ -0,0 +1,230 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/rulzi/hexa-go/internal/application/article/dto"
+	domainarticle "github.com/rulzi/hexa-go/internal/domain/article"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestCreateTagUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+	tagRepo := &mockTagRepository{}
+	uc := NewCreateTagUseCase(tagRepo)
+
+	tagRepo.On("Create", ctx, mock.MatchedBy(func(tag *domainarticle.Tag) bool {
+		return tag.OrgID == 1 && tag.Name == "Premier League" && tag.Slug == "premier-league" && tag.CreatedBy == 2 && tag.UpdatedBy == 2
+	})).Return(&domainarticle.Tag{ID: 4, OrgID: 1, Name: "Premier League", Slug: "premier-league"}, nil)
+
+	result, err := uc.Execute(ctx, reader, dto.TagRequest{Name: "Premier League"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(4), result.ID)
+	assert.Equal(t, "premier-league", result.Slug)
+	tagRepo.AssertExpectations(t)
+}
+
+func TestCreateTagUseCase_Execute_Exists(t *testing.T) {
+	ctx := context.Background()
+	tagRepo := &mockTagRepository{}
+	uc := NewCreateTagUseCase(tagRepo)
+
+	tagRepo.On("Create", ctx, mock.Anything).Return(nil, domainarticle.ErrTagExists)
+
+	result, err := uc.Execute(ctx, reader, dto.TagRequest{Name: "Sport"})
+
+	assert.ErrorIs(t, err, domainarticle.ErrTagExists)
+	assert.Nil(t, result)
+}
+
+func TestUpdateTagUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+	tagRepo := &mockTagRepository{}
+	uc := NewUpdateTagUseCase(tagRepo)
+
+	tagRepo.On("GetByID", ctx, int64(1), int64(4)).Return(&domainarticle.Tag{ID: 4, OrgID: 1, Name: "Sport", Slug: "sport"}, nil)
+	tagRepo.On("Update", ctx, mock.MatchedBy(func(tag *domainarticle.Tag) bool {
+		return tag.Name == "Sports" && tag.Slug == "sports"
+	})).Return(&domainarticle.Tag{ID: 4, OrgID: 1, Name: "Sports", Slug: "sports"}, nil)
+
+	result, err := uc.Execute(ctx, reader, 4, dto.TagRequest{Name: "Sports"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "sports", result.Slug)
+	tagRepo.AssertExpectations(t)
+}
+
+func TestTagUseCases_Execute_Impersonated(t *testing.T) {
+	ctx := context.Background()
+	tagRepo := &mockTagRepository{}
+
+	// The admin impersonating the user is recorded on the tag
+	actor := domainarticle.Actor{UserID: 2, OrgID: 1, ActorID: 9}
+	tagRepo.On("Create", ctx, mock.MatchedBy(func(tag *domainarticle.Tag) bool {
+		return tag.CreatedBy == 9 && tag.UpdatedBy == 9
+	})).Return(&domainarticle.Tag{ID: 4, OrgID: 1, Name: "Sport", Slug: "sport"}, nil)
+	tagRepo.On("GetByID", ctx, int64(1), int64(4)).Return(&domainarticle.Tag{ID: 4, OrgID: 1, Name: "Sport", Slug: "sport", CreatedBy: 2, UpdatedBy: 2}, nil)
+	tagRepo.On("Update", ctx, mock.MatchedBy(func(tag *domainarticle.Tag) bool {
+		return tag.CreatedBy == 2 && tag.UpdatedBy == 9
+	})).Return(&domainarticle.Tag{ID: 4, OrgID: 1, Name: "Sports", Slug: "sports"}, nil)
+
+	_, err := NewCreateTagUseCase(tagRepo).Execute(ctx, actor, dto.TagRequest{Name: "Sport"})
+	assert.NoError(t, err)
+
+	_, err = NewUpdateTagUseCase(tagRepo).Execute(ctx, actor, 4, dto.TagRequest{Name: "Sports"})
+	assert.NoError(t, err)
+
+	tagRepo.AssertExpectations(t)
+}
+
+func TestDeleteTagUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+	tagRepo := &mockTagRepository{}
+	listCache := &mockArticleListCache{}
+	uc := NewDeleteTagUseCase(tagRepo, listCache)
+
+	tagRepo.On("Delete", ctx, int64(1), int64(4)).Return(nil)
+	listCache.On("InvalidateArticleList", ctx).Return(nil)
+
+	err := uc.Execute(ctx, reader, 4)
+
+	assert.NoError(t, err)
+	tagRepo.AssertExpectations(t)
+	listCache.AssertExpectations(t)
+}
+
+func TestListTagsUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+	tagRepo := &mockTagRepository{}
+	uc := NewListTagsUseCase(tagRepo)
+
+	// Counts are taken over the articles the reader may view
+	tagRepo.On("List", ctx, readerFilter).Return([]*domainarticle.TagCount{
+		{Tag: &domainarticle.Tag{ID: 2, Name: "Football", Slug: "football"}, ArticleCount: 3},
+		{Tag: &domainarticle.Tag{ID: 1, Name: "Sport", Slug: "sport"}, ArticleCount: 0},
+	}, nil)
+
+	result, err := uc.Execute(ctx, reader)
+
+	assert.NoError(t, err)
+	assert.Len(t, result.Tags, 2)
+	assert.Equal(t, int64(3), *result.Tags[0].ArticleCount)
+	assert.Equal(t, int64(0), *result.Tags[1].ArticleCount)
+	tagRepo.AssertExpectations(t)
+}
+
+func TestSetArticleTagsUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+	repo := &mockArticleRepository{}
+	tagRepo := &mockTagRepository{}
+	listCache := &mockArticleListCache{}
+	uc := NewSetArticleTagsUseCase(repo, tagRepo, listCache)
+
+	tags := []*domainarticle.Tag{{ID: 1, OrgID: 1, Name: "Sport"}, {ID: 2, OrgID: 1, Name: "Football"}}
+	repo.On("GetByID", ctx, int64(1), int64(9)).Return(&domainarticle.Article{ID: 9, AuthorID: 2, OrgID: 1}, nil)
+	tagRepo.On("GetByIDs", ctx, int64(1), []int64{2, 1}).Return(tags, nil)
+	tagRepo.On("SetArticleTags", ctx, int64(9), []int64{2, 1}).Return(nil)
+	listCache.On("InvalidateArticleList", ctx).Return(nil)
+
+	result, err := uc.Execute(ctx, reader, 9, dto.SetArticleTagsRequest{TagIDs: []int64{2, 1, 2}})
+
+	assert.NoError(t, err)
+	assert.Len(t, result.Tags, 2)
+	repo.AssertExpectations(t)
+	tagRepo.AssertExpectations(t)
+	listCache.AssertExpectations(t)
+}
+
+func TestSetArticleTagsUseCase_Execute_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		article *domainarticle.Article
+		tags    []*domainarticle.Tag
+		wantErr error
+	}{
+		{
+			name:    "article not found",
+			wantErr: domainarticle.ErrArticleNotFound,
+		},
+		{
+			name:    "article of another author",
+			article: &domainarticle.Article{ID: 9, AuthorID: 3, OrgID: 1, Status: domainarticle.StatusPublished},
+			wantErr: domainarticle.ErrForbidden,
+		},
+		{
+			name:    "tag of another organization",
+			article: &domainarticle.Article{ID: 9, AuthorID: 2, OrgID: 1},
+			tags:    []*domainarticle.Tag{{ID: 1, OrgID: 1}},
+			wantErr: domainarticle.ErrTagNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			repo := &mockArticleRepository{}
+			tagRepo := &mockTagRepository{}
+			uc := NewSetArticleTagsUseCase(repo, tagRepo, nil)
+
+			repo.On("GetByID", ctx, int64(1), int64(9)).Return(tt.article, nil)
+			tagRepo.On("GetByIDs", ctx, int64(1), []int64{1, 5}).Return(tt.tags, nil)
+
+			result, err := uc.Execute(ctx, reader, 9, dto.SetArticleTagsRequest{TagIDs: []int64{1, 5}})
+
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Nil(t, result)
+			tagRepo.AssertNotCalled(t, "SetArticleTags", mock.Anything, mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestGetArticleTagsUseCase_Execute_HiddenDraft(t *testing.T) {
+	ctx := context.Background()
+	repo := &mockArticleRepository{}
+	tagRepo := &mockTagRepository{}
+	uc := NewGetArticleTagsUseCase(repo, tagRepo)
+
+	repo.On("GetByID", ctx, int64(1), int64(9)).Return(&domainarticle.Article{ID: 9, AuthorID: 3, OrgID: 1, Status: domainarticle.StatusDraft}, nil)
+
+	result, err := uc.Execute(ctx, reader, 9)
+
+	assert.ErrorIs(t, err, domainarticle.ErrArticleNotFound)
+	assert.Nil(t, result)
+	tagRepo.AssertNotCalled(t, "ListByArticle", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestListArticlesUseCase_Execute_ByTag(t *testing.T) {
+	ctx := context.Background()
+	repo := &mockArticleRepository{}
+	tagRepo := &mockTagRepository{}
+	uc := NewListArticlesUseCase(repo, nil, nil, tagRepo, nil)
+
+	filter := domainarticle.ListFilter{OrgID: 1, AuthorID: 2, TagID: 4}
+	tagRepo.On("GetByID", ctx, int64(1), int64(4)).Return(&domainarticle.Tag{ID: 4, OrgID: 1}, nil)
+	repo.On("List", ctx, filter, 10, 0).Return([]*domainarticle.Article{{ID: 1, OrgID: 1}}, nil)
+	repo.On("Count", ctx, filter).Return(int64(1), nil)
+
+	result, err := uc.Execute(ctx, reader, dto.ListArticlesRequest{TagID: 4})
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), result.Total)
+	repo.AssertExpectations(t)
+}
+
+func TestListArticlesUseCase_Execute_UnknownTag(t *testing.T) {
+	ctx := context.Background()
+	repo := &mockArticleRepository{}
+	tagRepo := &mockTagRepository{}
+	uc := NewListArticlesUseCase(repo, nil, nil, tagRepo, nil)
+
+	tagRepo.On("GetByID", ctx, int64(1), int64(4)).Return(nil, domainarticle.ErrTagNotFound)
+
+	result, err := uc.Execute(ctx, reader, dto.ListArticlesRequest{TagID: 4})
+
+	assert.ErrorIs(t, err, domainarticle.ErrTagNotFound)
+	assert.Nil(t, result)
+	repo.AssertNotCalled(t, "List", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
@@ -0,0 +1,83 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/rulzi/hexa-go/internal/application/article/dto"
+	domainarticle "github.com/rulzi/hexa-go/internal/domain/article"
+)
+
+// TransitionArticleUseCase handles moving an article through the publishing workflow
+type TransitionArticleUseCase struct {
+	articleRepo    domainarticle.Repository
+	articleService *domainarticle.Service
+	cache          domainarticle.Cache
+	listCache      ArticleListCache
+	searchIndex    domainarticle.SearchIndex
+}
+
+// NewTransitionArticleUseCase creates a new TransitionArticleUseCase
+func NewTransitionArticleUseCase(
+	articleRepo domainarticle.Repository,
+	articleService *domainarticle.Service,
+	cache domainarticle.Cache,
+	listCache ArticleListCache,
+	searchIndex domainarticle.SearchIndex,
+) *TransitionArticleUseCase {
+	return &TransitionArticleUseCase{
+		articleRepo:    articleRepo,
+		articleService: articleService,
+		cache:          cache,
+		listCache:      listCache,
+		searchIndex:    searchIndex,
+	}
+}
+
+// Execute moves the article to the status on behalf of the actor.
+// Articles the actor may not view are reported as not found.
+func (uc *TransitionArticleUseCase) Execute(ctx context.Context, actor domainarticle.Actor, id int64, to domainarticle.Status) (*dto.ArticleResponse, error) {
+	existingArticle, err := uc.articleRepo.GetByID(ctx, actor.OrgID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if existingArticle == nil || !actor.CanView(existingArticle) {
+		return nil, domainarticle.ErrArticleNotFound
+	}
+
+	if err := uc.articleService.Transition(actor, existingArticle, to, time.Now()); err != nil {
+		return nil, err
+	}
+
+	updatedArticle, err := uc.articleRepo.Update(ctx, existingArticle)
+	if err != nil {
+		return nil, err
+	}
+
+	// Invalidate cache, the article moves in or out of the lists
+	if uc.cache != nil {
+		_ = uc.cache.Delete(ctx, id)
+		_ = uc.cache.InvalidateList(ctx)
+	}
+	if uc.listCache != nil {
+		_ = uc.listCache.InvalidateArticleList(ctx)
+	}
+	// The status decides who finds the article
+	indexArticle(ctx, uc.searchIndex, updatedArticle)
+
+	return &dto.ArticleResponse{
+		ID:          updatedArticle.ID,
+		Title:       updatedArticle.Title,
+		Slug:        updatedArticle.Slug,
+		Content:     updatedArticle.Content,
+		AuthorID:    updatedArticle.AuthorID,
+		OrgID:       updatedArticle.OrgID,
+		CategoryID:  updatedArticle.CategoryID,
+		Status:      string(updatedArticle.Status),
+		PublishedAt: updatedArticle.PublishedAt,
+		PublishAt:   updatedArticle.PublishAt,
+		CreatedAt:   updatedArticle.CreatedAt,
+		UpdatedAt:   updatedArticle.UpdatedAt,
+	}, nil
+}
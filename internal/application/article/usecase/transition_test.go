@@ -0,0 +1,130 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	domainarticle "github.com/rulzi/hexa-go/internal/domain/article"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestTransitionArticleUseCase_Execute_Publish(t *testing.T) {
+	ctx := context.Background()
+	repo := &mockArticleRepository{}
+	service := domainarticle.NewService(repo)
+	cache := &mockArticleCache{}
+	listCache := &mockArticleListCache{}
+	index := &mockSearchIndex{}
+
+	uc := NewTransitionArticleUseCase(repo, service, cache, listCache, index)
+
+	existingArticle := &domainarticle.Article{
+		ID:        1,
+		Title:     "Test Article",
+		Content:   "Test Content",
+		AuthorID:  2,
+		OrgID:     1,
+		Status:    domainarticle.StatusInReview,
+		CreatedAt: time.Now().Add(-time.Hour),
+		UpdatedAt: time.Now().Add(-time.Hour),
+	}
+
+	repo.On("GetByID", ctx, int64(1), int64(1)).Return(existingArticle, nil)
+	repo.On("Update", ctx, mock.MatchedBy(func(a *domainarticle.Article) bool {
+		return a.Status == domainarticle.StatusPublished && a.PublishedAt != nil
+	})).Return(existingArticle, nil)
+	cache.On("Delete", ctx, int64(1)).Return(nil)
+	cache.On("InvalidateList", ctx).Return(nil)
+	listCache.On("InvalidateArticleList", ctx).Return(nil)
+	// Published articles become searchable by every reader
+	index.On("Index", ctx, existingArticle).Return(nil)
+
+	editor := domainarticle.Actor{UserID: 5, OrgID: 1, IsEditor: true}
+	result, err := uc.Execute(ctx, editor, 1, domainarticle.StatusPublished)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "published", result.Status)
+	assert.NotNil(t, result.PublishedAt)
+	repo.AssertExpectations(t)
+	cache.AssertExpectations(t)
+	listCache.AssertExpectations(t)
+	index.AssertExpectations(t)
+}
+
+func TestTransitionArticleUseCase_Execute_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		actor   domainarticle.Actor
+		status  domainarticle.Status
+		to      domainarticle.Status
+		wantErr error
+	}{
+		{
+			name:    "author can't publish",
+			actor:   domainarticle.Actor{UserID: 2, OrgID: 1},
+			status:  domainarticle.StatusInReview,
+			to:      domainarticle.StatusPublished,
+			wantErr: domainarticle.ErrForbidden,
+		},
+		{
+			name:    "draft of another author is hidden",
+			actor:   domainarticle.Actor{UserID: 3, OrgID: 1},
+			status:  domainarticle.StatusDraft,
+			to:      domainarticle.StatusInReview,
+			wantErr: domainarticle.ErrArticleNotFound,
+		},
+		{
+			name:    "draft can't be published without review",
+			actor:   domainarticle.Actor{UserID: 9, OrgID: 1, IsAdmin: true},
+			status:  domainarticle.StatusDraft,
+			to:      domainarticle.StatusPublished,
+			wantErr: domainarticle.ErrInvalidTransition,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			repo := &mockArticleRepository{}
+			uc := NewTransitionArticleUseCase(repo, domainarticle.NewService(repo), nil, nil, nil)
+
+			repo.On("GetByID", ctx, int64(1), int64(1)).Return(&domainarticle.Article{ID: 1, AuthorID: 2, OrgID: 1, Status: tt.status}, nil)
+
+			result, err := uc.Execute(ctx, tt.actor, 1, tt.to)
+
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Nil(t, result)
+			repo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestTransitionArticleUseCase_Execute_NotFound(t *testing.T) {
+	ctx := context.Background()
+	repo := &mockArticleRepository{}
+	uc := NewTransitionArticleUseCase(repo, domainarticle.NewService(repo), nil, nil, nil)
+
+	repo.On("GetByID", ctx, int64(1), int64(1)).Return(nil, nil)
+
+	result, err := uc.Execute(ctx, domainarticle.Actor{UserID: 2, OrgID: 1}, 1, domainarticle.StatusInReview)
+
+	assert.ErrorIs(t, err, domainarticle.ErrArticleNotFound)
+	assert.Nil(t, result)
+}
+
+func TestTransitionArticleUseCase_Execute_UpdateError(t *testing.T) {
+	ctx := context.Background()
+	repo := &mockArticleRepository{}
+	uc := NewTransitionArticleUseCase(repo, domainarticle.NewService(repo), nil, nil, nil)
+
+	repo.On("GetByID", ctx, int64(1), int64(1)).Return(&domainarticle.Article{ID: 1, AuthorID: 2, OrgID: 1, Status: domainarticle.StatusDraft}, nil)
+	repo.On("Update", ctx, mock.Anything).Return(nil, errors.New("database error"))
+
+	result, err := uc.Execute(ctx, domainarticle.Actor{UserID: 2, OrgID: 1}, 1, domainarticle.StatusInReview)
+
+	assert.Error(t, err)
+	assert.Nil(t, result)
+}
@@ -0,0 +1,87 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	domainarticle "github.com/rulzi/hexa-go/internal/domain/article"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListDeletedArticlesUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+	repo := &mockArticleRepository{}
+	uc := NewListDeletedArticlesUseCase(repo)
+
+	deletedAt := time.Now()
+	repo.On("ListDeleted", ctx, int64(1), 5, 10).Return([]*domainarticle.Article{
+		{ID: 3, Title: "Test Article", Content: "Test Content", AuthorID: 1, DeletedAt: &deletedAt},
+	}, nil)
+	repo.On("CountDeleted", ctx, int64(1)).Return(int64(11), nil)
+
+	result, err := uc.Execute(ctx, 1, 5, 10)
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(11), result.Total)
+	if assert.Len(t, result.Articles, 1) {
+		assert.Equal(t, int64(3), result.Articles[0].ID)
+		assert.Equal(t, &deletedAt, result.Articles[0].DeletedAt)
+	}
+	repo.AssertExpectations(t)
+}
+
+func TestRestoreArticleUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+	repo := &mockArticleRepository{}
+	cache := &mockArticleCache{}
+	listCache := &mockArticleListCache{}
+	index := &mockSearchIndex{}
+	uc := NewRestoreArticleUseCase(repo, cache, listCache, index)
+
+	restored := &domainarticle.Article{ID: 3, Title: "Test Article", AuthorID: 1}
+	repo.On("Restore", ctx, int64(1), int64(3)).Return(nil)
+	repo.On("GetByID", ctx, int64(1), int64(3)).Return(restored, nil)
+	cache.On("InvalidateList", ctx).Return(nil)
+	listCache.On("InvalidateArticleList", ctx).Return(nil)
+	index.On("Index", ctx, restored).Return(nil)
+
+	result, err := uc.Execute(ctx, 1, 3)
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(3), result.ID)
+	repo.AssertExpectations(t)
+	cache.AssertExpectations(t)
+	listCache.AssertExpectations(t)
+	index.AssertExpectations(t)
+}
+
+func TestRestoreArticleUseCase_Execute_NotInTrash(t *testing.T) {
+	ctx := context.Background()
+	repo := &mockArticleRepository{}
+	listCache := &mockArticleListCache{}
+	uc := NewRestoreArticleUseCase(repo, nil, listCache, nil)
+
+	repo.On("Restore", ctx, int64(1), int64(3)).Return(domainarticle.ErrArticleNotFound)
+
+	result, err := uc.Execute(ctx, 1, 3)
+
+	assert.Nil(t, result)
+	assert.Equal(t, domainarticle.ErrArticleNotFound, err)
+	listCache.AssertNotCalled(t, "InvalidateArticleList")
+}
+
+func TestPurgeArticlesUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+	repo := &mockArticleRepository{}
+	uc := NewPurgeArticlesUseCase(repo)
+
+	before := time.Now().AddDate(0, 0, -30)
+	repo.On("PurgeDeletedBefore", ctx, before).Return(int64(4), nil)
+
+	purged, err := uc.Execute(ctx, before)
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(4), purged)
+	repo.AssertExpectations(t)
+}
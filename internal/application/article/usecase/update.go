@@ -14,6 +14,8 @@ type UpdateArticleUseCase struct {
 	articleService *domainarticle.Service
 	cache          domainarticle.Cache
 	listCache      ArticleListCache
+	categoryRepo   domainarticle.CategoryRepository
+	searchIndex    domainarticle.SearchIndex
 }
 
 // NewUpdateArticleUseCase creates a new UpdateArticleUseCase
@@ -22,19 +24,24 @@ func NewUpdateArticleUseCase(
 	articleService *domainarticle.Service,
 	cache domainarticle.Cache,
 	listCache ArticleListCache,
+	categoryRepo domainarticle.CategoryRepository,
+	searchIndex domainarticle.SearchIndex,
 ) *UpdateArticleUseCase {
 	return &UpdateArticleUseCase{
 		articleRepo:    articleRepo,
 		articleService: articleService,
 		cache:          cache,
 		listCache:      listCache,
+		categoryRepo:   categoryRepo,
+		searchIndex:    searchIndex,
 	}
 }
 
-// Execute executes the update article use case
-func (uc *UpdateArticleUseCase) Execute(ctx context.Context, id int64, req dto.UpdateArticleRequest) (*dto.ArticleResponse, error) {
+// Execute executes the update article use case.
+// Only the author of the article or an admin may update it.
+func (uc *UpdateArticleUseCase) Execute(ctx context.Context, actor domainarticle.Actor, id int64, req dto.UpdateArticleRequest) (*dto.ArticleResponse, error) {
 	// Get existing article
-	existingArticle, err := uc.articleRepo.GetByID(ctx, id)
+	existingArticle, err := uc.articleRepo.GetByID(ctx, actor.OrgID, id)
 	if err != nil {
 		return nil, err
 	}
@@ -43,29 +50,59 @@ func (uc *UpdateArticleUseCase) Execute(ctx context.Context, id int64, req dto.U
 		return nil, domainarticle.ErrArticleNotFound
 	}
 
-	// Update fields
+	if !actor.CanModify(existingArticle) {
+		return nil, domainarticle.ErrForbidden
+	}
+
+	// Update fields, the slug follows the title and the previous one keeps resolving
+	previousSlug := existingArticle.Slug
+	titleChanged := existingArticle.Title != req.Title
 	existingArticle.Title = req.Title
 	existingArticle.Content = req.Content
+	existingArticle.CategoryID = req.CategoryID
 	existingArticle.UpdatedAt = time.Now()
+	existingArticle.UpdatedBy = actor.RecordedID()
 
 	// Validate entity
 	if err := existingArticle.Validate(); err != nil {
 		return nil, err
 	}
 
+	if err := checkCategory(ctx, uc.categoryRepo, actor.OrgID, req.CategoryID); err != nil {
+		return nil, err
+	}
+
+	if titleChanged {
+		if err := uc.articleService.AssignSlug(ctx, existingArticle); err != nil {
+			return nil, err
+		}
+	}
+
 	// Update in repository
-	updatedArticle, err := uc.articleRepo.Update(ctx, existingArticle)
+	updatedArticle, err := saveWithSlug(ctx, uc.articleService, existingArticle, uc.articleRepo.Update)
 	if err != nil {
 		return nil, err
 	}
 
+	if previousSlug != "" && previousSlug != updatedArticle.Slug {
+		if err := uc.articleRepo.AddPreviousSlug(ctx, updatedArticle.OrgID, updatedArticle.ID, previousSlug); err != nil {
+			return nil, err
+		}
+	}
+
 	response := &dto.ArticleResponse{
-		ID:        updatedArticle.ID,
-		Title:     updatedArticle.Title,
-		Content:   updatedArticle.Content,
-		AuthorID:  updatedArticle.AuthorID,
-		CreatedAt: updatedArticle.CreatedAt,
-		UpdatedAt: updatedArticle.UpdatedAt,
+		ID:          updatedArticle.ID,
+		Title:       updatedArticle.Title,
+		Slug:        updatedArticle.Slug,
+		Content:     updatedArticle.Content,
+		AuthorID:    updatedArticle.AuthorID,
+		OrgID:       updatedArticle.OrgID,
+		CategoryID:  updatedArticle.CategoryID,
+		Status:      string(updatedArticle.Status),
+		PublishedAt: updatedArticle.PublishedAt,
+		PublishAt:   updatedArticle.PublishAt,
+		CreatedAt:   updatedArticle.CreatedAt,
+		UpdatedAt:   updatedArticle.UpdatedAt,
 	}
 
 	// Invalidate cache
@@ -76,6 +113,7 @@ func (uc *UpdateArticleUseCase) Execute(ctx context.Context, id int64, req dto.U
 	if uc.listCache != nil {
 		_ = uc.listCache.InvalidateArticleList(ctx)
 	}
+	indexArticle(ctx, uc.searchIndex, updatedArticle)
 
 	return response, nil
 }
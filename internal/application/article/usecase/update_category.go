@@ -0,0 +1,69 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/rulzi/hexa-go/internal/application/article/dto"
+	domainarticle "github.com/rulzi/hexa-go/internal/domain/article"
+)
+
+// UpdateCategoryUseCase handles renaming and moving a category
+type UpdateCategoryUseCase struct {
+	categoryRepo domainarticle.CategoryRepository
+	listCache    ArticleListCache
+}
+
+// NewUpdateCategoryUseCase creates a new UpdateCategoryUseCase
+func NewUpdateCategoryUseCase(categoryRepo domainarticle.CategoryRepository, listCache ArticleListCache) *UpdateCategoryUseCase {
+	return &UpdateCategoryUseCase{
+		categoryRepo: categoryRepo,
+		listCache:    listCache,
+	}
+}
+
+// Execute executes the update category use case, the slug follows the name.
+// A category can't be moved under itself or one of its subcategories.
+func (uc *UpdateCategoryUseCase) Execute(ctx context.Context, actor domainarticle.Actor, id int64, req dto.CategoryRequest) (*dto.CategoryResponse, error) {
+	categories, err := uc.categoryRepo.List(ctx, actor.OrgID)
+	if err != nil {
+		return nil, err
+	}
+
+	var existingCategory *domainarticle.Category
+	for _, c := range categories {
+		if c.ID == id {
+			existingCategory = c
+		}
+	}
+	if existingCategory == nil {
+		return nil, domainarticle.ErrCategoryNotFound
+	}
+
+	if err := domainarticle.CheckCategoryParent(categories, id, req.ParentID); err != nil {
+		return nil, err
+	}
+
+	existingCategory.ParentID = req.ParentID
+	existingCategory.Name = req.Name
+	existingCategory.Slug = domainarticle.Slugify(req.Name)
+	existingCategory.UpdatedAt = time.Now()
+	existingCategory.UpdatedBy = actor.RecordedID()
+
+	if err := existingCategory.Validate(); err != nil {
+		return nil, err
+	}
+
+	updatedCategory, err := uc.categoryRepo.Update(ctx, existingCategory)
+	if err != nil {
+		return nil, err
+	}
+
+	// Moving a category changes the articles listed under its former and new ancestors
+	if uc.listCache != nil {
+		_ = uc.listCache.InvalidateArticleList(ctx)
+	}
+
+	response := categoryResponse(updatedCategory)
+	return &response, nil
+}
@@ -0,0 +1,46 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/rulzi/hexa-go/internal/application/article/dto"
+	domainarticle "github.com/rulzi/hexa-go/internal/domain/article"
+)
+
+// UpdateTagUseCase handles renaming a tag
+type UpdateTagUseCase struct {
+	tagRepo domainarticle.TagRepository
+}
+
+// NewUpdateTagUseCase creates a new UpdateTagUseCase
+func NewUpdateTagUseCase(tagRepo domainarticle.TagRepository) *UpdateTagUseCase {
+	return &UpdateTagUseCase{
+		tagRepo: tagRepo,
+	}
+}
+
+// Execute executes the update tag use case, the slug follows the name
+func (uc *UpdateTagUseCase) Execute(ctx context.Context, actor domainarticle.Actor, id int64, req dto.TagRequest) (*dto.TagResponse, error) {
+	existingTag, err := uc.tagRepo.GetByID(ctx, actor.OrgID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	existingTag.Name = req.Name
+	existingTag.Slug = domainarticle.Slugify(req.Name)
+	existingTag.UpdatedAt = time.Now()
+	existingTag.UpdatedBy = actor.RecordedID()
+
+	if err := existingTag.Validate(); err != nil {
+		return nil, err
+	}
+
+	updatedTag, err := uc.tagRepo.Update(ctx, existingTag)
+	if err != nil {
+		return nil, err
+	}
+
+	response := tagResponse(updatedTag)
+	return &response, nil
+}
@@ -18,7 +18,7 @@ func TestNewUpdateArticleUseCase(t *testing.T) {
 	cache := &mockArticleCache{}
 	listCache := &mockArticleListCache{}
 
-	uc := NewUpdateArticleUseCase(repo, service, cache, listCache)
+	uc := NewUpdateArticleUseCase(repo, service, cache, listCache, nil, nil)
 
 	assert.NotNil(t, uc)
 	assert.Equal(t, repo, uc.articleRepo)
@@ -34,14 +34,16 @@ func TestUpdateArticleUseCase_Execute_Success(t *testing.T) {
 	cache := &mockArticleCache{}
 	listCache := &mockArticleListCache{}
 
-	uc := NewUpdateArticleUseCase(repo, service, cache, listCache)
+	uc := NewUpdateArticleUseCase(repo, service, cache, listCache, nil, nil)
 
 	articleID := int64(1)
 	existingArticle := &domainarticle.Article{
 		ID:        articleID,
 		Title:     "Old Title",
+		Slug:      "old-title",
 		Content:   "Old Content",
 		AuthorID:  1,
+		OrgID:     1,
 		CreatedAt: time.Now().Add(-24 * time.Hour),
 		UpdatedAt: time.Now().Add(-24 * time.Hour),
 	}
@@ -54,24 +56,31 @@ func TestUpdateArticleUseCase_Execute_Success(t *testing.T) {
 	updatedArticle := &domainarticle.Article{
 		ID:        articleID,
 		Title:     req.Title,
+		Slug:      "new-title",
 		Content:   req.Content,
 		AuthorID:  existingArticle.AuthorID,
+		OrgID:     existingArticle.OrgID,
 		CreatedAt: existingArticle.CreatedAt,
 		UpdatedAt: time.Now(),
 	}
 
-	repo.On("GetByID", ctx, articleID).Return(existingArticle, nil)
-	repo.On("Update", ctx, mock.AnythingOfType("*article.Article")).Return(updatedArticle, nil)
+	repo.On("GetByID", ctx, int64(1), articleID).Return(existingArticle, nil)
+	repo.On("SlugTaken", ctx, int64(1), "new-title", articleID).Return(false, nil)
+	repo.On("Update", ctx, mock.MatchedBy(func(a *domainarticle.Article) bool {
+		return a.Slug == "new-title"
+	})).Return(updatedArticle, nil)
+	repo.On("AddPreviousSlug", ctx, int64(1), articleID, "old-title").Return(nil)
 	cache.On("Delete", ctx, articleID).Return(nil)
 	cache.On("InvalidateList", ctx).Return(nil)
 	listCache.On("InvalidateArticleList", ctx).Return(nil)
 
-	result, err := uc.Execute(ctx, articleID, req)
+	result, err := uc.Execute(ctx, domainarticle.Actor{UserID: 1, OrgID: 1}, articleID, req)
 
 	assert.NoError(t, err)
 	assert.NotNil(t, result)
 	assert.Equal(t, updatedArticle.ID, result.ID)
 	assert.Equal(t, req.Title, result.Title)
+	assert.Equal(t, "new-title", result.Slug)
 	assert.Equal(t, req.Content, result.Content)
 	assert.Equal(t, existingArticle.AuthorID, result.AuthorID)
 
@@ -80,6 +89,72 @@ func TestUpdateArticleUseCase_Execute_Success(t *testing.T) {
 	listCache.AssertExpectations(t)
 }
 
+func TestUpdateArticleUseCase_Execute_Forbidden(t *testing.T) {
+	ctx := context.Background()
+	repo := &mockArticleRepository{}
+	service := domainarticle.NewService(repo)
+	cache := &mockArticleCache{}
+	listCache := &mockArticleListCache{}
+
+	uc := NewUpdateArticleUseCase(repo, service, cache, listCache, nil, nil)
+
+	articleID := int64(1)
+	existingArticle := &domainarticle.Article{
+		ID:       articleID,
+		Title:    "Old Title",
+		Content:  "Old Content",
+		AuthorID: 1,
+		OrgID:    1,
+	}
+
+	req := dto.UpdateArticleRequest{
+		Title:   "New Title",
+		Content: "New Content",
+	}
+
+	repo.On("GetByID", ctx, int64(1), articleID).Return(existingArticle, nil)
+
+	result, err := uc.Execute(ctx, domainarticle.Actor{UserID: 2, OrgID: 1}, articleID, req)
+
+	assert.ErrorIs(t, err, domainarticle.ErrForbidden)
+	assert.Nil(t, result)
+	repo.AssertNotCalled(t, "Update")
+	cache.AssertNotCalled(t, "Delete")
+}
+
+func TestUpdateArticleUseCase_Execute_AdminCanUpdateAnyArticle(t *testing.T) {
+	ctx := context.Background()
+	repo := &mockArticleRepository{}
+	service := domainarticle.NewService(repo)
+
+	uc := NewUpdateArticleUseCase(repo, service, nil, nil, nil, nil)
+
+	articleID := int64(1)
+	existingArticle := &domainarticle.Article{
+		ID:       articleID,
+		Title:    "Old Title",
+		Content:  "Old Content",
+		AuthorID: 1,
+		OrgID:    1,
+	}
+
+	req := dto.UpdateArticleRequest{
+		Title:   "New Title",
+		Content: "New Content",
+	}
+
+	repo.On("GetByID", ctx, int64(1), articleID).Return(existingArticle, nil)
+	repo.On("SlugTaken", ctx, int64(1), "new-title", articleID).Return(false, nil)
+	repo.On("Update", ctx, mock.AnythingOfType("*article.Article")).Return(existingArticle, nil)
+
+	result, err := uc.Execute(ctx, domainarticle.Actor{UserID: 2, OrgID: 1, IsAdmin: true}, articleID, req)
+
+	assert.NoError(t, err)
+	assert.NotNil(t, result)
+	assert.Equal(t, int64(1), result.AuthorID)
+	repo.AssertExpectations(t)
+}
+
 func TestUpdateArticleUseCase_Execute_ArticleNotFound(t *testing.T) {
 	ctx := context.Background()
 	repo := &mockArticleRepository{}
@@ -87,7 +162,7 @@ func TestUpdateArticleUseCase_Execute_ArticleNotFound(t *testing.T) {
 	cache := &mockArticleCache{}
 	listCache := &mockArticleListCache{}
 
-	uc := NewUpdateArticleUseCase(repo, service, cache, listCache)
+	uc := NewUpdateArticleUseCase(repo, service, cache, listCache, nil, nil)
 
 	articleID := int64(1)
 	req := dto.UpdateArticleRequest{
@@ -95,9 +170,9 @@ func TestUpdateArticleUseCase_Execute_ArticleNotFound(t *testing.T) {
 		Content: "New Content",
 	}
 
-	repo.On("GetByID", ctx, articleID).Return(nil, nil)
+	repo.On("GetByID", ctx, int64(1), articleID).Return(nil, nil)
 
-	result, err := uc.Execute(ctx, articleID, req)
+	result, err := uc.Execute(ctx, domainarticle.Actor{UserID: 1, OrgID: 1}, articleID, req)
 
 	assert.Error(t, err)
 	assert.Equal(t, domainarticle.ErrArticleNotFound, err)
@@ -115,7 +190,7 @@ func TestUpdateArticleUseCase_Execute_GetByIDError(t *testing.T) {
 	cache := &mockArticleCache{}
 	listCache := &mockArticleListCache{}
 
-	uc := NewUpdateArticleUseCase(repo, service, cache, listCache)
+	uc := NewUpdateArticleUseCase(repo, service, cache, listCache, nil, nil)
 
 	articleID := int64(1)
 	req := dto.UpdateArticleRequest{
@@ -124,9 +199,9 @@ func TestUpdateArticleUseCase_Execute_GetByIDError(t *testing.T) {
 	}
 	repoError := errors.New("database error")
 
-	repo.On("GetByID", ctx, articleID).Return(nil, repoError)
+	repo.On("GetByID", ctx, int64(1), articleID).Return(nil, repoError)
 
-	result, err := uc.Execute(ctx, articleID, req)
+	result, err := uc.Execute(ctx, domainarticle.Actor{UserID: 1, OrgID: 1}, articleID, req)
 
 	assert.Error(t, err)
 	assert.Equal(t, repoError, err)
@@ -143,7 +218,7 @@ func TestUpdateArticleUseCase_Execute_ValidationError(t *testing.T) {
 	cache := &mockArticleCache{}
 	listCache := &mockArticleListCache{}
 
-	uc := NewUpdateArticleUseCase(repo, service, cache, listCache)
+	uc := NewUpdateArticleUseCase(repo, service, cache, listCache, nil, nil)
 
 	articleID := int64(1)
 	existingArticle := &domainarticle.Article{
@@ -151,6 +226,7 @@ func TestUpdateArticleUseCase_Execute_ValidationError(t *testing.T) {
 		Title:     "Old Title",
 		Content:   "Old Content",
 		AuthorID:  1,
+		OrgID:     1,
 		CreatedAt: time.Now(),
 		UpdatedAt: time.Now(),
 	}
@@ -177,9 +253,9 @@ func TestUpdateArticleUseCase_Execute_ValidationError(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			repo.On("GetByID", ctx, articleID).Return(existingArticle, nil)
+			repo.On("GetByID", ctx, int64(1), articleID).Return(existingArticle, nil)
 
-			result, err := uc.Execute(ctx, articleID, tt.req)
+			result, err := uc.Execute(ctx, domainarticle.Actor{UserID: 1, OrgID: 1}, articleID, tt.req)
 
 			assert.Error(t, err)
 			assert.Nil(t, result)
@@ -195,7 +271,7 @@ func TestUpdateArticleUseCase_Execute_UpdateError(t *testing.T) {
 	cache := &mockArticleCache{}
 	listCache := &mockArticleListCache{}
 
-	uc := NewUpdateArticleUseCase(repo, service, cache, listCache)
+	uc := NewUpdateArticleUseCase(repo, service, cache, listCache, nil, nil)
 
 	articleID := int64(1)
 	existingArticle := &domainarticle.Article{
@@ -203,6 +279,7 @@ func TestUpdateArticleUseCase_Execute_UpdateError(t *testing.T) {
 		Title:     "Old Title",
 		Content:   "Old Content",
 		AuthorID:  1,
+		OrgID:     1,
 		CreatedAt: time.Now(),
 		UpdatedAt: time.Now(),
 	}
@@ -214,10 +291,11 @@ func TestUpdateArticleUseCase_Execute_UpdateError(t *testing.T) {
 
 	updateError := errors.New("update error")
 
-	repo.On("GetByID", ctx, articleID).Return(existingArticle, nil)
+	repo.On("GetByID", ctx, int64(1), articleID).Return(existingArticle, nil)
+	repo.On("SlugTaken", ctx, int64(1), "new-title", articleID).Return(false, nil)
 	repo.On("Update", ctx, mock.AnythingOfType("*article.Article")).Return(nil, updateError)
 
-	result, err := uc.Execute(ctx, articleID, req)
+	result, err := uc.Execute(ctx, domainarticle.Actor{UserID: 1, OrgID: 1}, articleID, req)
 
 	assert.Error(t, err)
 	assert.Equal(t, updateError, err)
@@ -233,7 +311,7 @@ func TestUpdateArticleUseCase_Execute_WithNilCache(t *testing.T) {
 	service := domainarticle.NewService(repo)
 	listCache := &mockArticleListCache{}
 
-	uc := NewUpdateArticleUseCase(repo, service, nil, listCache)
+	uc := NewUpdateArticleUseCase(repo, service, nil, listCache, nil, nil)
 
 	articleID := int64(1)
 	existingArticle := &domainarticle.Article{
@@ -241,6 +319,7 @@ func TestUpdateArticleUseCase_Execute_WithNilCache(t *testing.T) {
 		Title:     "Old Title",
 		Content:   "Old Content",
 		AuthorID:  1,
+		OrgID:     1,
 		CreatedAt: time.Now(),
 		UpdatedAt: time.Now(),
 	}
@@ -255,15 +334,17 @@ func TestUpdateArticleUseCase_Execute_WithNilCache(t *testing.T) {
 		Title:     req.Title,
 		Content:   req.Content,
 		AuthorID:  existingArticle.AuthorID,
+		OrgID:     existingArticle.OrgID,
 		CreatedAt: existingArticle.CreatedAt,
 		UpdatedAt: time.Now(),
 	}
 
-	repo.On("GetByID", ctx, articleID).Return(existingArticle, nil)
+	repo.On("GetByID", ctx, int64(1), articleID).Return(existingArticle, nil)
+	repo.On("SlugTaken", ctx, int64(1), "new-title", articleID).Return(false, nil)
 	repo.On("Update", ctx, mock.AnythingOfType("*article.Article")).Return(updatedArticle, nil)
 	listCache.On("InvalidateArticleList", ctx).Return(nil)
 
-	result, err := uc.Execute(ctx, articleID, req)
+	result, err := uc.Execute(ctx, domainarticle.Actor{UserID: 1, OrgID: 1}, articleID, req)
 
 	assert.NoError(t, err)
 	assert.NotNil(t, result)
@@ -279,7 +360,7 @@ func TestUpdateArticleUseCase_Execute_WithNilListCache(t *testing.T) {
 	service := domainarticle.NewService(repo)
 	cache := &mockArticleCache{}
 
-	uc := NewUpdateArticleUseCase(repo, service, cache, nil)
+	uc := NewUpdateArticleUseCase(repo, service, cache, nil, nil, nil)
 
 	articleID := int64(1)
 	existingArticle := &domainarticle.Article{
@@ -287,6 +368,7 @@ func TestUpdateArticleUseCase_Execute_WithNilListCache(t *testing.T) {
 		Title:     "Old Title",
 		Content:   "Old Content",
 		AuthorID:  1,
+		OrgID:     1,
 		CreatedAt: time.Now(),
 		UpdatedAt: time.Now(),
 	}
@@ -301,16 +383,18 @@ func TestUpdateArticleUseCase_Execute_WithNilListCache(t *testing.T) {
 		Title:     req.Title,
 		Content:   req.Content,
 		AuthorID:  existingArticle.AuthorID,
+		OrgID:     existingArticle.OrgID,
 		CreatedAt: existingArticle.CreatedAt,
 		UpdatedAt: time.Now(),
 	}
 
-	repo.On("GetByID", ctx, articleID).Return(existingArticle, nil)
+	repo.On("GetByID", ctx, int64(1), articleID).Return(existingArticle, nil)
+	repo.On("SlugTaken", ctx, int64(1), "new-title", articleID).Return(false, nil)
 	repo.On("Update", ctx, mock.AnythingOfType("*article.Article")).Return(updatedArticle, nil)
 	cache.On("Delete", ctx, articleID).Return(nil)
 	cache.On("InvalidateList", ctx).Return(nil)
 
-	result, err := uc.Execute(ctx, articleID, req)
+	result, err := uc.Execute(ctx, domainarticle.Actor{UserID: 1, OrgID: 1}, articleID, req)
 
 	assert.NoError(t, err)
 	assert.NotNil(t, result)
@@ -320,3 +404,21 @@ func TestUpdateArticleUseCase_Execute_WithNilListCache(t *testing.T) {
 	cache.AssertExpectations(t)
 }
 
+func TestUpdateArticleUseCase_Execute_SameTitleKeepsSlug(t *testing.T) {
+	ctx := context.Background()
+	repo := &mockArticleRepository{}
+	uc := NewUpdateArticleUseCase(repo, domainarticle.NewService(repo), nil, nil, nil, nil)
+
+	articleID := int64(1)
+	existingArticle := &domainarticle.Article{ID: articleID, Title: "Same Title", Slug: "same-title", Content: "Old Content", AuthorID: 1, OrgID: 1}
+
+	repo.On("GetByID", ctx, int64(1), articleID).Return(existingArticle, nil)
+	repo.On("Update", ctx, mock.AnythingOfType("*article.Article")).Return(existingArticle, nil)
+
+	result, err := uc.Execute(ctx, domainarticle.Actor{UserID: 1, OrgID: 1}, articleID, dto.UpdateArticleRequest{Title: "Same Title", Content: "New Content"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "same-title", result.Slug)
+	repo.AssertNotCalled(t, "SlugTaken", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	repo.AssertNotCalled(t, "AddPreviousSlug", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
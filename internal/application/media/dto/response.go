@@ -8,12 +8,13 @@ import (
 
 // MediaResponse represents the response DTO for media
 type MediaResponse struct {
-	ID        int64     `json:"id"`
-	Name      string    `json:"name"`
-	Path      string    `json:"path"` // Storage path (relative)
-	URL       string    `json:"url"`  // Full URL to access the file
-	CreatedAt time.Time `json:"created_at"`
-	UpdatedAt time.Time `json:"updated_at"`
+	ID        int64      `json:"id"`
+	Name      string     `json:"name"`
+	Path      string     `json:"path"` // Storage path (relative)
+	URL       string     `json:"url"`  // Full URL to access the file
+	CreatedAt time.Time  `json:"created_at"`
+	UpdatedAt time.Time  `json:"updated_at"`
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
 }
 
 // BuildURL builds the full URL from base URL and path
@@ -32,8 +32,10 @@ func NewCreateMediaUseCase(
 	}
 }
 
-// Execute executes the create media use case
-func (uc *CreateMediaUseCase) Execute(ctx context.Context, filename string, file io.Reader) (*dto.MediaResponse, error) {
+// Execute executes the create media use case, ownerID is the user uploading the file,
+// actorID the user really uploading it (the admin impersonating the owner) and orgID
+// the organization the media belongs to
+func (uc *CreateMediaUseCase) Execute(ctx context.Context, ownerID, actorID, orgID int64, filename string, file io.Reader) (*dto.MediaResponse, error) {
 	// Save file to storage
 	storagePath, err := uc.storage.Save(ctx, filename, file)
 	if err != nil {
@@ -42,10 +44,14 @@ func (uc *CreateMediaUseCase) Execute(ctx context.Context, filename string, file
 
 	// Create media entity
 	newMedia := &domainmedia.Media{
+		OwnerID:   ownerID,
+		OrgID:     orgID,
 		Name:      filename,
 		Path:      storagePath,
 		CreatedAt: time.Now(),
 		UpdatedAt: time.Now(),
+		CreatedBy: actorID,
+		UpdatedBy: actorID,
 	}
 
 	// Validate entity
@@ -53,9 +53,12 @@ func TestCreateMediaUseCase_Execute_Success(t *testing.T) {
 	}
 
 	storage.On("Save", ctx, filename, file).Return(storagePath, nil)
-	repo.On("Create", ctx, mock.AnythingOfType("*media.Media")).Return(expectedMedia, nil)
+	repo.On("Create", ctx, mock.MatchedBy(func(m *domainmedia.Media) bool {
+		return m.OwnerID == 1 && m.OrgID == 2 && m.CreatedBy == 3 && m.UpdatedBy == 3
+	})).Return(expectedMedia, nil)
 
-	result, err := uc.Execute(ctx, filename, file)
+	// An admin impersonating the owner is recorded as the uploader
+	result, err := uc.Execute(ctx, int64(1), int64(3), int64(2), filename, file)
 
 	assert.NoError(t, err)
 	assert.NotNil(t, result)
@@ -84,7 +87,7 @@ func TestCreateMediaUseCase_Execute_StorageError(t *testing.T) {
 
 	storage.On("Save", ctx, filename, file).Return("", storageError)
 
-	result, err := uc.Execute(ctx, filename, file)
+	result, err := uc.Execute(ctx, int64(1), int64(1), int64(1), filename, file)
 
 	assert.Error(t, err)
 	assert.Equal(t, storageError, err)
@@ -111,7 +114,7 @@ func TestCreateMediaUseCase_Execute_ValidationError(t *testing.T) {
 	storage.On("Save", ctx, filename, file).Return(storagePath, nil)
 	storage.On("Delete", ctx, storagePath).Return(nil)
 
-	result, err := uc.Execute(ctx, filename, file)
+	result, err := uc.Execute(ctx, int64(1), int64(1), int64(1), filename, file)
 
 	assert.Error(t, err)
 	assert.Nil(t, result)
@@ -139,7 +142,7 @@ func TestCreateMediaUseCase_Execute_RepositoryError(t *testing.T) {
 	repo.On("Create", ctx, mock.AnythingOfType("*media.Media")).Return(nil, repoError)
 	storage.On("Delete", ctx, storagePath).Return(nil)
 
-	result, err := uc.Execute(ctx, filename, file)
+	result, err := uc.Execute(ctx, int64(1), int64(1), int64(1), filename, file)
 
 	assert.Error(t, err)
 	assert.Equal(t, repoError, err)
@@ -173,7 +176,7 @@ func TestCreateMediaUseCase_Execute_URIBuilding(t *testing.T) {
 	storage.On("Save", ctx, filename, file).Return(storagePath, nil)
 	repo.On("Create", ctx, mock.AnythingOfType("*media.Media")).Return(expectedMedia, nil)
 
-	result, err := uc.Execute(ctx, filename, file)
+	result, err := uc.Execute(ctx, int64(1), int64(1), int64(1), filename, file)
 
 	assert.NoError(t, err)
 	assert.NotNil(t, result)
@@ -207,7 +210,7 @@ func TestCreateMediaUseCase_Execute_BaseURLWithTrailingSlash(t *testing.T) {
 	storage.On("Save", ctx, filename, file).Return(storagePath, nil)
 	repo.On("Create", ctx, mock.AnythingOfType("*media.Media")).Return(expectedMedia, nil)
 
-	result, err := uc.Execute(ctx, filename, file)
+	result, err := uc.Execute(ctx, int64(1), int64(1), int64(1), filename, file)
 
 	assert.NoError(t, err)
 	assert.NotNil(t, result)
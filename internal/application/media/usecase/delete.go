@@ -6,24 +6,23 @@ import (
 	domainmedia "github.com/rulzi/hexa-go/internal/domain/media"
 )
 
-// DeleteMediaUseCase handles deleting a media
+// DeleteMediaUseCase handles moving a media to the trash.
+// The file stays in storage until the media is purged.
 type DeleteMediaUseCase struct {
 	mediaRepo domainmedia.Repository
-	storage   domainmedia.Storage
 }
 
 // NewDeleteMediaUseCase creates a new DeleteMediaUseCase
-func NewDeleteMediaUseCase(mediaRepo domainmedia.Repository, storage domainmedia.Storage) *DeleteMediaUseCase {
+func NewDeleteMediaUseCase(mediaRepo domainmedia.Repository) *DeleteMediaUseCase {
 	return &DeleteMediaUseCase{
 		mediaRepo: mediaRepo,
-		storage:   storage,
 	}
 }
 
 // Execute executes the delete media use case
-func (uc *DeleteMediaUseCase) Execute(ctx context.Context, id int64) error {
+func (uc *DeleteMediaUseCase) Execute(ctx context.Context, orgID, id int64) error {
 	// Check if media exists
-	existingMedia, err := uc.mediaRepo.GetByID(ctx, id)
+	existingMedia, err := uc.mediaRepo.GetByID(ctx, orgID, id)
 	if err != nil {
 		return err
 	}
@@ -32,13 +31,8 @@ func (uc *DeleteMediaUseCase) Execute(ctx context.Context, id int64) error {
 		return domainmedia.ErrMediaNotFound
 	}
 
-	// Delete file from storage
-	if err := uc.storage.Delete(ctx, existingMedia.Path); err != nil {
-		return err
-	}
-
-	// Delete media from database
-	if err := uc.mediaRepo.Delete(ctx, id); err != nil {
+	// Move media to the trash
+	if err := uc.mediaRepo.Delete(ctx, orgID, id); err != nil {
 		return err
 	}
 
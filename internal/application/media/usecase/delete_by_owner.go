@@ -0,0 +1,68 @@
+package usecase
+
+import (
+	"context"
+
+	domainmedia "github.com/rulzi/hexa-go/internal/domain/media"
+)
+
+// DeleteMediaByOwnerUseCase permanently deletes every media uploaded by a user, including the trash,
+// and removes their files from storage. It is used when the owner's account or their data in an
+// organization is erased.
+type DeleteMediaByOwnerUseCase struct {
+	mediaRepo domainmedia.Repository
+	storage   domainmedia.Storage
+}
+
+// NewDeleteMediaByOwnerUseCase creates a new DeleteMediaByOwnerUseCase
+func NewDeleteMediaByOwnerUseCase(mediaRepo domainmedia.Repository, storage domainmedia.Storage) *DeleteMediaByOwnerUseCase {
+	return &DeleteMediaByOwnerUseCase{
+		mediaRepo: mediaRepo,
+		storage:   storage,
+	}
+}
+
+// Execute deletes the media of the owner in orgID and returns how many were removed,
+// orgID zero deletes them in every organization.
+// Media go through the trash so they are removed the same way as purged media.
+func (uc *DeleteMediaByOwnerUseCase) Execute(ctx context.Context, orgID, ownerID int64) (int64, error) {
+	var deleted int64
+	// Media of other organizations or that could not be deleted stay in the result set, skip past them
+	skipped := 0
+	for {
+		mediaList, err := uc.mediaRepo.ListAllByOwner(ctx, ownerID, purgeBatchSize, skipped)
+		if err != nil {
+			return deleted, err
+		}
+
+		for _, m := range mediaList {
+			if orgID != 0 && m.OrgID != orgID {
+				skipped++
+				continue
+			}
+			if m.DeletedAt == nil {
+				if err := uc.mediaRepo.Delete(ctx, m.OrgID, m.ID); err != nil && err != domainmedia.ErrMediaNotFound {
+					return deleted, err
+				}
+			}
+
+			if err := uc.mediaRepo.Purge(ctx, m.ID); err != nil {
+				if err == domainmedia.ErrMediaNotFound {
+					// Restored or purged concurrently
+					skipped++
+					continue
+				}
+				return deleted, err
+			}
+			deleted++
+
+			if err := uc.storage.Delete(ctx, m.Path); err != nil {
+				return deleted, err
+			}
+		}
+
+		if len(mediaList) < purgeBatchSize {
+			return deleted, nil
+		}
+	}
+}
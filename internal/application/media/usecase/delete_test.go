@@ -12,21 +12,18 @@ import (
 
 func TestNewDeleteMediaUseCase(t *testing.T) {
 	repo := &mockMediaRepository{}
-	storage := &mockMediaStorage{}
 
-	uc := NewDeleteMediaUseCase(repo, storage)
+	uc := NewDeleteMediaUseCase(repo)
 
 	assert.NotNil(t, uc)
 	assert.Equal(t, repo, uc.mediaRepo)
-	assert.Equal(t, storage, uc.storage)
 }
 
 func TestDeleteMediaUseCase_Execute_Success(t *testing.T) {
 	ctx := context.Background()
 	repo := &mockMediaRepository{}
-	storage := &mockMediaStorage{}
 
-	uc := NewDeleteMediaUseCase(repo, storage)
+	uc := NewDeleteMediaUseCase(repo)
 
 	mediaID := int64(1)
 	existingMedia := &domainmedia.Media{
@@ -37,96 +34,59 @@ func TestDeleteMediaUseCase_Execute_Success(t *testing.T) {
 		UpdatedAt: time.Now(),
 	}
 
-	repo.On("GetByID", ctx, mediaID).Return(existingMedia, nil)
-	storage.On("Delete", ctx, existingMedia.Path).Return(nil)
-	repo.On("Delete", ctx, mediaID).Return(nil)
+	repo.On("GetByID", ctx, int64(1), mediaID).Return(existingMedia, nil)
+	repo.On("Delete", ctx, int64(1), mediaID).Return(nil)
 
-	err := uc.Execute(ctx, mediaID)
+	err := uc.Execute(ctx, 1, mediaID)
 
 	assert.NoError(t, err)
 	repo.AssertExpectations(t)
-	storage.AssertExpectations(t)
 }
 
 func TestDeleteMediaUseCase_Execute_MediaNotFound(t *testing.T) {
 	ctx := context.Background()
 	repo := &mockMediaRepository{}
-	storage := &mockMediaStorage{}
 
-	uc := NewDeleteMediaUseCase(repo, storage)
+	uc := NewDeleteMediaUseCase(repo)
 
 	mediaID := int64(1)
 
-	repo.On("GetByID", ctx, mediaID).Return(nil, nil)
+	repo.On("GetByID", ctx, int64(1), mediaID).Return(nil, nil)
 
-	err := uc.Execute(ctx, mediaID)
+	err := uc.Execute(ctx, 1, mediaID)
 
 	assert.Error(t, err)
 	assert.Equal(t, domainmedia.ErrMediaNotFound, err)
 
 	repo.AssertExpectations(t)
 	repo.AssertNotCalled(t, "Delete")
-	storage.AssertNotCalled(t, "Delete")
 }
 
 func TestDeleteMediaUseCase_Execute_GetByIDError(t *testing.T) {
 	ctx := context.Background()
 	repo := &mockMediaRepository{}
-	storage := &mockMediaStorage{}
 
-	uc := NewDeleteMediaUseCase(repo, storage)
+	uc := NewDeleteMediaUseCase(repo)
 
 	mediaID := int64(1)
 	repoError := errors.New("database error")
 
-	repo.On("GetByID", ctx, mediaID).Return(nil, repoError)
+	repo.On("GetByID", ctx, int64(1), mediaID).Return(nil, repoError)
 
-	err := uc.Execute(ctx, mediaID)
+	err := uc.Execute(ctx, 1, mediaID)
 
 	assert.Error(t, err)
 	assert.Equal(t, repoError, err)
 
 	repo.AssertExpectations(t)
 	repo.AssertNotCalled(t, "Delete")
-	storage.AssertNotCalled(t, "Delete")
-}
-
-func TestDeleteMediaUseCase_Execute_StorageDeleteError(t *testing.T) {
-	ctx := context.Background()
-	repo := &mockMediaRepository{}
-	storage := &mockMediaStorage{}
-
-	uc := NewDeleteMediaUseCase(repo, storage)
-
-	mediaID := int64(1)
-	existingMedia := &domainmedia.Media{
-		ID:        mediaID,
-		Name:      "test.jpg",
-		Path:      "2025/12/19/test.jpg",
-		CreatedAt: time.Now(),
-		UpdatedAt: time.Now(),
-	}
-	storageError := errors.New("storage delete error")
-
-	repo.On("GetByID", ctx, mediaID).Return(existingMedia, nil)
-	storage.On("Delete", ctx, existingMedia.Path).Return(storageError)
-
-	err := uc.Execute(ctx, mediaID)
-
-	assert.Error(t, err)
-	assert.Equal(t, storageError, err)
-
-	repo.AssertExpectations(t)
-	storage.AssertExpectations(t)
-	repo.AssertNotCalled(t, "Delete")
 }
 
 func TestDeleteMediaUseCase_Execute_RepositoryDeleteError(t *testing.T) {
 	ctx := context.Background()
 	repo := &mockMediaRepository{}
-	storage := &mockMediaStorage{}
 
-	uc := NewDeleteMediaUseCase(repo, storage)
+	uc := NewDeleteMediaUseCase(repo)
 
 	mediaID := int64(1)
 	existingMedia := &domainmedia.Media{
@@ -138,16 +98,14 @@ func TestDeleteMediaUseCase_Execute_RepositoryDeleteError(t *testing.T) {
 	}
 	repoError := errors.New("database delete error")
 
-	repo.On("GetByID", ctx, mediaID).Return(existingMedia, nil)
-	storage.On("Delete", ctx, existingMedia.Path).Return(nil)
-	repo.On("Delete", ctx, mediaID).Return(repoError)
+	repo.On("GetByID", ctx, int64(1), mediaID).Return(existingMedia, nil)
+	repo.On("Delete", ctx, int64(1), mediaID).Return(repoError)
 
-	err := uc.Execute(ctx, mediaID)
+	err := uc.Execute(ctx, 1, mediaID)
 
 	assert.Error(t, err)
 	assert.Equal(t, repoError, err)
 
 	repo.AssertExpectations(t)
-	storage.AssertExpectations(t)
 }
 
@@ -22,9 +22,9 @@ func NewGetMediaUseCase(mediaRepo domainmedia.Repository, baseURL string) *GetMe
 }
 
 // Execute executes the get media use case
-func (uc *GetMediaUseCase) Execute(ctx context.Context, id int64) (*dto.MediaResponse, error) {
+func (uc *GetMediaUseCase) Execute(ctx context.Context, orgID, id int64) (*dto.MediaResponse, error) {
 	// Get from repository
-	mediaEntity, err := uc.mediaRepo.GetByID(ctx, id)
+	mediaEntity, err := uc.mediaRepo.GetByID(ctx, orgID, id)
 	if err != nil {
 		return nil, err
 	}
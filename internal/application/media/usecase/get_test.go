@@ -41,9 +41,9 @@ func TestGetMediaUseCase_Execute_Success(t *testing.T) {
 		UpdatedAt: time.Now(),
 	}
 
-	repo.On("GetByID", ctx, mediaID).Return(mediaEntity, nil)
+	repo.On("GetByID", ctx, int64(1), mediaID).Return(mediaEntity, nil)
 
-	result, err := uc.Execute(ctx, mediaID)
+	result, err := uc.Execute(ctx, 1, mediaID)
 
 	assert.NoError(t, err)
 	assert.NotNil(t, result)
@@ -66,9 +66,9 @@ func TestGetMediaUseCase_Execute_MediaNotFound(t *testing.T) {
 
 	mediaID := int64(1)
 
-	repo.On("GetByID", ctx, mediaID).Return(nil, nil)
+	repo.On("GetByID", ctx, int64(1), mediaID).Return(nil, nil)
 
-	result, err := uc.Execute(ctx, mediaID)
+	result, err := uc.Execute(ctx, 1, mediaID)
 
 	assert.Error(t, err)
 	assert.Equal(t, domainmedia.ErrMediaNotFound, err)
@@ -87,9 +87,9 @@ func TestGetMediaUseCase_Execute_RepositoryError(t *testing.T) {
 	mediaID := int64(1)
 	repoError := errors.New("database error")
 
-	repo.On("GetByID", ctx, mediaID).Return(nil, repoError)
+	repo.On("GetByID", ctx, int64(1), mediaID).Return(nil, repoError)
 
-	result, err := uc.Execute(ctx, mediaID)
+	result, err := uc.Execute(ctx, 1, mediaID)
 
 	assert.Error(t, err)
 	assert.Equal(t, repoError, err)
@@ -114,9 +114,9 @@ func TestGetMediaUseCase_Execute_URIBuilding(t *testing.T) {
 		UpdatedAt: time.Now(),
 	}
 
-	repo.On("GetByID", ctx, mediaID).Return(mediaEntity, nil)
+	repo.On("GetByID", ctx, int64(1), mediaID).Return(mediaEntity, nil)
 
-	result, err := uc.Execute(ctx, mediaID)
+	result, err := uc.Execute(ctx, 1, mediaID)
 
 	assert.NoError(t, err)
 	assert.NotNil(t, result)
@@ -143,9 +143,9 @@ func TestGetMediaUseCase_Execute_PathWithoutLeadingSlash(t *testing.T) {
 		UpdatedAt: time.Now(),
 	}
 
-	repo.On("GetByID", ctx, mediaID).Return(mediaEntity, nil)
+	repo.On("GetByID", ctx, int64(1), mediaID).Return(mediaEntity, nil)
 
-	result, err := uc.Execute(ctx, mediaID)
+	result, err := uc.Execute(ctx, 1, mediaID)
 
 	assert.NoError(t, err)
 	assert.NotNil(t, result)
@@ -21,8 +21,8 @@ func NewListMediaUseCase(mediaRepo domainmedia.Repository, baseURL string) *List
 	}
 }
 
-// Execute executes the list media use case
-func (uc *ListMediaUseCase) Execute(ctx context.Context, limit, offset int) (*dto.ListMediaResponse, error) {
+// Execute executes the list media use case for the media of an organization
+func (uc *ListMediaUseCase) Execute(ctx context.Context, orgID int64, limit, offset int) (*dto.ListMediaResponse, error) {
 	// Default pagination
 	if limit <= 0 {
 		limit = 10
@@ -32,13 +32,13 @@ func (uc *ListMediaUseCase) Execute(ctx context.Context, limit, offset int) (*dt
 	}
 
 	// Get media from repository
-	mediaList, err := uc.mediaRepo.List(ctx, limit, offset)
+	mediaList, err := uc.mediaRepo.List(ctx, orgID, limit, offset)
 	if err != nil {
 		return nil, err
 	}
 
 	// Get total count
-	total, err := uc.mediaRepo.Count(ctx)
+	total, err := uc.mediaRepo.Count(ctx, orgID)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,63 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/rulzi/hexa-go/internal/application/media/dto"
+	domainmedia "github.com/rulzi/hexa-go/internal/domain/media"
+)
+
+// ListDeletedMediaUseCase handles listing the media in the trash
+type ListDeletedMediaUseCase struct {
+	mediaRepo domainmedia.Repository
+	baseURL   string
+}
+
+// NewListDeletedMediaUseCase creates a new ListDeletedMediaUseCase
+func NewListDeletedMediaUseCase(mediaRepo domainmedia.Repository, baseURL string) *ListDeletedMediaUseCase {
+	return &ListDeletedMediaUseCase{
+		mediaRepo: mediaRepo,
+		baseURL:   baseURL,
+	}
+}
+
+// Execute executes the list deleted media use case for the trash of an organization
+func (uc *ListDeletedMediaUseCase) Execute(ctx context.Context, orgID int64, limit, offset int) (*dto.ListMediaResponse, error) {
+	// Default pagination
+	if limit <= 0 {
+		limit = 10
+	}
+	if offset < 0 {
+		offset = 0
+	}
+
+	mediaList, err := uc.mediaRepo.ListDeleted(ctx, orgID, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+
+	total, err := uc.mediaRepo.CountDeleted(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+
+	mediaResponses := make([]dto.MediaResponse, len(mediaList))
+	for i, m := range mediaList {
+		mediaResponses[i] = dto.MediaResponse{
+			ID:        m.ID,
+			Name:      m.Name,
+			Path:      m.Path,
+			URL:       dto.BuildURL(uc.baseURL, m.Path),
+			CreatedAt: m.CreatedAt,
+			UpdatedAt: m.UpdatedAt,
+			DeletedAt: m.DeletedAt,
+		}
+	}
+
+	return &dto.ListMediaResponse{
+		Media:  mediaResponses,
+		Total:  total,
+		Limit:  limit,
+		Offset: offset,
+	}, nil
+}
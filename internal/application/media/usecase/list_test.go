@@ -52,10 +52,10 @@ func TestListMediaUseCase_Execute_Success(t *testing.T) {
 	}
 	total := int64(2)
 
-	repo.On("List", ctx, limit, offset).Return(mediaList, nil)
-	repo.On("Count", ctx).Return(total, nil)
+	repo.On("List", ctx, int64(1), limit, offset).Return(mediaList, nil)
+	repo.On("Count", ctx, int64(1)).Return(total, nil)
 
-	result, err := uc.Execute(ctx, limit, offset)
+	result, err := uc.Execute(ctx, 1, limit, offset)
 
 	assert.NoError(t, err)
 	assert.NotNil(t, result)
@@ -81,10 +81,10 @@ func TestListMediaUseCase_Execute_DefaultPagination(t *testing.T) {
 	mediaList := []*domainmedia.Media{}
 	total := int64(0)
 
-	repo.On("List", ctx, 10, 0).Return(mediaList, nil)
-	repo.On("Count", ctx).Return(total, nil)
+	repo.On("List", ctx, int64(1), 10, 0).Return(mediaList, nil)
+	repo.On("Count", ctx, int64(1)).Return(total, nil)
 
-	result, err := uc.Execute(ctx, -1, -1)
+	result, err := uc.Execute(ctx, 1, -1, -1)
 
 	assert.NoError(t, err)
 	assert.NotNil(t, result)
@@ -105,9 +105,9 @@ func TestListMediaUseCase_Execute_ListError(t *testing.T) {
 	offset := 0
 	listError := errors.New("list error")
 
-	repo.On("List", ctx, limit, offset).Return(nil, listError)
+	repo.On("List", ctx, int64(1), limit, offset).Return(nil, listError)
 
-	result, err := uc.Execute(ctx, limit, offset)
+	result, err := uc.Execute(ctx, 1, limit, offset)
 
 	assert.Error(t, err)
 	assert.Equal(t, listError, err)
@@ -137,10 +137,10 @@ func TestListMediaUseCase_Execute_CountError(t *testing.T) {
 	}
 	countError := errors.New("count error")
 
-	repo.On("List", ctx, limit, offset).Return(mediaList, nil)
-	repo.On("Count", ctx).Return(int64(0), countError)
+	repo.On("List", ctx, int64(1), limit, offset).Return(mediaList, nil)
+	repo.On("Count", ctx, int64(1)).Return(int64(0), countError)
 
-	result, err := uc.Execute(ctx, limit, offset)
+	result, err := uc.Execute(ctx, 1, limit, offset)
 
 	assert.Error(t, err)
 	assert.Equal(t, countError, err)
@@ -161,10 +161,10 @@ func TestListMediaUseCase_Execute_EmptyList(t *testing.T) {
 	mediaList := []*domainmedia.Media{}
 	total := int64(0)
 
-	repo.On("List", ctx, limit, offset).Return(mediaList, nil)
-	repo.On("Count", ctx).Return(total, nil)
+	repo.On("List", ctx, int64(1), limit, offset).Return(mediaList, nil)
+	repo.On("Count", ctx, int64(1)).Return(total, nil)
 
-	result, err := uc.Execute(ctx, limit, offset)
+	result, err := uc.Execute(ctx, 1, limit, offset)
 
 	assert.NoError(t, err)
 	assert.NotNil(t, result)
@@ -194,10 +194,10 @@ func TestListMediaUseCase_Execute_URIBuilding(t *testing.T) {
 	}
 	total := int64(1)
 
-	repo.On("List", ctx, limit, offset).Return(mediaList, nil)
-	repo.On("Count", ctx).Return(total, nil)
+	repo.On("List", ctx, int64(1), limit, offset).Return(mediaList, nil)
+	repo.On("Count", ctx, int64(1)).Return(total, nil)
 
-	result, err := uc.Execute(ctx, limit, offset)
+	result, err := uc.Execute(ctx, 1, limit, offset)
 
 	assert.NoError(t, err)
 	assert.NotNil(t, result)
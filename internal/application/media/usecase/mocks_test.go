@@ -3,6 +3,7 @@ package usecase
 import (
 	"context"
 	"io"
+	"time"
 
 	domainmedia "github.com/rulzi/hexa-go/internal/domain/media"
 	"github.com/stretchr/testify/mock"
@@ -21,8 +22,8 @@ func (m *mockMediaRepository) Create(ctx context.Context, media *domainmedia.Med
 	return args.Get(0).(*domainmedia.Media), args.Error(1)
 }
 
-func (m *mockMediaRepository) GetByID(ctx context.Context, id int64) (*domainmedia.Media, error) {
-	args := m.Called(ctx, id)
+func (m *mockMediaRepository) GetByID(ctx context.Context, orgID, id int64) (*domainmedia.Media, error) {
+	args := m.Called(ctx, orgID, id)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
@@ -37,21 +38,21 @@ func (m *mockMediaRepository) Update(ctx context.Context, media *domainmedia.Med
 	return args.Get(0).(*domainmedia.Media), args.Error(1)
 }
 
-func (m *mockMediaRepository) Delete(ctx context.Context, id int64) error {
-	args := m.Called(ctx, id)
+func (m *mockMediaRepository) Delete(ctx context.Context, orgID, id int64) error {
+	args := m.Called(ctx, orgID, id)
 	return args.Error(0)
 }
 
-func (m *mockMediaRepository) List(ctx context.Context, limit, offset int) ([]*domainmedia.Media, error) {
-	args := m.Called(ctx, limit, offset)
+func (m *mockMediaRepository) List(ctx context.Context, orgID int64, limit, offset int) ([]*domainmedia.Media, error) {
+	args := m.Called(ctx, orgID, limit, offset)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
 	return args.Get(0).([]*domainmedia.Media), args.Error(1)
 }
 
-func (m *mockMediaRepository) Count(ctx context.Context) (int64, error) {
-	args := m.Called(ctx)
+func (m *mockMediaRepository) Count(ctx context.Context, orgID int64) (int64, error) {
+	args := m.Called(ctx, orgID)
 	return args.Get(0).(int64), args.Error(1)
 }
 
@@ -77,3 +78,55 @@ func (m *mockMediaStorage) Get(ctx context.Context, path string) (io.ReadCloser,
 	}
 	return args.Get(0).(io.ReadCloser), args.Error(1)
 }
+
+func (m *mockMediaRepository) GetByPath(ctx context.Context, path string) (*domainmedia.Media, error) {
+	args := m.Called(ctx, path)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domainmedia.Media), args.Error(1)
+}
+
+func (m *mockMediaRepository) Restore(ctx context.Context, orgID, id int64) error {
+	args := m.Called(ctx, orgID, id)
+	return args.Error(0)
+}
+
+func (m *mockMediaRepository) ListDeleted(ctx context.Context, orgID int64, limit, offset int) ([]*domainmedia.Media, error) {
+	args := m.Called(ctx, orgID, limit, offset)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domainmedia.Media), args.Error(1)
+}
+
+func (m *mockMediaRepository) CountDeleted(ctx context.Context, orgID int64) (int64, error) {
+	args := m.Called(ctx, orgID)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *mockMediaRepository) ListDeletedBefore(ctx context.Context, before time.Time, limit int) ([]*domainmedia.Media, error) {
+	args := m.Called(ctx, before, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domainmedia.Media), args.Error(1)
+}
+
+func (m *mockMediaRepository) Purge(ctx context.Context, id int64) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *mockMediaRepository) ListAllByOwner(ctx context.Context, ownerID int64, limit, offset int) ([]*domainmedia.Media, error) {
+	args := m.Called(ctx, ownerID, limit, offset)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domainmedia.Media), args.Error(1)
+}
+
+func (m *mockMediaRepository) ReassignOwner(ctx context.Context, orgID, fromID, toID int64) (int64, error) {
+	args := m.Called(ctx, orgID, fromID, toID)
+	return args.Get(0).(int64), args.Error(1)
+}
@@ -0,0 +1,39 @@
+package usecase
+
+import (
+	"context"
+	"io"
+
+	domainmedia "github.com/rulzi/hexa-go/internal/domain/media"
+)
+
+// OpenMediaFileUseCase handles opening the stored file of a media for public download
+type OpenMediaFileUseCase struct {
+	mediaRepo domainmedia.Repository
+	storage   domainmedia.Storage
+}
+
+// NewOpenMediaFileUseCase creates a new OpenMediaFileUseCase
+func NewOpenMediaFileUseCase(mediaRepo domainmedia.Repository, storage domainmedia.Storage) *OpenMediaFileUseCase {
+	return &OpenMediaFileUseCase{
+		mediaRepo: mediaRepo,
+		storage:   storage,
+	}
+}
+
+// Execute opens the file stored at path. Only files of media that are not in the trash are opened,
+// the file of a trashed media stays in storage until it is purged but is no longer reachable.
+// The caller closes the returned file.
+func (uc *OpenMediaFileUseCase) Execute(ctx context.Context, path string) (*domainmedia.Media, io.ReadCloser, error) {
+	mediaEntity, err := uc.mediaRepo.GetByPath(ctx, path)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	file, err := uc.storage.Get(ctx, mediaEntity.Path)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return mediaEntity, file, nil
+}
@@ -0,0 +1,46 @@
+package usecase
+
+import (
+	"context"
+	"io"
+	"strings"
+	"testing"
+
+	domainmedia "github.com/rulzi/hexa-go/internal/domain/media"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOpenMediaFileUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("live media", func(t *testing.T) {
+		repo := &mockMediaRepository{}
+		storage := &mockMediaStorage{}
+		uc := NewOpenMediaFileUseCase(repo, storage)
+
+		repo.On("GetByPath", ctx, "2025/12/19/test.jpg").Return(&domainmedia.Media{ID: 1, Path: "2025/12/19/test.jpg"}, nil)
+		storage.On("Get", ctx, "2025/12/19/test.jpg").Return(io.NopCloser(strings.NewReader("image")), nil)
+
+		mediaEntity, file, err := uc.Execute(ctx, "2025/12/19/test.jpg")
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(1), mediaEntity.ID)
+		content, _ := io.ReadAll(file)
+		assert.Equal(t, "image", string(content))
+	})
+
+	t.Run("media in the trash", func(t *testing.T) {
+		repo := &mockMediaRepository{}
+		storage := &mockMediaStorage{}
+		uc := NewOpenMediaFileUseCase(repo, storage)
+
+		repo.On("GetByPath", ctx, "2025/12/19/test.jpg").Return(nil, domainmedia.ErrMediaNotFound)
+
+		mediaEntity, file, err := uc.Execute(ctx, "2025/12/19/test.jpg")
+
+		assert.Equal(t, domainmedia.ErrMediaNotFound, err)
+		assert.Nil(t, mediaEntity)
+		assert.Nil(t, file)
+		storage.AssertNotCalled(t, "Get")
+	})
+}
@@ -0,0 +1,77 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	domainmedia "github.com/rulzi/hexa-go/internal/domain/media"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestDeleteMediaByOwnerUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+	repo := &mockMediaRepository{}
+	storage := &mockMediaStorage{}
+	uc := NewDeleteMediaByOwnerUseCase(repo, storage)
+
+	deletedAt := time.Now()
+	repo.On("ListAllByOwner", ctx, int64(7), purgeBatchSize, 0).Return([]*domainmedia.Media{
+		{ID: 1, OwnerID: 7, OrgID: 4, Path: "2025/12/19/a.jpg"},
+		{ID: 2, OwnerID: 7, Path: "2025/12/19/b.jpg", DeletedAt: &deletedAt},
+		{ID: 3, OwnerID: 7, Path: "2025/12/19/c.jpg", DeletedAt: &deletedAt},
+	}, nil)
+	repo.On("Delete", ctx, int64(4), int64(1)).Return(nil)
+	repo.On("Purge", ctx, int64(1)).Return(nil)
+	repo.On("Purge", ctx, int64(2)).Return(nil)
+	repo.On("Purge", ctx, int64(3)).Return(domainmedia.ErrMediaNotFound)
+	storage.On("Delete", ctx, "2025/12/19/a.jpg").Return(nil)
+	storage.On("Delete", ctx, "2025/12/19/b.jpg").Return(nil)
+
+	deleted, err := uc.Execute(ctx, 0, 7)
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), deleted)
+	repo.AssertExpectations(t)
+	storage.AssertExpectations(t)
+	// Media 2 was already in the trash
+	repo.AssertNotCalled(t, "Delete", ctx, mock.Anything, int64(2))
+	storage.AssertNotCalled(t, "Delete", ctx, "2025/12/19/c.jpg")
+}
+
+func TestDeleteMediaByOwnerUseCase_Execute_Organization(t *testing.T) {
+	ctx := context.Background()
+	repo := &mockMediaRepository{}
+	storage := &mockMediaStorage{}
+	uc := NewDeleteMediaByOwnerUseCase(repo, storage)
+
+	repo.On("ListAllByOwner", ctx, int64(7), purgeBatchSize, 0).Return([]*domainmedia.Media{
+		{ID: 1, OwnerID: 7, OrgID: 2, Path: "2025/12/19/a.jpg"},
+		{ID: 2, OwnerID: 7, OrgID: 3, Path: "2025/12/19/b.jpg"},
+	}, nil)
+	repo.On("Delete", ctx, int64(2), int64(1)).Return(nil)
+	repo.On("Purge", ctx, int64(1)).Return(nil)
+	storage.On("Delete", ctx, "2025/12/19/a.jpg").Return(nil)
+
+	deleted, err := uc.Execute(ctx, 2, 7)
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), deleted)
+	repo.AssertExpectations(t)
+	repo.AssertNotCalled(t, "Purge", ctx, int64(2))
+}
+
+func TestReassignMediaUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+	repo := &mockMediaRepository{}
+	uc := NewReassignMediaUseCase(repo)
+
+	repo.On("ReassignOwner", ctx, int64(0), int64(7), int64(1)).Return(int64(4), nil)
+
+	moved, err := uc.Execute(ctx, 0, 7, 1)
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(4), moved)
+	repo.AssertExpectations(t)
+}
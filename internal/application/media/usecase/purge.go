@@ -0,0 +1,58 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	domainmedia "github.com/rulzi/hexa-go/internal/domain/media"
+)
+
+// purgeBatchSize is the number of trashed media loaded per round while purging
+const purgeBatchSize = 100
+
+// PurgeMediaUseCase permanently deletes the media that stayed in the trash past the retention period
+// and removes their files from storage
+type PurgeMediaUseCase struct {
+	mediaRepo domainmedia.Repository
+	storage   domainmedia.Storage
+}
+
+// NewPurgeMediaUseCase creates a new PurgeMediaUseCase
+func NewPurgeMediaUseCase(mediaRepo domainmedia.Repository, storage domainmedia.Storage) *PurgeMediaUseCase {
+	return &PurgeMediaUseCase{
+		mediaRepo: mediaRepo,
+		storage:   storage,
+	}
+}
+
+// Execute purges the media moved to the trash before deletedBefore and returns how many were removed.
+// The row goes first, so a media restored in the meantime never loses its file. A file that fails to
+// delete is left behind as an orphan and the error is returned.
+func (uc *PurgeMediaUseCase) Execute(ctx context.Context, deletedBefore time.Time) (int64, error) {
+	var purged int64
+	for {
+		mediaList, err := uc.mediaRepo.ListDeletedBefore(ctx, deletedBefore, purgeBatchSize)
+		if err != nil {
+			return purged, err
+		}
+
+		for _, m := range mediaList {
+			if err := uc.mediaRepo.Purge(ctx, m.ID); err != nil {
+				if err == domainmedia.ErrMediaNotFound {
+					// Restored or purged by another instance
+					continue
+				}
+				return purged, err
+			}
+			purged++
+
+			if err := uc.storage.Delete(ctx, m.Path); err != nil {
+				return purged, err
+			}
+		}
+
+		if len(mediaList) < purgeBatchSize {
+			return purged, nil
+		}
+	}
+}
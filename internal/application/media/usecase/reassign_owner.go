@@ -0,0 +1,26 @@
+package usecase
+
+import (
+	"context"
+
+	domainmedia "github.com/rulzi/hexa-go/internal/domain/media"
+)
+
+// ReassignMediaUseCase moves every media uploaded by a user, including the trash, to another user.
+// It is used to keep the uploads of an account, or of its data in an organization, that is erased.
+type ReassignMediaUseCase struct {
+	mediaRepo domainmedia.Repository
+}
+
+// NewReassignMediaUseCase creates a new ReassignMediaUseCase
+func NewReassignMediaUseCase(mediaRepo domainmedia.Repository) *ReassignMediaUseCase {
+	return &ReassignMediaUseCase{
+		mediaRepo: mediaRepo,
+	}
+}
+
+// Execute moves the media of fromID in orgID to toID and returns how many were moved,
+// orgID zero moves them in every organization
+func (uc *ReassignMediaUseCase) Execute(ctx context.Context, orgID, fromID, toID int64) (int64, error) {
+	return uc.mediaRepo.ReassignOwner(ctx, orgID, fromID, toID)
+}
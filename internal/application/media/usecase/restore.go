@@ -0,0 +1,43 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/rulzi/hexa-go/internal/application/media/dto"
+	domainmedia "github.com/rulzi/hexa-go/internal/domain/media"
+)
+
+// RestoreMediaUseCase handles taking a media out of the trash
+type RestoreMediaUseCase struct {
+	mediaRepo domainmedia.Repository
+	baseURL   string
+}
+
+// NewRestoreMediaUseCase creates a new RestoreMediaUseCase
+func NewRestoreMediaUseCase(mediaRepo domainmedia.Repository, baseURL string) *RestoreMediaUseCase {
+	return &RestoreMediaUseCase{
+		mediaRepo: mediaRepo,
+		baseURL:   baseURL,
+	}
+}
+
+// Execute executes the restore media use case
+func (uc *RestoreMediaUseCase) Execute(ctx context.Context, orgID, id int64) (*dto.MediaResponse, error) {
+	if err := uc.mediaRepo.Restore(ctx, orgID, id); err != nil {
+		return nil, err
+	}
+
+	m, err := uc.mediaRepo.GetByID(ctx, orgID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.MediaResponse{
+		ID:        m.ID,
+		Name:      m.Name,
+		Path:      m.Path,
+		URL:       dto.BuildURL(uc.baseURL, m.Path),
+		CreatedAt: m.CreatedAt,
+		UpdatedAt: m.UpdatedAt,
+	}, nil
+}
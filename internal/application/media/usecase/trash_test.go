@@ -0,0 +1,107 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	domainmedia "github.com/rulzi/hexa-go/internal/domain/media"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListDeletedMediaUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+	repo := &mockMediaRepository{}
+	uc := NewListDeletedMediaUseCase(repo, "http://localhost:8080")
+
+	deletedAt := time.Now()
+	repo.On("ListDeleted", ctx, int64(1), 10, 0).Return([]*domainmedia.Media{
+		{ID: 5, Name: "test.jpg", Path: "2025/12/19/test.jpg", DeletedAt: &deletedAt},
+	}, nil)
+	repo.On("CountDeleted", ctx, int64(1)).Return(int64(1), nil)
+
+	result, err := uc.Execute(ctx, 1, 10, 0)
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), result.Total)
+	if assert.Len(t, result.Media, 1) {
+		assert.Equal(t, "http://localhost:8080/api/v1/media/files/2025/12/19/test.jpg", result.Media[0].URL)
+		assert.Equal(t, &deletedAt, result.Media[0].DeletedAt)
+	}
+	repo.AssertExpectations(t)
+}
+
+func TestRestoreMediaUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+	repo := &mockMediaRepository{}
+	uc := NewRestoreMediaUseCase(repo, "http://localhost:8080")
+
+	repo.On("Restore", ctx, int64(1), int64(5)).Return(nil)
+	repo.On("GetByID", ctx, int64(1), int64(5)).Return(&domainmedia.Media{ID: 5, Name: "test.jpg", Path: "2025/12/19/test.jpg"}, nil)
+
+	result, err := uc.Execute(ctx, 1, 5)
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(5), result.ID)
+	repo.AssertExpectations(t)
+}
+
+func TestRestoreMediaUseCase_Execute_NotInTrash(t *testing.T) {
+	ctx := context.Background()
+	repo := &mockMediaRepository{}
+	uc := NewRestoreMediaUseCase(repo, "http://localhost:8080")
+
+	repo.On("Restore", ctx, int64(1), int64(5)).Return(domainmedia.ErrMediaNotFound)
+
+	result, err := uc.Execute(ctx, 1, 5)
+
+	assert.Nil(t, result)
+	assert.Equal(t, domainmedia.ErrMediaNotFound, err)
+	repo.AssertNotCalled(t, "GetByID")
+}
+
+func TestPurgeMediaUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+	repo := &mockMediaRepository{}
+	storage := &mockMediaStorage{}
+	uc := NewPurgeMediaUseCase(repo, storage)
+
+	before := time.Now().AddDate(0, 0, -30)
+	repo.On("ListDeletedBefore", ctx, before, purgeBatchSize).Return([]*domainmedia.Media{
+		{ID: 1, Path: "2025/12/19/a.jpg"},
+		{ID: 2, Path: "2025/12/19/b.jpg"},
+	}, nil)
+	repo.On("Purge", ctx, int64(1)).Return(nil)
+	repo.On("Purge", ctx, int64(2)).Return(domainmedia.ErrMediaNotFound)
+	storage.On("Delete", ctx, "2025/12/19/a.jpg").Return(nil)
+
+	purged, err := uc.Execute(ctx, before)
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), purged)
+	repo.AssertExpectations(t)
+	storage.AssertExpectations(t)
+	// Media 2 was restored concurrently, so its file must stay
+	storage.AssertNotCalled(t, "Delete", ctx, "2025/12/19/b.jpg")
+}
+
+func TestPurgeMediaUseCase_Execute_StorageError(t *testing.T) {
+	ctx := context.Background()
+	repo := &mockMediaRepository{}
+	storage := &mockMediaStorage{}
+	uc := NewPurgeMediaUseCase(repo, storage)
+
+	before := time.Now().AddDate(0, 0, -30)
+	storageError := errors.New("storage delete error")
+	repo.On("ListDeletedBefore", ctx, before, purgeBatchSize).Return([]*domainmedia.Media{
+		{ID: 1, Path: "2025/12/19/a.jpg"},
+	}, nil)
+	repo.On("Purge", ctx, int64(1)).Return(nil)
+	storage.On("Delete", ctx, "2025/12/19/a.jpg").Return(storageError)
+
+	purged, err := uc.Execute(ctx, before)
+
+	assert.Equal(t, storageError, err)
+	assert.Equal(t, int64(1), purged)
+}
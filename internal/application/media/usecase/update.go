@@ -32,10 +32,10 @@ func NewUpdateMediaUseCase(
 	}
 }
 
-// Execute executes the update media use case
-func (uc *UpdateMediaUseCase) Execute(ctx context.Context, id int64, filename string, file io.Reader) (*dto.MediaResponse, error) {
+// Execute executes the update media use case, actorID is the user replacing the file
+func (uc *UpdateMediaUseCase) Execute(ctx context.Context, actorID, orgID, id int64, filename string, file io.Reader) (*dto.MediaResponse, error) {
 	// Get existing media
-	existingMedia, err := uc.mediaRepo.GetByID(ctx, id)
+	existingMedia, err := uc.mediaRepo.GetByID(ctx, orgID, id)
 	if err != nil {
 		return nil, err
 	}
@@ -56,6 +56,7 @@ func (uc *UpdateMediaUseCase) Execute(ctx context.Context, id int64, filename st
 	existingMedia.Name = filename
 	existingMedia.Path = storagePath
 	existingMedia.UpdatedAt = time.Now()
+	existingMedia.UpdatedBy = actorID
 
 	// Validate entity
 	if err := existingMedia.Validate(); err != nil {
@@ -61,12 +61,14 @@ func TestUpdateMediaUseCase_Execute_Success(t *testing.T) {
 		UpdatedAt: time.Now(),
 	}
 
-	repo.On("GetByID", ctx, mediaID).Return(existingMedia, nil)
+	repo.On("GetByID", ctx, int64(1), mediaID).Return(existingMedia, nil)
 	storage.On("Save", ctx, newFilename, newFile).Return(newStoragePath, nil)
-	repo.On("Update", ctx, mock.AnythingOfType("*media.Media")).Return(updatedMedia, nil)
+	repo.On("Update", ctx, mock.MatchedBy(func(m *domainmedia.Media) bool {
+		return m.UpdatedBy == 3
+	})).Return(updatedMedia, nil)
 	storage.On("Delete", ctx, existingMedia.Path).Return(nil)
 
-	result, err := uc.Execute(ctx, mediaID, newFilename, newFile)
+	result, err := uc.Execute(ctx, 3, 1, mediaID, newFilename, newFile)
 
 	assert.NoError(t, err)
 	assert.NotNil(t, result)
@@ -93,9 +95,9 @@ func TestUpdateMediaUseCase_Execute_MediaNotFound(t *testing.T) {
 	newFilename := "new.jpg"
 	newFile := strings.NewReader("new file content")
 
-	repo.On("GetByID", ctx, mediaID).Return(nil, nil)
+	repo.On("GetByID", ctx, int64(1), mediaID).Return(nil, nil)
 
-	result, err := uc.Execute(ctx, mediaID, newFilename, newFile)
+	result, err := uc.Execute(ctx, 1, 1, mediaID, newFilename, newFile)
 
 	assert.Error(t, err)
 	assert.Equal(t, domainmedia.ErrMediaNotFound, err)
@@ -120,9 +122,9 @@ func TestUpdateMediaUseCase_Execute_GetByIDError(t *testing.T) {
 	newFile := strings.NewReader("new file content")
 	repoError := errors.New("database error")
 
-	repo.On("GetByID", ctx, mediaID).Return(nil, repoError)
+	repo.On("GetByID", ctx, int64(1), mediaID).Return(nil, repoError)
 
-	result, err := uc.Execute(ctx, mediaID, newFilename, newFile)
+	result, err := uc.Execute(ctx, 1, 1, mediaID, newFilename, newFile)
 
 	assert.Error(t, err)
 	assert.Equal(t, repoError, err)
@@ -154,10 +156,10 @@ func TestUpdateMediaUseCase_Execute_StorageSaveError(t *testing.T) {
 	newFile := strings.NewReader("new file content")
 	storageError := errors.New("storage save error")
 
-	repo.On("GetByID", ctx, mediaID).Return(existingMedia, nil)
+	repo.On("GetByID", ctx, int64(1), mediaID).Return(existingMedia, nil)
 	storage.On("Save", ctx, newFilename, newFile).Return("", storageError)
 
-	result, err := uc.Execute(ctx, mediaID, newFilename, newFile)
+	result, err := uc.Execute(ctx, 1, 1, mediaID, newFilename, newFile)
 
 	assert.Error(t, err)
 	assert.Equal(t, storageError, err)
@@ -192,11 +194,11 @@ func TestUpdateMediaUseCase_Execute_ValidationError(t *testing.T) {
 	newFile := strings.NewReader("new file content")
 	newStoragePath := "2025/12/19/.jpg"
 
-	repo.On("GetByID", ctx, mediaID).Return(existingMedia, nil)
+	repo.On("GetByID", ctx, int64(1), mediaID).Return(existingMedia, nil)
 	storage.On("Save", ctx, newFilename, newFile).Return(newStoragePath, nil)
 	storage.On("Delete", ctx, newStoragePath).Return(nil)
 
-	result, err := uc.Execute(ctx, mediaID, newFilename, newFile)
+	result, err := uc.Execute(ctx, 1, 1, mediaID, newFilename, newFile)
 
 	assert.Error(t, err)
 	assert.Equal(t, domainmedia.ErrNameRequired, err)
@@ -231,12 +233,12 @@ func TestUpdateMediaUseCase_Execute_RepositoryUpdateError(t *testing.T) {
 	newStoragePath := "2025/12/19/new.jpg"
 	repoError := errors.New("database update error")
 
-	repo.On("GetByID", ctx, mediaID).Return(existingMedia, nil)
+	repo.On("GetByID", ctx, int64(1), mediaID).Return(existingMedia, nil)
 	storage.On("Save", ctx, newFilename, newFile).Return(newStoragePath, nil)
 	repo.On("Update", ctx, mock.AnythingOfType("*media.Media")).Return(nil, repoError)
 	storage.On("Delete", ctx, newStoragePath).Return(nil)
 
-	result, err := uc.Execute(ctx, mediaID, newFilename, newFile)
+	result, err := uc.Execute(ctx, 1, 1, mediaID, newFilename, newFile)
 
 	assert.Error(t, err)
 	assert.Equal(t, repoError, err)
@@ -0,0 +1,132 @@
+package usecase
+
+import (
+	"context"
+
+	domainprivacy "github.com/rulzi/hexa-go/internal/domain/privacy"
+	domainuser "github.com/rulzi/hexa-go/internal/domain/user"
+)
+
+// UserAnonymizer erases the personal data of a user account
+type UserAnonymizer interface {
+	Execute(ctx context.Context, id int64) error
+}
+
+// ContentDeleter permanently deletes one kind of content owned by a user in an organization,
+// orgID zero covers every organization
+type ContentDeleter interface {
+	Execute(ctx context.Context, orgID, ownerID int64) (int64, error)
+}
+
+// ContentReassigner hands one kind of content owned by a user in an organization over to
+// another user, orgID zero covers every organization
+type ContentReassigner interface {
+	Execute(ctx context.Context, orgID, fromID, toID int64) (int64, error)
+}
+
+// EraseUserDataUseCase carries out an erasure request.
+// An account-wide erasure anonymizes the account first, an erasure filed for an organization
+// removes the membership last. The content is handled according to the policy in between.
+type EraseUserDataUseCase struct {
+	anonymizer    UserAnonymizer
+	organizations domainuser.OrganizationRepository
+	deleters      []ContentDeleter
+	reassigners   []ContentReassigner
+	policy        domainprivacy.ErasurePolicy
+	reassignTo    int64
+}
+
+// NewEraseUserDataUseCase creates a new EraseUserDataUseCase.
+// deleters are used with ErasureDelete, reassigners hand the content to reassignTo with ErasureReassign.
+func NewEraseUserDataUseCase(
+	anonymizer UserAnonymizer,
+	organizations domainuser.OrganizationRepository,
+	deleters []ContentDeleter,
+	reassigners []ContentReassigner,
+	policy domainprivacy.ErasurePolicy,
+	reassignTo int64,
+) *EraseUserDataUseCase {
+	return &EraseUserDataUseCase{
+		anonymizer:    anonymizer,
+		organizations: organizations,
+		deleters:      deleters,
+		reassigners:   reassigners,
+		policy:        policy,
+		reassignTo:    reassignTo,
+	}
+}
+
+// Execute erases the data of the user in orgID, orgID zero erases the whole account.
+// It can run again after a failure, an account that was already anonymized is reported
+// as ErrUserNotFound and a membership already removed as ErrNotMember, only the content
+// is handled then.
+func (uc *EraseUserDataUseCase) Execute(ctx context.Context, orgID, userID int64) error {
+	if uc.policy == domainprivacy.ErasureReassign {
+		if err := uc.checkReassignTarget(ctx, orgID, userID); err != nil {
+			return err
+		}
+	}
+
+	if orgID == 0 {
+		if err := uc.anonymizer.Execute(ctx, userID); err != nil && err != domainuser.ErrUserNotFound {
+			return err
+		}
+	}
+
+	switch uc.policy {
+	case domainprivacy.ErasureDelete:
+		for _, d := range uc.deleters {
+			if _, err := d.Execute(ctx, orgID, userID); err != nil {
+				return err
+			}
+		}
+	case domainprivacy.ErasureReassign:
+		for _, r := range uc.reassigners {
+			if _, err := r.Execute(ctx, orgID, userID, uc.reassignTo); err != nil {
+				return err
+			}
+		}
+	default:
+		return domainprivacy.ErrInvalidErasurePolicy
+	}
+
+	if orgID != 0 {
+		// Last, so a failed run can still be retried while the user is a member
+		if err := uc.organizations.RemoveMember(ctx, orgID, userID); err != nil && err != domainuser.ErrNotMember {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// checkReassignTarget makes sure the content stays in its organization, the user it is handed
+// over to must belong to orgID, or to every organization of the erased user when orgID is zero
+func (uc *EraseUserDataUseCase) checkReassignTarget(ctx context.Context, orgID, userID int64) error {
+	if userID == uc.reassignTo {
+		return domainprivacy.ErrReassignTargetErased
+	}
+
+	orgIDs := []int64{orgID}
+	if orgID == 0 {
+		memberships, err := uc.organizations.ListByUser(ctx, userID)
+		if err != nil {
+			return err
+		}
+		orgIDs = orgIDs[:0]
+		for _, m := range memberships {
+			orgIDs = append(orgIDs, m.Organization.ID)
+		}
+	}
+
+	for _, id := range orgIDs {
+		if _, err := uc.organizations.GetMembership(ctx, id, uc.reassignTo); err != nil {
+			if err == domainuser.ErrNotMember {
+				return domainprivacy.ErrReassignTargetNotMember
+			}
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,191 @@
+package usecase
+
+import (
+	"archive/zip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"path"
+
+	"github.com/rulzi/hexa-go/internal/application/privacy/dto"
+	domainarticle "github.com/rulzi/hexa-go/internal/domain/article"
+	domainmedia "github.com/rulzi/hexa-go/internal/domain/media"
+	domainprivacy "github.com/rulzi/hexa-go/internal/domain/privacy"
+	domainuser "github.com/rulzi/hexa-go/internal/domain/user"
+)
+
+// exportBatchSize is how many articles or media are read per query while building an archive
+const exportBatchSize = 100
+
+// ExportUserDataUseCase builds the archive of an export request.
+// The archive is a zip with profile.json, articles.json, media.json and the uploaded files under media/.
+type ExportUserDataUseCase struct {
+	userRepo     domainuser.Repository
+	articleRepo  domainarticle.Repository
+	mediaRepo    domainmedia.Repository
+	mediaStorage domainmedia.Storage
+	archives     domainprivacy.ArchiveStore
+}
+
+// NewExportUserDataUseCase creates a new ExportUserDataUseCase
+func NewExportUserDataUseCase(
+	userRepo domainuser.Repository,
+	articleRepo domainarticle.Repository,
+	mediaRepo domainmedia.Repository,
+	mediaStorage domainmedia.Storage,
+	archives domainprivacy.ArchiveStore,
+) *ExportUserDataUseCase {
+	return &ExportUserDataUseCase{
+		userRepo:     userRepo,
+		articleRepo:  articleRepo,
+		mediaRepo:    mediaRepo,
+		mediaStorage: mediaStorage,
+		archives:     archives,
+	}
+}
+
+// Execute builds the archive for the request and returns its path in the archive store.
+// Content in the trash is included, it is still held about the user. A request filed for
+// an organization only includes the content of that organization.
+func (uc *ExportUserDataUseCase) Execute(ctx context.Context, request *domainprivacy.DataRequest) (string, error) {
+	u, err := uc.userRepo.GetByID(ctx, 0, request.UserID)
+	if err != nil {
+		return "", err
+	}
+
+	// The archive is streamed into the store instead of being built in memory
+	reader, writer := io.Pipe()
+	go func() {
+		_ = writer.CloseWithError(uc.writeArchive(ctx, writer, u, request.OrgID))
+	}()
+
+	archivePath, err := uc.archives.Save(ctx, fmt.Sprintf("export-%d.zip", request.ID), reader)
+	// Unblocks the writer when the store stopped reading early
+	_ = reader.CloseWithError(err)
+	if err != nil {
+		return "", err
+	}
+
+	return archivePath, nil
+}
+
+// writeArchive writes the zip archive of the user to w, orgID zero includes the content of every organization
+func (uc *ExportUserDataUseCase) writeArchive(ctx context.Context, w io.Writer, u *domainuser.User, orgID int64) error {
+	zw := zip.NewWriter(w)
+
+	profile := dto.ExportProfile{
+		ID:              u.ID,
+		Name:            u.Name,
+		Email:           u.Email,
+		Role:            string(u.Role),
+		EmailVerifiedAt: u.EmailVerifiedAt,
+		CreatedAt:       u.CreatedAt,
+		UpdatedAt:       u.UpdatedAt,
+	}
+	if err := writeJSON(zw, "profile.json", profile); err != nil {
+		return err
+	}
+
+	articles := []dto.ExportArticle{}
+	for offset := 0; ; offset += exportBatchSize {
+		batch, err := uc.articleRepo.ListAllByAuthor(ctx, u.ID, exportBatchSize, offset)
+		if err != nil {
+			return err
+		}
+		for _, a := range batch {
+			if orgID != 0 && a.OrgID != orgID {
+				continue
+			}
+			articles = append(articles, dto.ExportArticle{
+				ID:        a.ID,
+				Title:     a.Title,
+				Content:   a.Content,
+				CreatedAt: a.CreatedAt,
+				UpdatedAt: a.UpdatedAt,
+				DeletedAt: a.DeletedAt,
+			})
+		}
+		if len(batch) < exportBatchSize {
+			break
+		}
+	}
+	if err := writeJSON(zw, "articles.json", articles); err != nil {
+		return err
+	}
+
+	mediaList := []dto.ExportMedia{}
+	for offset := 0; ; offset += exportBatchSize {
+		batch, err := uc.mediaRepo.ListAllByOwner(ctx, u.ID, exportBatchSize, offset)
+		if err != nil {
+			return err
+		}
+		for _, m := range batch {
+			if orgID != 0 && m.OrgID != orgID {
+				continue
+			}
+			file, err := uc.writeMediaFile(ctx, zw, m)
+			if err != nil {
+				return err
+			}
+			mediaList = append(mediaList, dto.ExportMedia{
+				ID:        m.ID,
+				Name:      m.Name,
+				File:      file,
+				CreatedAt: m.CreatedAt,
+				UpdatedAt: m.UpdatedAt,
+				DeletedAt: m.DeletedAt,
+			})
+		}
+		if len(batch) < exportBatchSize {
+			break
+		}
+	}
+	if err := writeJSON(zw, "media.json", mediaList); err != nil {
+		return err
+	}
+
+	return zw.Close()
+}
+
+// writeMediaFile copies the uploaded file of a media into the archive and returns its name there.
+// A file missing from storage is skipped and an empty name is returned.
+func (uc *ExportUserDataUseCase) writeMediaFile(ctx context.Context, zw *zip.Writer, m *domainmedia.Media) (string, error) {
+	src, err := uc.mediaStorage.Get(ctx, m.Path)
+	if err == domainmedia.ErrMediaNotFound {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+	defer func() {
+		if err := src.Close(); err != nil {
+			log.Printf("Failed to close file: %v", err)
+		}
+	}()
+
+	// The ID keeps names unique, the base name keeps entries inside media/
+	name := fmt.Sprintf("media/%d-%s", m.ID, path.Base(m.Name))
+	dst, err := zw.Create(name)
+	if err != nil {
+		return "", err
+	}
+	if _, err := io.Copy(dst, src); err != nil {
+		return "", err
+	}
+
+	return name, nil
+}
+
+// writeJSON adds a JSON document to the archive
+func writeJSON(zw *zip.Writer, name string, v interface{}) error {
+	w, err := zw.Create(name)
+	if err != nil {
+		return err
+	}
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(v)
+}
@@ -0,0 +1,156 @@
+package usecase
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/rulzi/hexa-go/internal/application/privacy/dto"
+	domainarticle "github.com/rulzi/hexa-go/internal/domain/article"
+	domainmedia "github.com/rulzi/hexa-go/internal/domain/media"
+	domainprivacy "github.com/rulzi/hexa-go/internal/domain/privacy"
+	domainuser "github.com/rulzi/hexa-go/internal/domain/user"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExportUserDataUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+	users := &mockUserRepository{}
+	articles := &mockArticleRepository{}
+	media := &mockMediaRepository{}
+	mediaStorage := &mockFileStore{}
+	archives := &mockFileStore{}
+	uc := NewExportUserDataUseCase(users, articles, media, mediaStorage, archives)
+
+	deletedAt := time.Now()
+	users.On("GetByID", ctx, int64(0), int64(1)).Return(&domainuser.User{ID: 1, Name: "John Doe", Email: "john@example.com", Role: domainuser.RoleAuthor}, nil)
+	articles.On("ListAllByAuthor", ctx, int64(1), exportBatchSize, 0).Return([]*domainarticle.Article{
+		{ID: 3, Title: "Hello", Content: "World", AuthorID: 1},
+		{ID: 4, Title: "Old", Content: "Trashed", AuthorID: 1, DeletedAt: &deletedAt},
+	}, nil)
+	media.On("ListAllByOwner", ctx, int64(1), exportBatchSize, 0).Return([]*domainmedia.Media{
+		{ID: 7, OwnerID: 1, Name: "photo.jpg", Path: "2025/12/19/photo_1.jpg"},
+		{ID: 8, OwnerID: 1, Name: "lost.png", Path: "2025/12/19/lost_1.png"},
+	}, nil)
+	mediaStorage.On("Get", ctx, "2025/12/19/photo_1.jpg").Return(io.NopCloser(strings.NewReader("jpeg bytes")), nil)
+	mediaStorage.On("Get", ctx, "2025/12/19/lost_1.png").Return(nil, domainmedia.ErrMediaNotFound)
+
+	var archive bytes.Buffer
+	archives.On("Save", ctx, "export-9.zip", mock.Anything).Run(func(args mock.Arguments) {
+		_, err := io.Copy(&archive, args.Get(2).(io.Reader))
+		require.NoError(t, err)
+	}).Return("exports/export-9.zip", nil)
+
+	archivePath, err := uc.Execute(ctx, &domainprivacy.DataRequest{ID: 9, UserID: 1})
+
+	require.NoError(t, err)
+	assert.Equal(t, "exports/export-9.zip", archivePath)
+
+	zr, err := zip.NewReader(bytes.NewReader(archive.Bytes()), int64(archive.Len()))
+	require.NoError(t, err)
+	files := map[string]string{}
+	for _, f := range zr.File {
+		rc, err := f.Open()
+		require.NoError(t, err)
+		content, err := io.ReadAll(rc)
+		require.NoError(t, err)
+		files[f.Name] = string(content)
+	}
+
+	var profile dto.ExportProfile
+	require.NoError(t, json.Unmarshal([]byte(files["profile.json"]), &profile))
+	assert.Equal(t, "john@example.com", profile.Email)
+
+	var exportedArticles []dto.ExportArticle
+	require.NoError(t, json.Unmarshal([]byte(files["articles.json"]), &exportedArticles))
+	assert.Len(t, exportedArticles, 2)
+	assert.NotNil(t, exportedArticles[1].DeletedAt)
+
+	var exportedMedia []dto.ExportMedia
+	require.NoError(t, json.Unmarshal([]byte(files["media.json"]), &exportedMedia))
+	if assert.Len(t, exportedMedia, 2) {
+		assert.Equal(t, "media/7-photo.jpg", exportedMedia[0].File)
+		// Missing files are listed without a file
+		assert.Empty(t, exportedMedia[1].File)
+	}
+	assert.Equal(t, "jpeg bytes", files["media/7-photo.jpg"])
+}
+
+func TestExportUserDataUseCase_Execute_Organization(t *testing.T) {
+	ctx := context.Background()
+	users := &mockUserRepository{}
+	articles := &mockArticleRepository{}
+	media := &mockMediaRepository{}
+	mediaStorage := &mockFileStore{}
+	archives := &mockFileStore{}
+	uc := NewExportUserDataUseCase(users, articles, media, mediaStorage, archives)
+
+	users.On("GetByID", ctx, int64(0), int64(1)).Return(&domainuser.User{ID: 1}, nil)
+	articles.On("ListAllByAuthor", ctx, int64(1), exportBatchSize, 0).Return([]*domainarticle.Article{
+		{ID: 3, Title: "Ours", AuthorID: 1, OrgID: 2},
+		{ID: 4, Title: "Theirs", AuthorID: 1, OrgID: 3},
+	}, nil)
+	media.On("ListAllByOwner", ctx, int64(1), exportBatchSize, 0).Return([]*domainmedia.Media{
+		{ID: 8, OwnerID: 1, OrgID: 3, Name: "theirs.png", Path: "2025/12/19/theirs_1.png"},
+	}, nil)
+
+	var archive bytes.Buffer
+	archives.On("Save", ctx, "export-9.zip", mock.Anything).Run(func(args mock.Arguments) {
+		_, err := io.Copy(&archive, args.Get(2).(io.Reader))
+		require.NoError(t, err)
+	}).Return("exports/export-9.zip", nil)
+
+	_, err := uc.Execute(ctx, &domainprivacy.DataRequest{ID: 9, UserID: 1, OrgID: 2})
+	require.NoError(t, err)
+
+	zr, err := zip.NewReader(bytes.NewReader(archive.Bytes()), int64(archive.Len()))
+	require.NoError(t, err)
+	files := map[string]string{}
+	for _, f := range zr.File {
+		rc, err := f.Open()
+		require.NoError(t, err)
+		content, err := io.ReadAll(rc)
+		require.NoError(t, err)
+		files[f.Name] = string(content)
+	}
+
+	// Only the content of the organization the request was filed for is exported
+	var exportedArticles []dto.ExportArticle
+	require.NoError(t, json.Unmarshal([]byte(files["articles.json"]), &exportedArticles))
+	if assert.Len(t, exportedArticles, 1) {
+		assert.Equal(t, int64(3), exportedArticles[0].ID)
+	}
+	var exportedMedia []dto.ExportMedia
+	require.NoError(t, json.Unmarshal([]byte(files["media.json"]), &exportedMedia))
+	assert.Empty(t, exportedMedia)
+	mediaStorage.AssertNotCalled(t, "Get", mock.Anything, mock.Anything)
+}
+
+func TestExportUserDataUseCase_Execute_ListError(t *testing.T) {
+	ctx := context.Background()
+	users := &mockUserRepository{}
+	articles := &mockArticleRepository{}
+	archives := &mockFileStore{}
+	uc := NewExportUserDataUseCase(users, articles, &mockMediaRepository{}, &mockFileStore{}, archives)
+
+	dbErr := errors.New("database error")
+	users.On("GetByID", ctx, int64(0), int64(1)).Return(&domainuser.User{ID: 1}, nil)
+	articles.On("ListAllByAuthor", ctx, int64(1), exportBatchSize, 0).Return(nil, dbErr)
+	// The store sees the error of the writer while reading the archive
+	archives.On("Save", ctx, "export-9.zip", mock.Anything).Run(func(args mock.Arguments) {
+		_, err := io.Copy(io.Discard, args.Get(2).(io.Reader))
+		assert.Equal(t, dbErr, err)
+	}).Return("", dbErr)
+
+	_, err := uc.Execute(ctx, &domainprivacy.DataRequest{ID: 9, UserID: 1})
+
+	assert.Equal(t, dbErr, err)
+}
@@ -0,0 +1,89 @@
+package usecase
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"github.com/rulzi/hexa-go/internal/application/privacy/dto"
+	domainprivacy "github.com/rulzi/hexa-go/internal/domain/privacy"
+)
+
+// GetDataRequestUseCase handles checking the status of a data export or erasure request
+type GetDataRequestUseCase struct {
+	requests domainprivacy.Repository
+}
+
+// NewGetDataRequestUseCase creates a new GetDataRequestUseCase
+func NewGetDataRequestUseCase(requests domainprivacy.Repository) *GetDataRequestUseCase {
+	return &GetDataRequestUseCase{
+		requests: requests,
+	}
+}
+
+// Execute returns the request with the given ID. When userID is not zero only requests
+// about that user are returned, when orgID is not zero only requests filed for that
+// organization. Other requests are reported as ErrRequestNotFound.
+func (uc *GetDataRequestUseCase) Execute(ctx context.Context, orgID, id, userID int64) (*dto.DataRequestResponse, error) {
+	r, err := getRequest(ctx, uc.requests, orgID, id, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return newDataRequestResponse(r), nil
+}
+
+// DownloadExportUseCase handles downloading the archive of a completed export
+type DownloadExportUseCase struct {
+	requests domainprivacy.Repository
+	archives domainprivacy.ArchiveStore
+}
+
+// NewDownloadExportUseCase creates a new DownloadExportUseCase
+func NewDownloadExportUseCase(requests domainprivacy.Repository, archives domainprivacy.ArchiveStore) *DownloadExportUseCase {
+	return &DownloadExportUseCase{
+		requests: requests,
+		archives: archives,
+	}
+}
+
+// Execute opens the archive of the export with the given ID for userID, the caller closes it.
+// orgID and userID restrict the exports that can be downloaded as in GetDataRequestUseCase.
+// Returns ErrExportNotReady unless the export completed and ErrExportExpired once the archive
+// has been removed.
+func (uc *DownloadExportUseCase) Execute(ctx context.Context, orgID, id, userID int64) (io.ReadCloser, error) {
+	r, err := getRequest(ctx, uc.requests, orgID, id, userID)
+	if err != nil {
+		return nil, err
+	}
+	if r.Type != domainprivacy.RequestExport {
+		return nil, domainprivacy.ErrRequestNotFound
+	}
+
+	if !r.IsDownloadable(time.Now()) {
+		if r.Status == domainprivacy.StatusCompleted || r.Status == domainprivacy.StatusExpired {
+			return nil, domainprivacy.ErrExportExpired
+		}
+		return nil, domainprivacy.ErrExportNotReady
+	}
+
+	return uc.archives.Get(ctx, r.ArchivePath)
+}
+
+// getRequest loads a request and hides the requests of other users when userID is not zero
+// and those not filed for the organization when orgID is not zero. Requests covering the
+// whole account are only visible to the user.
+func getRequest(ctx context.Context, requests domainprivacy.Repository, orgID, id, userID int64) (*domainprivacy.DataRequest, error) {
+	r, err := requests.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if userID != 0 && r.UserID != userID {
+		return nil, domainprivacy.ErrRequestNotFound
+	}
+	if orgID != 0 && r.OrgID != orgID {
+		return nil, domainprivacy.ErrRequestNotFound
+	}
+
+	return r, nil
+}
@@ -0,0 +1,207 @@
+package usecase
+
+import (
+	"context"
+	"io"
+	"time"
+
+	domainarticle "github.com/rulzi/hexa-go/internal/domain/article"
+	domainmedia "github.com/rulzi/hexa-go/internal/domain/media"
+	domainprivacy "github.com/rulzi/hexa-go/internal/domain/privacy"
+	domainuser "github.com/rulzi/hexa-go/internal/domain/user"
+	"github.com/stretchr/testify/mock"
+)
+
+// mockRequestRepository is a mock implementation of privacy.Repository
+type mockRequestRepository struct {
+	mock.Mock
+}
+
+func (m *mockRequestRepository) Create(ctx context.Context, request *domainprivacy.DataRequest) (*domainprivacy.DataRequest, error) {
+	args := m.Called(ctx, request)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domainprivacy.DataRequest), args.Error(1)
+}
+
+func (m *mockRequestRepository) GetByID(ctx context.Context, id int64) (*domainprivacy.DataRequest, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domainprivacy.DataRequest), args.Error(1)
+}
+
+func (m *mockRequestRepository) FindActive(ctx context.Context, orgID, userID int64, requestType domainprivacy.RequestType) (*domainprivacy.DataRequest, error) {
+	args := m.Called(ctx, orgID, userID, requestType)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domainprivacy.DataRequest), args.Error(1)
+}
+
+func (m *mockRequestRepository) ClaimNext(ctx context.Context, now, staleBefore time.Time) (*domainprivacy.DataRequest, error) {
+	args := m.Called(ctx, now, staleBefore)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domainprivacy.DataRequest), args.Error(1)
+}
+
+func (m *mockRequestRepository) Update(ctx context.Context, request *domainprivacy.DataRequest) error {
+	args := m.Called(ctx, request)
+	return args.Error(0)
+}
+
+func (m *mockRequestRepository) ListExpiredExports(ctx context.Context, before time.Time, limit int) ([]*domainprivacy.DataRequest, error) {
+	args := m.Called(ctx, before, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domainprivacy.DataRequest), args.Error(1)
+}
+
+// mockFileStore is a mock implementation of privacy.ArchiveStore and media.Storage
+type mockFileStore struct {
+	mock.Mock
+}
+
+func (m *mockFileStore) Save(ctx context.Context, filename string, file io.Reader) (string, error) {
+	args := m.Called(ctx, filename, file)
+	return args.String(0), args.Error(1)
+}
+
+func (m *mockFileStore) Delete(ctx context.Context, path string) error {
+	args := m.Called(ctx, path)
+	return args.Error(0)
+}
+
+func (m *mockFileStore) Get(ctx context.Context, path string) (io.ReadCloser, error) {
+	args := m.Called(ctx, path)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(io.ReadCloser), args.Error(1)
+}
+
+// mockUserRepository mocks the user lookups of these use cases,
+// the embedded interface panics if anything else is called
+type mockUserRepository struct {
+	domainuser.Repository
+	mock.Mock
+}
+
+func (m *mockUserRepository) GetByID(ctx context.Context, orgID, id int64) (*domainuser.User, error) {
+	args := m.Called(ctx, orgID, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domainuser.User), args.Error(1)
+}
+
+// mockArticleRepository mocks listing the articles of an author
+type mockArticleRepository struct {
+	domainarticle.Repository
+	mock.Mock
+}
+
+func (m *mockArticleRepository) ListAllByAuthor(ctx context.Context, authorID int64, limit, offset int) ([]*domainarticle.Article, error) {
+	args := m.Called(ctx, authorID, limit, offset)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domainarticle.Article), args.Error(1)
+}
+
+// mockMediaRepository mocks listing the media of an owner
+type mockMediaRepository struct {
+	domainmedia.Repository
+	mock.Mock
+}
+
+func (m *mockMediaRepository) ListAllByOwner(ctx context.Context, ownerID int64, limit, offset int) ([]*domainmedia.Media, error) {
+	args := m.Called(ctx, ownerID, limit, offset)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domainmedia.Media), args.Error(1)
+}
+
+// mockUserAnonymizer is a mock implementation of UserAnonymizer
+type mockUserAnonymizer struct {
+	mock.Mock
+}
+
+func (m *mockUserAnonymizer) Execute(ctx context.Context, id int64) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+// mockContentDeleter is a mock implementation of ContentDeleter
+type mockContentDeleter struct {
+	mock.Mock
+}
+
+func (m *mockContentDeleter) Execute(ctx context.Context, orgID, ownerID int64) (int64, error) {
+	args := m.Called(ctx, orgID, ownerID)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+// mockContentReassigner is a mock implementation of ContentReassigner
+type mockContentReassigner struct {
+	mock.Mock
+}
+
+func (m *mockContentReassigner) Execute(ctx context.Context, orgID, fromID, toID int64) (int64, error) {
+	args := m.Called(ctx, orgID, fromID, toID)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+// mockDataExporter is a mock implementation of DataExporter
+type mockDataExporter struct {
+	mock.Mock
+}
+
+func (m *mockDataExporter) Execute(ctx context.Context, request *domainprivacy.DataRequest) (string, error) {
+	args := m.Called(ctx, request)
+	return args.String(0), args.Error(1)
+}
+
+// mockDataEraser is a mock implementation of DataEraser
+type mockDataEraser struct {
+	mock.Mock
+}
+
+func (m *mockDataEraser) Execute(ctx context.Context, orgID, userID int64) error {
+	args := m.Called(ctx, orgID, userID)
+	return args.Error(0)
+}
+
+// mockOrganizationRepository mocks the membership lookups and removals of these use cases,
+// the embedded interface panics if anything else is called
+type mockOrganizationRepository struct {
+	domainuser.OrganizationRepository
+	mock.Mock
+}
+
+func (m *mockOrganizationRepository) GetMembership(ctx context.Context, orgID, userID int64) (*domainuser.Membership, error) {
+	args := m.Called(ctx, orgID, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domainuser.Membership), args.Error(1)
+}
+
+func (m *mockOrganizationRepository) ListByUser(ctx context.Context, userID int64) ([]*domainuser.OrganizationMembership, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domainuser.OrganizationMembership), args.Error(1)
+}
+
+func (m *mockOrganizationRepository) RemoveMember(ctx context.Context, orgID, userID int64) error {
+	args := m.Called(ctx, orgID, userID)
+	return args.Error(0)
+}
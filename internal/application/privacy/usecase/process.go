@@ -0,0 +1,127 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	domainprivacy "github.com/rulzi/hexa-go/internal/domain/privacy"
+)
+
+// expireBatchSize is how many expired exports are removed per run
+const expireBatchSize = 100
+
+// DataExporter builds the archive of an export request and returns its path
+type DataExporter interface {
+	Execute(ctx context.Context, request *domainprivacy.DataRequest) (string, error)
+}
+
+// DataEraser erases the data of a user in an organization, or their account when orgID is zero
+type DataEraser interface {
+	Execute(ctx context.Context, orgID, userID int64) error
+}
+
+// ProcessDataRequestsUseCase works through the pending export and erasure requests
+// and removes the archives of expired exports
+type ProcessDataRequestsUseCase struct {
+	requests   domainprivacy.Repository
+	exporter   DataExporter
+	eraser     DataEraser
+	archives   domainprivacy.ArchiveStore
+	exportTTL  time.Duration
+	staleAfter time.Duration
+	now        func() time.Time
+}
+
+// NewProcessDataRequestsUseCase creates a new ProcessDataRequestsUseCase.
+// Archives can be downloaded for exportTTL, requests running for longer than staleAfter are picked up again.
+func NewProcessDataRequestsUseCase(
+	requests domainprivacy.Repository,
+	exporter DataExporter,
+	eraser DataEraser,
+	archives domainprivacy.ArchiveStore,
+	exportTTL, staleAfter time.Duration,
+) *ProcessDataRequestsUseCase {
+	return &ProcessDataRequestsUseCase{
+		requests:   requests,
+		exporter:   exporter,
+		eraser:     eraser,
+		archives:   archives,
+		exportTTL:  exportTTL,
+		staleAfter: staleAfter,
+		now:        time.Now,
+	}
+}
+
+// Execute processes requests until none is pending and returns how many were processed.
+// A failing request is marked as failed and does not stop the others.
+func (uc *ProcessDataRequestsUseCase) Execute(ctx context.Context) (int, error) {
+	processed := 0
+	for {
+		now := uc.now()
+		r, err := uc.requests.ClaimNext(ctx, now, now.Add(-uc.staleAfter))
+		if err == domainprivacy.ErrRequestNotFound {
+			break
+		}
+		if err != nil {
+			return processed, err
+		}
+
+		if err := uc.process(ctx, r); err != nil {
+			return processed, err
+		}
+		processed++
+	}
+
+	return processed, uc.expireExports(ctx)
+}
+
+// process runs a claimed request and saves its outcome
+func (uc *ProcessDataRequestsUseCase) process(ctx context.Context, r *domainprivacy.DataRequest) error {
+	var err error
+	switch r.Type {
+	case domainprivacy.RequestExport:
+		var archivePath string
+		archivePath, err = uc.exporter.Execute(ctx, r)
+		if err == nil {
+			expiresAt := uc.now().Add(uc.exportTTL)
+			r.ArchivePath = archivePath
+			r.ExpiresAt = &expiresAt
+		}
+	case domainprivacy.RequestErasure:
+		err = uc.eraser.Execute(ctx, r.OrgID, r.UserID)
+	default:
+		err = fmt.Errorf("unknown request type %q", r.Type)
+	}
+
+	if err != nil {
+		log.Printf("Failed to process %s request %d: %v", r.Type, r.ID, err)
+		r.Fail(err, uc.now())
+	} else {
+		r.Complete(uc.now())
+	}
+
+	return uc.requests.Update(ctx, r)
+}
+
+// expireExports removes the archives of exports past their expiry
+func (uc *ProcessDataRequestsUseCase) expireExports(ctx context.Context) error {
+	expired, err := uc.requests.ListExpiredExports(ctx, uc.now(), expireBatchSize)
+	if err != nil {
+		return err
+	}
+
+	for _, r := range expired {
+		if err := uc.archives.Delete(ctx, r.ArchivePath); err != nil {
+			return err
+		}
+		r.Status = domainprivacy.StatusExpired
+		r.ArchivePath = ""
+		if err := uc.requests.Update(ctx, r); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,55 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	domainprivacy "github.com/rulzi/hexa-go/internal/domain/privacy"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestProcessDataRequestsUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+	requests := &mockRequestRepository{}
+	exporter := &mockDataExporter{}
+	eraser := &mockDataEraser{}
+	archives := &mockFileStore{}
+	uc := NewProcessDataRequestsUseCase(requests, exporter, eraser, archives, 48*time.Hour, time.Hour)
+
+	now := time.Date(2025, 12, 31, 12, 0, 0, 0, time.UTC)
+	uc.now = func() time.Time { return now }
+
+	export := &domainprivacy.DataRequest{ID: 1, UserID: 5, Type: domainprivacy.RequestExport, Status: domainprivacy.StatusRunning}
+	erasure := &domainprivacy.DataRequest{ID: 2, UserID: 6, OrgID: 2, Type: domainprivacy.RequestErasure, Status: domainprivacy.StatusRunning}
+	expired := &domainprivacy.DataRequest{ID: 3, UserID: 7, Type: domainprivacy.RequestExport, Status: domainprivacy.StatusCompleted, ArchivePath: "export-3.zip"}
+
+	requests.On("ClaimNext", ctx, now, now.Add(-time.Hour)).Return(export, nil).Once()
+	requests.On("ClaimNext", ctx, now, now.Add(-time.Hour)).Return(erasure, nil).Once()
+	requests.On("ClaimNext", ctx, now, now.Add(-time.Hour)).Return(nil, domainprivacy.ErrRequestNotFound).Once()
+	exporter.On("Execute", ctx, export).Return("export-1.zip", nil)
+	eraser.On("Execute", ctx, int64(2), int64(6)).Return(errors.New("database error"))
+	requests.On("Update", ctx, mock.Anything).Return(nil)
+	requests.On("ListExpiredExports", ctx, now, expireBatchSize).Return([]*domainprivacy.DataRequest{expired}, nil)
+	archives.On("Delete", ctx, "export-3.zip").Return(nil)
+
+	processed, err := uc.Execute(ctx)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 2, processed)
+
+	assert.Equal(t, domainprivacy.StatusCompleted, export.Status)
+	assert.Equal(t, "export-1.zip", export.ArchivePath)
+	assert.Equal(t, now.Add(48*time.Hour), *export.ExpiresAt)
+
+	// A failing request is recorded and does not stop the worker
+	assert.Equal(t, domainprivacy.StatusFailed, erasure.Status)
+	assert.Equal(t, "database error", erasure.Error)
+
+	assert.Equal(t, domainprivacy.StatusExpired, expired.Status)
+	assert.Empty(t, expired.ArchivePath)
+	requests.AssertExpectations(t)
+	archives.AssertExpectations(t)
+}
@@ -0,0 +1,144 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/rulzi/hexa-go/internal/application/privacy/dto"
+	domainprivacy "github.com/rulzi/hexa-go/internal/domain/privacy"
+	domainuser "github.com/rulzi/hexa-go/internal/domain/user"
+)
+
+// RequestExportUseCase handles filing a request for an export of the personal data of a user
+type RequestExportUseCase struct {
+	requests      domainprivacy.Repository
+	userRepo      domainuser.Repository
+	organizations domainuser.OrganizationRepository
+}
+
+// NewRequestExportUseCase creates a new RequestExportUseCase
+func NewRequestExportUseCase(
+	requests domainprivacy.Repository,
+	userRepo domainuser.Repository,
+	organizations domainuser.OrganizationRepository,
+) *RequestExportUseCase {
+	return &RequestExportUseCase{
+		requests:      requests,
+		userRepo:      userRepo,
+		organizations: organizations,
+	}
+}
+
+// Execute files an export of the data of userID on behalf of requestedBy.
+// When orgID is not zero userID must be a member of that organization and the export
+// only covers their data there, orgID zero exports the whole account.
+// The archive is built in the background.
+func (uc *RequestExportUseCase) Execute(ctx context.Context, orgID, userID, requestedBy int64) (*dto.DataRequestResponse, error) {
+	return fileRequest(ctx, uc.requests, uc.userRepo, uc.organizations, orgID, userID, requestedBy, domainprivacy.RequestExport)
+}
+
+// RequestErasureUseCase handles filing a request for the erasure of a user account
+type RequestErasureUseCase struct {
+	requests      domainprivacy.Repository
+	userRepo      domainuser.Repository
+	organizations domainuser.OrganizationRepository
+	policy        domainprivacy.ErasurePolicy
+	reassignTo    int64
+}
+
+// NewRequestErasureUseCase creates a new RequestErasureUseCase.
+// policy and reassignTo are the settings the erasure runs with, they are checked up front.
+func NewRequestErasureUseCase(
+	requests domainprivacy.Repository,
+	userRepo domainuser.Repository,
+	organizations domainuser.OrganizationRepository,
+	policy domainprivacy.ErasurePolicy,
+	reassignTo int64,
+) *RequestErasureUseCase {
+	return &RequestErasureUseCase{
+		requests:      requests,
+		userRepo:      userRepo,
+		organizations: organizations,
+		policy:        policy,
+		reassignTo:    reassignTo,
+	}
+}
+
+// Execute files the erasure of userID on behalf of requestedBy.
+// When orgID is not zero userID must be a member of that organization and only their
+// content and membership there are erased, orgID zero erases the whole account.
+// The erasure runs in the background.
+func (uc *RequestErasureUseCase) Execute(ctx context.Context, orgID, userID, requestedBy int64) (*dto.DataRequestResponse, error) {
+	if uc.policy == domainprivacy.ErasureReassign && userID == uc.reassignTo {
+		return nil, domainprivacy.ErrReassignTargetErased
+	}
+
+	return fileRequest(ctx, uc.requests, uc.userRepo, uc.organizations, orgID, userID, requestedBy, domainprivacy.RequestErasure)
+}
+
+// fileRequest stores a pending request covering orgID unless the user already has one
+// of the same type in progress for it
+func fileRequest(
+	ctx context.Context,
+	requests domainprivacy.Repository,
+	userRepo domainuser.Repository,
+	organizations domainuser.OrganizationRepository,
+	orgID, userID, requestedBy int64,
+	requestType domainprivacy.RequestType,
+) (*dto.DataRequestResponse, error) {
+	if err := checkMember(ctx, organizations, orgID, userID); err != nil {
+		if err == domainuser.ErrNotMember {
+			return nil, domainuser.ErrUserNotFound
+		}
+		return nil, err
+	}
+	if _, err := userRepo.GetByID(ctx, orgID, userID); err != nil {
+		return nil, err
+	}
+
+	_, err := requests.FindActive(ctx, orgID, userID, requestType)
+	if err == nil {
+		return nil, domainprivacy.ErrRequestInProgress
+	}
+	if err != domainprivacy.ErrRequestNotFound {
+		return nil, err
+	}
+
+	created, err := requests.Create(ctx, &domainprivacy.DataRequest{
+		UserID:      userID,
+		OrgID:       orgID,
+		RequestedBy: requestedBy,
+		Type:        requestType,
+		Status:      domainprivacy.StatusPending,
+		CreatedAt:   time.Now(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return newDataRequestResponse(created), nil
+}
+
+// checkMember returns ErrNotMember unless userID belongs to orgID, orgID zero allows any user
+func checkMember(ctx context.Context, organizations domainuser.OrganizationRepository, orgID, userID int64) error {
+	if orgID == 0 {
+		return nil
+	}
+	_, err := organizations.GetMembership(ctx, orgID, userID)
+	return err
+}
+
+// newDataRequestResponse maps a request to its response DTO
+func newDataRequestResponse(r *domainprivacy.DataRequest) *dto.DataRequestResponse {
+	return &dto.DataRequestResponse{
+		ID:          r.ID,
+		UserID:      r.UserID,
+		Type:        string(r.Type),
+		Status:      string(r.Status),
+		Error:       r.Error,
+		CreatedAt:   r.CreatedAt,
+		StartedAt:   r.StartedAt,
+		CompletedAt: r.CompletedAt,
+		ExpiresAt:   r.ExpiresAt,
+	}
+}
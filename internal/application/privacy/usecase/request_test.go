@@ -0,0 +1,318 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	domainprivacy "github.com/rulzi/hexa-go/internal/domain/privacy"
+	domainuser "github.com/rulzi/hexa-go/internal/domain/user"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestRequestExportUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+	requests := &mockRequestRepository{}
+	users := &mockUserRepository{}
+	uc := NewRequestExportUseCase(requests, users, &mockOrganizationRepository{})
+
+	users.On("GetByID", ctx, int64(0), int64(1)).Return(&domainuser.User{ID: 1}, nil)
+	requests.On("FindActive", ctx, int64(0), int64(1), domainprivacy.RequestExport).Return(nil, domainprivacy.ErrRequestNotFound)
+	requests.On("Create", ctx, mock.MatchedBy(func(r *domainprivacy.DataRequest) bool {
+		return r.UserID == 1 && r.OrgID == 0 && r.RequestedBy == 1 && r.Type == domainprivacy.RequestExport && r.Status == domainprivacy.StatusPending
+	})).Return(&domainprivacy.DataRequest{ID: 9, UserID: 1, Type: domainprivacy.RequestExport, Status: domainprivacy.StatusPending}, nil)
+
+	result, err := uc.Execute(ctx, 0, 1, 1)
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(9), result.ID)
+	assert.Equal(t, "export", result.Type)
+	assert.Equal(t, "pending", result.Status)
+	requests.AssertExpectations(t)
+}
+
+func TestRequestExportUseCase_Execute_InProgress(t *testing.T) {
+	ctx := context.Background()
+	requests := &mockRequestRepository{}
+	users := &mockUserRepository{}
+	uc := NewRequestExportUseCase(requests, users, &mockOrganizationRepository{})
+
+	users.On("GetByID", ctx, int64(0), int64(1)).Return(&domainuser.User{ID: 1}, nil)
+	requests.On("FindActive", ctx, int64(0), int64(1), domainprivacy.RequestExport).Return(&domainprivacy.DataRequest{ID: 8}, nil)
+
+	result, err := uc.Execute(ctx, 0, 1, 1)
+
+	assert.Nil(t, result)
+	assert.Equal(t, domainprivacy.ErrRequestInProgress, err)
+	requests.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+}
+
+func TestRequestExportUseCase_Execute_OtherOrganization(t *testing.T) {
+	ctx := context.Background()
+	requests := &mockRequestRepository{}
+	users := &mockUserRepository{}
+	orgs := &mockOrganizationRepository{}
+	uc := NewRequestExportUseCase(requests, users, orgs)
+
+	orgs.On("GetMembership", ctx, int64(2), int64(5)).Return(nil, domainuser.ErrNotMember)
+
+	result, err := uc.Execute(ctx, 2, 5, 1)
+
+	assert.Nil(t, result)
+	assert.Equal(t, domainuser.ErrUserNotFound, err)
+	requests.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+}
+
+func TestRequestErasureUseCase_Execute(t *testing.T) {
+	t.Run("unknown user", func(t *testing.T) {
+		ctx := context.Background()
+		requests := &mockRequestRepository{}
+		users := &mockUserRepository{}
+		uc := NewRequestErasureUseCase(requests, users, &mockOrganizationRepository{}, domainprivacy.ErasureDelete, 0)
+
+		users.On("GetByID", ctx, int64(0), int64(5)).Return(nil, domainuser.ErrUserNotFound)
+
+		_, err := uc.Execute(ctx, 0, 5, 1)
+
+		assert.Equal(t, domainuser.ErrUserNotFound, err)
+	})
+
+	t.Run("reassign target cannot be erased", func(t *testing.T) {
+		uc := NewRequestErasureUseCase(&mockRequestRepository{}, &mockUserRepository{}, &mockOrganizationRepository{}, domainprivacy.ErasureReassign, 1)
+
+		_, err := uc.Execute(context.Background(), 0, 1, 1)
+
+		assert.Equal(t, domainprivacy.ErrReassignTargetErased, err)
+	})
+
+	t.Run("filed by an admin", func(t *testing.T) {
+		ctx := context.Background()
+		requests := &mockRequestRepository{}
+		users := &mockUserRepository{}
+		orgs := &mockOrganizationRepository{}
+		uc := NewRequestErasureUseCase(requests, users, orgs, domainprivacy.ErasureReassign, 1)
+
+		orgs.On("GetMembership", ctx, int64(1), int64(5)).Return(&domainuser.Membership{OrgID: 1, UserID: 5}, nil)
+		users.On("GetByID", ctx, int64(1), int64(5)).Return(&domainuser.User{ID: 5}, nil)
+		requests.On("FindActive", ctx, int64(1), int64(5), domainprivacy.RequestErasure).Return(nil, domainprivacy.ErrRequestNotFound)
+		// Only covers the organization of the admin
+		requests.On("Create", ctx, mock.MatchedBy(func(r *domainprivacy.DataRequest) bool {
+			return r.UserID == 5 && r.OrgID == 1 && r.RequestedBy == 1 && r.Type == domainprivacy.RequestErasure
+		})).Return(&domainprivacy.DataRequest{ID: 3, UserID: 5, Type: domainprivacy.RequestErasure, Status: domainprivacy.StatusPending}, nil)
+
+		result, err := uc.Execute(ctx, 1, 5, 1)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "erasure", result.Type)
+	})
+}
+
+func TestGetDataRequestUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+	requests := &mockRequestRepository{}
+	uc := NewGetDataRequestUseCase(requests)
+
+	requests.On("GetByID", ctx, int64(9)).Return(&domainprivacy.DataRequest{ID: 9, UserID: 1, OrgID: 1, Status: domainprivacy.StatusRunning}, nil)
+	requests.On("GetByID", ctx, int64(10)).Return(&domainprivacy.DataRequest{ID: 10, UserID: 1, Status: domainprivacy.StatusRunning}, nil)
+
+	result, err := uc.Execute(ctx, 0, 9, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, "running", result.Status)
+
+	// Admins look requests up without a user
+	_, err = uc.Execute(ctx, 1, 9, 0)
+	assert.NoError(t, err)
+
+	// Requests of other users are hidden
+	_, err = uc.Execute(ctx, 0, 9, 2)
+	assert.Equal(t, domainprivacy.ErrRequestNotFound, err)
+
+	// So are requests filed for another organization
+	_, err = uc.Execute(ctx, 2, 9, 0)
+	assert.Equal(t, domainprivacy.ErrRequestNotFound, err)
+
+	// And requests covering the whole account, except to the user
+	_, err = uc.Execute(ctx, 1, 10, 0)
+	assert.Equal(t, domainprivacy.ErrRequestNotFound, err)
+	_, err = uc.Execute(ctx, 0, 10, 1)
+	assert.NoError(t, err)
+}
+
+func TestDownloadExportUseCase_Execute(t *testing.T) {
+	later := time.Now().Add(time.Hour)
+	earlier := time.Now().Add(-time.Hour)
+
+	tests := []struct {
+		name    string
+		request *domainprivacy.DataRequest
+		wantErr error
+	}{
+		{
+			name:    "completed export",
+			request: &domainprivacy.DataRequest{ID: 9, UserID: 1, Type: domainprivacy.RequestExport, Status: domainprivacy.StatusCompleted, ArchivePath: "export-9.zip", ExpiresAt: &later},
+		},
+		{
+			name:    "export still running",
+			request: &domainprivacy.DataRequest{ID: 9, UserID: 1, Type: domainprivacy.RequestExport, Status: domainprivacy.StatusRunning},
+			wantErr: domainprivacy.ErrExportNotReady,
+		},
+		{
+			name:    "export expired",
+			request: &domainprivacy.DataRequest{ID: 9, UserID: 1, Type: domainprivacy.RequestExport, Status: domainprivacy.StatusCompleted, ArchivePath: "export-9.zip", ExpiresAt: &earlier},
+			wantErr: domainprivacy.ErrExportExpired,
+		},
+		{
+			name:    "erasure request",
+			request: &domainprivacy.DataRequest{ID: 9, UserID: 1, Type: domainprivacy.RequestErasure, Status: domainprivacy.StatusCompleted},
+			wantErr: domainprivacy.ErrRequestNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			requests := &mockRequestRepository{}
+			archives := &mockFileStore{}
+			uc := NewDownloadExportUseCase(requests, archives)
+
+			requests.On("GetByID", ctx, int64(9)).Return(tt.request, nil)
+			archives.On("Get", ctx, "export-9.zip").Return(io.NopCloser(strings.NewReader("zip")), nil).Maybe()
+
+			archive, err := uc.Execute(ctx, 0, 9, 1)
+
+			if tt.wantErr != nil {
+				assert.Equal(t, tt.wantErr, err)
+				assert.Nil(t, archive)
+				archives.AssertNotCalled(t, "Get", mock.Anything, mock.Anything)
+			} else {
+				assert.NoError(t, err)
+				assert.NotNil(t, archive)
+			}
+		})
+	}
+}
+
+func TestEraseUserDataUseCase_Execute(t *testing.T) {
+	t.Run("delete policy", func(t *testing.T) {
+		ctx := context.Background()
+		anonymizer := &mockUserAnonymizer{}
+		articles := &mockContentDeleter{}
+		media := &mockContentDeleter{}
+		reassigner := &mockContentReassigner{}
+		uc := NewEraseUserDataUseCase(anonymizer, &mockOrganizationRepository{}, []ContentDeleter{articles, media}, []ContentReassigner{reassigner}, domainprivacy.ErasureDelete, 0)
+
+		anonymizer.On("Execute", ctx, int64(5)).Return(nil)
+		articles.On("Execute", ctx, int64(0), int64(5)).Return(int64(3), nil)
+		media.On("Execute", ctx, int64(0), int64(5)).Return(int64(2), nil)
+
+		err := uc.Execute(ctx, 0, 5)
+
+		assert.NoError(t, err)
+		anonymizer.AssertExpectations(t)
+		articles.AssertExpectations(t)
+		media.AssertExpectations(t)
+		reassigner.AssertNotCalled(t, "Execute", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("filed for an organization", func(t *testing.T) {
+		ctx := context.Background()
+		anonymizer := &mockUserAnonymizer{}
+		orgs := &mockOrganizationRepository{}
+		articles := &mockContentDeleter{}
+		uc := NewEraseUserDataUseCase(anonymizer, orgs, []ContentDeleter{articles}, nil, domainprivacy.ErasureDelete, 0)
+
+		articles.On("Execute", ctx, int64(2), int64(5)).Return(int64(3), nil)
+		orgs.On("RemoveMember", ctx, int64(2), int64(5)).Return(nil)
+
+		err := uc.Execute(ctx, 2, 5)
+
+		assert.NoError(t, err)
+		articles.AssertExpectations(t)
+		orgs.AssertExpectations(t)
+		// The account itself is left alone
+		anonymizer.AssertNotCalled(t, "Execute", mock.Anything, mock.Anything)
+	})
+
+	t.Run("reassign policy after an earlier attempt", func(t *testing.T) {
+		ctx := context.Background()
+		anonymizer := &mockUserAnonymizer{}
+		deleter := &mockContentDeleter{}
+		articles := &mockContentReassigner{}
+		orgs := &mockOrganizationRepository{}
+		uc := NewEraseUserDataUseCase(anonymizer, orgs, []ContentDeleter{deleter}, []ContentReassigner{articles}, domainprivacy.ErasureReassign, 1)
+
+		// The content stays in the organizations of the user, the target belongs to all of them
+		orgs.On("ListByUser", ctx, int64(5)).Return([]*domainuser.OrganizationMembership{
+			{Organization: &domainuser.Organization{ID: 1}},
+			{Organization: &domainuser.Organization{ID: 2}},
+		}, nil)
+		orgs.On("GetMembership", ctx, int64(1), int64(1)).Return(&domainuser.Membership{OrgID: 1, UserID: 1}, nil)
+		orgs.On("GetMembership", ctx, int64(2), int64(1)).Return(&domainuser.Membership{OrgID: 2, UserID: 1}, nil)
+		// Already anonymized by the failed attempt
+		anonymizer.On("Execute", ctx, int64(5)).Return(domainuser.ErrUserNotFound)
+		articles.On("Execute", ctx, int64(0), int64(5), int64(1)).Return(int64(3), nil)
+
+		err := uc.Execute(ctx, 0, 5)
+
+		assert.NoError(t, err)
+		articles.AssertExpectations(t)
+		orgs.AssertExpectations(t)
+		deleter.AssertNotCalled(t, "Execute", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("reassign target outside the organization", func(t *testing.T) {
+		ctx := context.Background()
+		orgs := &mockOrganizationRepository{}
+		articles := &mockContentReassigner{}
+		uc := NewEraseUserDataUseCase(&mockUserAnonymizer{}, orgs, nil, []ContentReassigner{articles}, domainprivacy.ErasureReassign, 1)
+
+		orgs.On("GetMembership", ctx, int64(2), int64(1)).Return(nil, domainuser.ErrNotMember)
+
+		err := uc.Execute(ctx, 2, 5)
+
+		// The content is not handed over to another organization, the user stays a member
+		assert.Equal(t, domainprivacy.ErrReassignTargetNotMember, err)
+		articles.AssertNotCalled(t, "Execute", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+		orgs.AssertNotCalled(t, "RemoveMember", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("reassign target outside one organization of the account", func(t *testing.T) {
+		ctx := context.Background()
+		anonymizer := &mockUserAnonymizer{}
+		orgs := &mockOrganizationRepository{}
+		articles := &mockContentReassigner{}
+		uc := NewEraseUserDataUseCase(anonymizer, orgs, nil, []ContentReassigner{articles}, domainprivacy.ErasureReassign, 1)
+
+		orgs.On("ListByUser", ctx, int64(5)).Return([]*domainuser.OrganizationMembership{
+			{Organization: &domainuser.Organization{ID: 1}},
+			{Organization: &domainuser.Organization{ID: 2}},
+		}, nil)
+		orgs.On("GetMembership", ctx, int64(1), int64(1)).Return(&domainuser.Membership{OrgID: 1, UserID: 1}, nil)
+		orgs.On("GetMembership", ctx, int64(2), int64(1)).Return(nil, domainuser.ErrNotMember)
+
+		err := uc.Execute(ctx, 0, 5)
+
+		assert.Equal(t, domainprivacy.ErrReassignTargetNotMember, err)
+		anonymizer.AssertNotCalled(t, "Execute", mock.Anything, mock.Anything)
+		articles.AssertNotCalled(t, "Execute", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("anonymization fails", func(t *testing.T) {
+		ctx := context.Background()
+		anonymizer := &mockUserAnonymizer{}
+		articles := &mockContentDeleter{}
+		uc := NewEraseUserDataUseCase(anonymizer, &mockOrganizationRepository{}, []ContentDeleter{articles}, nil, domainprivacy.ErasureDelete, 0)
+
+		dbErr := errors.New("database error")
+		anonymizer.On("Execute", ctx, int64(5)).Return(dbErr)
+
+		err := uc.Execute(ctx, 0, 5)
+
+		assert.Equal(t, dbErr, err)
+		articles.AssertNotCalled(t, "Execute", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
@@ -1,21 +1,152 @@
 package dto
 
-// CreateUserRequest represents the request DTO for creating a user
+import "time"
+
+// CreateUserRequest represents the request DTO for creating a user.
+// Passwords are checked against the password policy by the use cases, not here.
 type CreateUserRequest struct {
 	Name     string `json:"name" binding:"required"`
 	Email    string `json:"email" binding:"required,email"`
-	Password string `json:"password" binding:"required,min=6"`
+	Password string `json:"password" binding:"required"`
+	Role     string `json:"role,omitempty" binding:"omitempty,oneof=admin editor author reader"` // Optional
+	OrgID    int64  `json:"-"`                                                                   // Organization to join, zero for the default one
+
+	// EmailVerified marks the email as verified, for invitees who proved they own it
+	EmailVerified bool `json:"-"`
 }
 
-// UpdateUserRequest represents the request DTO for updating a user
+// UpdateUserRequest represents the request DTO for an admin updating a user, empty fields are left unchanged.
+// Passwords are checked against the password policy by the use case, not here.
 type UpdateUserRequest struct {
-	Name     string `json:"name" binding:"required"`
-	Email    string `json:"email" binding:"required,email"`
-	Password string `json:"password,omitempty"` // Optional
+	Name     string `json:"name,omitempty"`                                                      // Optional
+	Email    string `json:"email,omitempty" binding:"omitempty,email"`                           // Optional
+	Password string `json:"password,omitempty"`                                                  // Optional
+	Role     string `json:"role,omitempty" binding:"omitempty,oneof=admin editor author reader"` // Optional
+}
+
+// ListUsersRequest represents the query for listing users.
+// Search matches part of the name or email, dates are RFC 3339 timestamps.
+type ListUsersRequest struct {
+	Search      string     `form:"q"`
+	Role        string     `form:"role" binding:"omitempty,oneof=admin editor author reader"`
+	CreatedFrom *time.Time `form:"created_from"` // Inclusive
+	CreatedTo   *time.Time `form:"created_to"`   // Exclusive
+	Sort        string     `form:"sort" binding:"omitempty,oneof=created_at name email role"`
+	Order       string     `form:"order" binding:"omitempty,oneof=asc desc"`
+	Limit       int        `form:"limit"`
+	Offset      int        `form:"offset"`
 }
 
 // LoginRequest represents the request DTO for login
 type LoginRequest struct {
 	Email    string `json:"email" binding:"required,email"`
 	Password string `json:"password" binding:"required"`
+
+	// Filled in from the HTTP request, not the body
+	IPAddress string `json:"-"`
+	UserAgent string `json:"-"`
+}
+
+// RefreshTokenRequest represents the request DTO for refreshing an access token
+type RefreshTokenRequest struct {
+	RefreshToken string `json:"refresh_token" binding:"required"`
+}
+
+// LogoutRequest represents the request DTO for logout
+type LogoutRequest struct {
+	RefreshToken string `json:"refresh_token,omitempty"` // Optional
+}
+
+// ForgotPasswordRequest represents the request DTO for requesting a password reset
+type ForgotPasswordRequest struct {
+	Email string `json:"email" binding:"required,email"`
+}
+
+// ResetPasswordRequest represents the request DTO for resetting a password with a reset token
+type ResetPasswordRequest struct {
+	Token    string `json:"token" binding:"required"`
+	Password string `json:"password" binding:"required"`
+}
+
+// ResendVerificationRequest represents the request DTO for resending the verification email
+type ResendVerificationRequest struct {
+	Email string `json:"email" binding:"required,email"`
+}
+
+// TwoFactorCodeRequest represents the request DTO for actions confirmed with a two-factor code.
+// The code is a one-time password or, where accepted, a recovery code.
+type TwoFactorCodeRequest struct {
+	Code string `json:"code" binding:"required"`
+}
+
+// LoginTwoFactorRequest represents the request DTO for completing a login with a two-factor code
+type LoginTwoFactorRequest struct {
+	ChallengeToken string `json:"challenge_token" binding:"required"`
+	Code           string `json:"code" binding:"required"`
+
+	// Filled in from the HTTP request, not the body
+	IPAddress string `json:"-"`
+	UserAgent string `json:"-"`
+}
+
+// CreateAPIKeyRequest represents the request DTO for creating an API key.
+// Scopes are permissions such as "articles:read", none means everything the owner may do.
+type CreateAPIKeyRequest struct {
+	Name      string     `json:"name" binding:"required"`
+	Scopes    []string   `json:"scopes"`
+	ExpiresAt *time.Time `json:"expires_at"`
+}
+
+// IdentityCallbackRequest represents the redirect back from an identity provider
+type IdentityCallbackRequest struct {
+	Code  string `form:"code" binding:"required"`
+	State string `form:"state" binding:"required"`
+
+	// Filled in from the HTTP request, not the query
+	IPAddress string `form:"-"`
+	UserAgent string `form:"-"`
+}
+
+// IdentityLoginCodeRequest represents the request DTO for trading the one-time code of a sign-in
+// with an identity provider for tokens
+type IdentityLoginCodeRequest struct {
+	Code string `json:"code" binding:"required"`
+
+	// Filled in from the HTTP request, not the body
+	IPAddress string `json:"-"`
+	UserAgent string `json:"-"`
+}
+
+// UpdateProfileRequest represents the request DTO for users updating their own profile.
+// The role and password can't be changed here.
+type UpdateProfileRequest struct {
+	Name  string `json:"name" binding:"required"`
+	Email string `json:"email" binding:"required,email"`
+}
+
+// ChangePasswordRequest represents the request DTO for users changing their own password
+type ChangePasswordRequest struct {
+	CurrentPassword string `json:"current_password" binding:"required"`
+	NewPassword     string `json:"new_password" binding:"required"`
+}
+
+// CreateOrganizationRequest represents the request DTO for creating an organization
+type CreateOrganizationRequest struct {
+	Name string `json:"name" binding:"required,max=255"`
+}
+
+// CreateInvitationRequest represents the request DTO for inviting someone to join an organization.
+// Without expires_at the invitation expires after the configured time.
+type CreateInvitationRequest struct {
+	Email     string     `json:"email" binding:"required,email"`
+	Role      string     `json:"role,omitempty" binding:"omitempty,oneof=admin editor author reader"`
+	ExpiresAt *time.Time `json:"expires_at"`
+}
+
+// AcceptInvitationRequest represents the request DTO for accepting an invitation.
+// Name and password are required to create an account, an existing account joins without them.
+type AcceptInvitationRequest struct {
+	Token    string `json:"token" binding:"required"`
+	Name     string `json:"name,omitempty"`
+	Password string `json:"password,omitempty"`
 }
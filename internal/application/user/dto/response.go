@@ -4,11 +4,14 @@ import "time"
 
 // UserResponse represents the response DTO for user
 type UserResponse struct {
-	ID        int64     `json:"id"`
-	Name      string    `json:"name"`
-	Email     string    `json:"email"`
-	CreatedAt time.Time `json:"created_at"`
-	UpdatedAt time.Time `json:"updated_at"`
+	ID              int64      `json:"id"`
+	Name            string     `json:"name"`
+	Email           string     `json:"email"`
+	Role            string     `json:"role"`
+	EmailVerifiedAt *time.Time `json:"email_verified_at"`
+	CreatedAt       time.Time  `json:"created_at"`
+	UpdatedAt       time.Time  `json:"updated_at"`
+	DeletedAt       *time.Time `json:"deleted_at,omitempty"`
 }
 
 // ListUsersResponse represents the response DTO for listing users
@@ -19,8 +22,102 @@ type ListUsersResponse struct {
 	Offset int            `json:"offset"`
 }
 
-// LoginResponse represents the response DTO for login
+// LoginResponse represents the response DTO for login.
+// Users with two-factor authentication get a challenge token instead of tokens.
 type LoginResponse struct {
-	Token string       `json:"token"`
-	User  UserResponse `json:"user"`
+	Token             string        `json:"token,omitempty"`
+	RefreshToken      string        `json:"refresh_token,omitempty"`
+	User              *UserResponse `json:"user,omitempty"`
+	TwoFactorRequired bool          `json:"two_factor_required,omitempty"`
+	ChallengeToken    string        `json:"challenge_token,omitempty"`
+}
+
+// TokenResponse represents the response DTO for an issued token pair
+type TokenResponse struct {
+	Token        string `json:"token"`
+	RefreshToken string `json:"refresh_token"`
+}
+
+// ImpersonationResponse represents the response DTO for a token that lets an admin act as another user.
+// The token can't be refreshed and only lasts a short time.
+type ImpersonationResponse struct {
+	Token          string       `json:"token"`
+	ImpersonatedBy int64        `json:"impersonated_by"`
+	User           UserResponse `json:"user"`
+}
+
+// LoginAttemptResponse represents the response DTO for a recorded login attempt
+type LoginAttemptResponse struct {
+	ID        int64     `json:"id"`
+	IPAddress string    `json:"ip_address"`
+	UserAgent string    `json:"user_agent"`
+	Success   bool      `json:"success"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// ListLoginAttemptsResponse represents the response DTO for the login activity of a user
+type ListLoginAttemptsResponse struct {
+	Attempts []LoginAttemptResponse `json:"attempts"`
+	Limit    int                    `json:"limit"`
+}
+
+// SessionResponse represents the response DTO for an active session of a user
+type SessionResponse struct {
+	ID             string    `json:"id"`
+	UserAgent      string    `json:"user_agent"`
+	IPAddress      string    `json:"ip_address"`
+	CreatedAt      time.Time `json:"created_at"`
+	LastSeenAt     time.Time `json:"last_seen_at"`
+	Current        bool      `json:"current"`
+	ImpersonatedBy int64     `json:"impersonated_by,omitempty"`
+}
+
+// TwoFactorEnrollmentResponse represents the response DTO for starting two-factor enrollment
+type TwoFactorEnrollmentResponse struct {
+	Secret          string `json:"secret"`
+	ProvisioningURI string `json:"provisioning_uri"`
+}
+
+// RecoveryCodesResponse represents the response DTO for newly issued recovery codes
+type RecoveryCodesResponse struct {
+	RecoveryCodes []string `json:"recovery_codes"`
+}
+
+// APIKeyResponse represents the response DTO for an API key, without the key itself
+type APIKeyResponse struct {
+	ID         int64      `json:"id"`
+	Name       string     `json:"name"`
+	Prefix     string     `json:"prefix"`
+	Scopes     []string   `json:"scopes"`
+	ExpiresAt  *time.Time `json:"expires_at"`
+	LastUsedAt *time.Time `json:"last_used_at"`
+	CreatedAt  time.Time  `json:"created_at"`
+}
+
+// CreateAPIKeyResponse represents the response DTO for a new API key.
+// The key is only ever shown in this response.
+type CreateAPIKeyResponse struct {
+	APIKeyResponse
+	Key string `json:"key"`
+}
+
+// OrganizationResponse represents the response DTO for an organization seen by one of its members
+type OrganizationResponse struct {
+	ID        int64     `json:"id"`
+	Name      string    `json:"name"`
+	Role      string    `json:"role"`
+	Current   bool      `json:"current"`
+	JoinedAt  time.Time `json:"joined_at"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// InvitationResponse represents the response DTO for an invitation, without its token
+type InvitationResponse struct {
+	ID        int64     `json:"id"`
+	OrgID     int64     `json:"org_id"`
+	Email     string    `json:"email"`
+	Role      string    `json:"role"`
+	InvitedBy int64     `json:"invited_by"`
+	ExpiresAt time.Time `json:"expires_at"`
+	CreatedAt time.Time `json:"created_at"`
 }
@@ -0,0 +1,121 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/rulzi/hexa-go/internal/application/user/dto"
+	domainuser "github.com/rulzi/hexa-go/internal/domain/user"
+)
+
+// UserCreator creates user accounts, implemented by CreateUserUseCase
+type UserCreator interface {
+	Execute(ctx context.Context, req dto.CreateUserRequest) (*dto.UserResponse, error)
+}
+
+// AcceptInvitationUseCase handles accepting an invitation, which creates the account of the
+// invitee or adds an existing account to the organization
+type AcceptInvitationUseCase struct {
+	invitations   domainuser.InvitationRepository
+	userRepo      domainuser.Repository
+	organizations domainuser.OrganizationRepository
+	createUser    UserCreator
+	mode          domainuser.RegistrationMode
+}
+
+// NewAcceptInvitationUseCase creates a new AcceptInvitationUseCase
+func NewAcceptInvitationUseCase(
+	invitations domainuser.InvitationRepository,
+	userRepo domainuser.Repository,
+	organizations domainuser.OrganizationRepository,
+	createUser UserCreator,
+	mode domainuser.RegistrationMode,
+) *AcceptInvitationUseCase {
+	return &AcceptInvitationUseCase{
+		invitations:   invitations,
+		userRepo:      userRepo,
+		organizations: organizations,
+		createUser:    createUser,
+		mode:          mode,
+	}
+}
+
+// Execute adds the invitee to the organization with the role of the invitation.
+// An existing account joins as it is, otherwise the account is created with req.Name and
+// req.Password. The email is verified already, the invitation token was sent to it.
+func (uc *AcceptInvitationUseCase) Execute(ctx context.Context, req dto.AcceptInvitationRequest) (*dto.UserResponse, error) {
+	if !uc.mode.AllowsInvitations() {
+		return nil, domainuser.ErrRegistrationClosed
+	}
+
+	invitation, err := uc.invitations.GetByHash(ctx, domainuser.HashToken(req.Token))
+	if err != nil || invitation == nil {
+		return nil, domainuser.ErrInvalidInvitation
+	}
+
+	if invitation.IsAccepted() || invitation.IsExpired(time.Now()) {
+		return nil, domainuser.ErrInvalidInvitation
+	}
+
+	var accepted *dto.UserResponse
+	existingUser, err := uc.userRepo.GetByEmail(ctx, invitation.Email)
+	if err == nil && existingUser != nil {
+		accepted, err = uc.join(ctx, invitation, existingUser)
+	} else {
+		accepted, err = uc.register(ctx, invitation, req)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.invitations.MarkAccepted(ctx, invitation.ID); err != nil {
+		return nil, err
+	}
+
+	return accepted, nil
+}
+
+// join adds an existing account to the organization of the invitation.
+// A failed attempt can be retried, the account being a member already is not an error.
+func (uc *AcceptInvitationUseCase) join(ctx context.Context, invitation *domainuser.Invitation, u *domainuser.User) (*dto.UserResponse, error) {
+	err := uc.organizations.AddMember(ctx, &domainuser.Membership{
+		OrgID:     invitation.OrgID,
+		UserID:    u.ID,
+		Role:      invitation.Role,
+		CreatedAt: time.Now(),
+	})
+	if err != nil && err != domainuser.ErrAlreadyMember {
+		return nil, err
+	}
+
+	return &dto.UserResponse{
+		ID:              u.ID,
+		Name:            u.Name,
+		Email:           u.Email,
+		Role:            string(invitation.Role),
+		EmailVerifiedAt: u.EmailVerifiedAt,
+		CreatedAt:       u.CreatedAt,
+		UpdatedAt:       u.UpdatedAt,
+	}, nil
+}
+
+// register creates the account of the invitee in the organization of the invitation
+func (uc *AcceptInvitationUseCase) register(ctx context.Context, invitation *domainuser.Invitation, req dto.AcceptInvitationRequest) (*dto.UserResponse, error) {
+	if req.Name == "" {
+		return nil, domainuser.ErrNameRequired
+	}
+	if req.Password == "" {
+		return nil, domainuser.ErrPasswordRequired
+	}
+
+	// Emails are unique, so concurrent requests cannot create the account twice
+	// and a failed attempt leaves the invitation usable
+	return uc.createUser.Execute(ctx, dto.CreateUserRequest{
+		Name:          req.Name,
+		Email:         invitation.Email,
+		Password:      req.Password,
+		Role:          string(invitation.Role),
+		OrgID:         invitation.OrgID,
+		EmailVerified: true,
+	})
+}
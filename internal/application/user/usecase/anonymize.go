@@ -0,0 +1,88 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	domainuser "github.com/rulzi/hexa-go/internal/domain/user"
+)
+
+// AnonymizeUserUseCase handles erasing the personal data of a user account.
+// The row is kept with anonymized values and moved to the trash, so content
+// that still references it stays consistent until it is purged.
+type AnonymizeUserUseCase struct {
+	userRepo       domainuser.Repository
+	twoFactors     domainuser.TwoFactorRepository
+	apiKeys        domainuser.APIKeyRepository
+	identities     domainuser.IdentityRepository
+	loginAttempts  domainuser.LoginAttemptRepository
+	passwords      domainuser.PasswordHistoryRepository
+	revokeSessions *RevokeAllSessionsUseCase
+}
+
+// NewAnonymizeUserUseCase creates a new AnonymizeUserUseCase
+func NewAnonymizeUserUseCase(
+	userRepo domainuser.Repository,
+	twoFactors domainuser.TwoFactorRepository,
+	apiKeys domainuser.APIKeyRepository,
+	identities domainuser.IdentityRepository,
+	loginAttempts domainuser.LoginAttemptRepository,
+	passwords domainuser.PasswordHistoryRepository,
+	revokeSessions *RevokeAllSessionsUseCase,
+) *AnonymizeUserUseCase {
+	return &AnonymizeUserUseCase{
+		userRepo:       userRepo,
+		twoFactors:     twoFactors,
+		apiKeys:        apiKeys,
+		identities:     identities,
+		loginAttempts:  loginAttempts,
+		passwords:      passwords,
+		revokeSessions: revokeSessions,
+	}
+}
+
+// Execute executes the anonymize user use case.
+// Returns ErrUserNotFound if the user does not exist or is already in the trash.
+func (uc *AnonymizeUserUseCase) Execute(ctx context.Context, id int64) error {
+	u, err := uc.userRepo.GetByID(ctx, 0, id)
+	if err != nil {
+		return err
+	}
+
+	// Sign the user out everywhere before the credentials go away
+	if err := uc.revokeSessions.Execute(ctx, id); err != nil {
+		return err
+	}
+	if err := uc.twoFactors.Delete(ctx, id); err != nil {
+		return err
+	}
+
+	keys, err := uc.apiKeys.ListByUser(ctx, id)
+	if err != nil {
+		return err
+	}
+	for _, key := range keys {
+		err := uc.apiKeys.Revoke(ctx, id, key.ID)
+		// A key revoked concurrently is already unusable
+		if err != nil && err != domainuser.ErrAPIKeyNotFound {
+			return err
+		}
+	}
+
+	if err := uc.identities.DeleteByUser(ctx, id); err != nil {
+		return err
+	}
+	if err := uc.loginAttempts.DeleteByUser(ctx, id); err != nil {
+		return err
+	}
+	if err := uc.passwords.DeleteByUser(ctx, id); err != nil {
+		return err
+	}
+
+	u.Anonymize(time.Now())
+	if _, err := uc.userRepo.Update(ctx, 0, u); err != nil {
+		return err
+	}
+
+	return uc.userRepo.Delete(ctx, 0, id)
+}
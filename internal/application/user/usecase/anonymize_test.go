@@ -0,0 +1,76 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	domainuser "github.com/rulzi/hexa-go/internal/domain/user"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestAnonymizeUserUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+	repo := &mockUserRepository{}
+	twoFactors := &mockTwoFactorRepository{}
+	apiKeys := &mockAPIKeyRepository{}
+	identities := &mockIdentityRepository{}
+	attempts := &mockLoginAttemptRepository{}
+	history := &mockPasswordHistoryRepository{}
+	sessions := &mockSessionRepository{}
+	refreshRepo := &mockRefreshTokenRepository{}
+	revokeSessions := NewRevokeAllSessionsUseCase(sessions, refreshRepo, &mockTokenRevocationStore{}, 15*time.Minute)
+	uc := NewAnonymizeUserUseCase(repo, twoFactors, apiKeys, identities, attempts, history, revokeSessions)
+
+	verifiedAt := time.Now()
+	repo.On("GetByID", ctx, int64(0), int64(1)).Return(&domainuser.User{
+		ID:              1,
+		Name:            "John Doe",
+		Email:           "john@example.com",
+		Password:        "hashed",
+		Role:            domainuser.RoleAuthor,
+		EmailVerifiedAt: &verifiedAt,
+	}, nil)
+	sessions.On("ListActiveByUser", ctx, int64(1)).Return([]*domainuser.Session{}, nil)
+	refreshRepo.On("RevokeByUser", ctx, int64(1)).Return(nil)
+	twoFactors.On("Delete", ctx, int64(1)).Return(nil)
+	apiKeys.On("ListByUser", ctx, int64(1)).Return([]*domainuser.APIKey{{ID: 5}, {ID: 6}}, nil)
+	apiKeys.On("Revoke", ctx, int64(1), int64(5)).Return(nil)
+	apiKeys.On("Revoke", ctx, int64(1), int64(6)).Return(domainuser.ErrAPIKeyNotFound)
+	identities.On("DeleteByUser", ctx, int64(1)).Return(nil)
+	attempts.On("DeleteByUser", ctx, int64(1)).Return(nil)
+	history.On("DeleteByUser", ctx, int64(1)).Return(nil)
+	repo.On("Update", ctx, int64(0), mock.MatchedBy(func(u *domainuser.User) bool {
+		return u.Name == domainuser.AnonymizedName &&
+			u.Email == "deleted-1@erased.invalid" &&
+			u.Password == "" &&
+			u.EmailVerifiedAt == nil
+	})).Return(&domainuser.User{ID: 1}, nil)
+	repo.On("Delete", ctx, int64(0), int64(1)).Return(nil)
+
+	err := uc.Execute(ctx, 1)
+
+	assert.NoError(t, err)
+	repo.AssertExpectations(t)
+	twoFactors.AssertExpectations(t)
+	apiKeys.AssertExpectations(t)
+	identities.AssertExpectations(t)
+	attempts.AssertExpectations(t)
+	history.AssertExpectations(t)
+	refreshRepo.AssertExpectations(t)
+}
+
+func TestAnonymizeUserUseCase_Execute_UserNotFound(t *testing.T) {
+	ctx := context.Background()
+	repo := &mockUserRepository{}
+	twoFactors := &mockTwoFactorRepository{}
+	uc := NewAnonymizeUserUseCase(repo, twoFactors, &mockAPIKeyRepository{}, &mockIdentityRepository{}, &mockLoginAttemptRepository{}, &mockPasswordHistoryRepository{}, nil)
+
+	repo.On("GetByID", ctx, int64(0), int64(1)).Return(nil, domainuser.ErrUserNotFound)
+
+	err := uc.Execute(ctx, 1)
+
+	assert.Equal(t, domainuser.ErrUserNotFound, err)
+	twoFactors.AssertNotCalled(t, "Delete", mock.Anything, mock.Anything)
+}
@@ -0,0 +1,197 @@
+package usecase
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/rulzi/hexa-go/internal/application/user/dto"
+	domainuser "github.com/rulzi/hexa-go/internal/domain/user"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestCreateAPIKeyUseCase_Execute(t *testing.T) {
+	past := time.Now().Add(-time.Hour)
+	future := time.Now().Add(24 * time.Hour)
+
+	tests := []struct {
+		name    string
+		role    domainuser.Role
+		req     dto.CreateAPIKeyRequest
+		wantErr error
+	}{
+		{name: "unrestricted key", role: domainuser.RoleAuthor, req: dto.CreateAPIKeyRequest{Name: "ci"}},
+		{
+			name: "scoped key with expiry",
+			role: domainuser.RoleAuthor,
+			req:  dto.CreateAPIKeyRequest{Name: "ci", Scopes: []string{"articles:read"}, ExpiresAt: &future},
+		},
+		{
+			name:    "unknown scope",
+			role:    domainuser.RoleAdmin,
+			req:     dto.CreateAPIKeyRequest{Name: "ci", Scopes: []string{"everything"}},
+			wantErr: domainuser.ErrInvalidAPIKeyScope,
+		},
+		{
+			name:    "scope beyond the owner's role",
+			role:    domainuser.RoleReader,
+			req:     dto.CreateAPIKeyRequest{Name: "ci", Scopes: []string{"articles:write"}},
+			wantErr: domainuser.ErrInvalidAPIKeyScope,
+		},
+		{
+			name:    "expiry in the past",
+			role:    domainuser.RoleAuthor,
+			req:     dto.CreateAPIKeyRequest{Name: "ci", ExpiresAt: &past},
+			wantErr: domainuser.ErrInvalidAPIKeyExpiry,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			repo := &mockUserRepository{}
+			apiKeys := &mockAPIKeyRepository{}
+
+			orgs := &mockOrganizationRepository{}
+
+			// The role of the membership counts, not the role of the account
+			repo.On("GetByID", ctx, int64(0), int64(1)).Return(&domainuser.User{ID: 1, Role: domainuser.RoleAdmin}, nil)
+			orgs.On("GetMembership", ctx, int64(4), int64(1)).Return(&domainuser.Membership{OrgID: 4, UserID: 1, Role: tt.role}, nil)
+			created := &domainuser.APIKey{}
+			apiKeys.On("Create", ctx, mock.AnythingOfType("*user.APIKey")).
+				Run(func(args mock.Arguments) {
+					*created = *args.Get(1).(*domainuser.APIKey)
+					created.ID = 7
+				}).
+				Return(created, nil)
+
+			result, err := NewCreateAPIKeyUseCase(repo, orgs, apiKeys).Execute(ctx, 1, 4, tt.req)
+
+			if tt.wantErr != nil {
+				assert.Equal(t, tt.wantErr, err)
+				assert.Nil(t, result)
+				apiKeys.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, int64(7), result.ID)
+			assert.True(t, strings.HasPrefix(result.Key, result.Prefix))
+			assert.Equal(t, len(tt.req.Scopes), len(result.Scopes))
+
+			stored := apiKeys.Calls[0].Arguments.Get(1).(*domainuser.APIKey)
+			assert.Equal(t, domainuser.HashToken(result.Key), stored.KeyHash)
+			assert.Equal(t, int64(4), stored.OrgID)
+			assert.NotContains(t, stored.KeyHash, result.Key)
+		})
+	}
+}
+
+func TestListAPIKeysUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+	apiKeys := &mockAPIKeyRepository{}
+
+	apiKeys.On("ListByUser", ctx, int64(1)).Return([]*domainuser.APIKey{
+		{ID: 7, Name: "ci", Prefix: "hxa_abcdefgh", KeyHash: "hash", Scopes: []domainuser.Permission{domainuser.PermArticlesRead}},
+	}, nil)
+
+	result, err := NewListAPIKeysUseCase(apiKeys).Execute(ctx, 1)
+
+	assert.NoError(t, err)
+	assert.Len(t, result, 1)
+	assert.Equal(t, "hxa_abcdefgh", result[0].Prefix)
+	assert.Equal(t, []string{"articles:read"}, result[0].Scopes)
+}
+
+func TestRevokeAPIKeyUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+	apiKeys := &mockAPIKeyRepository{}
+
+	apiKeys.On("Revoke", ctx, int64(1), int64(7)).Return(domainuser.ErrAPIKeyNotFound)
+
+	err := NewRevokeAPIKeyUseCase(apiKeys).Execute(ctx, 1, 7)
+
+	assert.Equal(t, domainuser.ErrAPIKeyNotFound, err)
+}
+
+func TestAuthenticateAPIKeyUseCase_Authenticate(t *testing.T) {
+	now := time.Now()
+	past := now.Add(-time.Hour)
+	recently := now.Add(-10 * time.Second)
+	verifiedAt := now.Add(-24 * time.Hour)
+	owner := &domainuser.User{ID: 1, Email: "ci@example.com", Role: domainuser.RoleEditor, EmailVerifiedAt: &verifiedAt}
+	keyHash := domainuser.HashToken("hxa_key")
+
+	tests := []struct {
+		name      string
+		apiKey    *domainuser.APIKey
+		lookupErr error
+		notMember bool
+		wantErr   error
+		wantTouch bool
+	}{
+		{
+			name:      "active key",
+			apiKey:    &domainuser.APIKey{ID: 7, UserID: 1, OrgID: 4, Scopes: []domainuser.Permission{domainuser.PermArticlesRead}},
+			wantTouch: true,
+		},
+		{
+			name:   "recently used key is not touched again",
+			apiKey: &domainuser.APIKey{ID: 7, UserID: 1, OrgID: 4, LastUsedAt: &recently},
+		},
+		{
+			name:      "owner left the organization of the key",
+			apiKey:    &domainuser.APIKey{ID: 7, UserID: 1, OrgID: 4},
+			notMember: true,
+			wantErr:   domainuser.ErrInvalidAPIKey,
+		},
+		{name: "unknown key", lookupErr: domainuser.ErrInvalidAPIKey, wantErr: domainuser.ErrInvalidAPIKey},
+		{name: "expired key", apiKey: &domainuser.APIKey{ID: 7, UserID: 1, ExpiresAt: &past}, wantErr: domainuser.ErrInvalidAPIKey},
+		{name: "revoked key", apiKey: &domainuser.APIKey{ID: 7, UserID: 1, RevokedAt: &past}, wantErr: domainuser.ErrInvalidAPIKey},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			repo := &mockUserRepository{}
+			orgs := &mockOrganizationRepository{}
+			apiKeys := &mockAPIKeyRepository{}
+
+			if tt.apiKey != nil {
+				apiKeys.On("GetByHash", ctx, keyHash).Return(tt.apiKey, nil)
+			} else {
+				apiKeys.On("GetByHash", ctx, keyHash).Return(nil, tt.lookupErr)
+			}
+			repo.On("GetByID", ctx, int64(0), int64(1)).Return(owner, nil)
+			if tt.notMember {
+				orgs.On("GetMembership", ctx, int64(4), int64(1)).Return(nil, domainuser.ErrNotMember)
+			} else {
+				orgs.On("GetMembership", ctx, int64(4), int64(1)).Return(&domainuser.Membership{OrgID: 4, UserID: 1, Role: domainuser.RoleAuthor}, nil)
+			}
+			apiKeys.On("TouchLastUsed", ctx, int64(7), mock.Anything).Return(nil)
+
+			claims, err := NewAuthenticateAPIKeyUseCase(repo, orgs, apiKeys).Authenticate(ctx, "hxa_key")
+
+			if tt.wantErr != nil {
+				assert.Equal(t, tt.wantErr, err)
+				assert.Nil(t, claims)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, owner.ID, claims.UserID)
+			assert.Equal(t, domainuser.RoleAuthor, claims.Role)
+			assert.Equal(t, int64(4), claims.OrgID)
+			assert.True(t, claims.EmailVerified)
+			assert.Empty(t, claims.TokenID)
+			assert.Equal(t, tt.apiKey.Scopes, claims.Scopes)
+			if tt.wantTouch {
+				apiKeys.AssertCalled(t, "TouchLastUsed", ctx, int64(7), mock.Anything)
+			} else {
+				apiKeys.AssertNotCalled(t, "TouchLastUsed", mock.Anything, mock.Anything, mock.Anything)
+			}
+		})
+	}
+}
@@ -0,0 +1,79 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	domainuser "github.com/rulzi/hexa-go/internal/domain/user"
+)
+
+// apiKeyTouchInterval limits how often the last-used time of a key is written
+const apiKeyTouchInterval = time.Minute
+
+// AuthenticateAPIKeyUseCase handles authenticating requests made with an API key
+type AuthenticateAPIKeyUseCase struct {
+	userRepo      domainuser.Repository
+	organizations domainuser.OrganizationRepository
+	apiKeys       domainuser.APIKeyRepository
+}
+
+// NewAuthenticateAPIKeyUseCase creates a new AuthenticateAPIKeyUseCase
+func NewAuthenticateAPIKeyUseCase(
+	userRepo domainuser.Repository,
+	organizations domainuser.OrganizationRepository,
+	apiKeys domainuser.APIKeyRepository,
+) *AuthenticateAPIKeyUseCase {
+	return &AuthenticateAPIKeyUseCase{
+		userRepo:      userRepo,
+		organizations: organizations,
+		apiKeys:       apiKeys,
+	}
+}
+
+// Authenticate resolves an API key to the claims of its owner in the organization of the key.
+// The owner and the membership are loaded on every request so role changes apply immediately,
+// a key stops working once its owner leaves the organization.
+func (uc *AuthenticateAPIKeyUseCase) Authenticate(ctx context.Context, key string) (*domainuser.TokenClaims, error) {
+	apiKey, err := uc.apiKeys.GetByHash(ctx, domainuser.HashToken(key))
+	if err != nil {
+		return nil, domainuser.ErrInvalidAPIKey
+	}
+
+	now := time.Now()
+	if !apiKey.IsActive(now) {
+		return nil, domainuser.ErrInvalidAPIKey
+	}
+
+	owner, err := uc.userRepo.GetByID(ctx, 0, apiKey.UserID)
+	if err != nil {
+		return nil, domainuser.ErrInvalidAPIKey
+	}
+
+	membership, err := uc.organizations.GetMembership(ctx, apiKey.OrgID, owner.ID)
+	if err != nil {
+		return nil, domainuser.ErrInvalidAPIKey
+	}
+
+	// Recording usage is best effort and throttled to spare a write per request
+	if apiKey.LastUsedAt == nil || now.Sub(*apiKey.LastUsedAt) >= apiKeyTouchInterval {
+		_ = uc.apiKeys.TouchLastUsed(ctx, apiKey.ID, now)
+	}
+
+	return &domainuser.TokenClaims{
+		UserID:        owner.ID,
+		Email:         owner.Email,
+		Role:          membership.Role,
+		EmailVerified: owner.IsEmailVerified(),
+		ExpiresAt:     derefTime(apiKey.ExpiresAt),
+		OrgID:         membership.OrgID,
+		Scopes:        apiKey.Scopes,
+	}, nil
+}
+
+// derefTime returns the zero time for nil
+func derefTime(t *time.Time) time.Time {
+	if t == nil {
+		return time.Time{}
+	}
+	return *t
+}
@@ -0,0 +1,73 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/rulzi/hexa-go/internal/application/user/dto"
+	domainuser "github.com/rulzi/hexa-go/internal/domain/user"
+)
+
+// ChangePasswordUseCase handles users changing their own password
+type ChangePasswordUseCase struct {
+	userRepo       domainuser.Repository
+	passwordHasher domainuser.PasswordHasher
+	revokeSessions *RevokeAllSessionsUseCase
+	passwords      *PasswordChecker
+}
+
+// NewChangePasswordUseCase creates a new ChangePasswordUseCase.
+// New passwords are checked against the password policy when a password checker is provided.
+func NewChangePasswordUseCase(
+	userRepo domainuser.Repository,
+	passwordHasher domainuser.PasswordHasher,
+	revokeSessions *RevokeAllSessionsUseCase,
+	passwords *PasswordChecker,
+) *ChangePasswordUseCase {
+	return &ChangePasswordUseCase{
+		userRepo:       userRepo,
+		passwordHasher: passwordHasher,
+		revokeSessions: revokeSessions,
+		passwords:      passwords,
+	}
+}
+
+// Execute executes the change password use case.
+// Every session is signed out, including the current one, so whoever
+// knew the old password loses access right away.
+func (uc *ChangePasswordUseCase) Execute(ctx context.Context, userID int64, req dto.ChangePasswordRequest) error {
+	existingUser, err := uc.userRepo.GetByID(ctx, 0, userID)
+	if err != nil {
+		return err
+	}
+
+	if !uc.passwordHasher.Verify(existingUser.Password, req.CurrentPassword) {
+		return domainuser.ErrIncorrectPassword
+	}
+
+	if uc.passwords != nil {
+		if err := uc.passwords.Check(ctx, existingUser, req.NewPassword); err != nil {
+			return err
+		}
+	}
+
+	hashedPassword, err := uc.passwordHasher.Hash(req.NewPassword)
+	if err != nil {
+		return err
+	}
+
+	existingUser.Password = hashedPassword
+	existingUser.UpdatedAt = time.Now()
+
+	if _, err := uc.userRepo.Update(ctx, 0, existingUser); err != nil {
+		return err
+	}
+
+	if uc.passwords != nil {
+		if err := uc.passwords.Remember(ctx, existingUser.ID, hashedPassword); err != nil {
+			return err
+		}
+	}
+
+	return uc.revokeSessions.Execute(ctx, existingUser.ID)
+}
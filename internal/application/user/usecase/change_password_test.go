@@ -0,0 +1,91 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/rulzi/hexa-go/internal/application/user/dto"
+	domainuser "github.com/rulzi/hexa-go/internal/domain/user"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestChangePasswordUseCase_Execute(t *testing.T) {
+	req := dto.ChangePasswordRequest{CurrentPassword: "old_password", NewPassword: "new_password"}
+
+	tests := []struct {
+		name       string
+		setupMocks func(*mockUserRepository, *mockPasswordHasher, *mockRefreshTokenRepository)
+		wantErr    error
+	}{
+		{
+			name: "success signs out every session",
+			setupMocks: func(repo *mockUserRepository, passwordHasher *mockPasswordHasher, refreshRepo *mockRefreshTokenRepository) {
+				repo.On("GetByID", mock.Anything, int64(0), int64(1)).Return(&domainuser.User{ID: 1, Password: "old_hash"}, nil)
+				passwordHasher.On("Verify", "old_hash", "old_password").Return(true)
+				passwordHasher.On("Hash", "new_password").Return("new_hash", nil)
+				repo.On("Update", mock.Anything, int64(0), mock.MatchedBy(func(u *domainuser.User) bool {
+					return u.Password == "new_hash"
+				})).Return(&domainuser.User{ID: 1}, nil)
+				refreshRepo.On("RevokeByUser", mock.Anything, int64(1)).Return(nil)
+			},
+		},
+		{
+			name: "wrong current password",
+			setupMocks: func(repo *mockUserRepository, passwordHasher *mockPasswordHasher, refreshRepo *mockRefreshTokenRepository) {
+				repo.On("GetByID", mock.Anything, int64(0), int64(1)).Return(&domainuser.User{ID: 1, Password: "old_hash"}, nil)
+				passwordHasher.On("Verify", "old_hash", "old_password").Return(false)
+			},
+			wantErr: domainuser.ErrIncorrectPassword,
+		},
+		{
+			name: "user not found",
+			setupMocks: func(repo *mockUserRepository, passwordHasher *mockPasswordHasher, refreshRepo *mockRefreshTokenRepository) {
+				repo.On("GetByID", mock.Anything, int64(0), int64(1)).Return(nil, domainuser.ErrUserNotFound)
+			},
+			wantErr: domainuser.ErrUserNotFound,
+		},
+		{
+			name: "error on update",
+			setupMocks: func(repo *mockUserRepository, passwordHasher *mockPasswordHasher, refreshRepo *mockRefreshTokenRepository) {
+				repo.On("GetByID", mock.Anything, int64(0), int64(1)).Return(&domainuser.User{ID: 1, Password: "old_hash"}, nil)
+				passwordHasher.On("Verify", "old_hash", "old_password").Return(true)
+				passwordHasher.On("Hash", "new_password").Return("new_hash", nil)
+				repo.On("Update", mock.Anything, int64(0), mock.Anything).Return(nil, errors.New("database error"))
+			},
+			wantErr: errors.New("database error"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &mockUserRepository{}
+			passwordHasher := &mockPasswordHasher{}
+			refreshRepo := &mockRefreshTokenRepository{}
+			sessions := &mockSessionRepository{}
+			revocations := &mockTokenRevocationStore{}
+			sessions.On("ListActiveByUser", mock.Anything, int64(1)).Return([]*domainuser.Session{{ID: "current"}}, nil)
+			sessions.On("Revoke", mock.Anything, "current").Return(nil)
+			revocations.On("Revoke", mock.Anything, domainuser.SessionRevocationID("current"), mock.Anything).Return(nil)
+			tt.setupMocks(repo, passwordHasher, refreshRepo)
+
+			revokeSessions := NewRevokeAllSessionsUseCase(sessions, refreshRepo, revocations, 15*time.Minute)
+			uc := NewChangePasswordUseCase(repo, passwordHasher, revokeSessions, nil)
+			err := uc.Execute(context.Background(), 1, req)
+
+			if tt.wantErr != nil {
+				assert.Equal(t, tt.wantErr, err)
+				refreshRepo.AssertNotCalled(t, "RevokeByUser", mock.Anything, mock.Anything)
+				revocations.AssertNotCalled(t, "Revoke", mock.Anything, mock.Anything, mock.Anything)
+			} else {
+				assert.NoError(t, err)
+				revocations.AssertExpectations(t)
+			}
+			repo.AssertExpectations(t)
+			passwordHasher.AssertExpectations(t)
+			refreshRepo.AssertExpectations(t)
+		})
+	}
+}
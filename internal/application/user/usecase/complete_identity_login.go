@@ -0,0 +1,207 @@
+package usecase
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/rulzi/hexa-go/internal/application/user/dto"
+	domainuser "github.com/rulzi/hexa-go/internal/domain/user"
+)
+
+// CompleteIdentityLoginUseCase handles the callback of the identity provider.
+// The identity is linked to a local user, who gets a one-time login code that
+// RedeemIdentityLoginUseCase trades for our own tokens like after a password login.
+// Multi-factor authentication is left to the identity provider.
+type CompleteIdentityLoginUseCase struct {
+	userRepo       domainuser.Repository
+	identities     domainuser.IdentityRepository
+	provider       domainuser.IdentityProvider
+	states         domainuser.AuthorizationStateStore
+	codes          domainuser.LoginCodeStore
+	codeTTL        time.Duration
+	passwordHasher domainuser.PasswordHasher
+	policy         domainuser.EmailVerificationPolicy
+	organizations  domainuser.OrganizationRepository
+	defaultOrgID   int64
+	autoProvision  bool
+}
+
+// NewCompleteIdentityLoginUseCase creates a new CompleteIdentityLoginUseCase.
+// With autoProvision, identities without a local user get a new account with the default role
+// in the organization defaultOrgID.
+func NewCompleteIdentityLoginUseCase(
+	userRepo domainuser.Repository,
+	identities domainuser.IdentityRepository,
+	provider domainuser.IdentityProvider,
+	states domainuser.AuthorizationStateStore,
+	codes domainuser.LoginCodeStore,
+	codeTTL time.Duration,
+	passwordHasher domainuser.PasswordHasher,
+	policy domainuser.EmailVerificationPolicy,
+	organizations domainuser.OrganizationRepository,
+	defaultOrgID int64,
+	autoProvision bool,
+) *CompleteIdentityLoginUseCase {
+	return &CompleteIdentityLoginUseCase{
+		userRepo:       userRepo,
+		identities:     identities,
+		provider:       provider,
+		states:         states,
+		codes:          codes,
+		codeTTL:        codeTTL,
+		passwordHasher: passwordHasher,
+		policy:         policy,
+		organizations:  organizations,
+		defaultOrgID:   defaultOrgID,
+		autoProvision:  autoProvision,
+	}
+}
+
+// Execute executes the complete identity login use case and returns the one-time login code of the user.
+// Only the hash of the code is stored, it is redeemed once within the code TTL.
+func (uc *CompleteIdentityLoginUseCase) Execute(ctx context.Context, req dto.IdentityCallbackRequest) (string, error) {
+	// The state is single use and ties the callback to a sign-in started here
+	state, err := uc.states.Take(ctx, domainuser.HashToken(req.State))
+	if err != nil {
+		return "", err
+	}
+
+	identity, err := uc.provider.Exchange(ctx, req.Code, state.CodeVerifier, state.Nonce)
+	if err != nil {
+		return "", err
+	}
+
+	userEntity, err := uc.resolveUser(ctx, identity)
+	if err != nil {
+		return "", err
+	}
+
+	if uc.policy.BlocksLogin() && !userEntity.IsEmailVerified() {
+		return "", domainuser.ErrEmailNotVerified
+	}
+
+	code, err := domainuser.GenerateSecureToken(32)
+	if err != nil {
+		return "", err
+	}
+	if err := uc.codes.Save(ctx, domainuser.HashToken(code), userEntity.ID, uc.codeTTL); err != nil {
+		return "", err
+	}
+
+	return code, nil
+}
+
+// resolveUser returns the user linked to the identity, linking an existing user
+// with the same email or provisioning a new one on the first sign-in
+func (uc *CompleteIdentityLoginUseCase) resolveUser(ctx context.Context, identity *domainuser.ExternalIdentity) (*domainuser.User, error) {
+	link, err := uc.identities.GetByProviderSubject(ctx, identity.Provider, identity.Subject)
+	if err == nil {
+		return uc.userRepo.GetByID(ctx, 0, link.UserID)
+	}
+	if err != domainuser.ErrIdentityNotFound {
+		return nil, err
+	}
+
+	if identity.Email == "" {
+		return nil, domainuser.ErrInvalidIdentity
+	}
+
+	userEntity, err := uc.userRepo.GetByEmail(ctx, identity.Email)
+	switch {
+	case err == nil:
+		userEntity, err = uc.linkExisting(userEntity, identity)
+	case err == domainuser.ErrUserNotFound:
+		userEntity, err = uc.provision(ctx, identity)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = uc.identities.Create(ctx, &domainuser.UserIdentity{
+		UserID:    userEntity.ID,
+		Provider:  identity.Provider,
+		Subject:   identity.Subject,
+		Email:     identity.Email,
+		CreatedAt: time.Now(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return userEntity, nil
+}
+
+// linkExisting prepares linking an existing user. Only an email the provider has
+// verified proves the identity owns the account, anything else would allow a takeover.
+// An unverified local account is never linked either: whoever registered it may not
+// own the address and would keep signing in with their password next to the identity.
+// The owner claims such an account through a password reset first.
+func (uc *CompleteIdentityLoginUseCase) linkExisting(userEntity *domainuser.User, identity *domainuser.ExternalIdentity) (*domainuser.User, error) {
+	if !identity.EmailVerified {
+		return nil, domainuser.ErrIdentityEmailNotVerified
+	}
+	if !userEntity.IsEmailVerified() {
+		return nil, domainuser.ErrIdentityAccountNotVerified
+	}
+
+	return userEntity, nil
+}
+
+// provision creates a user for the identity. The account gets a random password
+// nobody knows, a local password can still be set with a password reset.
+func (uc *CompleteIdentityLoginUseCase) provision(ctx context.Context, identity *domainuser.ExternalIdentity) (*domainuser.User, error) {
+	if !uc.autoProvision {
+		return nil, domainuser.ErrIdentityNotProvisioned
+	}
+
+	password, err := domainuser.GenerateSecureToken(32)
+	if err != nil {
+		return nil, err
+	}
+	hashedPassword, err := uc.passwordHasher.Hash(password)
+	if err != nil {
+		return nil, err
+	}
+
+	name := identity.Name
+	if name == "" {
+		name, _, _ = strings.Cut(identity.Email, "@")
+	}
+
+	now := time.Now()
+	newUser := &domainuser.User{
+		Name:      name,
+		Email:     identity.Email,
+		Password:  hashedPassword,
+		Role:      domainuser.DefaultRole,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	if identity.EmailVerified {
+		newUser.EmailVerifiedAt = &now
+	}
+
+	if err := newUser.Validate(); err != nil {
+		return nil, err
+	}
+
+	createdUser, err := uc.userRepo.Create(ctx, newUser)
+	if err != nil {
+		return nil, err
+	}
+
+	if uc.organizations != nil {
+		err = uc.organizations.AddMember(ctx, &domainuser.Membership{
+			OrgID:     uc.defaultOrgID,
+			UserID:    createdUser.ID,
+			Role:      createdUser.Role,
+			CreatedAt: now,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return createdUser, nil
+}
@@ -0,0 +1,60 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/rulzi/hexa-go/internal/application/user/dto"
+	domainuser "github.com/rulzi/hexa-go/internal/domain/user"
+)
+
+// ConfirmTwoFactorUseCase handles enabling two-factor authentication with a first valid code
+type ConfirmTwoFactorUseCase struct {
+	twoFactors domainuser.TwoFactorRepository
+	totp       domainuser.TOTPAuthenticator
+	throttler  *LoginThrottler
+}
+
+// NewConfirmTwoFactorUseCase creates a new ConfirmTwoFactorUseCase
+func NewConfirmTwoFactorUseCase(
+	twoFactors domainuser.TwoFactorRepository,
+	totp domainuser.TOTPAuthenticator,
+	throttler *LoginThrottler,
+) *ConfirmTwoFactorUseCase {
+	return &ConfirmTwoFactorUseCase{
+		twoFactors: twoFactors,
+		totp:       totp,
+		throttler:  throttler,
+	}
+}
+
+// Execute executes the confirm two-factor use case and returns the recovery codes
+func (uc *ConfirmTwoFactorUseCase) Execute(ctx context.Context, userID int64, req dto.TwoFactorCodeRequest) (*dto.RecoveryCodesResponse, error) {
+	twoFactor, err := uc.twoFactors.GetByUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if twoFactor.IsEnabled() {
+		return nil, domainuser.ErrTwoFactorAlreadyEnabled
+	}
+
+	// Only a one-time password proves the authenticator app is set up
+	if err := checkThrottledTwoFactorCode(ctx, uc.throttler, uc.twoFactors, uc.totp, twoFactor, req.Code, false); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	twoFactor.EnabledAt = &now
+	twoFactor.UpdatedAt = now
+	if err := uc.twoFactors.Save(ctx, twoFactor); err != nil {
+		return nil, err
+	}
+
+	codes, err := issueRecoveryCodes(ctx, uc.twoFactors, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.RecoveryCodesResponse{RecoveryCodes: codes}, nil
+}
@@ -13,18 +13,32 @@ type CreateUserUseCase struct {
 	userRepo            domainuser.Repository
 	passwordHasher      domainuser.PasswordHasher
 	notificationService domainuser.NotificationService
+	verificationSender  *VerificationSender
+	organizations       domainuser.OrganizationRepository
+	defaultOrgID        int64
+	passwords           *PasswordChecker
 }
 
-// NewCreateUserUseCase creates a new CreateUserUseCase
+// NewCreateUserUseCase creates a new CreateUserUseCase.
+// New users join the organization of the request, or defaultOrgID, with their role.
+// Passwords are checked against the password policy when a password checker is provided.
 func NewCreateUserUseCase(
 	userRepo domainuser.Repository,
 	passwordHasher domainuser.PasswordHasher,
 	notificationService domainuser.NotificationService,
+	verificationSender *VerificationSender,
+	organizations domainuser.OrganizationRepository,
+	defaultOrgID int64,
+	passwords *PasswordChecker,
 ) *CreateUserUseCase {
 	return &CreateUserUseCase{
 		userRepo:            userRepo,
 		passwordHasher:      passwordHasher,
 		notificationService: notificationService,
+		verificationSender:  verificationSender,
+		organizations:       organizations,
+		defaultOrgID:        defaultOrgID,
+		passwords:           passwords,
 	}
 }
 
@@ -36,21 +50,39 @@ func (uc *CreateUserUseCase) Execute(ctx context.Context, req dto.CreateUserRequ
 		return nil, domainuser.ErrEmailExists
 	}
 
+	if uc.passwords != nil {
+		if err := uc.passwords.Check(ctx, &domainuser.User{Name: req.Name, Email: req.Email}, req.Password); err != nil {
+			return nil, err
+		}
+	}
+
 	// Hash password
 	hashedPassword, err := uc.passwordHasher.Hash(req.Password)
 	if err != nil {
 		return nil, err
 	}
 
+	// Users get the default role unless one is given
+	role := domainuser.Role(req.Role)
+	if role == "" {
+		role = domainuser.DefaultRole
+	}
+
 	// Create user entity
 	newUser := &domainuser.User{
 		Name:      req.Name,
 		Email:     req.Email,
 		Password:  hashedPassword,
+		Role:      role,
 		CreatedAt: time.Now(),
 		UpdatedAt: time.Now(),
 	}
 
+	if req.EmailVerified {
+		verifiedAt := newUser.CreatedAt
+		newUser.EmailVerifiedAt = &verifiedAt
+	}
+
 	// Validate entity
 	if err := newUser.Validate(); err != nil {
 		return nil, err
@@ -62,15 +94,45 @@ func (uc *CreateUserUseCase) Execute(ctx context.Context, req dto.CreateUserRequ
 		return nil, err
 	}
 
+	if uc.passwords != nil {
+		if err := uc.passwords.Remember(ctx, createdUser.ID, createdUser.Password); err != nil {
+			return nil, err
+		}
+	}
+
+	// Join the organization
+	if uc.organizations != nil {
+		orgID := req.OrgID
+		if orgID == 0 {
+			orgID = uc.defaultOrgID
+		}
+		err = uc.organizations.AddMember(ctx, &domainuser.Membership{
+			OrgID:     orgID,
+			UserID:    createdUser.ID,
+			Role:      createdUser.Role,
+			CreatedAt: createdUser.CreatedAt,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	// Send welcome email (external service)
 	_ = uc.notificationService.SendWelcomeEmail(ctx, createdUser.Email, createdUser.Name)
 
+	// Send verification link (external service)
+	if uc.verificationSender != nil && !createdUser.IsEmailVerified() {
+		_ = uc.verificationSender.Send(ctx, createdUser)
+	}
+
 	// Return response DTO
 	return &dto.UserResponse{
-		ID:        createdUser.ID,
-		Name:      createdUser.Name,
-		Email:     createdUser.Email,
-		CreatedAt: createdUser.CreatedAt,
-		UpdatedAt: createdUser.UpdatedAt,
+		ID:              createdUser.ID,
+		Name:            createdUser.Name,
+		Email:           createdUser.Email,
+		Role:            string(createdUser.Role),
+		EmailVerifiedAt: createdUser.EmailVerifiedAt,
+		CreatedAt:       createdUser.CreatedAt,
+		UpdatedAt:       createdUser.UpdatedAt,
 	}, nil
 }
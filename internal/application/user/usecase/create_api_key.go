@@ -0,0 +1,99 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/rulzi/hexa-go/internal/application/user/dto"
+	domainuser "github.com/rulzi/hexa-go/internal/domain/user"
+)
+
+// CreateAPIKeyUseCase handles creating API keys for machine clients
+type CreateAPIKeyUseCase struct {
+	userRepo      domainuser.Repository
+	organizations domainuser.OrganizationRepository
+	apiKeys       domainuser.APIKeyRepository
+}
+
+// NewCreateAPIKeyUseCase creates a new CreateAPIKeyUseCase
+func NewCreateAPIKeyUseCase(
+	userRepo domainuser.Repository,
+	organizations domainuser.OrganizationRepository,
+	apiKeys domainuser.APIKeyRepository,
+) *CreateAPIKeyUseCase {
+	return &CreateAPIKeyUseCase{
+		userRepo:      userRepo,
+		organizations: organizations,
+		apiKeys:       apiKeys,
+	}
+}
+
+// Execute executes the create API key use case.
+// The key acts in orgID, the organization the owner is signed in to.
+func (uc *CreateAPIKeyUseCase) Execute(ctx context.Context, userID, orgID int64, req dto.CreateAPIKeyRequest) (*dto.CreateAPIKeyResponse, error) {
+	if _, err := uc.userRepo.GetByID(ctx, 0, userID); err != nil {
+		return nil, err
+	}
+
+	membership, err := memberOf(ctx, uc.organizations, orgID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	// A key can never do more than its owner
+	scopes := make([]domainuser.Permission, len(req.Scopes))
+	for i, scope := range req.Scopes {
+		permission := domainuser.Permission(scope)
+		if !permission.IsValid() || !membership.Role.HasPermission(permission) {
+			return nil, domainuser.ErrInvalidAPIKeyScope
+		}
+		scopes[i] = permission
+	}
+
+	now := time.Now()
+	if req.ExpiresAt != nil && !req.ExpiresAt.After(now) {
+		return nil, domainuser.ErrInvalidAPIKeyExpiry
+	}
+
+	key, prefix, err := domainuser.GenerateAPIKey()
+	if err != nil {
+		return nil, err
+	}
+
+	created, err := uc.apiKeys.Create(ctx, &domainuser.APIKey{
+		UserID:    userID,
+		OrgID:     orgID,
+		Name:      req.Name,
+		Prefix:    prefix,
+		KeyHash:   domainuser.HashToken(key),
+		Scopes:    scopes,
+		ExpiresAt: req.ExpiresAt,
+		CreatedAt: now,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.CreateAPIKeyResponse{
+		APIKeyResponse: newAPIKeyResponse(created),
+		Key:            key,
+	}, nil
+}
+
+// newAPIKeyResponse builds the response for an API key, leaving out its hash
+func newAPIKeyResponse(k *domainuser.APIKey) dto.APIKeyResponse {
+	scopes := make([]string, len(k.Scopes))
+	for i, scope := range k.Scopes {
+		scopes[i] = string(scope)
+	}
+
+	return dto.APIKeyResponse{
+		ID:         k.ID,
+		Name:       k.Name,
+		Prefix:     k.Prefix,
+		Scopes:     scopes,
+		ExpiresAt:  k.ExpiresAt,
+		LastUsedAt: k.LastUsedAt,
+		CreatedAt:  k.CreatedAt,
+	}
+}
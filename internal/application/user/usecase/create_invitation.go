@@ -0,0 +1,111 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/rulzi/hexa-go/internal/application/user/dto"
+	domainuser "github.com/rulzi/hexa-go/internal/domain/user"
+)
+
+// CreateInvitationUseCase handles inviting someone to join an organization.
+// Someone without an account creates one when accepting, an existing account joins the organization.
+type CreateInvitationUseCase struct {
+	userRepo            domainuser.Repository
+	organizations       domainuser.OrganizationRepository
+	invitations         domainuser.InvitationRepository
+	notificationService domainuser.NotificationService
+	mode                domainuser.RegistrationMode
+	invitationTTL       time.Duration
+}
+
+// NewCreateInvitationUseCase creates a new CreateInvitationUseCase.
+// Invitations without an expiry expire after invitationTTL.
+func NewCreateInvitationUseCase(
+	userRepo domainuser.Repository,
+	organizations domainuser.OrganizationRepository,
+	invitations domainuser.InvitationRepository,
+	notificationService domainuser.NotificationService,
+	mode domainuser.RegistrationMode,
+	invitationTTL time.Duration,
+) *CreateInvitationUseCase {
+	return &CreateInvitationUseCase{
+		userRepo:            userRepo,
+		organizations:       organizations,
+		invitations:         invitations,
+		notificationService: notificationService,
+		mode:                mode,
+		invitationTTL:       invitationTTL,
+	}
+}
+
+// Execute invites req.Email to join orgID on behalf of invitedBy and sends the invitation.
+// Returns ErrAlreadyMember when the email belongs to a member of orgID. The membership of an
+// existing account is only added once the invitation is accepted with the token sent to its email.
+func (uc *CreateInvitationUseCase) Execute(ctx context.Context, orgID, invitedBy int64, req dto.CreateInvitationRequest) (*dto.InvitationResponse, error) {
+	if !uc.mode.AllowsInvitations() {
+		return nil, domainuser.ErrRegistrationClosed
+	}
+
+	existingUser, err := uc.userRepo.GetByEmail(ctx, req.Email)
+	if err == nil && existingUser != nil {
+		_, err := uc.organizations.GetMembership(ctx, orgID, existingUser.ID)
+		if err == nil {
+			return nil, domainuser.ErrAlreadyMember
+		}
+		if err != domainuser.ErrNotMember {
+			return nil, err
+		}
+	}
+
+	// Invitees get the default role unless one is given
+	role := domainuser.Role(req.Role)
+	if role == "" {
+		role = domainuser.DefaultRole
+	}
+	if !role.IsValid() {
+		return nil, domainuser.ErrInvalidRole
+	}
+
+	now := time.Now()
+	expiresAt := now.Add(uc.invitationTTL)
+	if req.ExpiresAt != nil {
+		if !req.ExpiresAt.After(now) {
+			return nil, domainuser.ErrInvalidInvitationExpiry
+		}
+		expiresAt = *req.ExpiresAt
+	}
+
+	token, err := domainuser.GenerateSecureToken(32)
+	if err != nil {
+		return nil, err
+	}
+
+	created, err := uc.invitations.Create(ctx, &domainuser.Invitation{
+		OrgID:     orgID,
+		Email:     req.Email,
+		Role:      role,
+		TokenHash: domainuser.HashToken(token),
+		InvitedBy: invitedBy,
+		ExpiresAt: expiresAt,
+		CreatedAt: now,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// The token only ever leaves through the notification
+	if err := uc.notificationService.SendInvitationEmail(ctx, created.Email, token, created.ExpiresAt); err != nil {
+		return nil, err
+	}
+
+	return &dto.InvitationResponse{
+		ID:        created.ID,
+		OrgID:     created.OrgID,
+		Email:     created.Email,
+		Role:      string(created.Role),
+		InvitedBy: created.InvitedBy,
+		ExpiresAt: created.ExpiresAt,
+		CreatedAt: created.CreatedAt,
+	}, nil
+}
@@ -0,0 +1,58 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/rulzi/hexa-go/internal/application/user/dto"
+	domainuser "github.com/rulzi/hexa-go/internal/domain/user"
+)
+
+// CreateOrganizationUseCase handles creating a new organization
+type CreateOrganizationUseCase struct {
+	organizations domainuser.OrganizationRepository
+}
+
+// NewCreateOrganizationUseCase creates a new CreateOrganizationUseCase
+func NewCreateOrganizationUseCase(organizations domainuser.OrganizationRepository) *CreateOrganizationUseCase {
+	return &CreateOrganizationUseCase{
+		organizations: organizations,
+	}
+}
+
+// Execute executes the create organization use case.
+// The user creating the organization becomes its first admin.
+func (uc *CreateOrganizationUseCase) Execute(ctx context.Context, userID int64, req dto.CreateOrganizationRequest) (*dto.OrganizationResponse, error) {
+	now := time.Now()
+	org := &domainuser.Organization{
+		Name:      req.Name,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	if err := org.Validate(); err != nil {
+		return nil, err
+	}
+
+	created, err := uc.organizations.Create(ctx, org)
+	if err != nil {
+		return nil, err
+	}
+
+	err = uc.organizations.AddMember(ctx, &domainuser.Membership{
+		OrgID:     created.ID,
+		UserID:    userID,
+		Role:      domainuser.RoleAdmin,
+		CreatedAt: now,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.OrganizationResponse{
+		ID:        created.ID,
+		Name:      created.Name,
+		Role:      string(domainuser.RoleAdmin),
+		JoinedAt:  now,
+		CreatedAt: created.CreatedAt,
+	}, nil
+}
@@ -20,7 +20,7 @@ func TestNewCreateUserUseCase(t *testing.T) {
 	passwordHasher := &mockPasswordHasher{}
 	notificationService := &mockNotificationService{}
 
-	uc := NewCreateUserUseCase(repo, passwordHasher, notificationService)
+	uc := NewCreateUserUseCase(repo, passwordHasher, notificationService, nil, nil, 0, nil)
 
 	assert.NotNil(t, uc)
 	assert.Equal(t, repo, uc.userRepo)
@@ -34,7 +34,7 @@ func TestCreateUserUseCase_Execute_Success(t *testing.T) {
 	passwordHasher := &mockPasswordHasher{}
 	notificationService := &mockNotificationService{}
 
-	uc := NewCreateUserUseCase(repo, passwordHasher, notificationService)
+	uc := NewCreateUserUseCase(repo, passwordHasher, notificationService, nil, nil, 0, nil)
 
 	req := dto.CreateUserRequest{
 		Name:     "Test User",
@@ -71,13 +71,55 @@ func TestCreateUserUseCase_Execute_Success(t *testing.T) {
 	notificationService.AssertExpectations(t)
 }
 
+func TestCreateUserUseCase_Execute_Role(t *testing.T) {
+	tests := []struct {
+		name     string
+		reqRole  string
+		wantRole domainuser.Role
+	}{
+		{name: "defaults to author", reqRole: "", wantRole: domainuser.DefaultRole},
+		{name: "explicit role", reqRole: "editor", wantRole: domainuser.RoleEditor},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			repo := &mockUserRepository{}
+			passwordHasher := &mockPasswordHasher{}
+			notificationService := &mockNotificationService{}
+
+			uc := NewCreateUserUseCase(repo, passwordHasher, notificationService, nil, nil, 0, nil)
+
+			req := dto.CreateUserRequest{
+				Name:     "Test User",
+				Email:    "test@example.com",
+				Password: "password123",
+				Role:     tt.reqRole,
+			}
+
+			repo.On("GetByEmail", ctx, req.Email).Return(nil, errors.New("not found"))
+			passwordHasher.On("Hash", req.Password).Return("hashed", nil)
+			repo.On("Create", ctx, mock.MatchedBy(func(u *domainuser.User) bool {
+				return u.Role == tt.wantRole
+			})).Return(&domainuser.User{ID: 1, Name: req.Name, Email: req.Email, Role: tt.wantRole}, nil)
+			notificationService.On("SendWelcomeEmail", ctx, req.Email, req.Name).Return(nil)
+
+			result, err := uc.Execute(ctx, req)
+
+			assert.NoError(t, err)
+			assert.Equal(t, string(tt.wantRole), result.Role)
+			repo.AssertExpectations(t)
+		})
+	}
+}
+
 func TestCreateUserUseCase_Execute_EmailExists(t *testing.T) {
 	ctx := context.Background()
 	repo := &mockUserRepository{}
 	passwordHasher := &mockPasswordHasher{}
 	notificationService := &mockNotificationService{}
 
-	uc := NewCreateUserUseCase(repo, passwordHasher, notificationService)
+	uc := NewCreateUserUseCase(repo, passwordHasher, notificationService, nil, nil, 0, nil)
 
 	req := dto.CreateUserRequest{
 		Name:     "Test User",
@@ -109,7 +151,7 @@ func TestCreateUserUseCase_Execute_GetByEmailErrorContinues(t *testing.T) {
 	passwordHasher := &mockPasswordHasher{}
 	notificationService := &mockNotificationService{}
 
-	uc := NewCreateUserUseCase(repo, passwordHasher, notificationService)
+	uc := NewCreateUserUseCase(repo, passwordHasher, notificationService, nil, nil, 0, nil)
 
 	req := dto.CreateUserRequest{
 		Name:     "Test User",
@@ -150,7 +192,7 @@ func TestCreateUserUseCase_Execute_PasswordHashError(t *testing.T) {
 	passwordHasher := &mockPasswordHasher{}
 	notificationService := &mockNotificationService{}
 
-	uc := NewCreateUserUseCase(repo, passwordHasher, notificationService)
+	uc := NewCreateUserUseCase(repo, passwordHasher, notificationService, nil, nil, 0, nil)
 
 	req := dto.CreateUserRequest{
 		Name:     "Test User",
@@ -174,13 +216,41 @@ func TestCreateUserUseCase_Execute_PasswordHashError(t *testing.T) {
 	repo.AssertNotCalled(t, "Create")
 }
 
+func TestCreateUserUseCase_Execute_WeakPassword(t *testing.T) {
+	ctx := context.Background()
+	repo := &mockUserRepository{}
+	passwordHasher := &mockPasswordHasher{}
+	notificationService := &mockNotificationService{}
+	passwords := NewPasswordChecker(domainuser.PasswordPolicy{MinLength: 8, DisallowPersonalInfo: true}, passwordHasher, nil, nil)
+
+	uc := NewCreateUserUseCase(repo, passwordHasher, notificationService, nil, nil, 0, passwords)
+
+	req := dto.CreateUserRequest{
+		Name:     "Test User",
+		Email:    "test@example.com",
+		Password: "test1",
+	}
+
+	repo.On("GetByEmail", ctx, req.Email).Return(nil, errors.New("not found"))
+
+	result, err := uc.Execute(ctx, req)
+
+	var policyErr *domainuser.PasswordPolicyError
+	assert.ErrorAs(t, err, &policyErr)
+	assert.Len(t, policyErr.Violations, 2)
+	assert.Nil(t, result)
+
+	passwordHasher.AssertNotCalled(t, "Hash", mock.Anything)
+	repo.AssertNotCalled(t, "Create")
+}
+
 func TestCreateUserUseCase_Execute_ValidationError(t *testing.T) {
 	ctx := context.Background()
 	repo := &mockUserRepository{}
 	passwordHasher := &mockPasswordHasher{}
 	notificationService := &mockNotificationService{}
 
-	uc := NewCreateUserUseCase(repo, passwordHasher, notificationService)
+	uc := NewCreateUserUseCase(repo, passwordHasher, notificationService, nil, nil, 0, nil)
 
 	tests := []struct {
 		name string
@@ -226,7 +296,7 @@ func TestCreateUserUseCase_Execute_RepositoryCreateError(t *testing.T) {
 	passwordHasher := &mockPasswordHasher{}
 	notificationService := &mockNotificationService{}
 
-	uc := NewCreateUserUseCase(repo, passwordHasher, notificationService)
+	uc := NewCreateUserUseCase(repo, passwordHasher, notificationService, nil, nil, 0, nil)
 
 	req := dto.CreateUserRequest{
 		Name:     "Test User",
@@ -258,7 +328,7 @@ func TestCreateUserUseCase_Execute_NotificationServiceErrorIgnored(t *testing.T)
 	passwordHasher := &mockPasswordHasher{}
 	notificationService := &mockNotificationService{}
 
-	uc := NewCreateUserUseCase(repo, passwordHasher, notificationService)
+	uc := NewCreateUserUseCase(repo, passwordHasher, notificationService, nil, nil, 0, nil)
 
 	req := dto.CreateUserRequest{
 		Name:     "Test User",
@@ -294,3 +364,100 @@ func TestCreateUserUseCase_Execute_NotificationServiceErrorIgnored(t *testing.T)
 	passwordHasher.AssertExpectations(t)
 	notificationService.AssertExpectations(t)
 }
+
+func TestCreateUserUseCase_Execute_SendsVerificationEmail(t *testing.T) {
+	ctx := context.Background()
+	repo := &mockUserRepository{}
+	passwordHasher := &mockPasswordHasher{}
+	notificationService := &mockNotificationService{}
+	signer := &mockEmailVerificationSigner{}
+
+	uc := NewCreateUserUseCase(repo, passwordHasher, notificationService, NewVerificationSender(signer, notificationService, time.Hour), nil, 0, nil)
+
+	req := dto.CreateUserRequest{Name: "Test User", Email: "test@example.com", Password: "password123"}
+
+	repo.On("GetByEmail", ctx, req.Email).Return(nil, domainuser.ErrUserNotFound)
+	passwordHasher.On("Hash", req.Password).Return("hashed", nil)
+	repo.On("Create", ctx, mock.AnythingOfType("*user.User")).Return(&domainuser.User{ID: 1, Name: req.Name, Email: req.Email}, nil)
+	notificationService.On("SendWelcomeEmail", ctx, req.Email, req.Name).Return(nil)
+	signer.On("Sign", mock.AnythingOfType("user.EmailVerificationClaims")).Return("signed", nil)
+	notificationService.On("SendVerificationEmail", ctx, req.Email, req.Name, "signed").Return(nil)
+
+	result, err := uc.Execute(ctx, req)
+
+	assert.NoError(t, err)
+	assert.Nil(t, result.EmailVerifiedAt)
+	signer.AssertExpectations(t)
+	notificationService.AssertExpectations(t)
+}
+
+func TestCreateUserUseCase_Execute_EmailVerified(t *testing.T) {
+	ctx := context.Background()
+	repo := &mockUserRepository{}
+	passwordHasher := &mockPasswordHasher{}
+	notificationService := &mockNotificationService{}
+	signer := &mockEmailVerificationSigner{}
+
+	uc := NewCreateUserUseCase(repo, passwordHasher, notificationService, NewVerificationSender(signer, notificationService, time.Hour), nil, 0, nil)
+
+	req := dto.CreateUserRequest{Name: "Test User", Email: "test@example.com", Password: "password123", EmailVerified: true}
+	now := time.Now()
+
+	repo.On("GetByEmail", ctx, req.Email).Return(nil, domainuser.ErrUserNotFound)
+	passwordHasher.On("Hash", req.Password).Return("hashed", nil)
+	repo.On("Create", ctx, mock.MatchedBy(func(u *domainuser.User) bool {
+		return u.IsEmailVerified()
+	})).Return(&domainuser.User{ID: 1, Name: req.Name, Email: req.Email, EmailVerifiedAt: &now}, nil)
+	notificationService.On("SendWelcomeEmail", ctx, req.Email, req.Name).Return(nil)
+
+	result, err := uc.Execute(ctx, req)
+
+	assert.NoError(t, err)
+	assert.NotNil(t, result.EmailVerifiedAt)
+	signer.AssertNotCalled(t, "Sign", mock.Anything)
+	notificationService.AssertNotCalled(t, "SendVerificationEmail", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestCreateUserUseCase_Execute_JoinsOrganization(t *testing.T) {
+	tests := []struct {
+		name      string
+		reqOrgID  int64
+		wantOrgID int64
+	}{
+		{name: "default organization", reqOrgID: 0, wantOrgID: 1},
+		{name: "organization of the request", reqOrgID: 4, wantOrgID: 4},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			repo := &mockUserRepository{}
+			passwordHasher := &mockPasswordHasher{}
+			notificationService := &mockNotificationService{}
+			orgs := &mockOrganizationRepository{}
+
+			uc := NewCreateUserUseCase(repo, passwordHasher, notificationService, nil, orgs, 1, nil)
+
+			req := dto.CreateUserRequest{
+				Name:     "Test User",
+				Email:    "test@example.com",
+				Password: "password123",
+				Role:     "editor",
+				OrgID:    tt.reqOrgID,
+			}
+
+			repo.On("GetByEmail", ctx, req.Email).Return(nil, domainuser.ErrUserNotFound)
+			passwordHasher.On("Hash", req.Password).Return("hashed", nil)
+			repo.On("Create", ctx, mock.Anything).Return(&domainuser.User{ID: 9, Name: req.Name, Email: req.Email, Role: domainuser.RoleEditor}, nil)
+			orgs.On("AddMember", ctx, mock.MatchedBy(func(m *domainuser.Membership) bool {
+				return m.OrgID == tt.wantOrgID && m.UserID == 9 && m.Role == domainuser.RoleEditor
+			})).Return(nil)
+			notificationService.On("SendWelcomeEmail", ctx, req.Email, req.Name).Return(nil)
+
+			_, err := uc.Execute(ctx, req)
+
+			assert.NoError(t, err)
+			orgs.AssertExpectations(t)
+		})
+	}
+}
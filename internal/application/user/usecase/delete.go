@@ -8,20 +8,30 @@ import (
 
 // DeleteUserUseCase handles deleting a user
 type DeleteUserUseCase struct {
-	userRepo domainuser.Repository
+	userRepo       domainuser.Repository
+	organizations  domainuser.OrganizationRepository
+	revokeSessions *RevokeAllSessionsUseCase
 }
 
 // NewDeleteUserUseCase creates a new DeleteUserUseCase
-func NewDeleteUserUseCase(userRepo domainuser.Repository) *DeleteUserUseCase {
+func NewDeleteUserUseCase(userRepo domainuser.Repository, organizations domainuser.OrganizationRepository, revokeSessions *RevokeAllSessionsUseCase) *DeleteUserUseCase {
 	return &DeleteUserUseCase{
-		userRepo: userRepo,
+		userRepo:       userRepo,
+		organizations:  organizations,
+		revokeSessions: revokeSessions,
 	}
 }
 
-// Execute executes the delete user use case
-func (uc *DeleteUserUseCase) Execute(ctx context.Context, id int64) error {
+// Execute executes the delete user use case.
+// A user that also belongs to other organizations only leaves orgID, the account itself is kept.
+// A deleted account is signed out of every session.
+func (uc *DeleteUserUseCase) Execute(ctx context.Context, orgID, id int64) error {
+	if _, err := memberOf(ctx, uc.organizations, orgID, id); err != nil {
+		return err
+	}
+
 	// Check if user exists
-	existingUser, err := uc.userRepo.GetByID(ctx, id)
+	existingUser, err := uc.userRepo.GetByID(ctx, orgID, id)
 	if err != nil {
 		return err
 	}
@@ -30,6 +40,18 @@ func (uc *DeleteUserUseCase) Execute(ctx context.Context, id int64) error {
 		return domainuser.ErrUserNotFound
 	}
 
+	memberships, err := uc.organizations.CountMemberships(ctx, id)
+	if err != nil {
+		return err
+	}
+	if memberships > 1 {
+		return uc.organizations.RemoveMember(ctx, orgID, id)
+	}
+
 	// Delete user
-	return uc.userRepo.Delete(ctx, id)
+	if err := uc.userRepo.Delete(ctx, orgID, id); err != nil {
+		return err
+	}
+
+	return uc.revokeSessions.Execute(ctx, id)
 }
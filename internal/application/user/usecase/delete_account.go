@@ -0,0 +1,35 @@
+package usecase
+
+import (
+	"context"
+
+	domainuser "github.com/rulzi/hexa-go/internal/domain/user"
+)
+
+// DeleteAccountUseCase handles users deleting their own account.
+// Unlike an admin removing a member, the account is deleted in every organization.
+type DeleteAccountUseCase struct {
+	userRepo       domainuser.Repository
+	revokeSessions *RevokeAllSessionsUseCase
+}
+
+// NewDeleteAccountUseCase creates a new DeleteAccountUseCase
+func NewDeleteAccountUseCase(userRepo domainuser.Repository, revokeSessions *RevokeAllSessionsUseCase) *DeleteAccountUseCase {
+	return &DeleteAccountUseCase{
+		userRepo:       userRepo,
+		revokeSessions: revokeSessions,
+	}
+}
+
+// Execute executes the delete account use case and signs the user out of every session
+func (uc *DeleteAccountUseCase) Execute(ctx context.Context, userID int64) error {
+	if _, err := uc.userRepo.GetByID(ctx, 0, userID); err != nil {
+		return err
+	}
+
+	if err := uc.userRepo.Delete(ctx, 0, userID); err != nil {
+		return err
+	}
+
+	return uc.revokeSessions.Execute(ctx, userID)
+}
@@ -8,12 +8,13 @@ import (
 
 	domainuser "github.com/rulzi/hexa-go/internal/domain/user"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 )
 
 func TestNewDeleteUserUseCase(t *testing.T) {
 	repo := &mockUserRepository{}
 
-	uc := NewDeleteUserUseCase(repo)
+	uc := NewDeleteUserUseCase(repo, newMemberOrganizations(domainuser.RoleAuthor), newTestRevokeSessions())
 
 	assert.NotNil(t, uc)
 	assert.Equal(t, repo, uc.userRepo)
@@ -23,7 +24,7 @@ func TestDeleteUserUseCase_Execute_Success(t *testing.T) {
 	ctx := context.Background()
 	repo := &mockUserRepository{}
 
-	uc := NewDeleteUserUseCase(repo)
+	uc := NewDeleteUserUseCase(repo, newMemberOrganizations(domainuser.RoleAuthor), newTestRevokeSessions())
 
 	userID := int64(1)
 	existingUser := &domainuser.User{
@@ -34,26 +35,27 @@ func TestDeleteUserUseCase_Execute_Success(t *testing.T) {
 		UpdatedAt: time.Now(),
 	}
 
-	repo.On("GetByID", ctx, userID).Return(existingUser, nil)
-	repo.On("Delete", ctx, userID).Return(nil)
+	repo.On("GetByID", ctx, int64(1), userID).Return(existingUser, nil)
+	repo.On("Delete", ctx, int64(1), userID).Return(nil)
 
-	err := uc.Execute(ctx, userID)
+	err := uc.Execute(ctx, 1, userID)
 
 	assert.NoError(t, err)
 	repo.AssertExpectations(t)
+	uc.revokeSessions.refreshTokens.(*mockRefreshTokenRepository).AssertCalled(t, "RevokeByUser", ctx, userID)
 }
 
 func TestDeleteUserUseCase_Execute_UserNotFound(t *testing.T) {
 	ctx := context.Background()
 	repo := &mockUserRepository{}
 
-	uc := NewDeleteUserUseCase(repo)
+	uc := NewDeleteUserUseCase(repo, newMemberOrganizations(domainuser.RoleAuthor), newTestRevokeSessions())
 
 	userID := int64(1)
 
-	repo.On("GetByID", ctx, userID).Return(nil, nil)
+	repo.On("GetByID", ctx, int64(1), userID).Return(nil, nil)
 
-	err := uc.Execute(ctx, userID)
+	err := uc.Execute(ctx, 1, userID)
 
 	assert.Error(t, err)
 	assert.Equal(t, domainuser.ErrUserNotFound, err)
@@ -66,14 +68,14 @@ func TestDeleteUserUseCase_Execute_GetByIDError(t *testing.T) {
 	ctx := context.Background()
 	repo := &mockUserRepository{}
 
-	uc := NewDeleteUserUseCase(repo)
+	uc := NewDeleteUserUseCase(repo, newMemberOrganizations(domainuser.RoleAuthor), newTestRevokeSessions())
 
 	userID := int64(1)
 	repoError := errors.New("database error")
 
-	repo.On("GetByID", ctx, userID).Return(nil, repoError)
+	repo.On("GetByID", ctx, int64(1), userID).Return(nil, repoError)
 
-	err := uc.Execute(ctx, userID)
+	err := uc.Execute(ctx, 1, userID)
 
 	assert.Error(t, err)
 	assert.Equal(t, repoError, err)
@@ -86,7 +88,7 @@ func TestDeleteUserUseCase_Execute_DeleteError(t *testing.T) {
 	ctx := context.Background()
 	repo := &mockUserRepository{}
 
-	uc := NewDeleteUserUseCase(repo)
+	uc := NewDeleteUserUseCase(repo, newMemberOrganizations(domainuser.RoleAuthor), newTestRevokeSessions())
 
 	userID := int64(1)
 	existingUser := &domainuser.User{
@@ -98,10 +100,10 @@ func TestDeleteUserUseCase_Execute_DeleteError(t *testing.T) {
 	}
 	deleteError := errors.New("delete error")
 
-	repo.On("GetByID", ctx, userID).Return(existingUser, nil)
-	repo.On("Delete", ctx, userID).Return(deleteError)
+	repo.On("GetByID", ctx, int64(1), userID).Return(existingUser, nil)
+	repo.On("Delete", ctx, int64(1), userID).Return(deleteError)
 
-	err := uc.Execute(ctx, userID)
+	err := uc.Execute(ctx, 1, userID)
 
 	assert.Error(t, err)
 	assert.Equal(t, deleteError, err)
@@ -109,3 +111,60 @@ func TestDeleteUserUseCase_Execute_DeleteError(t *testing.T) {
 	repo.AssertExpectations(t)
 }
 
+
+func TestDeleteUserUseCase_Execute_Organization(t *testing.T) {
+	ctx := context.Background()
+	existingUser := &domainuser.User{ID: 7, Name: "Test User", Email: "test@example.com"}
+
+	t.Run("member of other organizations only leaves this one", func(t *testing.T) {
+		repo := &mockUserRepository{}
+		orgs := &mockOrganizationRepository{}
+
+		orgs.On("GetMembership", ctx, int64(1), int64(7)).Return(&domainuser.Membership{OrgID: 1, UserID: 7}, nil)
+		repo.On("GetByID", ctx, int64(1), int64(7)).Return(existingUser, nil)
+		orgs.On("CountMemberships", ctx, int64(7)).Return(int64(2), nil)
+		orgs.On("RemoveMember", ctx, int64(1), int64(7)).Return(nil)
+
+		err := NewDeleteUserUseCase(repo, orgs, newTestRevokeSessions()).Execute(ctx, 1, 7)
+
+		assert.NoError(t, err)
+		orgs.AssertExpectations(t)
+		repo.AssertNotCalled(t, "Delete", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("user of another organization", func(t *testing.T) {
+		repo := &mockUserRepository{}
+		orgs := &mockOrganizationRepository{}
+
+		orgs.On("GetMembership", ctx, int64(2), int64(7)).Return(nil, domainuser.ErrNotMember)
+
+		err := NewDeleteUserUseCase(repo, orgs, newTestRevokeSessions()).Execute(ctx, 2, 7)
+
+		assert.Equal(t, domainuser.ErrUserNotFound, err)
+		repo.AssertNotCalled(t, "Delete", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestDeleteAccountUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+	repo := &mockUserRepository{}
+
+	repo.On("GetByID", ctx, int64(0), int64(7)).Return(&domainuser.User{ID: 7}, nil)
+	repo.On("Delete", ctx, int64(0), int64(7)).Return(nil)
+	revokeSessions := newTestRevokeSessions()
+
+	err := NewDeleteAccountUseCase(repo, revokeSessions).Execute(ctx, 7)
+
+	assert.NoError(t, err)
+	repo.AssertExpectations(t)
+	revokeSessions.refreshTokens.(*mockRefreshTokenRepository).AssertCalled(t, "RevokeByUser", ctx, int64(7))
+}
+
+// newTestRevokeSessions returns a RevokeAllSessionsUseCase for users without active sessions
+func newTestRevokeSessions() *RevokeAllSessionsUseCase {
+	sessions := &mockSessionRepository{}
+	sessions.On("ListActiveByUser", mock.Anything, mock.Anything).Return([]*domainuser.Session{}, nil)
+	refreshRepo := &mockRefreshTokenRepository{}
+	refreshRepo.On("RevokeByUser", mock.Anything, mock.Anything).Return(nil)
+	return NewRevokeAllSessionsUseCase(sessions, refreshRepo, &mockTokenRevocationStore{}, 15*time.Minute)
+}
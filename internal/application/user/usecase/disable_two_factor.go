@@ -0,0 +1,43 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/rulzi/hexa-go/internal/application/user/dto"
+	domainuser "github.com/rulzi/hexa-go/internal/domain/user"
+)
+
+// DisableTwoFactorUseCase handles turning off two-factor authentication
+type DisableTwoFactorUseCase struct {
+	twoFactors domainuser.TwoFactorRepository
+	totp       domainuser.TOTPAuthenticator
+	throttler  *LoginThrottler
+}
+
+// NewDisableTwoFactorUseCase creates a new DisableTwoFactorUseCase
+func NewDisableTwoFactorUseCase(
+	twoFactors domainuser.TwoFactorRepository,
+	totp domainuser.TOTPAuthenticator,
+	throttler *LoginThrottler,
+) *DisableTwoFactorUseCase {
+	return &DisableTwoFactorUseCase{
+		twoFactors: twoFactors,
+		totp:       totp,
+		throttler:  throttler,
+	}
+}
+
+// Execute executes the disable two-factor use case.
+// A recovery code is accepted so users who lost their device can turn it off.
+func (uc *DisableTwoFactorUseCase) Execute(ctx context.Context, userID int64, req dto.TwoFactorCodeRequest) error {
+	twoFactor, err := enabledTwoFactor(ctx, uc.twoFactors, userID)
+	if err != nil {
+		return err
+	}
+
+	if err := checkThrottledTwoFactorCode(ctx, uc.throttler, uc.twoFactors, uc.totp, twoFactor, req.Code, true); err != nil {
+		return err
+	}
+
+	return uc.twoFactors.Delete(ctx, userID)
+}
@@ -0,0 +1,67 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/rulzi/hexa-go/internal/application/user/dto"
+	domainuser "github.com/rulzi/hexa-go/internal/domain/user"
+)
+
+// EnrollTwoFactorUseCase handles starting two-factor enrollment
+type EnrollTwoFactorUseCase struct {
+	userRepo   domainuser.Repository
+	twoFactors domainuser.TwoFactorRepository
+	totp       domainuser.TOTPAuthenticator
+}
+
+// NewEnrollTwoFactorUseCase creates a new EnrollTwoFactorUseCase
+func NewEnrollTwoFactorUseCase(
+	userRepo domainuser.Repository,
+	twoFactors domainuser.TwoFactorRepository,
+	totp domainuser.TOTPAuthenticator,
+) *EnrollTwoFactorUseCase {
+	return &EnrollTwoFactorUseCase{
+		userRepo:   userRepo,
+		twoFactors: twoFactors,
+		totp:       totp,
+	}
+}
+
+// Execute executes the enroll two-factor use case.
+// Enrolling again before confirming replaces the pending secret.
+func (uc *EnrollTwoFactorUseCase) Execute(ctx context.Context, userID int64) (*dto.TwoFactorEnrollmentResponse, error) {
+	existingUser, err := uc.userRepo.GetByID(ctx, 0, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	existing, err := uc.twoFactors.GetByUser(ctx, userID)
+	if err != nil && err != domainuser.ErrTwoFactorNotEnrolled {
+		return nil, err
+	}
+	if existing != nil && existing.IsEnabled() {
+		return nil, domainuser.ErrTwoFactorAlreadyEnabled
+	}
+
+	secret, err := uc.totp.GenerateSecret()
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	err = uc.twoFactors.Save(ctx, &domainuser.TwoFactor{
+		UserID:    userID,
+		Secret:    secret,
+		CreatedAt: now,
+		UpdatedAt: now,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.TwoFactorEnrollmentResponse{
+		Secret:          secret,
+		ProvisioningURI: uc.totp.ProvisioningURI(secret, existingUser.Email),
+	}, nil
+}
@@ -0,0 +1,65 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/rulzi/hexa-go/internal/application/user/dto"
+	domainuser "github.com/rulzi/hexa-go/internal/domain/user"
+)
+
+// ForgotPasswordUseCase handles issuing password reset tokens
+type ForgotPasswordUseCase struct {
+	userRepo            domainuser.Repository
+	resetTokens         domainuser.PasswordResetRepository
+	notificationService domainuser.NotificationService
+	resetTTL            time.Duration
+}
+
+// NewForgotPasswordUseCase creates a new ForgotPasswordUseCase
+func NewForgotPasswordUseCase(
+	userRepo domainuser.Repository,
+	resetTokens domainuser.PasswordResetRepository,
+	notificationService domainuser.NotificationService,
+	resetTTL time.Duration,
+) *ForgotPasswordUseCase {
+	return &ForgotPasswordUseCase{
+		userRepo:            userRepo,
+		resetTokens:         resetTokens,
+		notificationService: notificationService,
+		resetTTL:            resetTTL,
+	}
+}
+
+// Execute executes the forgot password use case.
+// It succeeds for unknown emails too, so callers cannot tell whether an email is registered.
+func (uc *ForgotPasswordUseCase) Execute(ctx context.Context, req dto.ForgotPasswordRequest) error {
+	existingUser, err := uc.userRepo.GetByEmail(ctx, req.Email)
+	if err == domainuser.ErrUserNotFound || (err == nil && existingUser == nil) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	token, err := domainuser.GenerateSecureToken(32)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	_, err = uc.resetTokens.Create(ctx, &domainuser.PasswordResetToken{
+		UserID:    existingUser.ID,
+		TokenHash: domainuser.HashToken(token),
+		ExpiresAt: now.Add(uc.resetTTL),
+		CreatedAt: now,
+	})
+	if err != nil {
+		return err
+	}
+
+	// Send reset email (non-blocking, ignore errors so the response stays the same)
+	_ = uc.notificationService.SendPasswordResetEmail(ctx, existingUser.Email, existingUser.Name, token)
+
+	return nil
+}
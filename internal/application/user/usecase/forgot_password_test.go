@@ -0,0 +1,103 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/rulzi/hexa-go/internal/application/user/dto"
+	domainuser "github.com/rulzi/hexa-go/internal/domain/user"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func newTestForgotPasswordUseCase() (*ForgotPasswordUseCase, *mockUserRepository, *mockPasswordResetRepository, *mockNotificationService) {
+	repo := &mockUserRepository{}
+	resetRepo := &mockPasswordResetRepository{}
+	notificationService := &mockNotificationService{}
+	uc := NewForgotPasswordUseCase(repo, resetRepo, notificationService, time.Hour)
+	return uc, repo, resetRepo, notificationService
+}
+
+func TestNewForgotPasswordUseCase(t *testing.T) {
+	uc, repo, resetRepo, notificationService := newTestForgotPasswordUseCase()
+
+	assert.NotNil(t, uc)
+	assert.Equal(t, repo, uc.userRepo)
+	assert.Equal(t, resetRepo, uc.resetTokens)
+	assert.Equal(t, notificationService, uc.notificationService)
+	assert.Equal(t, time.Hour, uc.resetTTL)
+}
+
+func TestForgotPasswordUseCase_Execute_Success(t *testing.T) {
+	ctx := context.Background()
+	uc, repo, resetRepo, notificationService := newTestForgotPasswordUseCase()
+
+	req := dto.ForgotPasswordRequest{Email: "test@example.com"}
+	userEntity := &domainuser.User{ID: 1, Name: "Test User", Email: req.Email}
+
+	var storedHash string
+	repo.On("GetByEmail", ctx, req.Email).Return(userEntity, nil)
+	resetRepo.On("Create", ctx, mock.MatchedBy(func(token *domainuser.PasswordResetToken) bool {
+		storedHash = token.TokenHash
+		return token.UserID == 1 && token.ExpiresAt.After(time.Now().Add(59*time.Minute))
+	})).Return(&domainuser.PasswordResetToken{ID: 1}, nil)
+	notificationService.On("SendPasswordResetEmail", ctx, req.Email, userEntity.Name, mock.MatchedBy(func(token string) bool {
+		// Only the hash of the emailed token is stored
+		return token != "" && domainuser.HashToken(token) == storedHash
+	})).Return(nil)
+
+	err := uc.Execute(ctx, req)
+
+	assert.NoError(t, err)
+	repo.AssertExpectations(t)
+	resetRepo.AssertExpectations(t)
+	notificationService.AssertExpectations(t)
+}
+
+func TestForgotPasswordUseCase_Execute_UnknownEmail(t *testing.T) {
+	ctx := context.Background()
+	uc, repo, resetRepo, notificationService := newTestForgotPasswordUseCase()
+
+	req := dto.ForgotPasswordRequest{Email: "unknown@example.com"}
+	repo.On("GetByEmail", ctx, req.Email).Return(nil, domainuser.ErrUserNotFound)
+
+	err := uc.Execute(ctx, req)
+
+	assert.NoError(t, err)
+	resetRepo.AssertNotCalled(t, "Create")
+	notificationService.AssertNotCalled(t, "SendPasswordResetEmail")
+}
+
+func TestForgotPasswordUseCase_Execute_NotificationErrorIgnored(t *testing.T) {
+	ctx := context.Background()
+	uc, repo, resetRepo, notificationService := newTestForgotPasswordUseCase()
+
+	req := dto.ForgotPasswordRequest{Email: "test@example.com"}
+	userEntity := &domainuser.User{ID: 1, Name: "Test User", Email: req.Email}
+
+	repo.On("GetByEmail", ctx, req.Email).Return(userEntity, nil)
+	resetRepo.On("Create", ctx, mock.AnythingOfType("*user.PasswordResetToken")).Return(&domainuser.PasswordResetToken{ID: 1}, nil)
+	notificationService.On("SendPasswordResetEmail", ctx, req.Email, userEntity.Name, mock.Anything).Return(errors.New("smtp error"))
+
+	err := uc.Execute(ctx, req)
+
+	assert.NoError(t, err)
+}
+
+func TestForgotPasswordUseCase_Execute_RepositoryError(t *testing.T) {
+	ctx := context.Background()
+	uc, repo, resetRepo, _ := newTestForgotPasswordUseCase()
+
+	req := dto.ForgotPasswordRequest{Email: "test@example.com"}
+	userEntity := &domainuser.User{ID: 1, Name: "Test User", Email: req.Email}
+	repoErr := errors.New("database error")
+
+	repo.On("GetByEmail", ctx, req.Email).Return(userEntity, nil)
+	resetRepo.On("Create", ctx, mock.AnythingOfType("*user.PasswordResetToken")).Return(nil, repoErr)
+
+	err := uc.Execute(ctx, req)
+
+	assert.Equal(t, repoErr, err)
+}
@@ -9,19 +9,26 @@ import (
 
 // GetUserUseCase handles retrieving a user by ID
 type GetUserUseCase struct {
-	userRepo domainuser.Repository
+	userRepo      domainuser.Repository
+	organizations domainuser.OrganizationRepository
 }
 
 // NewGetUserUseCase creates a new GetUserUseCase
-func NewGetUserUseCase(userRepo domainuser.Repository) *GetUserUseCase {
+func NewGetUserUseCase(userRepo domainuser.Repository, organizations domainuser.OrganizationRepository) *GetUserUseCase {
 	return &GetUserUseCase{
-		userRepo: userRepo,
+		userRepo:      userRepo,
+		organizations: organizations,
 	}
 }
 
-// Execute executes the get user use case
-func (uc *GetUserUseCase) Execute(ctx context.Context, id int64) (*dto.UserResponse, error) {
-	userEntity, err := uc.userRepo.GetByID(ctx, id)
+// Execute executes the get user use case for a member of an organization
+func (uc *GetUserUseCase) Execute(ctx context.Context, orgID, id int64) (*dto.UserResponse, error) {
+	membership, err := memberOf(ctx, uc.organizations, orgID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	userEntity, err := uc.userRepo.GetByID(ctx, orgID, id)
 	if err != nil {
 		return nil, err
 	}
@@ -31,10 +38,12 @@ func (uc *GetUserUseCase) Execute(ctx context.Context, id int64) (*dto.UserRespo
 	}
 
 	return &dto.UserResponse{
-		ID:        userEntity.ID,
-		Name:      userEntity.Name,
-		Email:     userEntity.Email,
-		CreatedAt: userEntity.CreatedAt,
-		UpdatedAt: userEntity.UpdatedAt,
+		ID:              userEntity.ID,
+		Name:            userEntity.Name,
+		Email:           userEntity.Email,
+		Role:            string(membership.Role),
+		EmailVerifiedAt: userEntity.EmailVerifiedAt,
+		CreatedAt:       userEntity.CreatedAt,
+		UpdatedAt:       userEntity.UpdatedAt,
 	}, nil
 }
@@ -17,7 +17,7 @@ var _ *dto.UserResponse
 func TestNewGetUserUseCase(t *testing.T) {
 	repo := &mockUserRepository{}
 
-	uc := NewGetUserUseCase(repo)
+	uc := NewGetUserUseCase(repo, newMemberOrganizations(domainuser.RoleAuthor))
 
 	assert.NotNil(t, uc)
 	assert.Equal(t, repo, uc.userRepo)
@@ -27,7 +27,7 @@ func TestGetUserUseCase_Execute_Success(t *testing.T) {
 	ctx := context.Background()
 	repo := &mockUserRepository{}
 
-	uc := NewGetUserUseCase(repo)
+	uc := NewGetUserUseCase(repo, newMemberOrganizations(domainuser.RoleAuthor))
 
 	userID := int64(1)
 	userEntity := &domainuser.User{
@@ -39,9 +39,9 @@ func TestGetUserUseCase_Execute_Success(t *testing.T) {
 		UpdatedAt: time.Now(),
 	}
 
-	repo.On("GetByID", ctx, userID).Return(userEntity, nil)
+	repo.On("GetByID", ctx, int64(1), userID).Return(userEntity, nil)
 
-	result, err := uc.Execute(ctx, userID)
+	result, err := uc.Execute(ctx, 1, userID)
 
 	assert.NoError(t, err)
 	assert.NotNil(t, result)
@@ -57,13 +57,13 @@ func TestGetUserUseCase_Execute_UserNotFound(t *testing.T) {
 	ctx := context.Background()
 	repo := &mockUserRepository{}
 
-	uc := NewGetUserUseCase(repo)
+	uc := NewGetUserUseCase(repo, newMemberOrganizations(domainuser.RoleAuthor))
 
 	userID := int64(1)
 
-	repo.On("GetByID", ctx, userID).Return(nil, nil)
+	repo.On("GetByID", ctx, int64(1), userID).Return(nil, nil)
 
-	result, err := uc.Execute(ctx, userID)
+	result, err := uc.Execute(ctx, 1, userID)
 
 	assert.Error(t, err)
 	assert.Equal(t, domainuser.ErrUserNotFound, err)
@@ -76,14 +76,14 @@ func TestGetUserUseCase_Execute_RepositoryError(t *testing.T) {
 	ctx := context.Background()
 	repo := &mockUserRepository{}
 
-	uc := NewGetUserUseCase(repo)
+	uc := NewGetUserUseCase(repo, newMemberOrganizations(domainuser.RoleAuthor))
 
 	userID := int64(1)
 	repoError := errors.New("database error")
 
-	repo.On("GetByID", ctx, userID).Return(nil, repoError)
+	repo.On("GetByID", ctx, int64(1), userID).Return(nil, repoError)
 
-	result, err := uc.Execute(ctx, userID)
+	result, err := uc.Execute(ctx, 1, userID)
 
 	assert.Error(t, err)
 	assert.Equal(t, repoError, err)
@@ -92,3 +92,26 @@ func TestGetUserUseCase_Execute_RepositoryError(t *testing.T) {
 	repo.AssertExpectations(t)
 }
 
+
+func TestGetUserUseCase_Execute_Organization(t *testing.T) {
+	ctx := context.Background()
+	repo := &mockUserRepository{}
+	orgs := &mockOrganizationRepository{}
+
+	uc := NewGetUserUseCase(repo, orgs)
+
+	repo.On("GetByID", ctx, int64(1), int64(7)).Return(&domainuser.User{ID: 7, Role: domainuser.RoleReader}, nil)
+	orgs.On("GetMembership", ctx, int64(1), int64(7)).Return(&domainuser.Membership{OrgID: 1, UserID: 7, Role: domainuser.RoleEditor}, nil)
+	orgs.On("GetMembership", ctx, int64(2), int64(7)).Return(nil, domainuser.ErrNotMember)
+
+	// The role is the one the user has in the organization
+	result, err := uc.Execute(ctx, 1, 7)
+	assert.NoError(t, err)
+	assert.Equal(t, "editor", result.Role)
+
+	// Users of other organizations cannot be read by ID
+	result, err = uc.Execute(ctx, 2, 7)
+	assert.Equal(t, domainuser.ErrUserNotFound, err)
+	assert.Nil(t, result)
+	repo.AssertNumberOfCalls(t, "GetByID", 1)
+}
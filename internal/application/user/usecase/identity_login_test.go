@@ -0,0 +1,290 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/rulzi/hexa-go/internal/application/user/dto"
+	domainuser "github.com/rulzi/hexa-go/internal/domain/user"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestStartIdentityLoginUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+	provider := &mockIdentityProvider{}
+	states := &mockAuthorizationStateStore{}
+	uc := NewStartIdentityLoginUseCase(provider, states, 10*time.Minute)
+
+	var state, nonce, verifier string
+	provider.On("AuthorizationURL", ctx, mock.Anything, mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) {
+			state, nonce, verifier = args.String(1), args.String(2), args.String(3)
+		}).
+		Return("https://idp.example.com/authorize?state=abc", nil)
+	states.On("Save", ctx, mock.Anything, mock.Anything, 10*time.Minute).Return(nil)
+
+	authURL, returnedState, err := uc.Execute(ctx)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "https://idp.example.com/authorize?state=abc", authURL)
+	// The state is returned for binding the sign-in to the browser
+	assert.Equal(t, state, returnedState)
+	assert.NotEqual(t, state, verifier)
+	// Only the hash of the state is stored, the verifier and nonce never leave the server
+	states.AssertCalled(t, "Save", ctx, domainuser.HashToken(state), domainuser.AuthorizationState{
+		CodeVerifier: verifier,
+		Nonce:        nonce,
+	}, 10*time.Minute)
+}
+
+func TestStartIdentityLoginUseCase_Execute_ProviderError(t *testing.T) {
+	ctx := context.Background()
+	provider := &mockIdentityProvider{}
+	states := &mockAuthorizationStateStore{}
+	uc := NewStartIdentityLoginUseCase(provider, states, 10*time.Minute)
+
+	provider.On("AuthorizationURL", ctx, mock.Anything, mock.Anything, mock.Anything).Return("", errors.New("discovery failed"))
+
+	authURL, state, err := uc.Execute(ctx)
+
+	assert.Error(t, err)
+	assert.Empty(t, authURL)
+	assert.Empty(t, state)
+	states.AssertNotCalled(t, "Save", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestCompleteIdentityLoginUseCase_Execute(t *testing.T) {
+	verifiedAt := time.Now()
+	stateHash := domainuser.HashToken("state-1")
+	authState := &domainuser.AuthorizationState{CodeVerifier: "verifier", Nonce: "nonce"}
+	identity := &domainuser.ExternalIdentity{
+		Provider:      "corp",
+		Subject:       "sub-1",
+		Email:         "jane@example.com",
+		EmailVerified: true,
+		Name:          "Jane Doe",
+	}
+	unverifiedIdentity := *identity
+	unverifiedIdentity.EmailVerified = false
+
+	tests := []struct {
+		name          string
+		policy        domainuser.EmailVerificationPolicy
+		autoProvision bool
+		setup         func(ctx context.Context, repo *mockUserRepository, identities *mockIdentityRepository, provider *mockIdentityProvider, states *mockAuthorizationStateStore, hasher *mockPasswordHasher)
+		wantUserID    int64
+		wantErr       error
+	}{
+		{
+			name: "linked identity signs in",
+			setup: func(ctx context.Context, repo *mockUserRepository, identities *mockIdentityRepository, provider *mockIdentityProvider, states *mockAuthorizationStateStore, hasher *mockPasswordHasher) {
+				states.On("Take", ctx, stateHash).Return(authState, nil)
+				provider.On("Exchange", ctx, "code-1", "verifier", "nonce").Return(identity, nil)
+				identities.On("GetByProviderSubject", ctx, "corp", "sub-1").Return(&domainuser.UserIdentity{UserID: 7}, nil)
+				repo.On("GetByID", ctx, int64(0), int64(7)).Return(&domainuser.User{ID: 7, Email: "jane@corp.example.com", EmailVerifiedAt: &verifiedAt}, nil)
+			},
+			wantUserID: 7,
+		},
+		{
+			name: "existing verified user is linked",
+			setup: func(ctx context.Context, repo *mockUserRepository, identities *mockIdentityRepository, provider *mockIdentityProvider, states *mockAuthorizationStateStore, hasher *mockPasswordHasher) {
+				states.On("Take", ctx, stateHash).Return(authState, nil)
+				provider.On("Exchange", ctx, "code-1", "verifier", "nonce").Return(identity, nil)
+				identities.On("GetByProviderSubject", ctx, "corp", "sub-1").Return(nil, domainuser.ErrIdentityNotFound)
+				repo.On("GetByEmail", ctx, "jane@example.com").Return(&domainuser.User{ID: 3, Email: "jane@example.com", EmailVerifiedAt: &verifiedAt}, nil)
+				identities.On("Create", ctx, mock.MatchedBy(func(i *domainuser.UserIdentity) bool {
+					return i.UserID == 3 && i.Provider == "corp" && i.Subject == "sub-1"
+				})).Return(&domainuser.UserIdentity{ID: 1}, nil)
+			},
+			wantUserID: 3,
+		},
+		{
+			name: "unverified local account is not linked",
+			setup: func(ctx context.Context, repo *mockUserRepository, identities *mockIdentityRepository, provider *mockIdentityProvider, states *mockAuthorizationStateStore, hasher *mockPasswordHasher) {
+				states.On("Take", ctx, stateHash).Return(authState, nil)
+				provider.On("Exchange", ctx, "code-1", "verifier", "nonce").Return(identity, nil)
+				identities.On("GetByProviderSubject", ctx, "corp", "sub-1").Return(nil, domainuser.ErrIdentityNotFound)
+				repo.On("GetByEmail", ctx, "jane@example.com").Return(&domainuser.User{ID: 3, Email: "jane@example.com"}, nil)
+			},
+			wantErr: domainuser.ErrIdentityAccountNotVerified,
+		},
+		{
+			name: "existing user is not linked to an unverified email",
+			setup: func(ctx context.Context, repo *mockUserRepository, identities *mockIdentityRepository, provider *mockIdentityProvider, states *mockAuthorizationStateStore, hasher *mockPasswordHasher) {
+				states.On("Take", ctx, stateHash).Return(authState, nil)
+				provider.On("Exchange", ctx, "code-1", "verifier", "nonce").Return(&unverifiedIdentity, nil)
+				identities.On("GetByProviderSubject", ctx, "corp", "sub-1").Return(nil, domainuser.ErrIdentityNotFound)
+				repo.On("GetByEmail", ctx, "jane@example.com").Return(&domainuser.User{ID: 3, Email: "jane@example.com"}, nil)
+			},
+			wantErr: domainuser.ErrIdentityEmailNotVerified,
+		},
+		{
+			name:          "new identity is provisioned",
+			autoProvision: true,
+			setup: func(ctx context.Context, repo *mockUserRepository, identities *mockIdentityRepository, provider *mockIdentityProvider, states *mockAuthorizationStateStore, hasher *mockPasswordHasher) {
+				states.On("Take", ctx, stateHash).Return(authState, nil)
+				provider.On("Exchange", ctx, "code-1", "verifier", "nonce").Return(identity, nil)
+				identities.On("GetByProviderSubject", ctx, "corp", "sub-1").Return(nil, domainuser.ErrIdentityNotFound)
+				repo.On("GetByEmail", ctx, "jane@example.com").Return(nil, domainuser.ErrUserNotFound)
+				hasher.On("Hash", mock.Anything).Return("hashed_random", nil)
+				repo.On("Create", ctx, mock.MatchedBy(func(u *domainuser.User) bool {
+					return u.Name == "Jane Doe" && u.Email == "jane@example.com" && u.Password == "hashed_random" &&
+						u.Role == domainuser.DefaultRole && u.IsEmailVerified()
+				})).Return(&domainuser.User{ID: 9, Name: "Jane Doe", Email: "jane@example.com", EmailVerifiedAt: &verifiedAt}, nil)
+				identities.On("Create", ctx, mock.MatchedBy(func(i *domainuser.UserIdentity) bool {
+					return i.UserID == 9
+				})).Return(&domainuser.UserIdentity{ID: 1}, nil)
+			},
+			wantUserID: 9,
+		},
+		{
+			name: "new identity without provisioning",
+			setup: func(ctx context.Context, repo *mockUserRepository, identities *mockIdentityRepository, provider *mockIdentityProvider, states *mockAuthorizationStateStore, hasher *mockPasswordHasher) {
+				states.On("Take", ctx, stateHash).Return(authState, nil)
+				provider.On("Exchange", ctx, "code-1", "verifier", "nonce").Return(identity, nil)
+				identities.On("GetByProviderSubject", ctx, "corp", "sub-1").Return(nil, domainuser.ErrIdentityNotFound)
+				repo.On("GetByEmail", ctx, "jane@example.com").Return(nil, domainuser.ErrUserNotFound)
+			},
+			wantErr: domainuser.ErrIdentityNotProvisioned,
+		},
+		{
+			name:          "unverified user is blocked by the login policy",
+			policy:        domainuser.VerificationPolicyLogin,
+			autoProvision: true,
+			setup: func(ctx context.Context, repo *mockUserRepository, identities *mockIdentityRepository, provider *mockIdentityProvider, states *mockAuthorizationStateStore, hasher *mockPasswordHasher) {
+				states.On("Take", ctx, stateHash).Return(authState, nil)
+				provider.On("Exchange", ctx, "code-1", "verifier", "nonce").Return(&unverifiedIdentity, nil)
+				identities.On("GetByProviderSubject", ctx, "corp", "sub-1").Return(nil, domainuser.ErrIdentityNotFound)
+				repo.On("GetByEmail", ctx, "jane@example.com").Return(nil, domainuser.ErrUserNotFound)
+				hasher.On("Hash", mock.Anything).Return("hashed_random", nil)
+				repo.On("Create", ctx, mock.MatchedBy(func(u *domainuser.User) bool {
+					return !u.IsEmailVerified()
+				})).Return(&domainuser.User{ID: 9, Email: "jane@example.com"}, nil)
+				identities.On("Create", ctx, mock.Anything).Return(&domainuser.UserIdentity{ID: 1}, nil)
+			},
+			wantErr: domainuser.ErrEmailNotVerified,
+		},
+		{
+			name: "unknown or replayed state",
+			setup: func(ctx context.Context, repo *mockUserRepository, identities *mockIdentityRepository, provider *mockIdentityProvider, states *mockAuthorizationStateStore, hasher *mockPasswordHasher) {
+				states.On("Take", ctx, stateHash).Return(nil, domainuser.ErrInvalidAuthorizationState)
+			},
+			wantErr: domainuser.ErrInvalidAuthorizationState,
+		},
+		{
+			name: "provider rejects the code",
+			setup: func(ctx context.Context, repo *mockUserRepository, identities *mockIdentityRepository, provider *mockIdentityProvider, states *mockAuthorizationStateStore, hasher *mockPasswordHasher) {
+				states.On("Take", ctx, stateHash).Return(authState, nil)
+				provider.On("Exchange", ctx, "code-1", "verifier", "nonce").Return(nil, domainuser.ErrInvalidIdentity)
+			},
+			wantErr: domainuser.ErrInvalidIdentity,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			repo := &mockUserRepository{}
+			identities := &mockIdentityRepository{}
+			provider := &mockIdentityProvider{}
+			states := &mockAuthorizationStateStore{}
+			hasher := &mockPasswordHasher{}
+			codes := &mockLoginCodeStore{}
+
+			policy := tt.policy
+			if policy == "" {
+				policy = domainuser.VerificationPolicyNone
+			}
+			uc := NewCompleteIdentityLoginUseCase(repo, identities, provider, states, codes, time.Minute, hasher,
+				policy, nil, 0, tt.autoProvision)
+
+			codes.On("Save", ctx, mock.Anything, mock.Anything, time.Minute).Return(nil)
+			tt.setup(ctx, repo, identities, provider, states, hasher)
+
+			code, err := uc.Execute(ctx, dto.IdentityCallbackRequest{Code: "code-1", State: "state-1", IPAddress: "10.0.0.1"})
+
+			if tt.wantErr != nil {
+				assert.Equal(t, tt.wantErr, err)
+				assert.Empty(t, code)
+				codes.AssertNotCalled(t, "Save", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+			} else {
+				assert.NoError(t, err)
+				assert.NotEmpty(t, code)
+				// Only the hash of the login code is stored, for the signed in user
+				codes.AssertCalled(t, "Save", ctx, domainuser.HashToken(code), tt.wantUserID, time.Minute)
+			}
+			repo.AssertExpectations(t)
+			identities.AssertExpectations(t)
+			provider.AssertExpectations(t)
+			states.AssertExpectations(t)
+		})
+	}
+}
+
+func TestRedeemIdentityLoginUseCase_Execute(t *testing.T) {
+	codeHash := domainuser.HashToken("login-code")
+
+	tests := []struct {
+		name    string
+		setup   func(ctx context.Context, repo *mockUserRepository, codes *mockLoginCodeStore)
+		wantErr error
+	}{
+		{
+			name: "code is traded for tokens",
+			setup: func(ctx context.Context, repo *mockUserRepository, codes *mockLoginCodeStore) {
+				codes.On("Take", ctx, codeHash).Return(int64(7), nil)
+				repo.On("GetByID", ctx, int64(0), int64(7)).Return(&domainuser.User{ID: 7, Email: "jane@example.com"}, nil)
+			},
+		},
+		{
+			name: "unknown, expired or redeemed code",
+			setup: func(ctx context.Context, repo *mockUserRepository, codes *mockLoginCodeStore) {
+				codes.On("Take", ctx, codeHash).Return(int64(0), domainuser.ErrInvalidLoginCode)
+			},
+			wantErr: domainuser.ErrInvalidLoginCode,
+		},
+		{
+			name: "user deleted since the callback",
+			setup: func(ctx context.Context, repo *mockUserRepository, codes *mockLoginCodeStore) {
+				codes.On("Take", ctx, codeHash).Return(int64(7), nil)
+				repo.On("GetByID", ctx, int64(0), int64(7)).Return(nil, domainuser.ErrUserNotFound)
+			},
+			wantErr: domainuser.ErrInvalidLoginCode,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			repo := &mockUserRepository{}
+			codes := &mockLoginCodeStore{}
+			tokenGen := &mockTokenGenerator{}
+			refreshRepo := &mockRefreshTokenRepository{}
+			attemptRepo := &mockLoginAttemptRepository{}
+			uc := NewRedeemIdentityLoginUseCase(repo, codes, NewTokenIssuer(tokenGen, refreshRepo, nil, nil, time.Hour), attemptRepo)
+
+			tokenGen.On("Generate", mock.Anything).Return("jwt_token_123", nil)
+			refreshRepo.On("Create", ctx, mock.Anything).Return(&domainuser.RefreshToken{ID: 1}, nil)
+			attemptRepo.On("Create", ctx, mock.Anything).Return(nil)
+			tt.setup(ctx, repo, codes)
+
+			result, err := uc.Execute(ctx, dto.IdentityLoginCodeRequest{Code: "login-code", IPAddress: "10.0.0.1"})
+
+			if tt.wantErr != nil {
+				assert.Equal(t, tt.wantErr, err)
+				assert.Nil(t, result)
+				tokenGen.AssertNotCalled(t, "Generate", mock.Anything)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, "jwt_token_123", result.Token)
+				assert.Equal(t, int64(7), result.User.ID)
+				attemptRepo.AssertExpectations(t)
+			}
+			codes.AssertExpectations(t)
+			repo.AssertExpectations(t)
+		})
+	}
+}
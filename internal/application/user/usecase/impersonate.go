@@ -0,0 +1,112 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/rulzi/hexa-go/internal/application/user/dto"
+	domainuser "github.com/rulzi/hexa-go/internal/domain/user"
+)
+
+// ImpersonateUserUseCase handles issuing a token for an admin to act as another member of their organization.
+// The token carries the admin as the actor and can't be refreshed.
+type ImpersonateUserUseCase struct {
+	userRepo      domainuser.Repository
+	organizations domainuser.OrganizationRepository
+	tokenGen      domainuser.TokenGenerator
+	sessions      domainuser.SessionRepository
+	ttl           time.Duration
+}
+
+// NewImpersonateUserUseCase creates a new ImpersonateUserUseCase.
+// ttl must match the lifetime of the tokens issued by tokenGen.
+// The impersonation is recorded as a session of the user when sessions is set,
+// so the user sees it and can revoke it like any other login.
+func NewImpersonateUserUseCase(
+	userRepo domainuser.Repository,
+	organizations domainuser.OrganizationRepository,
+	tokenGen domainuser.TokenGenerator,
+	sessions domainuser.SessionRepository,
+	ttl time.Duration,
+) *ImpersonateUserUseCase {
+	return &ImpersonateUserUseCase{
+		userRepo:      userRepo,
+		organizations: organizations,
+		tokenGen:      tokenGen,
+		sessions:      sessions,
+		ttl:           ttl,
+	}
+}
+
+// Execute executes the impersonate user use case.
+// Admins can't impersonate themselves or other admins, so impersonation never reaches user management.
+func (uc *ImpersonateUserUseCase) Execute(ctx context.Context, orgID, actorID, id int64, userAgent, ipAddress string) (*dto.ImpersonationResponse, error) {
+	if actorID == id {
+		return nil, domainuser.ErrCannotImpersonate
+	}
+
+	membership, err := memberOf(ctx, uc.organizations, orgID, id)
+	if err != nil {
+		return nil, err
+	}
+	if membership.Role == domainuser.RoleAdmin {
+		return nil, domainuser.ErrCannotImpersonate
+	}
+
+	userEntity, err := uc.userRepo.GetByID(ctx, orgID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	claims := domainuser.TokenClaims{
+		UserID:        userEntity.ID,
+		Email:         userEntity.Email,
+		Role:          membership.Role,
+		EmailVerified: userEntity.IsEmailVerified(),
+		OrgID:         membership.OrgID,
+		ActorID:       actorID,
+	}
+	if uc.sessions != nil {
+		claims.SessionID, err = domainuser.GenerateSecureToken(16)
+		if err != nil {
+			return nil, err
+		}
+		if len(userAgent) > maxSessionUserAgentLength {
+			userAgent = userAgent[:maxSessionUserAgentLength]
+		}
+
+		now := time.Now()
+		err = uc.sessions.Create(ctx, &domainuser.Session{
+			ID:         claims.SessionID,
+			UserID:     userEntity.ID,
+			ActorID:    actorID,
+			UserAgent:  userAgent,
+			IPAddress:  ipAddress,
+			CreatedAt:  now,
+			LastSeenAt: now,
+			ExpiresAt:  now.Add(uc.ttl),
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	token, err := uc.tokenGen.Generate(claims)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.ImpersonationResponse{
+		Token:          token,
+		ImpersonatedBy: actorID,
+		User: dto.UserResponse{
+			ID:              userEntity.ID,
+			Name:            userEntity.Name,
+			Email:           userEntity.Email,
+			Role:            string(membership.Role),
+			EmailVerifiedAt: userEntity.EmailVerifiedAt,
+			CreatedAt:       userEntity.CreatedAt,
+			UpdatedAt:       userEntity.UpdatedAt,
+		},
+	}, nil
+}
@@ -0,0 +1,116 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	domainuser "github.com/rulzi/hexa-go/internal/domain/user"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestImpersonateUserUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+	userRepo := &mockUserRepository{}
+	orgs := &mockOrganizationRepository{}
+	tokenGen := &mockTokenGenerator{}
+	uc := NewImpersonateUserUseCase(userRepo, orgs, tokenGen, nil, 5*time.Minute)
+
+	orgs.On("GetMembership", ctx, int64(2), int64(5)).Return(&domainuser.Membership{OrgID: 2, UserID: 5, Role: domainuser.RoleAuthor}, nil)
+	userRepo.On("GetByID", ctx, int64(2), int64(5)).Return(&domainuser.User{ID: 5, Name: "Jane", Email: "jane@example.com", Role: domainuser.RoleReader}, nil)
+	tokenGen.On("Generate", domainuser.TokenClaims{
+		UserID:  5,
+		Email:   "jane@example.com",
+		Role:    domainuser.RoleAuthor,
+		OrgID:   2,
+		ActorID: 1,
+	}).Return("impersonation-token", nil)
+
+	result, err := uc.Execute(ctx, 2, 1, 5, "Mozilla/5.0", "203.0.113.7")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "impersonation-token", result.Token)
+	assert.Equal(t, int64(1), result.ImpersonatedBy)
+	assert.Equal(t, int64(5), result.User.ID)
+	assert.Equal(t, "author", result.User.Role)
+	tokenGen.AssertExpectations(t)
+}
+
+func TestImpersonateUserUseCase_Execute_RecordsSession(t *testing.T) {
+	ctx := context.Background()
+	userRepo := &mockUserRepository{}
+	orgs := &mockOrganizationRepository{}
+	tokenGen := &mockTokenGenerator{}
+	sessions := &mockSessionRepository{}
+	uc := NewImpersonateUserUseCase(userRepo, orgs, tokenGen, sessions, 5*time.Minute)
+
+	orgs.On("GetMembership", ctx, int64(2), int64(5)).Return(&domainuser.Membership{OrgID: 2, UserID: 5, Role: domainuser.RoleAuthor}, nil)
+	userRepo.On("GetByID", ctx, int64(2), int64(5)).Return(&domainuser.User{ID: 5, Email: "jane@example.com"}, nil)
+	var session *domainuser.Session
+	sessions.On("Create", ctx, mock.AnythingOfType("*user.Session")).Run(func(args mock.Arguments) {
+		session = args.Get(1).(*domainuser.Session)
+	}).Return(nil)
+	tokenGen.On("Generate", mock.MatchedBy(func(claims domainuser.TokenClaims) bool {
+		return claims.ActorID == 1 && claims.SessionID != "" && claims.SessionID == session.ID
+	})).Return("impersonation-token", nil)
+
+	result, err := uc.Execute(ctx, 2, 1, 5, "Mozilla/5.0", "203.0.113.7")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "impersonation-token", result.Token)
+	assert.Equal(t, int64(5), session.UserID)
+	assert.Equal(t, int64(1), session.ActorID)
+	assert.Equal(t, "Mozilla/5.0", session.UserAgent)
+	assert.Equal(t, "203.0.113.7", session.IPAddress)
+	assert.WithinDuration(t, time.Now().Add(5*time.Minute), session.ExpiresAt, time.Minute)
+	sessions.AssertExpectations(t)
+	tokenGen.AssertExpectations(t)
+}
+
+func TestImpersonateUserUseCase_Execute_Refused(t *testing.T) {
+	tests := []struct {
+		name    string
+		id      int64
+		setup   func(orgs *mockOrganizationRepository)
+		wantErr error
+	}{
+		{
+			name:    "themselves",
+			id:      1,
+			setup:   func(orgs *mockOrganizationRepository) {},
+			wantErr: domainuser.ErrCannotImpersonate,
+		},
+		{
+			name: "another admin",
+			id:   5,
+			setup: func(orgs *mockOrganizationRepository) {
+				orgs.On("GetMembership", mock.Anything, int64(2), int64(5)).Return(&domainuser.Membership{OrgID: 2, UserID: 5, Role: domainuser.RoleAdmin}, nil)
+			},
+			wantErr: domainuser.ErrCannotImpersonate,
+		},
+		{
+			name: "user outside the organization",
+			id:   5,
+			setup: func(orgs *mockOrganizationRepository) {
+				orgs.On("GetMembership", mock.Anything, int64(2), int64(5)).Return(nil, domainuser.ErrNotMember)
+			},
+			wantErr: domainuser.ErrUserNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			orgs := &mockOrganizationRepository{}
+			tokenGen := &mockTokenGenerator{}
+			uc := NewImpersonateUserUseCase(&mockUserRepository{}, orgs, tokenGen, nil, 5*time.Minute)
+			tt.setup(orgs)
+
+			result, err := uc.Execute(context.Background(), 2, 1, tt.id, "", "")
+
+			assert.Nil(t, result)
+			assert.Equal(t, tt.wantErr, err)
+			tokenGen.AssertNotCalled(t, "Generate", mock.Anything)
+		})
+	}
+}
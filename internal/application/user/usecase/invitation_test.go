@@ -0,0 +1,270 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/rulzi/hexa-go/internal/application/user/dto"
+	domainuser "github.com/rulzi/hexa-go/internal/domain/user"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestCreateInvitationUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+	repo := &mockUserRepository{}
+	invitations := &mockInvitationRepository{}
+	notificationService := &mockNotificationService{}
+	uc := NewCreateInvitationUseCase(repo, &mockOrganizationRepository{}, invitations, notificationService, domainuser.RegistrationInviteOnly, 72*time.Hour)
+
+	var token string
+	repo.On("GetByEmail", ctx, "new@example.com").Return(nil, domainuser.ErrUserNotFound)
+	invitations.On("Create", ctx, mock.MatchedBy(func(i *domainuser.Invitation) bool {
+		return i.OrgID == 2 && i.InvitedBy == 1 && i.Role == domainuser.RoleEditor && i.TokenHash != "" &&
+			i.ExpiresAt.After(time.Now().Add(71*time.Hour))
+	})).Return(&domainuser.Invitation{ID: 5, OrgID: 2, Email: "new@example.com", Role: domainuser.RoleEditor, InvitedBy: 1}, nil)
+	notificationService.On("SendInvitationEmail", ctx, "new@example.com", mock.AnythingOfType("string"), mock.Anything).
+		Run(func(args mock.Arguments) { token = args.String(2) }).Return(nil)
+
+	result, err := uc.Execute(ctx, 2, 1, dto.CreateInvitationRequest{Email: "new@example.com", Role: "editor"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(5), result.ID)
+	assert.Equal(t, "editor", result.Role)
+	assert.NotEmpty(t, token)
+	invitations.AssertCalled(t, "Create", ctx, mock.MatchedBy(func(i *domainuser.Invitation) bool {
+		return i.TokenHash == domainuser.HashToken(token)
+	}))
+}
+
+func TestCreateInvitationUseCase_Execute_ExistingAccount(t *testing.T) {
+	ctx := context.Background()
+	repo := &mockUserRepository{}
+	orgs := &mockOrganizationRepository{}
+	invitations := &mockInvitationRepository{}
+	notificationService := &mockNotificationService{}
+	uc := NewCreateInvitationUseCase(repo, orgs, invitations, notificationService, domainuser.RegistrationOpen, time.Hour)
+
+	// The account joins once the invitation is accepted, not when it is sent
+	repo.On("GetByEmail", ctx, "member@example.com").Return(&domainuser.User{ID: 3, Email: "member@example.com"}, nil)
+	orgs.On("GetMembership", ctx, int64(2), int64(3)).Return(nil, domainuser.ErrNotMember)
+	invitations.On("Create", ctx, mock.Anything).Return(&domainuser.Invitation{ID: 5, OrgID: 2, Email: "member@example.com", Role: domainuser.DefaultRole}, nil)
+	notificationService.On("SendInvitationEmail", ctx, "member@example.com", mock.AnythingOfType("string"), mock.Anything).Return(nil)
+
+	result, err := uc.Execute(ctx, 2, 1, dto.CreateInvitationRequest{Email: "member@example.com"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(5), result.ID)
+	orgs.AssertNotCalled(t, "AddMember", mock.Anything, mock.Anything)
+	notificationService.AssertExpectations(t)
+}
+
+func TestCreateInvitationUseCase_Execute_Errors(t *testing.T) {
+	past := time.Now().Add(-time.Hour)
+
+	tests := []struct {
+		name     string
+		mode     domainuser.RegistrationMode
+		existing *domainuser.User
+		req      dto.CreateInvitationRequest
+		wantErr  error
+	}{
+		{
+			name:    "registration disabled",
+			mode:    domainuser.RegistrationDisabled,
+			req:     dto.CreateInvitationRequest{Email: "new@example.com"},
+			wantErr: domainuser.ErrRegistrationClosed,
+		},
+		{
+			name:     "already a member",
+			mode:     domainuser.RegistrationOpen,
+			existing: &domainuser.User{ID: 3, Email: "new@example.com"},
+			req:      dto.CreateInvitationRequest{Email: "new@example.com"},
+			wantErr:  domainuser.ErrAlreadyMember,
+		},
+		{
+			name:    "expiry in the past",
+			mode:    domainuser.RegistrationInviteOnly,
+			req:     dto.CreateInvitationRequest{Email: "new@example.com", ExpiresAt: &past},
+			wantErr: domainuser.ErrInvalidInvitationExpiry,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			repo := &mockUserRepository{}
+			invitations := &mockInvitationRepository{}
+			notificationService := &mockNotificationService{}
+			orgs := &mockOrganizationRepository{}
+			uc := NewCreateInvitationUseCase(repo, orgs, invitations, notificationService, tt.mode, time.Hour)
+
+			if tt.existing != nil {
+				repo.On("GetByEmail", ctx, tt.req.Email).Return(tt.existing, nil)
+				orgs.On("GetMembership", ctx, int64(1), tt.existing.ID).Return(&domainuser.Membership{OrgID: 1, UserID: tt.existing.ID}, nil)
+			} else {
+				repo.On("GetByEmail", ctx, tt.req.Email).Return(nil, domainuser.ErrUserNotFound)
+			}
+
+			result, err := uc.Execute(ctx, 1, 1, tt.req)
+
+			assert.Nil(t, result)
+			assert.Equal(t, tt.wantErr, err)
+			invitations.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+			notificationService.AssertNotCalled(t, "SendInvitationEmail", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestAcceptInvitationUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+	invitations := &mockInvitationRepository{}
+	repo := &mockUserRepository{}
+	createUser := &mockUserCreator{}
+	uc := NewAcceptInvitationUseCase(invitations, repo, &mockOrganizationRepository{}, createUser, domainuser.RegistrationInviteOnly)
+
+	invitation := &domainuser.Invitation{ID: 5, OrgID: 2, Email: "new@example.com", Role: domainuser.RoleEditor, ExpiresAt: time.Now().Add(time.Hour)}
+	invitations.On("GetByHash", ctx, domainuser.HashToken("invite-token")).Return(invitation, nil)
+	repo.On("GetByEmail", ctx, "new@example.com").Return(nil, domainuser.ErrUserNotFound)
+	createUser.On("Execute", ctx, dto.CreateUserRequest{
+		Name:          "New User",
+		Email:         "new@example.com",
+		Password:      "password123",
+		Role:          "editor",
+		OrgID:         2,
+		EmailVerified: true,
+	}).Return(&dto.UserResponse{ID: 9, Email: "new@example.com", Role: "editor"}, nil)
+	invitations.On("MarkAccepted", ctx, int64(5)).Return(nil)
+
+	result, err := uc.Execute(ctx, dto.AcceptInvitationRequest{Token: "invite-token", Name: "New User", Password: "password123"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(9), result.ID)
+	invitations.AssertExpectations(t)
+	createUser.AssertExpectations(t)
+}
+
+func TestAcceptInvitationUseCase_Execute_ExistingAccount(t *testing.T) {
+	ctx := context.Background()
+	invitations := &mockInvitationRepository{}
+	repo := &mockUserRepository{}
+	orgs := &mockOrganizationRepository{}
+	createUser := &mockUserCreator{}
+	uc := NewAcceptInvitationUseCase(invitations, repo, orgs, createUser, domainuser.RegistrationOpen)
+
+	invitation := &domainuser.Invitation{ID: 5, OrgID: 2, Email: "member@example.com", Role: domainuser.RoleEditor, ExpiresAt: time.Now().Add(time.Hour)}
+	invitations.On("GetByHash", ctx, domainuser.HashToken("invite-token")).Return(invitation, nil)
+	repo.On("GetByEmail", ctx, "member@example.com").Return(&domainuser.User{ID: 3, Name: "Member", Email: "member@example.com"}, nil)
+	orgs.On("AddMember", ctx, mock.MatchedBy(func(m *domainuser.Membership) bool {
+		return m.OrgID == 2 && m.UserID == 3 && m.Role == domainuser.RoleEditor
+	})).Return(nil)
+	invitations.On("MarkAccepted", ctx, int64(5)).Return(nil)
+
+	// The account joins as it is, without a name or password
+	result, err := uc.Execute(ctx, dto.AcceptInvitationRequest{Token: "invite-token"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(3), result.ID)
+	assert.Equal(t, "editor", result.Role)
+	orgs.AssertExpectations(t)
+	invitations.AssertExpectations(t)
+	createUser.AssertNotCalled(t, "Execute", mock.Anything, mock.Anything)
+}
+
+func TestAcceptInvitationUseCase_Execute_Errors(t *testing.T) {
+	acceptedAt := time.Now()
+
+	tests := []struct {
+		name       string
+		mode       domainuser.RegistrationMode
+		invitation *domainuser.Invitation
+		req        *dto.AcceptInvitationRequest
+		createErr  error
+		wantErr    error
+	}{
+		{
+			name:    "registration disabled",
+			mode:    domainuser.RegistrationDisabled,
+			wantErr: domainuser.ErrRegistrationClosed,
+		},
+		{
+			name:    "unknown token",
+			mode:    domainuser.RegistrationOpen,
+			wantErr: domainuser.ErrInvalidInvitation,
+		},
+		{
+			name:       "expired invitation",
+			mode:       domainuser.RegistrationInviteOnly,
+			invitation: &domainuser.Invitation{ID: 5, Email: "new@example.com", ExpiresAt: time.Now().Add(-time.Minute)},
+			wantErr:    domainuser.ErrInvalidInvitation,
+		},
+		{
+			name:       "accepted invitation",
+			mode:       domainuser.RegistrationInviteOnly,
+			invitation: &domainuser.Invitation{ID: 5, Email: "new@example.com", ExpiresAt: time.Now().Add(time.Hour), AcceptedAt: &acceptedAt},
+			wantErr:    domainuser.ErrInvalidInvitation,
+		},
+		{
+			name:       "account created meanwhile",
+			mode:       domainuser.RegistrationInviteOnly,
+			invitation: &domainuser.Invitation{ID: 5, Email: "new@example.com", ExpiresAt: time.Now().Add(time.Hour)},
+			createErr:  domainuser.ErrEmailExists,
+			wantErr:    domainuser.ErrEmailExists,
+		},
+		{
+			name:       "new account without a password",
+			mode:       domainuser.RegistrationInviteOnly,
+			invitation: &domainuser.Invitation{ID: 5, Email: "new@example.com", ExpiresAt: time.Now().Add(time.Hour)},
+			req:        &dto.AcceptInvitationRequest{Token: "invite-token", Name: "New User"},
+			wantErr:    domainuser.ErrPasswordRequired,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			invitations := &mockInvitationRepository{}
+			createUser := &mockUserCreator{}
+			repo := &mockUserRepository{}
+			uc := NewAcceptInvitationUseCase(invitations, repo, &mockOrganizationRepository{}, createUser, tt.mode)
+
+			if tt.invitation != nil {
+				invitations.On("GetByHash", ctx, mock.Anything).Return(tt.invitation, nil)
+			} else {
+				invitations.On("GetByHash", ctx, mock.Anything).Return(nil, domainuser.ErrInvalidInvitation)
+			}
+			repo.On("GetByEmail", ctx, mock.Anything).Return(nil, domainuser.ErrUserNotFound).Maybe()
+			createUser.On("Execute", ctx, mock.Anything).Return(nil, tt.createErr).Maybe()
+
+			req := dto.AcceptInvitationRequest{Token: "invite-token", Name: "New User", Password: "password123"}
+			if tt.req != nil {
+				req = *tt.req
+			}
+			result, err := uc.Execute(ctx, req)
+
+			assert.Nil(t, result)
+			assert.Equal(t, tt.wantErr, err)
+			invitations.AssertNotCalled(t, "MarkAccepted", mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestAcceptInvitationUseCase_Execute_MarkAcceptedError(t *testing.T) {
+	ctx := context.Background()
+	invitations := &mockInvitationRepository{}
+	repo := &mockUserRepository{}
+	createUser := &mockUserCreator{}
+	uc := NewAcceptInvitationUseCase(invitations, repo, &mockOrganizationRepository{}, createUser, domainuser.RegistrationOpen)
+
+	dbErr := errors.New("database error")
+	invitations.On("GetByHash", ctx, mock.Anything).Return(&domainuser.Invitation{ID: 5, Email: "new@example.com", ExpiresAt: time.Now().Add(time.Hour)}, nil)
+	repo.On("GetByEmail", ctx, "new@example.com").Return(nil, domainuser.ErrUserNotFound)
+	createUser.On("Execute", ctx, mock.Anything).Return(&dto.UserResponse{ID: 9}, nil)
+	invitations.On("MarkAccepted", ctx, int64(5)).Return(dbErr)
+
+	_, err := uc.Execute(ctx, dto.AcceptInvitationRequest{Token: "invite-token", Name: "New User", Password: "password123"})
+
+	assert.Equal(t, dbErr, err)
+}
@@ -7,7 +7,7 @@ import (
 	domainuser "github.com/rulzi/hexa-go/internal/domain/user"
 )
 
-// ListUsersUseCase handles listing users with pagination
+// ListUsersUseCase handles searching, filtering and sorting users with pagination
 type ListUsersUseCase struct {
 	userRepo domainuser.Repository
 }
@@ -19,24 +19,33 @@ func NewListUsersUseCase(userRepo domainuser.Repository) *ListUsersUseCase {
 	}
 }
 
-// Execute executes the list users use case
-func (uc *ListUsersUseCase) Execute(ctx context.Context, limit, offset int) (*dto.ListUsersResponse, error) {
+// Execute executes the list users use case for the members of an organization
+func (uc *ListUsersUseCase) Execute(ctx context.Context, orgID int64, req dto.ListUsersRequest) (*dto.ListUsersResponse, error) {
 	// Default pagination
-	if limit <= 0 {
-		limit = 10
+	if req.Limit <= 0 {
+		req.Limit = 10
 	}
-	if offset < 0 {
-		offset = 0
+	if req.Offset < 0 {
+		req.Offset = 0
 	}
 
-	// Get users
-	users, err := uc.userRepo.List(ctx, limit, offset)
-	if err != nil {
+	criteria := domainuser.Criteria{
+		OrgID:       orgID,
+		Search:      req.Search,
+		Role:        domainuser.Role(req.Role),
+		CreatedFrom: req.CreatedFrom,
+		CreatedTo:   req.CreatedTo,
+		SortBy:      domainuser.SortField(req.Sort),
+		SortDir:     domainuser.SortDirection(req.Order),
+		Limit:       req.Limit,
+		Offset:      req.Offset,
+	}
+	if err := criteria.Validate(); err != nil {
 		return nil, err
 	}
 
-	// Get total count
-	total, err := uc.userRepo.Count(ctx)
+	// Get users with the total count
+	users, total, err := uc.userRepo.Find(ctx, criteria)
 	if err != nil {
 		return nil, err
 	}
@@ -45,18 +54,20 @@ func (uc *ListUsersUseCase) Execute(ctx context.Context, limit, offset int) (*dt
 	userResponses := make([]dto.UserResponse, len(users))
 	for i, u := range users {
 		userResponses[i] = dto.UserResponse{
-			ID:        u.ID,
-			Name:      u.Name,
-			Email:     u.Email,
-			CreatedAt: u.CreatedAt,
-			UpdatedAt: u.UpdatedAt,
+			ID:              u.ID,
+			Name:            u.Name,
+			Email:           u.Email,
+			Role:            string(u.Role),
+			EmailVerifiedAt: u.EmailVerifiedAt,
+			CreatedAt:       u.CreatedAt,
+			UpdatedAt:       u.UpdatedAt,
 		}
 	}
 
 	return &dto.ListUsersResponse{
 		Users:  userResponses,
 		Total:  total,
-		Limit:  limit,
-		Offset: offset,
+		Limit:  req.Limit,
+		Offset: req.Offset,
 	}, nil
 }
@@ -0,0 +1,35 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/rulzi/hexa-go/internal/application/user/dto"
+	domainuser "github.com/rulzi/hexa-go/internal/domain/user"
+)
+
+// ListAPIKeysUseCase handles listing the API keys of a user
+type ListAPIKeysUseCase struct {
+	apiKeys domainuser.APIKeyRepository
+}
+
+// NewListAPIKeysUseCase creates a new ListAPIKeysUseCase
+func NewListAPIKeysUseCase(apiKeys domainuser.APIKeyRepository) *ListAPIKeysUseCase {
+	return &ListAPIKeysUseCase{
+		apiKeys: apiKeys,
+	}
+}
+
+// Execute executes the list API keys use case
+func (uc *ListAPIKeysUseCase) Execute(ctx context.Context, userID int64) ([]dto.APIKeyResponse, error) {
+	keys, err := uc.apiKeys.ListByUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	keyResponses := make([]dto.APIKeyResponse, len(keys))
+	for i, k := range keys {
+		keyResponses[i] = newAPIKeyResponse(k)
+	}
+
+	return keyResponses, nil
+}
@@ -0,0 +1,62 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/rulzi/hexa-go/internal/application/user/dto"
+	domainuser "github.com/rulzi/hexa-go/internal/domain/user"
+)
+
+// ListDeletedUsersUseCase handles listing the users in the trash
+type ListDeletedUsersUseCase struct {
+	userRepo domainuser.Repository
+}
+
+// NewListDeletedUsersUseCase creates a new ListDeletedUsersUseCase
+func NewListDeletedUsersUseCase(userRepo domainuser.Repository) *ListDeletedUsersUseCase {
+	return &ListDeletedUsersUseCase{
+		userRepo: userRepo,
+	}
+}
+
+// Execute executes the list deleted users use case
+func (uc *ListDeletedUsersUseCase) Execute(ctx context.Context, orgID int64, limit, offset int) (*dto.ListUsersResponse, error) {
+	// Default pagination
+	if limit <= 0 {
+		limit = 10
+	}
+	if offset < 0 {
+		offset = 0
+	}
+
+	users, err := uc.userRepo.ListDeleted(ctx, orgID, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+
+	total, err := uc.userRepo.CountDeleted(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+
+	userResponses := make([]dto.UserResponse, len(users))
+	for i, u := range users {
+		userResponses[i] = dto.UserResponse{
+			ID:              u.ID,
+			Name:            u.Name,
+			Email:           u.Email,
+			Role:            string(u.Role),
+			EmailVerifiedAt: u.EmailVerifiedAt,
+			CreatedAt:       u.CreatedAt,
+			UpdatedAt:       u.UpdatedAt,
+			DeletedAt:       u.DeletedAt,
+		}
+	}
+
+	return &dto.ListUsersResponse{
+		Users:  userResponses,
+		Total:  total,
+		Limit:  limit,
+		Offset: offset,
+	}, nil
+}
@@ -0,0 +1,70 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/rulzi/hexa-go/internal/application/user/dto"
+	domainuser "github.com/rulzi/hexa-go/internal/domain/user"
+)
+
+// maxLoginAttemptsLimit caps how many attempts one request can list
+const maxLoginAttemptsLimit = 100
+
+// ListLoginAttemptsUseCase handles listing the recent login activity of a user
+type ListLoginAttemptsUseCase struct {
+	userRepo      domainuser.Repository
+	organizations domainuser.OrganizationRepository
+	attempts      domainuser.LoginAttemptRepository
+}
+
+// NewListLoginAttemptsUseCase creates a new ListLoginAttemptsUseCase
+func NewListLoginAttemptsUseCase(
+	userRepo domainuser.Repository,
+	organizations domainuser.OrganizationRepository,
+	attempts domainuser.LoginAttemptRepository,
+) *ListLoginAttemptsUseCase {
+	return &ListLoginAttemptsUseCase{
+		userRepo:      userRepo,
+		organizations: organizations,
+		attempts:      attempts,
+	}
+}
+
+// Execute executes the list login attempts use case
+func (uc *ListLoginAttemptsUseCase) Execute(ctx context.Context, orgID, userID int64, limit int) (*dto.ListLoginAttemptsResponse, error) {
+	if limit <= 0 {
+		limit = 20
+	}
+	if limit > maxLoginAttemptsLimit {
+		limit = maxLoginAttemptsLimit
+	}
+
+	// Make sure the user exists and belongs to the organization
+	if _, err := memberOf(ctx, uc.organizations, orgID, userID); err != nil {
+		return nil, err
+	}
+	if _, err := uc.userRepo.GetByID(ctx, orgID, userID); err != nil {
+		return nil, err
+	}
+
+	attempts, err := uc.attempts.ListByUser(ctx, userID, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	attemptResponses := make([]dto.LoginAttemptResponse, len(attempts))
+	for i, a := range attempts {
+		attemptResponses[i] = dto.LoginAttemptResponse{
+			ID:        a.ID,
+			IPAddress: a.IPAddress,
+			UserAgent: a.UserAgent,
+			Success:   a.Success,
+			CreatedAt: a.CreatedAt,
+		}
+	}
+
+	return &dto.ListLoginAttemptsResponse{
+		Attempts: attemptResponses,
+		Limit:    limit,
+	}, nil
+}
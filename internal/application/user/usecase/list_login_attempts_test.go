@@ -0,0 +1,86 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	domainuser "github.com/rulzi/hexa-go/internal/domain/user"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestListLoginAttemptsUseCase_Execute(t *testing.T) {
+	attempts := []*domainuser.LoginAttempt{
+		{ID: 2, UserID: 1, IPAddress: "10.0.0.1", Success: true, CreatedAt: time.Now()},
+		{ID: 1, UserID: 1, IPAddress: "10.0.0.2", Success: false, CreatedAt: time.Now().Add(-time.Minute)},
+	}
+
+	tests := []struct {
+		name      string
+		limit     int
+		wantLimit int
+		setup     func(repo *mockUserRepository, attemptRepo *mockLoginAttemptRepository)
+		wantErr   error
+	}{
+		{
+			name:      "success with default limit",
+			limit:     0,
+			wantLimit: 20,
+			setup: func(repo *mockUserRepository, attemptRepo *mockLoginAttemptRepository) {
+				repo.On("GetByID", mock.Anything, int64(1), int64(1)).Return(&domainuser.User{ID: 1}, nil)
+				attemptRepo.On("ListByUser", mock.Anything, int64(1), 20).Return(attempts, nil)
+			},
+		},
+		{
+			name:      "limit is capped",
+			limit:     1000,
+			wantLimit: 100,
+			setup: func(repo *mockUserRepository, attemptRepo *mockLoginAttemptRepository) {
+				repo.On("GetByID", mock.Anything, int64(1), int64(1)).Return(&domainuser.User{ID: 1}, nil)
+				attemptRepo.On("ListByUser", mock.Anything, int64(1), 100).Return(attempts, nil)
+			},
+		},
+		{
+			name: "user not found",
+			setup: func(repo *mockUserRepository, attemptRepo *mockLoginAttemptRepository) {
+				repo.On("GetByID", mock.Anything, int64(1), int64(1)).Return(nil, domainuser.ErrUserNotFound)
+			},
+			wantErr: domainuser.ErrUserNotFound,
+		},
+		{
+			name: "repository error",
+			setup: func(repo *mockUserRepository, attemptRepo *mockLoginAttemptRepository) {
+				repo.On("GetByID", mock.Anything, int64(1), int64(1)).Return(&domainuser.User{ID: 1}, nil)
+				attemptRepo.On("ListByUser", mock.Anything, int64(1), 20).Return(nil, errors.New("database error"))
+			},
+			wantErr: errors.New("database error"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &mockUserRepository{}
+			attemptRepo := &mockLoginAttemptRepository{}
+			tt.setup(repo, attemptRepo)
+
+			uc := NewListLoginAttemptsUseCase(repo, newMemberOrganizations(domainuser.RoleAuthor), attemptRepo)
+			result, err := uc.Execute(context.Background(), 1, 1, tt.limit)
+
+			if tt.wantErr != nil {
+				assert.Equal(t, tt.wantErr, err)
+				assert.Nil(t, result)
+			} else {
+				assert.NoError(t, err)
+				assert.Len(t, result.Attempts, 2)
+				assert.Equal(t, tt.wantLimit, result.Limit)
+				assert.Equal(t, "10.0.0.1", result.Attempts[0].IPAddress)
+				assert.False(t, result.Attempts[1].Success)
+			}
+
+			repo.AssertExpectations(t)
+			attemptRepo.AssertExpectations(t)
+		})
+	}
+}
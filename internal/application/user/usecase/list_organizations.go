@@ -0,0 +1,43 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/rulzi/hexa-go/internal/application/user/dto"
+	domainuser "github.com/rulzi/hexa-go/internal/domain/user"
+)
+
+// ListOrganizationsUseCase handles listing the organizations of a user
+type ListOrganizationsUseCase struct {
+	organizations domainuser.OrganizationRepository
+}
+
+// NewListOrganizationsUseCase creates a new ListOrganizationsUseCase
+func NewListOrganizationsUseCase(organizations domainuser.OrganizationRepository) *ListOrganizationsUseCase {
+	return &ListOrganizationsUseCase{
+		organizations: organizations,
+	}
+}
+
+// Execute executes the list organizations use case.
+// The organization of the current request is marked as current.
+func (uc *ListOrganizationsUseCase) Execute(ctx context.Context, userID, currentOrgID int64) ([]dto.OrganizationResponse, error) {
+	memberships, err := uc.organizations.ListByUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	orgResponses := make([]dto.OrganizationResponse, len(memberships))
+	for i, m := range memberships {
+		orgResponses[i] = dto.OrganizationResponse{
+			ID:        m.Organization.ID,
+			Name:      m.Organization.Name,
+			Role:      string(m.Role),
+			Current:   m.Organization.ID == currentOrgID,
+			JoinedAt:  m.JoinedAt,
+			CreatedAt: m.Organization.CreatedAt,
+		}
+	}
+
+	return orgResponses, nil
+}
@@ -0,0 +1,44 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/rulzi/hexa-go/internal/application/user/dto"
+	domainuser "github.com/rulzi/hexa-go/internal/domain/user"
+)
+
+// ListSessionsUseCase handles listing the active sessions of a user
+type ListSessionsUseCase struct {
+	sessions domainuser.SessionRepository
+}
+
+// NewListSessionsUseCase creates a new ListSessionsUseCase
+func NewListSessionsUseCase(sessions domainuser.SessionRepository) *ListSessionsUseCase {
+	return &ListSessionsUseCase{
+		sessions: sessions,
+	}
+}
+
+// Execute executes the list sessions use case.
+// The session of the current request is marked as current.
+func (uc *ListSessionsUseCase) Execute(ctx context.Context, userID int64, currentSessionID string) ([]dto.SessionResponse, error) {
+	sessions, err := uc.sessions.ListActiveByUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	sessionResponses := make([]dto.SessionResponse, len(sessions))
+	for i, s := range sessions {
+		sessionResponses[i] = dto.SessionResponse{
+			ID:             s.ID,
+			UserAgent:      s.UserAgent,
+			IPAddress:      s.IPAddress,
+			CreatedAt:      s.CreatedAt,
+			LastSeenAt:     s.LastSeenAt,
+			Current:        currentSessionID != "" && s.ID == currentSessionID,
+			ImpersonatedBy: s.ActorID,
+		}
+	}
+
+	return sessionResponses, nil
+}
@@ -9,11 +9,9 @@ import (
 	"github.com/rulzi/hexa-go/internal/application/user/dto"
 	domainuser "github.com/rulzi/hexa-go/internal/domain/user"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 )
 
-// Ensure dto is used
-var _ *dto.ListUsersResponse
-
 func TestNewListUsersUseCase(t *testing.T) {
 	repo := &mockUserRepository{}
 
@@ -51,10 +49,9 @@ func TestListUsersUseCase_Execute_Success(t *testing.T) {
 	}
 	total := int64(2)
 
-	repo.On("List", ctx, limit, offset).Return(users, nil)
-	repo.On("Count", ctx).Return(total, nil)
+	repo.On("Find", ctx, domainuser.Criteria{OrgID: 1, SortBy: domainuser.SortByCreatedAt, SortDir: domainuser.SortDesc, Limit: limit, Offset: offset}).Return(users, total, nil)
 
-	result, err := uc.Execute(ctx, limit, offset)
+	result, err := uc.Execute(ctx, 1, dto.ListUsersRequest{Limit: limit, Offset: offset})
 
 	assert.NoError(t, err)
 	assert.NotNil(t, result)
@@ -78,10 +75,9 @@ func TestListUsersUseCase_Execute_DefaultPagination(t *testing.T) {
 	users := []*domainuser.User{}
 	total := int64(0)
 
-	repo.On("List", ctx, 10, 0).Return(users, nil)
-	repo.On("Count", ctx).Return(total, nil)
+	repo.On("Find", ctx, domainuser.Criteria{OrgID: 1, SortBy: domainuser.SortByCreatedAt, SortDir: domainuser.SortDesc, Limit: 10, Offset: 0}).Return(users, total, nil)
 
-	result, err := uc.Execute(ctx, -1, -1)
+	result, err := uc.Execute(ctx, 1, dto.ListUsersRequest{Limit: -1, Offset: -1})
 
 	assert.NoError(t, err)
 	assert.NotNil(t, result)
@@ -91,7 +87,7 @@ func TestListUsersUseCase_Execute_DefaultPagination(t *testing.T) {
 	repo.AssertExpectations(t)
 }
 
-func TestListUsersUseCase_Execute_ListError(t *testing.T) {
+func TestListUsersUseCase_Execute_FindError(t *testing.T) {
 	ctx := context.Background()
 	repo := &mockUserRepository{}
 
@@ -101,19 +97,18 @@ func TestListUsersUseCase_Execute_ListError(t *testing.T) {
 	offset := 0
 	listError := errors.New("list error")
 
-	repo.On("List", ctx, limit, offset).Return(nil, listError)
+	repo.On("Find", ctx, mock.Anything).Return(nil, int64(0), listError)
 
-	result, err := uc.Execute(ctx, limit, offset)
+	result, err := uc.Execute(ctx, 1, dto.ListUsersRequest{Limit: limit, Offset: offset})
 
 	assert.Error(t, err)
 	assert.Equal(t, listError, err)
 	assert.Nil(t, result)
 
 	repo.AssertExpectations(t)
-	repo.AssertNotCalled(t, "Count")
 }
 
-func TestListUsersUseCase_Execute_CountError(t *testing.T) {
+func TestListUsersUseCase_Execute_EmptyList(t *testing.T) {
 	ctx := context.Background()
 	repo := &mockUserRepository{}
 
@@ -121,51 +116,86 @@ func TestListUsersUseCase_Execute_CountError(t *testing.T) {
 
 	limit := 10
 	offset := 0
-	users := []*domainuser.User{
-		{
-			ID:        1,
-			Name:      "User 1",
-			Email:     "user1@example.com",
-			Password:  "hashed1",
-			CreatedAt: time.Now(),
-			UpdatedAt: time.Now(),
-		},
-	}
-	countError := errors.New("count error")
+	users := []*domainuser.User{}
+	total := int64(0)
 
-	repo.On("List", ctx, limit, offset).Return(users, nil)
-	repo.On("Count", ctx).Return(int64(0), countError)
+	repo.On("Find", ctx, domainuser.Criteria{OrgID: 1, SortBy: domainuser.SortByCreatedAt, SortDir: domainuser.SortDesc, Limit: limit, Offset: offset}).Return(users, total, nil)
 
-	result, err := uc.Execute(ctx, limit, offset)
+	result, err := uc.Execute(ctx, 1, dto.ListUsersRequest{Limit: limit, Offset: offset})
 
-	assert.Error(t, err)
-	assert.Equal(t, countError, err)
-	assert.Nil(t, result)
+	assert.NoError(t, err)
+	assert.NotNil(t, result)
+	assert.Equal(t, int64(0), result.Total)
+	assert.Equal(t, 0, len(result.Users))
 
 	repo.AssertExpectations(t)
 }
 
-func TestListUsersUseCase_Execute_EmptyList(t *testing.T) {
+func TestListUsersUseCase_Execute_Filters(t *testing.T) {
 	ctx := context.Background()
 	repo := &mockUserRepository{}
 
 	uc := NewListUsersUseCase(repo)
 
-	limit := 10
-	offset := 0
-	users := []*domainuser.User{}
-	total := int64(0)
+	from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)
+	criteria := domainuser.Criteria{
+		OrgID:       1,
+		Search:      "doe",
+		Role:        domainuser.RoleEditor,
+		CreatedFrom: &from,
+		CreatedTo:   &to,
+		SortBy:      domainuser.SortByName,
+		SortDir:     domainuser.SortAsc,
+		Limit:       20,
+		Offset:      40,
+	}
 
-	repo.On("List", ctx, limit, offset).Return(users, nil)
-	repo.On("Count", ctx).Return(total, nil)
+	repo.On("Find", ctx, criteria).Return([]*domainuser.User{{ID: 3, Name: "Jane Doe", Role: domainuser.RoleEditor}}, int64(41), nil)
 
-	result, err := uc.Execute(ctx, limit, offset)
+	result, err := uc.Execute(ctx, 1, dto.ListUsersRequest{
+		Search:      "doe",
+		Role:        "editor",
+		CreatedFrom: &from,
+		CreatedTo:   &to,
+		Sort:        "name",
+		Order:       "asc",
+		Limit:       20,
+		Offset:      40,
+	})
 
 	assert.NoError(t, err)
-	assert.NotNil(t, result)
-	assert.Equal(t, int64(0), result.Total)
-	assert.Equal(t, 0, len(result.Users))
-
+	assert.Equal(t, int64(41), result.Total)
+	assert.Equal(t, "editor", result.Users[0].Role)
 	repo.AssertExpectations(t)
 }
 
+func TestListUsersUseCase_Execute_InvalidCriteria(t *testing.T) {
+	from := time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		req     dto.ListUsersRequest
+		wantErr error
+	}{
+		{name: "unknown sort field", req: dto.ListUsersRequest{Sort: "password"}, wantErr: domainuser.ErrInvalidSortField},
+		{name: "unknown direction", req: dto.ListUsersRequest{Order: "up"}, wantErr: domainuser.ErrInvalidSortDirection},
+		{name: "unknown role", req: dto.ListUsersRequest{Role: "owner"}, wantErr: domainuser.ErrInvalidRole},
+		{name: "range ends before it starts", req: dto.ListUsersRequest{CreatedFrom: &from, CreatedTo: &to}, wantErr: domainuser.ErrInvalidDateRange},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &mockUserRepository{}
+			uc := NewListUsersUseCase(repo)
+
+			result, err := uc.Execute(context.Background(), 1, tt.req)
+
+			assert.Equal(t, tt.wantErr, err)
+			assert.Nil(t, result)
+			repo.AssertNotCalled(t, "Find", mock.Anything, mock.Anything)
+		})
+	}
+}
+
@@ -2,6 +2,7 @@ package usecase
 
 import (
 	"context"
+	"time"
 
 	"github.com/rulzi/hexa-go/internal/application/user/dto"
 	domainuser "github.com/rulzi/hexa-go/internal/domain/user"
@@ -11,50 +12,165 @@ import (
 type LoginUseCase struct {
 	userRepo       domainuser.Repository
 	passwordHasher domainuser.PasswordHasher
-	tokenGen       domainuser.TokenGenerator
+	tokenIssuer    *TokenIssuer
+	policy         domainuser.EmailVerificationPolicy
+	throttler      *LoginThrottler
+	attempts       domainuser.LoginAttemptRepository
+	twoFactor      *TwoFactorChallenger
 }
 
 // NewLoginUseCase creates a new LoginUseCase
 func NewLoginUseCase(
 	userRepo domainuser.Repository,
 	passwordHasher domainuser.PasswordHasher,
-	tokenGen domainuser.TokenGenerator,
+	tokenIssuer *TokenIssuer,
+	policy domainuser.EmailVerificationPolicy,
+	throttler *LoginThrottler,
+	attempts domainuser.LoginAttemptRepository,
+	twoFactor *TwoFactorChallenger,
 ) *LoginUseCase {
 	return &LoginUseCase{
 		userRepo:       userRepo,
 		passwordHasher: passwordHasher,
-		tokenGen:       tokenGen,
+		tokenIssuer:    tokenIssuer,
+		policy:         policy,
+		throttler:      throttler,
+		attempts:       attempts,
+		twoFactor:      twoFactor,
 	}
 }
 
 // Execute executes the login use case
 func (uc *LoginUseCase) Execute(ctx context.Context, req dto.LoginRequest) (*dto.LoginResponse, error) {
+	// Reject locked accounts and IP addresses before checking the password
+	if uc.throttler != nil {
+		if err := uc.throttler.Check(ctx, req.Email, req.IPAddress); err != nil {
+			return nil, err
+		}
+	}
+
 	// Get user by email
 	userEntity, err := uc.userRepo.GetByEmail(ctx, req.Email)
 	if err != nil {
+		uc.loginFailed(ctx, req, 0)
 		return nil, domainuser.ErrInvalidCredentials
 	}
 
 	// Verify password
 	if !uc.passwordHasher.Verify(userEntity.Password, req.Password) {
+		uc.loginFailed(ctx, req, userEntity.ID)
 		return nil, domainuser.ErrInvalidCredentials
 	}
 
-	// Generate JWT token
-	token, err := uc.tokenGen.Generate(userEntity.ID, userEntity.Email)
+	// Enforce the email verification policy once the password is known to be right
+	if uc.policy.BlocksLogin() && !userEntity.IsEmailVerified() {
+		return nil, domainuser.ErrEmailNotVerified
+	}
+
+	// Users with two-factor authentication trade a challenge token for the real tokens.
+	// The lockout counter is only reset once the second factor is verified.
+	if uc.twoFactor != nil {
+		required, err := uc.twoFactor.Required(ctx, userEntity.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		if required {
+			challengeToken, err := uc.twoFactor.Issue(ctx, userEntity.ID)
+			if err != nil {
+				return nil, err
+			}
+
+			uc.rehashIfNeeded(ctx, userEntity, req.Password)
+
+			return &dto.LoginResponse{
+				TwoFactorRequired: true,
+				ChallengeToken:    challengeToken,
+			}, nil
+		}
+	}
+
+	if uc.throttler != nil {
+		uc.throttler.Succeeded(ctx, req.Email)
+	}
+
+	// Issue access and refresh tokens
+	tokens, err := uc.tokenIssuer.StartSession(ctx, userEntity, req.UserAgent, req.IPAddress)
 	if err != nil {
 		return nil, err
 	}
 
+	recordLoginAttempt(ctx, uc.attempts, req.Email, req.IPAddress, req.UserAgent, userEntity.ID, true)
+
+	uc.rehashIfNeeded(ctx, userEntity, req.Password)
+
 	// Return response
+	return newLoginResponse(tokens, userEntity), nil
+}
+
+// loginFailed counts the failure towards a lockout and records it
+func (uc *LoginUseCase) loginFailed(ctx context.Context, req dto.LoginRequest, userID int64) {
+	if uc.throttler != nil {
+		uc.throttler.Failed(ctx, req.Email, req.IPAddress)
+	}
+	recordLoginAttempt(ctx, uc.attempts, req.Email, req.IPAddress, req.UserAgent, userID, false)
+}
+
+// recordLoginAttempt stores the attempt for the login activity of the user
+func recordLoginAttempt(
+	ctx context.Context,
+	attempts domainuser.LoginAttemptRepository,
+	email, ipAddress, userAgent string,
+	userID int64,
+	success bool,
+) {
+	if attempts == nil {
+		return
+	}
+
+	// Recording is best effort and never fails the login
+	_ = attempts.Create(ctx, &domainuser.LoginAttempt{
+		UserID:    userID,
+		Email:     email,
+		IPAddress: ipAddress,
+		UserAgent: userAgent,
+		Success:   success,
+		CreatedAt: time.Now(),
+	})
+}
+
+// newLoginResponse builds the response for a completed login
+func newLoginResponse(tokens *dto.TokenResponse, userEntity *domainuser.User) *dto.LoginResponse {
 	return &dto.LoginResponse{
-		Token: token,
-		User: dto.UserResponse{
-			ID:        userEntity.ID,
-			Name:      userEntity.Name,
-			Email:     userEntity.Email,
-			CreatedAt: userEntity.CreatedAt,
-			UpdatedAt: userEntity.UpdatedAt,
+		Token:        tokens.Token,
+		RefreshToken: tokens.RefreshToken,
+		User: &dto.UserResponse{
+			ID:              userEntity.ID,
+			Name:            userEntity.Name,
+			Email:           userEntity.Email,
+			Role:            string(userEntity.Role),
+			EmailVerifiedAt: userEntity.EmailVerifiedAt,
+			CreatedAt:       userEntity.CreatedAt,
+			UpdatedAt:       userEntity.UpdatedAt,
 		},
-	}, nil
+	}
+}
+
+// rehashIfNeeded upgrades legacy or outdated hashes while the plain password is at hand
+func (uc *LoginUseCase) rehashIfNeeded(ctx context.Context, userEntity *domainuser.User, password string) {
+	if uc.passwordHasher.NeedsRehash(userEntity.Password) {
+		uc.rehashPassword(ctx, userEntity, password)
+	}
+}
+
+// rehashPassword stores a new hash of the password with the current algorithm and parameters.
+// Failures are ignored, the old hash keeps working and is upgraded on a later login.
+func (uc *LoginUseCase) rehashPassword(ctx context.Context, userEntity *domainuser.User, password string) {
+	hashedPassword, err := uc.passwordHasher.Hash(password)
+	if err != nil {
+		return
+	}
+
+	userEntity.Password = hashedPassword
+	_, _ = uc.userRepo.Update(ctx, 0, userEntity)
 }
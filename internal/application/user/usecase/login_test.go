@@ -9,6 +9,7 @@ import (
 	"github.com/rulzi/hexa-go/internal/application/user/dto"
 	domainuser "github.com/rulzi/hexa-go/internal/domain/user"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 )
 
 // Ensure dto is used
@@ -18,13 +19,14 @@ func TestNewLoginUseCase(t *testing.T) {
 	repo := &mockUserRepository{}
 	passwordHasher := &mockPasswordHasher{}
 	tokenGen := &mockTokenGenerator{}
+	refreshRepo := &mockRefreshTokenRepository{}
 
-	uc := NewLoginUseCase(repo, passwordHasher, tokenGen)
+	uc := NewLoginUseCase(repo, passwordHasher, NewTokenIssuer(tokenGen, refreshRepo, nil, nil, time.Hour), domainuser.VerificationPolicyNone, nil, nil, nil)
 
 	assert.NotNil(t, uc)
 	assert.Equal(t, repo, uc.userRepo)
 	assert.Equal(t, passwordHasher, uc.passwordHasher)
-	assert.Equal(t, tokenGen, uc.tokenGen)
+	assert.Equal(t, tokenGen, uc.tokenIssuer.tokenGen)
 }
 
 func TestLoginUseCase_Execute_Success(t *testing.T) {
@@ -32,8 +34,9 @@ func TestLoginUseCase_Execute_Success(t *testing.T) {
 	repo := &mockUserRepository{}
 	passwordHasher := &mockPasswordHasher{}
 	tokenGen := &mockTokenGenerator{}
+	refreshRepo := &mockRefreshTokenRepository{}
 
-	uc := NewLoginUseCase(repo, passwordHasher, tokenGen)
+	uc := NewLoginUseCase(repo, passwordHasher, NewTokenIssuer(tokenGen, refreshRepo, nil, nil, time.Hour), domainuser.VerificationPolicyNone, nil, nil, nil)
 
 	req := dto.LoginRequest{
 		Email:    "test@example.com",
@@ -53,13 +56,19 @@ func TestLoginUseCase_Execute_Success(t *testing.T) {
 
 	repo.On("GetByEmail", ctx, req.Email).Return(userEntity, nil)
 	passwordHasher.On("Verify", userEntity.Password, req.Password).Return(true)
-	tokenGen.On("Generate", userEntity.ID, userEntity.Email).Return(token, nil)
+	passwordHasher.On("NeedsRehash", userEntity.Password).Return(false)
+	tokenGen.On("Generate", domainuser.TokenClaims{UserID: userEntity.ID, Email: userEntity.Email, Role: userEntity.Role}).Return(token, nil)
+	refreshRepo.On("Create", ctx, mock.MatchedBy(func(rt *domainuser.RefreshToken) bool {
+		return rt.UserID == userEntity.ID && rt.FamilyID != "" && rt.TokenHash != ""
+	})).Return(&domainuser.RefreshToken{ID: 1}, nil)
 
 	result, err := uc.Execute(ctx, req)
 
 	assert.NoError(t, err)
 	assert.NotNil(t, result)
 	assert.Equal(t, token, result.Token)
+	assert.NotEmpty(t, result.RefreshToken)
+	assert.Equal(t, domainuser.HashToken(result.RefreshToken), refreshRepo.Calls[0].Arguments.Get(1).(*domainuser.RefreshToken).TokenHash)
 	assert.Equal(t, userEntity.ID, result.User.ID)
 	assert.Equal(t, userEntity.Name, result.User.Name)
 	assert.Equal(t, userEntity.Email, result.User.Email)
@@ -74,8 +83,9 @@ func TestLoginUseCase_Execute_UserNotFound(t *testing.T) {
 	repo := &mockUserRepository{}
 	passwordHasher := &mockPasswordHasher{}
 	tokenGen := &mockTokenGenerator{}
+	refreshRepo := &mockRefreshTokenRepository{}
 
-	uc := NewLoginUseCase(repo, passwordHasher, tokenGen)
+	uc := NewLoginUseCase(repo, passwordHasher, NewTokenIssuer(tokenGen, refreshRepo, nil, nil, time.Hour), domainuser.VerificationPolicyNone, nil, nil, nil)
 
 	req := dto.LoginRequest{
 		Email:    "test@example.com",
@@ -100,8 +110,9 @@ func TestLoginUseCase_Execute_GetByEmailError(t *testing.T) {
 	repo := &mockUserRepository{}
 	passwordHasher := &mockPasswordHasher{}
 	tokenGen := &mockTokenGenerator{}
+	refreshRepo := &mockRefreshTokenRepository{}
 
-	uc := NewLoginUseCase(repo, passwordHasher, tokenGen)
+	uc := NewLoginUseCase(repo, passwordHasher, NewTokenIssuer(tokenGen, refreshRepo, nil, nil, time.Hour), domainuser.VerificationPolicyNone, nil, nil, nil)
 
 	req := dto.LoginRequest{
 		Email:    "test@example.com",
@@ -126,8 +137,9 @@ func TestLoginUseCase_Execute_InvalidPassword(t *testing.T) {
 	repo := &mockUserRepository{}
 	passwordHasher := &mockPasswordHasher{}
 	tokenGen := &mockTokenGenerator{}
+	refreshRepo := &mockRefreshTokenRepository{}
 
-	uc := NewLoginUseCase(repo, passwordHasher, tokenGen)
+	uc := NewLoginUseCase(repo, passwordHasher, NewTokenIssuer(tokenGen, refreshRepo, nil, nil, time.Hour), domainuser.VerificationPolicyNone, nil, nil, nil)
 
 	req := dto.LoginRequest{
 		Email:    "test@example.com",
@@ -162,8 +174,9 @@ func TestLoginUseCase_Execute_TokenGenerationError(t *testing.T) {
 	repo := &mockUserRepository{}
 	passwordHasher := &mockPasswordHasher{}
 	tokenGen := &mockTokenGenerator{}
+	refreshRepo := &mockRefreshTokenRepository{}
 
-	uc := NewLoginUseCase(repo, passwordHasher, tokenGen)
+	uc := NewLoginUseCase(repo, passwordHasher, NewTokenIssuer(tokenGen, refreshRepo, nil, nil, time.Hour), domainuser.VerificationPolicyNone, nil, nil, nil)
 
 	req := dto.LoginRequest{
 		Email:    "test@example.com",
@@ -183,7 +196,7 @@ func TestLoginUseCase_Execute_TokenGenerationError(t *testing.T) {
 
 	repo.On("GetByEmail", ctx, req.Email).Return(userEntity, nil)
 	passwordHasher.On("Verify", userEntity.Password, req.Password).Return(true)
-	tokenGen.On("Generate", userEntity.ID, userEntity.Email).Return("", tokenError)
+	tokenGen.On("Generate", domainuser.TokenClaims{UserID: userEntity.ID, Email: userEntity.Email, Role: userEntity.Role}).Return("", tokenError)
 
 	result, err := uc.Execute(ctx, req)
 
@@ -196,3 +209,200 @@ func TestLoginUseCase_Execute_TokenGenerationError(t *testing.T) {
 	tokenGen.AssertExpectations(t)
 }
 
+func TestLoginUseCase_Execute_EmailNotVerified(t *testing.T) {
+	ctx := context.Background()
+	repo := &mockUserRepository{}
+	passwordHasher := &mockPasswordHasher{}
+	tokenGen := &mockTokenGenerator{}
+	refreshRepo := &mockRefreshTokenRepository{}
+
+	uc := NewLoginUseCase(repo, passwordHasher, NewTokenIssuer(tokenGen, refreshRepo, nil, nil, time.Hour), domainuser.VerificationPolicyLogin, nil, nil, nil)
+
+	req := dto.LoginRequest{
+		Email:    "test@example.com",
+		Password: "password123",
+	}
+	userEntity := &domainuser.User{ID: 1, Email: req.Email, Password: "hashed_password"}
+
+	repo.On("GetByEmail", ctx, req.Email).Return(userEntity, nil)
+	passwordHasher.On("Verify", userEntity.Password, req.Password).Return(true)
+
+	result, err := uc.Execute(ctx, req)
+
+	assert.Equal(t, domainuser.ErrEmailNotVerified, err)
+	assert.Nil(t, result)
+	tokenGen.AssertNotCalled(t, "Generate")
+}
+
+func TestLoginUseCase_Execute_Locked(t *testing.T) {
+	ctx := context.Background()
+	repo := &mockUserRepository{}
+	counter := &mockLoginAttemptCounter{}
+	throttler := NewLoginThrottler(counter, testAccountPolicy, testIPPolicy)
+
+	uc := NewLoginUseCase(repo, &mockPasswordHasher{}, nil, domainuser.VerificationPolicyNone, throttler, nil, nil)
+
+	req := dto.LoginRequest{Email: "test@example.com", Password: "password123", IPAddress: "10.0.0.1"}
+
+	counter.On("LockedFor", ctx, "account:test@example.com").Return(time.Minute, nil)
+	counter.On("LockedFor", ctx, "ip:10.0.0.1").Return(time.Duration(0), nil)
+
+	result, err := uc.Execute(ctx, req)
+
+	assert.Nil(t, result)
+	assert.True(t, errors.Is(err, domainuser.ErrTooManyLoginAttempts))
+	repo.AssertNotCalled(t, "GetByEmail")
+}
+
+func TestLoginUseCase_Execute_RecordsAttempts(t *testing.T) {
+	userEntity := &domainuser.User{ID: 1, Email: "test@example.com", Password: "hashed_password"}
+
+	tests := []struct {
+		name        string
+		password    string
+		valid       bool
+		setup       func(ctx context.Context, counter *mockLoginAttemptCounter, tokenGen *mockTokenGenerator, refreshRepo *mockRefreshTokenRepository)
+		wantSuccess bool
+	}{
+		{
+			name:     "failed password is throttled and recorded",
+			password: "wrong",
+			valid:    false,
+			setup: func(ctx context.Context, counter *mockLoginAttemptCounter, tokenGen *mockTokenGenerator, refreshRepo *mockRefreshTokenRepository) {
+				counter.On("Increment", ctx, "account:test@example.com", time.Hour).Return(1, nil)
+				counter.On("Increment", ctx, "ip:10.0.0.1", time.Hour).Return(1, nil)
+			},
+			wantSuccess: false,
+		},
+		{
+			name:     "success resets the account and is recorded",
+			password: "password123",
+			valid:    true,
+			setup: func(ctx context.Context, counter *mockLoginAttemptCounter, tokenGen *mockTokenGenerator, refreshRepo *mockRefreshTokenRepository) {
+				counter.On("Reset", ctx, "account:test@example.com").Return(nil)
+				tokenGen.On("Generate", mock.Anything).Return("jwt_token_123", nil)
+				refreshRepo.On("Create", ctx, mock.Anything).Return(&domainuser.RefreshToken{ID: 1}, nil)
+			},
+			wantSuccess: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			repo := &mockUserRepository{}
+			passwordHasher := &mockPasswordHasher{}
+			tokenGen := &mockTokenGenerator{}
+			refreshRepo := &mockRefreshTokenRepository{}
+			counter := &mockLoginAttemptCounter{}
+			attemptRepo := &mockLoginAttemptRepository{}
+
+			uc := NewLoginUseCase(repo, passwordHasher, NewTokenIssuer(tokenGen, refreshRepo, nil, nil, time.Hour), domainuser.VerificationPolicyNone,
+				NewLoginThrottler(counter, testAccountPolicy, testIPPolicy), attemptRepo, nil)
+
+			req := dto.LoginRequest{Email: userEntity.Email, Password: tt.password, IPAddress: "10.0.0.1", UserAgent: "curl/8.0"}
+
+			counter.On("LockedFor", ctx, mock.Anything).Return(time.Duration(0), nil)
+			repo.On("GetByEmail", ctx, req.Email).Return(userEntity, nil)
+			passwordHasher.On("Verify", userEntity.Password, req.Password).Return(tt.valid)
+			passwordHasher.On("NeedsRehash", userEntity.Password).Return(false).Maybe()
+			attemptRepo.On("Create", ctx, mock.MatchedBy(func(a *domainuser.LoginAttempt) bool {
+				return a.UserID == 1 && a.IPAddress == "10.0.0.1" && a.UserAgent == "curl/8.0" && a.Success == tt.wantSuccess
+			})).Return(nil)
+			tt.setup(ctx, counter, tokenGen, refreshRepo)
+
+			_, err := uc.Execute(ctx, req)
+
+			if tt.wantSuccess {
+				assert.NoError(t, err)
+			} else {
+				assert.Equal(t, domainuser.ErrInvalidCredentials, err)
+			}
+			counter.AssertExpectations(t)
+			attemptRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestLoginUseCase_Execute_RehashesPassword(t *testing.T) {
+	tests := []struct {
+		name    string
+		hashErr error
+	}{
+		{name: "legacy hash is replaced"},
+		{name: "hash error keeps the login working", hashErr: errors.New("hash error")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			repo := &mockUserRepository{}
+			passwordHasher := &mockPasswordHasher{}
+			tokenGen := &mockTokenGenerator{}
+			refreshRepo := &mockRefreshTokenRepository{}
+
+			uc := NewLoginUseCase(repo, passwordHasher, NewTokenIssuer(tokenGen, refreshRepo, nil, nil, time.Hour), domainuser.VerificationPolicyNone, nil, nil, nil)
+
+			req := dto.LoginRequest{Email: "test@example.com", Password: "password123"}
+			userEntity := &domainuser.User{ID: 1, Email: req.Email, Password: "$2a$10$legacy"}
+
+			repo.On("GetByEmail", ctx, req.Email).Return(userEntity, nil)
+			passwordHasher.On("Verify", "$2a$10$legacy", req.Password).Return(true)
+			tokenGen.On("Generate", mock.Anything).Return("jwt_token_123", nil)
+			refreshRepo.On("Create", ctx, mock.Anything).Return(&domainuser.RefreshToken{ID: 1}, nil)
+			passwordHasher.On("NeedsRehash", "$2a$10$legacy").Return(true)
+			if tt.hashErr != nil {
+				passwordHasher.On("Hash", req.Password).Return("", tt.hashErr)
+			} else {
+				passwordHasher.On("Hash", req.Password).Return("$argon2id$new", nil)
+				repo.On("Update", ctx, int64(0), mock.MatchedBy(func(u *domainuser.User) bool {
+					return u.ID == 1 && u.Password == "$argon2id$new"
+				})).Return(userEntity, nil)
+			}
+
+			result, err := uc.Execute(ctx, req)
+
+			assert.NoError(t, err)
+			assert.NotNil(t, result)
+			repo.AssertExpectations(t)
+			passwordHasher.AssertExpectations(t)
+		})
+	}
+}
+
+func TestLoginUseCase_Execute_TwoFactorChallenge(t *testing.T) {
+	ctx := context.Background()
+	repo := &mockUserRepository{}
+	passwordHasher := &mockPasswordHasher{}
+	tokenGen := &mockTokenGenerator{}
+	counter := &mockLoginAttemptCounter{}
+	twoFactors := &mockTwoFactorRepository{}
+	challenges := &mockTwoFactorChallengeStore{}
+
+	uc := NewLoginUseCase(repo, passwordHasher, NewTokenIssuer(tokenGen, &mockRefreshTokenRepository{}, nil, nil, time.Hour), domainuser.VerificationPolicyNone,
+		NewLoginThrottler(counter, testAccountPolicy, testIPPolicy), nil, NewTwoFactorChallenger(twoFactors, challenges, 5*time.Minute))
+
+	req := dto.LoginRequest{Email: "test@example.com", Password: "password123", IPAddress: "10.0.0.1"}
+	userEntity := &domainuser.User{ID: 1, Email: req.Email, Password: "hashed_password"}
+	enabledAt := time.Now()
+
+	counter.On("LockedFor", ctx, mock.Anything).Return(time.Duration(0), nil)
+	repo.On("GetByEmail", ctx, req.Email).Return(userEntity, nil)
+	passwordHasher.On("Verify", userEntity.Password, req.Password).Return(true)
+	passwordHasher.On("NeedsRehash", userEntity.Password).Return(false)
+	twoFactors.On("GetByUser", ctx, userEntity.ID).Return(&domainuser.TwoFactor{UserID: 1, Secret: "SECRET", EnabledAt: &enabledAt}, nil)
+	challenges.On("Save", ctx, mock.AnythingOfType("string"), userEntity.ID, 5*time.Minute).Return(nil)
+
+	result, err := uc.Execute(ctx, req)
+
+	assert.NoError(t, err)
+	assert.True(t, result.TwoFactorRequired)
+	assert.NotEmpty(t, result.ChallengeToken)
+	assert.Empty(t, result.Token)
+	assert.Nil(t, result.User)
+	assert.Equal(t, domainuser.HashToken(result.ChallengeToken), challenges.Calls[0].Arguments.Get(1))
+	tokenGen.AssertNotCalled(t, "Generate")
+	// The lockout is not reset until the second factor is verified
+	counter.AssertNotCalled(t, "Reset", mock.Anything, mock.Anything)
+}
+
@@ -0,0 +1,111 @@
+package usecase
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	domainuser "github.com/rulzi/hexa-go/internal/domain/user"
+)
+
+// LoginThrottler locks accounts and IP addresses after repeated failed logins.
+// Counter errors never block a login, so an unavailable store only disables throttling.
+type LoginThrottler struct {
+	counter       domainuser.LoginAttemptCounter
+	accountPolicy domainuser.LockoutPolicy
+	ipPolicy      domainuser.LockoutPolicy
+}
+
+// NewLoginThrottler creates a new LoginThrottler
+func NewLoginThrottler(
+	counter domainuser.LoginAttemptCounter,
+	accountPolicy domainuser.LockoutPolicy,
+	ipPolicy domainuser.LockoutPolicy,
+) *LoginThrottler {
+	return &LoginThrottler{
+		counter:       counter,
+		accountPolicy: accountPolicy,
+		ipPolicy:      ipPolicy,
+	}
+}
+
+func accountThrottleKey(email string) string {
+	return "account:" + strings.ToLower(email)
+}
+
+func ipThrottleKey(ip string) string {
+	return "ip:" + ip
+}
+
+func twoFactorThrottleKey(userID int64) string {
+	return "two_factor:" + strconv.FormatInt(userID, 10)
+}
+
+// Check returns a LoginLockedError when the account or the IP address is locked
+func (t *LoginThrottler) Check(ctx context.Context, email, ip string) error {
+	keys := []string{accountThrottleKey(email)}
+	if ip != "" {
+		keys = append(keys, ipThrottleKey(ip))
+	}
+
+	return t.check(ctx, keys)
+}
+
+// CheckTwoFactor returns a LoginLockedError when two-factor code checks of a signed-in user are locked
+func (t *LoginThrottler) CheckTwoFactor(ctx context.Context, userID int64) error {
+	return t.check(ctx, []string{twoFactorThrottleKey(userID)})
+}
+
+func (t *LoginThrottler) check(ctx context.Context, keys []string) error {
+	// Report the longest remaining lock
+	var locked *domainuser.LoginLockedError
+	for _, key := range keys {
+		remaining, err := t.counter.LockedFor(ctx, key)
+		if err != nil || remaining <= 0 {
+			continue
+		}
+		if locked == nil || remaining > locked.RetryAfter {
+			locked = &domainuser.LoginLockedError{RetryAfter: remaining}
+		}
+	}
+
+	if locked != nil {
+		return locked
+	}
+	return nil
+}
+
+// Failed counts a failed login for the account and the IP address and locks them when due
+func (t *LoginThrottler) Failed(ctx context.Context, email, ip string) {
+	t.fail(ctx, accountThrottleKey(email), t.accountPolicy)
+	if ip != "" {
+		t.fail(ctx, ipThrottleKey(ip), t.ipPolicy)
+	}
+}
+
+// TwoFactorFailed counts a wrong two-factor code of a signed-in user and locks the checks when due
+func (t *LoginThrottler) TwoFactorFailed(ctx context.Context, userID int64) {
+	t.fail(ctx, twoFactorThrottleKey(userID), t.accountPolicy)
+}
+
+func (t *LoginThrottler) fail(ctx context.Context, key string, policy domainuser.LockoutPolicy) {
+	failures, err := t.counter.Increment(ctx, key, policy.Window)
+	if err != nil {
+		return
+	}
+
+	if lockout := policy.LockoutFor(failures); lockout > 0 {
+		_ = t.counter.Lock(ctx, key, lockout)
+	}
+}
+
+// Succeeded forgets the failures of the account.
+// Failures of the IP address are kept, so one valid account cannot unlock guessing on others.
+func (t *LoginThrottler) Succeeded(ctx context.Context, email string) {
+	_ = t.counter.Reset(ctx, accountThrottleKey(email))
+}
+
+// TwoFactorSucceeded forgets the wrong two-factor codes of a signed-in user
+func (t *LoginThrottler) TwoFactorSucceeded(ctx context.Context, userID int64) {
+	_ = t.counter.Reset(ctx, twoFactorThrottleKey(userID))
+}
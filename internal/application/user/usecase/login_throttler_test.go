@@ -0,0 +1,79 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	domainuser "github.com/rulzi/hexa-go/internal/domain/user"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	testAccountPolicy = domainuser.LockoutPolicy{MaxAttempts: 3, BaseLockout: time.Minute, MaxLockout: time.Hour, Window: time.Hour}
+	testIPPolicy      = domainuser.LockoutPolicy{MaxAttempts: 10, BaseLockout: time.Minute, MaxLockout: time.Hour, Window: time.Hour}
+)
+
+func TestLoginThrottler_Check(t *testing.T) {
+	tests := []struct {
+		name       string
+		accountFor time.Duration
+		ipFor      time.Duration
+		ipErr      error
+		wantRetry  time.Duration
+	}{
+		{name: "not locked"},
+		{name: "account locked", accountFor: 2 * time.Minute, wantRetry: 2 * time.Minute},
+		{name: "longest lock wins", accountFor: 2 * time.Minute, ipFor: 5 * time.Minute, wantRetry: 5 * time.Minute},
+		{name: "counter error does not block", ipErr: errors.New("redis down")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			counter := &mockLoginAttemptCounter{}
+			counter.On("LockedFor", ctx, "account:test@example.com").Return(tt.accountFor, nil)
+			counter.On("LockedFor", ctx, "ip:10.0.0.1").Return(tt.ipFor, tt.ipErr)
+
+			throttler := NewLoginThrottler(counter, testAccountPolicy, testIPPolicy)
+			err := throttler.Check(ctx, "Test@Example.com", "10.0.0.1")
+
+			if tt.wantRetry == 0 {
+				assert.NoError(t, err)
+				return
+			}
+			var locked *domainuser.LoginLockedError
+			assert.True(t, errors.As(err, &locked))
+			assert.Equal(t, tt.wantRetry, locked.RetryAfter)
+			assert.True(t, errors.Is(err, domainuser.ErrTooManyLoginAttempts))
+		})
+	}
+}
+
+func TestLoginThrottler_Failed(t *testing.T) {
+	ctx := context.Background()
+	counter := &mockLoginAttemptCounter{}
+	counter.On("Increment", ctx, "account:test@example.com", time.Hour).Return(4, nil)
+	counter.On("Increment", ctx, "ip:10.0.0.1", time.Hour).Return(4, nil)
+	// Only the account is past its limit: 4 failures with a limit of 3 doubles the base lockout
+	counter.On("Lock", ctx, "account:test@example.com", 2*time.Minute).Return(nil)
+
+	throttler := NewLoginThrottler(counter, testAccountPolicy, testIPPolicy)
+	throttler.Failed(ctx, "test@example.com", "10.0.0.1")
+
+	counter.AssertExpectations(t)
+	counter.AssertNumberOfCalls(t, "Lock", 1)
+}
+
+func TestLoginThrottler_Succeeded(t *testing.T) {
+	ctx := context.Background()
+	counter := &mockLoginAttemptCounter{}
+	counter.On("Reset", ctx, "account:test@example.com").Return(nil)
+
+	throttler := NewLoginThrottler(counter, testAccountPolicy, testIPPolicy)
+	throttler.Succeeded(ctx, "test@example.com")
+
+	counter.AssertExpectations(t)
+	counter.AssertNotCalled(t, "Reset", ctx, "ip:10.0.0.1")
+}
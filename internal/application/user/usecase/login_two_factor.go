@@ -0,0 +1,91 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/rulzi/hexa-go/internal/application/user/dto"
+	domainuser "github.com/rulzi/hexa-go/internal/domain/user"
+)
+
+// LoginTwoFactorUseCase handles completing a login with a two-factor code
+type LoginTwoFactorUseCase struct {
+	userRepo    domainuser.Repository
+	twoFactors  domainuser.TwoFactorRepository
+	challenges  domainuser.TwoFactorChallengeStore
+	totp        domainuser.TOTPAuthenticator
+	tokenIssuer *TokenIssuer
+	throttler   *LoginThrottler
+	attempts    domainuser.LoginAttemptRepository
+}
+
+// NewLoginTwoFactorUseCase creates a new LoginTwoFactorUseCase
+func NewLoginTwoFactorUseCase(
+	userRepo domainuser.Repository,
+	twoFactors domainuser.TwoFactorRepository,
+	challenges domainuser.TwoFactorChallengeStore,
+	totp domainuser.TOTPAuthenticator,
+	tokenIssuer *TokenIssuer,
+	throttler *LoginThrottler,
+	attempts domainuser.LoginAttemptRepository,
+) *LoginTwoFactorUseCase {
+	return &LoginTwoFactorUseCase{
+		userRepo:    userRepo,
+		twoFactors:  twoFactors,
+		challenges:  challenges,
+		totp:        totp,
+		tokenIssuer: tokenIssuer,
+		throttler:   throttler,
+		attempts:    attempts,
+	}
+}
+
+// Execute executes the login two-factor use case
+func (uc *LoginTwoFactorUseCase) Execute(ctx context.Context, req dto.LoginTwoFactorRequest) (*dto.LoginResponse, error) {
+	// A challenge is redeemed once, a wrong code ends it too and the login starts over
+	userID, err := uc.challenges.Take(ctx, domainuser.HashToken(req.ChallengeToken))
+	if err != nil {
+		return nil, domainuser.ErrInvalidTwoFactorChallenge
+	}
+
+	userEntity, err := uc.userRepo.GetByID(ctx, 0, userID)
+	if err != nil {
+		return nil, domainuser.ErrInvalidTwoFactorChallenge
+	}
+
+	// Wrong codes count towards the same lockout as wrong passwords
+	if uc.throttler != nil {
+		if err := uc.throttler.Check(ctx, userEntity.Email, req.IPAddress); err != nil {
+			return nil, err
+		}
+	}
+
+	twoFactor, err := enabledTwoFactor(ctx, uc.twoFactors, userID)
+	if err != nil {
+		return nil, domainuser.ErrInvalidTwoFactorChallenge
+	}
+
+	if err := checkTwoFactorCode(ctx, uc.twoFactors, uc.totp, twoFactor, req.Code, true); err != nil {
+		if err != domainuser.ErrInvalidTwoFactorCode {
+			return nil, err
+		}
+
+		if uc.throttler != nil {
+			uc.throttler.Failed(ctx, userEntity.Email, req.IPAddress)
+		}
+		recordLoginAttempt(ctx, uc.attempts, userEntity.Email, req.IPAddress, req.UserAgent, userID, false)
+		return nil, err
+	}
+
+	if uc.throttler != nil {
+		uc.throttler.Succeeded(ctx, userEntity.Email)
+	}
+
+	tokens, err := uc.tokenIssuer.StartSession(ctx, userEntity, req.UserAgent, req.IPAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	recordLoginAttempt(ctx, uc.attempts, userEntity.Email, req.IPAddress, req.UserAgent, userID, true)
+
+	return newLoginResponse(tokens, userEntity), nil
+}
@@ -0,0 +1,157 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/rulzi/hexa-go/internal/application/user/dto"
+	domainuser "github.com/rulzi/hexa-go/internal/domain/user"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestLoginTwoFactorUseCase_Execute(t *testing.T) {
+	enabledAt := time.Now()
+	userEntity := &domainuser.User{ID: 1, Name: "Test User", Email: "test@example.com"}
+	twoFactor := &domainuser.TwoFactor{UserID: 1, Secret: "SECRET", EnabledAt: &enabledAt}
+	challengeHash := domainuser.HashToken("challenge")
+
+	tests := []struct {
+		name    string
+		code    string
+		setup   func(ctx context.Context, challenges *mockTwoFactorChallengeStore, twoFactors *mockTwoFactorRepository, totp *mockTOTPAuthenticator, counter *mockLoginAttemptCounter)
+		wantErr error
+	}{
+		{
+			name: "valid one-time password",
+			code: "123456",
+			setup: func(ctx context.Context, challenges *mockTwoFactorChallengeStore, twoFactors *mockTwoFactorRepository, totp *mockTOTPAuthenticator, counter *mockLoginAttemptCounter) {
+				challenges.On("Take", ctx, challengeHash).Return(int64(1), nil)
+				twoFactors.On("GetByUser", ctx, int64(1)).Return(twoFactor, nil)
+				totp.On("Validate", "SECRET", "123456", mock.Anything).Return(int64(100), true)
+				twoFactors.On("UseTimeStep", ctx, int64(1), int64(100)).Return(nil)
+				counter.On("Reset", ctx, "account:test@example.com").Return(nil)
+			},
+		},
+		{
+			name: "valid recovery code",
+			code: "ABCDE-12345",
+			setup: func(ctx context.Context, challenges *mockTwoFactorChallengeStore, twoFactors *mockTwoFactorRepository, totp *mockTOTPAuthenticator, counter *mockLoginAttemptCounter) {
+				challenges.On("Take", ctx, challengeHash).Return(int64(1), nil)
+				twoFactors.On("GetByUser", ctx, int64(1)).Return(twoFactor, nil)
+				totp.On("Validate", "SECRET", "ABCDE-12345", mock.Anything).Return(int64(0), false)
+				twoFactors.On("UseRecoveryCode", ctx, int64(1), domainuser.HashToken("abcde12345")).Return(nil)
+				counter.On("Reset", ctx, "account:test@example.com").Return(nil)
+			},
+		},
+		{
+			name: "invalid code counts as a failed login",
+			code: "000000",
+			setup: func(ctx context.Context, challenges *mockTwoFactorChallengeStore, twoFactors *mockTwoFactorRepository, totp *mockTOTPAuthenticator, counter *mockLoginAttemptCounter) {
+				challenges.On("Take", ctx, challengeHash).Return(int64(1), nil)
+				twoFactors.On("GetByUser", ctx, int64(1)).Return(twoFactor, nil)
+				totp.On("Validate", "SECRET", "000000", mock.Anything).Return(int64(0), false)
+				twoFactors.On("UseRecoveryCode", ctx, int64(1), mock.Anything).Return(domainuser.ErrInvalidTwoFactorCode)
+				counter.On("Increment", ctx, "account:test@example.com", time.Hour).Return(1, nil)
+				counter.On("Increment", ctx, "ip:10.0.0.1", time.Hour).Return(1, nil)
+			},
+			wantErr: domainuser.ErrInvalidTwoFactorCode,
+		},
+		{
+			name: "replayed one-time password counts as a failed login",
+			code: "123456",
+			setup: func(ctx context.Context, challenges *mockTwoFactorChallengeStore, twoFactors *mockTwoFactorRepository, totp *mockTOTPAuthenticator, counter *mockLoginAttemptCounter) {
+				challenges.On("Take", ctx, challengeHash).Return(int64(1), nil)
+				twoFactors.On("GetByUser", ctx, int64(1)).Return(&domainuser.TwoFactor{UserID: 1, Secret: "SECRET", EnabledAt: &enabledAt, LastUsedStep: 100}, nil)
+				totp.On("Validate", "SECRET", "123456", mock.Anything).Return(int64(100), true)
+				counter.On("Increment", ctx, "account:test@example.com", time.Hour).Return(1, nil)
+				counter.On("Increment", ctx, "ip:10.0.0.1", time.Hour).Return(1, nil)
+			},
+			wantErr: domainuser.ErrInvalidTwoFactorCode,
+		},
+		{
+			name: "one-time password accepted concurrently",
+			code: "123456",
+			setup: func(ctx context.Context, challenges *mockTwoFactorChallengeStore, twoFactors *mockTwoFactorRepository, totp *mockTOTPAuthenticator, counter *mockLoginAttemptCounter) {
+				challenges.On("Take", ctx, challengeHash).Return(int64(1), nil)
+				twoFactors.On("GetByUser", ctx, int64(1)).Return(twoFactor, nil)
+				totp.On("Validate", "SECRET", "123456", mock.Anything).Return(int64(100), true)
+				twoFactors.On("UseTimeStep", ctx, int64(1), int64(100)).Return(domainuser.ErrInvalidTwoFactorCode)
+				counter.On("Increment", ctx, "account:test@example.com", time.Hour).Return(1, nil)
+				counter.On("Increment", ctx, "ip:10.0.0.1", time.Hour).Return(1, nil)
+			},
+			wantErr: domainuser.ErrInvalidTwoFactorCode,
+		},
+		{
+			name: "unknown challenge",
+			code: "123456",
+			setup: func(ctx context.Context, challenges *mockTwoFactorChallengeStore, twoFactors *mockTwoFactorRepository, totp *mockTOTPAuthenticator, counter *mockLoginAttemptCounter) {
+				challenges.On("Take", ctx, challengeHash).Return(int64(0), domainuser.ErrInvalidTwoFactorChallenge)
+			},
+			wantErr: domainuser.ErrInvalidTwoFactorChallenge,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			repo := &mockUserRepository{}
+			twoFactors := &mockTwoFactorRepository{}
+			challenges := &mockTwoFactorChallengeStore{}
+			totp := &mockTOTPAuthenticator{}
+			counter := &mockLoginAttemptCounter{}
+			tokenGen := &mockTokenGenerator{}
+			refreshRepo := &mockRefreshTokenRepository{}
+			attemptRepo := &mockLoginAttemptRepository{}
+
+			uc := NewLoginTwoFactorUseCase(repo, twoFactors, challenges, totp, NewTokenIssuer(tokenGen, refreshRepo, nil, nil, time.Hour),
+				NewLoginThrottler(counter, testAccountPolicy, testIPPolicy), attemptRepo)
+
+			repo.On("GetByID", ctx, int64(0), int64(1)).Return(userEntity, nil)
+			counter.On("LockedFor", ctx, mock.Anything).Return(time.Duration(0), nil).Maybe()
+			tokenGen.On("Generate", mock.Anything).Return("jwt_token_123", nil)
+			refreshRepo.On("Create", ctx, mock.Anything).Return(&domainuser.RefreshToken{ID: 1}, nil)
+			attemptRepo.On("Create", ctx, mock.Anything).Return(nil)
+			tt.setup(ctx, challenges, twoFactors, totp, counter)
+
+			result, err := uc.Execute(ctx, dto.LoginTwoFactorRequest{ChallengeToken: "challenge", Code: tt.code, IPAddress: "10.0.0.1"})
+
+			if tt.wantErr != nil {
+				assert.Equal(t, tt.wantErr, err)
+				assert.Nil(t, result)
+				tokenGen.AssertNotCalled(t, "Generate", mock.Anything)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, "jwt_token_123", result.Token)
+				assert.Equal(t, userEntity.ID, result.User.ID)
+			}
+			challenges.AssertExpectations(t)
+			twoFactors.AssertExpectations(t)
+			counter.AssertExpectations(t)
+		})
+	}
+}
+
+func TestLoginTwoFactorUseCase_Execute_Locked(t *testing.T) {
+	ctx := context.Background()
+	repo := &mockUserRepository{}
+	twoFactors := &mockTwoFactorRepository{}
+	challenges := &mockTwoFactorChallengeStore{}
+	counter := &mockLoginAttemptCounter{}
+
+	uc := NewLoginTwoFactorUseCase(repo, twoFactors, challenges, &mockTOTPAuthenticator{}, nil,
+		NewLoginThrottler(counter, testAccountPolicy, testIPPolicy), nil)
+
+	challenges.On("Take", ctx, domainuser.HashToken("challenge")).Return(int64(1), nil)
+	repo.On("GetByID", ctx, int64(0), int64(1)).Return(&domainuser.User{ID: 1, Email: "test@example.com"}, nil)
+	counter.On("LockedFor", ctx, "account:test@example.com").Return(time.Minute, nil)
+	counter.On("LockedFor", ctx, "ip:10.0.0.1").Return(time.Duration(0), nil)
+
+	result, err := uc.Execute(ctx, dto.LoginTwoFactorRequest{ChallengeToken: "challenge", Code: "123456", IPAddress: "10.0.0.1"})
+
+	assert.Nil(t, result)
+	assert.True(t, errors.Is(err, domainuser.ErrTooManyLoginAttempts))
+	twoFactors.AssertNotCalled(t, "GetByUser", mock.Anything, mock.Anything)
+}
@@ -0,0 +1,62 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/rulzi/hexa-go/internal/application/user/dto"
+	domainuser "github.com/rulzi/hexa-go/internal/domain/user"
+)
+
+// LogoutUseCase handles revoking the tokens of the current login
+type LogoutUseCase struct {
+	refreshTokens domainuser.RefreshTokenRepository
+	revocations   domainuser.TokenRevocationStore
+	sessions      domainuser.SessionRepository
+}
+
+// NewLogoutUseCase creates a new LogoutUseCase, sessions is optional
+func NewLogoutUseCase(
+	refreshTokens domainuser.RefreshTokenRepository,
+	revocations domainuser.TokenRevocationStore,
+	sessions domainuser.SessionRepository,
+) *LogoutUseCase {
+	return &LogoutUseCase{
+		refreshTokens: refreshTokens,
+		revocations:   revocations,
+		sessions:      sessions,
+	}
+}
+
+// Execute executes the logout use case
+func (uc *LogoutUseCase) Execute(ctx context.Context, userID int64, tokenID string, tokenExpiresAt time.Time, req dto.LogoutRequest) error {
+	// Revoke the access token used for this request
+	if tokenID != "" {
+		if err := uc.revocations.Revoke(ctx, tokenID, tokenExpiresAt); err != nil {
+			return err
+		}
+	}
+
+	if req.RefreshToken == "" {
+		return nil
+	}
+
+	// Revoke the refresh token family, ignoring tokens of other users
+	stored, err := uc.refreshTokens.GetByHash(ctx, domainuser.HashToken(req.RefreshToken))
+	if err != nil || stored == nil || stored.UserID != userID {
+		return domainuser.ErrInvalidRefreshToken
+	}
+
+	if err := uc.refreshTokens.RevokeFamily(ctx, stored.FamilyID); err != nil {
+		return err
+	}
+
+	// The family is the session of the login, logins from before sessions were recorded have none
+	if uc.sessions != nil {
+		if err := uc.sessions.Revoke(ctx, stored.FamilyID); err != nil && err != domainuser.ErrSessionNotFound {
+			return err
+		}
+	}
+
+	return nil
+}
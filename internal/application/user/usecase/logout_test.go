@@ -0,0 +1,119 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/rulzi/hexa-go/internal/application/user/dto"
+	domainuser "github.com/rulzi/hexa-go/internal/domain/user"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestNewLogoutUseCase(t *testing.T) {
+	refreshRepo := &mockRefreshTokenRepository{}
+	revocations := &mockTokenRevocationStore{}
+
+	uc := NewLogoutUseCase(refreshRepo, revocations, nil)
+
+	assert.NotNil(t, uc)
+	assert.Equal(t, refreshRepo, uc.refreshTokens)
+	assert.Equal(t, revocations, uc.revocations)
+	assert.Nil(t, uc.sessions)
+}
+
+func TestLogoutUseCase_Execute_AccessTokenOnly(t *testing.T) {
+	ctx := context.Background()
+	refreshRepo := &mockRefreshTokenRepository{}
+	revocations := &mockTokenRevocationStore{}
+	uc := NewLogoutUseCase(refreshRepo, revocations, nil)
+
+	expiresAt := time.Now().Add(15 * time.Minute)
+	revocations.On("Revoke", ctx, "jti-1", expiresAt).Return(nil)
+
+	err := uc.Execute(ctx, 1, "jti-1", expiresAt, dto.LogoutRequest{})
+
+	assert.NoError(t, err)
+	revocations.AssertExpectations(t)
+	refreshRepo.AssertNotCalled(t, "GetByHash", mock.Anything, mock.Anything)
+}
+
+func TestLogoutUseCase_Execute_WithRefreshToken(t *testing.T) {
+	ctx := context.Background()
+	refreshRepo := &mockRefreshTokenRepository{}
+	revocations := &mockTokenRevocationStore{}
+	uc := NewLogoutUseCase(refreshRepo, revocations, nil)
+
+	expiresAt := time.Now().Add(15 * time.Minute)
+	req := dto.LogoutRequest{RefreshToken: "refresh_token"}
+	stored := &domainuser.RefreshToken{ID: 10, UserID: 1, FamilyID: "family-1"}
+
+	revocations.On("Revoke", ctx, "jti-1", expiresAt).Return(nil)
+	refreshRepo.On("GetByHash", ctx, domainuser.HashToken(req.RefreshToken)).Return(stored, nil)
+	refreshRepo.On("RevokeFamily", ctx, "family-1").Return(nil)
+
+	err := uc.Execute(ctx, 1, "jti-1", expiresAt, req)
+
+	assert.NoError(t, err)
+	revocations.AssertExpectations(t)
+	refreshRepo.AssertExpectations(t)
+}
+
+func TestLogoutUseCase_Execute_EndsSession(t *testing.T) {
+	ctx := context.Background()
+	refreshRepo := &mockRefreshTokenRepository{}
+	revocations := &mockTokenRevocationStore{}
+	sessions := &mockSessionRepository{}
+	uc := NewLogoutUseCase(refreshRepo, revocations, sessions)
+
+	expiresAt := time.Now().Add(15 * time.Minute)
+	req := dto.LogoutRequest{RefreshToken: "refresh_token"}
+	stored := &domainuser.RefreshToken{ID: 10, UserID: 1, FamilyID: "family-1"}
+
+	revocations.On("Revoke", ctx, "jti-1", expiresAt).Return(nil)
+	refreshRepo.On("GetByHash", ctx, domainuser.HashToken(req.RefreshToken)).Return(stored, nil)
+	refreshRepo.On("RevokeFamily", ctx, "family-1").Return(nil)
+	sessions.On("Revoke", ctx, "family-1").Return(nil)
+
+	err := uc.Execute(ctx, 1, "jti-1", expiresAt, req)
+
+	assert.NoError(t, err)
+	sessions.AssertExpectations(t)
+}
+
+func TestLogoutUseCase_Execute_RefreshTokenOfAnotherUser(t *testing.T) {
+	ctx := context.Background()
+	refreshRepo := &mockRefreshTokenRepository{}
+	revocations := &mockTokenRevocationStore{}
+	uc := NewLogoutUseCase(refreshRepo, revocations, nil)
+
+	expiresAt := time.Now().Add(15 * time.Minute)
+	req := dto.LogoutRequest{RefreshToken: "refresh_token"}
+	stored := &domainuser.RefreshToken{ID: 10, UserID: 2, FamilyID: "family-2"}
+
+	revocations.On("Revoke", ctx, "jti-1", expiresAt).Return(nil)
+	refreshRepo.On("GetByHash", ctx, domainuser.HashToken(req.RefreshToken)).Return(stored, nil)
+
+	err := uc.Execute(ctx, 1, "jti-1", expiresAt, req)
+
+	assert.Equal(t, domainuser.ErrInvalidRefreshToken, err)
+	refreshRepo.AssertNotCalled(t, "RevokeFamily", mock.Anything, mock.Anything)
+}
+
+func TestLogoutUseCase_Execute_RevocationError(t *testing.T) {
+	ctx := context.Background()
+	refreshRepo := &mockRefreshTokenRepository{}
+	revocations := &mockTokenRevocationStore{}
+	uc := NewLogoutUseCase(refreshRepo, revocations, nil)
+
+	expiresAt := time.Now().Add(15 * time.Minute)
+	storeErr := errors.New("redis error")
+	revocations.On("Revoke", ctx, "jti-1", expiresAt).Return(storeErr)
+
+	err := uc.Execute(ctx, 1, "jti-1", expiresAt, dto.LogoutRequest{RefreshToken: "refresh_token"})
+
+	assert.Equal(t, storeErr, err)
+	refreshRepo.AssertNotCalled(t, "GetByHash", mock.Anything, mock.Anything)
+}
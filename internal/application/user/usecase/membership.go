@@ -0,0 +1,22 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	domainuser "github.com/rulzi/hexa-go/internal/domain/user"
+)
+
+// memberOf returns the membership of a user in an organization.
+// Users of other organizations are reported as not found, so an admin cannot reach them by ID.
+func memberOf(ctx context.Context, organizations domainuser.OrganizationRepository, orgID, userID int64) (*domainuser.Membership, error) {
+	membership, err := organizations.GetMembership(ctx, orgID, userID)
+	if errors.Is(err, domainuser.ErrNotMember) {
+		return nil, domainuser.ErrUserNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return membership, nil
+}
@@ -2,7 +2,9 @@ package usecase
 
 import (
 	"context"
+	"time"
 
+	"github.com/rulzi/hexa-go/internal/application/user/dto"
 	domainuser "github.com/rulzi/hexa-go/internal/domain/user"
 	"github.com/stretchr/testify/mock"
 )
@@ -20,8 +22,8 @@ func (m *mockUserRepository) Create(ctx context.Context, user *domainuser.User)
 	return args.Get(0).(*domainuser.User), args.Error(1)
 }
 
-func (m *mockUserRepository) GetByID(ctx context.Context, id int64) (*domainuser.User, error) {
-	args := m.Called(ctx, id)
+func (m *mockUserRepository) GetByID(ctx context.Context, orgID, id int64) (*domainuser.User, error) {
+	args := m.Called(ctx, orgID, id)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
@@ -36,29 +38,29 @@ func (m *mockUserRepository) GetByEmail(ctx context.Context, email string) (*dom
 	return args.Get(0).(*domainuser.User), args.Error(1)
 }
 
-func (m *mockUserRepository) Update(ctx context.Context, user *domainuser.User) (*domainuser.User, error) {
-	args := m.Called(ctx, user)
+func (m *mockUserRepository) Update(ctx context.Context, orgID int64, user *domainuser.User) (*domainuser.User, error) {
+	args := m.Called(ctx, orgID, user)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
 	return args.Get(0).(*domainuser.User), args.Error(1)
 }
 
-func (m *mockUserRepository) Delete(ctx context.Context, id int64) error {
-	args := m.Called(ctx, id)
+func (m *mockUserRepository) Delete(ctx context.Context, orgID, id int64) error {
+	args := m.Called(ctx, orgID, id)
 	return args.Error(0)
 }
 
-func (m *mockUserRepository) List(ctx context.Context, limit, offset int) ([]*domainuser.User, error) {
-	args := m.Called(ctx, limit, offset)
+func (m *mockUserRepository) List(ctx context.Context, orgID int64, limit, offset int) ([]*domainuser.User, error) {
+	args := m.Called(ctx, orgID, limit, offset)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
 	return args.Get(0).([]*domainuser.User), args.Error(1)
 }
 
-func (m *mockUserRepository) Count(ctx context.Context) (int64, error) {
-	args := m.Called(ctx)
+func (m *mockUserRepository) Count(ctx context.Context, orgID int64) (int64, error) {
+	args := m.Called(ctx, orgID)
 	return args.Get(0).(int64), args.Error(1)
 }
 
@@ -77,6 +79,11 @@ func (m *mockPasswordHasher) Verify(hashedPassword, password string) bool {
 	return args.Bool(0)
 }
 
+func (m *mockPasswordHasher) NeedsRehash(hashedPassword string) bool {
+	args := m.Called(hashedPassword)
+	return args.Bool(0)
+}
+
 // mockNotificationService is a mock implementation of NotificationService
 type mockNotificationService struct {
 	mock.Mock
@@ -87,13 +94,589 @@ func (m *mockNotificationService) SendWelcomeEmail(ctx context.Context, email, n
 	return args.Error(0)
 }
 
+func (m *mockNotificationService) SendPasswordResetEmail(ctx context.Context, email, name, token string) error {
+	args := m.Called(ctx, email, name, token)
+	return args.Error(0)
+}
+
+func (m *mockNotificationService) SendVerificationEmail(ctx context.Context, email, name, token string) error {
+	args := m.Called(ctx, email, name, token)
+	return args.Error(0)
+}
+
+func (m *mockNotificationService) SendInvitationEmail(ctx context.Context, email, token string, expiresAt time.Time) error {
+	args := m.Called(ctx, email, token, expiresAt)
+	return args.Error(0)
+}
+
 // mockTokenGenerator is a mock implementation of TokenGenerator
 type mockTokenGenerator struct {
 	mock.Mock
 }
 
-func (m *mockTokenGenerator) Generate(userID int64, email string) (string, error) {
-	args := m.Called(userID, email)
+func (m *mockTokenGenerator) Generate(claims domainuser.TokenClaims) (string, error) {
+	args := m.Called(claims)
 	return args.String(0), args.Error(1)
 }
 
+
+// mockRefreshTokenRepository is a mock implementation of RefreshTokenRepository
+type mockRefreshTokenRepository struct {
+	mock.Mock
+}
+
+func (m *mockRefreshTokenRepository) Create(ctx context.Context, token *domainuser.RefreshToken) (*domainuser.RefreshToken, error) {
+	args := m.Called(ctx, token)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domainuser.RefreshToken), args.Error(1)
+}
+
+func (m *mockRefreshTokenRepository) GetByHash(ctx context.Context, tokenHash string) (*domainuser.RefreshToken, error) {
+	args := m.Called(ctx, tokenHash)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domainuser.RefreshToken), args.Error(1)
+}
+
+func (m *mockRefreshTokenRepository) Revoke(ctx context.Context, id int64) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *mockRefreshTokenRepository) RevokeFamily(ctx context.Context, familyID string) error {
+	args := m.Called(ctx, familyID)
+	return args.Error(0)
+}
+
+func (m *mockRefreshTokenRepository) RevokeByUser(ctx context.Context, userID int64) error {
+	args := m.Called(ctx, userID)
+	return args.Error(0)
+}
+
+// mockPasswordResetRepository is a mock implementation of PasswordResetRepository
+type mockPasswordResetRepository struct {
+	mock.Mock
+}
+
+func (m *mockPasswordResetRepository) Create(ctx context.Context, token *domainuser.PasswordResetToken) (*domainuser.PasswordResetToken, error) {
+	args := m.Called(ctx, token)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domainuser.PasswordResetToken), args.Error(1)
+}
+
+func (m *mockPasswordResetRepository) GetByHash(ctx context.Context, tokenHash string) (*domainuser.PasswordResetToken, error) {
+	args := m.Called(ctx, tokenHash)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domainuser.PasswordResetToken), args.Error(1)
+}
+
+func (m *mockPasswordResetRepository) MarkUsed(ctx context.Context, id int64) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *mockPasswordResetRepository) InvalidateByUser(ctx context.Context, userID int64) error {
+	args := m.Called(ctx, userID)
+	return args.Error(0)
+}
+
+// mockTokenRevocationStore is a mock implementation of TokenRevocationStore
+type mockTokenRevocationStore struct {
+	mock.Mock
+}
+
+func (m *mockTokenRevocationStore) Revoke(ctx context.Context, tokenID string, expiresAt time.Time) error {
+	args := m.Called(ctx, tokenID, expiresAt)
+	return args.Error(0)
+}
+
+func (m *mockTokenRevocationStore) IsRevoked(ctx context.Context, tokenID string) (bool, error) {
+	args := m.Called(ctx, tokenID)
+	return args.Bool(0), args.Error(1)
+}
+
+// mockEmailVerificationSigner is a mock implementation of EmailVerificationSigner
+type mockEmailVerificationSigner struct {
+	mock.Mock
+}
+
+func (m *mockEmailVerificationSigner) Sign(claims domainuser.EmailVerificationClaims) (string, error) {
+	args := m.Called(claims)
+	return args.String(0), args.Error(1)
+}
+
+func (m *mockEmailVerificationSigner) Verify(token string) (*domainuser.EmailVerificationClaims, error) {
+	args := m.Called(token)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domainuser.EmailVerificationClaims), args.Error(1)
+}
+
+// mockLoginAttemptCounter is a mock implementation of LoginAttemptCounter
+type mockLoginAttemptCounter struct {
+	mock.Mock
+}
+
+func (m *mockLoginAttemptCounter) Increment(ctx context.Context, key string, window time.Duration) (int, error) {
+	args := m.Called(ctx, key, window)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *mockLoginAttemptCounter) Lock(ctx context.Context, key string, duration time.Duration) error {
+	args := m.Called(ctx, key, duration)
+	return args.Error(0)
+}
+
+func (m *mockLoginAttemptCounter) LockedFor(ctx context.Context, key string) (time.Duration, error) {
+	args := m.Called(ctx, key)
+	return args.Get(0).(time.Duration), args.Error(1)
+}
+
+func (m *mockLoginAttemptCounter) Reset(ctx context.Context, key string) error {
+	args := m.Called(ctx, key)
+	return args.Error(0)
+}
+
+// mockLoginAttemptRepository is a mock implementation of LoginAttemptRepository
+type mockLoginAttemptRepository struct {
+	mock.Mock
+}
+
+func (m *mockLoginAttemptRepository) Create(ctx context.Context, attempt *domainuser.LoginAttempt) error {
+	args := m.Called(ctx, attempt)
+	return args.Error(0)
+}
+
+func (m *mockLoginAttemptRepository) ListByUser(ctx context.Context, userID int64, limit int) ([]*domainuser.LoginAttempt, error) {
+	args := m.Called(ctx, userID, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domainuser.LoginAttempt), args.Error(1)
+}
+
+func (m *mockLoginAttemptRepository) DeleteByUser(ctx context.Context, userID int64) error {
+	args := m.Called(ctx, userID)
+	return args.Error(0)
+}
+
+// mockTwoFactorRepository is a mock implementation of TwoFactorRepository
+type mockTwoFactorRepository struct {
+	mock.Mock
+}
+
+func (m *mockTwoFactorRepository) GetByUser(ctx context.Context, userID int64) (*domainuser.TwoFactor, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domainuser.TwoFactor), args.Error(1)
+}
+
+func (m *mockTwoFactorRepository) Save(ctx context.Context, twoFactor *domainuser.TwoFactor) error {
+	args := m.Called(ctx, twoFactor)
+	return args.Error(0)
+}
+
+func (m *mockTwoFactorRepository) Delete(ctx context.Context, userID int64) error {
+	args := m.Called(ctx, userID)
+	return args.Error(0)
+}
+
+func (m *mockTwoFactorRepository) ReplaceRecoveryCodes(ctx context.Context, userID int64, codeHashes []string) error {
+	args := m.Called(ctx, userID, codeHashes)
+	return args.Error(0)
+}
+
+func (m *mockTwoFactorRepository) UseRecoveryCode(ctx context.Context, userID int64, codeHash string) error {
+	args := m.Called(ctx, userID, codeHash)
+	return args.Error(0)
+}
+
+func (m *mockTwoFactorRepository) UseTimeStep(ctx context.Context, userID, step int64) error {
+	args := m.Called(ctx, userID, step)
+	return args.Error(0)
+}
+
+// mockTOTPAuthenticator is a mock implementation of TOTPAuthenticator
+type mockTOTPAuthenticator struct {
+	mock.Mock
+}
+
+func (m *mockTOTPAuthenticator) GenerateSecret() (string, error) {
+	args := m.Called()
+	return args.String(0), args.Error(1)
+}
+
+func (m *mockTOTPAuthenticator) ProvisioningURI(secret, accountName string) string {
+	args := m.Called(secret, accountName)
+	return args.String(0)
+}
+
+func (m *mockTOTPAuthenticator) Validate(secret, code string, at time.Time) (int64, bool) {
+	args := m.Called(secret, code, at)
+	return args.Get(0).(int64), args.Bool(1)
+}
+
+// mockTwoFactorChallengeStore is a mock implementation of TwoFactorChallengeStore
+type mockTwoFactorChallengeStore struct {
+	mock.Mock
+}
+
+func (m *mockTwoFactorChallengeStore) Save(ctx context.Context, tokenHash string, userID int64, ttl time.Duration) error {
+	args := m.Called(ctx, tokenHash, userID, ttl)
+	return args.Error(0)
+}
+
+func (m *mockTwoFactorChallengeStore) Take(ctx context.Context, tokenHash string) (int64, error) {
+	args := m.Called(ctx, tokenHash)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+// mockAPIKeyRepository is a mock implementation of APIKeyRepository
+type mockAPIKeyRepository struct {
+	mock.Mock
+}
+
+func (m *mockAPIKeyRepository) Create(ctx context.Context, key *domainuser.APIKey) (*domainuser.APIKey, error) {
+	args := m.Called(ctx, key)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domainuser.APIKey), args.Error(1)
+}
+
+func (m *mockAPIKeyRepository) GetByHash(ctx context.Context, keyHash string) (*domainuser.APIKey, error) {
+	args := m.Called(ctx, keyHash)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domainuser.APIKey), args.Error(1)
+}
+
+func (m *mockAPIKeyRepository) ListByUser(ctx context.Context, userID int64) ([]*domainuser.APIKey, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domainuser.APIKey), args.Error(1)
+}
+
+func (m *mockAPIKeyRepository) Revoke(ctx context.Context, userID, id int64) error {
+	args := m.Called(ctx, userID, id)
+	return args.Error(0)
+}
+
+func (m *mockAPIKeyRepository) TouchLastUsed(ctx context.Context, id int64, at time.Time) error {
+	args := m.Called(ctx, id, at)
+	return args.Error(0)
+}
+
+// mockIdentityProvider is a mock implementation of IdentityProvider
+type mockIdentityProvider struct {
+	mock.Mock
+}
+
+func (m *mockIdentityProvider) Name() string {
+	args := m.Called()
+	return args.String(0)
+}
+
+func (m *mockIdentityProvider) AuthorizationURL(ctx context.Context, state, nonce, codeVerifier string) (string, error) {
+	args := m.Called(ctx, state, nonce, codeVerifier)
+	return args.String(0), args.Error(1)
+}
+
+func (m *mockIdentityProvider) Exchange(ctx context.Context, code, codeVerifier, nonce string) (*domainuser.ExternalIdentity, error) {
+	args := m.Called(ctx, code, codeVerifier, nonce)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domainuser.ExternalIdentity), args.Error(1)
+}
+
+// mockIdentityRepository is a mock implementation of IdentityRepository
+type mockIdentityRepository struct {
+	mock.Mock
+}
+
+func (m *mockIdentityRepository) GetByProviderSubject(ctx context.Context, provider, subject string) (*domainuser.UserIdentity, error) {
+	args := m.Called(ctx, provider, subject)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domainuser.UserIdentity), args.Error(1)
+}
+
+func (m *mockIdentityRepository) Create(ctx context.Context, identity *domainuser.UserIdentity) (*domainuser.UserIdentity, error) {
+	args := m.Called(ctx, identity)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domainuser.UserIdentity), args.Error(1)
+}
+
+func (m *mockIdentityRepository) DeleteByUser(ctx context.Context, userID int64) error {
+	args := m.Called(ctx, userID)
+	return args.Error(0)
+}
+
+// mockAuthorizationStateStore is a mock implementation of AuthorizationStateStore
+type mockAuthorizationStateStore struct {
+	mock.Mock
+}
+
+func (m *mockAuthorizationStateStore) Save(ctx context.Context, stateHash string, state domainuser.AuthorizationState, ttl time.Duration) error {
+	args := m.Called(ctx, stateHash, state, ttl)
+	return args.Error(0)
+}
+
+func (m *mockAuthorizationStateStore) Take(ctx context.Context, stateHash string) (*domainuser.AuthorizationState, error) {
+	args := m.Called(ctx, stateHash)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domainuser.AuthorizationState), args.Error(1)
+}
+
+// mockLoginCodeStore is a mock implementation of LoginCodeStore
+type mockLoginCodeStore struct {
+	mock.Mock
+}
+
+func (m *mockLoginCodeStore) Save(ctx context.Context, codeHash string, userID int64, ttl time.Duration) error {
+	args := m.Called(ctx, codeHash, userID, ttl)
+	return args.Error(0)
+}
+
+func (m *mockLoginCodeStore) Take(ctx context.Context, codeHash string) (int64, error) {
+	args := m.Called(ctx, codeHash)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+// mockSessionRepository is a mock implementation of SessionRepository
+type mockSessionRepository struct {
+	mock.Mock
+}
+
+func (m *mockSessionRepository) Create(ctx context.Context, session *domainuser.Session) error {
+	args := m.Called(ctx, session)
+	return args.Error(0)
+}
+
+func (m *mockSessionRepository) GetByID(ctx context.Context, id string) (*domainuser.Session, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domainuser.Session), args.Error(1)
+}
+
+func (m *mockSessionRepository) ListActiveByUser(ctx context.Context, userID int64) ([]*domainuser.Session, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domainuser.Session), args.Error(1)
+}
+
+func (m *mockSessionRepository) Touch(ctx context.Context, id string, lastSeenAt, expiresAt time.Time) error {
+	args := m.Called(ctx, id, lastSeenAt, expiresAt)
+	return args.Error(0)
+}
+
+func (m *mockSessionRepository) Revoke(ctx context.Context, id string) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *mockUserRepository) Find(ctx context.Context, criteria domainuser.Criteria) ([]*domainuser.User, int64, error) {
+	args := m.Called(ctx, criteria)
+	if args.Get(0) == nil {
+		return nil, args.Get(1).(int64), args.Error(2)
+	}
+	return args.Get(0).([]*domainuser.User), args.Get(1).(int64), args.Error(2)
+}
+
+func (m *mockUserRepository) Restore(ctx context.Context, orgID, id int64) error {
+	args := m.Called(ctx, orgID, id)
+	return args.Error(0)
+}
+
+func (m *mockUserRepository) ListDeleted(ctx context.Context, orgID int64, limit, offset int) ([]*domainuser.User, error) {
+	args := m.Called(ctx, orgID, limit, offset)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domainuser.User), args.Error(1)
+}
+
+func (m *mockUserRepository) CountDeleted(ctx context.Context, orgID int64) (int64, error) {
+	args := m.Called(ctx, orgID)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *mockUserRepository) ListDeletedIDsBefore(ctx context.Context, before time.Time, limit int) ([]int64, error) {
+	args := m.Called(ctx, before, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]int64), args.Error(1)
+}
+
+func (m *mockUserRepository) Purge(ctx context.Context, id int64) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+// mockOrganizationRepository is a mock implementation of OrganizationRepository
+type mockOrganizationRepository struct {
+	mock.Mock
+}
+
+func (m *mockOrganizationRepository) Create(ctx context.Context, org *domainuser.Organization) (*domainuser.Organization, error) {
+	args := m.Called(ctx, org)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domainuser.Organization), args.Error(1)
+}
+
+func (m *mockOrganizationRepository) GetByID(ctx context.Context, id int64) (*domainuser.Organization, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domainuser.Organization), args.Error(1)
+}
+
+func (m *mockOrganizationRepository) ListByUser(ctx context.Context, userID int64) ([]*domainuser.OrganizationMembership, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domainuser.OrganizationMembership), args.Error(1)
+}
+
+func (m *mockOrganizationRepository) AddMember(ctx context.Context, membership *domainuser.Membership) error {
+	args := m.Called(ctx, membership)
+	return args.Error(0)
+}
+
+func (m *mockOrganizationRepository) GetMembership(ctx context.Context, orgID, userID int64) (*domainuser.Membership, error) {
+	args := m.Called(ctx, orgID, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domainuser.Membership), args.Error(1)
+}
+
+func (m *mockOrganizationRepository) UpdateMemberRole(ctx context.Context, orgID, userID int64, role domainuser.Role) error {
+	args := m.Called(ctx, orgID, userID, role)
+	return args.Error(0)
+}
+
+func (m *mockOrganizationRepository) RemoveMember(ctx context.Context, orgID, userID int64) error {
+	args := m.Called(ctx, orgID, userID)
+	return args.Error(0)
+}
+
+func (m *mockOrganizationRepository) CountMemberships(ctx context.Context, userID int64) (int64, error) {
+	args := m.Called(ctx, userID)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+// newMemberOrganizations returns an organization repository in which every user is
+// a member of organization 1, and of no other organization, with the given role
+func newMemberOrganizations(role domainuser.Role) *mockOrganizationRepository {
+	orgs := &mockOrganizationRepository{}
+	orgs.On("GetMembership", mock.Anything, int64(1), mock.Anything).
+		Return(&domainuser.Membership{OrgID: 1, Role: role}, nil).Maybe()
+	orgs.On("CountMemberships", mock.Anything, mock.Anything).Return(int64(1), nil).Maybe()
+	return orgs
+}
+
+// mockInvitationRepository is a mock implementation of InvitationRepository
+type mockInvitationRepository struct {
+	mock.Mock
+}
+
+func (m *mockInvitationRepository) Create(ctx context.Context, invitation *domainuser.Invitation) (*domainuser.Invitation, error) {
+	args := m.Called(ctx, invitation)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domainuser.Invitation), args.Error(1)
+}
+
+func (m *mockInvitationRepository) GetByHash(ctx context.Context, tokenHash string) (*domainuser.Invitation, error) {
+	args := m.Called(ctx, tokenHash)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domainuser.Invitation), args.Error(1)
+}
+
+func (m *mockInvitationRepository) MarkAccepted(ctx context.Context, id int64) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+// mockUserCreator is a mock implementation of UserCreator
+type mockUserCreator struct {
+	mock.Mock
+}
+
+func (m *mockUserCreator) Execute(ctx context.Context, req dto.CreateUserRequest) (*dto.UserResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.UserResponse), args.Error(1)
+}
+
+// mockPasswordHistoryRepository is a mock implementation of PasswordHistoryRepository
+type mockPasswordHistoryRepository struct {
+	mock.Mock
+}
+
+func (m *mockPasswordHistoryRepository) Add(ctx context.Context, userID int64, hash string, createdAt time.Time) error {
+	args := m.Called(ctx, userID, hash, createdAt)
+	return args.Error(0)
+}
+
+func (m *mockPasswordHistoryRepository) ListRecent(ctx context.Context, userID int64, limit int) ([]string, error) {
+	args := m.Called(ctx, userID, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]string), args.Error(1)
+}
+
+func (m *mockPasswordHistoryRepository) Prune(ctx context.Context, userID int64, keep int) error {
+	args := m.Called(ctx, userID, keep)
+	return args.Error(0)
+}
+
+func (m *mockPasswordHistoryRepository) DeleteByUser(ctx context.Context, userID int64) error {
+	args := m.Called(ctx, userID)
+	return args.Error(0)
+}
+
+// mockBreachedPasswordChecker is a mock implementation of BreachedPasswordChecker
+type mockBreachedPasswordChecker struct {
+	mock.Mock
+}
+
+func (m *mockBreachedPasswordChecker) IsBreached(ctx context.Context, password string) (bool, error) {
+	args := m.Called(ctx, password)
+	return args.Bool(0), args.Error(1)
+}
@@ -0,0 +1,118 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/rulzi/hexa-go/internal/application/user/dto"
+	domainuser "github.com/rulzi/hexa-go/internal/domain/user"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestCreateOrganizationUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+	orgs := &mockOrganizationRepository{}
+	uc := NewCreateOrganizationUseCase(orgs)
+
+	orgs.On("Create", ctx, mock.MatchedBy(func(o *domainuser.Organization) bool {
+		return o.Name == "Acme"
+	})).Return(&domainuser.Organization{ID: 4, Name: "Acme"}, nil)
+	orgs.On("AddMember", ctx, mock.MatchedBy(func(m *domainuser.Membership) bool {
+		return m.OrgID == 4 && m.UserID == 1 && m.Role == domainuser.RoleAdmin
+	})).Return(nil)
+
+	result, err := uc.Execute(ctx, 1, dto.CreateOrganizationRequest{Name: "Acme"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(4), result.ID)
+	assert.Equal(t, "admin", result.Role)
+	orgs.AssertExpectations(t)
+}
+
+func TestCreateOrganizationUseCase_Execute_NameRequired(t *testing.T) {
+	orgs := &mockOrganizationRepository{}
+
+	result, err := NewCreateOrganizationUseCase(orgs).Execute(context.Background(), 1, dto.CreateOrganizationRequest{})
+
+	assert.Equal(t, domainuser.ErrOrganizationNameRequired, err)
+	assert.Nil(t, result)
+	orgs.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+}
+
+func TestListOrganizationsUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+	orgs := &mockOrganizationRepository{}
+	uc := NewListOrganizationsUseCase(orgs)
+
+	now := time.Now()
+	orgs.On("ListByUser", ctx, int64(1)).Return([]*domainuser.OrganizationMembership{
+		{Organization: &domainuser.Organization{ID: 1, Name: "Default"}, Role: domainuser.RoleAuthor, JoinedAt: now},
+		{Organization: &domainuser.Organization{ID: 4, Name: "Acme"}, Role: domainuser.RoleAdmin, JoinedAt: now},
+	}, nil)
+
+	result, err := uc.Execute(ctx, 1, 4)
+
+	assert.NoError(t, err)
+	if assert.Len(t, result, 2) {
+		assert.False(t, result[0].Current)
+		assert.Equal(t, "author", result[0].Role)
+		assert.True(t, result[1].Current)
+		assert.Equal(t, "Acme", result[1].Name)
+	}
+}
+
+func TestSwitchOrganizationUseCase_Execute(t *testing.T) {
+	u := &domainuser.User{ID: 1, Email: "test@example.com"}
+
+	tests := []struct {
+		name       string
+		membership *domainuser.Membership
+		lookupErr  error
+		wantErr    error
+	}{
+		{name: "member", membership: &domainuser.Membership{OrgID: 4, UserID: 1, Role: domainuser.RoleEditor}},
+		{name: "not a member", lookupErr: domainuser.ErrNotMember, wantErr: domainuser.ErrOrganizationNotFound},
+		{name: "lookup error", lookupErr: errors.New("database error"), wantErr: errors.New("database error")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			repo := &mockUserRepository{}
+			orgs := &mockOrganizationRepository{}
+			tokenGen := &mockTokenGenerator{}
+			refreshRepo := &mockRefreshTokenRepository{}
+			sessions := &mockSessionRepository{}
+
+			repo.On("GetByID", ctx, int64(0), int64(1)).Return(u, nil)
+			if tt.membership != nil {
+				orgs.On("GetMembership", ctx, int64(4), int64(1)).Return(tt.membership, nil)
+			} else {
+				orgs.On("GetMembership", ctx, int64(4), int64(1)).Return(nil, tt.lookupErr)
+			}
+			tokenGen.On("Generate", mock.MatchedBy(func(claims domainuser.TokenClaims) bool {
+				return claims.OrgID == 4 && claims.Role == domainuser.RoleEditor && claims.SessionID == "session-1"
+			})).Return("access_token", nil)
+			refreshRepo.On("Create", ctx, mock.MatchedBy(func(rt *domainuser.RefreshToken) bool {
+				return rt.FamilyID == "session-1" && rt.OrgID == 4
+			})).Return(&domainuser.RefreshToken{ID: 1}, nil)
+			sessions.On("Touch", ctx, "session-1", mock.Anything, mock.Anything).Return(nil)
+
+			uc := NewSwitchOrganizationUseCase(repo, NewTokenIssuer(tokenGen, refreshRepo, sessions, orgs, time.Hour))
+			result, err := uc.Execute(ctx, 1, 4, "session-1")
+
+			if tt.wantErr != nil {
+				assert.Equal(t, tt.wantErr, err)
+				assert.Nil(t, result)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, "access_token", result.Token)
+			refreshRepo.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,95 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	domainuser "github.com/rulzi/hexa-go/internal/domain/user"
+)
+
+// PasswordChecker checks new passwords against the password policy
+// and remembers the passwords users had so they are not reused
+type PasswordChecker struct {
+	policy   domainuser.PasswordPolicy
+	hasher   domainuser.PasswordHasher
+	history  domainuser.PasswordHistoryRepository
+	breached domainuser.BreachedPasswordChecker
+}
+
+// NewPasswordChecker creates a new PasswordChecker.
+// Reuse is only checked when a history repository is provided, breaches only when a breach checker is provided.
+func NewPasswordChecker(
+	policy domainuser.PasswordPolicy,
+	hasher domainuser.PasswordHasher,
+	history domainuser.PasswordHistoryRepository,
+	breached domainuser.BreachedPasswordChecker,
+) *PasswordChecker {
+	return &PasswordChecker{
+		policy:   policy,
+		hasher:   hasher,
+		history:  history,
+		breached: breached,
+	}
+}
+
+// Check checks a new password of the user, new users have no ID yet.
+// Returns a *domainuser.PasswordPolicyError listing every rule the password breaks.
+func (p *PasswordChecker) Check(ctx context.Context, u *domainuser.User, password string) error {
+	violations := p.policy.Check(password, u.Name, u.Email)
+
+	if u.ID != 0 && p.policy.HistorySize > 0 && p.history != nil {
+		reused, err := p.isReused(ctx, u, password)
+		if err != nil {
+			return err
+		}
+		if reused {
+			violations = append(violations, domainuser.ReusedPasswordViolation(p.policy.HistorySize))
+		}
+	}
+
+	if p.breached != nil {
+		breached, err := p.breached.IsBreached(ctx, password)
+		if err != nil {
+			return err
+		}
+		if breached {
+			violations = append(violations, domainuser.BreachedPasswordViolation())
+		}
+	}
+
+	if len(violations) > 0 {
+		return &domainuser.PasswordPolicyError{Violations: violations}
+	}
+	return nil
+}
+
+// isReused reports whether the password is the current password of the user or one of the previous ones
+func (p *PasswordChecker) isReused(ctx context.Context, u *domainuser.User, password string) (bool, error) {
+	hashes, err := p.history.ListRecent(ctx, u.ID, p.policy.HistorySize)
+	if err != nil {
+		return false, err
+	}
+	// Users from before the history was kept only have their current password
+	if u.Password != "" {
+		hashes = append(hashes, u.Password)
+	}
+
+	for _, hash := range hashes {
+		if p.hasher.Verify(hash, password) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// Remember records the password hash the user now has and forgets the ones beyond the history size
+func (p *PasswordChecker) Remember(ctx context.Context, userID int64, hash string) error {
+	if p.policy.HistorySize <= 0 || p.history == nil {
+		return nil
+	}
+
+	if err := p.history.Add(ctx, userID, hash, time.Now()); err != nil {
+		return err
+	}
+	return p.history.Prune(ctx, userID, p.policy.HistorySize)
+}
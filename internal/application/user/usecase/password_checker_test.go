@@ -0,0 +1,135 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	domainuser "github.com/rulzi/hexa-go/internal/domain/user"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestPasswordChecker_Check(t *testing.T) {
+	ctx := context.Background()
+	policy := domainuser.PasswordPolicy{MinLength: 8, DisallowPersonalInfo: true, HistorySize: 3}
+
+	tests := []struct {
+		name       string
+		user       *domainuser.User
+		password   string
+		setupMocks func(*mockPasswordHasher, *mockPasswordHistoryRepository, *mockBreachedPasswordChecker)
+		wantRules  []string
+		wantErr    error
+	}{
+		{
+			name:     "strong password",
+			user:     &domainuser.User{ID: 1, Name: "John Doe", Email: "john@example.com", Password: "current_hash"},
+			password: "new-password",
+			setupMocks: func(hasher *mockPasswordHasher, history *mockPasswordHistoryRepository, breached *mockBreachedPasswordChecker) {
+				history.On("ListRecent", ctx, int64(1), 3).Return([]string{"old_hash"}, nil)
+				hasher.On("Verify", mock.Anything, "new-password").Return(false)
+				breached.On("IsBreached", ctx, "new-password").Return(false, nil)
+			},
+		},
+		{
+			name:     "new user skips the history",
+			user:     &domainuser.User{Name: "John Doe", Email: "john@example.com"},
+			password: "new-password",
+			setupMocks: func(hasher *mockPasswordHasher, history *mockPasswordHistoryRepository, breached *mockBreachedPasswordChecker) {
+				breached.On("IsBreached", ctx, "new-password").Return(false, nil)
+			},
+		},
+		{
+			name:     "every violation is reported",
+			user:     &domainuser.User{ID: 1, Name: "John Doe", Email: "john@example.com", Password: "current_hash"},
+			password: "john",
+			setupMocks: func(hasher *mockPasswordHasher, history *mockPasswordHistoryRepository, breached *mockBreachedPasswordChecker) {
+				history.On("ListRecent", ctx, int64(1), 3).Return([]string{"old_hash"}, nil)
+				hasher.On("Verify", "old_hash", "john").Return(true)
+				breached.On("IsBreached", ctx, "john").Return(true, nil)
+			},
+			wantRules: []string{
+				domainuser.PasswordRuleMinLength,
+				domainuser.PasswordRulePersonalInfo,
+				domainuser.PasswordRuleReused,
+				domainuser.PasswordRuleBreached,
+			},
+		},
+		{
+			name:     "current password counts as reused",
+			user:     &domainuser.User{ID: 1, Name: "John Doe", Email: "john@example.com", Password: "current_hash"},
+			password: "same-password",
+			setupMocks: func(hasher *mockPasswordHasher, history *mockPasswordHistoryRepository, breached *mockBreachedPasswordChecker) {
+				history.On("ListRecent", ctx, int64(1), 3).Return(nil, nil)
+				hasher.On("Verify", "current_hash", "same-password").Return(true)
+				breached.On("IsBreached", ctx, "same-password").Return(false, nil)
+			},
+			wantRules: []string{domainuser.PasswordRuleReused},
+		},
+		{
+			name:     "error on history",
+			user:     &domainuser.User{ID: 1, Name: "John Doe", Email: "john@example.com"},
+			password: "new-password",
+			setupMocks: func(hasher *mockPasswordHasher, history *mockPasswordHistoryRepository, breached *mockBreachedPasswordChecker) {
+				history.On("ListRecent", ctx, int64(1), 3).Return(nil, errors.New("database error"))
+			},
+			wantErr: errors.New("database error"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hasher := &mockPasswordHasher{}
+			history := &mockPasswordHistoryRepository{}
+			breached := &mockBreachedPasswordChecker{}
+			tt.setupMocks(hasher, history, breached)
+
+			checker := NewPasswordChecker(policy, hasher, history, breached)
+			err := checker.Check(ctx, tt.user, tt.password)
+
+			switch {
+			case tt.wantErr != nil:
+				assert.Equal(t, tt.wantErr, err)
+			case tt.wantRules != nil:
+				var policyErr *domainuser.PasswordPolicyError
+				assert.ErrorAs(t, err, &policyErr)
+				assert.ErrorIs(t, err, domainuser.ErrWeakPassword)
+				var rules []string
+				for _, v := range policyErr.Violations {
+					rules = append(rules, v.Rule)
+				}
+				assert.Equal(t, tt.wantRules, rules)
+			default:
+				assert.NoError(t, err)
+			}
+
+			hasher.AssertExpectations(t)
+			history.AssertExpectations(t)
+			breached.AssertExpectations(t)
+		})
+	}
+}
+
+func TestPasswordChecker_Remember(t *testing.T) {
+	ctx := context.Background()
+	history := &mockPasswordHistoryRepository{}
+	history.On("Add", ctx, int64(1), "new_hash", mock.Anything).Return(nil)
+	history.On("Prune", ctx, int64(1), 3).Return(nil)
+
+	checker := NewPasswordChecker(domainuser.PasswordPolicy{HistorySize: 3}, &mockPasswordHasher{}, history, nil)
+	err := checker.Remember(ctx, 1, "new_hash")
+
+	assert.NoError(t, err)
+	history.AssertExpectations(t)
+}
+
+func TestPasswordChecker_Remember_HistoryDisabled(t *testing.T) {
+	history := &mockPasswordHistoryRepository{}
+
+	checker := NewPasswordChecker(domainuser.PasswordPolicy{}, &mockPasswordHasher{}, history, nil)
+	err := checker.Remember(context.Background(), 1, "new_hash")
+
+	assert.NoError(t, err)
+	history.AssertNotCalled(t, "Add", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
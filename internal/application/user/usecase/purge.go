@@ -0,0 +1,59 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	domainuser "github.com/rulzi/hexa-go/internal/domain/user"
+)
+
+// purgeBatchSize is the number of trashed users loaded per round while purging
+const purgeBatchSize = 100
+
+// PurgeUsersUseCase permanently deletes the users that stayed in the trash past the retention period.
+// Their sessions and tokens go with them through the foreign keys. Articles are never purged with
+// their author: a user who still authors articles, including articles in the trash, is skipped and
+// stays in the trash until the articles are deleted or reassigned.
+type PurgeUsersUseCase struct {
+	userRepo       domainuser.Repository
+	revokeSessions *RevokeAllSessionsUseCase
+}
+
+// NewPurgeUsersUseCase creates a new PurgeUsersUseCase
+func NewPurgeUsersUseCase(userRepo domainuser.Repository, revokeSessions *RevokeAllSessionsUseCase) *PurgeUsersUseCase {
+	return &PurgeUsersUseCase{
+		userRepo:       userRepo,
+		revokeSessions: revokeSessions,
+	}
+}
+
+// Execute purges the users moved to the trash before deletedBefore and returns how many were removed.
+// Sessions are revoked first: the foreign keys drop their rows, but access tokens
+// that are still valid have to be denied until they expire.
+func (uc *PurgeUsersUseCase) Execute(ctx context.Context, deletedBefore time.Time) (int64, error) {
+	var purged int64
+	for {
+		ids, err := uc.userRepo.ListDeletedIDsBefore(ctx, deletedBefore, purgeBatchSize)
+		if err != nil {
+			return purged, err
+		}
+
+		for _, id := range ids {
+			if err := uc.revokeSessions.Execute(ctx, id); err != nil {
+				return purged, err
+			}
+			if err := uc.userRepo.Purge(ctx, id); err != nil {
+				if err == domainuser.ErrUserNotFound || err == domainuser.ErrUserHasArticles {
+					// Restored or purged by another instance, or given an article since it was listed
+					continue
+				}
+				return purged, err
+			}
+			purged++
+		}
+
+		if len(ids) < purgeBatchSize {
+			return purged, nil
+		}
+	}
+}
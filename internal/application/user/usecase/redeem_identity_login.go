@@ -0,0 +1,58 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/rulzi/hexa-go/internal/application/user/dto"
+	domainuser "github.com/rulzi/hexa-go/internal/domain/user"
+)
+
+// RedeemIdentityLoginUseCase trades the one-time code of a sign-in with the identity provider for tokens
+type RedeemIdentityLoginUseCase struct {
+	userRepo    domainuser.Repository
+	codes       domainuser.LoginCodeStore
+	tokenIssuer *TokenIssuer
+	attempts    domainuser.LoginAttemptRepository
+}
+
+// NewRedeemIdentityLoginUseCase creates a new RedeemIdentityLoginUseCase
+func NewRedeemIdentityLoginUseCase(
+	userRepo domainuser.Repository,
+	codes domainuser.LoginCodeStore,
+	tokenIssuer *TokenIssuer,
+	attempts domainuser.LoginAttemptRepository,
+) *RedeemIdentityLoginUseCase {
+	return &RedeemIdentityLoginUseCase{
+		userRepo:    userRepo,
+		codes:       codes,
+		tokenIssuer: tokenIssuer,
+		attempts:    attempts,
+	}
+}
+
+// Execute executes the redeem identity login use case.
+// The code is taken before anything else, so it can't be redeemed twice.
+func (uc *RedeemIdentityLoginUseCase) Execute(ctx context.Context, req dto.IdentityLoginCodeRequest) (*dto.LoginResponse, error) {
+	userID, err := uc.codes.Take(ctx, domainuser.HashToken(req.Code))
+	if err != nil {
+		return nil, err
+	}
+
+	userEntity, err := uc.userRepo.GetByID(ctx, 0, userID)
+	if err != nil {
+		// Deleted since the callback
+		if err == domainuser.ErrUserNotFound {
+			return nil, domainuser.ErrInvalidLoginCode
+		}
+		return nil, err
+	}
+
+	tokens, err := uc.tokenIssuer.StartSession(ctx, userEntity, req.UserAgent, req.IPAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	recordLoginAttempt(ctx, uc.attempts, userEntity.Email, req.IPAddress, req.UserAgent, userEntity.ID, true)
+
+	return newLoginResponse(tokens, userEntity), nil
+}
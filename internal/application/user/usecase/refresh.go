@@ -0,0 +1,69 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/rulzi/hexa-go/internal/application/user/dto"
+	domainuser "github.com/rulzi/hexa-go/internal/domain/user"
+)
+
+// RefreshTokenUseCase handles rotating a refresh token into a new token pair
+type RefreshTokenUseCase struct {
+	userRepo      domainuser.Repository
+	refreshTokens domainuser.RefreshTokenRepository
+	tokenIssuer   *TokenIssuer
+}
+
+// NewRefreshTokenUseCase creates a new RefreshTokenUseCase
+func NewRefreshTokenUseCase(
+	userRepo domainuser.Repository,
+	refreshTokens domainuser.RefreshTokenRepository,
+	tokenIssuer *TokenIssuer,
+) *RefreshTokenUseCase {
+	return &RefreshTokenUseCase{
+		userRepo:      userRepo,
+		refreshTokens: refreshTokens,
+		tokenIssuer:   tokenIssuer,
+	}
+}
+
+// Execute executes the refresh token use case
+func (uc *RefreshTokenUseCase) Execute(ctx context.Context, req dto.RefreshTokenRequest) (*dto.TokenResponse, error) {
+	stored, err := uc.refreshTokens.GetByHash(ctx, domainuser.HashToken(req.RefreshToken))
+	if err != nil || stored == nil {
+		return nil, domainuser.ErrInvalidRefreshToken
+	}
+
+	// A revoked token being presented again means it was stolen or replayed,
+	// so the whole family is revoked
+	if stored.IsRevoked() {
+		_ = uc.refreshTokens.RevokeFamily(ctx, stored.FamilyID)
+		return nil, domainuser.ErrRefreshTokenReused
+	}
+
+	if stored.IsExpired(time.Now()) {
+		return nil, domainuser.ErrInvalidRefreshToken
+	}
+
+	// Revoke the presented token, losing a concurrent rotation counts as reuse
+	if err := uc.refreshTokens.Revoke(ctx, stored.ID); err != nil {
+		if err == domainuser.ErrInvalidRefreshToken {
+			_ = uc.refreshTokens.RevokeFamily(ctx, stored.FamilyID)
+			return nil, domainuser.ErrRefreshTokenReused
+		}
+		return nil, err
+	}
+
+	userEntity, err := uc.userRepo.GetByID(ctx, 0, stored.UserID)
+	if err != nil || userEntity == nil {
+		return nil, domainuser.ErrInvalidRefreshToken
+	}
+
+	// Tokens keep acting in the organization of the family, leaving it ends the family
+	tokens, err := uc.tokenIssuer.Issue(ctx, userEntity, stored.FamilyID, stored.OrgID)
+	if err == domainuser.ErrNotMember {
+		return nil, domainuser.ErrInvalidRefreshToken
+	}
+	return tokens, err
+}
@@ -0,0 +1,181 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/rulzi/hexa-go/internal/application/user/dto"
+	domainuser "github.com/rulzi/hexa-go/internal/domain/user"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func newTestRefreshTokenUseCase() (*RefreshTokenUseCase, *mockUserRepository, *mockRefreshTokenRepository, *mockTokenGenerator) {
+	repo := &mockUserRepository{}
+	refreshRepo := &mockRefreshTokenRepository{}
+	tokenGen := &mockTokenGenerator{}
+	uc := NewRefreshTokenUseCase(repo, refreshRepo, NewTokenIssuer(tokenGen, refreshRepo, nil, nil, time.Hour))
+	return uc, repo, refreshRepo, tokenGen
+}
+
+func TestNewRefreshTokenUseCase(t *testing.T) {
+	uc, repo, refreshRepo, _ := newTestRefreshTokenUseCase()
+
+	assert.NotNil(t, uc)
+	assert.Equal(t, repo, uc.userRepo)
+	assert.Equal(t, refreshRepo, uc.refreshTokens)
+	assert.NotNil(t, uc.tokenIssuer)
+}
+
+func TestRefreshTokenUseCase_Execute_Success(t *testing.T) {
+	ctx := context.Background()
+	uc, repo, refreshRepo, tokenGen := newTestRefreshTokenUseCase()
+
+	req := dto.RefreshTokenRequest{RefreshToken: "refresh_token"}
+	stored := &domainuser.RefreshToken{
+		ID:        10,
+		UserID:    1,
+		FamilyID:  "family-1",
+		ExpiresAt: time.Now().Add(time.Hour),
+	}
+	userEntity := &domainuser.User{ID: 1, Email: "test@example.com"}
+
+	refreshRepo.On("GetByHash", ctx, domainuser.HashToken(req.RefreshToken)).Return(stored, nil)
+	refreshRepo.On("Revoke", ctx, int64(10)).Return(nil)
+	repo.On("GetByID", ctx, int64(0), int64(1)).Return(userEntity, nil)
+	tokenGen.On("Generate", domainuser.TokenClaims{UserID: 1, Email: "test@example.com"}).Return("new_access_token", nil)
+	refreshRepo.On("Create", ctx, mock.MatchedBy(func(rt *domainuser.RefreshToken) bool {
+		return rt.FamilyID == "family-1" && rt.UserID == 1
+	})).Return(&domainuser.RefreshToken{ID: 11}, nil)
+
+	result, err := uc.Execute(ctx, req)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "new_access_token", result.Token)
+	assert.NotEmpty(t, result.RefreshToken)
+	assert.NotEqual(t, req.RefreshToken, result.RefreshToken)
+	refreshRepo.AssertExpectations(t)
+	repo.AssertExpectations(t)
+	tokenGen.AssertExpectations(t)
+}
+
+func TestRefreshTokenUseCase_Execute_UnknownToken(t *testing.T) {
+	ctx := context.Background()
+	uc, _, refreshRepo, tokenGen := newTestRefreshTokenUseCase()
+
+	req := dto.RefreshTokenRequest{RefreshToken: "unknown"}
+	refreshRepo.On("GetByHash", ctx, domainuser.HashToken(req.RefreshToken)).Return(nil, domainuser.ErrInvalidRefreshToken)
+
+	result, err := uc.Execute(ctx, req)
+
+	assert.Equal(t, domainuser.ErrInvalidRefreshToken, err)
+	assert.Nil(t, result)
+	tokenGen.AssertNotCalled(t, "Generate")
+}
+
+func TestRefreshTokenUseCase_Execute_ReuseRevokesFamily(t *testing.T) {
+	ctx := context.Background()
+	uc, _, refreshRepo, tokenGen := newTestRefreshTokenUseCase()
+
+	revokedAt := time.Now().Add(-time.Minute)
+	req := dto.RefreshTokenRequest{RefreshToken: "rotated"}
+	stored := &domainuser.RefreshToken{
+		ID:        10,
+		UserID:    1,
+		FamilyID:  "family-1",
+		ExpiresAt: time.Now().Add(time.Hour),
+		RevokedAt: &revokedAt,
+	}
+
+	refreshRepo.On("GetByHash", ctx, domainuser.HashToken(req.RefreshToken)).Return(stored, nil)
+	refreshRepo.On("RevokeFamily", ctx, "family-1").Return(nil)
+
+	result, err := uc.Execute(ctx, req)
+
+	assert.Equal(t, domainuser.ErrRefreshTokenReused, err)
+	assert.Nil(t, result)
+	refreshRepo.AssertExpectations(t)
+	refreshRepo.AssertNotCalled(t, "Revoke", mock.Anything, mock.Anything)
+	tokenGen.AssertNotCalled(t, "Generate")
+}
+
+func TestRefreshTokenUseCase_Execute_ConcurrentRotationRevokesFamily(t *testing.T) {
+	ctx := context.Background()
+	uc, _, refreshRepo, _ := newTestRefreshTokenUseCase()
+
+	req := dto.RefreshTokenRequest{RefreshToken: "refresh_token"}
+	stored := &domainuser.RefreshToken{
+		ID:        10,
+		UserID:    1,
+		FamilyID:  "family-1",
+		ExpiresAt: time.Now().Add(time.Hour),
+	}
+
+	refreshRepo.On("GetByHash", ctx, domainuser.HashToken(req.RefreshToken)).Return(stored, nil)
+	refreshRepo.On("Revoke", ctx, int64(10)).Return(domainuser.ErrInvalidRefreshToken)
+	refreshRepo.On("RevokeFamily", ctx, "family-1").Return(nil)
+
+	result, err := uc.Execute(ctx, req)
+
+	assert.Equal(t, domainuser.ErrRefreshTokenReused, err)
+	assert.Nil(t, result)
+	refreshRepo.AssertExpectations(t)
+}
+
+func TestRefreshTokenUseCase_Execute_ExpiredToken(t *testing.T) {
+	ctx := context.Background()
+	uc, _, refreshRepo, _ := newTestRefreshTokenUseCase()
+
+	req := dto.RefreshTokenRequest{RefreshToken: "expired"}
+	stored := &domainuser.RefreshToken{
+		ID:        10,
+		UserID:    1,
+		FamilyID:  "family-1",
+		ExpiresAt: time.Now().Add(-time.Hour),
+	}
+
+	refreshRepo.On("GetByHash", ctx, domainuser.HashToken(req.RefreshToken)).Return(stored, nil)
+
+	result, err := uc.Execute(ctx, req)
+
+	assert.Equal(t, domainuser.ErrInvalidRefreshToken, err)
+	assert.Nil(t, result)
+	refreshRepo.AssertNotCalled(t, "Revoke", mock.Anything, mock.Anything)
+}
+
+func TestRefreshTokenUseCase_Execute_RevokeError(t *testing.T) {
+	ctx := context.Background()
+	uc, _, refreshRepo, _ := newTestRefreshTokenUseCase()
+
+	req := dto.RefreshTokenRequest{RefreshToken: "refresh_token"}
+	stored := &domainuser.RefreshToken{ID: 10, UserID: 1, FamilyID: "family-1", ExpiresAt: time.Now().Add(time.Hour)}
+	dbErr := errors.New("database error")
+
+	refreshRepo.On("GetByHash", ctx, domainuser.HashToken(req.RefreshToken)).Return(stored, nil)
+	refreshRepo.On("Revoke", ctx, int64(10)).Return(dbErr)
+
+	result, err := uc.Execute(ctx, req)
+
+	assert.Equal(t, dbErr, err)
+	assert.Nil(t, result)
+}
+
+func TestRefreshTokenUseCase_Execute_UserNotFound(t *testing.T) {
+	ctx := context.Background()
+	uc, repo, refreshRepo, tokenGen := newTestRefreshTokenUseCase()
+
+	req := dto.RefreshTokenRequest{RefreshToken: "refresh_token"}
+	stored := &domainuser.RefreshToken{ID: 10, UserID: 1, FamilyID: "family-1", ExpiresAt: time.Now().Add(time.Hour)}
+
+	refreshRepo.On("GetByHash", ctx, domainuser.HashToken(req.RefreshToken)).Return(stored, nil)
+	refreshRepo.On("Revoke", ctx, int64(10)).Return(nil)
+	repo.On("GetByID", ctx, int64(0), int64(1)).Return(nil, domainuser.ErrUserNotFound)
+
+	result, err := uc.Execute(ctx, req)
+
+	assert.Equal(t, domainuser.ErrInvalidRefreshToken, err)
+	assert.Nil(t, result)
+	tokenGen.AssertNotCalled(t, "Generate")
+}
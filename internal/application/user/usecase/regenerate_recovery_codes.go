@@ -0,0 +1,48 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/rulzi/hexa-go/internal/application/user/dto"
+	domainuser "github.com/rulzi/hexa-go/internal/domain/user"
+)
+
+// RegenerateRecoveryCodesUseCase handles replacing the recovery codes of a user
+type RegenerateRecoveryCodesUseCase struct {
+	twoFactors domainuser.TwoFactorRepository
+	totp       domainuser.TOTPAuthenticator
+	throttler  *LoginThrottler
+}
+
+// NewRegenerateRecoveryCodesUseCase creates a new RegenerateRecoveryCodesUseCase
+func NewRegenerateRecoveryCodesUseCase(
+	twoFactors domainuser.TwoFactorRepository,
+	totp domainuser.TOTPAuthenticator,
+	throttler *LoginThrottler,
+) *RegenerateRecoveryCodesUseCase {
+	return &RegenerateRecoveryCodesUseCase{
+		twoFactors: twoFactors,
+		totp:       totp,
+		throttler:  throttler,
+	}
+}
+
+// Execute executes the regenerate recovery codes use case.
+// The old codes stop working once the new ones are issued.
+func (uc *RegenerateRecoveryCodesUseCase) Execute(ctx context.Context, userID int64, req dto.TwoFactorCodeRequest) (*dto.RecoveryCodesResponse, error) {
+	twoFactor, err := enabledTwoFactor(ctx, uc.twoFactors, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkThrottledTwoFactorCode(ctx, uc.throttler, uc.twoFactors, uc.totp, twoFactor, req.Code, false); err != nil {
+		return nil, err
+	}
+
+	codes, err := issueRecoveryCodes(ctx, uc.twoFactors, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.RecoveryCodesResponse{RecoveryCodes: codes}, nil
+}
@@ -0,0 +1,43 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/rulzi/hexa-go/internal/application/user/dto"
+	domainuser "github.com/rulzi/hexa-go/internal/domain/user"
+)
+
+// ResendVerificationUseCase handles sending a new verification link
+type ResendVerificationUseCase struct {
+	userRepo           domainuser.Repository
+	verificationSender *VerificationSender
+}
+
+// NewResendVerificationUseCase creates a new ResendVerificationUseCase
+func NewResendVerificationUseCase(userRepo domainuser.Repository, verificationSender *VerificationSender) *ResendVerificationUseCase {
+	return &ResendVerificationUseCase{
+		userRepo:           userRepo,
+		verificationSender: verificationSender,
+	}
+}
+
+// Execute executes the resend verification use case.
+// It succeeds for unknown or already verified emails too, so callers cannot tell them apart.
+func (uc *ResendVerificationUseCase) Execute(ctx context.Context, req dto.ResendVerificationRequest) error {
+	existingUser, err := uc.userRepo.GetByEmail(ctx, req.Email)
+	if err == domainuser.ErrUserNotFound || (err == nil && existingUser == nil) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	if existingUser.IsEmailVerified() {
+		return nil
+	}
+
+	// Send verification email (ignore errors so the response stays the same)
+	_ = uc.verificationSender.Send(ctx, existingUser)
+
+	return nil
+}
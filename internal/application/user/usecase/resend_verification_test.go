@@ -0,0 +1,63 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/rulzi/hexa-go/internal/application/user/dto"
+	domainuser "github.com/rulzi/hexa-go/internal/domain/user"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestResendVerificationUseCase_Execute(t *testing.T) {
+	verifiedAt := time.Now()
+	req := dto.ResendVerificationRequest{Email: "test@example.com"}
+
+	tests := []struct {
+		name       string
+		setupMocks func(*mockUserRepository, *mockEmailVerificationSigner, *mockNotificationService)
+	}{
+		{
+			name: "sends link to unverified user",
+			setupMocks: func(repo *mockUserRepository, signer *mockEmailVerificationSigner, notificationService *mockNotificationService) {
+				repo.On("GetByEmail", mock.Anything, req.Email).Return(&domainuser.User{ID: 1, Name: "Test User", Email: req.Email}, nil)
+				signer.On("Sign", mock.MatchedBy(func(c domainuser.EmailVerificationClaims) bool {
+					return c.UserID == 1 && c.Email == req.Email && c.ExpiresAt.After(time.Now())
+				})).Return("signed", nil)
+				notificationService.On("SendVerificationEmail", mock.Anything, req.Email, "Test User", "signed").Return(nil)
+			},
+		},
+		{
+			name: "unknown email is silent",
+			setupMocks: func(repo *mockUserRepository, signer *mockEmailVerificationSigner, notificationService *mockNotificationService) {
+				repo.On("GetByEmail", mock.Anything, req.Email).Return(nil, domainuser.ErrUserNotFound)
+			},
+		},
+		{
+			name: "verified user is silent",
+			setupMocks: func(repo *mockUserRepository, signer *mockEmailVerificationSigner, notificationService *mockNotificationService) {
+				repo.On("GetByEmail", mock.Anything, req.Email).Return(&domainuser.User{ID: 1, Email: req.Email, EmailVerifiedAt: &verifiedAt}, nil)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &mockUserRepository{}
+			signer := &mockEmailVerificationSigner{}
+			notificationService := &mockNotificationService{}
+			tt.setupMocks(repo, signer, notificationService)
+
+			sender := NewVerificationSender(signer, notificationService, time.Hour)
+			uc := NewResendVerificationUseCase(repo, sender)
+			err := uc.Execute(context.Background(), req)
+
+			assert.NoError(t, err)
+			repo.AssertExpectations(t)
+			signer.AssertExpectations(t)
+			notificationService.AssertExpectations(t)
+		})
+	}
+}
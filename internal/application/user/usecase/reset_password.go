@@ -0,0 +1,92 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/rulzi/hexa-go/internal/application/user/dto"
+	domainuser "github.com/rulzi/hexa-go/internal/domain/user"
+)
+
+// ResetPasswordUseCase handles setting a new password with a reset token
+type ResetPasswordUseCase struct {
+	userRepo       domainuser.Repository
+	resetTokens    domainuser.PasswordResetRepository
+	revokeSessions *RevokeAllSessionsUseCase
+	passwordHasher domainuser.PasswordHasher
+	passwords      *PasswordChecker
+}
+
+// NewResetPasswordUseCase creates a new ResetPasswordUseCase.
+// New passwords are checked against the password policy when a password checker is provided.
+func NewResetPasswordUseCase(
+	userRepo domainuser.Repository,
+	resetTokens domainuser.PasswordResetRepository,
+	revokeSessions *RevokeAllSessionsUseCase,
+	passwordHasher domainuser.PasswordHasher,
+	passwords *PasswordChecker,
+) *ResetPasswordUseCase {
+	return &ResetPasswordUseCase{
+		userRepo:       userRepo,
+		resetTokens:    resetTokens,
+		revokeSessions: revokeSessions,
+		passwordHasher: passwordHasher,
+		passwords:      passwords,
+	}
+}
+
+// Execute executes the reset password use case.
+// Every existing session of the user is signed out afterwards.
+func (uc *ResetPasswordUseCase) Execute(ctx context.Context, req dto.ResetPasswordRequest) error {
+	stored, err := uc.resetTokens.GetByHash(ctx, domainuser.HashToken(req.Token))
+	if err != nil || stored == nil {
+		return domainuser.ErrInvalidResetToken
+	}
+
+	if stored.IsUsed() || stored.IsExpired(time.Now()) {
+		return domainuser.ErrInvalidResetToken
+	}
+
+	existingUser, err := uc.userRepo.GetByID(ctx, 0, stored.UserID)
+	if err != nil {
+		return err
+	}
+
+	// A rejected password leaves the token usable for another attempt
+	if uc.passwords != nil {
+		if err := uc.passwords.Check(ctx, existingUser, req.Password); err != nil {
+			return err
+		}
+	}
+
+	// Consume the token before changing the password so concurrent requests cannot use it twice
+	if err := uc.resetTokens.MarkUsed(ctx, stored.ID); err != nil {
+		return err
+	}
+
+	hashedPassword, err := uc.passwordHasher.Hash(req.Password)
+	if err != nil {
+		return err
+	}
+
+	existingUser.Password = hashedPassword
+	existingUser.UpdatedAt = time.Now()
+
+	if _, err := uc.userRepo.Update(ctx, 0, existingUser); err != nil {
+		return err
+	}
+
+	if uc.passwords != nil {
+		if err := uc.passwords.Remember(ctx, existingUser.ID, hashedPassword); err != nil {
+			return err
+		}
+	}
+
+	// Other reset links sent before this one are no longer valid
+	if err := uc.resetTokens.InvalidateByUser(ctx, existingUser.ID); err != nil {
+		return err
+	}
+
+	// Sign out every existing session, access tokens included
+	return uc.revokeSessions.Execute(ctx, existingUser.ID)
+}
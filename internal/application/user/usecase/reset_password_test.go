@@ -0,0 +1,136 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/rulzi/hexa-go/internal/application/user/dto"
+	domainuser "github.com/rulzi/hexa-go/internal/domain/user"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func newTestResetPasswordUseCase() (*ResetPasswordUseCase, *mockUserRepository, *mockPasswordResetRepository, *mockRefreshTokenRepository, *mockPasswordHasher) {
+	repo := &mockUserRepository{}
+	resetRepo := &mockPasswordResetRepository{}
+	refreshRepo := &mockRefreshTokenRepository{}
+	sessions := &mockSessionRepository{}
+	sessions.On("ListActiveByUser", mock.Anything, mock.Anything).Return([]*domainuser.Session{}, nil)
+	passwordHasher := &mockPasswordHasher{}
+	revokeSessions := NewRevokeAllSessionsUseCase(sessions, refreshRepo, &mockTokenRevocationStore{}, 15*time.Minute)
+	uc := NewResetPasswordUseCase(repo, resetRepo, revokeSessions, passwordHasher, nil)
+	return uc, repo, resetRepo, refreshRepo, passwordHasher
+}
+
+func TestNewResetPasswordUseCase(t *testing.T) {
+	uc, repo, resetRepo, refreshRepo, passwordHasher := newTestResetPasswordUseCase()
+
+	assert.NotNil(t, uc)
+	assert.Equal(t, repo, uc.userRepo)
+	assert.Equal(t, resetRepo, uc.resetTokens)
+	assert.Equal(t, refreshRepo, uc.revokeSessions.refreshTokens)
+	assert.Equal(t, passwordHasher, uc.passwordHasher)
+}
+
+func TestResetPasswordUseCase_Execute_Success(t *testing.T) {
+	ctx := context.Background()
+	uc, repo, resetRepo, refreshRepo, passwordHasher := newTestResetPasswordUseCase()
+
+	req := dto.ResetPasswordRequest{Token: "reset_token", Password: "new_password"}
+	stored := &domainuser.PasswordResetToken{ID: 5, UserID: 1, ExpiresAt: time.Now().Add(time.Hour)}
+	userEntity := &domainuser.User{ID: 1, Name: "Test User", Email: "test@example.com", Password: "old_hash"}
+
+	resetRepo.On("GetByHash", ctx, domainuser.HashToken(req.Token)).Return(stored, nil)
+	resetRepo.On("MarkUsed", ctx, int64(5)).Return(nil)
+	repo.On("GetByID", ctx, int64(0), int64(1)).Return(userEntity, nil)
+	passwordHasher.On("Hash", req.Password).Return("new_hash", nil)
+	repo.On("Update", ctx, int64(0), mock.MatchedBy(func(u *domainuser.User) bool {
+		return u.Password == "new_hash"
+	})).Return(userEntity, nil)
+	resetRepo.On("InvalidateByUser", ctx, int64(1)).Return(nil)
+	refreshRepo.On("RevokeByUser", ctx, int64(1)).Return(nil)
+
+	err := uc.Execute(ctx, req)
+
+	assert.NoError(t, err)
+	repo.AssertExpectations(t)
+	resetRepo.AssertExpectations(t)
+	refreshRepo.AssertExpectations(t)
+	passwordHasher.AssertExpectations(t)
+}
+
+func TestResetPasswordUseCase_Execute_InvalidToken(t *testing.T) {
+	usedAt := time.Now()
+
+	tests := []struct {
+		name   string
+		stored *domainuser.PasswordResetToken
+		err    error
+	}{
+		{name: "unknown token", err: domainuser.ErrInvalidResetToken},
+		{name: "expired token", stored: &domainuser.PasswordResetToken{ID: 5, UserID: 1, ExpiresAt: time.Now().Add(-time.Minute)}},
+		{name: "used token", stored: &domainuser.PasswordResetToken{ID: 5, UserID: 1, ExpiresAt: time.Now().Add(time.Hour), UsedAt: &usedAt}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			uc, repo, resetRepo, refreshRepo, passwordHasher := newTestResetPasswordUseCase()
+
+			req := dto.ResetPasswordRequest{Token: "reset_token", Password: "new_password"}
+			if tt.stored != nil {
+				resetRepo.On("GetByHash", ctx, domainuser.HashToken(req.Token)).Return(tt.stored, nil)
+			} else {
+				resetRepo.On("GetByHash", ctx, domainuser.HashToken(req.Token)).Return(nil, tt.err)
+			}
+
+			err := uc.Execute(ctx, req)
+
+			assert.Equal(t, domainuser.ErrInvalidResetToken, err)
+			resetRepo.AssertNotCalled(t, "MarkUsed", mock.Anything, mock.Anything)
+			repo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything, mock.Anything)
+			passwordHasher.AssertNotCalled(t, "Hash", mock.Anything)
+			refreshRepo.AssertNotCalled(t, "RevokeByUser", mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestResetPasswordUseCase_Execute_TokenConsumedConcurrently(t *testing.T) {
+	ctx := context.Background()
+	uc, repo, resetRepo, _, _ := newTestResetPasswordUseCase()
+
+	req := dto.ResetPasswordRequest{Token: "reset_token", Password: "new_password"}
+	stored := &domainuser.PasswordResetToken{ID: 5, UserID: 1, ExpiresAt: time.Now().Add(time.Hour)}
+
+	resetRepo.On("GetByHash", ctx, domainuser.HashToken(req.Token)).Return(stored, nil)
+	repo.On("GetByID", ctx, int64(0), int64(1)).Return(&domainuser.User{ID: 1}, nil)
+	resetRepo.On("MarkUsed", ctx, int64(5)).Return(domainuser.ErrInvalidResetToken)
+
+	err := uc.Execute(ctx, req)
+
+	assert.Equal(t, domainuser.ErrInvalidResetToken, err)
+	repo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestResetPasswordUseCase_Execute_UpdateError(t *testing.T) {
+	ctx := context.Background()
+	uc, repo, resetRepo, refreshRepo, passwordHasher := newTestResetPasswordUseCase()
+
+	req := dto.ResetPasswordRequest{Token: "reset_token", Password: "new_password"}
+	stored := &domainuser.PasswordResetToken{ID: 5, UserID: 1, ExpiresAt: time.Now().Add(time.Hour)}
+	userEntity := &domainuser.User{ID: 1, Name: "Test User", Email: "test@example.com"}
+	repoErr := errors.New("database error")
+
+	resetRepo.On("GetByHash", ctx, domainuser.HashToken(req.Token)).Return(stored, nil)
+	resetRepo.On("MarkUsed", ctx, int64(5)).Return(nil)
+	repo.On("GetByID", ctx, int64(0), int64(1)).Return(userEntity, nil)
+	passwordHasher.On("Hash", req.Password).Return("new_hash", nil)
+	repo.On("Update", ctx, int64(0), mock.AnythingOfType("*user.User")).Return(nil, repoErr)
+
+	err := uc.Execute(ctx, req)
+
+	assert.Equal(t, repoErr, err)
+	refreshRepo.AssertNotCalled(t, "RevokeByUser", mock.Anything, mock.Anything)
+}
@@ -0,0 +1,50 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/rulzi/hexa-go/internal/application/user/dto"
+	domainuser "github.com/rulzi/hexa-go/internal/domain/user"
+)
+
+// RestoreUserUseCase handles taking a user out of the trash
+type RestoreUserUseCase struct {
+	userRepo      domainuser.Repository
+	organizations domainuser.OrganizationRepository
+}
+
+// NewRestoreUserUseCase creates a new RestoreUserUseCase
+func NewRestoreUserUseCase(userRepo domainuser.Repository, organizations domainuser.OrganizationRepository) *RestoreUserUseCase {
+	return &RestoreUserUseCase{
+		userRepo:      userRepo,
+		organizations: organizations,
+	}
+}
+
+// Execute executes the restore user use case.
+// It returns ErrEmailExists when the email was taken by another account in the meantime.
+func (uc *RestoreUserUseCase) Execute(ctx context.Context, orgID, id int64) (*dto.UserResponse, error) {
+	membership, err := memberOf(ctx, uc.organizations, orgID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = uc.userRepo.Restore(ctx, orgID, id); err != nil {
+		return nil, err
+	}
+
+	u, err := uc.userRepo.GetByID(ctx, orgID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.UserResponse{
+		ID:              u.ID,
+		Name:            u.Name,
+		Email:           u.Email,
+		Role:            string(membership.Role),
+		EmailVerifiedAt: u.EmailVerifiedAt,
+		CreatedAt:       u.CreatedAt,
+		UpdatedAt:       u.UpdatedAt,
+	}, nil
+}
@@ -0,0 +1,55 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	domainuser "github.com/rulzi/hexa-go/internal/domain/user"
+)
+
+// RevokeAllSessionsUseCase handles signing a user out of every session
+type RevokeAllSessionsUseCase struct {
+	sessions      domainuser.SessionRepository
+	refreshTokens domainuser.RefreshTokenRepository
+	revocations   domainuser.TokenRevocationStore
+	accessTTL     time.Duration
+}
+
+// NewRevokeAllSessionsUseCase creates a new RevokeAllSessionsUseCase.
+// accessTTL is the lifetime of access tokens, revoked sessions are remembered that long.
+func NewRevokeAllSessionsUseCase(
+	sessions domainuser.SessionRepository,
+	refreshTokens domainuser.RefreshTokenRepository,
+	revocations domainuser.TokenRevocationStore,
+	accessTTL time.Duration,
+) *RevokeAllSessionsUseCase {
+	return &RevokeAllSessionsUseCase{
+		sessions:      sessions,
+		refreshTokens: refreshTokens,
+		revocations:   revocations,
+		accessTTL:     accessTTL,
+	}
+}
+
+// Execute executes the revoke all sessions use case, including the session of the current request
+func (uc *RevokeAllSessionsUseCase) Execute(ctx context.Context, userID int64) error {
+	sessions, err := uc.sessions.ListActiveByUser(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	// Revoking by user also covers logins from before sessions were recorded
+	if err := uc.refreshTokens.RevokeByUser(ctx, userID); err != nil {
+		return err
+	}
+
+	for _, s := range sessions {
+		err := endSession(ctx, uc.sessions, uc.revocations, uc.accessTTL, s.ID)
+		// A session revoked concurrently is already ended
+		if err != nil && err != domainuser.ErrSessionNotFound {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,25 @@
+package usecase
+
+import (
+	"context"
+
+	domainuser "github.com/rulzi/hexa-go/internal/domain/user"
+)
+
+// RevokeAPIKeyUseCase handles revoking an API key
+type RevokeAPIKeyUseCase struct {
+	apiKeys domainuser.APIKeyRepository
+}
+
+// NewRevokeAPIKeyUseCase creates a new RevokeAPIKeyUseCase
+func NewRevokeAPIKeyUseCase(apiKeys domainuser.APIKeyRepository) *RevokeAPIKeyUseCase {
+	return &RevokeAPIKeyUseCase{
+		apiKeys: apiKeys,
+	}
+}
+
+// Execute executes the revoke API key use case.
+// Users can only revoke their own keys.
+func (uc *RevokeAPIKeyUseCase) Execute(ctx context.Context, userID, id int64) error {
+	return uc.apiKeys.Revoke(ctx, userID, id)
+}
@@ -0,0 +1,67 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	domainuser "github.com/rulzi/hexa-go/internal/domain/user"
+)
+
+// RevokeSessionUseCase handles signing out a single session of a user
+type RevokeSessionUseCase struct {
+	sessions      domainuser.SessionRepository
+	refreshTokens domainuser.RefreshTokenRepository
+	revocations   domainuser.TokenRevocationStore
+	accessTTL     time.Duration
+}
+
+// NewRevokeSessionUseCase creates a new RevokeSessionUseCase.
+// accessTTL is the lifetime of access tokens, revoked sessions are remembered that long.
+func NewRevokeSessionUseCase(
+	sessions domainuser.SessionRepository,
+	refreshTokens domainuser.RefreshTokenRepository,
+	revocations domainuser.TokenRevocationStore,
+	accessTTL time.Duration,
+) *RevokeSessionUseCase {
+	return &RevokeSessionUseCase{
+		sessions:      sessions,
+		refreshTokens: refreshTokens,
+		revocations:   revocations,
+		accessTTL:     accessTTL,
+	}
+}
+
+// Execute executes the revoke session use case.
+// Users can only revoke their own sessions.
+func (uc *RevokeSessionUseCase) Execute(ctx context.Context, userID int64, sessionID string) error {
+	session, err := uc.sessions.GetByID(ctx, sessionID)
+	if err != nil {
+		return err
+	}
+	if session.UserID != userID || !session.IsActive(time.Now()) {
+		return domainuser.ErrSessionNotFound
+	}
+
+	if err := uc.refreshTokens.RevokeFamily(ctx, session.ID); err != nil {
+		return err
+	}
+
+	return endSession(ctx, uc.sessions, uc.revocations, uc.accessTTL, session.ID)
+}
+
+// endSession rejects the access tokens still in flight for a session and marks it revoked.
+// Its refresh tokens must already be revoked so that no new access tokens are issued.
+func endSession(
+	ctx context.Context,
+	sessions domainuser.SessionRepository,
+	revocations domainuser.TokenRevocationStore,
+	accessTTL time.Duration,
+	sessionID string,
+) error {
+	// Access tokens of the session expire within accessTTL, so that is as long as it must be remembered
+	if err := revocations.Revoke(ctx, domainuser.SessionRevocationID(sessionID), time.Now().Add(accessTTL)); err != nil {
+		return err
+	}
+
+	return sessions.Revoke(ctx, sessionID)
+}
@@ -0,0 +1,221 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/rulzi/hexa-go/internal/application/user/dto"
+	domainuser "github.com/rulzi/hexa-go/internal/domain/user"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestLoginUseCase_Execute_RecordsSession(t *testing.T) {
+	ctx := context.Background()
+	repo := &mockUserRepository{}
+	passwordHasher := &mockPasswordHasher{}
+	tokenGen := &mockTokenGenerator{}
+	refreshRepo := &mockRefreshTokenRepository{}
+	sessions := &mockSessionRepository{}
+
+	uc := NewLoginUseCase(repo, passwordHasher, NewTokenIssuer(tokenGen, refreshRepo, sessions, nil, time.Hour), domainuser.VerificationPolicyNone, nil, nil, nil)
+
+	req := dto.LoginRequest{
+		Email:     "test@example.com",
+		Password:  "password123",
+		IPAddress: "10.0.0.1",
+		UserAgent: "Mozilla/5.0",
+	}
+	userEntity := &domainuser.User{ID: 1, Email: req.Email, Password: "hashed_password"}
+
+	repo.On("GetByEmail", ctx, req.Email).Return(userEntity, nil)
+	passwordHasher.On("Verify", userEntity.Password, req.Password).Return(true)
+	passwordHasher.On("NeedsRehash", userEntity.Password).Return(false)
+	tokenGen.On("Generate", mock.Anything).Return("jwt_token_123", nil)
+	refreshRepo.On("Create", ctx, mock.Anything).Return(&domainuser.RefreshToken{ID: 1}, nil)
+	sessions.On("Create", ctx, mock.MatchedBy(func(s *domainuser.Session) bool {
+		return s.UserID == 1 && s.IPAddress == "10.0.0.1" && s.UserAgent == "Mozilla/5.0"
+	})).Return(nil)
+
+	result, err := uc.Execute(ctx, req)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "jwt_token_123", result.Token)
+	sessions.AssertExpectations(t)
+}
+
+func TestListSessionsUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+	sessions := &mockSessionRepository{}
+	uc := NewListSessionsUseCase(sessions)
+
+	now := time.Now()
+	sessions.On("ListActiveByUser", ctx, int64(1)).Return([]*domainuser.Session{
+		{ID: "session-2", UserID: 1, UserAgent: "curl/8.0", IPAddress: "10.0.0.2", CreatedAt: now, LastSeenAt: now},
+		{ID: "session-1", UserID: 1, UserAgent: "Mozilla/5.0", IPAddress: "10.0.0.1", CreatedAt: now, LastSeenAt: now},
+	}, nil)
+
+	result, err := uc.Execute(ctx, 1, "session-1")
+
+	assert.NoError(t, err)
+	assert.Len(t, result, 2)
+	assert.Equal(t, "session-2", result[0].ID)
+	assert.Equal(t, "curl/8.0", result[0].UserAgent)
+	assert.False(t, result[0].Current)
+	assert.True(t, result[1].Current)
+}
+
+func TestListSessionsUseCase_Execute_Empty(t *testing.T) {
+	ctx := context.Background()
+	sessions := &mockSessionRepository{}
+	uc := NewListSessionsUseCase(sessions)
+
+	sessions.On("ListActiveByUser", ctx, int64(1)).Return([]*domainuser.Session{}, nil)
+
+	result, err := uc.Execute(ctx, 1, "")
+
+	assert.NoError(t, err)
+	assert.NotNil(t, result)
+	assert.Empty(t, result)
+}
+
+func newTestRevokeSessionUseCase() (*RevokeSessionUseCase, *mockSessionRepository, *mockRefreshTokenRepository, *mockTokenRevocationStore) {
+	sessions := &mockSessionRepository{}
+	refreshRepo := &mockRefreshTokenRepository{}
+	revocations := &mockTokenRevocationStore{}
+	uc := NewRevokeSessionUseCase(sessions, refreshRepo, revocations, 15*time.Minute)
+	return uc, sessions, refreshRepo, revocations
+}
+
+func TestRevokeSessionUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+	uc, sessions, refreshRepo, revocations := newTestRevokeSessionUseCase()
+
+	sessions.On("GetByID", ctx, "session-1").Return(&domainuser.Session{
+		ID:        "session-1",
+		UserID:    1,
+		ExpiresAt: time.Now().Add(time.Hour),
+	}, nil)
+	refreshRepo.On("RevokeFamily", ctx, "session-1").Return(nil)
+	revocations.On("Revoke", ctx, "session:session-1", mock.MatchedBy(func(until time.Time) bool {
+		remaining := time.Until(until)
+		return remaining > 14*time.Minute && remaining <= 15*time.Minute
+	})).Return(nil)
+	sessions.On("Revoke", ctx, "session-1").Return(nil)
+
+	err := uc.Execute(ctx, 1, "session-1")
+
+	assert.NoError(t, err)
+	sessions.AssertExpectations(t)
+	refreshRepo.AssertExpectations(t)
+	revocations.AssertExpectations(t)
+}
+
+func TestRevokeSessionUseCase_Execute_NotRevocable(t *testing.T) {
+	revokedAt := time.Now()
+
+	tests := []struct {
+		name    string
+		session *domainuser.Session
+		err     error
+	}{
+		{
+			name: "unknown session",
+			err:  domainuser.ErrSessionNotFound,
+		},
+		{
+			name:    "session of another user",
+			session: &domainuser.Session{ID: "session-1", UserID: 2, ExpiresAt: time.Now().Add(time.Hour)},
+		},
+		{
+			name:    "already revoked",
+			session: &domainuser.Session{ID: "session-1", UserID: 1, ExpiresAt: time.Now().Add(time.Hour), RevokedAt: &revokedAt},
+		},
+		{
+			name:    "expired session",
+			session: &domainuser.Session{ID: "session-1", UserID: 1, ExpiresAt: time.Now().Add(-time.Minute)},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			uc, sessions, refreshRepo, revocations := newTestRevokeSessionUseCase()
+
+			if tt.session != nil {
+				sessions.On("GetByID", ctx, "session-1").Return(tt.session, nil)
+			} else {
+				sessions.On("GetByID", ctx, "session-1").Return(nil, tt.err)
+			}
+
+			err := uc.Execute(ctx, 1, "session-1")
+
+			assert.Equal(t, domainuser.ErrSessionNotFound, err)
+			refreshRepo.AssertNotCalled(t, "RevokeFamily", mock.Anything, mock.Anything)
+			revocations.AssertNotCalled(t, "Revoke", mock.Anything, mock.Anything, mock.Anything)
+			sessions.AssertNotCalled(t, "Revoke", mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestRevokeSessionUseCase_Execute_RevocationError(t *testing.T) {
+	ctx := context.Background()
+	uc, sessions, refreshRepo, revocations := newTestRevokeSessionUseCase()
+
+	storeErr := errors.New("redis error")
+	sessions.On("GetByID", ctx, "session-1").Return(&domainuser.Session{
+		ID:        "session-1",
+		UserID:    1,
+		ExpiresAt: time.Now().Add(time.Hour),
+	}, nil)
+	refreshRepo.On("RevokeFamily", ctx, "session-1").Return(nil)
+	revocations.On("Revoke", ctx, "session:session-1", mock.Anything).Return(storeErr)
+
+	err := uc.Execute(ctx, 1, "session-1")
+
+	assert.Equal(t, storeErr, err)
+	sessions.AssertNotCalled(t, "Revoke", mock.Anything, mock.Anything)
+}
+
+func TestRevokeAllSessionsUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+	sessions := &mockSessionRepository{}
+	refreshRepo := &mockRefreshTokenRepository{}
+	revocations := &mockTokenRevocationStore{}
+	uc := NewRevokeAllSessionsUseCase(sessions, refreshRepo, revocations, 15*time.Minute)
+
+	sessions.On("ListActiveByUser", ctx, int64(1)).Return([]*domainuser.Session{
+		{ID: "session-1", UserID: 1},
+		{ID: "session-2", UserID: 1},
+	}, nil)
+	refreshRepo.On("RevokeByUser", ctx, int64(1)).Return(nil)
+	revocations.On("Revoke", ctx, "session:session-1", mock.Anything).Return(nil)
+	revocations.On("Revoke", ctx, "session:session-2", mock.Anything).Return(nil)
+	sessions.On("Revoke", ctx, "session-1").Return(nil)
+	// Revoked by a concurrent request in the meantime
+	sessions.On("Revoke", ctx, "session-2").Return(domainuser.ErrSessionNotFound)
+
+	err := uc.Execute(ctx, 1)
+
+	assert.NoError(t, err)
+	sessions.AssertExpectations(t)
+	refreshRepo.AssertExpectations(t)
+	revocations.AssertExpectations(t)
+}
+
+func TestRevokeAllSessionsUseCase_Execute_ListError(t *testing.T) {
+	ctx := context.Background()
+	sessions := &mockSessionRepository{}
+	refreshRepo := &mockRefreshTokenRepository{}
+	uc := NewRevokeAllSessionsUseCase(sessions, refreshRepo, &mockTokenRevocationStore{}, 15*time.Minute)
+
+	dbErr := errors.New("database error")
+	sessions.On("ListActiveByUser", ctx, int64(1)).Return(nil, dbErr)
+
+	err := uc.Execute(ctx, 1)
+
+	assert.Equal(t, dbErr, err)
+	refreshRepo.AssertNotCalled(t, "RevokeByUser", mock.Anything, mock.Anything)
+}
@@ -0,0 +1,61 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	domainuser "github.com/rulzi/hexa-go/internal/domain/user"
+)
+
+// StartIdentityLoginUseCase handles starting a sign-in with the identity provider
+type StartIdentityLoginUseCase struct {
+	provider domainuser.IdentityProvider
+	states   domainuser.AuthorizationStateStore
+	stateTTL time.Duration
+}
+
+// NewStartIdentityLoginUseCase creates a new StartIdentityLoginUseCase
+func NewStartIdentityLoginUseCase(
+	provider domainuser.IdentityProvider,
+	states domainuser.AuthorizationStateStore,
+	stateTTL time.Duration,
+) *StartIdentityLoginUseCase {
+	return &StartIdentityLoginUseCase{
+		provider: provider,
+		states:   states,
+		stateTTL: stateTTL,
+	}
+}
+
+// Execute returns the URL of the identity provider the user signs in at and the state of the sign-in,
+// which the caller binds to the browser so a callback is only accepted from the browser that started it.
+// The PKCE code verifier and the nonce stay on the server until the callback.
+func (uc *StartIdentityLoginUseCase) Execute(ctx context.Context) (authURL, state string, err error) {
+	state, err = domainuser.GenerateSecureToken(32)
+	if err != nil {
+		return "", "", err
+	}
+	nonce, err := domainuser.GenerateSecureToken(16)
+	if err != nil {
+		return "", "", err
+	}
+	codeVerifier, err := domainuser.GenerateSecureToken(32)
+	if err != nil {
+		return "", "", err
+	}
+
+	authURL, err = uc.provider.AuthorizationURL(ctx, state, nonce, codeVerifier)
+	if err != nil {
+		return "", "", err
+	}
+
+	err = uc.states.Save(ctx, domainuser.HashToken(state), domainuser.AuthorizationState{
+		CodeVerifier: codeVerifier,
+		Nonce:        nonce,
+	}, uc.stateTTL)
+	if err != nil {
+		return "", "", err
+	}
+
+	return authURL, state, nil
+}
@@ -0,0 +1,43 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	"github.com/rulzi/hexa-go/internal/application/user/dto"
+	domainuser "github.com/rulzi/hexa-go/internal/domain/user"
+)
+
+// SwitchOrganizationUseCase handles moving a signed in user to another of their organizations
+type SwitchOrganizationUseCase struct {
+	userRepo    domainuser.Repository
+	tokenIssuer *TokenIssuer
+}
+
+// NewSwitchOrganizationUseCase creates a new SwitchOrganizationUseCase
+func NewSwitchOrganizationUseCase(userRepo domainuser.Repository, tokenIssuer *TokenIssuer) *SwitchOrganizationUseCase {
+	return &SwitchOrganizationUseCase{
+		userRepo:    userRepo,
+		tokenIssuer: tokenIssuer,
+	}
+}
+
+// Execute executes the switch organization use case.
+// The new token pair continues the session of the request and acts in orgID.
+// Organizations the user does not belong to are reported as not found.
+func (uc *SwitchOrganizationUseCase) Execute(ctx context.Context, userID, orgID int64, sessionID string) (*dto.TokenResponse, error) {
+	u, err := uc.userRepo.GetByID(ctx, 0, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	tokens, err := uc.tokenIssuer.Issue(ctx, u, sessionID, orgID)
+	if errors.Is(err, domainuser.ErrNotMember) {
+		return nil, domainuser.ErrOrganizationNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return tokens, nil
+}
@@ -0,0 +1,178 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/rulzi/hexa-go/internal/application/user/dto"
+	domainuser "github.com/rulzi/hexa-go/internal/domain/user"
+)
+
+// maxSessionUserAgentLength is the longest user agent stored with a session
+const maxSessionUserAgentLength = 255
+
+// TokenIssuer issues access and refresh token pairs
+type TokenIssuer struct {
+	tokenGen      domainuser.TokenGenerator
+	refreshTokens domainuser.RefreshTokenRepository
+	sessions      domainuser.SessionRepository
+	organizations domainuser.OrganizationRepository
+	refreshTTL    time.Duration
+}
+
+// NewTokenIssuer creates a new TokenIssuer.
+// Logins are recorded as sessions when a session repository is provided.
+// Tokens act in an organization, with the role of the user there, when an organization repository is provided.
+func NewTokenIssuer(
+	tokenGen domainuser.TokenGenerator,
+	refreshTokens domainuser.RefreshTokenRepository,
+	sessions domainuser.SessionRepository,
+	organizations domainuser.OrganizationRepository,
+	refreshTTL time.Duration,
+) *TokenIssuer {
+	return &TokenIssuer{
+		tokenGen:      tokenGen,
+		refreshTokens: refreshTokens,
+		sessions:      sessions,
+		organizations: organizations,
+		refreshTTL:    refreshTTL,
+	}
+}
+
+// StartSession issues the first token pair of a login and records the login as a session.
+// The session ID is the refresh token family of the login.
+// The tokens act in the organization the user joined first.
+func (i *TokenIssuer) StartSession(ctx context.Context, u *domainuser.User, userAgent, ipAddress string) (*dto.TokenResponse, error) {
+	return i.startSession(ctx, u, 0, userAgent, ipAddress)
+}
+
+// startSession starts a session acting in orgID, zero picks the organization the user joined first
+func (i *TokenIssuer) startSession(ctx context.Context, u *domainuser.User, orgID int64, userAgent, ipAddress string) (*dto.TokenResponse, error) {
+	sessionID, err := domainuser.GenerateSecureToken(16)
+	if err != nil {
+		return nil, err
+	}
+
+	tokens, err := i.issue(ctx, u, sessionID, orgID)
+	if err != nil {
+		return nil, err
+	}
+
+	if i.sessions != nil {
+		if len(userAgent) > maxSessionUserAgentLength {
+			userAgent = userAgent[:maxSessionUserAgentLength]
+		}
+
+		now := time.Now()
+		err = i.sessions.Create(ctx, &domainuser.Session{
+			ID:         sessionID,
+			UserID:     u.ID,
+			UserAgent:  userAgent,
+			IPAddress:  ipAddress,
+			CreatedAt:  now,
+			LastSeenAt: now,
+			ExpiresAt:  now.Add(i.refreshTTL),
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return tokens, nil
+}
+
+// Issue issues a new access token and refresh token for the user acting in orgID,
+// zero picks the organization the user joined first.
+// An empty familyID starts a new refresh token family, continuing a family
+// counts as activity on its session.
+func (i *TokenIssuer) Issue(ctx context.Context, u *domainuser.User, familyID string, orgID int64) (*dto.TokenResponse, error) {
+	if familyID == "" {
+		return i.startSession(ctx, u, orgID, "", "")
+	}
+
+	tokens, err := i.issue(ctx, u, familyID, orgID)
+	if err != nil {
+		return nil, err
+	}
+
+	if i.sessions != nil {
+		// Activity tracking is best effort and never fails the refresh
+		now := time.Now()
+		_ = i.sessions.Touch(ctx, familyID, now, now.Add(i.refreshTTL))
+	}
+
+	return tokens, nil
+}
+
+// issue generates the token pair of a refresh token family
+func (i *TokenIssuer) issue(ctx context.Context, u *domainuser.User, familyID string, orgID int64) (*dto.TokenResponse, error) {
+	claims := domainuser.TokenClaims{
+		UserID:        u.ID,
+		Email:         u.Email,
+		Role:          u.Role,
+		EmailVerified: u.IsEmailVerified(),
+	}
+	if i.sessions != nil {
+		claims.SessionID = familyID
+	}
+	if i.organizations != nil {
+		membership, err := i.membership(ctx, u.ID, orgID)
+		if err != nil {
+			return nil, err
+		}
+		claims.OrgID = membership.OrgID
+		claims.Role = membership.Role
+	}
+
+	// Generate access token
+	accessToken, err := i.tokenGen.Generate(claims)
+	if err != nil {
+		return nil, err
+	}
+
+	// Generate refresh token, only its hash is persisted
+	refreshToken, err := domainuser.GenerateSecureToken(32)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	_, err = i.refreshTokens.Create(ctx, &domainuser.RefreshToken{
+		UserID:    u.ID,
+		FamilyID:  familyID,
+		OrgID:     claims.OrgID,
+		TokenHash: domainuser.HashToken(refreshToken),
+		ExpiresAt: now.Add(i.refreshTTL),
+		CreatedAt: now,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.TokenResponse{
+		Token:        accessToken,
+		RefreshToken: refreshToken,
+	}, nil
+}
+
+// membership returns the membership the tokens act with, in orgID or in the organization the user joined first
+func (i *TokenIssuer) membership(ctx context.Context, userID, orgID int64) (*domainuser.Membership, error) {
+	if orgID != 0 {
+		return i.organizations.GetMembership(ctx, orgID, userID)
+	}
+
+	memberships, err := i.organizations.ListByUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if len(memberships) == 0 {
+		return nil, domainuser.ErrNoOrganization
+	}
+
+	return &domainuser.Membership{
+		OrgID:     memberships[0].Organization.ID,
+		UserID:    userID,
+		Role:      memberships[0].Role,
+		CreatedAt: memberships[0].JoinedAt,
+	}, nil
+}
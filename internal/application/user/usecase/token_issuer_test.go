@@ -0,0 +1,261 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	domainuser "github.com/rulzi/hexa-go/internal/domain/user"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestNewTokenIssuer(t *testing.T) {
+	tokenGen := &mockTokenGenerator{}
+	refreshRepo := &mockRefreshTokenRepository{}
+
+	sessions := &mockSessionRepository{}
+
+	issuer := NewTokenIssuer(tokenGen, refreshRepo, sessions, nil, time.Hour)
+
+	assert.NotNil(t, issuer)
+	assert.Equal(t, tokenGen, issuer.tokenGen)
+	assert.Equal(t, refreshRepo, issuer.refreshTokens)
+	assert.Equal(t, sessions, issuer.sessions)
+	assert.Equal(t, time.Hour, issuer.refreshTTL)
+}
+
+func TestTokenIssuer_Issue_NewFamily(t *testing.T) {
+	ctx := context.Background()
+	tokenGen := &mockTokenGenerator{}
+	refreshRepo := &mockRefreshTokenRepository{}
+	issuer := NewTokenIssuer(tokenGen, refreshRepo, nil, nil, time.Hour)
+
+	userEntity := &domainuser.User{ID: 1, Email: "test@example.com"}
+
+	tokenGen.On("Generate", domainuser.TokenClaims{UserID: 1, Email: "test@example.com"}).Return("access_token", nil)
+	refreshRepo.On("Create", ctx, mock.MatchedBy(func(rt *domainuser.RefreshToken) bool {
+		return rt.UserID == 1 && rt.FamilyID != "" &&
+			rt.ExpiresAt.Sub(rt.CreatedAt) == time.Hour
+	})).Return(&domainuser.RefreshToken{ID: 1}, nil)
+
+	result, err := issuer.Issue(ctx, userEntity, "", 0)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "access_token", result.Token)
+	assert.NotEmpty(t, result.RefreshToken)
+	stored := refreshRepo.Calls[0].Arguments.Get(1).(*domainuser.RefreshToken)
+	assert.Equal(t, domainuser.HashToken(result.RefreshToken), stored.TokenHash)
+	tokenGen.AssertExpectations(t)
+	refreshRepo.AssertExpectations(t)
+}
+
+func TestTokenIssuer_Issue_ExistingFamily(t *testing.T) {
+	ctx := context.Background()
+	tokenGen := &mockTokenGenerator{}
+	refreshRepo := &mockRefreshTokenRepository{}
+	issuer := NewTokenIssuer(tokenGen, refreshRepo, nil, nil, time.Hour)
+
+	userEntity := &domainuser.User{ID: 1, Email: "test@example.com"}
+
+	tokenGen.On("Generate", domainuser.TokenClaims{UserID: 1, Email: "test@example.com"}).Return("access_token", nil)
+	refreshRepo.On("Create", ctx, mock.MatchedBy(func(rt *domainuser.RefreshToken) bool {
+		return rt.FamilyID == "family-1"
+	})).Return(&domainuser.RefreshToken{ID: 2}, nil)
+
+	result, err := issuer.Issue(ctx, userEntity, "family-1", 0)
+
+	assert.NoError(t, err)
+	assert.NotNil(t, result)
+	refreshRepo.AssertExpectations(t)
+}
+
+func TestTokenIssuer_Issue_TokenGenerationError(t *testing.T) {
+	ctx := context.Background()
+	tokenGen := &mockTokenGenerator{}
+	refreshRepo := &mockRefreshTokenRepository{}
+	issuer := NewTokenIssuer(tokenGen, refreshRepo, nil, nil, time.Hour)
+
+	tokenErr := errors.New("token generation error")
+	tokenGen.On("Generate", domainuser.TokenClaims{UserID: 1, Email: "test@example.com"}).Return("", tokenErr)
+
+	result, err := issuer.Issue(ctx, &domainuser.User{ID: 1, Email: "test@example.com"}, "", 0)
+
+	assert.Equal(t, tokenErr, err)
+	assert.Nil(t, result)
+	refreshRepo.AssertNotCalled(t, "Create")
+}
+
+func TestTokenIssuer_Issue_RepositoryError(t *testing.T) {
+	ctx := context.Background()
+	tokenGen := &mockTokenGenerator{}
+	refreshRepo := &mockRefreshTokenRepository{}
+	issuer := NewTokenIssuer(tokenGen, refreshRepo, nil, nil, time.Hour)
+
+	repoErr := errors.New("database error")
+	tokenGen.On("Generate", domainuser.TokenClaims{UserID: 1, Email: "test@example.com"}).Return("access_token", nil)
+	refreshRepo.On("Create", ctx, mock.Anything).Return(nil, repoErr)
+
+	result, err := issuer.Issue(ctx, &domainuser.User{ID: 1, Email: "test@example.com"}, "", 0)
+
+	assert.Equal(t, repoErr, err)
+	assert.Nil(t, result)
+}
+
+func TestTokenIssuer_StartSession(t *testing.T) {
+	ctx := context.Background()
+	tokenGen := &mockTokenGenerator{}
+	refreshRepo := &mockRefreshTokenRepository{}
+	sessions := &mockSessionRepository{}
+	issuer := NewTokenIssuer(tokenGen, refreshRepo, sessions, nil, time.Hour)
+
+	userEntity := &domainuser.User{ID: 1, Email: "test@example.com"}
+
+	tokenGen.On("Generate", mock.MatchedBy(func(claims domainuser.TokenClaims) bool {
+		return claims.UserID == 1 && claims.SessionID != ""
+	})).Return("access_token", nil)
+	refreshRepo.On("Create", ctx, mock.Anything).Return(&domainuser.RefreshToken{ID: 1}, nil)
+	sessions.On("Create", ctx, mock.MatchedBy(func(s *domainuser.Session) bool {
+		return s.UserID == 1 && s.IPAddress == "10.0.0.1" && len(s.UserAgent) == maxSessionUserAgentLength &&
+			s.LastSeenAt.Equal(s.CreatedAt) && s.ExpiresAt.Sub(s.CreatedAt) == time.Hour
+	})).Return(nil)
+
+	result, err := issuer.StartSession(ctx, userEntity, strings.Repeat("a", 300), "10.0.0.1")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "access_token", result.Token)
+
+	// The session ID is carried in the access token and names the refresh token family
+	claims := tokenGen.Calls[0].Arguments.Get(0).(domainuser.TokenClaims)
+	stored := refreshRepo.Calls[0].Arguments.Get(1).(*domainuser.RefreshToken)
+	session := sessions.Calls[0].Arguments.Get(1).(*domainuser.Session)
+	assert.Equal(t, session.ID, claims.SessionID)
+	assert.Equal(t, session.ID, stored.FamilyID)
+	sessions.AssertExpectations(t)
+}
+
+func TestTokenIssuer_StartSession_SessionError(t *testing.T) {
+	ctx := context.Background()
+	tokenGen := &mockTokenGenerator{}
+	refreshRepo := &mockRefreshTokenRepository{}
+	sessions := &mockSessionRepository{}
+	issuer := NewTokenIssuer(tokenGen, refreshRepo, sessions, nil, time.Hour)
+
+	dbErr := errors.New("database error")
+	tokenGen.On("Generate", mock.Anything).Return("access_token", nil)
+	refreshRepo.On("Create", ctx, mock.Anything).Return(&domainuser.RefreshToken{ID: 1}, nil)
+	sessions.On("Create", ctx, mock.Anything).Return(dbErr)
+
+	result, err := issuer.StartSession(ctx, &domainuser.User{ID: 1}, "Mozilla/5.0", "10.0.0.1")
+
+	assert.Equal(t, dbErr, err)
+	assert.Nil(t, result)
+}
+
+func TestTokenIssuer_Issue_TouchesSession(t *testing.T) {
+	ctx := context.Background()
+	tokenGen := &mockTokenGenerator{}
+	refreshRepo := &mockRefreshTokenRepository{}
+	sessions := &mockSessionRepository{}
+	issuer := NewTokenIssuer(tokenGen, refreshRepo, sessions, nil, time.Hour)
+
+	tokenGen.On("Generate", domainuser.TokenClaims{UserID: 1, Email: "test@example.com", SessionID: "family-1"}).Return("access_token", nil)
+	refreshRepo.On("Create", ctx, mock.Anything).Return(&domainuser.RefreshToken{ID: 2}, nil)
+	sessions.On("Touch", ctx, "family-1", mock.Anything, mock.Anything).Return(errors.New("database error"))
+
+	result, err := issuer.Issue(ctx, &domainuser.User{ID: 1, Email: "test@example.com"}, "family-1", 0)
+
+	// Activity tracking failures do not fail the refresh
+	assert.NoError(t, err)
+	assert.NotNil(t, result)
+	lastSeenAt := sessions.Calls[0].Arguments.Get(2).(time.Time)
+	expiresAt := sessions.Calls[0].Arguments.Get(3).(time.Time)
+	assert.Equal(t, time.Hour, expiresAt.Sub(lastSeenAt))
+	sessions.AssertExpectations(t)
+}
+
+func TestTokenIssuer_Issue_Organization(t *testing.T) {
+	u := &domainuser.User{ID: 1, Email: "test@example.com", Role: domainuser.RoleReader}
+	now := time.Now()
+
+	tests := []struct {
+		name     string
+		orgID    int64
+		setup    func(orgs *mockOrganizationRepository)
+		wantOrg  int64
+		wantRole domainuser.Role
+		wantErr  error
+	}{
+		{
+			name:  "organization joined first",
+			orgID: 0,
+			setup: func(orgs *mockOrganizationRepository) {
+				orgs.On("ListByUser", mock.Anything, int64(1)).Return([]*domainuser.OrganizationMembership{
+					{Organization: &domainuser.Organization{ID: 3}, Role: domainuser.RoleEditor, JoinedAt: now},
+					{Organization: &domainuser.Organization{ID: 4}, Role: domainuser.RoleAdmin, JoinedAt: now},
+				}, nil)
+			},
+			wantOrg:  3,
+			wantRole: domainuser.RoleEditor,
+		},
+		{
+			name:  "explicit organization",
+			orgID: 4,
+			setup: func(orgs *mockOrganizationRepository) {
+				orgs.On("GetMembership", mock.Anything, int64(4), int64(1)).Return(&domainuser.Membership{OrgID: 4, UserID: 1, Role: domainuser.RoleAdmin}, nil)
+			},
+			wantOrg:  4,
+			wantRole: domainuser.RoleAdmin,
+		},
+		{
+			name:  "not a member",
+			orgID: 5,
+			setup: func(orgs *mockOrganizationRepository) {
+				orgs.On("GetMembership", mock.Anything, int64(5), int64(1)).Return(nil, domainuser.ErrNotMember)
+			},
+			wantErr: domainuser.ErrNotMember,
+		},
+		{
+			name:  "no organization at all",
+			orgID: 0,
+			setup: func(orgs *mockOrganizationRepository) {
+				orgs.On("ListByUser", mock.Anything, int64(1)).Return([]*domainuser.OrganizationMembership{}, nil)
+			},
+			wantErr: domainuser.ErrNoOrganization,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			tokenGen := &mockTokenGenerator{}
+			refreshRepo := &mockRefreshTokenRepository{}
+			orgs := &mockOrganizationRepository{}
+			tt.setup(orgs)
+
+			tokenGen.On("Generate", mock.MatchedBy(func(claims domainuser.TokenClaims) bool {
+				return claims.OrgID == tt.wantOrg && claims.Role == tt.wantRole
+			})).Return("access_token", nil)
+			refreshRepo.On("Create", ctx, mock.MatchedBy(func(rt *domainuser.RefreshToken) bool {
+				return rt.OrgID == tt.wantOrg
+			})).Return(&domainuser.RefreshToken{ID: 1}, nil)
+
+			issuer := NewTokenIssuer(tokenGen, refreshRepo, nil, orgs, time.Hour)
+			result, err := issuer.Issue(ctx, u, "family-1", tt.orgID)
+
+			if tt.wantErr != nil {
+				assert.Equal(t, tt.wantErr, err)
+				assert.Nil(t, result)
+				tokenGen.AssertNotCalled(t, "Generate", mock.Anything)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, "access_token", result.Token)
+			tokenGen.AssertExpectations(t)
+			refreshRepo.AssertExpectations(t)
+		})
+	}
+}
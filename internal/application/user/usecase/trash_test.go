@@ -0,0 +1,103 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	domainuser "github.com/rulzi/hexa-go/internal/domain/user"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListDeletedUsersUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+	repo := &mockUserRepository{}
+	uc := NewListDeletedUsersUseCase(repo)
+
+	deletedAt := time.Now()
+	repo.On("ListDeleted", ctx, int64(1), 10, 0).Return([]*domainuser.User{
+		{ID: 7, Name: "John Doe", Email: "john@example.com", Role: domainuser.RoleAuthor, DeletedAt: &deletedAt},
+	}, nil)
+	repo.On("CountDeleted", ctx, int64(1)).Return(int64(1), nil)
+
+	result, err := uc.Execute(ctx, 1, 0, -1)
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), result.Total)
+	assert.Equal(t, 10, result.Limit)
+	assert.Equal(t, 0, result.Offset)
+	if assert.Len(t, result.Users, 1) {
+		assert.Equal(t, int64(7), result.Users[0].ID)
+		assert.Equal(t, &deletedAt, result.Users[0].DeletedAt)
+	}
+	repo.AssertExpectations(t)
+}
+
+func TestRestoreUserUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+	repo := &mockUserRepository{}
+	uc := NewRestoreUserUseCase(repo, newMemberOrganizations(domainuser.RoleEditor))
+
+	repo.On("Restore", ctx, int64(1), int64(7)).Return(nil)
+	repo.On("GetByID", ctx, int64(1), int64(7)).Return(&domainuser.User{ID: 7, Name: "John Doe", Email: "john@example.com"}, nil)
+
+	result, err := uc.Execute(ctx, 1, 7)
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(7), result.ID)
+	assert.Equal(t, "editor", result.Role)
+	assert.Nil(t, result.DeletedAt)
+	repo.AssertExpectations(t)
+}
+
+func TestRestoreUserUseCase_Execute_NotInTrash(t *testing.T) {
+	ctx := context.Background()
+	repo := &mockUserRepository{}
+	uc := NewRestoreUserUseCase(repo, newMemberOrganizations(domainuser.RoleEditor))
+
+	repo.On("Restore", ctx, int64(1), int64(7)).Return(domainuser.ErrUserNotFound)
+
+	result, err := uc.Execute(ctx, 1, 7)
+
+	assert.Nil(t, result)
+	assert.Equal(t, domainuser.ErrUserNotFound, err)
+	repo.AssertNotCalled(t, "GetByID")
+}
+
+func TestRestoreUserUseCase_Execute_OtherOrganization(t *testing.T) {
+	ctx := context.Background()
+	repo := &mockUserRepository{}
+	orgs := &mockOrganizationRepository{}
+	uc := NewRestoreUserUseCase(repo, orgs)
+
+	orgs.On("GetMembership", ctx, int64(2), int64(7)).Return(nil, domainuser.ErrNotMember)
+
+	result, err := uc.Execute(ctx, 2, 7)
+
+	assert.Nil(t, result)
+	assert.Equal(t, domainuser.ErrUserNotFound, err)
+	repo.AssertNotCalled(t, "Restore")
+}
+
+func TestPurgeUsersUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+	repo := &mockUserRepository{}
+	revokeSessions := newTestRevokeSessions()
+	uc := NewPurgeUsersUseCase(repo, revokeSessions)
+
+	before := time.Now().AddDate(0, 0, -30)
+	repo.On("ListDeletedIDsBefore", ctx, before, purgeBatchSize).Return([]int64{3, 5, 8, 9}, nil)
+	repo.On("Purge", ctx, int64(3)).Return(nil)
+	repo.On("Purge", ctx, int64(5)).Return(domainuser.ErrUserNotFound)
+	repo.On("Purge", ctx, int64(8)).Return(nil)
+	repo.On("Purge", ctx, int64(9)).Return(domainuser.ErrUserHasArticles)
+
+	purged, err := uc.Execute(ctx, before)
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), purged)
+	repo.AssertExpectations(t)
+	refreshRepo := revokeSessions.refreshTokens.(*mockRefreshTokenRepository)
+	refreshRepo.AssertCalled(t, "RevokeByUser", ctx, int64(3))
+	refreshRepo.AssertCalled(t, "RevokeByUser", ctx, int64(8))
+}
@@ -0,0 +1,151 @@
+package usecase
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	domainuser "github.com/rulzi/hexa-go/internal/domain/user"
+)
+
+// TwoFactorChallenger issues login challenges to users with two-factor authentication
+type TwoFactorChallenger struct {
+	twoFactors   domainuser.TwoFactorRepository
+	challenges   domainuser.TwoFactorChallengeStore
+	challengeTTL time.Duration
+}
+
+// NewTwoFactorChallenger creates a new TwoFactorChallenger
+func NewTwoFactorChallenger(
+	twoFactors domainuser.TwoFactorRepository,
+	challenges domainuser.TwoFactorChallengeStore,
+	challengeTTL time.Duration,
+) *TwoFactorChallenger {
+	return &TwoFactorChallenger{
+		twoFactors:   twoFactors,
+		challenges:   challenges,
+		challengeTTL: challengeTTL,
+	}
+}
+
+// Required reports whether the user has confirmed two-factor authentication
+func (c *TwoFactorChallenger) Required(ctx context.Context, userID int64) (bool, error) {
+	twoFactor, err := c.twoFactors.GetByUser(ctx, userID)
+	if err == domainuser.ErrTwoFactorNotEnrolled {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	return twoFactor.IsEnabled(), nil
+}
+
+// Issue creates a challenge token for the user, only its hash is stored
+func (c *TwoFactorChallenger) Issue(ctx context.Context, userID int64) (string, error) {
+	token, err := domainuser.GenerateSecureToken(32)
+	if err != nil {
+		return "", err
+	}
+
+	if err := c.challenges.Save(ctx, domainuser.HashToken(token), userID, c.challengeTTL); err != nil {
+		return "", err
+	}
+
+	return token, nil
+}
+
+// checkThrottledTwoFactorCode is checkTwoFactorCode for signed-in users.
+// Wrong codes lock further checks of the user like wrong passwords lock logins,
+// so a stolen session can't guess codes to turn two-factor authentication off.
+func checkThrottledTwoFactorCode(
+	ctx context.Context,
+	throttler *LoginThrottler,
+	twoFactors domainuser.TwoFactorRepository,
+	totp domainuser.TOTPAuthenticator,
+	twoFactor *domainuser.TwoFactor,
+	code string,
+	allowRecovery bool,
+) error {
+	if throttler == nil {
+		return checkTwoFactorCode(ctx, twoFactors, totp, twoFactor, code, allowRecovery)
+	}
+
+	if err := throttler.CheckTwoFactor(ctx, twoFactor.UserID); err != nil {
+		return err
+	}
+
+	err := checkTwoFactorCode(ctx, twoFactors, totp, twoFactor, code, allowRecovery)
+	if err == domainuser.ErrInvalidTwoFactorCode {
+		throttler.TwoFactorFailed(ctx, twoFactor.UserID)
+	} else if err == nil {
+		throttler.TwoFactorSucceeded(ctx, twoFactor.UserID)
+	}
+	return err
+}
+
+// checkTwoFactorCode accepts a current one-time password or, when allowed, an unused recovery code.
+// A recovery code is consumed by a successful check, and a one-time password can't be
+// used again, nor can any code of an earlier time step (RFC 6238 section 5.2).
+func checkTwoFactorCode(
+	ctx context.Context,
+	twoFactors domainuser.TwoFactorRepository,
+	totp domainuser.TOTPAuthenticator,
+	twoFactor *domainuser.TwoFactor,
+	code string,
+	allowRecovery bool,
+) error {
+	if step, ok := totp.Validate(twoFactor.Secret, strings.TrimSpace(code), time.Now()); ok {
+		if step <= twoFactor.LastUsedStep {
+			return domainuser.ErrInvalidTwoFactorCode
+		}
+		// Concurrent checks of the same code race on this update, only one of them wins
+		if err := twoFactors.UseTimeStep(ctx, twoFactor.UserID, step); err != nil {
+			return err
+		}
+		return nil
+	}
+
+	if !allowRecovery {
+		return domainuser.ErrInvalidTwoFactorCode
+	}
+
+	return twoFactors.UseRecoveryCode(ctx, twoFactor.UserID, domainuser.HashToken(domainuser.NormalizeRecoveryCode(code)))
+}
+
+// issueRecoveryCodes replaces the recovery codes of the user and returns the new plain codes.
+// Only their hashes are stored, so they are shown to the user exactly once.
+func issueRecoveryCodes(ctx context.Context, twoFactors domainuser.TwoFactorRepository, userID int64) ([]string, error) {
+	codes, err := domainuser.GenerateRecoveryCodes(domainuser.RecoveryCodeCount)
+	if err != nil {
+		return nil, err
+	}
+
+	hashes := make([]string, len(codes))
+	for i, code := range codes {
+		hashes[i] = domainuser.HashToken(domainuser.NormalizeRecoveryCode(code))
+	}
+
+	if err := twoFactors.ReplaceRecoveryCodes(ctx, userID, hashes); err != nil {
+		return nil, err
+	}
+
+	return codes, nil
+}
+
+// enabledTwoFactor returns the confirmed enrollment of the user
+func enabledTwoFactor(ctx context.Context, twoFactors domainuser.TwoFactorRepository, userID int64) (*domainuser.TwoFactor, error) {
+	twoFactor, err := twoFactors.GetByUser(ctx, userID)
+	if err == domainuser.ErrTwoFactorNotEnrolled {
+		return nil, domainuser.ErrTwoFactorNotEnabled
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if !twoFactor.IsEnabled() {
+		return nil, domainuser.ErrTwoFactorNotEnabled
+	}
+
+	return twoFactor, nil
+}
@@ -0,0 +1,208 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/rulzi/hexa-go/internal/application/user/dto"
+	domainuser "github.com/rulzi/hexa-go/internal/domain/user"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestTwoFactorChallenger_Required(t *testing.T) {
+	enabledAt := time.Now()
+
+	tests := []struct {
+		name      string
+		twoFactor *domainuser.TwoFactor
+		err       error
+		want      bool
+	}{
+		{name: "not enrolled", err: domainuser.ErrTwoFactorNotEnrolled, want: false},
+		{name: "pending confirmation", twoFactor: &domainuser.TwoFactor{UserID: 1}, want: false},
+		{name: "enabled", twoFactor: &domainuser.TwoFactor{UserID: 1, EnabledAt: &enabledAt}, want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			twoFactors := &mockTwoFactorRepository{}
+			if tt.twoFactor != nil {
+				twoFactors.On("GetByUser", ctx, int64(1)).Return(tt.twoFactor, nil)
+			} else {
+				twoFactors.On("GetByUser", ctx, int64(1)).Return(nil, tt.err)
+			}
+
+			required, err := NewTwoFactorChallenger(twoFactors, nil, time.Minute).Required(ctx, 1)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, required)
+		})
+	}
+}
+
+func TestEnrollTwoFactorUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+	repo := &mockUserRepository{}
+	twoFactors := &mockTwoFactorRepository{}
+	totp := &mockTOTPAuthenticator{}
+
+	repo.On("GetByID", ctx, int64(0), int64(1)).Return(&domainuser.User{ID: 1, Email: "test@example.com"}, nil)
+	twoFactors.On("GetByUser", ctx, int64(1)).Return(nil, domainuser.ErrTwoFactorNotEnrolled)
+	totp.On("GenerateSecret").Return("SECRET", nil)
+	totp.On("ProvisioningURI", "SECRET", "test@example.com").Return("otpauth://totp/test")
+	twoFactors.On("Save", ctx, mock.MatchedBy(func(tf *domainuser.TwoFactor) bool {
+		return tf.UserID == 1 && tf.Secret == "SECRET" && tf.EnabledAt == nil
+	})).Return(nil)
+
+	result, err := NewEnrollTwoFactorUseCase(repo, twoFactors, totp).Execute(ctx, 1)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "SECRET", result.Secret)
+	assert.Equal(t, "otpauth://totp/test", result.ProvisioningURI)
+	twoFactors.AssertExpectations(t)
+}
+
+func TestEnrollTwoFactorUseCase_Execute_AlreadyEnabled(t *testing.T) {
+	ctx := context.Background()
+	repo := &mockUserRepository{}
+	twoFactors := &mockTwoFactorRepository{}
+	totp := &mockTOTPAuthenticator{}
+	enabledAt := time.Now()
+
+	repo.On("GetByID", ctx, int64(0), int64(1)).Return(&domainuser.User{ID: 1, Email: "test@example.com"}, nil)
+	twoFactors.On("GetByUser", ctx, int64(1)).Return(&domainuser.TwoFactor{UserID: 1, EnabledAt: &enabledAt}, nil)
+
+	result, err := NewEnrollTwoFactorUseCase(repo, twoFactors, totp).Execute(ctx, 1)
+
+	assert.Equal(t, domainuser.ErrTwoFactorAlreadyEnabled, err)
+	assert.Nil(t, result)
+	totp.AssertNotCalled(t, "GenerateSecret")
+}
+
+func TestConfirmTwoFactorUseCase_Execute(t *testing.T) {
+	tests := []struct {
+		name    string
+		valid   bool
+		wantErr error
+	}{
+		{name: "valid code enables and issues recovery codes", valid: true},
+		{name: "invalid code", valid: false, wantErr: domainuser.ErrInvalidTwoFactorCode},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			twoFactors := &mockTwoFactorRepository{}
+			totp := &mockTOTPAuthenticator{}
+
+			twoFactors.On("GetByUser", ctx, int64(1)).Return(&domainuser.TwoFactor{UserID: 1, Secret: "SECRET"}, nil)
+			totp.On("Validate", "SECRET", "123456", mock.Anything).Return(int64(100), tt.valid)
+			twoFactors.On("UseTimeStep", ctx, int64(1), int64(100)).Return(nil)
+			twoFactors.On("Save", ctx, mock.MatchedBy(func(tf *domainuser.TwoFactor) bool {
+				return tf.IsEnabled()
+			})).Return(nil)
+			twoFactors.On("ReplaceRecoveryCodes", ctx, int64(1), mock.Anything).Return(nil)
+
+			result, err := NewConfirmTwoFactorUseCase(twoFactors, totp, nil).Execute(ctx, 1, dto.TwoFactorCodeRequest{Code: "123456"})
+
+			if tt.wantErr != nil {
+				assert.Equal(t, tt.wantErr, err)
+				assert.Nil(t, result)
+				twoFactors.AssertNotCalled(t, "Save", mock.Anything, mock.Anything)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Len(t, result.RecoveryCodes, domainuser.RecoveryCodeCount)
+			hashes := twoFactors.Calls[3].Arguments.Get(2).([]string)
+			assert.Equal(t, domainuser.HashToken(domainuser.NormalizeRecoveryCode(result.RecoveryCodes[0])), hashes[0])
+		})
+	}
+}
+
+func TestRegenerateRecoveryCodesUseCase_Execute_NotEnabled(t *testing.T) {
+	ctx := context.Background()
+	twoFactors := &mockTwoFactorRepository{}
+
+	twoFactors.On("GetByUser", ctx, int64(1)).Return(&domainuser.TwoFactor{UserID: 1, Secret: "SECRET"}, nil)
+
+	result, err := NewRegenerateRecoveryCodesUseCase(twoFactors, &mockTOTPAuthenticator{}, nil).Execute(ctx, 1, dto.TwoFactorCodeRequest{Code: "123456"})
+
+	assert.Equal(t, domainuser.ErrTwoFactorNotEnabled, err)
+	assert.Nil(t, result)
+}
+
+func TestDisableTwoFactorUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+	twoFactors := &mockTwoFactorRepository{}
+	totp := &mockTOTPAuthenticator{}
+	enabledAt := time.Now()
+
+	twoFactors.On("GetByUser", ctx, int64(1)).Return(&domainuser.TwoFactor{UserID: 1, Secret: "SECRET", EnabledAt: &enabledAt}, nil)
+	totp.On("Validate", "SECRET", "abcde-12345", mock.Anything).Return(int64(0), false)
+	twoFactors.On("UseRecoveryCode", ctx, int64(1), domainuser.HashToken("abcde12345")).Return(nil)
+	twoFactors.On("Delete", ctx, int64(1)).Return(nil)
+
+	err := NewDisableTwoFactorUseCase(twoFactors, totp, nil).Execute(ctx, 1, dto.TwoFactorCodeRequest{Code: "abcde-12345"})
+
+	assert.NoError(t, err)
+	twoFactors.AssertExpectations(t)
+}
+
+func TestDisableTwoFactorUseCase_Execute_LockedAfterWrongCodes(t *testing.T) {
+	ctx := context.Background()
+	twoFactors := &mockTwoFactorRepository{}
+	totp := &mockTOTPAuthenticator{}
+	counter := &mockLoginAttemptCounter{}
+	enabledAt := time.Now()
+
+	uc := NewDisableTwoFactorUseCase(twoFactors, totp, NewLoginThrottler(counter, testAccountPolicy, testIPPolicy))
+
+	twoFactors.On("GetByUser", ctx, int64(1)).Return(&domainuser.TwoFactor{UserID: 1, Secret: "SECRET", EnabledAt: &enabledAt}, nil)
+	totp.On("Validate", "SECRET", "000000", mock.Anything).Return(int64(0), false)
+	twoFactors.On("UseRecoveryCode", ctx, int64(1), mock.Anything).Return(domainuser.ErrInvalidTwoFactorCode)
+	counter.On("LockedFor", ctx, "two_factor:1").Return(time.Duration(0), nil).Times(testAccountPolicy.MaxAttempts)
+	counter.On("LockedFor", ctx, "two_factor:1").Return(time.Minute, nil).Once()
+	for i := 1; i <= testAccountPolicy.MaxAttempts; i++ {
+		counter.On("Increment", ctx, "two_factor:1", time.Hour).Return(i, nil).Once()
+	}
+	counter.On("Lock", ctx, "two_factor:1", time.Minute).Return(nil).Once()
+
+	for i := 0; i < testAccountPolicy.MaxAttempts; i++ {
+		err := uc.Execute(ctx, 1, dto.TwoFactorCodeRequest{Code: "000000"})
+		assert.Equal(t, domainuser.ErrInvalidTwoFactorCode, err)
+	}
+
+	// The next attempt is rejected even with a valid code
+	err := uc.Execute(ctx, 1, dto.TwoFactorCodeRequest{Code: "123456"})
+
+	assert.True(t, errors.Is(err, domainuser.ErrTooManyLoginAttempts))
+	totp.AssertNotCalled(t, "Validate", "SECRET", "123456", mock.Anything)
+	twoFactors.AssertNotCalled(t, "Delete", mock.Anything, mock.Anything)
+	counter.AssertExpectations(t)
+}
+
+func TestDisableTwoFactorUseCase_Execute_ResetsFailuresOnSuccess(t *testing.T) {
+	ctx := context.Background()
+	twoFactors := &mockTwoFactorRepository{}
+	totp := &mockTOTPAuthenticator{}
+	counter := &mockLoginAttemptCounter{}
+	enabledAt := time.Now()
+
+	twoFactors.On("GetByUser", ctx, int64(1)).Return(&domainuser.TwoFactor{UserID: 1, Secret: "SECRET", EnabledAt: &enabledAt}, nil)
+	totp.On("Validate", "SECRET", "123456", mock.Anything).Return(int64(100), true)
+	twoFactors.On("UseTimeStep", ctx, int64(1), int64(100)).Return(nil)
+	twoFactors.On("Delete", ctx, int64(1)).Return(nil)
+	counter.On("LockedFor", ctx, "two_factor:1").Return(time.Duration(0), nil)
+	counter.On("Reset", ctx, "two_factor:1").Return(nil)
+
+	err := NewDisableTwoFactorUseCase(twoFactors, totp, NewLoginThrottler(counter, testAccountPolicy, testIPPolicy)).
+		Execute(ctx, 1, dto.TwoFactorCodeRequest{Code: "123456"})
+
+	assert.NoError(t, err)
+	twoFactors.AssertExpectations(t)
+	counter.AssertExpectations(t)
+}
@@ -8,50 +8,110 @@ import (
 	domainuser "github.com/rulzi/hexa-go/internal/domain/user"
 )
 
-// UpdateUserUseCase handles updating a user
+// UpdateUserUseCase handles an admin updating a member of an organization.
+// The name, email and password belong to the account, which every organization of the user shares,
+// so admins only change them for users who belong to no other organization.
 type UpdateUserUseCase struct {
 	userRepo       domainuser.Repository
+	organizations  domainuser.OrganizationRepository
 	passwordHasher domainuser.PasswordHasher
+	passwords      *PasswordChecker
 }
 
-// NewUpdateUserUseCase creates a new UpdateUserUseCase
+// NewUpdateUserUseCase creates a new UpdateUserUseCase.
+// New passwords are checked against the password policy when a password checker is provided.
 func NewUpdateUserUseCase(
 	userRepo domainuser.Repository,
+	organizations domainuser.OrganizationRepository,
 	passwordHasher domainuser.PasswordHasher,
+	passwords *PasswordChecker,
 ) *UpdateUserUseCase {
 	return &UpdateUserUseCase{
 		userRepo:       userRepo,
+		organizations:  organizations,
 		passwordHasher: passwordHasher,
+		passwords:      passwords,
 	}
 }
 
-// Execute executes the update user use case
-func (uc *UpdateUserUseCase) Execute(ctx context.Context, id int64, req dto.UpdateUserRequest) (*dto.UserResponse, error) {
-	// Get existing user
-	existingUser, err := uc.userRepo.GetByID(ctx, id)
+// Execute executes the update user use case for a member of an organization.
+// Empty fields are left unchanged, a role change applies to the membership in orgID only.
+// A user who is also a member of another organization is not found for account changes.
+func (uc *UpdateUserUseCase) Execute(ctx context.Context, orgID, id int64, req dto.UpdateUserRequest) (*dto.UserResponse, error) {
+	membership, err := memberOf(ctx, uc.organizations, orgID, id)
 	if err != nil {
 		return nil, err
 	}
 
-	if existingUser == nil {
-		return nil, domainuser.ErrUserNotFound
+	if req.Role != "" {
+		membership.Role = domainuser.Role(req.Role)
+		if err := membership.Validate(); err != nil {
+			return nil, err
+		}
 	}
 
+	existingUser, err := uc.userRepo.GetByID(ctx, orgID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	updatedUser := existingUser
+	if req.Name != "" || req.Email != "" || req.Password != "" {
+		memberships, err := uc.organizations.CountMemberships(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		if memberships > 1 {
+			return nil, domainuser.ErrUserNotFound
+		}
+
+		updatedUser, err = uc.updateAccount(ctx, orgID, existingUser, req)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if req.Role != "" {
+		if err := uc.organizations.UpdateMemberRole(ctx, orgID, id, membership.Role); err != nil {
+			return nil, err
+		}
+	}
+
+	return &dto.UserResponse{
+		ID:              updatedUser.ID,
+		Name:            updatedUser.Name,
+		Email:           updatedUser.Email,
+		Role:            string(membership.Role),
+		EmailVerifiedAt: updatedUser.EmailVerifiedAt,
+		CreatedAt:       updatedUser.CreatedAt,
+		UpdatedAt:       updatedUser.UpdatedAt,
+	}, nil
+}
+
+// updateAccount changes the name, email and password of the user
+func (uc *UpdateUserUseCase) updateAccount(ctx context.Context, orgID int64, existingUser *domainuser.User, req dto.UpdateUserRequest) (*domainuser.User, error) {
 	// Check if email is being changed and if it already exists
-	if req.Email != existingUser.Email {
+	if req.Email != "" && req.Email != existingUser.Email {
 		emailUser, err := uc.userRepo.GetByEmail(ctx, req.Email)
 		if err == nil && emailUser != nil {
 			return nil, domainuser.ErrEmailExists
 		}
+		existingUser.Email = req.Email
 	}
 
-	// Update fields
-	existingUser.Name = req.Name
-	existingUser.Email = req.Email
+	if req.Name != "" {
+		existingUser.Name = req.Name
+	}
 	existingUser.UpdatedAt = time.Now()
 
-	// Update password if provided
+	// Update password if provided, checked with the new name and email
 	if req.Password != "" {
+		if uc.passwords != nil {
+			if err := uc.passwords.Check(ctx, existingUser, req.Password); err != nil {
+				return nil, err
+			}
+		}
+
 		hashedPassword, err := uc.passwordHasher.Hash(req.Password)
 		if err != nil {
 			return nil, err
@@ -59,22 +119,20 @@ func (uc *UpdateUserUseCase) Execute(ctx context.Context, id int64, req dto.Upda
 		existingUser.Password = hashedPassword
 	}
 
-	// Validate entity
 	if err := existingUser.Validate(); err != nil {
 		return nil, err
 	}
 
-	// Update in repository
-	updatedUser, err := uc.userRepo.Update(ctx, existingUser)
+	updatedUser, err := uc.userRepo.Update(ctx, orgID, existingUser)
 	if err != nil {
 		return nil, err
 	}
 
-	return &dto.UserResponse{
-		ID:        updatedUser.ID,
-		Name:      updatedUser.Name,
-		Email:     updatedUser.Email,
-		CreatedAt: updatedUser.CreatedAt,
-		UpdatedAt: updatedUser.UpdatedAt,
-	}, nil
+	if req.Password != "" && uc.passwords != nil {
+		if err := uc.passwords.Remember(ctx, updatedUser.ID, updatedUser.Password); err != nil {
+			return nil, err
+		}
+	}
+
+	return updatedUser, nil
 }
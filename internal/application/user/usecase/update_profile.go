@@ -0,0 +1,77 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/rulzi/hexa-go/internal/application/user/dto"
+	domainuser "github.com/rulzi/hexa-go/internal/domain/user"
+)
+
+// UpdateProfileUseCase handles users updating their own profile
+type UpdateProfileUseCase struct {
+	userRepo           domainuser.Repository
+	organizations      domainuser.OrganizationRepository
+	verificationSender *VerificationSender
+}
+
+// NewUpdateProfileUseCase creates a new UpdateProfileUseCase
+func NewUpdateProfileUseCase(userRepo domainuser.Repository, organizations domainuser.OrganizationRepository, verificationSender *VerificationSender) *UpdateProfileUseCase {
+	return &UpdateProfileUseCase{
+		userRepo:           userRepo,
+		organizations:      organizations,
+		verificationSender: verificationSender,
+	}
+}
+
+// Execute executes the update profile use case.
+// A new email address has to be verified again. The returned role is the one
+// of the active organization, like GET /users/me.
+func (uc *UpdateProfileUseCase) Execute(ctx context.Context, orgID, userID int64, req dto.UpdateProfileRequest) (*dto.UserResponse, error) {
+	membership, err := memberOf(ctx, uc.organizations, orgID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	existingUser, err := uc.userRepo.GetByID(ctx, 0, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	emailChanged := req.Email != existingUser.Email
+	if emailChanged {
+		emailUser, err := uc.userRepo.GetByEmail(ctx, req.Email)
+		if err == nil && emailUser != nil {
+			return nil, domainuser.ErrEmailExists
+		}
+		existingUser.EmailVerifiedAt = nil
+	}
+
+	existingUser.Name = req.Name
+	existingUser.Email = req.Email
+	existingUser.UpdatedAt = time.Now()
+
+	if err := existingUser.Validate(); err != nil {
+		return nil, err
+	}
+
+	updatedUser, err := uc.userRepo.Update(ctx, 0, existingUser)
+	if err != nil {
+		return nil, err
+	}
+
+	// Send verification link for the new address (external service)
+	if emailChanged && uc.verificationSender != nil {
+		_ = uc.verificationSender.Send(ctx, updatedUser)
+	}
+
+	return &dto.UserResponse{
+		ID:              updatedUser.ID,
+		Name:            updatedUser.Name,
+		Email:           updatedUser.Email,
+		Role:            string(membership.Role),
+		EmailVerifiedAt: updatedUser.EmailVerifiedAt,
+		CreatedAt:       updatedUser.CreatedAt,
+		UpdatedAt:       updatedUser.UpdatedAt,
+	}, nil
+}
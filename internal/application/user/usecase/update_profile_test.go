@@ -0,0 +1,118 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/rulzi/hexa-go/internal/application/user/dto"
+	domainuser "github.com/rulzi/hexa-go/internal/domain/user"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestUpdateProfileUseCase_Execute(t *testing.T) {
+	verifiedAt := time.Now()
+
+	tests := []struct {
+		name       string
+		req        dto.UpdateProfileRequest
+		setupMocks func(*mockUserRepository, *mockOrganizationRepository, *mockEmailVerificationSigner, *mockNotificationService)
+		wantErr    error
+	}{
+		{
+			name: "name change keeps the verification",
+			req:  dto.UpdateProfileRequest{Name: "New Name", Email: "test@example.com"},
+			setupMocks: func(repo *mockUserRepository, organizations *mockOrganizationRepository, signer *mockEmailVerificationSigner, notificationService *mockNotificationService) {
+				organizations.On("GetMembership", mock.Anything, int64(5), int64(1)).Return(&domainuser.Membership{OrgID: 5, UserID: 1, Role: domainuser.RoleEditor}, nil)
+				repo.On("GetByID", mock.Anything, int64(0), int64(1)).
+					Return(&domainuser.User{ID: 1, Name: "Old Name", Email: "test@example.com", Password: "hash", Role: domainuser.RoleAuthor, EmailVerifiedAt: &verifiedAt}, nil)
+				repo.On("Update", mock.Anything, int64(0), mock.MatchedBy(func(u *domainuser.User) bool {
+					return u.Name == "New Name" && u.IsEmailVerified() && u.Role == domainuser.RoleAuthor
+				})).Return(&domainuser.User{ID: 1, Name: "New Name", Email: "test@example.com", EmailVerifiedAt: &verifiedAt}, nil)
+			},
+		},
+		{
+			name: "new email has to be verified again",
+			req:  dto.UpdateProfileRequest{Name: "Test User", Email: "new@example.com"},
+			setupMocks: func(repo *mockUserRepository, organizations *mockOrganizationRepository, signer *mockEmailVerificationSigner, notificationService *mockNotificationService) {
+				organizations.On("GetMembership", mock.Anything, int64(5), int64(1)).Return(&domainuser.Membership{OrgID: 5, UserID: 1, Role: domainuser.RoleEditor}, nil)
+				repo.On("GetByID", mock.Anything, int64(0), int64(1)).
+					Return(&domainuser.User{ID: 1, Name: "Test User", Email: "test@example.com", Password: "hash", EmailVerifiedAt: &verifiedAt}, nil)
+				repo.On("GetByEmail", mock.Anything, "new@example.com").Return(nil, domainuser.ErrUserNotFound)
+				repo.On("Update", mock.Anything, int64(0), mock.MatchedBy(func(u *domainuser.User) bool {
+					return u.Email == "new@example.com" && !u.IsEmailVerified()
+				})).Return(&domainuser.User{ID: 1, Name: "Test User", Email: "new@example.com"}, nil)
+				signer.On("Sign", mock.Anything).Return("signed", nil)
+				notificationService.On("SendVerificationEmail", mock.Anything, "new@example.com", "Test User", "signed").Return(nil)
+			},
+		},
+		{
+			name: "email taken by another user",
+			req:  dto.UpdateProfileRequest{Name: "Test User", Email: "taken@example.com"},
+			setupMocks: func(repo *mockUserRepository, organizations *mockOrganizationRepository, signer *mockEmailVerificationSigner, notificationService *mockNotificationService) {
+				organizations.On("GetMembership", mock.Anything, int64(5), int64(1)).Return(&domainuser.Membership{OrgID: 5, UserID: 1, Role: domainuser.RoleEditor}, nil)
+				repo.On("GetByID", mock.Anything, int64(0), int64(1)).
+					Return(&domainuser.User{ID: 1, Name: "Test User", Email: "test@example.com", Password: "hash"}, nil)
+				repo.On("GetByEmail", mock.Anything, "taken@example.com").Return(&domainuser.User{ID: 2}, nil)
+			},
+			wantErr: domainuser.ErrEmailExists,
+		},
+		{
+			name: "user not found",
+			req:  dto.UpdateProfileRequest{Name: "Test User", Email: "test@example.com"},
+			setupMocks: func(repo *mockUserRepository, organizations *mockOrganizationRepository, signer *mockEmailVerificationSigner, notificationService *mockNotificationService) {
+				organizations.On("GetMembership", mock.Anything, int64(5), int64(1)).Return(&domainuser.Membership{OrgID: 5, UserID: 1, Role: domainuser.RoleEditor}, nil)
+				repo.On("GetByID", mock.Anything, int64(0), int64(1)).Return(nil, domainuser.ErrUserNotFound)
+			},
+			wantErr: domainuser.ErrUserNotFound,
+		},
+		{
+			name: "not a member of the active organization",
+			req:  dto.UpdateProfileRequest{Name: "Test User", Email: "test@example.com"},
+			setupMocks: func(repo *mockUserRepository, organizations *mockOrganizationRepository, signer *mockEmailVerificationSigner, notificationService *mockNotificationService) {
+				organizations.On("GetMembership", mock.Anything, int64(5), int64(1)).Return(nil, domainuser.ErrNotMember)
+			},
+			wantErr: domainuser.ErrUserNotFound,
+		},
+		{
+			name: "error on update",
+			req:  dto.UpdateProfileRequest{Name: "Test User", Email: "test@example.com"},
+			setupMocks: func(repo *mockUserRepository, organizations *mockOrganizationRepository, signer *mockEmailVerificationSigner, notificationService *mockNotificationService) {
+				organizations.On("GetMembership", mock.Anything, int64(5), int64(1)).Return(&domainuser.Membership{OrgID: 5, UserID: 1, Role: domainuser.RoleEditor}, nil)
+				repo.On("GetByID", mock.Anything, int64(0), int64(1)).
+					Return(&domainuser.User{ID: 1, Name: "Test User", Email: "test@example.com", Password: "hash"}, nil)
+				repo.On("Update", mock.Anything, int64(0), mock.Anything).Return(nil, errors.New("database error"))
+			},
+			wantErr: errors.New("database error"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &mockUserRepository{}
+			organizations := &mockOrganizationRepository{}
+			signer := &mockEmailVerificationSigner{}
+			notificationService := &mockNotificationService{}
+			tt.setupMocks(repo, organizations, signer, notificationService)
+
+			uc := NewUpdateProfileUseCase(repo, organizations, NewVerificationSender(signer, notificationService, time.Hour))
+			result, err := uc.Execute(context.Background(), 5, 1, tt.req)
+
+			if tt.wantErr != nil {
+				assert.Equal(t, tt.wantErr, err)
+				assert.Nil(t, result)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.req.Name, result.Name)
+				assert.Equal(t, tt.req.Email, result.Email)
+				assert.Equal(t, string(domainuser.RoleEditor), result.Role)
+			}
+			repo.AssertExpectations(t)
+			organizations.AssertExpectations(t)
+			signer.AssertExpectations(t)
+			notificationService.AssertExpectations(t)
+		})
+	}
+}
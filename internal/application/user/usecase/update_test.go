@@ -12,17 +12,16 @@ import (
 	"github.com/stretchr/testify/mock"
 )
 
-// Ensure dto is used
-var _ *dto.UserResponse
-
 func TestNewUpdateUserUseCase(t *testing.T) {
 	repo := &mockUserRepository{}
+	orgs := &mockOrganizationRepository{}
 	passwordHasher := &mockPasswordHasher{}
 
-	uc := NewUpdateUserUseCase(repo, passwordHasher)
+	uc := NewUpdateUserUseCase(repo, orgs, passwordHasher, nil)
 
 	assert.NotNil(t, uc)
 	assert.Equal(t, repo, uc.userRepo)
+	assert.Equal(t, orgs, uc.organizations)
 	assert.Equal(t, passwordHasher, uc.passwordHasher)
 }
 
@@ -31,7 +30,7 @@ func TestUpdateUserUseCase_Execute_Success(t *testing.T) {
 	repo := &mockUserRepository{}
 	passwordHasher := &mockPasswordHasher{}
 
-	uc := NewUpdateUserUseCase(repo, passwordHasher)
+	uc := NewUpdateUserUseCase(repo, newMemberOrganizations(domainuser.RoleAuthor), passwordHasher, nil)
 
 	userID := int64(1)
 	existingUser := &domainuser.User{
@@ -58,11 +57,11 @@ func TestUpdateUserUseCase_Execute_Success(t *testing.T) {
 		UpdatedAt: time.Now(),
 	}
 
-	repo.On("GetByID", ctx, userID).Return(existingUser, nil)
+	repo.On("GetByID", ctx, int64(1), userID).Return(existingUser, nil)
 	repo.On("GetByEmail", ctx, req.Email).Return(nil, errors.New("not found"))
-	repo.On("Update", ctx, mock.AnythingOfType("*user.User")).Return(updatedUser, nil)
+	repo.On("Update", ctx, int64(1), mock.AnythingOfType("*user.User")).Return(updatedUser, nil)
 
-	result, err := uc.Execute(ctx, userID, req)
+	result, err := uc.Execute(ctx, 1, userID, req)
 
 	assert.NoError(t, err)
 	assert.NotNil(t, result)
@@ -79,7 +78,7 @@ func TestUpdateUserUseCase_Execute_SuccessWithPasswordUpdate(t *testing.T) {
 	repo := &mockUserRepository{}
 	passwordHasher := &mockPasswordHasher{}
 
-	uc := NewUpdateUserUseCase(repo, passwordHasher)
+	uc := NewUpdateUserUseCase(repo, newMemberOrganizations(domainuser.RoleAuthor), passwordHasher, nil)
 
 	userID := int64(1)
 	existingUser := &domainuser.User{
@@ -107,11 +106,11 @@ func TestUpdateUserUseCase_Execute_SuccessWithPasswordUpdate(t *testing.T) {
 		UpdatedAt: time.Now(),
 	}
 
-	repo.On("GetByID", ctx, userID).Return(existingUser, nil)
+	repo.On("GetByID", ctx, int64(1), userID).Return(existingUser, nil)
 	passwordHasher.On("Hash", req.Password).Return(newHashedPassword, nil)
-	repo.On("Update", ctx, mock.AnythingOfType("*user.User")).Return(updatedUser, nil)
+	repo.On("Update", ctx, int64(1), mock.AnythingOfType("*user.User")).Return(updatedUser, nil)
 
-	result, err := uc.Execute(ctx, userID, req)
+	result, err := uc.Execute(ctx, 1, userID, req)
 
 	assert.NoError(t, err)
 	assert.NotNil(t, result)
@@ -122,30 +121,186 @@ func TestUpdateUserUseCase_Execute_SuccessWithPasswordUpdate(t *testing.T) {
 	passwordHasher.AssertExpectations(t)
 }
 
-func TestUpdateUserUseCase_Execute_UserNotFound(t *testing.T) {
+func TestUpdateUserUseCase_Execute_PasswordPolicy(t *testing.T) {
 	ctx := context.Background()
 	repo := &mockUserRepository{}
 	passwordHasher := &mockPasswordHasher{}
+	history := &mockPasswordHistoryRepository{}
+	passwords := NewPasswordChecker(domainuser.PasswordPolicy{MinLength: 8, DisallowPersonalInfo: true, HistorySize: 3}, passwordHasher, history, nil)
+
+	uc := NewUpdateUserUseCase(repo, newMemberOrganizations(domainuser.RoleAuthor), passwordHasher, passwords)
+
+	existingUser := func() *domainuser.User {
+		return &domainuser.User{ID: 1, Name: "Old Name", Email: "old@example.com", Password: "old_hashed_password"}
+	}
+	repo.On("GetByID", ctx, int64(1), int64(1)).Return(existingUser(), nil).Once()
+	repo.On("GetByEmail", ctx, "renamed@example.com").Return(nil, errors.New("not found"))
+	history.On("ListRecent", ctx, int64(1), 3).Return([]string{}, nil)
+	passwordHasher.On("Verify", mock.Anything, mock.Anything).Return(false)
+
+	// The password is checked against the new email
+	result, err := uc.Execute(ctx, 1, 1, dto.UpdateUserRequest{Email: "renamed@example.com", Password: "renamed@example.com1"})
+
+	var policyErr *domainuser.PasswordPolicyError
+	assert.ErrorAs(t, err, &policyErr)
+	assert.Nil(t, result)
+	passwordHasher.AssertNotCalled(t, "Hash", mock.Anything)
+	repo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything, mock.Anything)
+
+	// A password that satisfies the policy is saved and remembered
+	repo.On("GetByID", ctx, int64(1), int64(1)).Return(existingUser(), nil).Once()
+	passwordHasher.On("Hash", "Correct-Horse-9").Return("new_hashed_password", nil)
+	repo.On("Update", ctx, int64(1), mock.AnythingOfType("*user.User")).Return(&domainuser.User{ID: 1, Name: "Old Name", Email: "old@example.com", Password: "new_hashed_password"}, nil)
+	history.On("Add", ctx, int64(1), "new_hashed_password", mock.Anything).Return(nil)
+	history.On("Prune", ctx, int64(1), 3).Return(nil)
+
+	result, err = uc.Execute(ctx, 1, 1, dto.UpdateUserRequest{Password: "Correct-Horse-9"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "old@example.com", result.Email)
+	history.AssertExpectations(t)
+}
+
+func TestUpdateUserUseCase_Execute_RoleChange(t *testing.T) {
+	ctx := context.Background()
+	repo := &mockUserRepository{}
+	orgs := &mockOrganizationRepository{}
 
-	uc := NewUpdateUserUseCase(repo, passwordHasher)
+	uc := NewUpdateUserUseCase(repo, orgs, &mockPasswordHasher{}, nil)
 
 	userID := int64(1)
-	req := dto.UpdateUserRequest{
-		Name:     "New Name",
-		Email:    "new@example.com",
-		Password: "",
+	existingUser := &domainuser.User{
+		ID:        userID,
+		Name:      "Test User",
+		Email:     "test@example.com",
+		Password:  "hashed_password",
+		Role:      domainuser.RoleAuthor,
+		CreatedAt: time.Now().Add(-24 * time.Hour),
+		UpdatedAt: time.Now().Add(-24 * time.Hour),
+	}
+
+	// The role changes in the organization only, the account is left alone
+	orgs.On("GetMembership", ctx, int64(4), userID).Return(&domainuser.Membership{OrgID: 4, UserID: userID, Role: domainuser.RoleAuthor}, nil)
+	orgs.On("UpdateMemberRole", ctx, int64(4), userID, domainuser.RoleEditor).Return(nil)
+	repo.On("GetByID", ctx, int64(4), userID).Return(existingUser, nil)
+
+	result, err := uc.Execute(ctx, 4, userID, dto.UpdateUserRequest{Role: "editor"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "editor", result.Role)
+	assert.Equal(t, existingUser.Email, result.Email)
+	repo.AssertExpectations(t)
+	repo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything, mock.Anything)
+	orgs.AssertExpectations(t)
+	orgs.AssertNotCalled(t, "CountMemberships", mock.Anything, mock.Anything)
+}
+
+func TestUpdateUserUseCase_Execute_RoleChangeOfMemberOfOtherOrganizations(t *testing.T) {
+	ctx := context.Background()
+	repo := &mockUserRepository{}
+	orgs := &mockOrganizationRepository{}
+
+	uc := NewUpdateUserUseCase(repo, orgs, &mockPasswordHasher{}, nil)
+
+	// Users who also belong to other organizations still get their role changed
+	orgs.On("GetMembership", ctx, int64(4), int64(1)).Return(&domainuser.Membership{OrgID: 4, UserID: 1, Role: domainuser.RoleAuthor}, nil)
+	orgs.On("CountMemberships", ctx, int64(1)).Return(int64(2), nil).Maybe()
+	orgs.On("UpdateMemberRole", ctx, int64(4), int64(1), domainuser.RoleEditor).Return(nil)
+	repo.On("GetByID", ctx, int64(4), int64(1)).Return(&domainuser.User{ID: 1, Name: "Test User", Email: "test@example.com"}, nil)
+
+	result, err := uc.Execute(ctx, 4, 1, dto.UpdateUserRequest{Role: "editor"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "editor", result.Role)
+	orgs.AssertExpectations(t)
+}
+
+func TestUpdateUserUseCase_Execute_AccountOfMemberOfOtherOrganizations(t *testing.T) {
+	tests := []struct {
+		name string
+		req  dto.UpdateUserRequest
+	}{
+		{name: "name", req: dto.UpdateUserRequest{Name: "New Name"}},
+		{name: "email", req: dto.UpdateUserRequest{Email: "attacker@example.com"}},
+		{name: "password", req: dto.UpdateUserRequest{Password: "new_password123"}},
+		{name: "password with role", req: dto.UpdateUserRequest{Password: "new_password123", Role: "editor"}},
 	}
 
-	repo.On("GetByID", ctx, userID).Return(nil, nil)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			repo := &mockUserRepository{}
+			orgs := &mockOrganizationRepository{}
+			passwordHasher := &mockPasswordHasher{}
+
+			uc := NewUpdateUserUseCase(repo, orgs, passwordHasher, nil)
+
+			// The account is shared with another organization, its admin can't take it over
+			orgs.On("GetMembership", ctx, int64(4), int64(1)).Return(&domainuser.Membership{OrgID: 4, UserID: 1, Role: domainuser.RoleAuthor}, nil)
+			orgs.On("CountMemberships", ctx, int64(1)).Return(int64(2), nil)
+			repo.On("GetByID", ctx, int64(4), int64(1)).Return(&domainuser.User{ID: 1, Name: "Test User", Email: "test@example.com", Password: "hashed_password"}, nil)
+
+			result, err := uc.Execute(ctx, 4, 1, tt.req)
+
+			assert.Equal(t, domainuser.ErrUserNotFound, err)
+			assert.Nil(t, result)
+			repo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything, mock.Anything)
+			passwordHasher.AssertNotCalled(t, "Hash", mock.Anything)
+			orgs.AssertNotCalled(t, "UpdateMemberRole", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestUpdateUserUseCase_Execute_CountMembershipsError(t *testing.T) {
+	ctx := context.Background()
+	repo := &mockUserRepository{}
+	orgs := &mockOrganizationRepository{}
+
+	uc := NewUpdateUserUseCase(repo, orgs, &mockPasswordHasher{}, nil)
+
+	repoErr := errors.New("database error")
+	orgs.On("GetMembership", ctx, int64(1), int64(1)).Return(&domainuser.Membership{OrgID: 1, UserID: 1, Role: domainuser.RoleAuthor}, nil)
+	orgs.On("CountMemberships", ctx, int64(1)).Return(int64(0), repoErr)
+	repo.On("GetByID", ctx, int64(1), int64(1)).Return(&domainuser.User{ID: 1, Name: "Test User", Email: "test@example.com"}, nil)
 
-	result, err := uc.Execute(ctx, userID, req)
+	result, err := uc.Execute(ctx, 1, 1, dto.UpdateUserRequest{Name: "New Name"})
+
+	assert.Nil(t, result)
+	assert.Equal(t, repoErr, err)
+	repo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestUpdateUserUseCase_Execute_UserNotFound(t *testing.T) {
+	ctx := context.Background()
+	repo := &mockUserRepository{}
+	orgs := &mockOrganizationRepository{}
+
+	uc := NewUpdateUserUseCase(repo, orgs, &mockPasswordHasher{}, nil)
+
+	// Members of other organizations are not found
+	orgs.On("GetMembership", ctx, int64(4), int64(999)).Return(nil, domainuser.ErrNotMember)
+
+	result, err := uc.Execute(ctx, 4, 999, dto.UpdateUserRequest{Name: "New Name", Email: "new@example.com"})
 
 	assert.Error(t, err)
-	assert.Equal(t, domainuser.ErrUserNotFound, err)
 	assert.Nil(t, result)
+	assert.Equal(t, domainuser.ErrUserNotFound, err)
+	repo.AssertNotCalled(t, "GetByID", mock.Anything, mock.Anything, mock.Anything)
+	repo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything, mock.Anything)
+	orgs.AssertNotCalled(t, "UpdateMemberRole", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
 
-	repo.AssertExpectations(t)
-	repo.AssertNotCalled(t, "Update")
+func TestUpdateUserUseCase_Execute_InvalidRole(t *testing.T) {
+	ctx := context.Background()
+	repo := &mockUserRepository{}
+
+	uc := NewUpdateUserUseCase(repo, newMemberOrganizations(domainuser.RoleAuthor), &mockPasswordHasher{}, nil)
+
+	result, err := uc.Execute(ctx, 1, 1, dto.UpdateUserRequest{Role: "owner"})
+
+	assert.Nil(t, result)
+	assert.Equal(t, domainuser.ErrInvalidRole, err)
+	repo.AssertNotCalled(t, "GetByID", mock.Anything, mock.Anything, mock.Anything)
 }
 
 func TestUpdateUserUseCase_Execute_GetByIDError(t *testing.T) {
@@ -153,7 +308,7 @@ func TestUpdateUserUseCase_Execute_GetByIDError(t *testing.T) {
 	repo := &mockUserRepository{}
 	passwordHasher := &mockPasswordHasher{}
 
-	uc := NewUpdateUserUseCase(repo, passwordHasher)
+	uc := NewUpdateUserUseCase(repo, newMemberOrganizations(domainuser.RoleAuthor), passwordHasher, nil)
 
 	userID := int64(1)
 	req := dto.UpdateUserRequest{
@@ -163,16 +318,16 @@ func TestUpdateUserUseCase_Execute_GetByIDError(t *testing.T) {
 	}
 
 	repoError := errors.New("database error")
-	repo.On("GetByID", ctx, userID).Return(nil, repoError)
+	repo.On("GetByID", ctx, int64(1), userID).Return(nil, repoError)
 
-	result, err := uc.Execute(ctx, userID, req)
+	result, err := uc.Execute(ctx, 1, userID, req)
 
 	assert.Error(t, err)
 	assert.Equal(t, repoError, err)
 	assert.Nil(t, result)
 
 	repo.AssertExpectations(t)
-	repo.AssertNotCalled(t, "Update")
+	repo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything, mock.Anything)
 }
 
 func TestUpdateUserUseCase_Execute_EmailExists(t *testing.T) {
@@ -180,7 +335,7 @@ func TestUpdateUserUseCase_Execute_EmailExists(t *testing.T) {
 	repo := &mockUserRepository{}
 	passwordHasher := &mockPasswordHasher{}
 
-	uc := NewUpdateUserUseCase(repo, passwordHasher)
+	uc := NewUpdateUserUseCase(repo, newMemberOrganizations(domainuser.RoleAuthor), passwordHasher, nil)
 
 	userID := int64(1)
 	existingUser := &domainuser.User{
@@ -203,17 +358,17 @@ func TestUpdateUserUseCase_Execute_EmailExists(t *testing.T) {
 		Email: req.Email,
 	}
 
-	repo.On("GetByID", ctx, userID).Return(existingUser, nil)
+	repo.On("GetByID", ctx, int64(1), userID).Return(existingUser, nil)
 	repo.On("GetByEmail", ctx, req.Email).Return(existingEmailUser, nil)
 
-	result, err := uc.Execute(ctx, userID, req)
+	result, err := uc.Execute(ctx, 1, userID, req)
 
 	assert.Error(t, err)
 	assert.Equal(t, domainuser.ErrEmailExists, err)
 	assert.Nil(t, result)
 
 	repo.AssertExpectations(t)
-	repo.AssertNotCalled(t, "Update")
+	repo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything, mock.Anything)
 }
 
 func TestUpdateUserUseCase_Execute_SameEmailNoConflict(t *testing.T) {
@@ -221,7 +376,7 @@ func TestUpdateUserUseCase_Execute_SameEmailNoConflict(t *testing.T) {
 	repo := &mockUserRepository{}
 	passwordHasher := &mockPasswordHasher{}
 
-	uc := NewUpdateUserUseCase(repo, passwordHasher)
+	uc := NewUpdateUserUseCase(repo, newMemberOrganizations(domainuser.RoleAuthor), passwordHasher, nil)
 
 	userID := int64(1)
 	existingUser := &domainuser.User{
@@ -248,18 +403,18 @@ func TestUpdateUserUseCase_Execute_SameEmailNoConflict(t *testing.T) {
 		UpdatedAt: time.Now(),
 	}
 
-	repo.On("GetByID", ctx, userID).Return(existingUser, nil)
+	repo.On("GetByID", ctx, int64(1), userID).Return(existingUser, nil)
 	// GetByEmail should not be called when email is the same
-	repo.On("Update", ctx, mock.AnythingOfType("*user.User")).Return(updatedUser, nil)
+	repo.On("Update", ctx, int64(1), mock.AnythingOfType("*user.User")).Return(updatedUser, nil)
 
-	result, err := uc.Execute(ctx, userID, req)
+	result, err := uc.Execute(ctx, 1, userID, req)
 
 	assert.NoError(t, err)
 	assert.NotNil(t, result)
 	assert.Equal(t, req.Name, result.Name)
 
 	repo.AssertExpectations(t)
-	repo.AssertNotCalled(t, "GetByEmail")
+	repo.AssertNotCalled(t, "GetByEmail", mock.Anything, mock.Anything)
 }
 
 func TestUpdateUserUseCase_Execute_PasswordHashError(t *testing.T) {
@@ -267,7 +422,7 @@ func TestUpdateUserUseCase_Execute_PasswordHashError(t *testing.T) {
 	repo := &mockUserRepository{}
 	passwordHasher := &mockPasswordHasher{}
 
-	uc := NewUpdateUserUseCase(repo, passwordHasher)
+	uc := NewUpdateUserUseCase(repo, newMemberOrganizations(domainuser.RoleAuthor), passwordHasher, nil)
 
 	userID := int64(1)
 	existingUser := &domainuser.User{
@@ -287,10 +442,10 @@ func TestUpdateUserUseCase_Execute_PasswordHashError(t *testing.T) {
 
 	hashError := errors.New("hash error")
 
-	repo.On("GetByID", ctx, userID).Return(existingUser, nil)
+	repo.On("GetByID", ctx, int64(1), userID).Return(existingUser, nil)
 	passwordHasher.On("Hash", req.Password).Return("", hashError)
 
-	result, err := uc.Execute(ctx, userID, req)
+	result, err := uc.Execute(ctx, 1, userID, req)
 
 	assert.Error(t, err)
 	assert.Equal(t, hashError, err)
@@ -298,62 +453,7 @@ func TestUpdateUserUseCase_Execute_PasswordHashError(t *testing.T) {
 
 	repo.AssertExpectations(t)
 	passwordHasher.AssertExpectations(t)
-	repo.AssertNotCalled(t, "Update")
-}
-
-func TestUpdateUserUseCase_Execute_ValidationError(t *testing.T) {
-	ctx := context.Background()
-	repo := &mockUserRepository{}
-	passwordHasher := &mockPasswordHasher{}
-
-	uc := NewUpdateUserUseCase(repo, passwordHasher)
-
-	userID := int64(1)
-	existingUser := &domainuser.User{
-		ID:        userID,
-		Name:      "Old Name",
-		Email:     "old@example.com",
-		Password:  "hashed_password",
-		CreatedAt: time.Now(),
-		UpdatedAt: time.Now(),
-	}
-
-	tests := []struct {
-		name string
-		req  dto.UpdateUserRequest
-	}{
-		{
-			name: "empty name",
-			req: dto.UpdateUserRequest{
-				Name:     "",
-				Email:    "new@example.com",
-				Password: "",
-			},
-		},
-		{
-			name: "empty email",
-			req: dto.UpdateUserRequest{
-				Name:     "New Name",
-				Email:    "",
-				Password: "",
-			},
-		},
-	}
-
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			repo.On("GetByID", ctx, userID).Return(existingUser, nil)
-			if tt.req.Email != existingUser.Email {
-				repo.On("GetByEmail", ctx, tt.req.Email).Return(nil, errors.New("not found"))
-			}
-
-			result, err := uc.Execute(ctx, userID, tt.req)
-
-			assert.Error(t, err)
-			assert.Nil(t, result)
-			repo.AssertNotCalled(t, "Update")
-		})
-	}
+	repo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything, mock.Anything)
 }
 
 func TestUpdateUserUseCase_Execute_RepositoryUpdateError(t *testing.T) {
@@ -361,7 +461,7 @@ func TestUpdateUserUseCase_Execute_RepositoryUpdateError(t *testing.T) {
 	repo := &mockUserRepository{}
 	passwordHasher := &mockPasswordHasher{}
 
-	uc := NewUpdateUserUseCase(repo, passwordHasher)
+	uc := NewUpdateUserUseCase(repo, newMemberOrganizations(domainuser.RoleAuthor), passwordHasher, nil)
 
 	userID := int64(1)
 	existingUser := &domainuser.User{
@@ -381,11 +481,11 @@ func TestUpdateUserUseCase_Execute_RepositoryUpdateError(t *testing.T) {
 
 	updateError := errors.New("update error")
 
-	repo.On("GetByID", ctx, userID).Return(existingUser, nil)
+	repo.On("GetByID", ctx, int64(1), userID).Return(existingUser, nil)
 	repo.On("GetByEmail", ctx, req.Email).Return(nil, errors.New("not found"))
-	repo.On("Update", ctx, mock.AnythingOfType("*user.User")).Return(nil, updateError)
+	repo.On("Update", ctx, int64(1), mock.AnythingOfType("*user.User")).Return(nil, updateError)
 
-	result, err := uc.Execute(ctx, userID, req)
+	result, err := uc.Execute(ctx, 1, userID, req)
 
 	assert.Error(t, err)
 	assert.Equal(t, updateError, err)
@@ -394,3 +494,21 @@ func TestUpdateUserUseCase_Execute_RepositoryUpdateError(t *testing.T) {
 	repo.AssertExpectations(t)
 }
 
+func TestUpdateUserUseCase_Execute_UpdateMemberRoleError(t *testing.T) {
+	ctx := context.Background()
+	repo := &mockUserRepository{}
+	orgs := &mockOrganizationRepository{}
+
+	uc := NewUpdateUserUseCase(repo, orgs, &mockPasswordHasher{}, nil)
+
+	repoErr := errors.New("database error")
+	orgs.On("GetMembership", ctx, int64(1), int64(1)).Return(&domainuser.Membership{OrgID: 1, UserID: 1, Role: domainuser.RoleAuthor}, nil)
+	orgs.On("UpdateMemberRole", ctx, int64(1), int64(1), domainuser.RoleEditor).Return(repoErr)
+	repo.On("GetByID", ctx, int64(1), int64(1)).Return(&domainuser.User{ID: 1}, nil)
+
+	result, err := uc.Execute(ctx, 1, 1, dto.UpdateUserRequest{Role: "editor"})
+
+	assert.Nil(t, result)
+	assert.Equal(t, repoErr, err)
+	orgs.AssertExpectations(t)
+}
@@ -0,0 +1,43 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	domainuser "github.com/rulzi/hexa-go/internal/domain/user"
+)
+
+// VerificationSender signs email verification links and sends them to users.
+// It is shared by the use cases that need to send verification emails.
+type VerificationSender struct {
+	signer              domainuser.EmailVerificationSigner
+	notificationService domainuser.NotificationService
+	linkTTL             time.Duration
+}
+
+// NewVerificationSender creates a new VerificationSender
+func NewVerificationSender(
+	signer domainuser.EmailVerificationSigner,
+	notificationService domainuser.NotificationService,
+	linkTTL time.Duration,
+) *VerificationSender {
+	return &VerificationSender{
+		signer:              signer,
+		notificationService: notificationService,
+		linkTTL:             linkTTL,
+	}
+}
+
+// Send signs a verification token for the user and emails it
+func (s *VerificationSender) Send(ctx context.Context, u *domainuser.User) error {
+	token, err := s.signer.Sign(domainuser.EmailVerificationClaims{
+		UserID:    u.ID,
+		Email:     u.Email,
+		ExpiresAt: time.Now().Add(s.linkTTL),
+	})
+	if err != nil {
+		return err
+	}
+
+	return s.notificationService.SendVerificationEmail(ctx, u.Email, u.Name, token)
+}
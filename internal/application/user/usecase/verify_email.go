@@ -0,0 +1,55 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	domainuser "github.com/rulzi/hexa-go/internal/domain/user"
+)
+
+// VerifyEmailUseCase handles confirming an email address from a verification link
+type VerifyEmailUseCase struct {
+	userRepo domainuser.Repository
+	signer   domainuser.EmailVerificationSigner
+}
+
+// NewVerifyEmailUseCase creates a new VerifyEmailUseCase
+func NewVerifyEmailUseCase(userRepo domainuser.Repository, signer domainuser.EmailVerificationSigner) *VerifyEmailUseCase {
+	return &VerifyEmailUseCase{
+		userRepo: userRepo,
+		signer:   signer,
+	}
+}
+
+// Execute executes the verify email use case.
+// Verifying an already verified address succeeds without changes.
+func (uc *VerifyEmailUseCase) Execute(ctx context.Context, token string) error {
+	claims, err := uc.signer.Verify(token)
+	if err != nil {
+		return domainuser.ErrInvalidVerificationToken
+	}
+
+	existingUser, err := uc.userRepo.GetByID(ctx, 0, claims.UserID)
+	if err != nil {
+		if err == domainuser.ErrUserNotFound {
+			return domainuser.ErrInvalidVerificationToken
+		}
+		return err
+	}
+
+	// Links sent to a previous address are no longer valid
+	if existingUser.Email != claims.Email {
+		return domainuser.ErrInvalidVerificationToken
+	}
+
+	if existingUser.IsEmailVerified() {
+		return nil
+	}
+
+	now := time.Now()
+	existingUser.EmailVerifiedAt = &now
+	existingUser.UpdatedAt = now
+
+	_, err = uc.userRepo.Update(ctx, 0, existingUser)
+	return err
+}
@@ -0,0 +1,79 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	domainuser "github.com/rulzi/hexa-go/internal/domain/user"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestVerifyEmailUseCase_Execute(t *testing.T) {
+	verifiedAt := time.Now().Add(-time.Hour)
+	claims := &domainuser.EmailVerificationClaims{UserID: 1, Email: "test@example.com", ExpiresAt: time.Now().Add(time.Hour)}
+
+	tests := []struct {
+		name       string
+		setupMocks func(*mockUserRepository, *mockEmailVerificationSigner)
+		wantErr    error
+	}{
+		{
+			name: "success",
+			setupMocks: func(repo *mockUserRepository, signer *mockEmailVerificationSigner) {
+				signer.On("Verify", "token").Return(claims, nil)
+				repo.On("GetByID", mock.Anything, int64(0), int64(1)).Return(&domainuser.User{ID: 1, Email: "test@example.com"}, nil)
+				repo.On("Update", mock.Anything, int64(0), mock.MatchedBy(func(u *domainuser.User) bool {
+					return u.IsEmailVerified()
+				})).Return(&domainuser.User{ID: 1}, nil)
+			},
+		},
+		{
+			name: "already verified",
+			setupMocks: func(repo *mockUserRepository, signer *mockEmailVerificationSigner) {
+				signer.On("Verify", "token").Return(claims, nil)
+				repo.On("GetByID", mock.Anything, int64(0), int64(1)).Return(&domainuser.User{ID: 1, Email: "test@example.com", EmailVerifiedAt: &verifiedAt}, nil)
+			},
+		},
+		{
+			name: "invalid signature",
+			setupMocks: func(repo *mockUserRepository, signer *mockEmailVerificationSigner) {
+				signer.On("Verify", "token").Return(nil, errors.New("bad signature"))
+			},
+			wantErr: domainuser.ErrInvalidVerificationToken,
+		},
+		{
+			name: "user not found",
+			setupMocks: func(repo *mockUserRepository, signer *mockEmailVerificationSigner) {
+				signer.On("Verify", "token").Return(claims, nil)
+				repo.On("GetByID", mock.Anything, int64(0), int64(1)).Return(nil, domainuser.ErrUserNotFound)
+			},
+			wantErr: domainuser.ErrInvalidVerificationToken,
+		},
+		{
+			name: "email changed since link was sent",
+			setupMocks: func(repo *mockUserRepository, signer *mockEmailVerificationSigner) {
+				signer.On("Verify", "token").Return(claims, nil)
+				repo.On("GetByID", mock.Anything, int64(0), int64(1)).Return(&domainuser.User{ID: 1, Email: "new@example.com"}, nil)
+			},
+			wantErr: domainuser.ErrInvalidVerificationToken,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &mockUserRepository{}
+			signer := &mockEmailVerificationSigner{}
+			tt.setupMocks(repo, signer)
+
+			uc := NewVerifyEmailUseCase(repo, signer)
+			err := uc.Execute(context.Background(), "token")
+
+			assert.Equal(t, tt.wantErr, err)
+			repo.AssertExpectations(t)
+			signer.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,65 @@
+package article
+
+// Actor identifies the authenticated user acting on articles
+// and the organization the user is signed in to
+type Actor struct {
+	UserID   int64
+	OrgID    int64
+	IsAdmin  bool
+	IsEditor bool
+	ActorID  int64 // admin impersonating the user, zero otherwise
+}
+
+// RecordedID returns the ID recorded as having changed an article,
+// the impersonating admin rather than the user they act as
+func (a Actor) RecordedID() int64 {
+	if a.ActorID != 0 {
+		return a.ActorID
+	}
+	return a.UserID
+}
+
+// CanModify reports whether the actor may update or delete the article.
+// Articles of other organizations are never modifiable, not even by admins.
+func (a Actor) CanModify(article *Article) bool {
+	if article.OrgID != a.OrgID {
+		return false
+	}
+	return a.IsAdmin || (a.UserID > 0 && article.AuthorID == a.UserID)
+}
+
+// CanReview reports whether the actor may publish the article or send it back to its author
+func (a Actor) CanReview(article *Article) bool {
+	return article.OrgID == a.OrgID && (a.IsAdmin || a.IsEditor)
+}
+
+// CanView reports whether the actor may read the article.
+// Everyone reads published articles, authors their own articles whatever their status,
+// editors the articles waiting for review and admins every article of their organization.
+func (a Actor) CanView(article *Article) bool {
+	if article.OrgID != a.OrgID {
+		return false
+	}
+	switch {
+	case article.Status == StatusPublished, a.IsAdmin:
+		return true
+	case a.UserID > 0 && article.AuthorID == a.UserID:
+		return true
+	case a.IsEditor && article.Status == StatusInReview:
+		return true
+	}
+	return false
+}
+
+// ListFilter returns the filter selecting the articles the actor may view, see CanView
+func (a Actor) ListFilter() ListFilter {
+	filter := ListFilter{OrgID: a.OrgID, AuthorID: a.UserID}
+	switch {
+	case a.IsAdmin:
+		filter.AuthorID = 0
+		filter.Statuses = []Status{StatusDraft, StatusInReview, StatusArchived}
+	case a.IsEditor:
+		filter.Statuses = []Status{StatusInReview}
+	}
+	return filter
+}
@@ -0,0 +1,78 @@
+package article
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestActor_CanModify(t *testing.T) {
+	article := &Article{ID: 1, AuthorID: 7, OrgID: 3}
+
+	tests := []struct {
+		name  string
+		actor Actor
+		want  bool
+	}{
+		{name: "owner", actor: Actor{UserID: 7, OrgID: 3}, want: true},
+		{name: "other user", actor: Actor{UserID: 8, OrgID: 3}, want: false},
+		{name: "admin", actor: Actor{UserID: 8, OrgID: 3, IsAdmin: true}, want: true},
+		{name: "owner signed in to another organization", actor: Actor{UserID: 7, OrgID: 4}, want: false},
+		{name: "admin of another organization", actor: Actor{UserID: 8, OrgID: 4, IsAdmin: true}, want: false},
+		{name: "anonymous", actor: Actor{}, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.actor.CanModify(article))
+		})
+	}
+}
+
+func TestActor_CanView(t *testing.T) {
+	tests := []struct {
+		name   string
+		actor  Actor
+		status Status
+		want   bool
+	}{
+		{name: "reader sees published", actor: Actor{UserID: 8, OrgID: 3}, status: StatusPublished, want: true},
+		{name: "reader doesn't see drafts", actor: Actor{UserID: 8, OrgID: 3}, status: StatusDraft, want: false},
+		{name: "author sees own draft", actor: Actor{UserID: 7, OrgID: 3}, status: StatusDraft, want: true},
+		{name: "author sees own archived", actor: Actor{UserID: 7, OrgID: 3}, status: StatusArchived, want: true},
+		{name: "editor sees review queue", actor: Actor{UserID: 8, OrgID: 3, IsEditor: true}, status: StatusInReview, want: true},
+		{name: "editor doesn't see others' drafts", actor: Actor{UserID: 8, OrgID: 3, IsEditor: true}, status: StatusDraft, want: false},
+		{name: "admin sees everything", actor: Actor{UserID: 8, OrgID: 3, IsAdmin: true}, status: StatusArchived, want: true},
+		{name: "published of another organization", actor: Actor{UserID: 8, OrgID: 4}, status: StatusPublished, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			article := &Article{ID: 1, AuthorID: 7, OrgID: 3, Status: tt.status}
+			assert.Equal(t, tt.want, tt.actor.CanView(article))
+		})
+	}
+}
+
+func TestActor_ListFilter(t *testing.T) {
+	tests := []struct {
+		name  string
+		actor Actor
+		want  ListFilter
+	}{
+		{name: "reader", actor: Actor{UserID: 7, OrgID: 3}, want: ListFilter{OrgID: 3, AuthorID: 7}},
+		{name: "editor", actor: Actor{UserID: 7, OrgID: 3, IsEditor: true}, want: ListFilter{OrgID: 3, AuthorID: 7, Statuses: []Status{StatusInReview}}},
+		{name: "admin", actor: Actor{UserID: 7, OrgID: 3, IsAdmin: true}, want: ListFilter{OrgID: 3, Statuses: []Status{StatusDraft, StatusInReview, StatusArchived}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.actor.ListFilter())
+		})
+	}
+}
+
+func TestActor_RecordedID(t *testing.T) {
+	assert.Equal(t, int64(7), Actor{UserID: 7, OrgID: 3}.RecordedID())
+	assert.Equal(t, int64(2), Actor{UserID: 7, OrgID: 3, ActorID: 2}.RecordedID())
+}
@@ -9,7 +9,10 @@ type Cache interface {
 	// Get retrieves a cached article by ID
 	Get(ctx context.Context, id int64) (*Article, error)
 
-	// Set stores an article in cache
+	// GetBySlug retrieves a cached article of an organization by its slug
+	GetBySlug(ctx context.Context, orgID int64, slug string) (*Article, error)
+
+	// Set stores an article in cache under its ID and its slug
 	Set(ctx context.Context, id int64, article *Article) error
 
 	// Delete removes an article from cache
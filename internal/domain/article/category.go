@@ -0,0 +1,109 @@
+package article
+
+import (
+	"context"
+	"time"
+)
+
+// Category files articles of an organization in a tree, an article belongs to at most one category.
+// Listing the articles of a category also lists the articles of its subcategories.
+type Category struct {
+	ID        int64     `json:"id"`
+	OrgID     int64     `json:"org_id"`
+	ParentID  *int64    `json:"parent_id,omitempty"` // Nil for a top level category
+	Name      string    `json:"name"`
+	Slug      string    `json:"slug"` // Unique within the organization, derived from the name
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+	CreatedBy int64     `json:"created_by,omitempty"` // User who created the category, the admin when impersonating
+	UpdatedBy int64     `json:"updated_by,omitempty"` // User who last changed the category
+}
+
+// Validate validates the category entity
+func (c *Category) Validate() error {
+	if c.Name == "" {
+		return ErrCategoryNameRequired
+	}
+	if c.ParentID != nil && c.ID != 0 && *c.ParentID == c.ID {
+		return ErrCategoryCycle
+	}
+	return nil
+}
+
+// CategoryRepository is the driven port for category persistence.
+// Every lookup is scoped to an organization, a category of another organization is reported as not found.
+type CategoryRepository interface {
+	// Create creates a new category, returns ErrCategoryExists if the organization already has a category with its slug
+	Create(ctx context.Context, category *Category) (*Category, error)
+
+	// GetByID retrieves a category of an organization by ID, returns ErrCategoryNotFound if unknown
+	GetByID(ctx context.Context, orgID, id int64) (*Category, error)
+
+	// Update renames or moves a category, returns ErrCategoryExists if the organization already has a category with its new slug
+	Update(ctx context.Context, category *Category) (*Category, error)
+
+	// Delete deletes a category of an organization, its articles are left without category.
+	// Returns ErrCategoryNotFound if unknown and ErrCategoryHasChildren if it still has subcategories.
+	Delete(ctx context.Context, orgID, id int64) error
+
+	// List retrieves every category of an organization by name
+	List(ctx context.Context, orgID int64) ([]*Category, error)
+}
+
+// CategorySubtree returns the ID of a category followed by the IDs of all its descendants
+// among the categories of its organization, or ErrCategoryNotFound if the category isn't one of them
+func CategorySubtree(categories []*Category, id int64) ([]int64, error) {
+	children := make(map[int64][]int64)
+	found := false
+	for _, c := range categories {
+		if c.ID == id {
+			found = true
+		}
+		if c.ParentID != nil {
+			children[*c.ParentID] = append(children[*c.ParentID], c.ID)
+		}
+	}
+	if !found {
+		return nil, ErrCategoryNotFound
+	}
+
+	// Breadth first, skipping categories already reached in case concurrent moves left a cycle behind
+	subtree := []int64{id}
+	seen := map[int64]bool{id: true}
+	for i := 0; i < len(subtree); i++ {
+		for _, child := range children[subtree[i]] {
+			if !seen[child] {
+				seen[child] = true
+				subtree = append(subtree, child)
+			}
+		}
+	}
+	return subtree, nil
+}
+
+// CheckCategoryParent makes sure a category can be moved under the given parent among the categories
+// of its organization: the parent must exist and must not be the category itself or one of its descendants
+func CheckCategoryParent(categories []*Category, id int64, parentID *int64) error {
+	if parentID == nil {
+		return nil
+	}
+
+	parents := make(map[int64]*int64, len(categories))
+	for _, c := range categories {
+		parents[c.ID] = c.ParentID
+	}
+	if _, ok := parents[*parentID]; !ok {
+		return ErrCategoryNotFound
+	}
+
+	// Walk up from the new parent, the category must not be found on the way to the root.
+	// A walk longer than the number of categories went round an existing cycle.
+	steps := 0
+	for ancestor := parentID; ancestor != nil; ancestor = parents[*ancestor] {
+		if *ancestor == id || steps > len(categories) {
+			return ErrCategoryCycle
+		}
+		steps++
+	}
+	return nil
+}
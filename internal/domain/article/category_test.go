@@ -0,0 +1,65 @@
+package article
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// categoryTree is news > {sport > football, politics} and blog
+func categoryTree() []*Category {
+	parent := func(id int64) *int64 { return &id }
+	return []*Category{
+		{ID: 1, Name: "News"},
+		{ID: 2, Name: "Sport", ParentID: parent(1)},
+		{ID: 3, Name: "Football", ParentID: parent(2)},
+		{ID: 4, Name: "Politics", ParentID: parent(1)},
+		{ID: 5, Name: "Blog"},
+	}
+}
+
+func TestCategory_Validate(t *testing.T) {
+	self := int64(1)
+
+	assert.NoError(t, (&Category{ID: 1, Name: "News"}).Validate())
+	assert.Equal(t, ErrCategoryNameRequired, (&Category{}).Validate())
+	assert.Equal(t, ErrCategoryCycle, (&Category{ID: 1, Name: "News", ParentID: &self}).Validate())
+}
+
+func TestCategorySubtree(t *testing.T) {
+	subtree, err := CategorySubtree(categoryTree(), 1)
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, []int64{1, 2, 3, 4}, subtree)
+	assert.Equal(t, int64(1), subtree[0])
+
+	subtree, err = CategorySubtree(categoryTree(), 5)
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{5}, subtree)
+
+	_, err = CategorySubtree(categoryTree(), 9)
+	assert.Equal(t, ErrCategoryNotFound, err)
+}
+
+func TestCheckCategoryParent(t *testing.T) {
+	id := func(id int64) *int64 { return &id }
+
+	tests := []struct {
+		name     string
+		category int64
+		parentID *int64
+		wantErr  error
+	}{
+		{name: "move to top level", category: 3, parentID: nil},
+		{name: "move under a sibling branch", category: 3, parentID: id(4)},
+		{name: "new category", category: 0, parentID: id(3)},
+		{name: "under itself", category: 2, parentID: id(2), wantErr: ErrCategoryCycle},
+		{name: "under a descendant", category: 1, parentID: id(3), wantErr: ErrCategoryCycle},
+		{name: "unknown parent", category: 2, parentID: id(9), wantErr: ErrCategoryNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.wantErr, CheckCategoryParent(categoryTree(), tt.category, tt.parentID))
+		})
+	}
+}
@@ -4,12 +4,21 @@ import "time"
 
 // Article represents the article entity in the domain
 type Article struct {
-	ID        int64     `json:"id"`
-	Title     string    `json:"title"`
-	Content   string    `json:"content"`
-	AuthorID  int64     `json:"author_id"`
-	CreatedAt time.Time `json:"created_at"`
-	UpdatedAt time.Time `json:"updated_at"`
+	ID          int64      `json:"id"`
+	Title       string     `json:"title"`
+	Slug        string     `json:"slug"` // Unique within the organization, derived from the title
+	Content     string     `json:"content"`
+	AuthorID    int64      `json:"author_id"`
+	OrgID       int64      `json:"org_id"`
+	CategoryID  *int64     `json:"category_id,omitempty"` // Nil for an article without category
+	Status      Status     `json:"status"`
+	PublishedAt *time.Time `json:"published_at,omitempty"` // When the article was first published
+	PublishAt   *time.Time `json:"publish_at,omitempty"`   // When the article in review is scheduled to go live
+	CreatedAt   time.Time  `json:"created_at"`
+	UpdatedAt   time.Time  `json:"updated_at"`
+	CreatedBy   int64      `json:"created_by,omitempty"` // User who created the article, the admin when impersonating the author
+	UpdatedBy   int64      `json:"updated_by,omitempty"` // User who last changed the article, zero for the scheduler
+	DeletedAt   *time.Time `json:"deleted_at,omitempty"`
 }
 
 // Validate validates the article entity
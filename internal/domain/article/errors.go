@@ -11,4 +11,34 @@ var (
 	ErrContentRequired = errors.New("content is required")
 	// ErrAuthorIDRequired is returned when author ID is missing
 	ErrAuthorIDRequired = errors.New("author id is required")
+	// ErrForbidden is returned when the actor is not allowed to modify an article
+	ErrForbidden = errors.New("not allowed to modify this article")
+	// ErrInvalidStatus is returned when a status is not a known article status
+	ErrInvalidStatus = errors.New("invalid article status")
+	// ErrInvalidTransition is returned when an article can't move from its status to the requested one
+	ErrInvalidTransition = errors.New("article can't move to this status from its current status")
+	// ErrPublishAtInPast is returned when an article is scheduled to go live at a time already passed
+	ErrPublishAtInPast = errors.New("publish time must be in the future")
+	// ErrSlugUnavailable is returned when no unique slug could be derived from an article title
+	ErrSlugUnavailable = errors.New("no unique slug available for this title")
+	// ErrSlugTaken is returned when another article of the organization got the slug before the article was saved
+	ErrSlugTaken = errors.New("slug is already taken")
+	// ErrTagNotFound is returned when a tag is not found
+	ErrTagNotFound = errors.New("tag not found")
+	// ErrTagNameRequired is returned when tag name is missing
+	ErrTagNameRequired = errors.New("tag name is required")
+	// ErrTagExists is returned when the organization already has a tag with the same slug
+	ErrTagExists = errors.New("tag already exists")
+	// ErrCategoryNotFound is returned when a category is not found
+	ErrCategoryNotFound = errors.New("category not found")
+	// ErrCategoryNameRequired is returned when category name is missing
+	ErrCategoryNameRequired = errors.New("category name is required")
+	// ErrCategoryExists is returned when the organization already has a category with the same slug
+	ErrCategoryExists = errors.New("category already exists")
+	// ErrCategoryCycle is returned when a category is moved under itself or one of its descendants
+	ErrCategoryCycle = errors.New("category can't be moved under itself or one of its subcategories")
+	// ErrCategoryHasChildren is returned when a category with subcategories is deleted
+	ErrCategoryHasChildren = errors.New("category still has subcategories")
+	// ErrSearchQueryRequired is returned when a search query has no word to look for
+	ErrSearchQueryRequired = errors.New("search query is required")
 )
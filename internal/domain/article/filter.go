@@ -0,0 +1,12 @@
+package article
+
+// ListFilter selects the articles of an organization to list.
+// Published articles are always listed, the other ones only when they match AuthorID or Statuses.
+// TagID and CategoryIDs then narrow the selection down.
+type ListFilter struct {
+	OrgID       int64
+	AuthorID    int64    // Lists every article of this author whatever its status, zero for none
+	Statuses    []Status // Lists every article in these statuses whoever wrote it
+	TagID       int64    // Only lists the articles carrying this tag, zero for any
+	CategoryIDs []int64  // Only lists the articles in one of these categories, empty for any
+}
@@ -1,32 +1,89 @@
 package article
 
-import "context"
+import (
+	"context"
+	"time"
+)
 
 // Repository is the driven port (interface) for article persistence
-// This defines what the domain needs, not how it's implemented
+// This defines what the domain needs, not how it's implemented.
+// Every lookup made on behalf of a user is scoped to an organization, an article of
+// another organization is reported as not found.
 type Repository interface {
-	// Create creates a new article
+	// Create creates a new article, returns ErrSlugTaken if another article of the organization has its slug
 	Create(ctx context.Context, article *Article) (*Article, error)
 
-	// GetByID retrieves an article by ID
-	GetByID(ctx context.Context, id int64) (*Article, error)
+	// GetByID retrieves an article of an organization by ID
+	GetByID(ctx context.Context, orgID, id int64) (*Article, error)
 
-	// Update updates an existing article
+	// GetBySlug retrieves an article of an organization by its current slug
+	GetBySlug(ctx context.Context, orgID int64, slug string) (*Article, error)
+
+	// GetByPreviousSlug retrieves an article of an organization by a slug it had before its title changed
+	GetByPreviousSlug(ctx context.Context, orgID int64, slug string) (*Article, error)
+
+	// SlugTaken reports whether a slug is used by another article of an organization than the excluded one,
+	// as its current slug or as a previous one, including the articles in the trash
+	SlugTaken(ctx context.Context, orgID int64, slug string, excludeID int64) (bool, error)
+
+	// AddPreviousSlug records a slug an article no longer uses so that it still resolves to it
+	AddPreviousSlug(ctx context.Context, orgID, articleID int64, slug string) error
+
+	// Update updates an existing article within its organization,
+	// returns ErrSlugTaken if another article of the organization has its slug
 	Update(ctx context.Context, article *Article) (*Article, error)
 
-	// Delete deletes an article by ID
-	Delete(ctx context.Context, id int64) error
+	// Delete moves an article of an organization to the trash (soft delete)
+	Delete(ctx context.Context, orgID, id int64) error
 
-	// List retrieves all articles with pagination
-	List(ctx context.Context, limit, offset int) ([]*Article, error)
+	// List retrieves the articles of an organization selected by the filter with pagination
+	List(ctx context.Context, filter ListFilter, limit, offset int) ([]*Article, error)
 
-	// ListByAuthor retrieves articles by author ID with pagination
-	ListByAuthor(ctx context.Context, authorID int64, limit, offset int) ([]*Article, error)
+	// ListByAuthor retrieves the articles of an author in an organization with pagination
+	ListByAuthor(ctx context.Context, orgID, authorID int64, limit, offset int) ([]*Article, error)
 
-	// Count returns the total number of articles
-	Count(ctx context.Context) (int64, error)
+	// Count returns the total number of articles of an organization selected by the filter
+	Count(ctx context.Context, filter ListFilter) (int64, error)
 
-	// CountByAuthor returns the total number of articles by author
-	CountByAuthor(ctx context.Context, authorID int64) (int64, error)
-}
+	// CountByAuthor returns the total number of articles of an author in an organization
+	CountByAuthor(ctx context.Context, orgID, authorID int64) (int64, error)
+
+	// Restore takes an article of an organization out of the trash, returns ErrArticleNotFound if it is not in the trash
+	Restore(ctx context.Context, orgID, id int64) error
+
+	// ListDeleted retrieves the articles in the trash of an organization with pagination
+	ListDeleted(ctx context.Context, orgID int64, limit, offset int) ([]*Article, error)
+
+	// CountDeleted returns the number of articles in the trash of an organization
+	CountDeleted(ctx context.Context, orgID int64) (int64, error)
+
+	// ListIDsByCategory returns the IDs of the articles of an organization in a category,
+	// including the articles in the trash
+	ListIDsByCategory(ctx context.Context, orgID, categoryID int64) ([]int64, error)
 
+	// The methods below are used by background jobs and span every organization.
+
+	// PurgeDeletedBefore permanently deletes the articles moved to the trash before the given time
+	PurgeDeletedBefore(ctx context.Context, before time.Time) (int64, error)
+
+	// ListAllByAuthor retrieves the articles of an author with pagination, including the articles in the trash
+	ListAllByAuthor(ctx context.Context, authorID int64, limit, offset int) ([]*Article, error)
+
+	// DeleteByAuthor permanently deletes every article of an author in an organization, including the
+	// articles in the trash. orgID zero covers every organization.
+	DeleteByAuthor(ctx context.Context, orgID, authorID int64) (int64, error)
+
+	// ReassignAuthor moves every article of an author in an organization, including the articles in the
+	// trash, to another author. orgID zero covers every organization.
+	ReassignAuthor(ctx context.Context, orgID, fromID, toID int64) (int64, error)
+
+	// ListDueForPublishing retrieves the articles in review scheduled to go live at or before the given time
+	ListDueForPublishing(ctx context.Context, now time.Time, limit int) ([]*Article, error)
+
+	// PublishIfDue stores a published scheduled article only if it is still due at the given time and
+	// reports whether it did. Concurrent schedulers race on this update, only one of them wins.
+	PublishIfDue(ctx context.Context, article *Article, now time.Time) (bool, error)
+
+	// ListAfter retrieves the articles not in the trash with an ID above afterID by ID, used to walk every article in batches
+	ListAfter(ctx context.Context, afterID int64, limit int) ([]*Article, error)
+}
@@ -0,0 +1,169 @@
+package article
+
+import (
+	"context"
+	"html"
+	"strings"
+	"unicode"
+)
+
+// snippetLength is the number of characters of content shown around the first match of a search
+const snippetLength = 160
+
+// SearchQuery selects the articles matching a full text query among the articles the actor may view
+type SearchQuery struct {
+	Text   string
+	Actor  Actor
+	Limit  int
+	Offset int
+}
+
+// SearchHit is an article matching a search
+type SearchHit struct {
+	Article *Article
+	Score   float64 // Relevance of the article, only comparable within the same search
+	Snippet string  // Excerpt of the content around the first match, see Snippet
+}
+
+// SearchResult is a page of search hits by relevance with the total number of matching articles
+type SearchResult struct {
+	Hits  []*SearchHit
+	Total int64
+}
+
+// SearchIndex is the driven port for full text search over article titles and content.
+// A match in the title weighs more than a match in the content.
+type SearchIndex interface {
+	// Index adds an article to the index or replaces its indexed copy
+	Index(ctx context.Context, article *Article) error
+
+	// Remove takes an article out of the index, removing an article that isn't indexed is not an error
+	Remove(ctx context.Context, id int64) error
+
+	// Search retrieves a page of the articles matching any term of the query by relevance,
+	// the most recent article first among equally relevant ones
+	Search(ctx context.Context, query SearchQuery) (*SearchResult, error)
+}
+
+// SearchWords splits a text into its lower case words the way search indexes and queries see it
+func SearchWords(text string) []string {
+	return strings.FieldsFunc(strings.ToLower(text), isNotWordRune)
+}
+
+// SearchTerms splits a search query into its distinct lower case words, in their first order
+func SearchTerms(text string) []string {
+	var terms []string
+	seen := make(map[string]bool)
+	for _, word := range SearchWords(text) {
+		if !seen[word] {
+			seen[word] = true
+			terms = append(terms, word)
+		}
+	}
+	return terms
+}
+
+// Snippet returns an excerpt of about snippetLength characters of the content starting shortly before
+// the first word matching one of the terms, or the start of the content when no word matches.
+// The excerpt is HTML escaped and every matching word is wrapped in <mark></mark>, an ellipsis marks cut text.
+func Snippet(content string, terms []string) string {
+	match := make(map[string]bool, len(terms))
+	for _, term := range terms {
+		match[term] = true
+	}
+
+	runes := []rune(content)
+	words := wordSpans(runes)
+
+	// Center the window on the first match, leaving a third of it for the text before
+	start := 0
+	for _, w := range words {
+		if match[w.text] {
+			start = w.start - snippetLength/3
+			break
+		}
+	}
+	if start < 0 {
+		start = 0
+	}
+	end := start + snippetLength
+	if end >= len(runes) {
+		end = len(runes)
+		start = end - snippetLength
+		if start < 0 {
+			start = 0
+		}
+	}
+
+	// Cut the content between words only
+	if start > 0 {
+		for _, w := range words {
+			if w.start >= start {
+				start = w.start
+				break
+			}
+		}
+	}
+	if end < len(runes) {
+		for i := len(words) - 1; i >= 0; i-- {
+			if words[i].end <= end {
+				end = words[i].end
+				break
+			}
+		}
+	}
+	if end < start {
+		end = start
+	}
+
+	var b strings.Builder
+	if start > 0 {
+		b.WriteString("…")
+	}
+	pos := start
+	for _, w := range words {
+		if w.start < start || w.end > end || !match[w.text] {
+			continue
+		}
+		b.WriteString(html.EscapeString(string(runes[pos:w.start])))
+		b.WriteString("<mark>")
+		b.WriteString(html.EscapeString(string(runes[w.start:w.end])))
+		b.WriteString("</mark>")
+		pos = w.end
+	}
+	b.WriteString(html.EscapeString(string(runes[pos:end])))
+	if end < len(runes) {
+		b.WriteString("…")
+	}
+	return b.String()
+}
+
+// wordSpan is a word of a text, start and end are rune offsets
+type wordSpan struct {
+	text       string // Lower case
+	start, end int
+}
+
+// wordSpans returns the words of a text in order
+func wordSpans(runes []rune) []wordSpan {
+	var spans []wordSpan
+	start := -1
+	for i := 0; i <= len(runes); i++ {
+		if i < len(runes) && !isNotWordRune(runes[i]) {
+			if start < 0 {
+				start = i
+			}
+			continue
+		}
+		if start >= 0 {
+			spans = append(spans, wordSpan{text: strings.ToLower(string(runes[start:i])), start: start, end: i})
+			start = -1
+		}
+	}
+	return spans
+}
+
+// isNotWordRune reports whether the rune separates words
+func isNotWordRune(r rune) bool {
+	return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+}
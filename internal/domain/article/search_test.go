@@ -0,0 +1,86 @@
+package article
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSearchTerms(t *testing.T) {
+	tests := []struct {
+		text string
+		want []string
+	}{
+		{"Go generics", []string{"go", "generics"}},
+		{"  go, GO & Go!  ", []string{"go"}},
+		{"crème brûlée 2024", []string{"crème", "brûlée", "2024"}},
+		{"--- !!", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.text, func(t *testing.T) {
+			assert.Equal(t, tt.want, SearchTerms(tt.text))
+		})
+	}
+}
+
+func TestSnippet(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		terms   []string
+		want    string
+	}{
+		{
+			name:    "short content is highlighted whole",
+			content: "Go makes concurrency easy with goroutines.",
+			terms:   []string{"concurrency", "go"},
+			want:    "<mark>Go</mark> makes <mark>concurrency</mark> easy with goroutines.",
+		},
+		{
+			name:    "only whole words are highlighted",
+			content: "Going to the Go meetup",
+			terms:   []string{"go"},
+			want:    "Going to the <mark>Go</mark> meetup",
+		},
+		{
+			name:    "content is escaped",
+			content: "Use <b>go</b> & friends",
+			terms:   []string{"go"},
+			want:    "Use &lt;b&gt;<mark>go</mark>&lt;/b&gt; &amp; friends",
+		},
+		{
+			name:    "no match shows the start",
+			content: "Nothing to see here",
+			terms:   []string{"go"},
+			want:    "Nothing to see here",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, Snippet(tt.content, tt.terms))
+		})
+	}
+}
+
+func TestSnippet_Long(t *testing.T) {
+	content := strings.Repeat("lorem ipsum ", 50) + "the needle is here " + strings.Repeat("dolor sit ", 50)
+
+	snippet := Snippet(content, []string{"needle"})
+
+	assert.True(t, strings.HasPrefix(snippet, "…lorem") || strings.HasPrefix(snippet, "…ipsum"), snippet)
+	assert.True(t, strings.HasSuffix(snippet, "dolor…") || strings.HasSuffix(snippet, "sit…"), snippet)
+	assert.Contains(t, snippet, "the <mark>needle</mark> is here")
+	assert.LessOrEqual(t, len([]rune(strings.ReplaceAll(snippet, "<mark>needle</mark>", "needle"))), snippetLength+2)
+}
+
+func TestSnippet_MatchNearEnd(t *testing.T) {
+	content := strings.Repeat("lorem ipsum ", 50) + "the needle"
+
+	snippet := Snippet(content, []string{"needle"})
+
+	assert.True(t, strings.HasPrefix(snippet, "…"), snippet)
+	assert.True(t, strings.HasSuffix(snippet, "the <mark>needle</mark>"), snippet)
+}
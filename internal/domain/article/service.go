@@ -1,5 +1,14 @@
 package article
 
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// maxSlugAttempts bounds the numbered suffixes tried to make a slug unique
+const maxSlugAttempts = 1000
+
 // Service provides domain-level business logic for articles
 type Service struct {
 	repo Repository
@@ -10,3 +19,99 @@ func NewService(repo Repository) *Service {
 	return &Service{repo: repo}
 }
 
+// Transition moves the article to another status of the publishing workflow on behalf of the actor.
+// Authors submit their drafts for review, editors publish them or send them back,
+// and published articles are archived and reopened as drafts.
+func (s *Service) Transition(actor Actor, article *Article, to Status, now time.Time) error {
+	if !to.IsValid() {
+		return ErrInvalidStatus
+	}
+
+	var allowed bool
+	switch to {
+	case StatusPublished:
+		allowed = actor.CanReview(article)
+	case StatusDraft:
+		// Editors send the articles they review back to their authors
+		allowed = actor.CanModify(article) || (article.Status == StatusInReview && actor.CanReview(article))
+	case StatusArchived:
+		allowed = actor.CanModify(article) || actor.CanReview(article)
+	default:
+		allowed = actor.CanModify(article)
+	}
+	if !allowed {
+		return ErrForbidden
+	}
+
+	if !article.Status.CanTransitionTo(to) {
+		return ErrInvalidTransition
+	}
+
+	article.Status = to
+	if to == StatusPublished && article.PublishedAt == nil {
+		article.PublishedAt = &now
+	}
+	// A schedule only holds while the article waits in review
+	article.PublishAt = nil
+	article.UpdatedAt = now
+	article.UpdatedBy = actor.RecordedID()
+	return nil
+}
+
+// Schedule queues an article in review to be published at the given time on behalf of the actor,
+// a nil time cancels the schedule. Only the actors allowed to publish the article may schedule it.
+func (s *Service) Schedule(actor Actor, article *Article, publishAt *time.Time, now time.Time) error {
+	if !actor.CanReview(article) {
+		return ErrForbidden
+	}
+	if article.Status != StatusInReview {
+		return ErrInvalidTransition
+	}
+	if publishAt != nil && !publishAt.After(now) {
+		return ErrPublishAtInPast
+	}
+
+	article.PublishAt = publishAt
+	article.UpdatedAt = now
+	article.UpdatedBy = actor.RecordedID()
+	return nil
+}
+
+// PublishScheduled publishes an article whose schedule is due, on behalf of the scheduler
+func (s *Service) PublishScheduled(article *Article, now time.Time) error {
+	if article.Status != StatusInReview || article.PublishAt == nil || article.PublishAt.After(now) {
+		return ErrInvalidTransition
+	}
+
+	article.Status = StatusPublished
+	if article.PublishedAt == nil {
+		article.PublishedAt = &now
+	}
+	article.PublishAt = nil
+	article.UpdatedAt = now
+	article.UpdatedBy = 0
+	return nil
+}
+
+// AssignSlug derives the slug of the article from its title. A slug already used by another
+// article of the organization, now or in the past, gets a numbered suffix.
+func (s *Service) AssignSlug(ctx context.Context, article *Article) error {
+	base := Slugify(article.Title)
+	for attempt := 1; attempt <= maxSlugAttempts; attempt++ {
+		slug := base
+		if attempt > 1 {
+			slug = fmt.Sprintf("%s-%d", base, attempt)
+		}
+
+		taken, err := s.repo.SlugTaken(ctx, article.OrgID, slug, article.ID)
+		if err != nil {
+			return err
+		}
+		if !taken {
+			article.Slug = slug
+			return nil
+		}
+	}
+	return ErrSlugUnavailable
+}
+
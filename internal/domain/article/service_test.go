@@ -3,6 +3,7 @@ package article
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -10,13 +11,14 @@ import (
 // mockRepository is a mock implementation of Repository for testing
 type mockRepository struct {
 	createFunc        func(ctx context.Context, article *Article) (*Article, error)
-	getByIDFunc       func(ctx context.Context, id int64) (*Article, error)
+	getByIDFunc       func(ctx context.Context, orgID, id int64) (*Article, error)
 	updateFunc        func(ctx context.Context, article *Article) (*Article, error)
-	deleteFunc        func(ctx context.Context, id int64) error
-	listFunc          func(ctx context.Context, limit, offset int) ([]*Article, error)
-	listByAuthorFunc  func(ctx context.Context, authorID int64, limit, offset int) ([]*Article, error)
-	countFunc         func(ctx context.Context) (int64, error)
-	countByAuthorFunc func(ctx context.Context, authorID int64) (int64, error)
+	deleteFunc        func(ctx context.Context, orgID, id int64) error
+	listFunc          func(ctx context.Context, filter ListFilter, limit, offset int) ([]*Article, error)
+	listByAuthorFunc  func(ctx context.Context, orgID, authorID int64, limit, offset int) ([]*Article, error)
+	countFunc         func(ctx context.Context, filter ListFilter) (int64, error)
+	countByAuthorFunc func(ctx context.Context, orgID, authorID int64) (int64, error)
+	slugTakenFunc     func(ctx context.Context, orgID int64, slug string, excludeID int64) (bool, error)
 }
 
 func (m *mockRepository) Create(ctx context.Context, article *Article) (*Article, error) {
@@ -26,13 +28,32 @@ func (m *mockRepository) Create(ctx context.Context, article *Article) (*Article
 	return nil, nil
 }
 
-func (m *mockRepository) GetByID(ctx context.Context, id int64) (*Article, error) {
+func (m *mockRepository) GetByID(ctx context.Context, orgID, id int64) (*Article, error) {
 	if m.getByIDFunc != nil {
-		return m.getByIDFunc(ctx, id)
+		return m.getByIDFunc(ctx, orgID, id)
 	}
 	return nil, nil
 }
 
+func (m *mockRepository) GetBySlug(ctx context.Context, orgID int64, slug string) (*Article, error) {
+	return nil, nil
+}
+
+func (m *mockRepository) GetByPreviousSlug(ctx context.Context, orgID int64, slug string) (*Article, error) {
+	return nil, nil
+}
+
+func (m *mockRepository) SlugTaken(ctx context.Context, orgID int64, slug string, excludeID int64) (bool, error) {
+	if m.slugTakenFunc != nil {
+		return m.slugTakenFunc(ctx, orgID, slug, excludeID)
+	}
+	return false, nil
+}
+
+func (m *mockRepository) AddPreviousSlug(ctx context.Context, orgID, articleID int64, slug string) error {
+	return nil
+}
+
 func (m *mockRepository) Update(ctx context.Context, article *Article) (*Article, error) {
 	if m.updateFunc != nil {
 		return m.updateFunc(ctx, article)
@@ -40,37 +61,37 @@ func (m *mockRepository) Update(ctx context.Context, article *Article) (*Article
 	return nil, nil
 }
 
-func (m *mockRepository) Delete(ctx context.Context, id int64) error {
+func (m *mockRepository) Delete(ctx context.Context, orgID, id int64) error {
 	if m.deleteFunc != nil {
-		return m.deleteFunc(ctx, id)
+		return m.deleteFunc(ctx, orgID, id)
 	}
 	return nil
 }
 
-func (m *mockRepository) List(ctx context.Context, limit, offset int) ([]*Article, error) {
+func (m *mockRepository) List(ctx context.Context, filter ListFilter, limit, offset int) ([]*Article, error) {
 	if m.listFunc != nil {
-		return m.listFunc(ctx, limit, offset)
+		return m.listFunc(ctx, filter, limit, offset)
 	}
 	return nil, nil
 }
 
-func (m *mockRepository) ListByAuthor(ctx context.Context, authorID int64, limit, offset int) ([]*Article, error) {
+func (m *mockRepository) ListByAuthor(ctx context.Context, orgID, authorID int64, limit, offset int) ([]*Article, error) {
 	if m.listByAuthorFunc != nil {
-		return m.listByAuthorFunc(ctx, authorID, limit, offset)
+		return m.listByAuthorFunc(ctx, orgID, authorID, limit, offset)
 	}
 	return nil, nil
 }
 
-func (m *mockRepository) Count(ctx context.Context) (int64, error) {
+func (m *mockRepository) Count(ctx context.Context, filter ListFilter) (int64, error) {
 	if m.countFunc != nil {
-		return m.countFunc(ctx)
+		return m.countFunc(ctx, filter)
 	}
 	return 0, nil
 }
 
-func (m *mockRepository) CountByAuthor(ctx context.Context, authorID int64) (int64, error) {
+func (m *mockRepository) CountByAuthor(ctx context.Context, orgID, authorID int64) (int64, error) {
 	if m.countByAuthorFunc != nil {
-		return m.countByAuthorFunc(ctx, authorID)
+		return m.countByAuthorFunc(ctx, orgID, authorID)
 	}
 	return 0, nil
 }
@@ -98,3 +119,220 @@ func TestNewService(t *testing.T) {
 		})
 	}
 }
+
+func (m *mockRepository) Restore(ctx context.Context, orgID, id int64) error {
+	return nil
+}
+
+func (m *mockRepository) ListDeleted(ctx context.Context, orgID int64, limit, offset int) ([]*Article, error) {
+	return nil, nil
+}
+
+func (m *mockRepository) CountDeleted(ctx context.Context, orgID int64) (int64, error) {
+	return 0, nil
+}
+
+func (m *mockRepository) PurgeDeletedBefore(ctx context.Context, before time.Time) (int64, error) {
+	return 0, nil
+}
+
+func (m *mockRepository) ListAllByAuthor(ctx context.Context, authorID int64, limit, offset int) ([]*Article, error) {
+	return nil, nil
+}
+
+func (m *mockRepository) DeleteByAuthor(ctx context.Context, orgID, authorID int64) (int64, error) {
+	return 0, nil
+}
+
+func (m *mockRepository) ReassignAuthor(ctx context.Context, orgID, fromID, toID int64) (int64, error) {
+	return 0, nil
+}
+
+func (m *mockRepository) ListIDsByCategory(ctx context.Context, orgID, categoryID int64) ([]int64, error) {
+	return nil, nil
+}
+
+func (m *mockRepository) ListDueForPublishing(ctx context.Context, now time.Time, limit int) ([]*Article, error) {
+	return nil, nil
+}
+
+func (m *mockRepository) PublishIfDue(ctx context.Context, article *Article, now time.Time) (bool, error) {
+	return false, nil
+}
+
+func (m *mockRepository) ListAfter(ctx context.Context, afterID int64, limit int) ([]*Article, error) {
+	return nil, nil
+}
+
+func TestService_Transition(t *testing.T) {
+	author := Actor{UserID: 7, OrgID: 3}
+	editor := Actor{UserID: 8, OrgID: 3, IsEditor: true}
+	admin := Actor{UserID: 9, OrgID: 3, IsAdmin: true}
+	reader := Actor{UserID: 10, OrgID: 3}
+	otherEditor := Actor{UserID: 11, OrgID: 4, IsEditor: true}
+
+	tests := []struct {
+		name    string
+		actor   Actor
+		from    Status
+		to      Status
+		wantErr error
+	}{
+		{name: "author submits draft", actor: author, from: StatusDraft, to: StatusInReview},
+		{name: "author withdraws review", actor: author, from: StatusInReview, to: StatusDraft},
+		{name: "editor sends review back", actor: editor, from: StatusInReview, to: StatusDraft},
+		{name: "editor publishes", actor: editor, from: StatusInReview, to: StatusPublished},
+		{name: "admin publishes", actor: admin, from: StatusInReview, to: StatusPublished},
+		{name: "author archives", actor: author, from: StatusPublished, to: StatusArchived},
+		{name: "editor archives", actor: editor, from: StatusPublished, to: StatusArchived},
+		{name: "author reopens archived", actor: author, from: StatusArchived, to: StatusDraft},
+		{name: "author can't publish", actor: author, from: StatusInReview, to: StatusPublished, wantErr: ErrForbidden},
+		{name: "reader can't submit", actor: reader, from: StatusDraft, to: StatusInReview, wantErr: ErrForbidden},
+		{name: "editor can't submit someone else's draft", actor: editor, from: StatusDraft, to: StatusInReview, wantErr: ErrForbidden},
+		{name: "editor of another organization", actor: otherEditor, from: StatusInReview, to: StatusPublished, wantErr: ErrForbidden},
+		{name: "draft can't skip review", actor: admin, from: StatusDraft, to: StatusPublished, wantErr: ErrInvalidTransition},
+		{name: "archived can't be published", actor: editor, from: StatusArchived, to: StatusPublished, wantErr: ErrInvalidTransition},
+		{name: "unknown status", actor: admin, from: StatusDraft, to: Status("deleted"), wantErr: ErrInvalidStatus},
+	}
+
+	service := NewService(&mockRepository{})
+	now := time.Now()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			article := &Article{ID: 1, AuthorID: 7, OrgID: 3, Status: tt.from}
+
+			err := service.Transition(tt.actor, article, tt.to, now)
+
+			if tt.wantErr != nil {
+				assert.Equal(t, tt.wantErr, err)
+				assert.Equal(t, tt.from, article.Status)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.to, article.Status)
+			assert.Equal(t, now, article.UpdatedAt)
+		})
+	}
+}
+
+func TestService_Transition_PublishedAt(t *testing.T) {
+	service := NewService(&mockRepository{})
+	editor := Actor{UserID: 8, OrgID: 3, IsEditor: true}
+	firstPublished := time.Now().Add(-24 * time.Hour)
+
+	article := &Article{ID: 1, AuthorID: 7, OrgID: 3, Status: StatusInReview}
+	assert.NoError(t, service.Transition(editor, article, StatusPublished, firstPublished))
+	assert.Equal(t, firstPublished, *article.PublishedAt)
+
+	// Publishing again after a rework keeps the first publication date
+	article.Status = StatusInReview
+	assert.NoError(t, service.Transition(editor, article, StatusPublished, time.Now()))
+	assert.Equal(t, firstPublished, *article.PublishedAt)
+}
+
+func TestService_Schedule(t *testing.T) {
+	author := Actor{UserID: 7, OrgID: 3}
+	editor := Actor{UserID: 8, OrgID: 3, IsEditor: true}
+	now := time.Now()
+	later := now.Add(time.Hour)
+	earlier := now.Add(-time.Minute)
+
+	tests := []struct {
+		name      string
+		actor     Actor
+		status    Status
+		publishAt *time.Time
+		wantErr   error
+	}{
+		{name: "editor schedules article in review", actor: editor, status: StatusInReview, publishAt: &later},
+		{name: "editor cancels schedule", actor: editor, status: StatusInReview, publishAt: nil},
+		{name: "author can't schedule", actor: author, status: StatusInReview, publishAt: &later, wantErr: ErrForbidden},
+		{name: "draft can't be scheduled", actor: editor, status: StatusDraft, publishAt: &later, wantErr: ErrInvalidTransition},
+		{name: "time already passed", actor: editor, status: StatusInReview, publishAt: &earlier, wantErr: ErrPublishAtInPast},
+	}
+
+	service := NewService(&mockRepository{})
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			previous := now.Add(30 * time.Minute)
+			article := &Article{ID: 1, AuthorID: 7, OrgID: 3, Status: tt.status, PublishAt: &previous}
+
+			err := service.Schedule(tt.actor, article, tt.publishAt, now)
+
+			if tt.wantErr != nil {
+				assert.Equal(t, tt.wantErr, err)
+				assert.Equal(t, &previous, article.PublishAt)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.publishAt, article.PublishAt)
+		})
+	}
+}
+
+func TestService_PublishScheduled(t *testing.T) {
+	service := NewService(&mockRepository{})
+	now := time.Now()
+	due := now.Add(-time.Minute)
+	notDue := now.Add(time.Minute)
+
+	article := &Article{ID: 1, AuthorID: 7, OrgID: 3, Status: StatusInReview, PublishAt: &notDue}
+	assert.Equal(t, ErrInvalidTransition, service.PublishScheduled(article, now))
+	assert.Equal(t, StatusInReview, article.Status)
+
+	article.PublishAt = &due
+	assert.NoError(t, service.PublishScheduled(article, now))
+	assert.Equal(t, StatusPublished, article.Status)
+	assert.Equal(t, now, *article.PublishedAt)
+	assert.Nil(t, article.PublishAt)
+
+	// Articles moved out of review since they were scheduled are left alone
+	draft := &Article{ID: 2, AuthorID: 7, OrgID: 3, Status: StatusDraft, PublishAt: &due}
+	assert.Equal(t, ErrInvalidTransition, service.PublishScheduled(draft, now))
+}
+
+func TestService_Transition_ClearsSchedule(t *testing.T) {
+	service := NewService(&mockRepository{})
+	editor := Actor{UserID: 8, OrgID: 3, IsEditor: true}
+	publishAt := time.Now().Add(time.Hour)
+
+	article := &Article{ID: 1, AuthorID: 7, OrgID: 3, Status: StatusInReview, PublishAt: &publishAt}
+	assert.NoError(t, service.Transition(editor, article, StatusDraft, time.Now()))
+	assert.Nil(t, article.PublishAt)
+}
+
+func TestService_AssignSlug(t *testing.T) {
+	taken := map[string]bool{"hello-world": true, "hello-world-2": true}
+	repo := &mockRepository{
+		slugTakenFunc: func(ctx context.Context, orgID int64, slug string, excludeID int64) (bool, error) {
+			assert.Equal(t, int64(3), orgID)
+			assert.Equal(t, int64(1), excludeID)
+			return taken[slug], nil
+		},
+	}
+	service := NewService(repo)
+
+	article := &Article{ID: 1, OrgID: 3, Title: "Hello, World!"}
+	assert.NoError(t, service.AssignSlug(context.Background(), article))
+	assert.Equal(t, "hello-world-3", article.Slug)
+
+	article.Title = "Fresh title"
+	assert.NoError(t, service.AssignSlug(context.Background(), article))
+	assert.Equal(t, "fresh-title", article.Slug)
+}
+
+func TestService_AssignSlug_Unavailable(t *testing.T) {
+	repo := &mockRepository{
+		slugTakenFunc: func(ctx context.Context, orgID int64, slug string, excludeID int64) (bool, error) {
+			return true, nil
+		},
+	}
+	article := &Article{ID: 1, OrgID: 3, Title: "Popular"}
+
+	err := NewService(repo).AssignSlug(context.Background(), article)
+
+	assert.Equal(t, ErrSlugUnavailable, err)
+	assert.Empty(t, article.Slug)
+}
@@ -0,0 +1,70 @@
+package article
+
+import "strings"
+
+// maxSlugLength leaves room in the slug column for the suffix that makes a slug unique
+const maxSlugLength = 200
+
+// defaultSlug is used for titles without any letter or digit that can be transliterated
+const defaultSlug = "article"
+
+// transliterations maps the lower case letters outside a-z to their ASCII spelling,
+// letters missing from it are dropped from slugs
+var transliterations = buildTransliterations(map[string]string{
+	"àáâãäåāăą": "a", "çćĉċč": "c", "ďđð": "d", "èéêëēĕėęě": "e", "ĝğġģ": "g",
+	"ĥħ": "h", "ìíîïĩīĭįı": "i", "ĵ": "j", "ķ": "k", "ĺļľŀł": "l",
+	"ñńņňŉ": "n", "òóôõöøōŏő": "o", "ŕŗř": "r", "śŝşšș": "s", "ţťŧț": "t",
+	"ùúûüũūŭůűų": "u", "ŵ": "w", "ýÿŷ": "y", "źżž": "z",
+	"ß": "ss", "æ": "ae", "œ": "oe", "þ": "th",
+	"а": "a", "б": "b", "в": "v", "г": "g", "д": "d", "еэ": "e", "ё": "yo",
+	"ж": "zh", "з": "z", "и": "i", "йы": "y", "к": "k", "л": "l", "м": "m",
+	"н": "n", "о": "o", "п": "p", "р": "r", "с": "s", "т": "t", "у": "u",
+	"ф": "f", "х": "kh", "ц": "ts", "ч": "ch", "ш": "sh", "щ": "shch",
+	"ю": "yu", "я": "ya",
+	// Signs and apostrophes are dropped without splitting the word
+	"ъь'’": "",
+})
+
+// buildTransliterations expands groups of letters sharing the same spelling
+func buildTransliterations(groups map[string]string) map[rune]string {
+	table := make(map[rune]string)
+	for letters, spelling := range groups {
+		for _, r := range letters {
+			table[r] = spelling
+		}
+	}
+	return table
+}
+
+// Slugify turns a title into a lower case, ASCII, dash separated slug
+func Slugify(title string) string {
+	var b strings.Builder
+	separate := false
+	for _, r := range strings.ToLower(title) {
+		spelling, ok := transliterations[r]
+		if !ok {
+			spelling = string(r)
+		}
+
+		for _, c := range spelling {
+			if (c < 'a' || c > 'z') && (c < '0' || c > '9') {
+				separate = true
+				continue
+			}
+			if separate && b.Len() > 0 {
+				b.WriteByte('-')
+			}
+			separate = false
+			b.WriteRune(c)
+		}
+	}
+
+	slug := b.String()
+	if len(slug) > maxSlugLength {
+		slug = strings.TrimRight(slug[:maxSlugLength], "-")
+	}
+	if slug == "" {
+		return defaultSlug
+	}
+	return slug
+}
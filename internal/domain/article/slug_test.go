@@ -0,0 +1,36 @@
+package article
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSlugify(t *testing.T) {
+	tests := []struct {
+		title string
+		want  string
+	}{
+		{"Hello World", "hello-world"},
+		{"  Go 1.23: What's New?  ", "go-1-23-whats-new"},
+		{"Crème brûlée à la française", "creme-brulee-a-la-francaise"},
+		{"Straße über Łódź", "strasse-uber-lodz"},
+		{"Привет мир", "privet-mir"},
+		{"日本語", "article"},
+		{"---", "article"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.title, func(t *testing.T) {
+			assert.Equal(t, tt.want, Slugify(tt.title))
+		})
+	}
+}
+
+func TestSlugify_Long(t *testing.T) {
+	slug := Slugify(strings.Repeat("word ", 100))
+
+	assert.LessOrEqual(t, len(slug), maxSlugLength)
+	assert.False(t, strings.HasSuffix(slug, "-"))
+}
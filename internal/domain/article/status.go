@@ -0,0 +1,39 @@
+package article
+
+// Status is the stage of an article in the publishing workflow
+type Status string
+
+const (
+	// StatusDraft is an article its author is still writing
+	StatusDraft Status = "draft"
+	// StatusInReview is an article waiting for an editor to publish it
+	StatusInReview Status = "in_review"
+	// StatusPublished is an article every member of the organization can read
+	StatusPublished Status = "published"
+	// StatusArchived is an article taken offline after it was published
+	StatusArchived Status = "archived"
+)
+
+// transitions maps every status to the statuses an article may move to from it
+var transitions = map[Status][]Status{
+	StatusDraft:     {StatusInReview},
+	StatusInReview:  {StatusDraft, StatusPublished},
+	StatusPublished: {StatusArchived},
+	StatusArchived:  {StatusDraft},
+}
+
+// IsValid reports whether the status is a known status
+func (s Status) IsValid() bool {
+	_, ok := transitions[s]
+	return ok
+}
+
+// CanTransitionTo reports whether an article may move from the status to another one
+func (s Status) CanTransitionTo(to Status) bool {
+	for _, allowed := range transitions[s] {
+		if allowed == to {
+			return true
+		}
+	}
+	return false
+}
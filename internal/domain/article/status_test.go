@@ -0,0 +1,37 @@
+package article
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStatus_CanTransitionTo(t *testing.T) {
+	tests := []struct {
+		from Status
+		to   Status
+		want bool
+	}{
+		{from: StatusDraft, to: StatusInReview, want: true},
+		{from: StatusDraft, to: StatusPublished, want: false},
+		{from: StatusInReview, to: StatusDraft, want: true},
+		{from: StatusInReview, to: StatusPublished, want: true},
+		{from: StatusPublished, to: StatusArchived, want: true},
+		{from: StatusPublished, to: StatusDraft, want: false},
+		{from: StatusArchived, to: StatusDraft, want: true},
+		{from: StatusArchived, to: StatusPublished, want: false},
+		{from: Status("unknown"), to: StatusDraft, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.from)+" to "+string(tt.to), func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.from.CanTransitionTo(tt.to))
+		})
+	}
+}
+
+func TestStatus_IsValid(t *testing.T) {
+	assert.True(t, StatusInReview.IsValid())
+	assert.False(t, Status("").IsValid())
+	assert.False(t, Status("deleted").IsValid())
+}
@@ -0,0 +1,60 @@
+package article
+
+import (
+	"context"
+	"time"
+)
+
+// Tag labels articles of an organization, an article carries any number of tags
+type Tag struct {
+	ID        int64     `json:"id"`
+	OrgID     int64     `json:"org_id"`
+	Name      string    `json:"name"`
+	Slug      string    `json:"slug"` // Unique within the organization, derived from the name
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+	CreatedBy int64     `json:"created_by,omitempty"` // User who created the tag, the admin when impersonating
+	UpdatedBy int64     `json:"updated_by,omitempty"` // User who last renamed the tag
+}
+
+// Validate validates the tag entity
+func (t *Tag) Validate() error {
+	if t.Name == "" {
+		return ErrTagNameRequired
+	}
+	return nil
+}
+
+// TagCount is a tag with the number of articles carrying it
+type TagCount struct {
+	Tag          *Tag
+	ArticleCount int64
+}
+
+// TagRepository is the driven port for tag persistence and the article-tag join.
+// Every lookup is scoped to an organization, a tag of another organization is reported as not found.
+type TagRepository interface {
+	// Create creates a new tag, returns ErrTagExists if the organization already has a tag with its slug
+	Create(ctx context.Context, tag *Tag) (*Tag, error)
+
+	// GetByID retrieves a tag of an organization by ID, returns ErrTagNotFound if unknown
+	GetByID(ctx context.Context, orgID, id int64) (*Tag, error)
+
+	// GetByIDs retrieves the tags of an organization among the given IDs, unknown IDs are skipped
+	GetByIDs(ctx context.Context, orgID int64, ids []int64) ([]*Tag, error)
+
+	// Update renames a tag, returns ErrTagExists if the organization already has a tag with its new slug
+	Update(ctx context.Context, tag *Tag) (*Tag, error)
+
+	// Delete deletes a tag of an organization and takes it off its articles, returns ErrTagNotFound if unknown
+	Delete(ctx context.Context, orgID, id int64) error
+
+	// List retrieves the tags of an organization by name, each counting the articles selected by the filter
+	List(ctx context.Context, filter ListFilter) ([]*TagCount, error)
+
+	// ListByArticle retrieves the tags of an article by name
+	ListByArticle(ctx context.Context, orgID, articleID int64) ([]*Tag, error)
+
+	// SetArticleTags replaces the tags of an article
+	SetArticleTags(ctx context.Context, articleID int64, tagIDs []int64) error
+}
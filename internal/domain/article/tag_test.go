@@ -0,0 +1,12 @@
+package article
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTag_Validate(t *testing.T) {
+	assert.NoError(t, (&Tag{Name: "Go"}).Validate())
+	assert.Equal(t, ErrTagNameRequired, (&Tag{}).Validate())
+}
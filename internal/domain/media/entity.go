@@ -4,11 +4,16 @@ import "time"
 
 // Media represents the media entity in the domain
 type Media struct {
-	ID        int64     `json:"id"`
-	Name      string    `json:"name"`
-	Path      string    `json:"path"`
-	CreatedAt time.Time `json:"created_at"`
-	UpdatedAt time.Time `json:"updated_at"`
+	ID        int64      `json:"id"`
+	OwnerID   int64      `json:"owner_id,omitempty"` // Zero for media uploaded before owners were recorded
+	OrgID     int64      `json:"org_id"`
+	Name      string     `json:"name"`
+	Path      string     `json:"path"`
+	CreatedAt time.Time  `json:"created_at"`
+	UpdatedAt time.Time  `json:"updated_at"`
+	CreatedBy int64      `json:"created_by,omitempty"` // User who uploaded the file, the admin when impersonating the owner
+	UpdatedBy int64      `json:"updated_by,omitempty"` // User who last replaced the file
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
 }
 
 // Validate validates the media entity
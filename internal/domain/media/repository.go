@@ -1,6 +1,9 @@
 package media
 
-import "context"
+import (
+	"context"
+	"time"
+)
 
 // Repository is the driven port (interface) for media persistence
 // This defines what the domain needs, not how it's implemented
@@ -8,18 +11,45 @@ type Repository interface {
 	// Create creates a new media
 	Create(ctx context.Context, media *Media) (*Media, error)
 
-	// GetByID retrieves a media by ID
-	GetByID(ctx context.Context, id int64) (*Media, error)
+	// GetByID retrieves a media of an organization by ID
+	GetByID(ctx context.Context, orgID, id int64) (*Media, error)
 
-	// Update updates an existing media
+	// Update updates an existing media within its organization
 	Update(ctx context.Context, media *Media) (*Media, error)
 
-	// Delete deletes a media by ID
-	Delete(ctx context.Context, id int64) error
+	// Delete moves a media of an organization to the trash (soft delete), the file stays in storage until purged
+	Delete(ctx context.Context, orgID, id int64) error
 
-	// List retrieves all media with pagination
-	List(ctx context.Context, limit, offset int) ([]*Media, error)
+	// List retrieves the media of an organization with pagination
+	List(ctx context.Context, orgID int64, limit, offset int) ([]*Media, error)
 
-	// Count returns the total number of media
-	Count(ctx context.Context) (int64, error)
+	// Count returns the number of media of an organization
+	Count(ctx context.Context, orgID int64) (int64, error)
+
+	// Restore takes a media of an organization out of the trash, returns ErrMediaNotFound if it is not in the trash
+	Restore(ctx context.Context, orgID, id int64) error
+
+	// ListDeleted retrieves the media in the trash of an organization with pagination
+	ListDeleted(ctx context.Context, orgID int64, limit, offset int) ([]*Media, error)
+
+	// CountDeleted returns the number of media in the trash of an organization
+	CountDeleted(ctx context.Context, orgID int64) (int64, error)
+
+	// The methods below are used by background jobs and public file serving and span every organization.
+
+	// GetByPath retrieves the media stored at a path, returns ErrMediaNotFound if it is unknown or in the trash
+	GetByPath(ctx context.Context, path string) (*Media, error)
+
+	// ListDeletedBefore retrieves up to limit media moved to the trash before the given time
+	ListDeletedBefore(ctx context.Context, before time.Time, limit int) ([]*Media, error)
+
+	// Purge permanently deletes a media that is in the trash
+	Purge(ctx context.Context, id int64) error
+
+	// ListAllByOwner retrieves the media uploaded by a user with pagination, including the media in the trash
+	ListAllByOwner(ctx context.Context, ownerID int64, limit, offset int) ([]*Media, error)
+
+	// ReassignOwner moves every media of a user in an organization, including the media in the trash,
+	// to another user. orgID zero covers every organization.
+	ReassignOwner(ctx context.Context, orgID, fromID, toID int64) (int64, error)
 }
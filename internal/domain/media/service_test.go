@@ -3,6 +3,7 @@ package media
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -10,11 +11,11 @@ import (
 // mockRepository is a mock implementation of Repository for testing
 type mockRepository struct {
 	createFunc  func(ctx context.Context, media *Media) (*Media, error)
-	getByIDFunc func(ctx context.Context, id int64) (*Media, error)
+	getByIDFunc func(ctx context.Context, orgID, id int64) (*Media, error)
 	updateFunc  func(ctx context.Context, media *Media) (*Media, error)
-	deleteFunc  func(ctx context.Context, id int64) error
-	listFunc    func(ctx context.Context, limit, offset int) ([]*Media, error)
-	countFunc   func(ctx context.Context) (int64, error)
+	deleteFunc  func(ctx context.Context, orgID, id int64) error
+	listFunc    func(ctx context.Context, orgID int64, limit, offset int) ([]*Media, error)
+	countFunc   func(ctx context.Context, orgID int64) (int64, error)
 }
 
 func (m *mockRepository) Create(ctx context.Context, media *Media) (*Media, error) {
@@ -24,9 +25,9 @@ func (m *mockRepository) Create(ctx context.Context, media *Media) (*Media, erro
 	return nil, nil
 }
 
-func (m *mockRepository) GetByID(ctx context.Context, id int64) (*Media, error) {
+func (m *mockRepository) GetByID(ctx context.Context, orgID, id int64) (*Media, error) {
 	if m.getByIDFunc != nil {
-		return m.getByIDFunc(ctx, id)
+		return m.getByIDFunc(ctx, orgID, id)
 	}
 	return nil, nil
 }
@@ -38,23 +39,23 @@ func (m *mockRepository) Update(ctx context.Context, media *Media) (*Media, erro
 	return nil, nil
 }
 
-func (m *mockRepository) Delete(ctx context.Context, id int64) error {
+func (m *mockRepository) Delete(ctx context.Context, orgID, id int64) error {
 	if m.deleteFunc != nil {
-		return m.deleteFunc(ctx, id)
+		return m.deleteFunc(ctx, orgID, id)
 	}
 	return nil
 }
 
-func (m *mockRepository) List(ctx context.Context, limit, offset int) ([]*Media, error) {
+func (m *mockRepository) List(ctx context.Context, orgID int64, limit, offset int) ([]*Media, error) {
 	if m.listFunc != nil {
-		return m.listFunc(ctx, limit, offset)
+		return m.listFunc(ctx, orgID, limit, offset)
 	}
 	return nil, nil
 }
 
-func (m *mockRepository) Count(ctx context.Context) (int64, error) {
+func (m *mockRepository) Count(ctx context.Context, orgID int64) (int64, error) {
 	if m.countFunc != nil {
-		return m.countFunc(ctx)
+		return m.countFunc(ctx, orgID)
 	}
 	return 0, nil
 }
@@ -83,3 +84,35 @@ func TestNewService(t *testing.T) {
 	}
 }
 
+
+func (m *mockRepository) Restore(ctx context.Context, orgID, id int64) error {
+	return nil
+}
+
+func (m *mockRepository) ListDeleted(ctx context.Context, orgID int64, limit, offset int) ([]*Media, error) {
+	return nil, nil
+}
+
+func (m *mockRepository) CountDeleted(ctx context.Context, orgID int64) (int64, error) {
+	return 0, nil
+}
+
+func (m *mockRepository) ListDeletedBefore(ctx context.Context, before time.Time, limit int) ([]*Media, error) {
+	return nil, nil
+}
+
+func (m *mockRepository) Purge(ctx context.Context, id int64) error {
+	return nil
+}
+
+func (m *mockRepository) ListAllByOwner(ctx context.Context, ownerID int64, limit, offset int) ([]*Media, error) {
+	return nil, nil
+}
+
+func (m *mockRepository) GetByPath(ctx context.Context, path string) (*Media, error) {
+	return nil, nil
+}
+
+func (m *mockRepository) ReassignOwner(ctx context.Context, orgID, fromID, toID int64) (int64, error) {
+	return 0, nil
+}
@@ -0,0 +1,93 @@
+package privacy
+
+import "time"
+
+// RequestType is what a data-subject request asks for
+type RequestType string
+
+const (
+	// RequestExport builds an archive of the personal data of a user
+	RequestExport RequestType = "export"
+	// RequestErasure anonymizes a user and removes or hands over their content.
+	// Scoped to an organization it removes or hands over their content there and their membership.
+	RequestErasure RequestType = "erasure"
+)
+
+// RequestStatus is the progress of a data-subject request
+type RequestStatus string
+
+const (
+	// StatusPending requests wait for a worker
+	StatusPending RequestStatus = "pending"
+	// StatusRunning requests are being processed by a worker
+	StatusRunning RequestStatus = "running"
+	// StatusCompleted requests are done, exports can be downloaded until they expire
+	StatusCompleted RequestStatus = "completed"
+	// StatusFailed requests stopped with an error
+	StatusFailed RequestStatus = "failed"
+	// StatusExpired exports were completed but their archive has been removed
+	StatusExpired RequestStatus = "expired"
+)
+
+// DataRequest is a data-subject request processed in the background
+type DataRequest struct {
+	ID          int64
+	UserID      int64
+	OrgID       int64 // The organization whose data is covered, zero covers the whole account
+	RequestedBy int64 // The user themselves or the admin who filed the request
+	Type        RequestType
+	Status      RequestStatus
+	ArchivePath string // Set on completed exports
+	Error       string // Set on failed requests
+	CreatedAt   time.Time
+	StartedAt   *time.Time
+	CompletedAt *time.Time
+	ExpiresAt   *time.Time // When the archive of a completed export is removed
+}
+
+// IsActive reports whether the request still waits for or is being processed by a worker
+func (r *DataRequest) IsActive() bool {
+	return r.Status == StatusPending || r.Status == StatusRunning
+}
+
+// IsDownloadable reports whether the request is an export whose archive can be downloaded at the given time
+func (r *DataRequest) IsDownloadable(now time.Time) bool {
+	return r.Type == RequestExport &&
+		r.Status == StatusCompleted &&
+		r.ArchivePath != "" &&
+		(r.ExpiresAt == nil || now.Before(*r.ExpiresAt))
+}
+
+// Complete marks the request as done at the given time
+func (r *DataRequest) Complete(now time.Time) {
+	r.Status = StatusCompleted
+	r.Error = ""
+	r.CompletedAt = &now
+}
+
+// Fail marks the request as stopped with the given error at the given time
+func (r *DataRequest) Fail(err error, now time.Time) {
+	r.Status = StatusFailed
+	r.Error = err.Error()
+	r.CompletedAt = &now
+}
+
+// ErasurePolicy decides what happens to the content of an erased user
+type ErasurePolicy string
+
+const (
+	// ErasureDelete permanently deletes the articles and media of the user
+	ErasureDelete ErasurePolicy = "delete"
+	// ErasureReassign hands the articles and media of the user over to another account
+	ErasureReassign ErasurePolicy = "reassign"
+)
+
+// ParseErasurePolicy parses a configured policy, returns ErrInvalidErasurePolicy if it is unknown
+func ParseErasurePolicy(value string) (ErasurePolicy, error) {
+	switch p := ErasurePolicy(value); p {
+	case ErasureDelete, ErasureReassign:
+		return p, nil
+	default:
+		return "", ErrInvalidErasurePolicy
+	}
+}
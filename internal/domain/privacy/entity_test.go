@@ -0,0 +1,88 @@
+package privacy
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDataRequest_IsActive(t *testing.T) {
+	for status, want := range map[RequestStatus]bool{
+		StatusPending:   true,
+		StatusRunning:   true,
+		StatusCompleted: false,
+		StatusFailed:    false,
+		StatusExpired:   false,
+	} {
+		r := DataRequest{Status: status}
+		assert.Equal(t, want, r.IsActive(), status)
+	}
+}
+
+func TestDataRequest_IsDownloadable(t *testing.T) {
+	now := time.Now()
+	later := now.Add(time.Hour)
+	earlier := now.Add(-time.Hour)
+
+	tests := []struct {
+		name    string
+		request DataRequest
+		want    bool
+	}{
+		{
+			name:    "completed export",
+			request: DataRequest{Type: RequestExport, Status: StatusCompleted, ArchivePath: "export-1.zip", ExpiresAt: &later},
+			want:    true,
+		},
+		{
+			name:    "pending export",
+			request: DataRequest{Type: RequestExport, Status: StatusPending},
+			want:    false,
+		},
+		{
+			name:    "expired export",
+			request: DataRequest{Type: RequestExport, Status: StatusCompleted, ArchivePath: "export-1.zip", ExpiresAt: &earlier},
+			want:    false,
+		},
+		{
+			name:    "completed erasure",
+			request: DataRequest{Type: RequestErasure, Status: StatusCompleted},
+			want:    false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.request.IsDownloadable(now))
+		})
+	}
+}
+
+func TestDataRequest_CompleteAndFail(t *testing.T) {
+	now := time.Now()
+	r := &DataRequest{Status: StatusRunning}
+
+	r.Fail(errors.New("storage unavailable"), now)
+	assert.Equal(t, StatusFailed, r.Status)
+	assert.Equal(t, "storage unavailable", r.Error)
+	assert.Equal(t, &now, r.CompletedAt)
+
+	r.Complete(now)
+	assert.Equal(t, StatusCompleted, r.Status)
+	assert.Empty(t, r.Error)
+}
+
+func TestParseErasurePolicy(t *testing.T) {
+	policy, err := ParseErasurePolicy("delete")
+	assert.NoError(t, err)
+	assert.Equal(t, ErasureDelete, policy)
+
+	policy, err = ParseErasurePolicy("reassign")
+	assert.NoError(t, err)
+	assert.Equal(t, ErasureReassign, policy)
+
+	_, err = ParseErasurePolicy("keep")
+	assert.Equal(t, ErrInvalidErasurePolicy, err)
+}
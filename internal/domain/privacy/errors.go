@@ -0,0 +1,20 @@
+package privacy
+
+import "errors"
+
+var (
+	// ErrRequestNotFound is returned when a data-subject request is not found
+	ErrRequestNotFound = errors.New("data request not found")
+	// ErrRequestInProgress is returned when the user already has a pending request of the same type
+	ErrRequestInProgress = errors.New("a request of this type is already in progress")
+	// ErrExportNotReady is returned when an export is downloaded before it completed
+	ErrExportNotReady = errors.New("export is not ready")
+	// ErrExportExpired is returned when the archive of an export has already been removed
+	ErrExportExpired = errors.New("export has expired")
+	// ErrInvalidErasurePolicy is returned when the configured erasure policy is unknown
+	ErrInvalidErasurePolicy = errors.New("invalid erasure policy")
+	// ErrReassignTargetErased is returned when content would be handed over to the user being erased
+	ErrReassignTargetErased = errors.New("content cannot be reassigned to the erased user")
+	// ErrReassignTargetNotMember is returned when content would be handed over to a user outside its organization
+	ErrReassignTargetNotMember = errors.New("content cannot be reassigned to a user outside the organization")
+)
@@ -0,0 +1,44 @@
+package privacy
+
+import (
+	"context"
+	"io"
+	"time"
+)
+
+// Repository is the driven port for data-subject request persistence
+type Repository interface {
+	// Create stores a new request
+	Create(ctx context.Context, request *DataRequest) (*DataRequest, error)
+
+	// GetByID retrieves a request, returns ErrRequestNotFound if it does not exist
+	GetByID(ctx context.Context, id int64) (*DataRequest, error)
+
+	// FindActive returns the pending or running request of a user of the given type covering orgID,
+	// ErrRequestNotFound if there is none. orgID zero looks for a request covering the whole account.
+	FindActive(ctx context.Context, orgID, userID int64, requestType RequestType) (*DataRequest, error)
+
+	// ClaimNext marks the oldest pending request as running and returns it. Requests that
+	// have been running since before staleBefore are claimed again, their worker is assumed dead.
+	// Returns ErrRequestNotFound when there is nothing to do.
+	ClaimNext(ctx context.Context, now, staleBefore time.Time) (*DataRequest, error)
+
+	// Update saves the status, archive and timestamps of a request
+	Update(ctx context.Context, request *DataRequest) error
+
+	// ListExpiredExports returns up to limit completed exports whose archive expired before the given time
+	ListExpiredExports(ctx context.Context, before time.Time, limit int) ([]*DataRequest, error)
+}
+
+// ArchiveStore is a port for storing export archives.
+// It has the method set of media.Storage, so a file storage can keep the archives.
+type ArchiveStore interface {
+	// Save saves an archive and returns its path
+	Save(ctx context.Context, filename string, file io.Reader) (string, error)
+
+	// Delete deletes an archive by path
+	Delete(ctx context.Context, path string) error
+
+	// Get retrieves an archive by path
+	Get(ctx context.Context, path string) (io.ReadCloser, error)
+}
@@ -0,0 +1,67 @@
+package user
+
+import (
+	"context"
+	"time"
+)
+
+// APIKeyMarker starts every API key so leaked keys are easy to spot
+const APIKeyMarker = "hxa_"
+
+// apiKeyPrefixLength is the number of leading characters kept to identify a key in listings
+const apiKeyPrefixLength = len(APIKeyMarker) + 8
+
+// APIKey represents a user-owned key for machine clients.
+// Only the hash of the key is stored, the prefix lets users tell keys apart.
+// A key acts in the organization it was created in.
+type APIKey struct {
+	ID         int64
+	UserID     int64
+	OrgID      int64
+	Name       string
+	Prefix     string
+	KeyHash    string
+	Scopes     []Permission
+	ExpiresAt  *time.Time
+	LastUsedAt *time.Time
+	RevokedAt  *time.Time
+	CreatedAt  time.Time
+}
+
+// IsActive reports whether the key is neither revoked nor expired at the given time
+func (k *APIKey) IsActive(now time.Time) bool {
+	if k.RevokedAt != nil {
+		return false
+	}
+	return k.ExpiresAt == nil || now.Before(*k.ExpiresAt)
+}
+
+// GenerateAPIKey creates a new random API key and returns it with its prefix
+func GenerateAPIKey() (key string, prefix string, err error) {
+	secret, err := GenerateSecureToken(32)
+	if err != nil {
+		return "", "", err
+	}
+
+	key = APIKeyMarker + secret
+	return key, key[:apiKeyPrefixLength], nil
+}
+
+// APIKeyRepository is the driven port for API key persistence
+type APIKeyRepository interface {
+	// Create stores a new API key
+	Create(ctx context.Context, key *APIKey) (*APIKey, error)
+
+	// GetByHash retrieves an API key by the hash of its value, returns ErrInvalidAPIKey if unknown
+	GetByHash(ctx context.Context, keyHash string) (*APIKey, error)
+
+	// ListByUser returns the keys of a user that have not been revoked, newest first
+	ListByUser(ctx context.Context, userID int64) ([]*APIKey, error)
+
+	// Revoke revokes a key of the user, returns ErrAPIKeyNotFound
+	// if the key is unknown, owned by someone else or already revoked
+	Revoke(ctx context.Context, userID, id int64) error
+
+	// TouchLastUsed records when the key was last used
+	TouchLastUsed(ctx context.Context, id int64, at time.Time) error
+}
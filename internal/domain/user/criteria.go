@@ -0,0 +1,78 @@
+package user
+
+import "time"
+
+// SortField is a field users can be ordered by
+type SortField string
+
+const (
+	// SortByCreatedAt orders users by when they signed up
+	SortByCreatedAt SortField = "created_at"
+	// SortByName orders users by name
+	SortByName SortField = "name"
+	// SortByEmail orders users by email address
+	SortByEmail SortField = "email"
+	// SortByRole orders users by their role in the organization
+	SortByRole SortField = "role"
+)
+
+// IsValid reports whether users can be ordered by the field
+func (f SortField) IsValid() bool {
+	switch f {
+	case SortByCreatedAt, SortByName, SortByEmail, SortByRole:
+		return true
+	}
+	return false
+}
+
+// SortDirection is the direction users are ordered in
+type SortDirection string
+
+const (
+	// SortAsc orders from the lowest to the highest value
+	SortAsc SortDirection = "asc"
+	// SortDesc orders from the highest to the lowest value
+	SortDesc SortDirection = "desc"
+)
+
+// IsValid reports whether the direction is a known direction
+func (d SortDirection) IsValid() bool {
+	return d == SortAsc || d == SortDesc
+}
+
+// Criteria selects and orders the members of an organization.
+// Zero values don't filter, without a sort field the newest users come first.
+type Criteria struct {
+	OrgID       int64
+	Search      string     // Substring of the name or email
+	Role        Role       // Role in the organization
+	CreatedFrom *time.Time // Inclusive
+	CreatedTo   *time.Time // Exclusive
+	SortBy      SortField
+	SortDir     SortDirection
+	Limit       int
+	Offset      int
+}
+
+// Validate validates the criteria and fills in the default order
+func (c *Criteria) Validate() error {
+	if c.Role != "" && !c.Role.IsValid() {
+		return ErrInvalidRole
+	}
+	if c.SortBy == "" {
+		c.SortBy = SortByCreatedAt
+	}
+	if !c.SortBy.IsValid() {
+		return ErrInvalidSortField
+	}
+	if c.SortDir == "" {
+		c.SortDir = SortDesc
+	}
+	if !c.SortDir.IsValid() {
+		return ErrInvalidSortDirection
+	}
+	if c.CreatedFrom != nil && c.CreatedTo != nil && !c.CreatedFrom.Before(*c.CreatedTo) {
+		return ErrInvalidDateRange
+	}
+	return nil
+}
@@ -0,0 +1,42 @@
+package user
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCriteria_Validate(t *testing.T) {
+	from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := from.Add(24 * time.Hour)
+
+	tests := []struct {
+		name     string
+		criteria Criteria
+		wantErr  error
+	}{
+		{name: "empty criteria", criteria: Criteria{}},
+		{name: "every filter", criteria: Criteria{Search: "doe", Role: RoleEditor, CreatedFrom: &from, CreatedTo: &to, SortBy: SortByEmail, SortDir: SortAsc}},
+		{name: "open-ended range", criteria: Criteria{CreatedTo: &to}},
+		{name: "unknown role", criteria: Criteria{Role: "owner"}, wantErr: ErrInvalidRole},
+		{name: "unknown sort field", criteria: Criteria{SortBy: "password"}, wantErr: ErrInvalidSortField},
+		{name: "unknown direction", criteria: Criteria{SortDir: "up"}, wantErr: ErrInvalidSortDirection},
+		{name: "range ends before it starts", criteria: Criteria{CreatedFrom: &to, CreatedTo: &from}, wantErr: ErrInvalidDateRange},
+		{name: "empty range", criteria: Criteria{CreatedFrom: &from, CreatedTo: &from}, wantErr: ErrInvalidDateRange},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.wantErr, tt.criteria.Validate())
+		})
+	}
+}
+
+func TestCriteria_Validate_DefaultOrder(t *testing.T) {
+	criteria := Criteria{}
+
+	assert.NoError(t, criteria.Validate())
+	assert.Equal(t, SortByCreatedAt, criteria.SortBy)
+	assert.Equal(t, SortDesc, criteria.SortDir)
+}
@@ -0,0 +1,43 @@
+package user
+
+import "time"
+
+// EmailVerificationClaims represents the data carried by a signed verification link.
+// The email is included so that a link stops working once the address changes.
+type EmailVerificationClaims struct {
+	UserID    int64
+	Email     string
+	ExpiresAt time.Time
+}
+
+// EmailVerificationSigner is a port for signing and checking email verification tokens
+type EmailVerificationSigner interface {
+	// Sign creates a tamper-proof token for the claims
+	Sign(claims EmailVerificationClaims) (string, error)
+
+	// Verify checks the token, returns ErrInvalidVerificationToken
+	// if it was tampered with or is expired
+	Verify(token string) (*EmailVerificationClaims, error)
+}
+
+// EmailVerificationPolicy decides what unverified users are allowed to do
+type EmailVerificationPolicy string
+
+const (
+	// VerificationPolicyNone lets unverified users do everything
+	VerificationPolicyNone EmailVerificationPolicy = "none"
+	// VerificationPolicyContent lets unverified users log in but not create content
+	VerificationPolicyContent EmailVerificationPolicy = "content"
+	// VerificationPolicyLogin stops unverified users from logging in
+	VerificationPolicyLogin EmailVerificationPolicy = "login"
+)
+
+// BlocksLogin reports whether unverified users may not log in
+func (p EmailVerificationPolicy) BlocksLogin() bool {
+	return p == VerificationPolicyLogin
+}
+
+// BlocksContent reports whether unverified users may not create content
+func (p EmailVerificationPolicy) BlocksContent() bool {
+	return p == VerificationPolicyContent || p == VerificationPolicyLogin
+}
@@ -0,0 +1,27 @@
+package user
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEmailVerificationPolicy(t *testing.T) {
+	tests := []struct {
+		policy        EmailVerificationPolicy
+		blocksLogin   bool
+		blocksContent bool
+	}{
+		{policy: VerificationPolicyNone, blocksLogin: false, blocksContent: false},
+		{policy: VerificationPolicyContent, blocksLogin: false, blocksContent: true},
+		{policy: VerificationPolicyLogin, blocksLogin: true, blocksContent: true},
+		{policy: "", blocksLogin: false, blocksContent: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.policy), func(t *testing.T) {
+			assert.Equal(t, tt.blocksLogin, tt.policy.BlocksLogin())
+			assert.Equal(t, tt.blocksContent, tt.policy.BlocksContent())
+		})
+	}
+}
@@ -1,15 +1,40 @@
 package user
 
-import "time"
+import (
+	"fmt"
+	"time"
+)
 
 // User represents the user entity in the domain
 type User struct {
-	ID        int64     `json:"id"`
-	Name      string    `json:"name"`
-	Email     string    `json:"email"`
-	Password  string    `json:"-"` // Hidden from JSON
-	CreatedAt time.Time `json:"created_at"`
-	UpdatedAt time.Time `json:"updated_at"`
+	ID              int64      `json:"id"`
+	Name            string     `json:"name"`
+	Email           string     `json:"email"`
+	Password        string     `json:"-"` // Hidden from JSON
+	Role            Role       `json:"role"`
+	EmailVerifiedAt *time.Time `json:"email_verified_at,omitempty"`
+	CreatedAt       time.Time  `json:"created_at"`
+	UpdatedAt       time.Time  `json:"updated_at"`
+	DeletedAt       *time.Time `json:"deleted_at,omitempty"`
+}
+
+// IsEmailVerified reports whether the user has verified their email address
+func (u *User) IsEmailVerified() bool {
+	return u.EmailVerifiedAt != nil
+}
+
+// AnonymizedName replaces the name of erased users
+const AnonymizedName = "Deleted user"
+
+// Anonymize replaces the personal data of the user so the row no longer identifies anyone.
+// The email stays unique per user and can never receive mail.
+func (u *User) Anonymize(now time.Time) {
+	u.Name = AnonymizedName
+	u.Email = fmt.Sprintf("deleted-%d@erased.invalid", u.ID)
+	u.Password = ""
+	u.Role = RoleReader
+	u.EmailVerifiedAt = nil
+	u.UpdatedAt = now
 }
 
 // Validate validates the user entity
@@ -23,5 +48,8 @@ func (u *User) Validate() error {
 	if u.Password == "" {
 		return ErrPasswordRequired
 	}
+	if u.Role != "" && !u.Role.IsValid() {
+		return ErrInvalidRole
+	}
 	return nil
 }
@@ -121,6 +121,28 @@ func TestUser_Validate(t *testing.T) {
 			},
 			wantErr: nil,
 		},
+		{
+			name: "valid user with role",
+			user: User{
+				ID:       1,
+				Name:     "John Doe",
+				Email:    "john@example.com",
+				Password: "password123",
+				Role:     RoleAdmin,
+			},
+			wantErr: nil,
+		},
+		{
+			name: "invalid role",
+			user: User{
+				ID:       1,
+				Name:     "John Doe",
+				Email:    "john@example.com",
+				Password: "password123",
+				Role:     Role("superuser"),
+			},
+			wantErr: ErrInvalidRole,
+		},
 		{
 			name: "valid user with complex email",
 			user: User{
@@ -148,3 +170,34 @@ func TestUser_Validate(t *testing.T) {
 	}
 }
 
+func TestUser_IsEmailVerified(t *testing.T) {
+	u := &User{}
+	assert.False(t, u.IsEmailVerified())
+
+	now := time.Now()
+	u.EmailVerifiedAt = &now
+	assert.True(t, u.IsEmailVerified())
+}
+
+
+func TestUser_Anonymize(t *testing.T) {
+	verifiedAt := time.Now().Add(-time.Hour)
+	now := time.Now()
+	u := &User{
+		ID:              42,
+		Name:            "John Doe",
+		Email:           "john@example.com",
+		Password:        "hashed",
+		Role:            RoleAdmin,
+		EmailVerifiedAt: &verifiedAt,
+	}
+
+	u.Anonymize(now)
+
+	assert.Equal(t, AnonymizedName, u.Name)
+	assert.Equal(t, "deleted-42@erased.invalid", u.Email)
+	assert.Empty(t, u.Password)
+	assert.Equal(t, RoleReader, u.Role)
+	assert.False(t, u.IsEmailVerified())
+	assert.Equal(t, now, u.UpdatedAt)
+}
@@ -7,6 +7,8 @@ var (
 	ErrUserNotFound = errors.New("user not found")
 	// ErrEmailExists is returned when email already exists in the system
 	ErrEmailExists = errors.New("email already exists")
+	// ErrUserHasArticles is returned when a user who still authors articles would be purged
+	ErrUserHasArticles = errors.New("user still authors articles")
 	// ErrNameRequired is returned when user name is missing
 	ErrNameRequired = errors.New("name is required")
 	// ErrEmailRequired is returned when user email is missing
@@ -17,4 +19,84 @@ var (
 	ErrInvalidEmail = errors.New("invalid email format")
 	// ErrInvalidCredentials is returned when login credentials are invalid
 	ErrInvalidCredentials = errors.New("invalid email or password")
+	// ErrIncorrectPassword is returned when the current password given to change it is wrong
+	ErrIncorrectPassword = errors.New("current password is incorrect")
+	// ErrInvalidRole is returned when a role is not one of the known roles
+	ErrInvalidRole = errors.New("invalid role")
+	// ErrInvalidRefreshToken is returned when a refresh token is unknown, expired or revoked
+	ErrInvalidRefreshToken = errors.New("invalid or expired refresh token")
+	// ErrRefreshTokenReused is returned when an already rotated refresh token is presented again
+	ErrRefreshTokenReused = errors.New("refresh token reuse detected")
+	// ErrTokenRevoked is returned when an access token has been revoked
+	ErrTokenRevoked = errors.New("token has been revoked")
+	// ErrInvalidResetToken is returned when a password reset token is unknown, expired or already used
+	ErrInvalidResetToken = errors.New("invalid or expired password reset token")
+	// ErrInvalidVerificationToken is returned when an email verification token is tampered with or expired
+	ErrInvalidVerificationToken = errors.New("invalid or expired verification token")
+	// ErrEmailNotVerified is returned when an unverified user does something the verification policy forbids
+	ErrEmailNotVerified = errors.New("email address has not been verified")
+	// ErrTooManyLoginAttempts is returned when logins are locked after repeated failures
+	ErrTooManyLoginAttempts = errors.New("too many failed login attempts")
+	// ErrTwoFactorNotEnrolled is returned when a user has not started two-factor enrollment
+	ErrTwoFactorNotEnrolled = errors.New("two-factor authentication is not enrolled")
+	// ErrTwoFactorNotEnabled is returned when two-factor authentication has not been confirmed
+	ErrTwoFactorNotEnabled = errors.New("two-factor authentication is not enabled")
+	// ErrTwoFactorAlreadyEnabled is returned when enrolling a user that already uses two-factor authentication
+	ErrTwoFactorAlreadyEnabled = errors.New("two-factor authentication is already enabled")
+	// ErrInvalidTwoFactorCode is returned when a one-time or recovery code is wrong
+	ErrInvalidTwoFactorCode = errors.New("invalid two-factor code")
+	// ErrInvalidTwoFactorChallenge is returned when a login challenge token is unknown or expired
+	ErrInvalidTwoFactorChallenge = errors.New("invalid or expired two-factor challenge")
+	// ErrAPIKeyNotFound is returned when an API key does not exist or belongs to another user
+	ErrAPIKeyNotFound = errors.New("api key not found")
+	// ErrInvalidAPIKey is returned when an API key is unknown, expired or revoked
+	ErrInvalidAPIKey = errors.New("invalid or expired api key")
+	// ErrInvalidAPIKeyScope is returned when an API key scope is unknown or not granted to the owner
+	ErrInvalidAPIKeyScope = errors.New("invalid api key scope")
+	// ErrInvalidAPIKeyExpiry is returned when an API key would expire in the past
+	ErrInvalidAPIKeyExpiry = errors.New("api key expiry must be in the future")
+	// ErrInvalidIdentity is returned when an identity provider rejects the sign-in or its identity token is not valid
+	ErrInvalidIdentity = errors.New("invalid external identity")
+	// ErrIdentityNotFound is returned when no user is linked to an external identity
+	ErrIdentityNotFound = errors.New("external identity not found")
+	// ErrIdentityEmailNotVerified is returned when an external identity without a verified email would be linked to an existing user
+	ErrIdentityEmailNotVerified = errors.New("email of the external identity is not verified")
+	// ErrIdentityAccountNotVerified is returned when an external identity matches a local account whose email was never verified
+	ErrIdentityAccountNotVerified = errors.New("account email is not verified, verify it or reset the password before signing in with an identity provider")
+	// ErrIdentityNotProvisioned is returned when an external identity has no user and provisioning is disabled
+	ErrIdentityNotProvisioned = errors.New("no account exists for the external identity")
+	// ErrInvalidAuthorizationState is returned when a sign-in callback is unknown, expired or replayed
+	ErrInvalidAuthorizationState = errors.New("invalid or expired authorization state")
+	// ErrInvalidLoginCode is returned when the code of a completed sign-in is unknown, expired or redeemed
+	ErrInvalidLoginCode = errors.New("invalid or expired login code")
+	// ErrSessionNotFound is returned when a session does not exist, belongs to another user or is already revoked
+	ErrSessionNotFound = errors.New("session not found")
+	// ErrSessionRevoked is returned when an access token belongs to a session that has been signed out
+	ErrSessionRevoked = errors.New("session has been revoked")
+	// ErrOrganizationNotFound is returned when an organization does not exist
+	ErrOrganizationNotFound = errors.New("organization not found")
+	// ErrOrganizationNameRequired is returned when an organization name is missing
+	ErrOrganizationNameRequired = errors.New("organization name is required")
+	// ErrNotMember is returned when a user does not belong to an organization
+	ErrNotMember = errors.New("user is not a member of the organization")
+	// ErrAlreadyMember is returned when adding a user to an organization they already belong to
+	ErrAlreadyMember = errors.New("user is already a member of the organization")
+	// ErrNoOrganization is returned when a user signs in without belonging to any organization
+	ErrNoOrganization = errors.New("user does not belong to any organization")
+	// ErrRegistrationClosed is returned when signing up or inviting users is not allowed by the registration mode
+	ErrRegistrationClosed = errors.New("registration is closed")
+	// ErrInvalidInvitation is returned when an invitation is unknown, expired or already accepted
+	ErrInvalidInvitation = errors.New("invalid or expired invitation")
+	// ErrInvalidInvitationExpiry is returned when an invitation would expire in the past
+	ErrInvalidInvitationExpiry = errors.New("invitation expiry must be in the future")
+	// ErrInvalidSortField is returned when users are ordered by an unknown field
+	ErrInvalidSortField = errors.New("invalid sort field")
+	// ErrInvalidSortDirection is returned when a sort direction is not asc or desc
+	ErrInvalidSortDirection = errors.New("invalid sort direction")
+	// ErrInvalidDateRange is returned when a date range ends before it starts
+	ErrInvalidDateRange = errors.New("date range must end after it starts")
+	// ErrCannotImpersonate is returned when impersonating oneself or another admin
+	ErrCannotImpersonate = errors.New("user cannot be impersonated")
+	// ErrWeakPassword is returned when a password breaks the password policy, see PasswordPolicyError for the rules
+	ErrWeakPassword = errors.New("password does not meet the password policy")
 )
@@ -0,0 +1,79 @@
+package user
+
+import (
+	"context"
+	"time"
+)
+
+// ExternalIdentity is a user identity asserted by an external identity provider
+type ExternalIdentity struct {
+	Provider      string
+	Subject       string
+	Email         string
+	EmailVerified bool
+	Name          string
+}
+
+// UserIdentity links a local user to the subject of an external identity provider
+type UserIdentity struct {
+	ID        int64
+	UserID    int64
+	Provider  string
+	Subject   string
+	Email     string
+	CreatedAt time.Time
+}
+
+// AuthorizationState is what a sign-in remembers between the redirect to the
+// identity provider and the callback. It never leaves the server.
+type AuthorizationState struct {
+	CodeVerifier string
+	Nonce        string
+}
+
+// IdentityProvider is a port for signing in with an external identity provider
+type IdentityProvider interface {
+	// Name identifies the provider in linked identities
+	Name() string
+
+	// AuthorizationURL returns the URL users are sent to for signing in.
+	// The code challenge sent to the provider is derived from codeVerifier (PKCE).
+	AuthorizationURL(ctx context.Context, state, nonce, codeVerifier string) (string, error)
+
+	// Exchange trades an authorization code for the verified identity of the user,
+	// returns ErrInvalidIdentity if the code or the identity token are not valid
+	Exchange(ctx context.Context, code, codeVerifier, nonce string) (*ExternalIdentity, error)
+}
+
+// IdentityRepository is the driven port for linked identities
+type IdentityRepository interface {
+	// GetByProviderSubject returns the link of a provider subject, ErrIdentityNotFound if there is none
+	GetByProviderSubject(ctx context.Context, provider, subject string) (*UserIdentity, error)
+
+	// Create links an identity to a user
+	Create(ctx context.Context, identity *UserIdentity) (*UserIdentity, error)
+
+	// DeleteByUser removes every identity linked to a user
+	DeleteByUser(ctx context.Context, userID int64) error
+}
+
+// AuthorizationStateStore is a port for pending sign-ins with an identity provider
+type AuthorizationStateStore interface {
+	// Save stores the state until the TTL runs out
+	Save(ctx context.Context, stateHash string, state AuthorizationState, ttl time.Duration) error
+
+	// Take returns and removes the state so a callback is accepted only once,
+	// ErrInvalidAuthorizationState if it is unknown or expired
+	Take(ctx context.Context, stateHash string) (*AuthorizationState, error)
+}
+
+// LoginCodeStore is a port for completed sign-ins with an identity provider.
+// The browser is redirected with a one-time code and trades it for tokens, so tokens never appear in a URL.
+type LoginCodeStore interface {
+	// Save stores the code for the user until the TTL runs out
+	Save(ctx context.Context, codeHash string, userID int64, ttl time.Duration) error
+
+	// Take returns the user of the code and removes it so it is redeemed only once,
+	// ErrInvalidLoginCode if it is unknown or expired
+	Take(ctx context.Context, codeHash string) (int64, error)
+}
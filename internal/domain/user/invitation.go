@@ -0,0 +1,65 @@
+package user
+
+import (
+	"context"
+	"time"
+)
+
+// RegistrationMode decides how new accounts may be created
+type RegistrationMode string
+
+const (
+	// RegistrationOpen lets anyone register, invitations can be sent as well
+	RegistrationOpen RegistrationMode = "open"
+	// RegistrationInviteOnly lets only invited users create an account
+	RegistrationInviteOnly RegistrationMode = "invite"
+	// RegistrationDisabled leaves creating accounts to admins
+	RegistrationDisabled RegistrationMode = "disabled"
+)
+
+// AllowsSignUp reports whether anyone may register
+func (m RegistrationMode) AllowsSignUp() bool {
+	return m == RegistrationOpen
+}
+
+// AllowsInvitations reports whether invitations may be sent and accepted
+func (m RegistrationMode) AllowsInvitations() bool {
+	return m == RegistrationOpen || m == RegistrationInviteOnly
+}
+
+// Invitation represents an invitation to create an account in an organization.
+// Only the hash of the token sent to the invitee is stored.
+type Invitation struct {
+	ID         int64
+	OrgID      int64
+	Email      string
+	Role       Role
+	TokenHash  string
+	InvitedBy  int64
+	ExpiresAt  time.Time
+	AcceptedAt *time.Time
+	CreatedAt  time.Time
+}
+
+// IsAccepted reports whether the invitation has already been used
+func (i *Invitation) IsAccepted() bool {
+	return i.AcceptedAt != nil
+}
+
+// IsExpired reports whether the invitation is expired at the given time
+func (i *Invitation) IsExpired(now time.Time) bool {
+	return !now.Before(i.ExpiresAt)
+}
+
+// InvitationRepository is the driven port for invitation persistence
+type InvitationRepository interface {
+	// Create stores a new invitation
+	Create(ctx context.Context, invitation *Invitation) (*Invitation, error)
+
+	// GetByHash retrieves an invitation by the hash of its token, returns ErrInvalidInvitation if unknown
+	GetByHash(ctx context.Context, tokenHash string) (*Invitation, error)
+
+	// MarkAccepted consumes an invitation, returns ErrInvalidInvitation
+	// if the invitation is unknown or already accepted
+	MarkAccepted(ctx context.Context, id int64) error
+}
@@ -0,0 +1,36 @@
+package user
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRegistrationMode(t *testing.T) {
+	assert.True(t, RegistrationOpen.AllowsSignUp())
+	assert.True(t, RegistrationOpen.AllowsInvitations())
+
+	assert.False(t, RegistrationInviteOnly.AllowsSignUp())
+	assert.True(t, RegistrationInviteOnly.AllowsInvitations())
+
+	assert.False(t, RegistrationDisabled.AllowsSignUp())
+	assert.False(t, RegistrationDisabled.AllowsInvitations())
+}
+
+func TestInvitation_IsAccepted(t *testing.T) {
+	invitation := &Invitation{}
+	assert.False(t, invitation.IsAccepted())
+
+	now := time.Now()
+	invitation.AcceptedAt = &now
+	assert.True(t, invitation.IsAccepted())
+}
+
+func TestInvitation_IsExpired(t *testing.T) {
+	now := time.Now()
+
+	assert.False(t, (&Invitation{ExpiresAt: now.Add(time.Minute)}).IsExpired(now))
+	assert.True(t, (&Invitation{ExpiresAt: now}).IsExpired(now))
+	assert.True(t, (&Invitation{ExpiresAt: now.Add(-time.Minute)}).IsExpired(now))
+}
@@ -0,0 +1,93 @@
+package user
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// LoginAttempt represents a recorded login attempt.
+// UserID is zero when the email did not match any user.
+type LoginAttempt struct {
+	ID        int64
+	UserID    int64
+	Email     string
+	IPAddress string
+	UserAgent string
+	Success   bool
+	CreatedAt time.Time
+}
+
+// LoginAttemptRepository is the driven port for login attempt persistence
+type LoginAttemptRepository interface {
+	// Create stores a login attempt
+	Create(ctx context.Context, attempt *LoginAttempt) error
+
+	// ListByUser returns the most recent attempts of a user, newest first
+	ListByUser(ctx context.Context, userID int64, limit int) ([]*LoginAttempt, error)
+
+	// DeleteByUser removes every attempt of a user
+	DeleteByUser(ctx context.Context, userID int64) error
+}
+
+// LoginAttemptCounter is a port for counting failed logins and locking keys.
+// Keys identify what is being throttled, such as an account or an IP address.
+type LoginAttemptCounter interface {
+	// Increment adds a failure for the key and returns the new count.
+	// The count is forgotten once no failure happened for the window.
+	Increment(ctx context.Context, key string, window time.Duration) (int, error)
+
+	// Lock blocks the key for the given duration
+	Lock(ctx context.Context, key string, duration time.Duration) error
+
+	// LockedFor returns how long the key stays locked, zero when it is not locked
+	LockedFor(ctx context.Context, key string) (time.Duration, error)
+
+	// Reset forgets the failures and the lock of the key
+	Reset(ctx context.Context, key string) error
+}
+
+// LockoutPolicy decides how long to lock a key after repeated failures.
+// Every failure past MaxAttempts doubles the lockout, up to MaxLockout.
+type LockoutPolicy struct {
+	MaxAttempts int
+	BaseLockout time.Duration
+	MaxLockout  time.Duration
+	Window      time.Duration
+}
+
+// LockoutFor returns the lockout for the given number of failures, zero if none is due
+func (p LockoutPolicy) LockoutFor(failures int) time.Duration {
+	if p.MaxAttempts <= 0 || failures < p.MaxAttempts {
+		return 0
+	}
+
+	lockout := p.BaseLockout
+	for i := p.MaxAttempts; i < failures; i++ {
+		lockout *= 2
+		if lockout >= p.MaxLockout {
+			return p.MaxLockout
+		}
+	}
+
+	if lockout > p.MaxLockout {
+		return p.MaxLockout
+	}
+	return lockout
+}
+
+// LoginLockedError is returned when a login is rejected because of a lockout.
+// It matches ErrTooManyLoginAttempts with errors.Is.
+type LoginLockedError struct {
+	RetryAfter time.Duration
+}
+
+// Error implements the error interface
+func (e *LoginLockedError) Error() string {
+	return fmt.Sprintf("%s, retry after %s", ErrTooManyLoginAttempts, e.RetryAfter.Round(time.Second))
+}
+
+// Unwrap returns ErrTooManyLoginAttempts
+func (e *LoginLockedError) Unwrap() error {
+	return ErrTooManyLoginAttempts
+}
@@ -0,0 +1,44 @@
+package user
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLockoutPolicy_LockoutFor(t *testing.T) {
+	policy := LockoutPolicy{MaxAttempts: 5, BaseLockout: time.Minute, MaxLockout: 10 * time.Minute}
+
+	tests := []struct {
+		failures int
+		want     time.Duration
+	}{
+		{failures: 0, want: 0},
+		{failures: 4, want: 0},
+		{failures: 5, want: time.Minute},
+		{failures: 6, want: 2 * time.Minute},
+		{failures: 7, want: 4 * time.Minute},
+		{failures: 8, want: 8 * time.Minute},
+		{failures: 9, want: 10 * time.Minute},
+		{failures: 100, want: 10 * time.Minute},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, policy.LockoutFor(tt.failures), "failures=%d", tt.failures)
+	}
+}
+
+func TestLockoutPolicy_LockoutFor_Disabled(t *testing.T) {
+	policy := LockoutPolicy{MaxAttempts: 0, BaseLockout: time.Minute, MaxLockout: time.Hour}
+
+	assert.Equal(t, time.Duration(0), policy.LockoutFor(50))
+}
+
+func TestLoginLockedError(t *testing.T) {
+	var err error = &LoginLockedError{RetryAfter: 90 * time.Second}
+
+	assert.True(t, errors.Is(err, ErrTooManyLoginAttempts))
+	assert.Contains(t, err.Error(), "1m30s")
+}
@@ -1,10 +1,22 @@
 package user
 
-import "context"
+import (
+	"context"
+	"time"
+)
 
 // NotificationService is a port for sending notifications (e.g., emails)
 type NotificationService interface {
 	// SendWelcomeEmail sends a welcome email to a new user
 	SendWelcomeEmail(ctx context.Context, email, name string) error
+
+	// SendPasswordResetEmail sends a password reset token to a user
+	SendPasswordResetEmail(ctx context.Context, email, name, token string) error
+
+	// SendVerificationEmail sends an email verification token to a user
+	SendVerificationEmail(ctx context.Context, email, name, token string) error
+
+	// SendInvitationEmail sends an invitation token to someone invited to join an organization
+	SendInvitationEmail(ctx context.Context, email, token string, expiresAt time.Time) error
 }
 
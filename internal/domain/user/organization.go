@@ -0,0 +1,74 @@
+package user
+
+import (
+	"context"
+	"time"
+)
+
+// Organization is a tenant of the deployment. Articles and media belong to one
+// organization, users take part in organizations through memberships.
+type Organization struct {
+	ID        int64
+	Name      string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// Validate validates the organization entity
+func (o *Organization) Validate() error {
+	if o.Name == "" {
+		return ErrOrganizationNameRequired
+	}
+	return nil
+}
+
+// Membership grants a user a role within an organization.
+// The role of the membership, not the role of the user, decides what the user may do there.
+type Membership struct {
+	OrgID     int64
+	UserID    int64
+	Role      Role
+	CreatedAt time.Time
+}
+
+// Validate validates the membership entity
+func (m *Membership) Validate() error {
+	if !m.Role.IsValid() {
+		return ErrInvalidRole
+	}
+	return nil
+}
+
+// OrganizationMembership is an organization seen from one of its members
+type OrganizationMembership struct {
+	Organization *Organization
+	Role         Role
+	JoinedAt     time.Time
+}
+
+// OrganizationRepository is the driven port for organization and membership persistence
+type OrganizationRepository interface {
+	// Create creates a new organization
+	Create(ctx context.Context, org *Organization) (*Organization, error)
+
+	// GetByID retrieves an organization by ID, returns ErrOrganizationNotFound if unknown
+	GetByID(ctx context.Context, id int64) (*Organization, error)
+
+	// ListByUser returns the organizations a user belongs to, oldest membership first
+	ListByUser(ctx context.Context, userID int64) ([]*OrganizationMembership, error)
+
+	// AddMember adds a user to an organization, returns ErrAlreadyMember if the user already belongs to it
+	AddMember(ctx context.Context, membership *Membership) error
+
+	// GetMembership retrieves the membership of a user in an organization, returns ErrNotMember if there is none
+	GetMembership(ctx context.Context, orgID, userID int64) (*Membership, error)
+
+	// UpdateMemberRole changes the role of a member, returns ErrNotMember if the user does not belong to the organization
+	UpdateMemberRole(ctx context.Context, orgID, userID int64, role Role) error
+
+	// RemoveMember removes a user from an organization, returns ErrNotMember if the user does not belong to it
+	RemoveMember(ctx context.Context, orgID, userID int64) error
+
+	// CountMemberships returns the number of organizations a user belongs to
+	CountMemberships(ctx context.Context, userID int64) (int64, error)
+}
@@ -0,0 +1,32 @@
+package user
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOrganization_Validate(t *testing.T) {
+	assert.NoError(t, (&Organization{Name: "Acme"}).Validate())
+	assert.Equal(t, ErrOrganizationNameRequired, (&Organization{}).Validate())
+}
+
+func TestMembership_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		role    Role
+		wantErr error
+	}{
+		{name: "admin", role: RoleAdmin, wantErr: nil},
+		{name: "reader", role: RoleReader, wantErr: nil},
+		{name: "empty role", role: "", wantErr: ErrInvalidRole},
+		{name: "unknown role", role: Role("owner"), wantErr: ErrInvalidRole},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := &Membership{OrgID: 1, UserID: 2, Role: tt.role}
+			assert.Equal(t, tt.wantErr, m.Validate())
+		})
+	}
+}
@@ -4,5 +4,9 @@ package user
 type PasswordHasher interface {
 	Hash(password string) (string, error)
 	Verify(hashedPassword, password string) bool
+
+	// NeedsRehash reports whether the hash was made with another algorithm
+	// or outdated parameters and should be replaced on the next login
+	NeedsRehash(hashedPassword string) bool
 }
 
@@ -0,0 +1,157 @@
+package user
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Rules of the password policy, reported in PasswordViolation.Rule
+const (
+	PasswordRuleMinLength    = "min_length"
+	PasswordRuleUppercase    = "uppercase"
+	PasswordRuleLowercase    = "lowercase"
+	PasswordRuleDigit        = "digit"
+	PasswordRuleSymbol       = "symbol"
+	PasswordRulePersonalInfo = "personal_info"
+	PasswordRuleReused       = "reused"
+	PasswordRuleBreached     = "breached"
+)
+
+// minPersonalInfoLength is the shortest part of a name or email a password may not contain,
+// shorter parts match too many passwords by accident
+const minPersonalInfoLength = 3
+
+// PasswordPolicy describes the passwords users may choose
+type PasswordPolicy struct {
+	MinLength            int  // In characters
+	RequireUppercase     bool // At least one uppercase letter
+	RequireLowercase     bool // At least one lowercase letter
+	RequireDigit         bool // At least one digit
+	RequireSymbol        bool // At least one character that is not a letter or digit
+	DisallowPersonalInfo bool // No part of the name or email of the user
+	HistorySize          int  // Number of previous passwords that can't be reused, zero allows reuse
+}
+
+// PasswordViolation is a rule of the password policy a password breaks
+type PasswordViolation struct {
+	Rule    string `json:"rule"`
+	Message string `json:"message"`
+}
+
+// PasswordPolicyError is returned when a password breaks the password policy.
+// It lists every broken rule so users can fix them at once.
+type PasswordPolicyError struct {
+	Violations []PasswordViolation
+}
+
+// Error implements the error interface
+func (e *PasswordPolicyError) Error() string {
+	return ErrWeakPassword.Error()
+}
+
+// Unwrap lets errors.Is match the error with ErrWeakPassword
+func (e *PasswordPolicyError) Unwrap() error {
+	return ErrWeakPassword
+}
+
+// Check returns the rules the password breaks for a user with the given name and email.
+// Reuse and breaches are checked against their stores, not here.
+func (p PasswordPolicy) Check(password, name, email string) []PasswordViolation {
+	var violations []PasswordViolation
+
+	if utf8.RuneCountInString(password) < p.MinLength {
+		violations = append(violations, PasswordViolation{
+			Rule:    PasswordRuleMinLength,
+			Message: fmt.Sprintf("password must be at least %d characters long", p.MinLength),
+		})
+	}
+
+	var hasUpper, hasLower, hasDigit, hasSymbol bool
+	for _, r := range password {
+		switch {
+		case unicode.IsUpper(r):
+			hasUpper = true
+		case unicode.IsLower(r):
+			hasLower = true
+		case unicode.IsDigit(r):
+			hasDigit = true
+		case !unicode.IsLetter(r):
+			hasSymbol = true
+		}
+	}
+	if p.RequireUppercase && !hasUpper {
+		violations = append(violations, PasswordViolation{Rule: PasswordRuleUppercase, Message: "password must contain an uppercase letter"})
+	}
+	if p.RequireLowercase && !hasLower {
+		violations = append(violations, PasswordViolation{Rule: PasswordRuleLowercase, Message: "password must contain a lowercase letter"})
+	}
+	if p.RequireDigit && !hasDigit {
+		violations = append(violations, PasswordViolation{Rule: PasswordRuleDigit, Message: "password must contain a digit"})
+	}
+	if p.RequireSymbol && !hasSymbol {
+		violations = append(violations, PasswordViolation{Rule: PasswordRuleSymbol, Message: "password must contain a symbol"})
+	}
+
+	if p.DisallowPersonalInfo && containsPersonalInfo(password, name, email) {
+		violations = append(violations, PasswordViolation{Rule: PasswordRulePersonalInfo, Message: "password must not contain your name or email"})
+	}
+
+	return violations
+}
+
+// containsPersonalInfo reports whether the password contains a word of the name or the local part of the email
+func containsPersonalInfo(password, name, email string) bool {
+	password = strings.ToLower(password)
+
+	parts := strings.Fields(strings.ToLower(name))
+	if local, _, found := strings.Cut(strings.ToLower(email), "@"); found {
+		parts = append(parts, local)
+	}
+
+	for _, part := range parts {
+		if utf8.RuneCountInString(part) >= minPersonalInfoLength && strings.Contains(password, part) {
+			return true
+		}
+	}
+	return false
+}
+
+// ReusedPasswordViolation is the violation of a password the user had before
+func ReusedPasswordViolation(historySize int) PasswordViolation {
+	return PasswordViolation{
+		Rule:    PasswordRuleReused,
+		Message: fmt.Sprintf("password must not be one of your last %d passwords", historySize),
+	}
+}
+
+// BreachedPasswordViolation is the violation of a password that appeared in a data breach
+func BreachedPasswordViolation() PasswordViolation {
+	return PasswordViolation{
+		Rule:    PasswordRuleBreached,
+		Message: "password has appeared in a data breach, choose another one",
+	}
+}
+
+// PasswordHistoryRepository is the driven port for the password hashes users had before
+type PasswordHistoryRepository interface {
+	// Add records a password hash the user now has
+	Add(ctx context.Context, userID int64, hash string, createdAt time.Time) error
+
+	// ListRecent retrieves the most recent password hashes of a user, newest first
+	ListRecent(ctx context.Context, userID int64, limit int) ([]string, error)
+
+	// Prune deletes all but the most recent keep password hashes of a user
+	Prune(ctx context.Context, userID int64, keep int) error
+
+	// DeleteByUser deletes every password hash of a user
+	DeleteByUser(ctx context.Context, userID int64) error
+}
+
+// BreachedPasswordChecker is a port for checking passwords against known data breaches
+type BreachedPasswordChecker interface {
+	IsBreached(ctx context.Context, password string) (bool, error)
+}
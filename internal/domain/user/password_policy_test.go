@@ -0,0 +1,71 @@
+package user
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func rules(violations []PasswordViolation) []string {
+	var names []string
+	for _, v := range violations {
+		names = append(names, v.Rule)
+	}
+	return names
+}
+
+func TestPasswordPolicy_Check(t *testing.T) {
+	strict := PasswordPolicy{
+		MinLength:            10,
+		RequireUppercase:     true,
+		RequireLowercase:     true,
+		RequireDigit:         true,
+		RequireSymbol:        true,
+		DisallowPersonalInfo: true,
+	}
+
+	tests := []struct {
+		name     string
+		policy   PasswordPolicy
+		password string
+		userName string
+		want     []string
+	}{
+		{name: "meets every rule", policy: strict, password: "Tr0ub4dor&3x"},
+		{name: "too short", policy: strict, password: "Tr0ub&x", want: []string{PasswordRuleMinLength}},
+		{name: "length counts characters", policy: PasswordPolicy{MinLength: 4}, password: "pässwört"},
+		{name: "missing classes", policy: strict, password: "correcthorsebattery", want: []string{PasswordRuleUppercase, PasswordRuleDigit, PasswordRuleSymbol}},
+		{name: "only symbols and digits", policy: strict, password: "1234567890!@#", want: []string{PasswordRuleUppercase, PasswordRuleLowercase}},
+		{name: "contains the name", policy: strict, password: "Jane-Doe-2024!", want: []string{PasswordRulePersonalInfo}},
+		{name: "contains the email", policy: strict, password: "JDOE.work#2024", want: []string{PasswordRulePersonalInfo}},
+		{name: "short name parts are ignored", policy: PasswordPolicy{DisallowPersonalInfo: true}, password: "janedoe", userName: "Ja Ne"},
+		{name: "personal info allowed", policy: PasswordPolicy{MinLength: 8}, password: "janedoe123"},
+		{name: "every rule broken", policy: strict, password: "jane", want: []string{
+			PasswordRuleMinLength, PasswordRuleUppercase, PasswordRuleDigit, PasswordRuleSymbol, PasswordRulePersonalInfo,
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			name, email := tt.userName, "jdoe.work@example.com"
+			if name == "" {
+				name = "Jane Doe"
+			}
+
+			assert.Equal(t, tt.want, rules(tt.policy.Check(tt.password, name, email)))
+		})
+	}
+}
+
+func TestPasswordPolicyError(t *testing.T) {
+	var err error = &PasswordPolicyError{Violations: []PasswordViolation{BreachedPasswordViolation(), ReusedPasswordViolation(5)}}
+
+	assert.True(t, errors.Is(err, ErrWeakPassword))
+	assert.Equal(t, ErrWeakPassword.Error(), err.Error())
+
+	var policyErr *PasswordPolicyError
+	assert.True(t, errors.As(err, &policyErr))
+	assert.Equal(t, []string{PasswordRuleBreached, PasswordRuleReused}, rules(policyErr.Violations))
+	assert.Contains(t, policyErr.Violations[1].Message, "last 5 passwords")
+}
@@ -0,0 +1,43 @@
+package user
+
+import (
+	"context"
+	"time"
+)
+
+// PasswordResetToken represents a persisted, single-use password reset token.
+// Only the hash of the token value is stored.
+type PasswordResetToken struct {
+	ID        int64
+	UserID    int64
+	TokenHash string
+	ExpiresAt time.Time
+	UsedAt    *time.Time
+	CreatedAt time.Time
+}
+
+// IsUsed reports whether the token has already been used
+func (t *PasswordResetToken) IsUsed() bool {
+	return t.UsedAt != nil
+}
+
+// IsExpired reports whether the token is expired at the given time
+func (t *PasswordResetToken) IsExpired(now time.Time) bool {
+	return !now.Before(t.ExpiresAt)
+}
+
+// PasswordResetRepository is the driven port for password reset token persistence
+type PasswordResetRepository interface {
+	// Create stores a new reset token
+	Create(ctx context.Context, token *PasswordResetToken) (*PasswordResetToken, error)
+
+	// GetByHash retrieves a reset token by the hash of its value
+	GetByHash(ctx context.Context, tokenHash string) (*PasswordResetToken, error)
+
+	// MarkUsed consumes a token, returns ErrInvalidResetToken
+	// if the token is unknown or already used
+	MarkUsed(ctx context.Context, id int64) error
+
+	// InvalidateByUser consumes every unused token of a user
+	InvalidateByUser(ctx context.Context, userID int64) error
+}
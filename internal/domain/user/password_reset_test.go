@@ -0,0 +1,25 @@
+package user
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPasswordResetToken_IsUsed(t *testing.T) {
+	token := &PasswordResetToken{}
+	assert.False(t, token.IsUsed())
+
+	now := time.Now()
+	token.UsedAt = &now
+	assert.True(t, token.IsUsed())
+}
+
+func TestPasswordResetToken_IsExpired(t *testing.T) {
+	now := time.Now()
+
+	assert.False(t, (&PasswordResetToken{ExpiresAt: now.Add(time.Minute)}).IsExpired(now))
+	assert.True(t, (&PasswordResetToken{ExpiresAt: now}).IsExpired(now))
+	assert.True(t, (&PasswordResetToken{ExpiresAt: now.Add(-time.Minute)}).IsExpired(now))
+}
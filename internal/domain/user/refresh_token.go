@@ -0,0 +1,58 @@
+package user
+
+import (
+	"context"
+	"time"
+)
+
+// RefreshToken represents a persisted refresh token.
+// Tokens issued by rotating the same login share a FamilyID so that
+// the whole chain can be revoked when reuse is detected.
+type RefreshToken struct {
+	ID        int64
+	UserID    int64
+	FamilyID  string
+	OrgID     int64 // organization the rotated tokens act in
+	TokenHash string
+	ExpiresAt time.Time
+	RevokedAt *time.Time
+	CreatedAt time.Time
+}
+
+// IsRevoked reports whether the token has been revoked
+func (t *RefreshToken) IsRevoked() bool {
+	return t.RevokedAt != nil
+}
+
+// IsExpired reports whether the token is expired at the given time
+func (t *RefreshToken) IsExpired(now time.Time) bool {
+	return !now.Before(t.ExpiresAt)
+}
+
+// RefreshTokenRepository is the driven port for refresh token persistence
+type RefreshTokenRepository interface {
+	// Create stores a new refresh token
+	Create(ctx context.Context, token *RefreshToken) (*RefreshToken, error)
+
+	// GetByHash retrieves a refresh token by the hash of its value
+	GetByHash(ctx context.Context, tokenHash string) (*RefreshToken, error)
+
+	// Revoke revokes a single token, returns ErrInvalidRefreshToken
+	// if the token is unknown or already revoked
+	Revoke(ctx context.Context, id int64) error
+
+	// RevokeFamily revokes every token of a family
+	RevokeFamily(ctx context.Context, familyID string) error
+
+	// RevokeByUser revokes every token issued to a user
+	RevokeByUser(ctx context.Context, userID int64) error
+}
+
+// TokenRevocationStore is a port for revoking access tokens before they expire
+type TokenRevocationStore interface {
+	// Revoke marks the token ID as revoked until expiresAt
+	Revoke(ctx context.Context, tokenID string, expiresAt time.Time) error
+
+	// IsRevoked reports whether the token ID has been revoked
+	IsRevoked(ctx context.Context, tokenID string) (bool, error)
+}
@@ -0,0 +1,67 @@
+package user
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRefreshToken_IsRevoked(t *testing.T) {
+	revokedAt := time.Now()
+
+	tests := []struct {
+		name  string
+		token RefreshToken
+		want  bool
+	}{
+		{
+			name:  "active token",
+			token: RefreshToken{ID: 1, RevokedAt: nil},
+			want:  false,
+		},
+		{
+			name:  "revoked token",
+			token: RefreshToken{ID: 1, RevokedAt: &revokedAt},
+			want:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.token.IsRevoked())
+		})
+	}
+}
+
+func TestRefreshToken_IsExpired(t *testing.T) {
+	now := time.Now()
+
+	tests := []struct {
+		name  string
+		token RefreshToken
+		want  bool
+	}{
+		{
+			name:  "expires in the future",
+			token: RefreshToken{ExpiresAt: now.Add(time.Hour)},
+			want:  false,
+		},
+		{
+			name:  "expired in the past",
+			token: RefreshToken{ExpiresAt: now.Add(-time.Hour)},
+			want:  true,
+		},
+		{
+			name:  "expires exactly now",
+			token: RefreshToken{ExpiresAt: now},
+			want:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.token.IsExpired(now))
+		})
+	}
+}
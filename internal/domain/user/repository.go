@@ -1,6 +1,9 @@
 package user
 
-import "context"
+import (
+	"context"
+	"time"
+)
 
 // Repository is the driven port (interface) for user persistence
 // This defines what the domain needs, not how it's implemented
@@ -8,22 +11,46 @@ type Repository interface {
 	// Create creates a new user
 	Create(ctx context.Context, user *User) (*User, error)
 
-	// GetByID retrieves a user by ID
-	GetByID(ctx context.Context, id int64) (*User, error)
+	// GetByID retrieves a user by ID. When orgID is not zero the user must be a member of
+	// that organization, other users are reported as ErrUserNotFound.
+	GetByID(ctx context.Context, orgID, id int64) (*User, error)
 
 	// GetByEmail retrieves a user by email
 	GetByEmail(ctx context.Context, email string) (*User, error)
 
-	// Update updates an existing user
-	Update(ctx context.Context, user *User) (*User, error)
+	// Update updates an existing user, a member of orgID unless orgID is zero
+	Update(ctx context.Context, orgID int64, user *User) (*User, error)
 
-	// Delete deletes a user by ID
-	Delete(ctx context.Context, id int64) error
+	// Delete moves a user to the trash (soft delete), a member of orgID unless orgID is zero
+	Delete(ctx context.Context, orgID, id int64) error
 
-	// List retrieves all users with pagination
-	List(ctx context.Context, limit, offset int) ([]*User, error)
+	// List retrieves the members of an organization with pagination.
+	// The role of every user is their role in the organization.
+	List(ctx context.Context, orgID int64, limit, offset int) ([]*User, error)
 
-	// Count returns the total number of users
-	Count(ctx context.Context) (int64, error)
+	// Count returns the number of members of an organization
+	Count(ctx context.Context, orgID int64) (int64, error)
+
+	// Find retrieves the members of an organization matching the criteria,
+	// with the number of members matching it regardless of the pagination
+	Find(ctx context.Context, criteria Criteria) ([]*User, int64, error)
+
+	// Restore takes a user out of the trash, a member of orgID unless orgID is zero.
+	// Returns ErrUserNotFound if it is not in the trash.
+	Restore(ctx context.Context, orgID, id int64) error
+
+	// ListDeleted retrieves the members of an organization that are in the trash with pagination
+	ListDeleted(ctx context.Context, orgID int64, limit, offset int) ([]*User, error)
+
+	// CountDeleted returns the number of members of an organization that are in the trash
+	CountDeleted(ctx context.Context, orgID int64) (int64, error)
+
+	// ListDeletedIDsBefore retrieves the IDs of up to limit users moved to the trash before the given time
+	// who do not author any article
+	ListDeletedIDsBefore(ctx context.Context, before time.Time, limit int) ([]int64, error)
+
+	// Purge permanently deletes a user that is in the trash, returns ErrUserNotFound if it is not in the trash
+	// and ErrUserHasArticles if the user still authors articles
+	Purge(ctx context.Context, id int64) error
 }
 
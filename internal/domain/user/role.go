@@ -0,0 +1,99 @@
+package user
+
+// Role represents the role of a user
+type Role string
+
+const (
+	// RoleAdmin can manage users and all content
+	RoleAdmin Role = "admin"
+	// RoleEditor can manage all content
+	RoleEditor Role = "editor"
+	// RoleAuthor can write content
+	RoleAuthor Role = "author"
+	// RoleReader can only read content
+	RoleReader Role = "reader"
+)
+
+// DefaultRole is the role given to users that register themselves
+const DefaultRole = RoleAuthor
+
+// Permission represents an action a role may perform
+type Permission string
+
+const (
+	// PermUsersRead allows reading user accounts
+	PermUsersRead Permission = "users:read"
+	// PermUsersWrite allows creating, updating and deleting user accounts
+	PermUsersWrite Permission = "users:write"
+	// PermArticlesRead allows reading articles
+	PermArticlesRead Permission = "articles:read"
+	// PermArticlesWrite allows creating, updating and deleting articles
+	PermArticlesWrite Permission = "articles:write"
+	// PermArticlesPublish allows publishing the articles submitted for review
+	PermArticlesPublish Permission = "articles:publish"
+	// PermTaxonomyManage allows creating, renaming and deleting tags and categories
+	PermTaxonomyManage Permission = "taxonomy:manage"
+	// PermMediaRead allows reading media
+	PermMediaRead Permission = "media:read"
+	// PermMediaWrite allows uploading and replacing media
+	PermMediaWrite Permission = "media:write"
+	// PermMediaDelete allows deleting media
+	PermMediaDelete Permission = "media:delete"
+	// PermTrashManage allows listing and restoring deleted users, articles and media
+	PermTrashManage Permission = "trash:manage"
+	// PermUsersImpersonate allows acting as another user to reproduce what they see
+	PermUsersImpersonate Permission = "users:impersonate"
+)
+
+// rolePermissions maps every role to the permissions it grants
+var rolePermissions = map[Role][]Permission{
+	RoleAdmin: {
+		PermUsersRead, PermUsersWrite,
+		PermArticlesRead, PermArticlesWrite, PermArticlesPublish,
+		PermTaxonomyManage,
+		PermMediaRead, PermMediaWrite, PermMediaDelete,
+		PermTrashManage,
+		PermUsersImpersonate,
+	},
+	RoleEditor: {
+		PermArticlesRead, PermArticlesWrite, PermArticlesPublish,
+		PermTaxonomyManage,
+		PermMediaRead, PermMediaWrite, PermMediaDelete,
+	},
+	RoleAuthor: {
+		PermArticlesRead, PermArticlesWrite,
+		PermMediaRead, PermMediaWrite,
+	},
+	RoleReader: {
+		PermArticlesRead,
+		PermMediaRead,
+	},
+}
+
+// IsValid reports whether the role is a known role
+func (r Role) IsValid() bool {
+	_, ok := rolePermissions[r]
+	return ok
+}
+
+// IsValid reports whether the permission is granted by any role
+func (p Permission) IsValid() bool {
+	for _, permissions := range rolePermissions {
+		for _, granted := range permissions {
+			if granted == p {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// HasPermission reports whether the role grants the permission
+func (r Role) HasPermission(p Permission) bool {
+	for _, granted := range rolePermissions[r] {
+		if granted == p {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,87 @@
+package user
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRole_IsValid(t *testing.T) {
+	tests := []struct {
+		role Role
+		want bool
+	}{
+		{RoleAdmin, true},
+		{RoleEditor, true},
+		{RoleAuthor, true},
+		{RoleReader, true},
+		{Role(""), false},
+		{Role("superuser"), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.role), func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.role.IsValid())
+		})
+	}
+}
+
+func TestRole_HasPermission(t *testing.T) {
+	allPermissions := []Permission{
+		PermUsersRead, PermUsersWrite,
+		PermArticlesRead, PermArticlesWrite, PermArticlesPublish,
+		PermTaxonomyManage,
+		PermMediaRead, PermMediaWrite, PermMediaDelete,
+		PermTrashManage,
+		PermUsersImpersonate,
+	}
+
+	tests := []struct {
+		role    Role
+		granted []Permission
+	}{
+		{RoleAdmin, allPermissions},
+		{RoleEditor, []Permission{PermArticlesRead, PermArticlesWrite, PermArticlesPublish, PermTaxonomyManage, PermMediaRead, PermMediaWrite, PermMediaDelete}},
+		{RoleAuthor, []Permission{PermArticlesRead, PermArticlesWrite, PermMediaRead, PermMediaWrite}},
+		{RoleReader, []Permission{PermArticlesRead, PermMediaRead}},
+		{Role("unknown"), nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.role), func(t *testing.T) {
+			for _, p := range allPermissions {
+				assert.Equal(t, contains(tt.granted, p), tt.role.HasPermission(p), "permission %s", p)
+			}
+		})
+	}
+}
+
+func contains(permissions []Permission, p Permission) bool {
+	for _, granted := range permissions {
+		if granted == p {
+			return true
+		}
+	}
+	return false
+}
+
+func TestPermission_IsValid(t *testing.T) {
+	tests := []struct {
+		permission Permission
+		want       bool
+	}{
+		{PermArticlesRead, true},
+		{PermMediaDelete, true},
+		{PermUsersWrite, true},
+		{PermArticlesPublish, true},
+		{PermTaxonomyManage, true},
+		{Permission(""), false},
+		{Permission("articles:approve"), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.permission), func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.permission.IsValid())
+		})
+	}
+}
@@ -0,0 +1,25 @@
+package user
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGenerateSecureToken(t *testing.T) {
+	token1, err := GenerateSecureToken(32)
+	assert.NoError(t, err)
+	assert.Len(t, token1, 43) // base64 (raw, url-safe) of 32 bytes
+
+	token2, err := GenerateSecureToken(32)
+	assert.NoError(t, err)
+	assert.NotEqual(t, token1, token2)
+}
+
+func TestHashToken(t *testing.T) {
+	hash := HashToken("token-value")
+
+	assert.Len(t, hash, 64)
+	assert.Equal(t, hash, HashToken("token-value"))
+	assert.NotEqual(t, hash, HashToken("other-token-value"))
+}
@@ -3,6 +3,7 @@ package user
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -25,7 +26,7 @@ func (m *mockRepository) Create(ctx context.Context, user *User) (*User, error)
 	return nil, nil
 }
 
-func (m *mockRepository) GetByID(ctx context.Context, id int64) (*User, error) {
+func (m *mockRepository) GetByID(ctx context.Context, orgID, id int64) (*User, error) {
 	if m.getByIDFunc != nil {
 		return m.getByIDFunc(ctx, id)
 	}
@@ -39,28 +40,28 @@ func (m *mockRepository) GetByEmail(ctx context.Context, email string) (*User, e
 	return nil, nil
 }
 
-func (m *mockRepository) Update(ctx context.Context, user *User) (*User, error) {
+func (m *mockRepository) Update(ctx context.Context, orgID int64, user *User) (*User, error) {
 	if m.updateFunc != nil {
 		return m.updateFunc(ctx, user)
 	}
 	return nil, nil
 }
 
-func (m *mockRepository) Delete(ctx context.Context, id int64) error {
+func (m *mockRepository) Delete(ctx context.Context, orgID, id int64) error {
 	if m.deleteFunc != nil {
 		return m.deleteFunc(ctx, id)
 	}
 	return nil
 }
 
-func (m *mockRepository) List(ctx context.Context, limit, offset int) ([]*User, error) {
+func (m *mockRepository) List(ctx context.Context, orgID int64, limit, offset int) ([]*User, error) {
 	if m.listFunc != nil {
 		return m.listFunc(ctx, limit, offset)
 	}
 	return nil, nil
 }
 
-func (m *mockRepository) Count(ctx context.Context) (int64, error) {
+func (m *mockRepository) Count(ctx context.Context, orgID int64) (int64, error) {
 	if m.countFunc != nil {
 		return m.countFunc(ctx)
 	}
@@ -69,12 +70,12 @@ func (m *mockRepository) Count(ctx context.Context) (int64, error) {
 
 // mockTokenGenerator is a mock implementation of TokenGenerator for testing
 type mockTokenGenerator struct {
-	generateFunc func(userID int64, email string) (string, error)
+	generateFunc func(claims TokenClaims) (string, error)
 }
 
-func (m *mockTokenGenerator) Generate(userID int64, email string) (string, error) {
+func (m *mockTokenGenerator) Generate(claims TokenClaims) (string, error) {
 	if m.generateFunc != nil {
-		return m.generateFunc(userID, email)
+		return m.generateFunc(claims)
 	}
 	return "mock-token", nil
 }
@@ -93,8 +94,9 @@ func (m *mockTokenValidator) Validate(token string) (*TokenClaims, error) {
 
 // mockPasswordHasher is a mock implementation of PasswordHasher for testing
 type mockPasswordHasher struct {
-	hashFunc   func(password string) (string, error)
-	verifyFunc func(hashedPassword, password string) bool
+	hashFunc        func(password string) (string, error)
+	verifyFunc      func(hashedPassword, password string) bool
+	needsRehashFunc func(hashedPassword string) bool
 }
 
 func (m *mockPasswordHasher) Hash(password string) (string, error) {
@@ -111,6 +113,13 @@ func (m *mockPasswordHasher) Verify(hashedPassword, password string) bool {
 	return true
 }
 
+func (m *mockPasswordHasher) NeedsRehash(hashedPassword string) bool {
+	if m.needsRehashFunc != nil {
+		return m.needsRehashFunc(hashedPassword)
+	}
+	return false
+}
+
 func TestNewService(t *testing.T) {
 	tests := []struct {
 		name           string
@@ -154,3 +163,27 @@ func TestNewService(t *testing.T) {
 	}
 }
 
+
+func (m *mockRepository) Find(ctx context.Context, criteria Criteria) ([]*User, int64, error) {
+	return nil, 0, nil
+}
+
+func (m *mockRepository) Restore(ctx context.Context, orgID, id int64) error {
+	return nil
+}
+
+func (m *mockRepository) ListDeleted(ctx context.Context, orgID int64, limit, offset int) ([]*User, error) {
+	return nil, nil
+}
+
+func (m *mockRepository) CountDeleted(ctx context.Context, orgID int64) (int64, error) {
+	return 0, nil
+}
+
+func (m *mockRepository) ListDeletedIDsBefore(ctx context.Context, before time.Time, limit int) ([]int64, error) {
+	return nil, nil
+}
+
+func (m *mockRepository) Purge(ctx context.Context, id int64) error {
+	return nil
+}
@@ -0,0 +1,52 @@
+package user
+
+import (
+	"context"
+	"time"
+)
+
+// Session represents a login on one device.
+// Its ID is carried in the access tokens of the login and doubles as
+// the refresh token family, so rotating tokens keeps the same session.
+// Impersonation is recorded as a session of the user started by the admin.
+type Session struct {
+	ID         string
+	UserID     int64
+	ActorID    int64 // admin impersonating the user, zero for logins of the user
+	UserAgent  string
+	IPAddress  string
+	CreatedAt  time.Time
+	LastSeenAt time.Time
+	ExpiresAt  time.Time
+	RevokedAt  *time.Time
+}
+
+// IsActive reports whether the session is neither revoked nor expired at the given time
+func (s *Session) IsActive(now time.Time) bool {
+	return s.RevokedAt == nil && now.Before(s.ExpiresAt)
+}
+
+// SessionRevocationID returns the ID under which a revoked session is kept in the
+// TokenRevocationStore, so that access tokens of the session are rejected
+func SessionRevocationID(sessionID string) string {
+	return "session:" + sessionID
+}
+
+// SessionRepository is the driven port for session persistence
+type SessionRepository interface {
+	// Create stores a new session
+	Create(ctx context.Context, session *Session) error
+
+	// GetByID retrieves a session, returns ErrSessionNotFound if it does not exist
+	GetByID(ctx context.Context, id string) (*Session, error)
+
+	// ListActiveByUser returns the sessions of a user that are neither revoked nor expired, most recently seen first
+	ListActiveByUser(ctx context.Context, userID int64) ([]*Session, error)
+
+	// Touch records activity on the session and extends its expiry
+	Touch(ctx context.Context, id string, lastSeenAt, expiresAt time.Time) error
+
+	// Revoke revokes a session, returns ErrSessionNotFound
+	// if the session is unknown or already revoked
+	Revoke(ctx context.Context, id string) error
+}
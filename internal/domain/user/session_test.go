@@ -0,0 +1,46 @@
+package user
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSession_IsActive(t *testing.T) {
+	now := time.Now()
+	revokedAt := now.Add(-time.Minute)
+
+	tests := []struct {
+		name    string
+		session Session
+		want    bool
+	}{
+		{
+			name:    "active session",
+			session: Session{ID: "s1", ExpiresAt: now.Add(time.Hour)},
+			want:    true,
+		},
+		{
+			name:    "revoked session",
+			session: Session{ID: "s1", ExpiresAt: now.Add(time.Hour), RevokedAt: &revokedAt},
+			want:    false,
+		},
+		{
+			name:    "expired session",
+			session: Session{ID: "s1", ExpiresAt: now},
+			want:    false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.session.IsActive(now))
+		})
+	}
+}
+
+func TestSessionRevocationID(t *testing.T) {
+	assert.Equal(t, "session:abc", SessionRevocationID("abc"))
+	assert.NotEqual(t, "abc", SessionRevocationID("abc"))
+}
@@ -1,14 +1,35 @@
 package user
 
-// TokenClaims represents the claims extracted from a token
+import "time"
+
+// TokenClaims represents the claims carried by a token.
+// TokenID and ExpiresAt are assigned by the TokenGenerator
 type TokenClaims struct {
-	UserID int64
-	Email  string
+	UserID        int64
+	Email         string
+	Role          Role
+	EmailVerified bool
+	TokenID       string
+	ExpiresAt     time.Time
+	// OrgID is the organization the token acts in, Role is the role of the user there
+	OrgID int64
+	// SessionID identifies the login the token was issued for, empty when sessions are not recorded
+	SessionID string
+	// Scopes narrows the permissions of the role, empty means unrestricted.
+	// Only API keys carry scopes.
+	Scopes []Permission
+	// ActorID is the admin acting as the user, zero unless the token was issued for impersonation
+	ActorID int64
+}
+
+// IsImpersonation reports whether the token lets an admin act as another user
+func (c *TokenClaims) IsImpersonation() bool {
+	return c.ActorID != 0
 }
 
 // TokenGenerator is a port for generating authentication tokens
 type TokenGenerator interface {
-	Generate(userID int64, email string) (string, error)
+	Generate(claims TokenClaims) (string, error)
 }
 
 // TokenValidator is a port for validating authentication tokens
@@ -16,3 +37,22 @@ type TokenValidator interface {
 	Validate(token string) (*TokenClaims, error)
 }
 
+// PublicKey is the public part of a token signing key, in JSON Web Key format (RFC 7517)
+type PublicKey struct {
+	KeyID     string `json:"kid"`
+	KeyType   string `json:"kty"`
+	Algorithm string `json:"alg"`
+	Use       string `json:"use"`
+	// RSA modulus and exponent
+	N string `json:"n,omitempty"`
+	E string `json:"e,omitempty"`
+	// OKP curve and public key (Ed25519)
+	Curve string `json:"crv,omitempty"`
+	X     string `json:"x,omitempty"`
+}
+
+// PublicKeyProvider is a port for publishing the keys that verify authentication tokens,
+// so other services can validate tokens without holding the signing key
+type PublicKeyProvider interface {
+	PublicKeys() []PublicKey
+}
@@ -0,0 +1,96 @@
+package user
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"strings"
+	"time"
+)
+
+// RecoveryCodeCount is how many recovery codes are issued at once
+const RecoveryCodeCount = 10
+
+// TwoFactor represents the TOTP enrollment of a user.
+// It is pending until the user confirms it with a valid code.
+type TwoFactor struct {
+	UserID       int64
+	Secret       string
+	EnabledAt    *time.Time
+	LastUsedStep int64 // time step of the last accepted one-time password, codes up to it are rejected
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+// IsEnabled reports whether the enrollment has been confirmed
+func (t *TwoFactor) IsEnabled() bool {
+	return t.EnabledAt != nil
+}
+
+// TwoFactorRepository is the driven port for two-factor persistence
+type TwoFactorRepository interface {
+	// GetByUser returns the enrollment of a user, ErrTwoFactorNotEnrolled if there is none
+	GetByUser(ctx context.Context, userID int64) (*TwoFactor, error)
+
+	// Save creates or replaces the enrollment of a user
+	Save(ctx context.Context, twoFactor *TwoFactor) error
+
+	// Delete removes the enrollment and the recovery codes of a user
+	Delete(ctx context.Context, userID int64) error
+
+	// ReplaceRecoveryCodes replaces all recovery codes of a user with the given hashes
+	ReplaceRecoveryCodes(ctx context.Context, userID int64, codeHashes []string) error
+
+	// UseRecoveryCode consumes an unused recovery code, returns ErrInvalidTwoFactorCode
+	// if the code is unknown or was already used
+	UseRecoveryCode(ctx context.Context, userID int64, codeHash string) error
+
+	// UseTimeStep records the time step of an accepted one-time password, returns
+	// ErrInvalidTwoFactorCode if a code of this step or a later one was already accepted
+	UseTimeStep(ctx context.Context, userID, step int64) error
+}
+
+// TOTPAuthenticator is a port for time-based one-time passwords (RFC 6238)
+type TOTPAuthenticator interface {
+	// GenerateSecret creates a new shared secret
+	GenerateSecret() (string, error)
+
+	// ProvisioningURI returns the otpauth:// URI that authenticator apps read from a QR code
+	ProvisioningURI(secret, accountName string) string
+
+	// Validate reports whether the code is valid for the secret at the given time
+	// and returns the time step the code belongs to
+	Validate(secret, code string, at time.Time) (int64, bool)
+}
+
+// TwoFactorChallengeStore is a port for short-lived login challenges.
+// A challenge is issued after the password check and traded for tokens with a valid code.
+type TwoFactorChallengeStore interface {
+	// Save stores the challenge for the user until the TTL runs out
+	Save(ctx context.Context, tokenHash string, userID int64, ttl time.Duration) error
+
+	// Take returns the user of the challenge and removes it so it is redeemed only once,
+	// ErrInvalidTwoFactorChallenge if it is unknown or expired
+	Take(ctx context.Context, tokenHash string) (int64, error)
+}
+
+// GenerateRecoveryCodes returns n random recovery codes formatted as xxxxx-xxxxx
+func GenerateRecoveryCodes(n int) ([]string, error) {
+	codes := make([]string, n)
+	for i := range codes {
+		b := make([]byte, 5)
+		if _, err := rand.Read(b); err != nil {
+			return nil, err
+		}
+		code := hex.EncodeToString(b)
+		codes[i] = code[:5] + "-" + code[5:]
+	}
+	return codes, nil
+}
+
+// NormalizeRecoveryCode makes recovery codes comparable regardless of case, spaces and dashes
+func NormalizeRecoveryCode(code string) string {
+	code = strings.ToLower(strings.TrimSpace(code))
+	code = strings.ReplaceAll(code, " ", "")
+	return strings.ReplaceAll(code, "-", "")
+}
@@ -0,0 +1,37 @@
+package user
+
+import (
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTwoFactor_IsEnabled(t *testing.T) {
+	twoFactor := &TwoFactor{}
+	assert.False(t, twoFactor.IsEnabled())
+
+	now := time.Now()
+	twoFactor.EnabledAt = &now
+	assert.True(t, twoFactor.IsEnabled())
+}
+
+func TestGenerateRecoveryCodes(t *testing.T) {
+	codes, err := GenerateRecoveryCodes(RecoveryCodeCount)
+	assert.NoError(t, err)
+
+	assert.Len(t, codes, RecoveryCodeCount)
+	seen := make(map[string]bool)
+	for _, code := range codes {
+		assert.Regexp(t, regexp.MustCompile(`^[0-9a-f]{5}-[0-9a-f]{5}$`), code)
+		assert.False(t, seen[code])
+		seen[code] = true
+	}
+}
+
+func TestNormalizeRecoveryCode(t *testing.T) {
+	assert.Equal(t, "abcde12345", NormalizeRecoveryCode("abcde-12345"))
+	assert.Equal(t, "abcde12345", NormalizeRecoveryCode(" ABCDE 12345 "))
+	assert.Equal(t, "abcde12345", NormalizeRecoveryCode("abcde12345"))
+}
@@ -3,6 +3,7 @@ package config
 import (
 	"fmt"
 	"os"
+	"strings"
 
 	"github.com/joho/godotenv"
 )
@@ -13,14 +14,24 @@ type Config struct {
 	Database DatabaseConfig
 	Redis    RedisConfig
 	JWT      JWTConfig
+	Auth     AuthConfig
+	OIDC     OIDCConfig
 	Storage  StorageConfig
+	Trash    TrashConfig
+	Article  ArticleConfig
+	Privacy  PrivacyConfig
+	Org      OrganizationConfig
+	Password PasswordConfig
 }
 
 // ServerConfig holds server configuration
 type ServerConfig struct {
-	Port  string
-	Host  string
-	Debug bool
+	Port           string
+	Host           string
+	Debug          bool
+	BaseURL        string   // public URL used in links sent to users
+	TrustedProxies []string // proxy IPs or CIDRs whose X-Forwarded-For is believed, none by default
+	Replicas       int      // number of instances serving the application, in-process state needs 1
 }
 
 // DatabaseConfig holds database configuration
@@ -42,8 +53,49 @@ type RedisConfig struct {
 
 // JWTConfig holds JWT configuration
 type JWTConfig struct {
-	Secret     string
-	Expiration int // in hours
+	Secret            string // HS256 secret, used when no key directory is set
+	KeyDir            string // directory of PEM encoded RS256/EdDSA keys named <kid>.pem
+	SigningKeyID      string // kid of the active signing key in KeyDir
+	Issuer            string
+	Audience          string
+	AccessExpiration  int // in minutes
+	RefreshExpiration int // in hours
+}
+
+// AuthConfig holds account security configuration
+type AuthConfig struct {
+	PasswordResetExpiration      int    // in minutes
+	EmailVerificationPolicy      string // none, content or login
+	EmailVerificationSecret      string // falls back to the JWT secret when empty
+	EmailVerificationExpiration  int    // in hours
+	LoginMaxAttempts             int    // failures per account before a lockout
+	LoginMaxAttemptsPerIP        int    // failures per IP address before a lockout
+	LoginLockoutBase             int    // in seconds, doubled for every further failure
+	LoginLockoutMax              int    // in minutes
+	LoginAttemptWindow           int    // in minutes, failures older than this are forgotten
+	Argon2Memory                 int    // in KiB
+	Argon2Iterations             int
+	Argon2Parallelism            int
+	TOTPIssuer                   string // shown in authenticator apps
+	TwoFactorChallengeExpiration int    // in minutes
+	RegistrationMode             string // open, invite or disabled
+	InvitationExpiration         int    // in hours
+	ImpersonationExpiration      int    // in minutes, must be below the access token expiration
+}
+
+// OIDCConfig holds the OpenID Connect identity provider configuration.
+// Sign-in with the provider is disabled while IssuerURL is empty.
+type OIDCConfig struct {
+	ProviderName        string // stored with linked identities
+	IssuerURL           string
+	ClientID            string
+	ClientSecret        string
+	RedirectURL         string // must point to /api/v1/users/oidc/callback
+	LoginRedirectURL    string // page of the client receiving ?code= after a sign-in, trades it at /api/v1/users/oidc/token
+	Scopes              string // space separated, openid is always requested
+	AutoProvision       bool   // create users on their first sign-in
+	StateExpiration     int    // in minutes
+	LoginCodeExpiration int    // in seconds
 }
 
 // StorageConfig holds storage configuration
@@ -52,6 +104,45 @@ type StorageConfig struct {
 	BaseURL  string
 }
 
+// TrashConfig holds configuration for soft-deleted users, articles and media
+type TrashConfig struct {
+	RetentionDays int // days items stay in the trash before they are purged, 0 keeps them forever
+	PurgeInterval int // in minutes
+}
+
+// ArticleConfig holds configuration for the article workflow
+type ArticleConfig struct {
+	SchedulerInterval int    // in seconds, how often scheduled articles are published, 0 disables scheduled publishing
+	SearchBackend     string // mysql (FULLTEXT indexes) or memory (in-process index rebuilt at startup, single instance only)
+}
+
+// PrivacyConfig holds configuration for data export and erasure requests
+type PrivacyConfig struct {
+	ExportPath        string // directory for export archives, must not be publicly served
+	ExportExpiration  int    // in hours, archives are deleted afterwards
+	ErasurePolicy     string // delete or reassign, what happens to an erased user's content
+	ErasureReassignTo int64  // user receiving the content with the reassign policy
+	WorkerInterval    int    // in seconds
+	StaleAfter        int    // in minutes, running requests are picked up again afterwards
+}
+
+// OrganizationConfig holds configuration for organizations
+type OrganizationConfig struct {
+	DefaultID int64 // organization joined by users who sign up or are provisioned on their own
+}
+
+// PasswordConfig holds the password policy
+type PasswordConfig struct {
+	MinLength            int // in characters
+	RequireUppercase     bool
+	RequireLowercase     bool
+	RequireDigit         bool
+	RequireSymbol        bool
+	DisallowPersonalInfo bool   // rejects passwords containing the name or email of the user
+	History              int    // number of previous passwords that can't be reused, 0 allows reuse
+	BreachedPath         string // directory of k-anonymity range files, empty disables the breach check
+}
+
 // Load loads configuration from environment variables
 func Load() *Config {
 	// Load .env file (ignore error if file doesn't exist)
@@ -59,9 +150,12 @@ func Load() *Config {
 
 	return &Config{
 		Server: ServerConfig{
-			Port:  getEnv("SERVER_PORT", "8080"),
-			Host:  getEnv("SERVER_HOST", "0.0.0.0"),
-			Debug: getEnvBool("DEBUG", false),
+			Port:           getEnv("SERVER_PORT", "8080"),
+			Host:           getEnv("SERVER_HOST", "0.0.0.0"),
+			Debug:          getEnvBool("DEBUG", false),
+			BaseURL:        getEnv("APP_BASE_URL", "http://localhost:8080"),
+			TrustedProxies: getEnvList("TRUSTED_PROXIES"),
+			Replicas:       getEnvInt("APP_REPLICAS", 1),
 		},
 		Database: DatabaseConfig{
 			Host:     getEnv("DB_HOST", "localhost"),
@@ -76,13 +170,78 @@ func Load() *Config {
 			Password: getEnv("REDIS_PASSWORD", ""),
 		},
 		JWT: JWTConfig{
-			Secret:     getEnv("JWT_SECRET", "your-secret-key-change-in-production"),
-			Expiration: getEnvInt("JWT_EXPIRATION", 24), // 24 hours default
+			Secret:            getEnv("JWT_SECRET", "your-secret-key-change-in-production"),
+			KeyDir:            getEnv("JWT_KEY_DIR", ""),
+			SigningKeyID:      getEnv("JWT_SIGNING_KEY_ID", ""),
+			Issuer:            getEnv("JWT_ISSUER", "hexa-go"),
+			Audience:          getEnv("JWT_AUDIENCE", "hexa-go"),
+			AccessExpiration:  getEnvInt("JWT_ACCESS_EXPIRATION", 15),   // 15 minutes default
+			RefreshExpiration: getEnvInt("JWT_REFRESH_EXPIRATION", 720), // 30 days default
+		},
+		Auth: AuthConfig{
+			PasswordResetExpiration:      getEnvInt("PASSWORD_RESET_EXPIRATION", 60), // 1 hour default
+			EmailVerificationPolicy:      getEnv("EMAIL_VERIFICATION_POLICY", "none"),
+			EmailVerificationSecret:      getEnv("EMAIL_VERIFICATION_SECRET", ""),
+			EmailVerificationExpiration:  getEnvInt("EMAIL_VERIFICATION_EXPIRATION", 48), // 2 days default
+			LoginMaxAttempts:             getEnvInt("LOGIN_MAX_ATTEMPTS", 5),
+			LoginMaxAttemptsPerIP:        getEnvInt("LOGIN_MAX_ATTEMPTS_PER_IP", 20),
+			LoginLockoutBase:             getEnvInt("LOGIN_LOCKOUT_BASE", 60),     // 1 minute default
+			LoginLockoutMax:              getEnvInt("LOGIN_LOCKOUT_MAX", 60),      // 1 hour default
+			LoginAttemptWindow:           getEnvInt("LOGIN_ATTEMPT_WINDOW", 1440), // 1 day default
+			Argon2Memory:                 getEnvInt("ARGON2_MEMORY", 65536),       // 64 MiB default
+			Argon2Iterations:             getEnvInt("ARGON2_ITERATIONS", 3),
+			Argon2Parallelism:            getEnvInt("ARGON2_PARALLELISM", 4),
+			TOTPIssuer:                   getEnv("TOTP_ISSUER", "Hexa-Go"),
+			TwoFactorChallengeExpiration: getEnvInt("TWO_FACTOR_CHALLENGE_EXPIRATION", 5), // 5 minutes default
+			RegistrationMode:             getEnv("REGISTRATION_MODE", "open"),
+			InvitationExpiration:         getEnvInt("INVITATION_EXPIRATION", 72),   // 3 days default
+			ImpersonationExpiration:      getEnvInt("IMPERSONATION_EXPIRATION", 5), // 5 minutes default
+		},
+		OIDC: OIDCConfig{
+			ProviderName:        getEnv("OIDC_PROVIDER_NAME", "oidc"),
+			IssuerURL:           getEnv("OIDC_ISSUER_URL", ""),
+			ClientID:            getEnv("OIDC_CLIENT_ID", ""),
+			ClientSecret:        getEnv("OIDC_CLIENT_SECRET", ""),
+			RedirectURL:         getEnv("OIDC_REDIRECT_URL", "http://localhost:8080/api/v1/users/oidc/callback"),
+			LoginRedirectURL:    getEnv("OIDC_LOGIN_REDIRECT_URL", "http://localhost:3000/login/oidc"),
+			Scopes:              getEnv("OIDC_SCOPES", "openid email profile"),
+			AutoProvision:       getEnvBool("OIDC_AUTO_PROVISION", true),
+			StateExpiration:     getEnvInt("OIDC_STATE_EXPIRATION", 10),      // 10 minutes default
+			LoginCodeExpiration: getEnvInt("OIDC_LOGIN_CODE_EXPIRATION", 60), // 1 minute default
 		},
 		Storage: StorageConfig{
 			BasePath: getEnv("STORAGE_BASE_PATH", "./storage"),
 			BaseURL:  getEnv("STORAGE_BASE_URL", "http://localhost:8080"),
 		},
+		Trash: TrashConfig{
+			RetentionDays: getEnvInt("TRASH_RETENTION_DAYS", 30),
+			PurgeInterval: getEnvInt("TRASH_PURGE_INTERVAL", 60), // 1 hour default
+		},
+		Article: ArticleConfig{
+			SchedulerInterval: getEnvInt("ARTICLE_SCHEDULER_INTERVAL", 30),
+			SearchBackend:     getEnv("ARTICLE_SEARCH_BACKEND", "mysql"),
+		},
+		Privacy: PrivacyConfig{
+			ExportPath:        getEnv("PRIVACY_EXPORT_PATH", "./exports"),
+			ExportExpiration:  getEnvInt("PRIVACY_EXPORT_EXPIRATION", 48), // 2 days default
+			ErasurePolicy:     getEnv("PRIVACY_ERASURE_POLICY", "delete"),
+			ErasureReassignTo: int64(getEnvInt("PRIVACY_ERASURE_REASSIGN_TO", 0)),
+			WorkerInterval:    getEnvInt("PRIVACY_WORKER_INTERVAL", 30),
+			StaleAfter:        getEnvInt("PRIVACY_STALE_AFTER", 60), // 1 hour default
+		},
+		Org: OrganizationConfig{
+			DefaultID: int64(getEnvInt("DEFAULT_ORGANIZATION_ID", 1)),
+		},
+		Password: PasswordConfig{
+			MinLength:            getEnvInt("PASSWORD_MIN_LENGTH", 8),
+			RequireUppercase:     getEnvBool("PASSWORD_REQUIRE_UPPERCASE", false),
+			RequireLowercase:     getEnvBool("PASSWORD_REQUIRE_LOWERCASE", false),
+			RequireDigit:         getEnvBool("PASSWORD_REQUIRE_DIGIT", false),
+			RequireSymbol:        getEnvBool("PASSWORD_REQUIRE_SYMBOL", false),
+			DisallowPersonalInfo: getEnvBool("PASSWORD_DISALLOW_PERSONAL_INFO", true),
+			History:              getEnvInt("PASSWORD_HISTORY", 5),
+			BreachedPath:         getEnv("BREACHED_PASSWORDS_PATH", ""),
+		},
 	}
 }
 
@@ -123,6 +282,17 @@ func getEnv(key, defaultValue string) string {
 	return defaultValue
 }
 
+// getEnvList gets an environment variable as a comma separated list or returns nil if it is not set
+func getEnvList(key string) []string {
+	var values []string
+	for _, value := range strings.Split(os.Getenv(key), ",") {
+		if value = strings.TrimSpace(value); value != "" {
+			values = append(values, value)
+		}
+	}
+	return values
+}
+
 // getEnvBool gets an environment variable as boolean or returns a default value
 func getEnvBool(key string, defaultValue bool) bool {
 	value := os.Getenv(key)
@@ -2,6 +2,7 @@ package article
 
 import (
 	"database/sql"
+	"fmt"
 	"time"
 
 	"github.com/redis/go-redis/v9"
@@ -14,20 +15,44 @@ import (
 
 // Container holds all article domain dependencies
 type Container struct {
-	Repo          domainarticle.Repository
-	Service       *domainarticle.Service
-	CreateUseCase *usecase.CreateArticleUseCase
-	GetUseCase    *usecase.GetArticleUseCase
-	ListUseCase   *usecase.ListArticlesUseCase
-	UpdateUseCase *usecase.UpdateArticleUseCase
-	DeleteUseCase *usecase.DeleteArticleUseCase
-	Handler       *httparticle.Handler
+	Repo               domainarticle.Repository
+	TagRepo            domainarticle.TagRepository
+	CategoryRepo       domainarticle.CategoryRepository
+	Service            *domainarticle.Service
+	CreateUseCase      *usecase.CreateArticleUseCase
+	GetUseCase         *usecase.GetArticleUseCase
+	GetBySlugUseCase   *usecase.GetArticleBySlugUseCase
+	ListUseCase        *usecase.ListArticlesUseCase
+	UpdateUseCase      *usecase.UpdateArticleUseCase
+	DeleteUseCase      *usecase.DeleteArticleUseCase
+	ListDeletedUseCase *usecase.ListDeletedArticlesUseCase
+	RestoreUseCase     *usecase.RestoreArticleUseCase
+	PurgeUseCase       *usecase.PurgeArticlesUseCase
+	DeleteByAuthorUC   *usecase.DeleteArticlesByAuthorUseCase
+	ReassignUC         *usecase.ReassignArticlesUseCase
+	TransitionUseCase  *usecase.TransitionArticleUseCase
+	ScheduleUseCase    *usecase.ScheduleArticleUseCase
+	PublishScheduledUC *usecase.PublishScheduledArticlesUseCase
+	SearchIndex        domainarticle.SearchIndex
+	SearchUseCase      *usecase.SearchArticlesUseCase
+	ReindexUC          *usecase.ReindexArticlesUseCase // Nil when the search index persists on its own
+	Handler            *httparticle.Handler
+	TrashHandler       *httparticle.TrashHandler
+	WorkflowHandler    *httparticle.WorkflowHandler
+	SlugHandler        *httparticle.SlugHandler
+	TagHandler         *httparticle.TagHandler
+	CategoryHandler    *httparticle.CategoryHandler
+	SearchHandler      *httparticle.SearchHandler
 }
 
-// NewContainer creates a new article domain container
-func NewContainer(database *sql.DB, redisClient *redis.Client) *Container {
+// NewContainer creates a new article domain container.
+// searchBackend is mysql for the FULLTEXT indexes of the articles table or memory for an in-process index.
+// The in-process index only sees the changes made by its own instance, it is refused when replicas is above 1.
+func NewContainer(database *sql.DB, redisClient *redis.Client, searchBackend string, replicas int) (*Container, error) {
 	// Initialize repository (driven adapter)
 	articleRepo := articledb.NewMySQLRepository(database)
+	tagRepo := articledb.NewMySQLTagRepository(database)
+	categoryRepo := articledb.NewMySQLCategoryRepository(database)
 
 	// Initialize cache (driven adapter)
 	var domainCache domainarticle.Cache
@@ -38,15 +63,54 @@ func NewContainer(database *sql.DB, redisClient *redis.Client) *Container {
 		dtoCache = dtoCacheAdapter
 	}
 
+	// Initialize search index (driven adapter), the in-process one is filled by ReindexUC at startup
+	var searchIndex domainarticle.SearchIndex
+	switch searchBackend {
+	case "mysql":
+		searchIndex = articledb.NewMySQLSearchIndex(database)
+	case "memory":
+		// Scheduled articles are indexed by the replica that publishes them only
+		if replicas > 1 {
+			return nil, fmt.Errorf("article search backend memory is single-instance only, use mysql with %d replicas", replicas)
+		}
+		searchIndex = articledb.NewMemorySearchIndex()
+	default:
+		return nil, fmt.Errorf("unknown article search backend %q, expected mysql or memory", searchBackend)
+	}
+
 	// Initialize domain service
 	articleService := domainarticle.NewService(articleRepo)
 
 	// Initialize use cases (application layer)
-	createArticleUseCase := usecase.NewCreateArticleUseCase(articleRepo, articleService, domainCache)
+	createArticleUseCase := usecase.NewCreateArticleUseCase(articleRepo, articleService, domainCache, categoryRepo, searchIndex)
 	getArticleUseCase := usecase.NewGetArticleUseCase(articleRepo, domainCache)
-	listArticlesUseCase := usecase.NewListArticlesUseCase(articleRepo, domainCache, dtoCache)
-	updateArticleUseCase := usecase.NewUpdateArticleUseCase(articleRepo, articleService, domainCache, dtoCache)
-	deleteArticleUseCase := usecase.NewDeleteArticleUseCase(articleRepo, domainCache, dtoCache)
+	getArticleBySlugUseCase := usecase.NewGetArticleBySlugUseCase(articleRepo, domainCache)
+	listArticlesUseCase := usecase.NewListArticlesUseCase(articleRepo, domainCache, dtoCache, tagRepo, categoryRepo)
+	updateArticleUseCase := usecase.NewUpdateArticleUseCase(articleRepo, articleService, domainCache, dtoCache, categoryRepo, searchIndex)
+	deleteArticleUseCase := usecase.NewDeleteArticleUseCase(articleRepo, domainCache, dtoCache, searchIndex)
+	listDeletedArticlesUseCase := usecase.NewListDeletedArticlesUseCase(articleRepo)
+	restoreArticleUseCase := usecase.NewRestoreArticleUseCase(articleRepo, domainCache, dtoCache, searchIndex)
+	purgeArticlesUseCase := usecase.NewPurgeArticlesUseCase(articleRepo)
+	deleteByAuthorUseCase := usecase.NewDeleteArticlesByAuthorUseCase(articleRepo, domainCache, dtoCache, searchIndex)
+	reassignArticlesUseCase := usecase.NewReassignArticlesUseCase(articleRepo, domainCache, dtoCache, searchIndex)
+	transitionArticleUseCase := usecase.NewTransitionArticleUseCase(articleRepo, articleService, domainCache, dtoCache, searchIndex)
+	scheduleArticleUseCase := usecase.NewScheduleArticleUseCase(articleRepo, articleService, domainCache, dtoCache)
+	publishScheduledUseCase := usecase.NewPublishScheduledArticlesUseCase(articleRepo, articleService, domainCache, searchIndex)
+	createTagUseCase := usecase.NewCreateTagUseCase(tagRepo)
+	updateTagUseCase := usecase.NewUpdateTagUseCase(tagRepo)
+	deleteTagUseCase := usecase.NewDeleteTagUseCase(tagRepo, dtoCache)
+	listTagsUseCase := usecase.NewListTagsUseCase(tagRepo)
+	getArticleTagsUseCase := usecase.NewGetArticleTagsUseCase(articleRepo, tagRepo)
+	setArticleTagsUseCase := usecase.NewSetArticleTagsUseCase(articleRepo, tagRepo, dtoCache)
+	createCategoryUseCase := usecase.NewCreateCategoryUseCase(categoryRepo)
+	updateCategoryUseCase := usecase.NewUpdateCategoryUseCase(categoryRepo, dtoCache)
+	deleteCategoryUseCase := usecase.NewDeleteCategoryUseCase(categoryRepo, articleRepo, domainCache, dtoCache)
+	listCategoriesUseCase := usecase.NewListCategoriesUseCase(categoryRepo)
+	searchArticlesUseCase := usecase.NewSearchArticlesUseCase(searchIndex)
+	var reindexUseCase *usecase.ReindexArticlesUseCase
+	if searchBackend == "memory" {
+		reindexUseCase = usecase.NewReindexArticlesUseCase(articleRepo, searchIndex)
+	}
 
 	// Initialize HTTP handler (driving adapter)
 	articleHandler := httparticle.NewHandler(
@@ -56,15 +120,53 @@ func NewContainer(database *sql.DB, redisClient *redis.Client) *Container {
 		updateArticleUseCase,
 		deleteArticleUseCase,
 	)
+	trashHandler := httparticle.NewTrashHandler(listDeletedArticlesUseCase, restoreArticleUseCase)
+	workflowHandler := httparticle.NewWorkflowHandler(transitionArticleUseCase, scheduleArticleUseCase)
+	slugHandler := httparticle.NewSlugHandler(getArticleBySlugUseCase)
+	tagHandler := httparticle.NewTagHandler(
+		createTagUseCase,
+		updateTagUseCase,
+		deleteTagUseCase,
+		listTagsUseCase,
+		getArticleTagsUseCase,
+		setArticleTagsUseCase,
+	)
+	categoryHandler := httparticle.NewCategoryHandler(
+		createCategoryUseCase,
+		updateCategoryUseCase,
+		deleteCategoryUseCase,
+		listCategoriesUseCase,
+	)
+	searchHandler := httparticle.NewSearchHandler(searchArticlesUseCase)
 
 	return &Container{
-		Repo:          articleRepo,
-		Service:       articleService,
-		CreateUseCase: createArticleUseCase,
-		GetUseCase:    getArticleUseCase,
-		ListUseCase:   listArticlesUseCase,
-		UpdateUseCase: updateArticleUseCase,
-		DeleteUseCase: deleteArticleUseCase,
-		Handler:       articleHandler,
-	}
+		Repo:               articleRepo,
+		TagRepo:            tagRepo,
+		CategoryRepo:       categoryRepo,
+		Service:            articleService,
+		CreateUseCase:      createArticleUseCase,
+		GetUseCase:         getArticleUseCase,
+		GetBySlugUseCase:   getArticleBySlugUseCase,
+		ListUseCase:        listArticlesUseCase,
+		UpdateUseCase:      updateArticleUseCase,
+		DeleteUseCase:      deleteArticleUseCase,
+		ListDeletedUseCase: listDeletedArticlesUseCase,
+		RestoreUseCase:     restoreArticleUseCase,
+		PurgeUseCase:       purgeArticlesUseCase,
+		DeleteByAuthorUC:   deleteByAuthorUseCase,
+		ReassignUC:         reassignArticlesUseCase,
+		TransitionUseCase:  transitionArticleUseCase,
+		ScheduleUseCase:    scheduleArticleUseCase,
+		PublishScheduledUC: publishScheduledUseCase,
+		SearchIndex:        searchIndex,
+		SearchUseCase:      searchArticlesUseCase,
+		ReindexUC:          reindexUseCase,
+		Handler:            articleHandler,
+		TrashHandler:       trashHandler,
+		WorkflowHandler:    workflowHandler,
+		SlugHandler:        slugHandler,
+		TagHandler:         tagHandler,
+		CategoryHandler:    categoryHandler,
+		SearchHandler:      searchHandler,
+	}, nil
 }
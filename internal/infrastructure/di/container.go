@@ -2,11 +2,15 @@ package di
 
 import (
 	"database/sql"
+	"time"
 
 	"github.com/redis/go-redis/v9"
 	"github.com/rulzi/hexa-go/internal/adapters/http"
+	"github.com/rulzi/hexa-go/internal/adapters/job"
+	"github.com/rulzi/hexa-go/internal/infrastructure/config"
 	diarticle "github.com/rulzi/hexa-go/internal/infrastructure/di/article"
 	dimedia "github.com/rulzi/hexa-go/internal/infrastructure/di/media"
+	diprivacy "github.com/rulzi/hexa-go/internal/infrastructure/di/privacy"
 	diuser "github.com/rulzi/hexa-go/internal/infrastructure/di/user"
 )
 
@@ -17,28 +21,109 @@ type Container struct {
 	User    *diuser.Container
 	Article *diarticle.Container
 	Media   *dimedia.Container
+	Privacy *diprivacy.Container
 	Router  *http.Router
+
+	// TrashPurge is nil when deleted items are kept forever
+	TrashPurge *job.TrashPurgeJob
+
+	// DataRequests carries out export and erasure requests in the background
+	DataRequests *job.DataRequestJob
+
+	// ScheduledPublish is nil when scheduled publishing is disabled
+	ScheduledPublish *job.ScheduledPublishJob
 }
 
 // NewContainer creates a new dependency injection container
-func NewContainer(database *sql.DB, redisClient *redis.Client, jwtSecret string, jwtExpiration int, storageBasePath string, storageBaseURL string) (*Container, error) {
+func NewContainer(database *sql.DB, redisClient *redis.Client, cfg *config.Config) (*Container, error) {
 	// Initialize domain containers
-	userContainer := diuser.NewContainer(database, jwtSecret, jwtExpiration)
-	articleContainer := diarticle.NewContainer(database, redisClient)
-	mediaContainer, err := dimedia.NewContainer(database, storageBasePath, storageBaseURL)
+	userContainer, err := diuser.NewContainer(database, redisClient, cfg)
+	if err != nil {
+		return nil, err
+	}
+	articleContainer, err := diarticle.NewContainer(database, redisClient, cfg.Article.SearchBackend, cfg.Server.Replicas)
+	if err != nil {
+		return nil, err
+	}
+	mediaContainer, err := dimedia.NewContainer(database, cfg.Storage.BasePath, cfg.Storage.BaseURL)
+	if err != nil {
+		return nil, err
+	}
+	privacyContainer, err := diprivacy.NewContainer(database, cfg, userContainer, articleContainer, mediaContainer)
 	if err != nil {
 		return nil, err
 	}
 
 	// Initialize router
-	router := http.NewRouter(userContainer.Handler, articleContainer.Handler, mediaContainer.Handler, userContainer.TokenValidator, storageBasePath)
+	router := http.NewRouter(
+		userContainer.Handler,
+		userContainer.TokenHandler,
+		userContainer.PasswordHandler,
+		userContainer.VerificationHandler,
+		userContainer.ActivityHandler,
+		userContainer.TwoFactorHandler,
+		userContainer.JWKSHandler,
+		userContainer.APIKeyHandler,
+		userContainer.IdentityHandler,
+		userContainer.ProfileHandler,
+		userContainer.SessionHandler,
+		userContainer.OrganizationHandler,
+		userContainer.InvitationHandler,
+		userContainer.ImpersonateHandler,
+		userContainer.TrashHandler,
+		articleContainer.Handler,
+		articleContainer.TrashHandler,
+		articleContainer.WorkflowHandler,
+		articleContainer.SlugHandler,
+		articleContainer.SearchHandler,
+		articleContainer.TagHandler,
+		articleContainer.CategoryHandler,
+		mediaContainer.Handler,
+		mediaContainer.TrashHandler,
+		mediaContainer.FileHandler,
+		privacyContainer.Handler,
+		userContainer.TokenValidator,
+		userContainer.TokenRevocations,
+		userContainer.AuthenticateAPIKey,
+		userContainer.VerificationPolicy,
+		userContainer.RegistrationMode,
+		cfg.Server.TrustedProxies,
+	)
+
+	// Initialize trash purge job, articles and media go before the users owning them.
+	// Users who still author articles are skipped rather than purged together with their articles.
+	var trashPurge *job.TrashPurgeJob
+	if cfg.Trash.RetentionDays > 0 && cfg.Trash.PurgeInterval > 0 {
+		trashPurge = job.NewTrashPurgeJob(
+			[]job.TrashPurgeStep{
+				{Name: "articles", Purger: articleContainer.PurgeUseCase},
+				{Name: "media", Purger: mediaContainer.PurgeUseCase},
+				{Name: "users", Purger: userContainer.PurgeUC},
+			},
+			time.Duration(cfg.Trash.RetentionDays)*24*time.Hour,
+			time.Duration(cfg.Trash.PurgeInterval)*time.Minute,
+		)
+	}
+
+	// Initialize scheduled publishing, every replica runs it and each article is published once
+	var scheduledPublish *job.ScheduledPublishJob
+	if cfg.Article.SchedulerInterval > 0 {
+		scheduledPublish = job.NewScheduledPublishJob(
+			articleContainer.PublishScheduledUC,
+			time.Duration(cfg.Article.SchedulerInterval)*time.Second,
+		)
+	}
 
 	return &Container{
-		DB:      database,
-		Redis:   redisClient,
-		User:    userContainer,
-		Article: articleContainer,
-		Media:   mediaContainer,
-		Router:  router,
+		DB:               database,
+		Redis:            redisClient,
+		User:             userContainer,
+		Article:          articleContainer,
+		Media:            mediaContainer,
+		Privacy:          privacyContainer,
+		Router:           router,
+		TrashPurge:       trashPurge,
+		DataRequests:     privacyContainer.Job,
+		ScheduledPublish: scheduledPublish,
 	}, nil
 }
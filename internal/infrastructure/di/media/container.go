@@ -12,15 +12,22 @@ import (
 
 // Container holds all media domain dependencies
 type Container struct {
-	Repo          domainmedia.Repository
-	Storage       domainmedia.Storage
-	Service       *domainmedia.Service
-	CreateUseCase *usecase.CreateMediaUseCase
-	GetUseCase    *usecase.GetMediaUseCase
-	ListUseCase   *usecase.ListMediaUseCase
-	UpdateUseCase *usecase.UpdateMediaUseCase
-	DeleteUseCase *usecase.DeleteMediaUseCase
-	Handler       *httpmedia.Handler
+	Repo               domainmedia.Repository
+	Storage            domainmedia.Storage
+	Service            *domainmedia.Service
+	CreateUseCase      *usecase.CreateMediaUseCase
+	GetUseCase         *usecase.GetMediaUseCase
+	ListUseCase        *usecase.ListMediaUseCase
+	UpdateUseCase      *usecase.UpdateMediaUseCase
+	DeleteUseCase      *usecase.DeleteMediaUseCase
+	ListDeletedUseCase *usecase.ListDeletedMediaUseCase
+	RestoreUseCase     *usecase.RestoreMediaUseCase
+	PurgeUseCase       *usecase.PurgeMediaUseCase
+	DeleteByOwnerUC    *usecase.DeleteMediaByOwnerUseCase
+	ReassignUC         *usecase.ReassignMediaUseCase
+	Handler            *httpmedia.Handler
+	TrashHandler       *httpmedia.TrashHandler
+	FileHandler        *httpmedia.FileHandler
 }
 
 // NewContainer creates a new media domain container
@@ -42,7 +49,13 @@ func NewContainer(database *sql.DB, storageBasePath string, baseURL string) (*Co
 	getMediaUseCase := usecase.NewGetMediaUseCase(mediaRepo, baseURL)
 	listMediaUseCase := usecase.NewListMediaUseCase(mediaRepo, baseURL)
 	updateMediaUseCase := usecase.NewUpdateMediaUseCase(mediaRepo, mediaService, storage, baseURL)
-	deleteMediaUseCase := usecase.NewDeleteMediaUseCase(mediaRepo, storage)
+	deleteMediaUseCase := usecase.NewDeleteMediaUseCase(mediaRepo)
+	listDeletedMediaUseCase := usecase.NewListDeletedMediaUseCase(mediaRepo, baseURL)
+	restoreMediaUseCase := usecase.NewRestoreMediaUseCase(mediaRepo, baseURL)
+	purgeMediaUseCase := usecase.NewPurgeMediaUseCase(mediaRepo, storage)
+	deleteByOwnerUseCase := usecase.NewDeleteMediaByOwnerUseCase(mediaRepo, storage)
+	reassignMediaUseCase := usecase.NewReassignMediaUseCase(mediaRepo)
+	openFileUseCase := usecase.NewOpenMediaFileUseCase(mediaRepo, storage)
 
 	// Initialize HTTP handler (driving adapter)
 	mediaHandler := httpmedia.NewHandler(
@@ -52,16 +65,25 @@ func NewContainer(database *sql.DB, storageBasePath string, baseURL string) (*Co
 		updateMediaUseCase,
 		deleteMediaUseCase,
 	)
+	trashHandler := httpmedia.NewTrashHandler(listDeletedMediaUseCase, restoreMediaUseCase)
+	fileHandler := httpmedia.NewFileHandler(openFileUseCase)
 
 	return &Container{
-		Repo:          mediaRepo,
-		Storage:       storage,
-		Service:       mediaService,
-		CreateUseCase: createMediaUseCase,
-		GetUseCase:    getMediaUseCase,
-		ListUseCase:   listMediaUseCase,
-		UpdateUseCase: updateMediaUseCase,
-		DeleteUseCase: deleteMediaUseCase,
-		Handler:       mediaHandler,
+		Repo:               mediaRepo,
+		Storage:            storage,
+		Service:            mediaService,
+		CreateUseCase:      createMediaUseCase,
+		GetUseCase:         getMediaUseCase,
+		ListUseCase:        listMediaUseCase,
+		UpdateUseCase:      updateMediaUseCase,
+		DeleteUseCase:      deleteMediaUseCase,
+		ListDeletedUseCase: listDeletedMediaUseCase,
+		RestoreUseCase:     restoreMediaUseCase,
+		PurgeUseCase:       purgeMediaUseCase,
+		DeleteByOwnerUC:    deleteByOwnerUseCase,
+		ReassignUC:         reassignMediaUseCase,
+		Handler:            mediaHandler,
+		TrashHandler:       trashHandler,
+		FileHandler:        fileHandler,
 	}, nil
 }
@@ -0,0 +1,90 @@
+package privacy
+
+import (
+	"database/sql"
+	"time"
+
+	httpprivacy "github.com/rulzi/hexa-go/internal/adapters/http/privacy"
+	"github.com/rulzi/hexa-go/internal/adapters/job"
+	privacydb "github.com/rulzi/hexa-go/internal/adapters/repository/privacy"
+	mediastorage "github.com/rulzi/hexa-go/internal/adapters/storage/media"
+	"github.com/rulzi/hexa-go/internal/application/privacy/usecase"
+	domainprivacy "github.com/rulzi/hexa-go/internal/domain/privacy"
+	"github.com/rulzi/hexa-go/internal/infrastructure/config"
+	diarticle "github.com/rulzi/hexa-go/internal/infrastructure/di/article"
+	dimedia "github.com/rulzi/hexa-go/internal/infrastructure/di/media"
+	diuser "github.com/rulzi/hexa-go/internal/infrastructure/di/user"
+)
+
+// Container holds all data export and erasure dependencies
+type Container struct {
+	Repo            domainprivacy.Repository
+	Archives        domainprivacy.ArchiveStore
+	ExportUseCase   *usecase.RequestExportUseCase
+	ErasureUseCase  *usecase.RequestErasureUseCase
+	GetUseCase      *usecase.GetDataRequestUseCase
+	DownloadUseCase *usecase.DownloadExportUseCase
+	ProcessUseCase  *usecase.ProcessDataRequestsUseCase
+	Handler         *httpprivacy.Handler
+	Job             *job.DataRequestJob
+}
+
+// NewContainer creates a new privacy container on top of the user, article and media containers
+func NewContainer(
+	database *sql.DB,
+	cfg *config.Config,
+	users *diuser.Container,
+	articles *diarticle.Container,
+	media *dimedia.Container,
+) (*Container, error) {
+	policy, err := domainprivacy.ParseErasurePolicy(cfg.Privacy.ErasurePolicy)
+	if err != nil {
+		return nil, err
+	}
+
+	// Initialize repository and archive store (driven adapters)
+	requestRepo := privacydb.NewMySQLRepository(database)
+	archives, err := mediastorage.NewLocalStorage(cfg.Privacy.ExportPath)
+	if err != nil {
+		return nil, err
+	}
+
+	// Initialize use cases (application layer)
+	exportUseCase := usecase.NewRequestExportUseCase(requestRepo, users.Repo, users.OrganizationRepo)
+	erasureUseCase := usecase.NewRequestErasureUseCase(requestRepo, users.Repo, users.OrganizationRepo, policy, cfg.Privacy.ErasureReassignTo)
+	getUseCase := usecase.NewGetDataRequestUseCase(requestRepo)
+	downloadUseCase := usecase.NewDownloadExportUseCase(requestRepo, archives)
+	exporter := usecase.NewExportUserDataUseCase(users.Repo, articles.Repo, media.Repo, media.Storage, archives)
+	eraser := usecase.NewEraseUserDataUseCase(
+		users.AnonymizeUC,
+		users.OrganizationRepo,
+		[]usecase.ContentDeleter{articles.DeleteByAuthorUC, media.DeleteByOwnerUC},
+		[]usecase.ContentReassigner{articles.ReassignUC, media.ReassignUC},
+		policy,
+		cfg.Privacy.ErasureReassignTo,
+	)
+	processUseCase := usecase.NewProcessDataRequestsUseCase(
+		requestRepo,
+		exporter,
+		eraser,
+		archives,
+		time.Duration(cfg.Privacy.ExportExpiration)*time.Hour,
+		time.Duration(cfg.Privacy.StaleAfter)*time.Minute,
+	)
+
+	// Initialize HTTP handler (driving adapter) and background worker
+	handler := httpprivacy.NewHandler(exportUseCase, erasureUseCase, getUseCase, downloadUseCase)
+	worker := job.NewDataRequestJob(processUseCase, time.Duration(cfg.Privacy.WorkerInterval)*time.Second)
+
+	return &Container{
+		Repo:            requestRepo,
+		Archives:        archives,
+		ExportUseCase:   exportUseCase,
+		ErasureUseCase:  erasureUseCase,
+		GetUseCase:      getUseCase,
+		DownloadUseCase: downloadUseCase,
+		ProcessUseCase:  processUseCase,
+		Handler:         handler,
+		Job:             worker,
+	}, nil
+}
@@ -2,56 +2,345 @@ package user
 
 import (
 	"database/sql"
+	"fmt"
+	"strings"
+	"time"
 
+	"github.com/redis/go-redis/v9"
 	authadapter "github.com/rulzi/hexa-go/internal/adapters/auth"
+	usercache "github.com/rulzi/hexa-go/internal/adapters/cache/user"
 	userdb "github.com/rulzi/hexa-go/internal/adapters/repository/user"
 	userexternal "github.com/rulzi/hexa-go/internal/adapters/external/user"
 	httpuser "github.com/rulzi/hexa-go/internal/adapters/http/user"
 	"github.com/rulzi/hexa-go/internal/application/user/usecase"
 	domainuser "github.com/rulzi/hexa-go/internal/domain/user"
+	"github.com/rulzi/hexa-go/internal/infrastructure/config"
 )
 
 // Container holds all user domain dependencies
 type Container struct {
-	Repo              domainuser.Repository
-	Service           *domainuser.Service
-	TokenGen          domainuser.TokenGenerator
-	TokenValidator    domainuser.TokenValidator
-	PasswordHasher    domainuser.PasswordHasher
+	Repo                domainuser.Repository
+	RefreshTokenRepo    domainuser.RefreshTokenRepository
+	PasswordResetRepo   domainuser.PasswordResetRepository
+	LoginAttemptRepo    domainuser.LoginAttemptRepository
+	TwoFactorRepo       domainuser.TwoFactorRepository
+	APIKeyRepo          domainuser.APIKeyRepository
+	IdentityRepo        domainuser.IdentityRepository
+	SessionRepo         domainuser.SessionRepository
+	OrganizationRepo    domainuser.OrganizationRepository
+	InvitationRepo      domainuser.InvitationRepository
+	Service             *domainuser.Service
+	TokenGen            domainuser.TokenGenerator
+	TokenValidator      domainuser.TokenValidator
+	PublicKeys          domainuser.PublicKeyProvider
+	TokenRevocations    domainuser.TokenRevocationStore
+	LoginAttempts       domainuser.LoginAttemptCounter
+	TwoFactorChallenges domainuser.TwoFactorChallengeStore
+	AuthorizationStates domainuser.AuthorizationStateStore
+	LoginCodes          domainuser.LoginCodeStore
+	IdentityProvider    domainuser.IdentityProvider
+	PasswordHasher      domainuser.PasswordHasher
 	NotificationService domainuser.NotificationService
-	CreateUseCase     *usecase.CreateUserUseCase
-	GetUseCase        *usecase.GetUserUseCase
-	ListUseCase       *usecase.ListUsersUseCase
-	UpdateUseCase     *usecase.UpdateUserUseCase
-	DeleteUseCase     *usecase.DeleteUserUseCase
-	LoginUseCase      *usecase.LoginUseCase
-	Handler           *httpuser.Handler
+	TokenIssuer         *usecase.TokenIssuer
+	CreateUseCase       *usecase.CreateUserUseCase
+	GetUseCase          *usecase.GetUserUseCase
+	ListUseCase         *usecase.ListUsersUseCase
+	UpdateUseCase       *usecase.UpdateUserUseCase
+	DeleteUseCase       *usecase.DeleteUserUseCase
+	DeleteAccountUC     *usecase.DeleteAccountUseCase
+	LoginUseCase        *usecase.LoginUseCase
+	RefreshUseCase      *usecase.RefreshTokenUseCase
+	LogoutUseCase       *usecase.LogoutUseCase
+	ForgotPasswordUC    *usecase.ForgotPasswordUseCase
+	ResetPasswordUC     *usecase.ResetPasswordUseCase
+	VerifyEmailUC       *usecase.VerifyEmailUseCase
+	ResendVerifyUC      *usecase.ResendVerificationUseCase
+	ListLoginAttemptsUC *usecase.ListLoginAttemptsUseCase
+	EnrollTwoFactorUC   *usecase.EnrollTwoFactorUseCase
+	ConfirmTwoFactorUC  *usecase.ConfirmTwoFactorUseCase
+	RecoveryCodesUC     *usecase.RegenerateRecoveryCodesUseCase
+	DisableTwoFactorUC  *usecase.DisableTwoFactorUseCase
+	LoginTwoFactorUC    *usecase.LoginTwoFactorUseCase
+	CreateAPIKeyUC      *usecase.CreateAPIKeyUseCase
+	ListAPIKeysUC       *usecase.ListAPIKeysUseCase
+	RevokeAPIKeyUC      *usecase.RevokeAPIKeyUseCase
+	AuthenticateAPIKey  *usecase.AuthenticateAPIKeyUseCase
+	StartIdentityUC     *usecase.StartIdentityLoginUseCase
+	CompleteIdentityUC  *usecase.CompleteIdentityLoginUseCase
+	RedeemIdentityUC    *usecase.RedeemIdentityLoginUseCase
+	UpdateProfileUC     *usecase.UpdateProfileUseCase
+	ChangePasswordUC    *usecase.ChangePasswordUseCase
+	ListSessionsUC      *usecase.ListSessionsUseCase
+	RevokeSessionUC     *usecase.RevokeSessionUseCase
+	RevokeAllSessionsUC *usecase.RevokeAllSessionsUseCase
+	ListDeletedUC       *usecase.ListDeletedUsersUseCase
+	RestoreUC           *usecase.RestoreUserUseCase
+	PurgeUC             *usecase.PurgeUsersUseCase
+	AnonymizeUC         *usecase.AnonymizeUserUseCase
+	CreateOrgUC         *usecase.CreateOrganizationUseCase
+	ListOrgsUC          *usecase.ListOrganizationsUseCase
+	SwitchOrgUC         *usecase.SwitchOrganizationUseCase
+	CreateInvitationUC  *usecase.CreateInvitationUseCase
+	AcceptInvitationUC  *usecase.AcceptInvitationUseCase
+	ImpersonateUC       *usecase.ImpersonateUserUseCase
+	VerificationPolicy  domainuser.EmailVerificationPolicy
+	RegistrationMode    domainuser.RegistrationMode
+	Handler             *httpuser.Handler
+	TokenHandler        *httpuser.TokenHandler
+	PasswordHandler     *httpuser.PasswordHandler
+	VerificationHandler *httpuser.VerificationHandler
+	ActivityHandler     *httpuser.LoginActivityHandler
+	TwoFactorHandler    *httpuser.TwoFactorHandler
+	JWKSHandler         *httpuser.JWKSHandler
+	APIKeyHandler       *httpuser.APIKeyHandler
+	IdentityHandler     *httpuser.IdentityHandler
+	ProfileHandler      *httpuser.ProfileHandler
+	SessionHandler      *httpuser.SessionHandler
+	TrashHandler        *httpuser.TrashHandler
+	OrganizationHandler *httpuser.OrganizationHandler
+	InvitationHandler   *httpuser.InvitationHandler
+	ImpersonateHandler  *httpuser.ImpersonationHandler
 }
 
 // NewContainer creates a new user domain container
-func NewContainer(database *sql.DB, jwtSecret string, jwtExpiration int) *Container {
-	// Initialize repository (driven adapter)
+func NewContainer(database *sql.DB, redisClient *redis.Client, cfg *config.Config) (*Container, error) {
+	// Initialize repositories (driven adapters)
 	userRepo := userdb.NewMySQLRepository(database)
+	refreshTokenRepo := userdb.NewMySQLRefreshTokenRepository(database)
+	passwordResetRepo := userdb.NewMySQLPasswordResetRepository(database)
+	loginAttemptRepo := userdb.NewMySQLLoginAttemptRepository(database)
+	twoFactorRepo := userdb.NewMySQLTwoFactorRepository(database)
+	apiKeyRepo := userdb.NewMySQLAPIKeyRepository(database)
+	identityRepo := userdb.NewMySQLIdentityRepository(database)
+	sessionRepo := userdb.NewMySQLSessionRepository(database)
+	organizationRepo := userdb.NewMySQLOrganizationRepository(database)
+	invitationRepo := userdb.NewMySQLInvitationRepository(database)
+	passwordHistoryRepo := userdb.NewMySQLPasswordHistoryRepository(database)
 
 	// Initialize auth adapters (driven adapters)
-	jwtAdapter := authadapter.NewJWTAdapter(jwtSecret, jwtExpiration)
-	passwordHasher := authadapter.NewBcryptPasswordHasher()
+	// Asymmetric keys are used when a key directory is configured, the shared secret otherwise
+	jwtKeys := authadapter.NewHMACKeySet(cfg.JWT.Secret)
+	if cfg.JWT.KeyDir != "" {
+		var err error
+		jwtKeys, err = authadapter.LoadKeySet(cfg.JWT.KeyDir, cfg.JWT.SigningKeyID)
+		if err != nil {
+			return nil, err
+		}
+	}
+	jwtAdapter := authadapter.NewJWTAdapter(
+		jwtKeys,
+		cfg.JWT.Issuer,
+		cfg.JWT.Audience,
+		time.Duration(cfg.JWT.AccessExpiration)*time.Minute,
+	)
+	// Impersonation tokens are signed with the same keys but expire sooner
+	if cfg.Auth.ImpersonationExpiration <= 0 || cfg.Auth.ImpersonationExpiration >= cfg.JWT.AccessExpiration {
+		return nil, fmt.Errorf("impersonation expiration of %d minutes must be positive and below the access token expiration of %d minutes",
+			cfg.Auth.ImpersonationExpiration, cfg.JWT.AccessExpiration)
+	}
+	impersonationTTL := time.Duration(cfg.Auth.ImpersonationExpiration) * time.Minute
+	impersonationTokens := authadapter.NewJWTAdapter(
+		jwtKeys,
+		cfg.JWT.Issuer,
+		cfg.JWT.Audience,
+		impersonationTTL,
+	)
+	// Existing bcrypt hashes are still accepted and upgraded to Argon2id on login
+	passwordHasher := authadapter.NewArgon2idPasswordHasher(authadapter.Argon2Params{
+		Memory:      uint32(cfg.Auth.Argon2Memory),
+		Iterations:  uint32(cfg.Auth.Argon2Iterations),
+		Parallelism: uint8(cfg.Auth.Argon2Parallelism),
+		SaltLength:  authadapter.DefaultArgon2Params.SaltLength,
+		KeyLength:   authadapter.DefaultArgon2Params.KeyLength,
+	})
+
+	// Passwords are checked against a local breach corpus when one is configured
+	var breachedPasswords domainuser.BreachedPasswordChecker
+	if cfg.Password.BreachedPath != "" {
+		breachedPasswords = authadapter.NewRangeFileBreachedPasswordChecker(cfg.Password.BreachedPath)
+	}
+	passwordPolicy := domainuser.PasswordPolicy{
+		MinLength:            cfg.Password.MinLength,
+		RequireUppercase:     cfg.Password.RequireUppercase,
+		RequireLowercase:     cfg.Password.RequireLowercase,
+		RequireDigit:         cfg.Password.RequireDigit,
+		RequireSymbol:        cfg.Password.RequireSymbol,
+		DisallowPersonalInfo: cfg.Password.DisallowPersonalInfo,
+		HistorySize:          cfg.Password.History,
+	}
+
+	// Verification links are signed with their own secret when one is configured
+	verificationSecret := cfg.Auth.EmailVerificationSecret
+	if verificationSecret == "" {
+		verificationSecret = cfg.JWT.Secret
+	}
+	verificationSigner := authadapter.NewHMACVerificationSigner(verificationSecret)
+	verificationPolicy := domainuser.EmailVerificationPolicy(cfg.Auth.EmailVerificationPolicy)
+	registrationMode := domainuser.RegistrationMode(cfg.Auth.RegistrationMode)
+	totpAdapter := authadapter.NewTOTPAdapter(cfg.Auth.TOTPIssuer)
+
+	// Initialize token revocation store, login attempt counter and two-factor challenges, in memory when Redis is absent
+	var tokenRevocations domainuser.TokenRevocationStore
+	var loginAttempts domainuser.LoginAttemptCounter
+	var twoFactorChallenges domainuser.TwoFactorChallengeStore
+	var authorizationStates domainuser.AuthorizationStateStore
+	var loginCodes domainuser.LoginCodeStore
+	if redisClient != nil {
+		tokenRevocations = usercache.NewRedisTokenRevocationStore(redisClient)
+		loginAttempts = usercache.NewRedisLoginAttemptCounter(redisClient)
+		twoFactorChallenges = usercache.NewRedisTwoFactorChallengeStore(redisClient)
+		authorizationStates = usercache.NewRedisAuthorizationStateStore(redisClient)
+		loginCodes = usercache.NewRedisLoginCodeStore(redisClient)
+	} else {
+		tokenRevocations = usercache.NewMemoryTokenRevocationStore()
+		loginAttempts = usercache.NewMemoryLoginAttemptCounter()
+		twoFactorChallenges = usercache.NewMemoryTwoFactorChallengeStore()
+		authorizationStates = usercache.NewMemoryAuthorizationStateStore()
+		loginCodes = usercache.NewMemoryLoginCodeStore()
+	}
+
+	// Initialize identity provider, sign-in with it is only offered when an issuer is configured
+	var identityProvider domainuser.IdentityProvider
+	if cfg.OIDC.IssuerURL != "" {
+		identityProvider = authadapter.NewOIDCProvider(authadapter.OIDCConfig{
+			Name:         cfg.OIDC.ProviderName,
+			IssuerURL:    cfg.OIDC.IssuerURL,
+			ClientID:     cfg.OIDC.ClientID,
+			ClientSecret: cfg.OIDC.ClientSecret,
+			RedirectURL:  cfg.OIDC.RedirectURL,
+			Scopes:       strings.Fields(cfg.OIDC.Scopes),
+		}, nil)
+	}
 
 	// Initialize domain service
 	userService := domainuser.NewService(userRepo, jwtAdapter, jwtAdapter, passwordHasher)
 
 	// Initialize external service adapter
-	notificationService := userexternal.NewEmailSenderImpl()
+	notificationService := userexternal.NewEmailSenderImpl(cfg.Server.BaseURL)
 
 	// Initialize use cases (application layer)
-	createUseCase := usecase.NewCreateUserUseCase(userRepo, passwordHasher, notificationService)
-	getUseCase := usecase.NewGetUserUseCase(userRepo)
+	tokenIssuer := usecase.NewTokenIssuer(jwtAdapter, refreshTokenRepo, sessionRepo, organizationRepo, time.Duration(cfg.JWT.RefreshExpiration)*time.Hour)
+	verificationSender := usecase.NewVerificationSender(
+		verificationSigner,
+		notificationService,
+		time.Duration(cfg.Auth.EmailVerificationExpiration)*time.Hour,
+	)
+	passwordChecker := usecase.NewPasswordChecker(passwordPolicy, passwordHasher, passwordHistoryRepo, breachedPasswords)
+	createUseCase := usecase.NewCreateUserUseCase(
+		userRepo,
+		passwordHasher,
+		notificationService,
+		verificationSender,
+		organizationRepo,
+		cfg.Org.DefaultID,
+		passwordChecker,
+	)
+	accessTTL := time.Duration(cfg.JWT.AccessExpiration) * time.Minute
+	revokeAllSessionsUseCase := usecase.NewRevokeAllSessionsUseCase(sessionRepo, refreshTokenRepo, tokenRevocations, accessTTL)
+	getUseCase := usecase.NewGetUserUseCase(userRepo, organizationRepo)
 	listUseCase := usecase.NewListUsersUseCase(userRepo)
-	updateUseCase := usecase.NewUpdateUserUseCase(userRepo, passwordHasher)
-	deleteUseCase := usecase.NewDeleteUserUseCase(userRepo)
-	loginUseCase := usecase.NewLoginUseCase(userRepo, passwordHasher, jwtAdapter)
+	updateUseCase := usecase.NewUpdateUserUseCase(userRepo, organizationRepo, passwordHasher, passwordChecker)
+	deleteUseCase := usecase.NewDeleteUserUseCase(userRepo, organizationRepo, revokeAllSessionsUseCase)
+	deleteAccountUseCase := usecase.NewDeleteAccountUseCase(userRepo, revokeAllSessionsUseCase)
+	updateProfileUseCase := usecase.NewUpdateProfileUseCase(userRepo, organizationRepo, verificationSender)
+	changePasswordUseCase := usecase.NewChangePasswordUseCase(userRepo, passwordHasher, revokeAllSessionsUseCase, passwordChecker)
+	accountLockout := domainuser.LockoutPolicy{
+		MaxAttempts: cfg.Auth.LoginMaxAttempts,
+		BaseLockout: time.Duration(cfg.Auth.LoginLockoutBase) * time.Second,
+		MaxLockout:  time.Duration(cfg.Auth.LoginLockoutMax) * time.Minute,
+		Window:      time.Duration(cfg.Auth.LoginAttemptWindow) * time.Minute,
+	}
+	ipLockout := accountLockout
+	ipLockout.MaxAttempts = cfg.Auth.LoginMaxAttemptsPerIP
+	loginThrottler := usecase.NewLoginThrottler(loginAttempts, accountLockout, ipLockout)
+	twoFactorChallenger := usecase.NewTwoFactorChallenger(
+		twoFactorRepo,
+		twoFactorChallenges,
+		time.Duration(cfg.Auth.TwoFactorChallengeExpiration)*time.Minute,
+	)
+	loginUseCase := usecase.NewLoginUseCase(userRepo, passwordHasher, tokenIssuer, verificationPolicy, loginThrottler, loginAttemptRepo, twoFactorChallenger)
+	listLoginAttemptsUseCase := usecase.NewListLoginAttemptsUseCase(userRepo, organizationRepo, loginAttemptRepo)
+	enrollTwoFactorUseCase := usecase.NewEnrollTwoFactorUseCase(userRepo, twoFactorRepo, totpAdapter)
+	confirmTwoFactorUseCase := usecase.NewConfirmTwoFactorUseCase(twoFactorRepo, totpAdapter, loginThrottler)
+	recoveryCodesUseCase := usecase.NewRegenerateRecoveryCodesUseCase(twoFactorRepo, totpAdapter, loginThrottler)
+	disableTwoFactorUseCase := usecase.NewDisableTwoFactorUseCase(twoFactorRepo, totpAdapter, loginThrottler)
+	loginTwoFactorUseCase := usecase.NewLoginTwoFactorUseCase(
+		userRepo,
+		twoFactorRepo,
+		twoFactorChallenges,
+		totpAdapter,
+		tokenIssuer,
+		loginThrottler,
+		loginAttemptRepo,
+	)
+	createAPIKeyUseCase := usecase.NewCreateAPIKeyUseCase(userRepo, organizationRepo, apiKeyRepo)
+	listAPIKeysUseCase := usecase.NewListAPIKeysUseCase(apiKeyRepo)
+	revokeAPIKeyUseCase := usecase.NewRevokeAPIKeyUseCase(apiKeyRepo)
+	authenticateAPIKeyUseCase := usecase.NewAuthenticateAPIKeyUseCase(userRepo, organizationRepo, apiKeyRepo)
+	var startIdentityUseCase *usecase.StartIdentityLoginUseCase
+	var completeIdentityUseCase *usecase.CompleteIdentityLoginUseCase
+	var redeemIdentityUseCase *usecase.RedeemIdentityLoginUseCase
+	if identityProvider != nil {
+		startIdentityUseCase = usecase.NewStartIdentityLoginUseCase(
+			identityProvider,
+			authorizationStates,
+			time.Duration(cfg.OIDC.StateExpiration)*time.Minute,
+		)
+		completeIdentityUseCase = usecase.NewCompleteIdentityLoginUseCase(
+			userRepo,
+			identityRepo,
+			identityProvider,
+			authorizationStates,
+			loginCodes,
+			time.Duration(cfg.OIDC.LoginCodeExpiration)*time.Second,
+			passwordHasher,
+			verificationPolicy,
+			organizationRepo,
+			cfg.Org.DefaultID,
+			cfg.OIDC.AutoProvision,
+		)
+		redeemIdentityUseCase = usecase.NewRedeemIdentityLoginUseCase(userRepo, loginCodes, tokenIssuer, loginAttemptRepo)
+	}
+	refreshUseCase := usecase.NewRefreshTokenUseCase(userRepo, refreshTokenRepo, tokenIssuer)
+	logoutUseCase := usecase.NewLogoutUseCase(refreshTokenRepo, tokenRevocations, sessionRepo)
+	listSessionsUseCase := usecase.NewListSessionsUseCase(sessionRepo)
+	revokeSessionUseCase := usecase.NewRevokeSessionUseCase(sessionRepo, refreshTokenRepo, tokenRevocations, accessTTL)
+	forgotPasswordUseCase := usecase.NewForgotPasswordUseCase(
+		userRepo,
+		passwordResetRepo,
+		notificationService,
+		time.Duration(cfg.Auth.PasswordResetExpiration)*time.Minute,
+	)
+	resetPasswordUseCase := usecase.NewResetPasswordUseCase(userRepo, passwordResetRepo, revokeAllSessionsUseCase, passwordHasher, passwordChecker)
+	verifyEmailUseCase := usecase.NewVerifyEmailUseCase(userRepo, verificationSigner)
+	resendVerificationUseCase := usecase.NewResendVerificationUseCase(userRepo, verificationSender)
+	listDeletedUseCase := usecase.NewListDeletedUsersUseCase(userRepo)
+	restoreUseCase := usecase.NewRestoreUserUseCase(userRepo, organizationRepo)
+	purgeUseCase := usecase.NewPurgeUsersUseCase(userRepo, revokeAllSessionsUseCase)
+	createOrganizationUseCase := usecase.NewCreateOrganizationUseCase(organizationRepo)
+	listOrganizationsUseCase := usecase.NewListOrganizationsUseCase(organizationRepo)
+	switchOrganizationUseCase := usecase.NewSwitchOrganizationUseCase(userRepo, tokenIssuer)
+	createInvitationUseCase := usecase.NewCreateInvitationUseCase(
+		userRepo,
+		organizationRepo,
+		invitationRepo,
+		notificationService,
+		registrationMode,
+		time.Duration(cfg.Auth.InvitationExpiration)*time.Hour,
+	)
+	acceptInvitationUseCase := usecase.NewAcceptInvitationUseCase(invitationRepo, userRepo, organizationRepo, createUseCase, registrationMode)
+	impersonateUseCase := usecase.NewImpersonateUserUseCase(userRepo, organizationRepo, impersonationTokens, sessionRepo, impersonationTTL)
+	anonymizeUseCase := usecase.NewAnonymizeUserUseCase(
+		userRepo,
+		twoFactorRepo,
+		apiKeyRepo,
+		identityRepo,
+		loginAttemptRepo,
+		passwordHistoryRepo,
+		revokeAllSessionsUseCase,
+	)
 
-	// Initialize HTTP handler (driving adapter)
+	// Initialize HTTP handlers (driving adapters)
 	userHandler := httpuser.NewHandler(
 		createUseCase,
 		getUseCase,
@@ -60,20 +349,123 @@ func NewContainer(database *sql.DB, jwtSecret string, jwtExpiration int) *Contai
 		deleteUseCase,
 		loginUseCase,
 	)
+	tokenHandler := httpuser.NewTokenHandler(refreshUseCase, logoutUseCase)
+	passwordHandler := httpuser.NewPasswordHandler(forgotPasswordUseCase, resetPasswordUseCase)
+	verificationHandler := httpuser.NewVerificationHandler(verifyEmailUseCase, resendVerificationUseCase)
+	activityHandler := httpuser.NewLoginActivityHandler(listLoginAttemptsUseCase)
+	jwksHandler := httpuser.NewJWKSHandler(jwtKeys)
+	apiKeyHandler := httpuser.NewAPIKeyHandler(createAPIKeyUseCase, listAPIKeysUseCase, revokeAPIKeyUseCase)
+	profileHandler := httpuser.NewProfileHandler(getUseCase, updateProfileUseCase, deleteAccountUseCase, changePasswordUseCase)
+	sessionHandler := httpuser.NewSessionHandler(listSessionsUseCase, revokeSessionUseCase, revokeAllSessionsUseCase)
+	trashHandler := httpuser.NewTrashHandler(listDeletedUseCase, restoreUseCase)
+	organizationHandler := httpuser.NewOrganizationHandler(
+		createOrganizationUseCase,
+		listOrganizationsUseCase,
+		switchOrganizationUseCase,
+	)
+	invitationHandler := httpuser.NewInvitationHandler(createInvitationUseCase, acceptInvitationUseCase)
+	impersonationHandler := httpuser.NewImpersonationHandler(impersonateUseCase)
+	var identityHandler *httpuser.IdentityHandler
+	if identityProvider != nil {
+		// The state cookie is sent back to the callback, it can only be Secure when the callback is on HTTPS
+		identityHandler = httpuser.NewIdentityHandler(
+			startIdentityUseCase,
+			completeIdentityUseCase,
+			redeemIdentityUseCase,
+			cfg.OIDC.LoginRedirectURL,
+			time.Duration(cfg.OIDC.StateExpiration)*time.Minute,
+			strings.HasPrefix(cfg.OIDC.RedirectURL, "https://"),
+		)
+	}
+	twoFactorHandler := httpuser.NewTwoFactorHandler(
+		enrollTwoFactorUseCase,
+		confirmTwoFactorUseCase,
+		recoveryCodesUseCase,
+		disableTwoFactorUseCase,
+		loginTwoFactorUseCase,
+	)
 
 	return &Container{
 		Repo:                userRepo,
+		RefreshTokenRepo:    refreshTokenRepo,
+		PasswordResetRepo:   passwordResetRepo,
+		LoginAttemptRepo:    loginAttemptRepo,
+		TwoFactorRepo:       twoFactorRepo,
+		APIKeyRepo:          apiKeyRepo,
+		IdentityRepo:        identityRepo,
+		SessionRepo:         sessionRepo,
+		OrganizationRepo:    organizationRepo,
+		InvitationRepo:      invitationRepo,
 		Service:             userService,
 		TokenGen:            jwtAdapter,
 		TokenValidator:      jwtAdapter,
+		PublicKeys:          jwtKeys,
+		TokenRevocations:    tokenRevocations,
+		LoginAttempts:       loginAttempts,
+		TwoFactorChallenges: twoFactorChallenges,
+		AuthorizationStates: authorizationStates,
+		LoginCodes:          loginCodes,
+		IdentityProvider:    identityProvider,
 		PasswordHasher:      passwordHasher,
 		NotificationService: notificationService,
+		TokenIssuer:         tokenIssuer,
 		CreateUseCase:       createUseCase,
 		GetUseCase:          getUseCase,
 		ListUseCase:         listUseCase,
 		UpdateUseCase:       updateUseCase,
 		DeleteUseCase:       deleteUseCase,
+		DeleteAccountUC:     deleteAccountUseCase,
 		LoginUseCase:        loginUseCase,
+		RefreshUseCase:      refreshUseCase,
+		LogoutUseCase:       logoutUseCase,
+		ForgotPasswordUC:    forgotPasswordUseCase,
+		ResetPasswordUC:     resetPasswordUseCase,
+		VerifyEmailUC:       verifyEmailUseCase,
+		ResendVerifyUC:      resendVerificationUseCase,
+		ListLoginAttemptsUC: listLoginAttemptsUseCase,
+		EnrollTwoFactorUC:   enrollTwoFactorUseCase,
+		ConfirmTwoFactorUC:  confirmTwoFactorUseCase,
+		RecoveryCodesUC:     recoveryCodesUseCase,
+		DisableTwoFactorUC:  disableTwoFactorUseCase,
+		LoginTwoFactorUC:    loginTwoFactorUseCase,
+		CreateAPIKeyUC:      createAPIKeyUseCase,
+		ListAPIKeysUC:       listAPIKeysUseCase,
+		RevokeAPIKeyUC:      revokeAPIKeyUseCase,
+		AuthenticateAPIKey:  authenticateAPIKeyUseCase,
+		StartIdentityUC:     startIdentityUseCase,
+		CompleteIdentityUC:  completeIdentityUseCase,
+		RedeemIdentityUC:    redeemIdentityUseCase,
+		UpdateProfileUC:     updateProfileUseCase,
+		ChangePasswordUC:    changePasswordUseCase,
+		ListSessionsUC:      listSessionsUseCase,
+		RevokeSessionUC:     revokeSessionUseCase,
+		RevokeAllSessionsUC: revokeAllSessionsUseCase,
+		ListDeletedUC:       listDeletedUseCase,
+		RestoreUC:           restoreUseCase,
+		PurgeUC:             purgeUseCase,
+		AnonymizeUC:         anonymizeUseCase,
+		CreateOrgUC:         createOrganizationUseCase,
+		ListOrgsUC:          listOrganizationsUseCase,
+		SwitchOrgUC:         switchOrganizationUseCase,
+		CreateInvitationUC:  createInvitationUseCase,
+		AcceptInvitationUC:  acceptInvitationUseCase,
+		ImpersonateUC:       impersonateUseCase,
+		VerificationPolicy:  verificationPolicy,
+		RegistrationMode:    registrationMode,
 		Handler:             userHandler,
-	}
+		TokenHandler:        tokenHandler,
+		PasswordHandler:     passwordHandler,
+		VerificationHandler: verificationHandler,
+		ActivityHandler:     activityHandler,
+		TwoFactorHandler:    twoFactorHandler,
+		JWKSHandler:         jwksHandler,
+		APIKeyHandler:       apiKeyHandler,
+		IdentityHandler:     identityHandler,
+		ProfileHandler:      profileHandler,
+		SessionHandler:      sessionHandler,
+		TrashHandler:        trashHandler,
+		OrganizationHandler: organizationHandler,
+		InvitationHandler:   invitationHandler,
+		ImpersonateHandler:  impersonationHandler,
+	}, nil
 }
@@ -0,0 +1,6 @@
+-- Add role to users table
+-- Promote the first administrator manually:
+--   UPDATE users SET role = 'admin' WHERE email = '<admin email>';
+ALTER TABLE users
+    ADD COLUMN role VARCHAR(20) NOT NULL DEFAULT 'author' AFTER password,
+    ADD INDEX idx_users_role (role);
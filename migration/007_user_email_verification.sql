@@ -0,0 +1,6 @@
+-- Add email verification to users table
+-- Existing accounts are treated as verified so the policy only affects new registrations
+ALTER TABLE users
+    ADD COLUMN email_verified_at TIMESTAMP NULL DEFAULT NULL AFTER role;
+
+UPDATE users SET email_verified_at = created_at WHERE email_verified_at IS NULL;
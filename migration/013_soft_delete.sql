@@ -0,0 +1,13 @@
+-- Add soft delete to users, articles and media
+-- Deleted rows keep deleted_at until the purge job removes them after TRASH_RETENTION_DAYS.
+ALTER TABLE users
+    ADD COLUMN deleted_at TIMESTAMP NULL DEFAULT NULL,
+    ADD INDEX idx_users_deleted_at (deleted_at);
+
+ALTER TABLE articles
+    ADD COLUMN deleted_at TIMESTAMP NULL DEFAULT NULL,
+    ADD INDEX idx_articles_deleted_at (deleted_at);
+
+ALTER TABLE media
+    ADD COLUMN deleted_at TIMESTAMP NULL DEFAULT NULL,
+    ADD INDEX idx_media_deleted_at (deleted_at);
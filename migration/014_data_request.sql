@@ -0,0 +1,25 @@
+-- Record who uploaded media and add data-subject requests (exports and erasures)
+-- Media uploaded before this migration have no owner and are not part of exports.
+ALTER TABLE media
+    ADD COLUMN owner_id BIGINT NULL DEFAULT NULL AFTER id,
+    ADD INDEX idx_media_owner_id (owner_id),
+    ADD CONSTRAINT fk_media_owner FOREIGN KEY (owner_id) REFERENCES users(id) ON DELETE SET NULL;
+
+-- Requests are kept after the account is erased, they hold no personal data.
+CREATE TABLE IF NOT EXISTS data_requests (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    user_id BIGINT NOT NULL,
+    requested_by BIGINT NOT NULL,
+    type VARCHAR(20) NOT NULL,
+    status VARCHAR(20) NOT NULL,
+    archive_path VARCHAR(255) NOT NULL DEFAULT '',
+    error VARCHAR(1024) NOT NULL DEFAULT '',
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    started_at TIMESTAMP NULL DEFAULT NULL,
+    completed_at TIMESTAMP NULL DEFAULT NULL,
+    expires_at TIMESTAMP NULL DEFAULT NULL,
+    
+    INDEX idx_data_requests_status_id (status, id),
+    INDEX idx_data_requests_user_id_type (user_id, type),
+    INDEX idx_data_requests_expires_at (expires_at)
+);
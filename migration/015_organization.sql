@@ -0,0 +1,45 @@
+-- Add organizations and scope content to them
+-- Existing users, articles and media are moved to the Default organization (id 1), which
+-- users who sign up on their own join (DEFAULT_ORGANIZATION_ID).
+CREATE TABLE IF NOT EXISTS organizations (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    name VARCHAR(255) NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP
+);
+
+INSERT INTO organizations (id, name) VALUES (1, 'Default');
+
+-- The role of a user is the role of its membership in the active organization.
+CREATE TABLE IF NOT EXISTS organization_members (
+    org_id BIGINT NOT NULL,
+    user_id BIGINT NOT NULL,
+    role VARCHAR(20) NOT NULL DEFAULT 'author',
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    
+    PRIMARY KEY (org_id, user_id),
+    INDEX idx_organization_members_user_id (user_id),
+    
+    FOREIGN KEY (org_id) REFERENCES organizations(id) ON DELETE CASCADE,
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+INSERT INTO organization_members (org_id, user_id, role, created_at)
+    SELECT 1, id, role, created_at FROM users;
+
+ALTER TABLE articles
+    ADD COLUMN org_id BIGINT NOT NULL DEFAULT 1 AFTER author_id,
+    ADD INDEX idx_articles_org_id (org_id),
+    ADD CONSTRAINT fk_articles_org FOREIGN KEY (org_id) REFERENCES organizations(id) ON DELETE CASCADE;
+
+ALTER TABLE media
+    ADD COLUMN org_id BIGINT NOT NULL DEFAULT 1 AFTER owner_id,
+    ADD INDEX idx_media_org_id (org_id),
+    ADD CONSTRAINT fk_media_org FOREIGN KEY (org_id) REFERENCES organizations(id) ON DELETE CASCADE;
+
+-- Tokens and API keys are issued for one organization
+ALTER TABLE refresh_tokens
+    ADD COLUMN org_id BIGINT NOT NULL DEFAULT 1 AFTER user_id;
+
+ALTER TABLE api_keys
+    ADD COLUMN org_id BIGINT NOT NULL DEFAULT 1 AFTER user_id;
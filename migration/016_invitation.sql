@@ -0,0 +1,19 @@
+-- Create invitations table
+-- Only the hash of the invitation token is stored, the token itself is sent by email.
+-- invited_by is cleared when the admin who sent the invitation is purged.
+CREATE TABLE IF NOT EXISTS invitations (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    org_id BIGINT NOT NULL,
+    email VARCHAR(255) NOT NULL,
+    role VARCHAR(20) NOT NULL DEFAULT 'author',
+    token_hash CHAR(64) NOT NULL UNIQUE,
+    invited_by BIGINT NULL DEFAULT NULL,
+    expires_at TIMESTAMP NOT NULL,
+    accepted_at TIMESTAMP NULL DEFAULT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    
+    INDEX idx_invitations_email (email),
+    
+    FOREIGN KEY (org_id) REFERENCES organizations(id) ON DELETE CASCADE,
+    FOREIGN KEY (invited_by) REFERENCES users(id) ON DELETE SET NULL
+);
@@ -0,0 +1,12 @@
+-- Create password_history table
+-- Holds the previous password hashes of users so they are not reused
+CREATE TABLE IF NOT EXISTS password_history (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    user_id BIGINT NOT NULL,
+    password_hash VARCHAR(255) NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    
+    INDEX idx_password_history_user_id_created_at (user_id, created_at),
+    
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
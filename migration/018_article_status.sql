@@ -0,0 +1,8 @@
+-- Add the publishing workflow to articles (draft -> in_review -> published -> archived)
+-- Articles written before the workflow were live already, so they are published as of their creation.
+ALTER TABLE articles
+    ADD COLUMN status VARCHAR(20) NOT NULL DEFAULT 'draft',
+    ADD COLUMN published_at TIMESTAMP NULL DEFAULT NULL,
+    ADD INDEX idx_articles_org_id_status (org_id, status);
+
+UPDATE articles SET status = 'published', published_at = created_at;
@@ -0,0 +1,4 @@
+-- Let editors schedule articles in review to be published at a given time
+ALTER TABLE articles
+    ADD COLUMN publish_at TIMESTAMP NULL DEFAULT NULL,
+    ADD INDEX idx_articles_status_publish_at (status, publish_at);
@@ -0,0 +1,25 @@
+-- Give articles a slug, unique within their organization, and keep the slugs they used before
+-- Existing articles get a slug from their title suffixed with their ID. Letters outside a-z are
+-- dropped, the next title change gives them a transliterated slug and keeps this one working.
+-- Long titles are cut so that the suffix still fits in the column.
+ALTER TABLE articles ADD COLUMN slug VARCHAR(255) NULL DEFAULT NULL AFTER title;
+
+UPDATE articles
+SET slug = TRIM(BOTH '-' FROM CONCAT(LEFT(LOWER(REGEXP_REPLACE(title, '[^A-Za-z0-9]+', '-')), 255 - LENGTH(id) - 1), '-', id));
+
+ALTER TABLE articles
+    MODIFY COLUMN slug VARCHAR(255) NOT NULL,
+    ADD UNIQUE INDEX idx_articles_org_id_slug (org_id, slug);
+
+-- Previous slugs of an article resolve to it with a redirect hint
+CREATE TABLE IF NOT EXISTS article_slugs (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    org_id BIGINT NOT NULL,
+    article_id BIGINT NOT NULL,
+    slug VARCHAR(255) NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    
+    UNIQUE INDEX idx_article_slugs_org_id_slug (org_id, slug),
+    
+    FOREIGN KEY (article_id) REFERENCES articles(id) ON DELETE CASCADE
+);
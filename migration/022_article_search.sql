@@ -0,0 +1,6 @@
+-- Full text search over article titles and content, used by the MySQL search index
+-- The title has its own index so that title matches can rank higher. InnoDB builds one
+-- FULLTEXT index per statement.
+ALTER TABLE articles ADD FULLTEXT INDEX idx_articles_search (title, content);
+
+ALTER TABLE articles ADD FULLTEXT INDEX idx_articles_title_search (title);
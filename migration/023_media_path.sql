@@ -0,0 +1,2 @@
+-- Media files are served through a lookup by path, which skips media in the trash
+ALTER TABLE media ADD INDEX idx_media_path (path);
@@ -0,0 +1,7 @@
+-- Purging a user no longer deletes their articles. A user in the trash who still
+-- authors articles is kept until the articles are deleted or handed to another user.
+-- articles_ibfk_1 is the name MySQL gave the author foreign key of migration 002.
+ALTER TABLE articles DROP FOREIGN KEY articles_ibfk_1;
+
+ALTER TABLE articles
+    ADD CONSTRAINT fk_articles_author FOREIGN KEY (author_id) REFERENCES users(id) ON DELETE RESTRICT;
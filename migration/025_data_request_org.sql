@@ -0,0 +1,7 @@
+-- Requests filed by an organization admin only cover the data of that organization.
+-- org_id 0 marks a request covering the whole account, which only the user can file.
+-- Existing requests keep covering the whole account.
+ALTER TABLE data_requests
+    ADD COLUMN org_id BIGINT NOT NULL DEFAULT 0 AFTER user_id,
+    DROP INDEX idx_data_requests_user_id_type,
+    ADD INDEX idx_data_requests_user_id_type (user_id, type, org_id);
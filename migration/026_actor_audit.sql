@@ -0,0 +1,13 @@
+-- Changes made while an admin impersonates a user are recorded under the admin.
+-- created_by and updated_by hold who really changed an article, updated_by 0 marks the scheduler.
+-- Existing articles are attributed to their author.
+ALTER TABLE articles
+    ADD COLUMN created_by BIGINT NOT NULL DEFAULT 0 AFTER updated_at,
+    ADD COLUMN updated_by BIGINT NOT NULL DEFAULT 0 AFTER created_by;
+
+UPDATE articles SET created_by = author_id, updated_by = author_id;
+
+-- Impersonation is recorded as a session of the user, actor_id holds the admin.
+-- actor_id 0 marks a login of the user.
+ALTER TABLE sessions
+    ADD COLUMN actor_id BIGINT NOT NULL DEFAULT 0 AFTER user_id;
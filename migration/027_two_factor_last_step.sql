@@ -0,0 +1,4 @@
+-- A one-time password is accepted once, last_used_step holds the time step of the last accepted code.
+-- Codes of that step or an earlier one are rejected.
+ALTER TABLE user_two_factor
+    ADD COLUMN last_used_step BIGINT NOT NULL DEFAULT 0 AFTER enabled_at;
@@ -0,0 +1,15 @@
+-- Media, tags and categories record who really changed them, the admin when impersonating a user.
+-- Existing media are attributed to their owner, 0 marks an unknown actor.
+ALTER TABLE media
+    ADD COLUMN created_by BIGINT NOT NULL DEFAULT 0 AFTER updated_at,
+    ADD COLUMN updated_by BIGINT NOT NULL DEFAULT 0 AFTER created_by;
+
+UPDATE media SET created_by = owner_id, updated_by = owner_id WHERE owner_id IS NOT NULL;
+
+ALTER TABLE tags
+    ADD COLUMN created_by BIGINT NOT NULL DEFAULT 0 AFTER updated_at,
+    ADD COLUMN updated_by BIGINT NOT NULL DEFAULT 0 AFTER created_by;
+
+ALTER TABLE categories
+    ADD COLUMN created_by BIGINT NOT NULL DEFAULT 0 AFTER updated_at,
+    ADD COLUMN updated_by BIGINT NOT NULL DEFAULT 0 AFTER created_by;